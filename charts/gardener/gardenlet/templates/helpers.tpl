@@ -220,6 +220,10 @@ controllers:
     {{- if .Values.config.controllers.shoot.dnsEntryTTLSeconds }}
     dnsEntryTTLSeconds: {{ .Values.config.controllers.shoot.dnsEntryTTLSeconds }}
     {{- end }}
+    {{- if .Values.config.controllers.shoot.caPrivateKeyStore }}
+    caPrivateKeyStore:
+{{ toYaml .Values.config.controllers.shoot.caPrivateKeyStore | indent 6 }}
+    {{- end }}
   shootCare:
     concurrentSyncs: {{ required ".Values.config.controllers.shootCare.concurrentSyncs is required" .Values.config.controllers.shootCare.concurrentSyncs }}
     syncPeriod: {{ required ".Values.config.controllers.shootCare.syncPeriod is required" .Values.config.controllers.shootCare.syncPeriod }}
@@ -238,8 +242,14 @@ controllers:
 {{ toYaml .Values.config.controllers.shootCare.conditionThresholds | indent 4 }}
     {{- end }}
     webhookRemediatorEnabled: {{ required ".Values.config.controllers.shootCare.webhookRemediatorEnabled is required" .Values.config.controllers.shootCare.webhookRemediatorEnabled }}
+    {{- if .Values.config.controllers.shootCare.caCertificateExpirationThreshold }}
+    caCertificateExpirationThreshold: {{ .Values.config.controllers.shootCare.caCertificateExpirationThreshold }}
+    {{- end }}
   seedCare:
     syncPeriod: {{ required ".Values.config.controllers.seedCare.syncPeriod is required" .Values.config.controllers.seedCare.syncPeriod }}
+    {{- if .Values.config.controllers.seedCare.resourceUsageSyncPeriod }}
+    resourceUsageSyncPeriod: {{ .Values.config.controllers.seedCare.resourceUsageSyncPeriod }}
+    {{- end }}
     conditionThresholds:
     {{- if .Values.config.controllers.seedCare.conditionThresholds }}
 {{ toYaml .Values.config.controllers.seedCare.conditionThresholds | indent 4 }}
@@ -755,6 +755,7 @@ func ComputeExpectedGardenletConfiguration(
 						},
 					},
 				},
+				ResourceUsageSyncPeriod: &metav1.Duration{Duration: 10 * time.Minute},
 			},
 			ShootState: &gardenletconfigv1alpha1.ShootStateControllerConfiguration{
 				ConcurrentSyncs: &five,
@@ -13,10 +13,15 @@ import (
 	"github.com/gardener/gardener/pkg/gardenadm/cmd"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/bootstrap"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/connect"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/diagnose"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/discover"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/etcd"
 	initcmd "github.com/gardener/gardener/pkg/gardenadm/cmd/init"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/join"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/preflight"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/reset"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/token"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/version"
 )
 
@@ -67,8 +72,12 @@ func prepareClusterBootstrapGroup(cmd *cobra.Command, opts *cmd.Options) {
 	for _, subcommand := range []*cobra.Command{
 		initcmd.NewCommand(opts),
 		join.NewCommand(opts),
+		preflight.NewCommand(opts),
 		bootstrap.NewCommand(opts),
 		token.NewCommand(opts),
+		reset.NewCommand(opts),
+		upgrade.NewCommand(opts),
+		etcd.NewCommand(opts),
 	} {
 		subcommand.GroupID = group.ID
 		cmd.AddCommand(subcommand)
@@ -101,6 +110,7 @@ func prepareAdditionalGroup(cmd *cobra.Command, opts *cmd.Options) {
 	cmd.SetCompletionCommandGroupID(group.ID)
 
 	for _, subcommand := range []*cobra.Command{
+		diagnose.NewCommand(opts),
 		version.NewCommand(opts),
 	} {
 		subcommand.GroupID = group.ID
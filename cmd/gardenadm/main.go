@@ -7,20 +7,33 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"os"
+	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
 
 	"github.com/gardener/gardener/cmd/gardenadm/app"
 	"github.com/gardener/gardener/cmd/utils"
 	"github.com/gardener/gardener/pkg/gardenadm/features"
+	"github.com/gardener/gardener/pkg/tracing"
 )
 
 func main() {
 	utils.DeduplicateWarnings()
 	features.RegisterFeatureGates()
 
-	if err := app.NewCommand().ExecuteContext(signals.SetupSignalHandler()); err != nil {
+	err := app.NewCommand().ExecuteContext(signals.SetupSignalHandler())
+
+	// flush pending spans after the command has been executed, regardless of whether it succeeded
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	if shutdownErr := tracing.Shutdown(ctx); shutdownErr != nil {
+		fmt.Fprintf(os.Stderr, "Failed shutting down tracing: %v\n", shutdownErr)
+	}
+	cancel()
+
+	if err != nil {
 		os.Exit(1)
 	}
 }
@@ -148,6 +148,10 @@ func addAllFieldIndexes(ctx context.Context, i client.FieldIndexer) error {
 		indexer.AddProjectNamespace,
 		indexer.AddShootSeedName,
 		indexer.AddShootStatusSeedName,
+		indexer.AddShootCloudProfileRefName,
+		indexer.AddShootSecretBindingName,
+		indexer.AddShootCredentialsBindingName,
+		indexer.AddSecretBindingQuotas,
 		indexer.AddBackupBucketSeedName,
 		indexer.AddBackupEntrySeedName,
 		indexer.AddControllerInstallationSeedRefName,
@@ -155,6 +159,8 @@ func addAllFieldIndexes(ctx context.Context, i client.FieldIndexer) error {
 		indexer.AddNamespacedCloudProfileParentRefName,
 		// operations API group
 		indexer.AddBastionShootName,
+		// security API group
+		indexer.AddCredentialsBindingQuotas,
 		// seedmanagement API group
 		indexer.AddManagedSeedShootName,
 	} {
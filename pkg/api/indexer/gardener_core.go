@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -114,6 +115,28 @@ func NamespacedCloudProfileParentRefNameIndexerFunc(obj client.Object) []string
 	return []string{namespacedCloudProfile.Spec.Parent.Name}
 }
 
+// ShootCloudProfileRefNameIndexerFunc extracts the name of the CloudProfile or NamespacedCloudProfile referenced by a
+// Shoot, regardless of whether it is referenced via .spec.cloudProfileName or .spec.cloudProfile.name.
+func ShootCloudProfileRefNameIndexerFunc(obj client.Object) []string {
+	shoot, ok := obj.(*gardencorev1beta1.Shoot)
+	if !ok {
+		return []string{""}
+	}
+	if shoot.Spec.CloudProfile != nil {
+		return []string{shoot.Spec.CloudProfile.Name}
+	}
+	return []string{ptr.Deref(shoot.Spec.CloudProfileName, "")}
+}
+
+// SecretBindingQuotasIndexerFunc extracts the references (<namespace>/<name>) in the .quotas field of a SecretBinding.
+func SecretBindingQuotasIndexerFunc(obj client.Object) []string {
+	secretBinding, ok := obj.(*gardencorev1beta1.SecretBinding)
+	if !ok {
+		return []string{""}
+	}
+	return QuotaReferenceKeys(secretBinding.Quotas)
+}
+
 // AddProjectNamespace adds an index for core.ProjectNamespace to the given indexer.
 func AddProjectNamespace(ctx context.Context, indexer client.FieldIndexer) error {
 	if err := indexer.IndexField(ctx, &gardencorev1beta1.Project{}, core.ProjectNamespace, ProjectNamespaceIndexerFunc); err != nil {
@@ -136,6 +159,42 @@ func AddShootSeedName(ctx context.Context, indexer client.FieldIndexer) error {
 	return nil
 }
 
+// AddShootCloudProfileRefName adds an index for core.ShootCloudProfileRefName to the given indexer.
+func AddShootCloudProfileRefName(ctx context.Context, indexer client.FieldIndexer) error {
+	if err := indexer.IndexField(ctx, &gardencorev1beta1.Shoot{}, core.ShootCloudProfileRefName, ShootCloudProfileRefNameIndexerFunc); err != nil {
+		return fmt.Errorf("failed to add indexer for %s to Shoot Informer: %w", core.ShootCloudProfileRefName, err)
+	}
+	return nil
+}
+
+// AddShootSecretBindingName adds an index for core.ShootSecretBindingName to the given indexer.
+func AddShootSecretBindingName(ctx context.Context, indexer client.FieldIndexer) error {
+	if err := indexer.IndexField(ctx, &gardencorev1beta1.Shoot{}, core.ShootSecretBindingName, func(obj client.Object) []string {
+		shoot, ok := obj.(*gardencorev1beta1.Shoot)
+		if !ok {
+			return []string{""}
+		}
+		return []string{ptr.Deref(shoot.Spec.SecretBindingName, "")}
+	}); err != nil {
+		return fmt.Errorf("failed to add indexer for %s to Shoot Informer: %w", core.ShootSecretBindingName, err)
+	}
+	return nil
+}
+
+// AddShootCredentialsBindingName adds an index for core.ShootCredentialsBindingName to the given indexer.
+func AddShootCredentialsBindingName(ctx context.Context, indexer client.FieldIndexer) error {
+	if err := indexer.IndexField(ctx, &gardencorev1beta1.Shoot{}, core.ShootCredentialsBindingName, func(obj client.Object) []string {
+		shoot, ok := obj.(*gardencorev1beta1.Shoot)
+		if !ok {
+			return []string{""}
+		}
+		return []string{ptr.Deref(shoot.Spec.CredentialsBindingName, "")}
+	}); err != nil {
+		return fmt.Errorf("failed to add indexer for %s to Shoot Informer: %w", core.ShootCredentialsBindingName, err)
+	}
+	return nil
+}
+
 // AddShootStatusSeedName adds an index for core.ShootStatusSeedName to the given indexer.
 func AddShootStatusSeedName(ctx context.Context, indexer client.FieldIndexer) error {
 	if err := indexer.IndexField(ctx, &gardencorev1beta1.Shoot{}, core.ShootStatusSeedName, func(obj client.Object) []string {
@@ -243,3 +302,21 @@ func AddNamespacedCloudProfileParentRefName(ctx context.Context, indexer client.
 	}
 	return nil
 }
+
+// AddSecretBindingQuotas adds an index for core.SecretBindingQuotas to the given indexer.
+func AddSecretBindingQuotas(ctx context.Context, indexer client.FieldIndexer) error {
+	if err := indexer.IndexField(ctx, &gardencorev1beta1.SecretBinding{}, core.SecretBindingQuotas, SecretBindingQuotasIndexerFunc); err != nil {
+		return fmt.Errorf("failed to add indexer for %s to SecretBinding Informer: %w", core.SecretBindingQuotas, err)
+	}
+	return nil
+}
+
+// QuotaReferenceKeys returns the keys (<namespace>/<name>) of the given Quota references, as used by the
+// SecretBinding and CredentialsBinding quota indexes.
+func QuotaReferenceKeys(quotaRefs []corev1.ObjectReference) []string {
+	keys := make([]string, 0, len(quotaRefs))
+	for _, ref := range quotaRefs {
+		keys = append(keys, ref.Namespace+"/"+ref.Name)
+	}
+	return keys
+}
@@ -55,6 +55,52 @@ var _ = Describe("Core", func() {
 		Entry("Shoot w/ seedName", &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{SeedName: ptr.To("seed")}}, ConsistOf("seed")),
 	)
 
+	DescribeTable("#AddShootCloudProfileRefName",
+		func(obj client.Object, matcher gomegatypes.GomegaMatcher) {
+			Expect(AddShootCloudProfileRefName(context.TODO(), indexer)).To(Succeed())
+
+			Expect(indexer.obj).To(Equal(&gardencorev1beta1.Shoot{}))
+			Expect(indexer.field).To(Equal("spec.cloudProfile.Name"))
+			Expect(indexer.extractValue).NotTo(BeNil())
+			Expect(indexer.extractValue(obj)).To(matcher)
+		},
+
+		Entry("no Shoot", &corev1.Secret{}, ConsistOf("")),
+		Entry("Shoot w/o cloud profile", &gardencorev1beta1.Shoot{}, ConsistOf("")),
+		Entry("Shoot w/ cloudProfileName", &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{CloudProfileName: ptr.To("profile")}}, ConsistOf("profile")),
+		Entry("Shoot w/ cloudProfile", &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{CloudProfile: &gardencorev1beta1.CloudProfileReference{Kind: "NamespacedCloudProfile", Name: "profile"}}}, ConsistOf("profile")),
+	)
+
+	DescribeTable("#AddShootSecretBindingName",
+		func(obj client.Object, matcher gomegatypes.GomegaMatcher) {
+			Expect(AddShootSecretBindingName(context.TODO(), indexer)).To(Succeed())
+
+			Expect(indexer.obj).To(Equal(&gardencorev1beta1.Shoot{}))
+			Expect(indexer.field).To(Equal("spec.secretBindingName"))
+			Expect(indexer.extractValue).NotTo(BeNil())
+			Expect(indexer.extractValue(obj)).To(matcher)
+		},
+
+		Entry("no Shoot", &corev1.Secret{}, ConsistOf("")),
+		Entry("Shoot w/o secretBindingName", &gardencorev1beta1.Shoot{}, ConsistOf("")),
+		Entry("Shoot w/ secretBindingName", &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{SecretBindingName: ptr.To("binding")}}, ConsistOf("binding")),
+	)
+
+	DescribeTable("#AddShootCredentialsBindingName",
+		func(obj client.Object, matcher gomegatypes.GomegaMatcher) {
+			Expect(AddShootCredentialsBindingName(context.TODO(), indexer)).To(Succeed())
+
+			Expect(indexer.obj).To(Equal(&gardencorev1beta1.Shoot{}))
+			Expect(indexer.field).To(Equal("spec.credentialsBindingName"))
+			Expect(indexer.extractValue).NotTo(BeNil())
+			Expect(indexer.extractValue(obj)).To(matcher)
+		},
+
+		Entry("no Shoot", &corev1.Secret{}, ConsistOf("")),
+		Entry("Shoot w/o credentialsBindingName", &gardencorev1beta1.Shoot{}, ConsistOf("")),
+		Entry("Shoot w/ credentialsBindingName", &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{CredentialsBindingName: ptr.To("binding")}}, ConsistOf("binding")),
+	)
+
 	DescribeTable("#AddShootStatusSeedName",
 		func(obj client.Object, matcher gomegatypes.GomegaMatcher) {
 			Expect(AddShootStatusSeedName(context.TODO(), indexer)).To(Succeed())
@@ -219,4 +265,19 @@ var _ = Describe("Core", func() {
 		Entry("InternalSecret w/o type", &gardencorev1beta1.InternalSecret{}, ConsistOf("")),
 		Entry("InternalSecret w/ type", &gardencorev1beta1.InternalSecret{Type: corev1.SecretTypeBootstrapToken}, ConsistOf("bootstrap.kubernetes.io/token")),
 	)
+
+	DescribeTable("#AddSecretBindingQuotas",
+		func(obj client.Object, matcher gomegatypes.GomegaMatcher) {
+			Expect(AddSecretBindingQuotas(context.TODO(), indexer)).To(Succeed())
+
+			Expect(indexer.obj).To(Equal(&gardencorev1beta1.SecretBinding{}))
+			Expect(indexer.field).To(Equal("quotas"))
+			Expect(indexer.extractValue).NotTo(BeNil())
+			Expect(indexer.extractValue(obj)).To(matcher)
+		},
+
+		Entry("no SecretBinding", &corev1.Secret{}, ConsistOf("")),
+		Entry("SecretBinding w/o quotas", &gardencorev1beta1.SecretBinding{}, BeEmpty()),
+		Entry("SecretBinding w/ quotas", &gardencorev1beta1.SecretBinding{Quotas: []corev1.ObjectReference{{Namespace: "garden", Name: "quota1"}, {Name: "quota2"}}}, ConsistOf("garden/quota1", "/quota2")),
+	)
 })
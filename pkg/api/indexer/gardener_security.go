@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package indexer
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/apis/security"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+)
+
+// CredentialsBindingQuotasIndexerFunc extracts the references (<namespace>/<name>) in the .quotas field of a
+// CredentialsBinding.
+func CredentialsBindingQuotasIndexerFunc(obj client.Object) []string {
+	credentialsBinding, ok := obj.(*securityv1alpha1.CredentialsBinding)
+	if !ok {
+		return []string{""}
+	}
+	return QuotaReferenceKeys(credentialsBinding.Quotas)
+}
+
+// AddCredentialsBindingQuotas adds an index for security.CredentialsBindingQuotas to the given indexer.
+func AddCredentialsBindingQuotas(ctx context.Context, indexer client.FieldIndexer) error {
+	if err := indexer.IndexField(ctx, &securityv1alpha1.CredentialsBinding{}, security.CredentialsBindingQuotas, CredentialsBindingQuotasIndexerFunc); err != nil {
+		return fmt.Errorf("failed to add indexer for %s to CredentialsBinding Informer: %w", security.CredentialsBindingQuotas, err)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package indexer_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gomegatypes "github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/gardener/gardener/pkg/api/indexer"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+)
+
+var _ = Describe("Security", func() {
+	var indexer *fakeFieldIndexer
+
+	BeforeEach(func() {
+		indexer = &fakeFieldIndexer{}
+	})
+
+	DescribeTable("#AddCredentialsBindingQuotas",
+		func(obj client.Object, matcher gomegatypes.GomegaMatcher) {
+			Expect(AddCredentialsBindingQuotas(context.TODO(), indexer)).To(Succeed())
+
+			Expect(indexer.obj).To(Equal(&securityv1alpha1.CredentialsBinding{}))
+			Expect(indexer.field).To(Equal("quotas"))
+			Expect(indexer.extractValue).NotTo(BeNil())
+			Expect(indexer.extractValue(obj)).To(matcher)
+		},
+
+		Entry("no CredentialsBinding", &corev1.Secret{}, ConsistOf("")),
+		Entry("CredentialsBinding w/o quotas", &securityv1alpha1.CredentialsBinding{}, BeEmpty()),
+		Entry("CredentialsBinding w/ quotas", &securityv1alpha1.CredentialsBinding{Quotas: []corev1.ObjectReference{{Namespace: "garden", Name: "quota1"}, {Name: "quota2"}}}, ConsistOf("garden/quota1", "/quota2")),
+	)
+})
@@ -45,6 +45,10 @@ const (
 	// the Seed name of a core.gardener.cloud/v1beta1.ControllerInstallation.
 	SeedRefName = "spec.seedRef.name"
 
+	// SecretBindingQuotas is the field selector path for finding
+	// the Quotas (<namespace>/<name>) referenced by a core.gardener.cloud/v1beta1.SecretBinding.
+	SecretBindingQuotas = "quotas"
+
 	// ShootCloudProfileName is the field selector path for finding
 	// the CloudProfile name of a core.gardener.cloud/v1beta1.Shoot.
 	ShootCloudProfileName = "spec.cloudProfileName"
@@ -54,6 +58,12 @@ const (
 	// ShootCloudProfileRefKind is the field selector path for finding
 	// the referenced CloudProfile kind of a core.gardener.cloud/v1beta1.Shoot.
 	ShootCloudProfileRefKind = "spec.cloudProfile.Kind"
+	// ShootSecretBindingName is the field selector path for finding
+	// the SecretBinding name of a core.gardener.cloud/v1beta1.Shoot.
+	ShootSecretBindingName = "spec.secretBindingName"
+	// ShootCredentialsBindingName is the field selector path for finding
+	// the CredentialsBinding name of a core.gardener.cloud/v1beta1.Shoot.
+	ShootCredentialsBindingName = "spec.credentialsBindingName"
 	// ShootSeedName is the field selector path for finding
 	// the Seed cluster of a core.gardener.cloud/v1beta1.Shoot.
 	ShootSeedName = "spec.seedName"
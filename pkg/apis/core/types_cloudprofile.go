@@ -7,6 +7,7 @@ package core
 import (
 	"slices"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -76,6 +77,9 @@ type CloudProfileSpec struct {
 	// The order of values for a given capability is relevant. The most important value is listed first.
 	// During maintenance upgrades, the image that matches most capabilities will be selected.
 	MachineCapabilities []CapabilityDefinition
+	// QuotaAccounting configures the resources which are accounted against Quotas for the control planes and load
+	// balancers of Shoot clusters using this CloudProfile, in addition to the resources of their worker pools.
+	QuotaAccounting *QuotaAccounting
 }
 
 // SeedSelector contains constraints for selecting seed to be usable for shoots using a profile
@@ -151,6 +155,27 @@ type ExpirableVersion struct {
 	// Classification defines the state of a version (preview, supported, deprecated).
 	// To get the currently valid classification, use CurrentLifecycleClassification().
 	Classification *VersionClassification
+	// Rollout configures a staged rollout of this version to shoots grouped by their purpose. If unset, the version is
+	// offered to all shoots at once.
+	Rollout *VersionRollout
+}
+
+// VersionRollout contains the configuration for a staged rollout of a version in waves.
+type VersionRollout struct {
+	// StartTime is the time at which the version is offered to the first wave.
+	StartTime metav1.Time
+	// Waves is the ordered list of rollout waves. Shoots whose purpose is not part of any wave belong to the last wave.
+	Waves []RolloutWave
+}
+
+// RolloutWave is a group of shoots to which a version is offered at the same time.
+type RolloutWave struct {
+	// Purposes are the purposes of the shoots belonging to this wave.
+	Purposes []ShootPurpose
+	// SoakDuration is the duration for which the version must have been offered to the previous wave, and for which the
+	// shoots of the previous waves running the version must have reported healthy conditions, before the version is
+	// offered to this wave. It is ignored for the first wave.
+	SoakDuration *metav1.Duration
 }
 
 // MachineType contains certain properties of a machine type.
@@ -251,6 +276,25 @@ type Limits struct {
 	MaxNodesTotal *int32
 }
 
+// QuotaAccounting configures the resources which are accounted against Quotas for Shoot clusters.
+type QuotaAccounting struct {
+	// ControlPlanes are the resources accounted for the control plane of a Shoot depending on its failure tolerance.
+	ControlPlanes []ControlPlaneQuotaAccounting
+	// LoadBalancer are the resources accounted for each load balancer deducible from the Shoot specification, i.e., one
+	// for the Shoot plus one if the nginx-ingress addon is enabled. LoadBalancer services created in the Shoot cluster
+	// are not accounted.
+	LoadBalancer corev1.ResourceList
+}
+
+// ControlPlaneQuotaAccounting contains the resources accounted for a control plane with a certain failure tolerance.
+type ControlPlaneQuotaAccounting struct {
+	// FailureToleranceType is the failure tolerance type of the control plane. If not set, the resources are accounted
+	// for control planes which are not highly available.
+	FailureToleranceType *FailureToleranceType
+	// Resources are the resources accounted for the control plane.
+	Resources corev1.ResourceList
+}
+
 const (
 	// VolumeClassStandard is a constant for the standard volume class.
 	VolumeClassStandard string = "standard"
@@ -63,6 +63,20 @@ type ProjectSpec struct {
 	Tolerations *ProjectTolerations
 	// DualApprovalForDeletion contains configuration for the dual approval concept for resource deletion.
 	DualApprovalForDeletion []DualApprovalForDeletion
+	// MaintenanceFreezes is a list of periods during which automatic version updates and credentials rotations are not
+	// performed for the shoots of this project.
+	MaintenanceFreezes []MaintenanceFreeze
+	// HibernationCalendars is a list of named calendars which can be referenced by the hibernation schedules of the
+	// shoots of this project.
+	HibernationCalendars []HibernationCalendar
+	// AutoHibernation contains the configuration for hibernating the shoots of this project automatically when they are
+	// idle. It can be overridden in the shoots.
+	AutoHibernation *AutoHibernation
+	// Lifecycle contains the lifecycle policy of this project.
+	Lifecycle *ProjectLifecycle
+	// Notifications is a list of subscriptions which route selected events of this project and its shoots to external
+	// sinks.
+	Notifications []NotificationSubscription
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -78,6 +92,13 @@ type ProjectStatus struct {
 	StaleAutoDeleteTimestamp *metav1.Time
 	// LastActivityTimestamp contains the timestamp from the last activity performed in this project.
 	LastActivityTimestamp *metav1.Time
+	// ArchivedTimestamp contains the timestamp when the project was archived because it expired or was inactive.
+	ArchivedTimestamp *metav1.Time
+	// ArchivedAutoDeleteTimestamp contains the timestamp when the project will be automatically deleted because it is
+	// archived.
+	ArchivedAutoDeleteTimestamp *metav1.Time
+	// Conditions represents the latest available observations of a Project's current state.
+	Conditions []Condition
 }
 
 // ProjectMember is a member of a project.
@@ -117,6 +138,87 @@ type DualApprovalForDeletion struct {
 	IncludeServiceAccounts *bool
 }
 
+// MaintenanceFreeze is a period during which automatic updates of Kubernetes and machine image versions as well as
+// automatic credentials rotations are not performed for shoots.
+type MaintenanceFreeze struct {
+	// Name is the name of the maintenance freeze.
+	Name string
+	// Begin is the start (inclusive) of the maintenance freeze.
+	Begin metav1.Time
+	// End is the end (exclusive) of the maintenance freeze.
+	End metav1.Time
+	// Recurrence defines whether the maintenance freeze repeats. Only `Yearly` is supported. If unset, the maintenance
+	// freeze is active only once.
+	Recurrence *MaintenanceFreezeRecurrence
+	// HoldForcedUpdates defines whether forceful updates of expired Kubernetes and machine image versions are held back
+	// during the maintenance freeze as well. It can only be set for landscape-wide maintenance freezes in the
+	// gardener-controller-manager configuration, not in Projects.
+	HoldForcedUpdates *bool
+}
+
+// MaintenanceFreezeRecurrence defines how a maintenance freeze repeats.
+type MaintenanceFreezeRecurrence string
+
+const (
+	// MaintenanceFreezeRecurrenceYearly is a constant for a maintenance freeze repeating every year.
+	MaintenanceFreezeRecurrenceYearly MaintenanceFreezeRecurrence = "Yearly"
+)
+
+// HibernationCalendar is a named list of days, e.g., public holidays or company shutdown days, which can be referenced
+// by hibernation schedules of shoots.
+type HibernationCalendar struct {
+	// Name is the name of the hibernation calendar.
+	Name string
+	// Days is a list of days or ranges of days of the calendar.
+	Days []HibernationCalendarDays
+}
+
+// HibernationCalendarDays is a single day or a range of days. Days are evaluated in the location of the hibernation
+// schedule referencing the calendar.
+type HibernationCalendarDays struct {
+	// Begin is the first day of the range in the format `YYYY-MM-DD`.
+	Begin string
+	// End is the last day (inclusive) of the range in the format `YYYY-MM-DD`. If unset, the range only consists of the
+	// day of Begin.
+	End *string
+}
+
+// ProjectLifecycle contains the lifecycle policy of a project.
+type ProjectLifecycle struct {
+	// ExpirationTimestamp is the point in time at which the project expires. Expired projects are archived, i.e., their
+	// shoots are hibernated and no new shoots can be created, and are deleted after the archival period. The archival
+	// can be reverted by extending or removing the expiration timestamp before the project is deleted.
+	ExpirationTimestamp *metav1.Time
+}
+
+// NotificationSubscription routes selected events of a project and its shoots to a sink.
+type NotificationSubscription struct {
+	// Name is the name of the subscription.
+	Name string
+	// Kinds restricts the subscription to events about objects of the given kinds (`Project` or `Shoot`). Events about
+	// all kinds are selected if empty.
+	Kinds []string
+	// Types restricts the subscription to events of the given types (`Normal` or `Warning`). Events of all types are
+	// selected if empty.
+	Types []string
+	// Reasons restricts the subscription to events with the given reasons. Events with all reasons are selected if empty.
+	Reasons []string
+	// Webhook is the HTTP webhook to which the selected events are sent.
+	Webhook NotificationWebhook
+}
+
+// NotificationWebhook is an HTTP webhook to which events are sent.
+type NotificationWebhook struct {
+	// URL is the URL of the webhook. Events are sent with HTTP POST requests.
+	URL string
+	// PayloadTemplate is a Go template for the request body. It can access the fields `.Project`, `.Namespace`,
+	// `.Kind`, `.Name`, `.Type`, `.Reason`, `.Message`, `.Count` and `.Timestamp` of the event. The `json` function
+	// renders a value as JSON, e.g., to quote strings. If not set, the event is sent as a JSON object with these fields.
+	PayloadTemplate *string
+	// ContentType is the content type of the request body (defaults to `application/json`).
+	ContentType *string
+}
+
 const (
 	// ProjectMemberAdmin is a const for a role that provides full admin access.
 	ProjectMemberAdmin = "admin"
@@ -144,6 +246,12 @@ const (
 	ProjectFailed ProjectPhase = "Failed"
 	// ProjectTerminating indicates that the project is in termination process.
 	ProjectTerminating ProjectPhase = "Terminating"
+	// ProjectArchived indicates that the project is archived and will be deleted eventually.
+	ProjectArchived ProjectPhase = "Archived"
+
+	// ProjectDeletionScheduled is a constant for a condition type indicating that the project is scheduled for automatic
+	// deletion.
+	ProjectDeletionScheduled ConditionType = "DeletionScheduled"
 
 	// ProjectEventNamespaceReconcileFailed indicates that the namespace reconciliation has failed.
 	ProjectEventNamespaceReconcileFailed = "NamespaceReconcileFailed"
@@ -155,4 +263,13 @@ const (
 	ProjectEventNamespaceDeletionFailed = "NamespaceDeletionFailed"
 	// ProjectEventNamespaceMarkedForDeletion indicates that the namespace has been successfully marked for deletion.
 	ProjectEventNamespaceMarkedForDeletion = "NamespaceMarkedForDeletion"
+	// ProjectEventArchived indicates that the project has been archived.
+	ProjectEventArchived = "Archived"
+	// ProjectEventUnarchived indicates that the archival of the project has been reverted.
+	ProjectEventUnarchived = "Unarchived"
+	// ProjectEventDeletionScheduled notifies about the upcoming automatic deletion of the project.
+	ProjectEventDeletionScheduled = "DeletionScheduled"
+	// ProjectEventDeletionNotConfirmed indicates that the automatic deletion of the project is blocked because it still
+	// contains shoots and the deletion was not confirmed.
+	ProjectEventDeletionNotConfirmed = "DeletionNotConfirmed"
 )
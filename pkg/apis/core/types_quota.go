@@ -20,6 +20,8 @@ type Quota struct {
 
 	// Spec defines the Quota constraints.
 	Spec QuotaSpec
+	// Status contains the current usage of the Quota.
+	Status QuotaStatus
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -42,6 +44,33 @@ type QuotaSpec struct {
 	Metrics corev1.ResourceList
 	// Scope is the scope of the Quota object, either 'project', 'secret' or 'workloadidentity'. This field is immutable.
 	Scope corev1.ObjectReference
+	// SoftMetrics is a list of thresholds for the resources in Metrics. Exceeding them does not reject requests, but
+	// results in warnings and events.
+	SoftMetrics corev1.ResourceList
+	// GracePeriod is the duration during which the limits in Metrics may be exceeded before requests get rejected. It
+	// starts when the resources allocated by the Shoots exceed the limits for the first time.
+	GracePeriod *metav1.Duration
+}
+
+// QuotaStatus holds the most recently observed status of the Quota.
+type QuotaStatus struct {
+	// Used is the amount of the constrained resources which is allocated by the Shoots referencing the Quota.
+	Used corev1.ResourceList
+	// Shoots is the list of Shoots allocating resources of the Quota.
+	Shoots []QuotaShootUsage
+	// LimitsExceededSince is the time since the resources allocated by the Shoots exceed at least one of the limits in
+	// Metrics. It determines the end of the grace period.
+	LimitsExceededSince *metav1.Time
+}
+
+// QuotaShootUsage contains the resources allocated by a Shoot referencing a Quota.
+type QuotaShootUsage struct {
+	// Name is the name of the Shoot.
+	Name string
+	// Namespace is the namespace of the Shoot.
+	Namespace string
+	// Used is the amount of the constrained resources which is allocated by the Shoot.
+	Used corev1.ResourceList
 }
 
 const (
@@ -57,4 +86,12 @@ const (
 	QuotaMetricStoragePremium corev1.ResourceName = corev1.ResourceStorage + ".premium"
 	// QuotaMetricLoadbalancer is the constraint for the amount of loadbalancers
 	QuotaMetricLoadbalancer corev1.ResourceName = "loadbalancer"
+	// QuotaMetricControlPlaneNonHA is the constraint for the number of control planes which are not highly available
+	QuotaMetricControlPlaneNonHA corev1.ResourceName = "controlplane.nonha"
+	// QuotaMetricControlPlaneHANode is the constraint for the number of control planes which are highly available
+	// across the nodes of a single zone
+	QuotaMetricControlPlaneHANode corev1.ResourceName = "controlplane.ha.node"
+	// QuotaMetricControlPlaneHAZone is the constraint for the number of control planes which are highly available across
+	// multiple zones
+	QuotaMetricControlPlaneHAZone corev1.ResourceName = "controlplane.ha.zone"
 )
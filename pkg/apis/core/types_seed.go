@@ -99,6 +99,18 @@ type SeedStatus struct {
 	ClientCertificateExpirationTimestamp *metav1.Time
 	// LastOperation holds information about the last operation on the Seed.
 	LastOperation *LastOperation
+	// ResourceUsage contains the aggregated resource requests of the shoot control planes and the allocatable
+	// resources of the nodes of the seed cluster.
+	ResourceUsage *SeedResourceUsage
+}
+
+// SeedResourceUsage contains the aggregated resource requests of the shoot control planes and the allocatable
+// resources of the nodes of a seed cluster.
+type SeedResourceUsage struct {
+	// ControlPlaneRequests are the aggregated resource requests of all pods in the shoot control plane namespaces.
+	ControlPlaneRequests corev1.ResourceList
+	// NodeAllocatable are the aggregated allocatable resources of all nodes of the seed cluster.
+	NodeAllocatable corev1.ResourceList
 }
 
 // Backup contains the object store configuration for backups for shoot (currently only etcd).
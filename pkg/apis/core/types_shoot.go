@@ -175,6 +175,12 @@ type ShootStatus struct {
 	InPlaceUpdates *InPlaceUpdatesStatus
 	// ManualWorkerPoolRollout contains information about the worker pool rollout progress.
 	ManualWorkerPoolRollout *ManualWorkerPoolRollout
+	// UpcomingMaintenance contains a preview of the operations which are expected to be performed in the next
+	// maintenance time window of the Shoot.
+	UpcomingMaintenance *UpcomingMaintenance
+	// LastActivityTime is the most recent time at which user requests or workload changes were observed in the
+	// kube-apiserver of the Shoot. It is only maintained for awake Shoots with enabled automatic hibernation.
+	LastActivityTime *metav1.Time
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -187,6 +193,37 @@ type LastMaintenance struct {
 	State LastOperationState
 	// FailureReason holds the information about the last maintenance operation failure reason.
 	FailureReason *string
+	// Deferral contains information about maintenance operations which were deferred because of an active maintenance
+	// freeze.
+	Deferral *MaintenanceDeferral
+}
+
+// MaintenanceDeferral contains information about maintenance operations which were deferred because of an active
+// maintenance freeze.
+type MaintenanceDeferral struct {
+	// Freeze is the name of the active maintenance freeze.
+	Freeze string
+	// Until is the end of the active maintenance freeze.
+	Until metav1.Time
+	// Operations are human-readable descriptions of the deferred maintenance operations.
+	Operations []string
+}
+
+// UpcomingMaintenance contains a preview of the operations which are expected to be performed in the next maintenance
+// time window of the Shoot.
+type UpcomingMaintenance struct {
+	// WindowStart is the begin of the next maintenance time window of the Shoot.
+	WindowStart metav1.Time
+	// Operations are human-readable descriptions of the maintenance operations which are expected to be performed.
+	Operations []string
+	// FailedOperations are human-readable descriptions of the maintenance operations which are expected to fail, e.g.,
+	// because no suitable version to update to is available.
+	FailedOperations []string
+	// Deferral contains information about maintenance operations which are expected to be deferred because of a
+	// maintenance freeze active at the begin of the next maintenance time window.
+	Deferral *MaintenanceDeferral
+	// LastUpdateTime is the time when the preview was last updated.
+	LastUpdateTime metav1.Time
 }
 
 // NetworkingStatus contains information about cluster networking such as CIDRs.
@@ -223,6 +260,19 @@ type ShootCredentials struct {
 	Rotation *ShootCredentialsRotation
 	// EncryptionAtRest contains information about Shoot data encryption at rest.
 	EncryptionAtRest *EncryptionAtRest
+	// CertificateExpirations contains a summary of the expiration times of the certificates managed by gardenlet for
+	// the Shoot.
+	CertificateExpirations []CertificateExpiration
+}
+
+// CertificateExpiration contains information about the expiration of a certificate.
+type CertificateExpiration struct {
+	// Name is the name of the certificate.
+	Name string
+	// CertificateAuthority states whether the certificate is a certificate authority.
+	CertificateAuthority bool
+	// ExpirationTime is the time when the certificate expires.
+	ExpirationTime metav1.Time
 }
 
 // ShootCredentialsRotation contains information about the rotation of credentials.
@@ -493,6 +543,21 @@ type Hibernation struct {
 	Enabled *bool
 	// Schedules determine the hibernation schedules.
 	Schedules []HibernationSchedule
+	// StayAwakeUntil is the point in time until which the Shoot is not hibernated by its hibernation schedules. Once it
+	// has passed, the Shoot is hibernated if it would be hibernated according to its schedules at that time.
+	StayAwakeUntil *metav1.Time
+	// AutoHibernation contains the configuration for hibernating the Shoot automatically when it is idle. If set, it
+	// takes precedence over the configuration in the Shoot's Project.
+	AutoHibernation *AutoHibernation
+}
+
+// AutoHibernation contains the configuration for hibernating shoots automatically when they are idle, i.e., when
+// neither user requests nor workload changes have been observed in their kube-apiserver for some time.
+type AutoHibernation struct {
+	// Enabled specifies whether shoots are hibernated automatically when they are idle.
+	Enabled bool
+	// IdleTimeout is the duration without any observed activity after which a shoot is hibernated. Defaults to 4h.
+	IdleTimeout *metav1.Duration
 }
 
 // HibernationSchedule determines the hibernation schedule of a Shoot.
@@ -505,6 +570,12 @@ type HibernationSchedule struct {
 	End *string
 	// Location is the time location in which both start and shall be evaluated.
 	Location *string
+	// SkipCalendars is a list of names of hibernation calendars of the Shoot's Project. Start and end times falling on
+	// the days of these calendars are skipped.
+	SkipCalendars []string
+	// HibernateCalendars is a list of names of hibernation calendars of the Shoot's Project. The Shoot is hibernated at
+	// the beginning of each day of these calendars and end times falling on these days are skipped.
+	HibernateCalendars []string
 }
 
 // Kubernetes contains the version and configuration variables for the Shoot control plane.
@@ -1613,6 +1684,8 @@ const (
 	ShootEventSchedulingSuccessful = "SchedulingSuccessful"
 	// ShootEventSchedulingFailed indicates that a scheduling decision failed.
 	ShootEventSchedulingFailed = "SchedulingFailed"
+	// ShootEventSchedulingScores indicates the scores of the seed candidates of a scheduling decision.
+	ShootEventSchedulingScores = "SchedulingScores"
 )
 
 const (
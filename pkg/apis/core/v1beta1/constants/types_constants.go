@@ -358,6 +358,9 @@ const (
 	// LabelExcludeWebhookFromRemediation is a constant for a label on a webhook in the shoot which makes it being
 	// excluded from automatic remediation.
 	LabelExcludeWebhookFromRemediation = "remediation.webhook.shoot.gardener.cloud/exclude"
+	// ShootHibernationReason is a constant for an annotation on a Shoot which describes why it was hibernated
+	// automatically.
+	ShootHibernationReason = "shoot.gardener.cloud/hibernation-reason"
 
 	// ShootTasks is a constant for an annotation on a Shoot which states that certain tasks should be done.
 	ShootTasks = "shoot.gardener.cloud/tasks"
@@ -876,6 +879,9 @@ const (
 	// skipped by the stale project controller. If the project has already configured stale timestamps in its status
 	// then they will be reset.
 	ProjectSkipStaleCheck = "project.gardener.cloud/skip-stale-check"
+	// ProjectOperationRenew is a constant for the value of the operation annotation on a Project which marks it as
+	// active again. This reverts the archival of a Project which was archived due to inactivity.
+	ProjectOperationRenew = "renew"
 	// NamespaceProject is the key of an annotation on namespace whose value holds the project uid.
 	NamespaceProject = "namespace.gardener.cloud/project"
 	// NamespaceKeepAfterProjectDeletion is a constant for an annotation on a `Namespace` resource that states that it
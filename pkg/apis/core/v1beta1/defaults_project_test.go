@@ -7,6 +7,7 @@ package v1beta1_test
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/utils/ptr"
 
@@ -160,4 +161,18 @@ var _ = Describe("Project defaulting", func() {
 			}
 		})
 	})
+
+	Describe("notification defaulting", func() {
+		It("should default the content type of webhooks", func() {
+			obj.Spec.Notifications = []NotificationSubscription{
+				{Name: "foo"},
+				{Name: "bar", Webhook: NotificationWebhook{ContentType: ptr.To("text/plain")}},
+			}
+
+			SetObjectDefaults_Project(obj)
+
+			Expect(obj.Spec.Notifications[0].Webhook.ContentType).To(PointTo(Equal("application/json")))
+			Expect(obj.Spec.Notifications[1].Webhook.ContentType).To(PointTo(Equal("text/plain")))
+		})
+	})
 })
@@ -352,10 +352,38 @@ func (m *AuthorizerKubeconfigReference) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AuthorizerKubeconfigReference proto.InternalMessageInfo
 
+func (m *AutoHibernation) Reset()      { *m = AutoHibernation{} }
+func (*AutoHibernation) ProtoMessage() {}
+func (*AutoHibernation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{11}
+}
+func (m *AutoHibernation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoHibernation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AutoHibernation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoHibernation.Merge(m, src)
+}
+func (m *AutoHibernation) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoHibernation) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoHibernation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoHibernation proto.InternalMessageInfo
+
 func (m *AvailabilityZone) Reset()      { *m = AvailabilityZone{} }
 func (*AvailabilityZone) ProtoMessage() {}
 func (*AvailabilityZone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{11}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{12}
 }
 func (m *AvailabilityZone) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -383,7 +411,7 @@ var xxx_messageInfo_AvailabilityZone proto.InternalMessageInfo
 func (m *Backup) Reset()      { *m = Backup{} }
 func (*Backup) ProtoMessage() {}
 func (*Backup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{12}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{13}
 }
 func (m *Backup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -411,7 +439,7 @@ var xxx_messageInfo_Backup proto.InternalMessageInfo
 func (m *BackupBucket) Reset()      { *m = BackupBucket{} }
 func (*BackupBucket) ProtoMessage() {}
 func (*BackupBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{13}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{14}
 }
 func (m *BackupBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -439,7 +467,7 @@ var xxx_messageInfo_BackupBucket proto.InternalMessageInfo
 func (m *BackupBucketList) Reset()      { *m = BackupBucketList{} }
 func (*BackupBucketList) ProtoMessage() {}
 func (*BackupBucketList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{14}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{15}
 }
 func (m *BackupBucketList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -467,7 +495,7 @@ var xxx_messageInfo_BackupBucketList proto.InternalMessageInfo
 func (m *BackupBucketProvider) Reset()      { *m = BackupBucketProvider{} }
 func (*BackupBucketProvider) ProtoMessage() {}
 func (*BackupBucketProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{15}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{16}
 }
 func (m *BackupBucketProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -495,7 +523,7 @@ var xxx_messageInfo_BackupBucketProvider proto.InternalMessageInfo
 func (m *BackupBucketSpec) Reset()      { *m = BackupBucketSpec{} }
 func (*BackupBucketSpec) ProtoMessage() {}
 func (*BackupBucketSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{16}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{17}
 }
 func (m *BackupBucketSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -523,7 +551,7 @@ var xxx_messageInfo_BackupBucketSpec proto.InternalMessageInfo
 func (m *BackupBucketStatus) Reset()      { *m = BackupBucketStatus{} }
 func (*BackupBucketStatus) ProtoMessage() {}
 func (*BackupBucketStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{17}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{18}
 }
 func (m *BackupBucketStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -551,7 +579,7 @@ var xxx_messageInfo_BackupBucketStatus proto.InternalMessageInfo
 func (m *BackupEntry) Reset()      { *m = BackupEntry{} }
 func (*BackupEntry) ProtoMessage() {}
 func (*BackupEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{18}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{19}
 }
 func (m *BackupEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -579,7 +607,7 @@ var xxx_messageInfo_BackupEntry proto.InternalMessageInfo
 func (m *BackupEntryList) Reset()      { *m = BackupEntryList{} }
 func (*BackupEntryList) ProtoMessage() {}
 func (*BackupEntryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{19}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{20}
 }
 func (m *BackupEntryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -607,7 +635,7 @@ var xxx_messageInfo_BackupEntryList proto.InternalMessageInfo
 func (m *BackupEntrySpec) Reset()      { *m = BackupEntrySpec{} }
 func (*BackupEntrySpec) ProtoMessage() {}
 func (*BackupEntrySpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{20}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{21}
 }
 func (m *BackupEntrySpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -635,7 +663,7 @@ var xxx_messageInfo_BackupEntrySpec proto.InternalMessageInfo
 func (m *BackupEntryStatus) Reset()      { *m = BackupEntryStatus{} }
 func (*BackupEntryStatus) ProtoMessage() {}
 func (*BackupEntryStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{21}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{22}
 }
 func (m *BackupEntryStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -663,7 +691,7 @@ var xxx_messageInfo_BackupEntryStatus proto.InternalMessageInfo
 func (m *Bastion) Reset()      { *m = Bastion{} }
 func (*Bastion) ProtoMessage() {}
 func (*Bastion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{22}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{23}
 }
 func (m *Bastion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -691,7 +719,7 @@ var xxx_messageInfo_Bastion proto.InternalMessageInfo
 func (m *BastionMachineImage) Reset()      { *m = BastionMachineImage{} }
 func (*BastionMachineImage) ProtoMessage() {}
 func (*BastionMachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{23}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{24}
 }
 func (m *BastionMachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -719,7 +747,7 @@ var xxx_messageInfo_BastionMachineImage proto.InternalMessageInfo
 func (m *BastionMachineType) Reset()      { *m = BastionMachineType{} }
 func (*BastionMachineType) ProtoMessage() {}
 func (*BastionMachineType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{24}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{25}
 }
 func (m *BastionMachineType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -747,7 +775,7 @@ var xxx_messageInfo_BastionMachineType proto.InternalMessageInfo
 func (m *CARotation) Reset()      { *m = CARotation{} }
 func (*CARotation) ProtoMessage() {}
 func (*CARotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{25}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{26}
 }
 func (m *CARotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -775,7 +803,7 @@ var xxx_messageInfo_CARotation proto.InternalMessageInfo
 func (m *CRI) Reset()      { *m = CRI{} }
 func (*CRI) ProtoMessage() {}
 func (*CRI) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{26}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{27}
 }
 func (m *CRI) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -803,7 +831,7 @@ var xxx_messageInfo_CRI proto.InternalMessageInfo
 func (m *CapabilityDefinition) Reset()      { *m = CapabilityDefinition{} }
 func (*CapabilityDefinition) ProtoMessage() {}
 func (*CapabilityDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{27}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{28}
 }
 func (m *CapabilityDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -831,7 +859,7 @@ var xxx_messageInfo_CapabilityDefinition proto.InternalMessageInfo
 func (m *CapabilityValues) Reset()      { *m = CapabilityValues{} }
 func (*CapabilityValues) ProtoMessage() {}
 func (*CapabilityValues) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{28}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{29}
 }
 func (m *CapabilityValues) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -856,10 +884,38 @@ func (m *CapabilityValues) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CapabilityValues proto.InternalMessageInfo
 
+func (m *CertificateExpiration) Reset()      { *m = CertificateExpiration{} }
+func (*CertificateExpiration) ProtoMessage() {}
+func (*CertificateExpiration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{30}
+}
+func (m *CertificateExpiration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CertificateExpiration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CertificateExpiration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CertificateExpiration.Merge(m, src)
+}
+func (m *CertificateExpiration) XXX_Size() int {
+	return m.Size()
+}
+func (m *CertificateExpiration) XXX_DiscardUnknown() {
+	xxx_messageInfo_CertificateExpiration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CertificateExpiration proto.InternalMessageInfo
+
 func (m *CloudProfile) Reset()      { *m = CloudProfile{} }
 func (*CloudProfile) ProtoMessage() {}
 func (*CloudProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{29}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{31}
 }
 func (m *CloudProfile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -887,7 +943,7 @@ var xxx_messageInfo_CloudProfile proto.InternalMessageInfo
 func (m *CloudProfileList) Reset()      { *m = CloudProfileList{} }
 func (*CloudProfileList) ProtoMessage() {}
 func (*CloudProfileList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{30}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{32}
 }
 func (m *CloudProfileList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -915,7 +971,7 @@ var xxx_messageInfo_CloudProfileList proto.InternalMessageInfo
 func (m *CloudProfileReference) Reset()      { *m = CloudProfileReference{} }
 func (*CloudProfileReference) ProtoMessage() {}
 func (*CloudProfileReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{31}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{33}
 }
 func (m *CloudProfileReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -943,7 +999,7 @@ var xxx_messageInfo_CloudProfileReference proto.InternalMessageInfo
 func (m *CloudProfileSpec) Reset()      { *m = CloudProfileSpec{} }
 func (*CloudProfileSpec) ProtoMessage() {}
 func (*CloudProfileSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{32}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{34}
 }
 func (m *CloudProfileSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -971,7 +1027,7 @@ var xxx_messageInfo_CloudProfileSpec proto.InternalMessageInfo
 func (m *ClusterAutoscaler) Reset()      { *m = ClusterAutoscaler{} }
 func (*ClusterAutoscaler) ProtoMessage() {}
 func (*ClusterAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{33}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{35}
 }
 func (m *ClusterAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -999,7 +1055,7 @@ var xxx_messageInfo_ClusterAutoscaler proto.InternalMessageInfo
 func (m *ClusterAutoscalerOptions) Reset()      { *m = ClusterAutoscalerOptions{} }
 func (*ClusterAutoscalerOptions) ProtoMessage() {}
 func (*ClusterAutoscalerOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{34}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{36}
 }
 func (m *ClusterAutoscalerOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1027,7 +1083,7 @@ var xxx_messageInfo_ClusterAutoscalerOptions proto.InternalMessageInfo
 func (m *Condition) Reset()      { *m = Condition{} }
 func (*Condition) ProtoMessage() {}
 func (*Condition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{35}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{37}
 }
 func (m *Condition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1055,7 +1111,7 @@ var xxx_messageInfo_Condition proto.InternalMessageInfo
 func (m *ContainerRuntime) Reset()      { *m = ContainerRuntime{} }
 func (*ContainerRuntime) ProtoMessage() {}
 func (*ContainerRuntime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{36}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{38}
 }
 func (m *ContainerRuntime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1083,7 +1139,7 @@ var xxx_messageInfo_ContainerRuntime proto.InternalMessageInfo
 func (m *ControlPlane) Reset()      { *m = ControlPlane{} }
 func (*ControlPlane) ProtoMessage() {}
 func (*ControlPlane) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{37}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{39}
 }
 func (m *ControlPlane) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1111,7 +1167,7 @@ var xxx_messageInfo_ControlPlane proto.InternalMessageInfo
 func (m *ControlPlaneAutoscaling) Reset()      { *m = ControlPlaneAutoscaling{} }
 func (*ControlPlaneAutoscaling) ProtoMessage() {}
 func (*ControlPlaneAutoscaling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{38}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{40}
 }
 func (m *ControlPlaneAutoscaling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1136,10 +1192,38 @@ func (m *ControlPlaneAutoscaling) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ControlPlaneAutoscaling proto.InternalMessageInfo
 
+func (m *ControlPlaneQuotaAccounting) Reset()      { *m = ControlPlaneQuotaAccounting{} }
+func (*ControlPlaneQuotaAccounting) ProtoMessage() {}
+func (*ControlPlaneQuotaAccounting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{41}
+}
+func (m *ControlPlaneQuotaAccounting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ControlPlaneQuotaAccounting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ControlPlaneQuotaAccounting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ControlPlaneQuotaAccounting.Merge(m, src)
+}
+func (m *ControlPlaneQuotaAccounting) XXX_Size() int {
+	return m.Size()
+}
+func (m *ControlPlaneQuotaAccounting) XXX_DiscardUnknown() {
+	xxx_messageInfo_ControlPlaneQuotaAccounting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ControlPlaneQuotaAccounting proto.InternalMessageInfo
+
 func (m *ControllerDeployment) Reset()      { *m = ControllerDeployment{} }
 func (*ControllerDeployment) ProtoMessage() {}
 func (*ControllerDeployment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{39}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{42}
 }
 func (m *ControllerDeployment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1167,7 +1251,7 @@ var xxx_messageInfo_ControllerDeployment proto.InternalMessageInfo
 func (m *ControllerDeploymentList) Reset()      { *m = ControllerDeploymentList{} }
 func (*ControllerDeploymentList) ProtoMessage() {}
 func (*ControllerDeploymentList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{40}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{43}
 }
 func (m *ControllerDeploymentList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1195,7 +1279,7 @@ var xxx_messageInfo_ControllerDeploymentList proto.InternalMessageInfo
 func (m *ControllerInstallation) Reset()      { *m = ControllerInstallation{} }
 func (*ControllerInstallation) ProtoMessage() {}
 func (*ControllerInstallation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{41}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{44}
 }
 func (m *ControllerInstallation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1223,7 +1307,7 @@ var xxx_messageInfo_ControllerInstallation proto.InternalMessageInfo
 func (m *ControllerInstallationList) Reset()      { *m = ControllerInstallationList{} }
 func (*ControllerInstallationList) ProtoMessage() {}
 func (*ControllerInstallationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{42}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{45}
 }
 func (m *ControllerInstallationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1251,7 +1335,7 @@ var xxx_messageInfo_ControllerInstallationList proto.InternalMessageInfo
 func (m *ControllerInstallationSpec) Reset()      { *m = ControllerInstallationSpec{} }
 func (*ControllerInstallationSpec) ProtoMessage() {}
 func (*ControllerInstallationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{43}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{46}
 }
 func (m *ControllerInstallationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1279,7 +1363,7 @@ var xxx_messageInfo_ControllerInstallationSpec proto.InternalMessageInfo
 func (m *ControllerInstallationStatus) Reset()      { *m = ControllerInstallationStatus{} }
 func (*ControllerInstallationStatus) ProtoMessage() {}
 func (*ControllerInstallationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{44}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{47}
 }
 func (m *ControllerInstallationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1307,7 +1391,7 @@ var xxx_messageInfo_ControllerInstallationStatus proto.InternalMessageInfo
 func (m *ControllerRegistration) Reset()      { *m = ControllerRegistration{} }
 func (*ControllerRegistration) ProtoMessage() {}
 func (*ControllerRegistration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{45}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{48}
 }
 func (m *ControllerRegistration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1335,7 +1419,7 @@ var xxx_messageInfo_ControllerRegistration proto.InternalMessageInfo
 func (m *ControllerRegistrationDeployment) Reset()      { *m = ControllerRegistrationDeployment{} }
 func (*ControllerRegistrationDeployment) ProtoMessage() {}
 func (*ControllerRegistrationDeployment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{46}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{49}
 }
 func (m *ControllerRegistrationDeployment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1363,7 +1447,7 @@ var xxx_messageInfo_ControllerRegistrationDeployment proto.InternalMessageInfo
 func (m *ControllerRegistrationList) Reset()      { *m = ControllerRegistrationList{} }
 func (*ControllerRegistrationList) ProtoMessage() {}
 func (*ControllerRegistrationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{47}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{50}
 }
 func (m *ControllerRegistrationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1391,7 +1475,7 @@ var xxx_messageInfo_ControllerRegistrationList proto.InternalMessageInfo
 func (m *ControllerRegistrationSpec) Reset()      { *m = ControllerRegistrationSpec{} }
 func (*ControllerRegistrationSpec) ProtoMessage() {}
 func (*ControllerRegistrationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{48}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{51}
 }
 func (m *ControllerRegistrationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1419,7 +1503,7 @@ var xxx_messageInfo_ControllerRegistrationSpec proto.InternalMessageInfo
 func (m *ControllerResource) Reset()      { *m = ControllerResource{} }
 func (*ControllerResource) ProtoMessage() {}
 func (*ControllerResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{49}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{52}
 }
 func (m *ControllerResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1447,7 +1531,7 @@ var xxx_messageInfo_ControllerResource proto.InternalMessageInfo
 func (m *ControllerResourceLifecycle) Reset()      { *m = ControllerResourceLifecycle{} }
 func (*ControllerResourceLifecycle) ProtoMessage() {}
 func (*ControllerResourceLifecycle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{50}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{53}
 }
 func (m *ControllerResourceLifecycle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1475,7 +1559,7 @@ var xxx_messageInfo_ControllerResourceLifecycle proto.InternalMessageInfo
 func (m *CoreDNS) Reset()      { *m = CoreDNS{} }
 func (*CoreDNS) ProtoMessage() {}
 func (*CoreDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{51}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{54}
 }
 func (m *CoreDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1503,7 +1587,7 @@ var xxx_messageInfo_CoreDNS proto.InternalMessageInfo
 func (m *CoreDNSAutoscaling) Reset()      { *m = CoreDNSAutoscaling{} }
 func (*CoreDNSAutoscaling) ProtoMessage() {}
 func (*CoreDNSAutoscaling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{52}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{55}
 }
 func (m *CoreDNSAutoscaling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1531,7 +1615,7 @@ var xxx_messageInfo_CoreDNSAutoscaling proto.InternalMessageInfo
 func (m *CoreDNSRewriting) Reset()      { *m = CoreDNSRewriting{} }
 func (*CoreDNSRewriting) ProtoMessage() {}
 func (*CoreDNSRewriting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{53}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{56}
 }
 func (m *CoreDNSRewriting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1559,7 +1643,7 @@ var xxx_messageInfo_CoreDNSRewriting proto.InternalMessageInfo
 func (m *DNS) Reset()      { *m = DNS{} }
 func (*DNS) ProtoMessage() {}
 func (*DNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{54}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{57}
 }
 func (m *DNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1587,7 +1671,7 @@ var xxx_messageInfo_DNS proto.InternalMessageInfo
 func (m *DNSIncludeExclude) Reset()      { *m = DNSIncludeExclude{} }
 func (*DNSIncludeExclude) ProtoMessage() {}
 func (*DNSIncludeExclude) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{55}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{58}
 }
 func (m *DNSIncludeExclude) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1615,7 +1699,7 @@ var xxx_messageInfo_DNSIncludeExclude proto.InternalMessageInfo
 func (m *DNSProvider) Reset()      { *m = DNSProvider{} }
 func (*DNSProvider) ProtoMessage() {}
 func (*DNSProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{56}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{59}
 }
 func (m *DNSProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1643,7 +1727,7 @@ var xxx_messageInfo_DNSProvider proto.InternalMessageInfo
 func (m *DataVolume) Reset()      { *m = DataVolume{} }
 func (*DataVolume) ProtoMessage() {}
 func (*DataVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{57}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{60}
 }
 func (m *DataVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1671,7 +1755,7 @@ var xxx_messageInfo_DataVolume proto.InternalMessageInfo
 func (m *DeploymentRef) Reset()      { *m = DeploymentRef{} }
 func (*DeploymentRef) ProtoMessage() {}
 func (*DeploymentRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{58}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{61}
 }
 func (m *DeploymentRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1699,7 +1783,7 @@ var xxx_messageInfo_DeploymentRef proto.InternalMessageInfo
 func (m *DualApprovalForDeletion) Reset()      { *m = DualApprovalForDeletion{} }
 func (*DualApprovalForDeletion) ProtoMessage() {}
 func (*DualApprovalForDeletion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{59}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{62}
 }
 func (m *DualApprovalForDeletion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1727,7 +1811,7 @@ var xxx_messageInfo_DualApprovalForDeletion proto.InternalMessageInfo
 func (m *ETCD) Reset()      { *m = ETCD{} }
 func (*ETCD) ProtoMessage() {}
 func (*ETCD) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{60}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{63}
 }
 func (m *ETCD) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1755,7 +1839,7 @@ var xxx_messageInfo_ETCD proto.InternalMessageInfo
 func (m *ETCDConfig) Reset()      { *m = ETCDConfig{} }
 func (*ETCDConfig) ProtoMessage() {}
 func (*ETCDConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{61}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{64}
 }
 func (m *ETCDConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1783,7 +1867,7 @@ var xxx_messageInfo_ETCDConfig proto.InternalMessageInfo
 func (m *ETCDEncryptionKeyRotation) Reset()      { *m = ETCDEncryptionKeyRotation{} }
 func (*ETCDEncryptionKeyRotation) ProtoMessage() {}
 func (*ETCDEncryptionKeyRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{62}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{65}
 }
 func (m *ETCDEncryptionKeyRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1811,7 +1895,7 @@ var xxx_messageInfo_ETCDEncryptionKeyRotation proto.InternalMessageInfo
 func (m *EncryptionAtRest) Reset()      { *m = EncryptionAtRest{} }
 func (*EncryptionAtRest) ProtoMessage() {}
 func (*EncryptionAtRest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{63}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{66}
 }
 func (m *EncryptionAtRest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1839,7 +1923,7 @@ var xxx_messageInfo_EncryptionAtRest proto.InternalMessageInfo
 func (m *EncryptionConfig) Reset()      { *m = EncryptionConfig{} }
 func (*EncryptionConfig) ProtoMessage() {}
 func (*EncryptionConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{64}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{67}
 }
 func (m *EncryptionConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1867,7 +1951,7 @@ var xxx_messageInfo_EncryptionConfig proto.InternalMessageInfo
 func (m *ExpirableVersion) Reset()      { *m = ExpirableVersion{} }
 func (*ExpirableVersion) ProtoMessage() {}
 func (*ExpirableVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{65}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{68}
 }
 func (m *ExpirableVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1895,7 +1979,7 @@ var xxx_messageInfo_ExpirableVersion proto.InternalMessageInfo
 func (m *ExposureClass) Reset()      { *m = ExposureClass{} }
 func (*ExposureClass) ProtoMessage() {}
 func (*ExposureClass) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{66}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{69}
 }
 func (m *ExposureClass) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1923,7 +2007,7 @@ var xxx_messageInfo_ExposureClass proto.InternalMessageInfo
 func (m *ExposureClassList) Reset()      { *m = ExposureClassList{} }
 func (*ExposureClassList) ProtoMessage() {}
 func (*ExposureClassList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{67}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{70}
 }
 func (m *ExposureClassList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1951,7 +2035,7 @@ var xxx_messageInfo_ExposureClassList proto.InternalMessageInfo
 func (m *ExposureClassScheduling) Reset()      { *m = ExposureClassScheduling{} }
 func (*ExposureClassScheduling) ProtoMessage() {}
 func (*ExposureClassScheduling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{68}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{71}
 }
 func (m *ExposureClassScheduling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1979,7 +2063,7 @@ var xxx_messageInfo_ExposureClassScheduling proto.InternalMessageInfo
 func (m *Extension) Reset()      { *m = Extension{} }
 func (*Extension) ProtoMessage() {}
 func (*Extension) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{69}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{72}
 }
 func (m *Extension) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2007,7 +2091,7 @@ var xxx_messageInfo_Extension proto.InternalMessageInfo
 func (m *ExtensionResourceState) Reset()      { *m = ExtensionResourceState{} }
 func (*ExtensionResourceState) ProtoMessage() {}
 func (*ExtensionResourceState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{70}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{73}
 }
 func (m *ExtensionResourceState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2035,7 +2119,7 @@ var xxx_messageInfo_ExtensionResourceState proto.InternalMessageInfo
 func (m *FailureTolerance) Reset()      { *m = FailureTolerance{} }
 func (*FailureTolerance) ProtoMessage() {}
 func (*FailureTolerance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{71}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{74}
 }
 func (m *FailureTolerance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2063,7 +2147,7 @@ var xxx_messageInfo_FailureTolerance proto.InternalMessageInfo
 func (m *Gardener) Reset()      { *m = Gardener{} }
 func (*Gardener) ProtoMessage() {}
 func (*Gardener) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{72}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{75}
 }
 func (m *Gardener) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2091,7 +2175,7 @@ var xxx_messageInfo_Gardener proto.InternalMessageInfo
 func (m *GardenerResourceData) Reset()      { *m = GardenerResourceData{} }
 func (*GardenerResourceData) ProtoMessage() {}
 func (*GardenerResourceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{73}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{76}
 }
 func (m *GardenerResourceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2119,7 +2203,7 @@ var xxx_messageInfo_GardenerResourceData proto.InternalMessageInfo
 func (m *HelmControllerDeployment) Reset()      { *m = HelmControllerDeployment{} }
 func (*HelmControllerDeployment) ProtoMessage() {}
 func (*HelmControllerDeployment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{74}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{77}
 }
 func (m *HelmControllerDeployment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2147,7 +2231,7 @@ var xxx_messageInfo_HelmControllerDeployment proto.InternalMessageInfo
 func (m *Hibernation) Reset()      { *m = Hibernation{} }
 func (*Hibernation) ProtoMessage() {}
 func (*Hibernation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{75}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{78}
 }
 func (m *Hibernation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2172,10 +2256,66 @@ func (m *Hibernation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Hibernation proto.InternalMessageInfo
 
+func (m *HibernationCalendar) Reset()      { *m = HibernationCalendar{} }
+func (*HibernationCalendar) ProtoMessage() {}
+func (*HibernationCalendar) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{79}
+}
+func (m *HibernationCalendar) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HibernationCalendar) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HibernationCalendar) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HibernationCalendar.Merge(m, src)
+}
+func (m *HibernationCalendar) XXX_Size() int {
+	return m.Size()
+}
+func (m *HibernationCalendar) XXX_DiscardUnknown() {
+	xxx_messageInfo_HibernationCalendar.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HibernationCalendar proto.InternalMessageInfo
+
+func (m *HibernationCalendarDays) Reset()      { *m = HibernationCalendarDays{} }
+func (*HibernationCalendarDays) ProtoMessage() {}
+func (*HibernationCalendarDays) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{80}
+}
+func (m *HibernationCalendarDays) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HibernationCalendarDays) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HibernationCalendarDays) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HibernationCalendarDays.Merge(m, src)
+}
+func (m *HibernationCalendarDays) XXX_Size() int {
+	return m.Size()
+}
+func (m *HibernationCalendarDays) XXX_DiscardUnknown() {
+	xxx_messageInfo_HibernationCalendarDays.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HibernationCalendarDays proto.InternalMessageInfo
+
 func (m *HibernationSchedule) Reset()      { *m = HibernationSchedule{} }
 func (*HibernationSchedule) ProtoMessage() {}
 func (*HibernationSchedule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{76}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{81}
 }
 func (m *HibernationSchedule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2203,7 +2343,7 @@ var xxx_messageInfo_HibernationSchedule proto.InternalMessageInfo
 func (m *HighAvailability) Reset()      { *m = HighAvailability{} }
 func (*HighAvailability) ProtoMessage() {}
 func (*HighAvailability) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{77}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{82}
 }
 func (m *HighAvailability) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2231,7 +2371,7 @@ var xxx_messageInfo_HighAvailability proto.InternalMessageInfo
 func (m *HorizontalPodAutoscalerConfig) Reset()      { *m = HorizontalPodAutoscalerConfig{} }
 func (*HorizontalPodAutoscalerConfig) ProtoMessage() {}
 func (*HorizontalPodAutoscalerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{78}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{83}
 }
 func (m *HorizontalPodAutoscalerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2259,7 +2399,7 @@ var xxx_messageInfo_HorizontalPodAutoscalerConfig proto.InternalMessageInfo
 func (m *InPlaceUpdates) Reset()      { *m = InPlaceUpdates{} }
 func (*InPlaceUpdates) ProtoMessage() {}
 func (*InPlaceUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{79}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{84}
 }
 func (m *InPlaceUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2287,7 +2427,7 @@ var xxx_messageInfo_InPlaceUpdates proto.InternalMessageInfo
 func (m *InPlaceUpdatesStatus) Reset()      { *m = InPlaceUpdatesStatus{} }
 func (*InPlaceUpdatesStatus) ProtoMessage() {}
 func (*InPlaceUpdatesStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{80}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{85}
 }
 func (m *InPlaceUpdatesStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2315,7 +2455,7 @@ var xxx_messageInfo_InPlaceUpdatesStatus proto.InternalMessageInfo
 func (m *Ingress) Reset()      { *m = Ingress{} }
 func (*Ingress) ProtoMessage() {}
 func (*Ingress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{81}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{86}
 }
 func (m *Ingress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2343,7 +2483,7 @@ var xxx_messageInfo_Ingress proto.InternalMessageInfo
 func (m *IngressController) Reset()      { *m = IngressController{} }
 func (*IngressController) ProtoMessage() {}
 func (*IngressController) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{82}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{87}
 }
 func (m *IngressController) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2371,7 +2511,7 @@ var xxx_messageInfo_IngressController proto.InternalMessageInfo
 func (m *InternalSecret) Reset()      { *m = InternalSecret{} }
 func (*InternalSecret) ProtoMessage() {}
 func (*InternalSecret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{83}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{88}
 }
 func (m *InternalSecret) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2399,7 +2539,7 @@ var xxx_messageInfo_InternalSecret proto.InternalMessageInfo
 func (m *InternalSecretList) Reset()      { *m = InternalSecretList{} }
 func (*InternalSecretList) ProtoMessage() {}
 func (*InternalSecretList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{84}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{89}
 }
 func (m *InternalSecretList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2427,7 +2567,7 @@ var xxx_messageInfo_InternalSecretList proto.InternalMessageInfo
 func (m *KubeAPIServerConfig) Reset()      { *m = KubeAPIServerConfig{} }
 func (*KubeAPIServerConfig) ProtoMessage() {}
 func (*KubeAPIServerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{85}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{90}
 }
 func (m *KubeAPIServerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2455,7 +2595,7 @@ var xxx_messageInfo_KubeAPIServerConfig proto.InternalMessageInfo
 func (m *KubeControllerManagerConfig) Reset()      { *m = KubeControllerManagerConfig{} }
 func (*KubeControllerManagerConfig) ProtoMessage() {}
 func (*KubeControllerManagerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{86}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{91}
 }
 func (m *KubeControllerManagerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2483,7 +2623,7 @@ var xxx_messageInfo_KubeControllerManagerConfig proto.InternalMessageInfo
 func (m *KubeProxyConfig) Reset()      { *m = KubeProxyConfig{} }
 func (*KubeProxyConfig) ProtoMessage() {}
 func (*KubeProxyConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{87}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{92}
 }
 func (m *KubeProxyConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2511,7 +2651,7 @@ var xxx_messageInfo_KubeProxyConfig proto.InternalMessageInfo
 func (m *KubeSchedulerConfig) Reset()      { *m = KubeSchedulerConfig{} }
 func (*KubeSchedulerConfig) ProtoMessage() {}
 func (*KubeSchedulerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{88}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{93}
 }
 func (m *KubeSchedulerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2539,7 +2679,7 @@ var xxx_messageInfo_KubeSchedulerConfig proto.InternalMessageInfo
 func (m *KubeletConfig) Reset()      { *m = KubeletConfig{} }
 func (*KubeletConfig) ProtoMessage() {}
 func (*KubeletConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{89}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{94}
 }
 func (m *KubeletConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2567,7 +2707,7 @@ var xxx_messageInfo_KubeletConfig proto.InternalMessageInfo
 func (m *KubeletConfigEviction) Reset()      { *m = KubeletConfigEviction{} }
 func (*KubeletConfigEviction) ProtoMessage() {}
 func (*KubeletConfigEviction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{90}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{95}
 }
 func (m *KubeletConfigEviction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2595,7 +2735,7 @@ var xxx_messageInfo_KubeletConfigEviction proto.InternalMessageInfo
 func (m *KubeletConfigEvictionMinimumReclaim) Reset()      { *m = KubeletConfigEvictionMinimumReclaim{} }
 func (*KubeletConfigEvictionMinimumReclaim) ProtoMessage() {}
 func (*KubeletConfigEvictionMinimumReclaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{91}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{96}
 }
 func (m *KubeletConfigEvictionMinimumReclaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2623,7 +2763,7 @@ var xxx_messageInfo_KubeletConfigEvictionMinimumReclaim proto.InternalMessageInf
 func (m *KubeletConfigEvictionSoftGracePeriod) Reset()      { *m = KubeletConfigEvictionSoftGracePeriod{} }
 func (*KubeletConfigEvictionSoftGracePeriod) ProtoMessage() {}
 func (*KubeletConfigEvictionSoftGracePeriod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{92}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{97}
 }
 func (m *KubeletConfigEvictionSoftGracePeriod) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2651,7 +2791,7 @@ var xxx_messageInfo_KubeletConfigEvictionSoftGracePeriod proto.InternalMessageIn
 func (m *KubeletConfigReserved) Reset()      { *m = KubeletConfigReserved{} }
 func (*KubeletConfigReserved) ProtoMessage() {}
 func (*KubeletConfigReserved) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{93}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{98}
 }
 func (m *KubeletConfigReserved) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2679,7 +2819,7 @@ var xxx_messageInfo_KubeletConfigReserved proto.InternalMessageInfo
 func (m *Kubernetes) Reset()      { *m = Kubernetes{} }
 func (*Kubernetes) ProtoMessage() {}
 func (*Kubernetes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{94}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{99}
 }
 func (m *Kubernetes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2707,7 +2847,7 @@ var xxx_messageInfo_Kubernetes proto.InternalMessageInfo
 func (m *KubernetesConfig) Reset()      { *m = KubernetesConfig{} }
 func (*KubernetesConfig) ProtoMessage() {}
 func (*KubernetesConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{95}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{100}
 }
 func (m *KubernetesConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2735,7 +2875,7 @@ var xxx_messageInfo_KubernetesConfig proto.InternalMessageInfo
 func (m *KubernetesDashboard) Reset()      { *m = KubernetesDashboard{} }
 func (*KubernetesDashboard) ProtoMessage() {}
 func (*KubernetesDashboard) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{96}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{101}
 }
 func (m *KubernetesDashboard) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2763,7 +2903,7 @@ var xxx_messageInfo_KubernetesDashboard proto.InternalMessageInfo
 func (m *KubernetesSettings) Reset()      { *m = KubernetesSettings{} }
 func (*KubernetesSettings) ProtoMessage() {}
 func (*KubernetesSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{97}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{102}
 }
 func (m *KubernetesSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2791,7 +2931,7 @@ var xxx_messageInfo_KubernetesSettings proto.InternalMessageInfo
 func (m *LastError) Reset()      { *m = LastError{} }
 func (*LastError) ProtoMessage() {}
 func (*LastError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{98}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{103}
 }
 func (m *LastError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2819,7 +2959,7 @@ var xxx_messageInfo_LastError proto.InternalMessageInfo
 func (m *LastMaintenance) Reset()      { *m = LastMaintenance{} }
 func (*LastMaintenance) ProtoMessage() {}
 func (*LastMaintenance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{99}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{104}
 }
 func (m *LastMaintenance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2847,7 +2987,7 @@ var xxx_messageInfo_LastMaintenance proto.InternalMessageInfo
 func (m *LastOperation) Reset()      { *m = LastOperation{} }
 func (*LastOperation) ProtoMessage() {}
 func (*LastOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{100}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{105}
 }
 func (m *LastOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2875,7 +3015,7 @@ var xxx_messageInfo_LastOperation proto.InternalMessageInfo
 func (m *Limits) Reset()      { *m = Limits{} }
 func (*Limits) ProtoMessage() {}
 func (*Limits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{101}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{106}
 }
 func (m *Limits) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2903,7 +3043,7 @@ var xxx_messageInfo_Limits proto.InternalMessageInfo
 func (m *LoadBalancerServicesProxyProtocol) Reset()      { *m = LoadBalancerServicesProxyProtocol{} }
 func (*LoadBalancerServicesProxyProtocol) ProtoMessage() {}
 func (*LoadBalancerServicesProxyProtocol) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{102}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{107}
 }
 func (m *LoadBalancerServicesProxyProtocol) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2931,7 +3071,7 @@ var xxx_messageInfo_LoadBalancerServicesProxyProtocol proto.InternalMessageInfo
 func (m *Machine) Reset()      { *m = Machine{} }
 func (*Machine) ProtoMessage() {}
 func (*Machine) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{103}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{108}
 }
 func (m *Machine) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2959,7 +3099,7 @@ var xxx_messageInfo_Machine proto.InternalMessageInfo
 func (m *MachineControllerManagerSettings) Reset()      { *m = MachineControllerManagerSettings{} }
 func (*MachineControllerManagerSettings) ProtoMessage() {}
 func (*MachineControllerManagerSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{104}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{109}
 }
 func (m *MachineControllerManagerSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2987,7 +3127,7 @@ var xxx_messageInfo_MachineControllerManagerSettings proto.InternalMessageInfo
 func (m *MachineImage) Reset()      { *m = MachineImage{} }
 func (*MachineImage) ProtoMessage() {}
 func (*MachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{105}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{110}
 }
 func (m *MachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3015,7 +3155,7 @@ var xxx_messageInfo_MachineImage proto.InternalMessageInfo
 func (m *MachineImageFlavor) Reset()      { *m = MachineImageFlavor{} }
 func (*MachineImageFlavor) ProtoMessage() {}
 func (*MachineImageFlavor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{106}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{111}
 }
 func (m *MachineImageFlavor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3043,7 +3183,7 @@ var xxx_messageInfo_MachineImageFlavor proto.InternalMessageInfo
 func (m *MachineImageVersion) Reset()      { *m = MachineImageVersion{} }
 func (*MachineImageVersion) ProtoMessage() {}
 func (*MachineImageVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{107}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{112}
 }
 func (m *MachineImageVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3071,7 +3211,7 @@ var xxx_messageInfo_MachineImageVersion proto.InternalMessageInfo
 func (m *MachineType) Reset()      { *m = MachineType{} }
 func (*MachineType) ProtoMessage() {}
 func (*MachineType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{108}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{113}
 }
 func (m *MachineType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3099,7 +3239,7 @@ var xxx_messageInfo_MachineType proto.InternalMessageInfo
 func (m *MachineTypeStorage) Reset()      { *m = MachineTypeStorage{} }
 func (*MachineTypeStorage) ProtoMessage() {}
 func (*MachineTypeStorage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{109}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{114}
 }
 func (m *MachineTypeStorage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3127,7 +3267,7 @@ var xxx_messageInfo_MachineTypeStorage proto.InternalMessageInfo
 func (m *Maintenance) Reset()      { *m = Maintenance{} }
 func (*Maintenance) ProtoMessage() {}
 func (*Maintenance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{110}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{115}
 }
 func (m *Maintenance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3155,7 +3295,7 @@ var xxx_messageInfo_Maintenance proto.InternalMessageInfo
 func (m *MaintenanceAutoRotation) Reset()      { *m = MaintenanceAutoRotation{} }
 func (*MaintenanceAutoRotation) ProtoMessage() {}
 func (*MaintenanceAutoRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{111}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{116}
 }
 func (m *MaintenanceAutoRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3183,7 +3323,7 @@ var xxx_messageInfo_MaintenanceAutoRotation proto.InternalMessageInfo
 func (m *MaintenanceAutoUpdate) Reset()      { *m = MaintenanceAutoUpdate{} }
 func (*MaintenanceAutoUpdate) ProtoMessage() {}
 func (*MaintenanceAutoUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{112}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{117}
 }
 func (m *MaintenanceAutoUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3211,7 +3351,7 @@ var xxx_messageInfo_MaintenanceAutoUpdate proto.InternalMessageInfo
 func (m *MaintenanceCredentialsAutoRotation) Reset()      { *m = MaintenanceCredentialsAutoRotation{} }
 func (*MaintenanceCredentialsAutoRotation) ProtoMessage() {}
 func (*MaintenanceCredentialsAutoRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{113}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{118}
 }
 func (m *MaintenanceCredentialsAutoRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3236,10 +3376,66 @@ func (m *MaintenanceCredentialsAutoRotation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MaintenanceCredentialsAutoRotation proto.InternalMessageInfo
 
+func (m *MaintenanceDeferral) Reset()      { *m = MaintenanceDeferral{} }
+func (*MaintenanceDeferral) ProtoMessage() {}
+func (*MaintenanceDeferral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{119}
+}
+func (m *MaintenanceDeferral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceDeferral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MaintenanceDeferral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceDeferral.Merge(m, src)
+}
+func (m *MaintenanceDeferral) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceDeferral) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceDeferral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceDeferral proto.InternalMessageInfo
+
+func (m *MaintenanceFreeze) Reset()      { *m = MaintenanceFreeze{} }
+func (*MaintenanceFreeze) ProtoMessage() {}
+func (*MaintenanceFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{120}
+}
+func (m *MaintenanceFreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MaintenanceFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceFreeze.Merge(m, src)
+}
+func (m *MaintenanceFreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceFreeze proto.InternalMessageInfo
+
 func (m *MaintenanceRotationConfig) Reset()      { *m = MaintenanceRotationConfig{} }
 func (*MaintenanceRotationConfig) ProtoMessage() {}
 func (*MaintenanceRotationConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{114}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{121}
 }
 func (m *MaintenanceRotationConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3267,7 +3463,7 @@ var xxx_messageInfo_MaintenanceRotationConfig proto.InternalMessageInfo
 func (m *MaintenanceTimeWindow) Reset()      { *m = MaintenanceTimeWindow{} }
 func (*MaintenanceTimeWindow) ProtoMessage() {}
 func (*MaintenanceTimeWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{115}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{122}
 }
 func (m *MaintenanceTimeWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3295,7 +3491,7 @@ var xxx_messageInfo_MaintenanceTimeWindow proto.InternalMessageInfo
 func (m *ManualWorkerPoolRollout) Reset()      { *m = ManualWorkerPoolRollout{} }
 func (*ManualWorkerPoolRollout) ProtoMessage() {}
 func (*ManualWorkerPoolRollout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{116}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{123}
 }
 func (m *ManualWorkerPoolRollout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3323,7 +3519,7 @@ var xxx_messageInfo_ManualWorkerPoolRollout proto.InternalMessageInfo
 func (m *MemorySwapConfiguration) Reset()      { *m = MemorySwapConfiguration{} }
 func (*MemorySwapConfiguration) ProtoMessage() {}
 func (*MemorySwapConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{117}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{124}
 }
 func (m *MemorySwapConfiguration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3351,7 +3547,7 @@ var xxx_messageInfo_MemorySwapConfiguration proto.InternalMessageInfo
 func (m *Monitoring) Reset()      { *m = Monitoring{} }
 func (*Monitoring) ProtoMessage() {}
 func (*Monitoring) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{118}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{125}
 }
 func (m *Monitoring) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3379,7 +3575,7 @@ var xxx_messageInfo_Monitoring proto.InternalMessageInfo
 func (m *NamedResourceReference) Reset()      { *m = NamedResourceReference{} }
 func (*NamedResourceReference) ProtoMessage() {}
 func (*NamedResourceReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{119}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{126}
 }
 func (m *NamedResourceReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3407,7 +3603,7 @@ var xxx_messageInfo_NamedResourceReference proto.InternalMessageInfo
 func (m *NamespacedCloudProfile) Reset()      { *m = NamespacedCloudProfile{} }
 func (*NamespacedCloudProfile) ProtoMessage() {}
 func (*NamespacedCloudProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{120}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{127}
 }
 func (m *NamespacedCloudProfile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3435,7 +3631,7 @@ var xxx_messageInfo_NamespacedCloudProfile proto.InternalMessageInfo
 func (m *NamespacedCloudProfileList) Reset()      { *m = NamespacedCloudProfileList{} }
 func (*NamespacedCloudProfileList) ProtoMessage() {}
 func (*NamespacedCloudProfileList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{121}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{128}
 }
 func (m *NamespacedCloudProfileList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3463,7 +3659,7 @@ var xxx_messageInfo_NamespacedCloudProfileList proto.InternalMessageInfo
 func (m *NamespacedCloudProfileSpec) Reset()      { *m = NamespacedCloudProfileSpec{} }
 func (*NamespacedCloudProfileSpec) ProtoMessage() {}
 func (*NamespacedCloudProfileSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{122}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{129}
 }
 func (m *NamespacedCloudProfileSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3491,7 +3687,7 @@ var xxx_messageInfo_NamespacedCloudProfileSpec proto.InternalMessageInfo
 func (m *NamespacedCloudProfileStatus) Reset()      { *m = NamespacedCloudProfileStatus{} }
 func (*NamespacedCloudProfileStatus) ProtoMessage() {}
 func (*NamespacedCloudProfileStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{123}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{130}
 }
 func (m *NamespacedCloudProfileStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3519,7 +3715,7 @@ var xxx_messageInfo_NamespacedCloudProfileStatus proto.InternalMessageInfo
 func (m *Networking) Reset()      { *m = Networking{} }
 func (*Networking) ProtoMessage() {}
 func (*Networking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{124}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{131}
 }
 func (m *Networking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3547,7 +3743,7 @@ var xxx_messageInfo_Networking proto.InternalMessageInfo
 func (m *NetworkingStatus) Reset()      { *m = NetworkingStatus{} }
 func (*NetworkingStatus) ProtoMessage() {}
 func (*NetworkingStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{125}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{132}
 }
 func (m *NetworkingStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3575,7 +3771,7 @@ var xxx_messageInfo_NetworkingStatus proto.InternalMessageInfo
 func (m *NginxIngress) Reset()      { *m = NginxIngress{} }
 func (*NginxIngress) ProtoMessage() {}
 func (*NginxIngress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{126}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{133}
 }
 func (m *NginxIngress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3603,7 +3799,7 @@ var xxx_messageInfo_NginxIngress proto.InternalMessageInfo
 func (m *NodeLocalDNS) Reset()      { *m = NodeLocalDNS{} }
 func (*NodeLocalDNS) ProtoMessage() {}
 func (*NodeLocalDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{127}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{134}
 }
 func (m *NodeLocalDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3628,10 +3824,66 @@ func (m *NodeLocalDNS) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NodeLocalDNS proto.InternalMessageInfo
 
+func (m *NotificationSubscription) Reset()      { *m = NotificationSubscription{} }
+func (*NotificationSubscription) ProtoMessage() {}
+func (*NotificationSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{135}
+}
+func (m *NotificationSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NotificationSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NotificationSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationSubscription.Merge(m, src)
+}
+func (m *NotificationSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *NotificationSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationSubscription proto.InternalMessageInfo
+
+func (m *NotificationWebhook) Reset()      { *m = NotificationWebhook{} }
+func (*NotificationWebhook) ProtoMessage() {}
+func (*NotificationWebhook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{136}
+}
+func (m *NotificationWebhook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NotificationWebhook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NotificationWebhook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationWebhook.Merge(m, src)
+}
+func (m *NotificationWebhook) XXX_Size() int {
+	return m.Size()
+}
+func (m *NotificationWebhook) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationWebhook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationWebhook proto.InternalMessageInfo
+
 func (m *OCIRepository) Reset()      { *m = OCIRepository{} }
 func (*OCIRepository) ProtoMessage() {}
 func (*OCIRepository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{128}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{137}
 }
 func (m *OCIRepository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3659,7 +3911,7 @@ var xxx_messageInfo_OCIRepository proto.InternalMessageInfo
 func (m *OIDCConfig) Reset()      { *m = OIDCConfig{} }
 func (*OIDCConfig) ProtoMessage() {}
 func (*OIDCConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{129}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{138}
 }
 func (m *OIDCConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3687,7 +3939,7 @@ var xxx_messageInfo_OIDCConfig proto.InternalMessageInfo
 func (m *ObservabilityRotation) Reset()      { *m = ObservabilityRotation{} }
 func (*ObservabilityRotation) ProtoMessage() {}
 func (*ObservabilityRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{130}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{139}
 }
 func (m *ObservabilityRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3715,7 +3967,7 @@ var xxx_messageInfo_ObservabilityRotation proto.InternalMessageInfo
 func (m *OpenIDConnectClientAuthentication) Reset()      { *m = OpenIDConnectClientAuthentication{} }
 func (*OpenIDConnectClientAuthentication) ProtoMessage() {}
 func (*OpenIDConnectClientAuthentication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{131}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{140}
 }
 func (m *OpenIDConnectClientAuthentication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3743,7 +3995,7 @@ var xxx_messageInfo_OpenIDConnectClientAuthentication proto.InternalMessageInfo
 func (m *PendingWorkerUpdates) Reset()      { *m = PendingWorkerUpdates{} }
 func (*PendingWorkerUpdates) ProtoMessage() {}
 func (*PendingWorkerUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{132}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{141}
 }
 func (m *PendingWorkerUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3771,7 +4023,7 @@ var xxx_messageInfo_PendingWorkerUpdates proto.InternalMessageInfo
 func (m *PendingWorkersRollout) Reset()      { *m = PendingWorkersRollout{} }
 func (*PendingWorkersRollout) ProtoMessage() {}
 func (*PendingWorkersRollout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{133}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{142}
 }
 func (m *PendingWorkersRollout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3799,7 +4051,7 @@ var xxx_messageInfo_PendingWorkersRollout proto.InternalMessageInfo
 func (m *Project) Reset()      { *m = Project{} }
 func (*Project) ProtoMessage() {}
 func (*Project) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{134}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{143}
 }
 func (m *Project) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3824,10 +4076,38 @@ func (m *Project) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Project proto.InternalMessageInfo
 
+func (m *ProjectLifecycle) Reset()      { *m = ProjectLifecycle{} }
+func (*ProjectLifecycle) ProtoMessage() {}
+func (*ProjectLifecycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{144}
+}
+func (m *ProjectLifecycle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectLifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectLifecycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectLifecycle.Merge(m, src)
+}
+func (m *ProjectLifecycle) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectLifecycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectLifecycle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectLifecycle proto.InternalMessageInfo
+
 func (m *ProjectList) Reset()      { *m = ProjectList{} }
 func (*ProjectList) ProtoMessage() {}
 func (*ProjectList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{135}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{145}
 }
 func (m *ProjectList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3855,7 +4135,7 @@ var xxx_messageInfo_ProjectList proto.InternalMessageInfo
 func (m *ProjectMember) Reset()      { *m = ProjectMember{} }
 func (*ProjectMember) ProtoMessage() {}
 func (*ProjectMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{136}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{146}
 }
 func (m *ProjectMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3883,7 +4163,7 @@ var xxx_messageInfo_ProjectMember proto.InternalMessageInfo
 func (m *ProjectSpec) Reset()      { *m = ProjectSpec{} }
 func (*ProjectSpec) ProtoMessage() {}
 func (*ProjectSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{137}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{147}
 }
 func (m *ProjectSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3911,7 +4191,7 @@ var xxx_messageInfo_ProjectSpec proto.InternalMessageInfo
 func (m *ProjectStatus) Reset()      { *m = ProjectStatus{} }
 func (*ProjectStatus) ProtoMessage() {}
 func (*ProjectStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{138}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{148}
 }
 func (m *ProjectStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3939,7 +4219,7 @@ var xxx_messageInfo_ProjectStatus proto.InternalMessageInfo
 func (m *ProjectTolerations) Reset()      { *m = ProjectTolerations{} }
 func (*ProjectTolerations) ProtoMessage() {}
 func (*ProjectTolerations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{139}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{149}
 }
 func (m *ProjectTolerations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3967,7 +4247,7 @@ var xxx_messageInfo_ProjectTolerations proto.InternalMessageInfo
 func (m *Provider) Reset()      { *m = Provider{} }
 func (*Provider) ProtoMessage() {}
 func (*Provider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{140}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{150}
 }
 func (m *Provider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3995,7 +4275,7 @@ var xxx_messageInfo_Provider proto.InternalMessageInfo
 func (m *Quota) Reset()      { *m = Quota{} }
 func (*Quota) ProtoMessage() {}
 func (*Quota) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{141}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{151}
 }
 func (m *Quota) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4020,10 +4300,38 @@ func (m *Quota) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Quota proto.InternalMessageInfo
 
+func (m *QuotaAccounting) Reset()      { *m = QuotaAccounting{} }
+func (*QuotaAccounting) ProtoMessage() {}
+func (*QuotaAccounting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{152}
+}
+func (m *QuotaAccounting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaAccounting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QuotaAccounting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaAccounting.Merge(m, src)
+}
+func (m *QuotaAccounting) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaAccounting) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaAccounting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaAccounting proto.InternalMessageInfo
+
 func (m *QuotaList) Reset()      { *m = QuotaList{} }
 func (*QuotaList) ProtoMessage() {}
 func (*QuotaList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{142}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{153}
 }
 func (m *QuotaList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4048,10 +4356,38 @@ func (m *QuotaList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QuotaList proto.InternalMessageInfo
 
+func (m *QuotaShootUsage) Reset()      { *m = QuotaShootUsage{} }
+func (*QuotaShootUsage) ProtoMessage() {}
+func (*QuotaShootUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{154}
+}
+func (m *QuotaShootUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaShootUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QuotaShootUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaShootUsage.Merge(m, src)
+}
+func (m *QuotaShootUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaShootUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaShootUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaShootUsage proto.InternalMessageInfo
+
 func (m *QuotaSpec) Reset()      { *m = QuotaSpec{} }
 func (*QuotaSpec) ProtoMessage() {}
 func (*QuotaSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{143}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{155}
 }
 func (m *QuotaSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4076,10 +4412,38 @@ func (m *QuotaSpec) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QuotaSpec proto.InternalMessageInfo
 
+func (m *QuotaStatus) Reset()      { *m = QuotaStatus{} }
+func (*QuotaStatus) ProtoMessage() {}
+func (*QuotaStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{156}
+}
+func (m *QuotaStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QuotaStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaStatus.Merge(m, src)
+}
+func (m *QuotaStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaStatus proto.InternalMessageInfo
+
 func (m *Region) Reset()      { *m = Region{} }
 func (*Region) ProtoMessage() {}
 func (*Region) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{144}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{157}
 }
 func (m *Region) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4107,7 +4471,7 @@ var xxx_messageInfo_Region proto.InternalMessageInfo
 func (m *ResourceData) Reset()      { *m = ResourceData{} }
 func (*ResourceData) ProtoMessage() {}
 func (*ResourceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{145}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{158}
 }
 func (m *ResourceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4135,7 +4499,7 @@ var xxx_messageInfo_ResourceData proto.InternalMessageInfo
 func (m *ResourceWatchCacheSize) Reset()      { *m = ResourceWatchCacheSize{} }
 func (*ResourceWatchCacheSize) ProtoMessage() {}
 func (*ResourceWatchCacheSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{146}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{159}
 }
 func (m *ResourceWatchCacheSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4160,10 +4524,38 @@ func (m *ResourceWatchCacheSize) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ResourceWatchCacheSize proto.InternalMessageInfo
 
+func (m *RolloutWave) Reset()      { *m = RolloutWave{} }
+func (*RolloutWave) ProtoMessage() {}
+func (*RolloutWave) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{160}
+}
+func (m *RolloutWave) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutWave) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutWave) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutWave.Merge(m, src)
+}
+func (m *RolloutWave) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutWave) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutWave.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutWave proto.InternalMessageInfo
+
 func (m *SSHAccess) Reset()      { *m = SSHAccess{} }
 func (*SSHAccess) ProtoMessage() {}
 func (*SSHAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{147}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{161}
 }
 func (m *SSHAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4191,7 +4583,7 @@ var xxx_messageInfo_SSHAccess proto.InternalMessageInfo
 func (m *SecretBinding) Reset()      { *m = SecretBinding{} }
 func (*SecretBinding) ProtoMessage() {}
 func (*SecretBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{148}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{162}
 }
 func (m *SecretBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4219,7 +4611,7 @@ var xxx_messageInfo_SecretBinding proto.InternalMessageInfo
 func (m *SecretBindingList) Reset()      { *m = SecretBindingList{} }
 func (*SecretBindingList) ProtoMessage() {}
 func (*SecretBindingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{149}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{163}
 }
 func (m *SecretBindingList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4247,7 +4639,7 @@ var xxx_messageInfo_SecretBindingList proto.InternalMessageInfo
 func (m *SecretBindingProvider) Reset()      { *m = SecretBindingProvider{} }
 func (*SecretBindingProvider) ProtoMessage() {}
 func (*SecretBindingProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{150}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{164}
 }
 func (m *SecretBindingProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4275,7 +4667,7 @@ var xxx_messageInfo_SecretBindingProvider proto.InternalMessageInfo
 func (m *Seed) Reset()      { *m = Seed{} }
 func (*Seed) ProtoMessage() {}
 func (*Seed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{151}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{165}
 }
 func (m *Seed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4303,7 +4695,7 @@ var xxx_messageInfo_Seed proto.InternalMessageInfo
 func (m *SeedDNS) Reset()      { *m = SeedDNS{} }
 func (*SeedDNS) ProtoMessage() {}
 func (*SeedDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{152}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{166}
 }
 func (m *SeedDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4331,7 +4723,7 @@ var xxx_messageInfo_SeedDNS proto.InternalMessageInfo
 func (m *SeedDNSProvider) Reset()      { *m = SeedDNSProvider{} }
 func (*SeedDNSProvider) ProtoMessage() {}
 func (*SeedDNSProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{153}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{167}
 }
 func (m *SeedDNSProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4359,7 +4751,7 @@ var xxx_messageInfo_SeedDNSProvider proto.InternalMessageInfo
 func (m *SeedDNSProviderConfig) Reset()      { *m = SeedDNSProviderConfig{} }
 func (*SeedDNSProviderConfig) ProtoMessage() {}
 func (*SeedDNSProviderConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{154}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{168}
 }
 func (m *SeedDNSProviderConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4387,7 +4779,7 @@ var xxx_messageInfo_SeedDNSProviderConfig proto.InternalMessageInfo
 func (m *SeedList) Reset()      { *m = SeedList{} }
 func (*SeedList) ProtoMessage() {}
 func (*SeedList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{155}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{169}
 }
 func (m *SeedList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4415,7 +4807,7 @@ var xxx_messageInfo_SeedList proto.InternalMessageInfo
 func (m *SeedNetworks) Reset()      { *m = SeedNetworks{} }
 func (*SeedNetworks) ProtoMessage() {}
 func (*SeedNetworks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{156}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{170}
 }
 func (m *SeedNetworks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4443,7 +4835,7 @@ var xxx_messageInfo_SeedNetworks proto.InternalMessageInfo
 func (m *SeedProvider) Reset()      { *m = SeedProvider{} }
 func (*SeedProvider) ProtoMessage() {}
 func (*SeedProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{157}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{171}
 }
 func (m *SeedProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4468,10 +4860,38 @@ func (m *SeedProvider) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SeedProvider proto.InternalMessageInfo
 
+func (m *SeedResourceUsage) Reset()      { *m = SeedResourceUsage{} }
+func (*SeedResourceUsage) ProtoMessage() {}
+func (*SeedResourceUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{172}
+}
+func (m *SeedResourceUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SeedResourceUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SeedResourceUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SeedResourceUsage.Merge(m, src)
+}
+func (m *SeedResourceUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *SeedResourceUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SeedResourceUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SeedResourceUsage proto.InternalMessageInfo
+
 func (m *SeedSelector) Reset()      { *m = SeedSelector{} }
 func (*SeedSelector) ProtoMessage() {}
 func (*SeedSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{158}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{173}
 }
 func (m *SeedSelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4499,7 +4919,7 @@ var xxx_messageInfo_SeedSelector proto.InternalMessageInfo
 func (m *SeedSettingDependencyWatchdog) Reset()      { *m = SeedSettingDependencyWatchdog{} }
 func (*SeedSettingDependencyWatchdog) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{159}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{174}
 }
 func (m *SeedSettingDependencyWatchdog) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4527,7 +4947,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdog proto.InternalMessageInfo
 func (m *SeedSettingDependencyWatchdogProber) Reset()      { *m = SeedSettingDependencyWatchdogProber{} }
 func (*SeedSettingDependencyWatchdogProber) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdogProber) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{160}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{175}
 }
 func (m *SeedSettingDependencyWatchdogProber) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4555,7 +4975,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdogProber proto.InternalMessageInf
 func (m *SeedSettingDependencyWatchdogWeeder) Reset()      { *m = SeedSettingDependencyWatchdogWeeder{} }
 func (*SeedSettingDependencyWatchdogWeeder) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdogWeeder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{161}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{176}
 }
 func (m *SeedSettingDependencyWatchdogWeeder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4583,7 +5003,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdogWeeder proto.InternalMessageInf
 func (m *SeedSettingExcessCapacityReservation) Reset()      { *m = SeedSettingExcessCapacityReservation{} }
 func (*SeedSettingExcessCapacityReservation) ProtoMessage() {}
 func (*SeedSettingExcessCapacityReservation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{162}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{177}
 }
 func (m *SeedSettingExcessCapacityReservation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4613,7 +5033,7 @@ func (m *SeedSettingExcessCapacityReservationConfig) Reset() {
 }
 func (*SeedSettingExcessCapacityReservationConfig) ProtoMessage() {}
 func (*SeedSettingExcessCapacityReservationConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{163}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{178}
 }
 func (m *SeedSettingExcessCapacityReservationConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4641,7 +5061,7 @@ var xxx_messageInfo_SeedSettingExcessCapacityReservationConfig proto.InternalMes
 func (m *SeedSettingLoadBalancerServices) Reset()      { *m = SeedSettingLoadBalancerServices{} }
 func (*SeedSettingLoadBalancerServices) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{164}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{179}
 }
 func (m *SeedSettingLoadBalancerServices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4671,7 +5091,7 @@ func (m *SeedSettingLoadBalancerServicesZonalIngress) Reset() {
 }
 func (*SeedSettingLoadBalancerServicesZonalIngress) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServicesZonalIngress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{165}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{180}
 }
 func (m *SeedSettingLoadBalancerServicesZonalIngress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4699,7 +5119,7 @@ var xxx_messageInfo_SeedSettingLoadBalancerServicesZonalIngress proto.InternalMe
 func (m *SeedSettingLoadBalancerServicesZones) Reset()      { *m = SeedSettingLoadBalancerServicesZones{} }
 func (*SeedSettingLoadBalancerServicesZones) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServicesZones) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{166}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{181}
 }
 func (m *SeedSettingLoadBalancerServicesZones) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4727,7 +5147,7 @@ var xxx_messageInfo_SeedSettingLoadBalancerServicesZones proto.InternalMessageIn
 func (m *SeedSettingScheduling) Reset()      { *m = SeedSettingScheduling{} }
 func (*SeedSettingScheduling) ProtoMessage() {}
 func (*SeedSettingScheduling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{167}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{182}
 }
 func (m *SeedSettingScheduling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4755,7 +5175,7 @@ var xxx_messageInfo_SeedSettingScheduling proto.InternalMessageInfo
 func (m *SeedSettingTopologyAwareRouting) Reset()      { *m = SeedSettingTopologyAwareRouting{} }
 func (*SeedSettingTopologyAwareRouting) ProtoMessage() {}
 func (*SeedSettingTopologyAwareRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{168}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{183}
 }
 func (m *SeedSettingTopologyAwareRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4783,7 +5203,7 @@ var xxx_messageInfo_SeedSettingTopologyAwareRouting proto.InternalMessageInfo
 func (m *SeedSettingVerticalPodAutoscaler) Reset()      { *m = SeedSettingVerticalPodAutoscaler{} }
 func (*SeedSettingVerticalPodAutoscaler) ProtoMessage() {}
 func (*SeedSettingVerticalPodAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{169}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{184}
 }
 func (m *SeedSettingVerticalPodAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4811,7 +5231,7 @@ var xxx_messageInfo_SeedSettingVerticalPodAutoscaler proto.InternalMessageInfo
 func (m *SeedSettings) Reset()      { *m = SeedSettings{} }
 func (*SeedSettings) ProtoMessage() {}
 func (*SeedSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{170}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{185}
 }
 func (m *SeedSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4839,7 +5259,7 @@ var xxx_messageInfo_SeedSettings proto.InternalMessageInfo
 func (m *SeedSpec) Reset()      { *m = SeedSpec{} }
 func (*SeedSpec) ProtoMessage() {}
 func (*SeedSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{171}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{186}
 }
 func (m *SeedSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4867,7 +5287,7 @@ var xxx_messageInfo_SeedSpec proto.InternalMessageInfo
 func (m *SeedStatus) Reset()      { *m = SeedStatus{} }
 func (*SeedStatus) ProtoMessage() {}
 func (*SeedStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{172}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{187}
 }
 func (m *SeedStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4895,7 +5315,7 @@ var xxx_messageInfo_SeedStatus proto.InternalMessageInfo
 func (m *SeedTaint) Reset()      { *m = SeedTaint{} }
 func (*SeedTaint) ProtoMessage() {}
 func (*SeedTaint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{173}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{188}
 }
 func (m *SeedTaint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4923,7 +5343,7 @@ var xxx_messageInfo_SeedTaint proto.InternalMessageInfo
 func (m *SeedTemplate) Reset()      { *m = SeedTemplate{} }
 func (*SeedTemplate) ProtoMessage() {}
 func (*SeedTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{174}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{189}
 }
 func (m *SeedTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4951,7 +5371,7 @@ var xxx_messageInfo_SeedTemplate proto.InternalMessageInfo
 func (m *SeedVolume) Reset()      { *m = SeedVolume{} }
 func (*SeedVolume) ProtoMessage() {}
 func (*SeedVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{175}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{190}
 }
 func (m *SeedVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4979,7 +5399,7 @@ var xxx_messageInfo_SeedVolume proto.InternalMessageInfo
 func (m *SeedVolumeProvider) Reset()      { *m = SeedVolumeProvider{} }
 func (*SeedVolumeProvider) ProtoMessage() {}
 func (*SeedVolumeProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{176}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{191}
 }
 func (m *SeedVolumeProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5007,7 +5427,7 @@ var xxx_messageInfo_SeedVolumeProvider proto.InternalMessageInfo
 func (m *ServiceAccountConfig) Reset()      { *m = ServiceAccountConfig{} }
 func (*ServiceAccountConfig) ProtoMessage() {}
 func (*ServiceAccountConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{177}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{192}
 }
 func (m *ServiceAccountConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5035,7 +5455,7 @@ var xxx_messageInfo_ServiceAccountConfig proto.InternalMessageInfo
 func (m *ServiceAccountKeyRotation) Reset()      { *m = ServiceAccountKeyRotation{} }
 func (*ServiceAccountKeyRotation) ProtoMessage() {}
 func (*ServiceAccountKeyRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{178}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{193}
 }
 func (m *ServiceAccountKeyRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5063,7 +5483,7 @@ var xxx_messageInfo_ServiceAccountKeyRotation proto.InternalMessageInfo
 func (m *Shoot) Reset()      { *m = Shoot{} }
 func (*Shoot) ProtoMessage() {}
 func (*Shoot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{179}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{194}
 }
 func (m *Shoot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5091,7 +5511,7 @@ var xxx_messageInfo_Shoot proto.InternalMessageInfo
 func (m *ShootAdvertisedAddress) Reset()      { *m = ShootAdvertisedAddress{} }
 func (*ShootAdvertisedAddress) ProtoMessage() {}
 func (*ShootAdvertisedAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{180}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{195}
 }
 func (m *ShootAdvertisedAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5119,7 +5539,7 @@ var xxx_messageInfo_ShootAdvertisedAddress proto.InternalMessageInfo
 func (m *ShootCredentials) Reset()      { *m = ShootCredentials{} }
 func (*ShootCredentials) ProtoMessage() {}
 func (*ShootCredentials) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{181}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{196}
 }
 func (m *ShootCredentials) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5147,7 +5567,7 @@ var xxx_messageInfo_ShootCredentials proto.InternalMessageInfo
 func (m *ShootCredentialsRotation) Reset()      { *m = ShootCredentialsRotation{} }
 func (*ShootCredentialsRotation) ProtoMessage() {}
 func (*ShootCredentialsRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{182}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{197}
 }
 func (m *ShootCredentialsRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5175,7 +5595,7 @@ var xxx_messageInfo_ShootCredentialsRotation proto.InternalMessageInfo
 func (m *ShootKubeconfigRotation) Reset()      { *m = ShootKubeconfigRotation{} }
 func (*ShootKubeconfigRotation) ProtoMessage() {}
 func (*ShootKubeconfigRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{183}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{198}
 }
 func (m *ShootKubeconfigRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5203,7 +5623,7 @@ var xxx_messageInfo_ShootKubeconfigRotation proto.InternalMessageInfo
 func (m *ShootList) Reset()      { *m = ShootList{} }
 func (*ShootList) ProtoMessage() {}
 func (*ShootList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{184}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{199}
 }
 func (m *ShootList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5231,7 +5651,7 @@ var xxx_messageInfo_ShootList proto.InternalMessageInfo
 func (m *ShootMachineImage) Reset()      { *m = ShootMachineImage{} }
 func (*ShootMachineImage) ProtoMessage() {}
 func (*ShootMachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{185}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{200}
 }
 func (m *ShootMachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5259,7 +5679,7 @@ var xxx_messageInfo_ShootMachineImage proto.InternalMessageInfo
 func (m *ShootNetworks) Reset()      { *m = ShootNetworks{} }
 func (*ShootNetworks) ProtoMessage() {}
 func (*ShootNetworks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{186}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{201}
 }
 func (m *ShootNetworks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5287,7 +5707,7 @@ var xxx_messageInfo_ShootNetworks proto.InternalMessageInfo
 func (m *ShootSSHKeypairRotation) Reset()      { *m = ShootSSHKeypairRotation{} }
 func (*ShootSSHKeypairRotation) ProtoMessage() {}
 func (*ShootSSHKeypairRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{187}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{202}
 }
 func (m *ShootSSHKeypairRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5315,7 +5735,7 @@ var xxx_messageInfo_ShootSSHKeypairRotation proto.InternalMessageInfo
 func (m *ShootSpec) Reset()      { *m = ShootSpec{} }
 func (*ShootSpec) ProtoMessage() {}
 func (*ShootSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{188}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{203}
 }
 func (m *ShootSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5343,7 +5763,7 @@ var xxx_messageInfo_ShootSpec proto.InternalMessageInfo
 func (m *ShootState) Reset()      { *m = ShootState{} }
 func (*ShootState) ProtoMessage() {}
 func (*ShootState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{189}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{204}
 }
 func (m *ShootState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5371,7 +5791,7 @@ var xxx_messageInfo_ShootState proto.InternalMessageInfo
 func (m *ShootStateList) Reset()      { *m = ShootStateList{} }
 func (*ShootStateList) ProtoMessage() {}
 func (*ShootStateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{190}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{205}
 }
 func (m *ShootStateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5399,7 +5819,7 @@ var xxx_messageInfo_ShootStateList proto.InternalMessageInfo
 func (m *ShootStateSpec) Reset()      { *m = ShootStateSpec{} }
 func (*ShootStateSpec) ProtoMessage() {}
 func (*ShootStateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{191}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{206}
 }
 func (m *ShootStateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5427,7 +5847,7 @@ var xxx_messageInfo_ShootStateSpec proto.InternalMessageInfo
 func (m *ShootStatus) Reset()      { *m = ShootStatus{} }
 func (*ShootStatus) ProtoMessage() {}
 func (*ShootStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{192}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{207}
 }
 func (m *ShootStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5455,7 +5875,7 @@ var xxx_messageInfo_ShootStatus proto.InternalMessageInfo
 func (m *ShootTemplate) Reset()      { *m = ShootTemplate{} }
 func (*ShootTemplate) ProtoMessage() {}
 func (*ShootTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{193}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{208}
 }
 func (m *ShootTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5483,7 +5903,7 @@ var xxx_messageInfo_ShootTemplate proto.InternalMessageInfo
 func (m *StructuredAuthentication) Reset()      { *m = StructuredAuthentication{} }
 func (*StructuredAuthentication) ProtoMessage() {}
 func (*StructuredAuthentication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{194}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{209}
 }
 func (m *StructuredAuthentication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5511,7 +5931,7 @@ var xxx_messageInfo_StructuredAuthentication proto.InternalMessageInfo
 func (m *StructuredAuthorization) Reset()      { *m = StructuredAuthorization{} }
 func (*StructuredAuthorization) ProtoMessage() {}
 func (*StructuredAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{195}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{210}
 }
 func (m *StructuredAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5539,7 +5959,7 @@ var xxx_messageInfo_StructuredAuthorization proto.InternalMessageInfo
 func (m *SystemComponents) Reset()      { *m = SystemComponents{} }
 func (*SystemComponents) ProtoMessage() {}
 func (*SystemComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{196}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{211}
 }
 func (m *SystemComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5567,7 +5987,7 @@ var xxx_messageInfo_SystemComponents proto.InternalMessageInfo
 func (m *Toleration) Reset()      { *m = Toleration{} }
 func (*Toleration) ProtoMessage() {}
 func (*Toleration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{197}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{212}
 }
 func (m *Toleration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5592,10 +6012,66 @@ func (m *Toleration) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Toleration proto.InternalMessageInfo
 
+func (m *UpcomingMaintenance) Reset()      { *m = UpcomingMaintenance{} }
+func (*UpcomingMaintenance) ProtoMessage() {}
+func (*UpcomingMaintenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{213}
+}
+func (m *UpcomingMaintenance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpcomingMaintenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *UpcomingMaintenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpcomingMaintenance.Merge(m, src)
+}
+func (m *UpcomingMaintenance) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpcomingMaintenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpcomingMaintenance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpcomingMaintenance proto.InternalMessageInfo
+
+func (m *VersionRollout) Reset()      { *m = VersionRollout{} }
+func (*VersionRollout) ProtoMessage() {}
+func (*VersionRollout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{214}
+}
+func (m *VersionRollout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VersionRollout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *VersionRollout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VersionRollout.Merge(m, src)
+}
+func (m *VersionRollout) XXX_Size() int {
+	return m.Size()
+}
+func (m *VersionRollout) XXX_DiscardUnknown() {
+	xxx_messageInfo_VersionRollout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VersionRollout proto.InternalMessageInfo
+
 func (m *VerticalPodAutoscaler) Reset()      { *m = VerticalPodAutoscaler{} }
 func (*VerticalPodAutoscaler) ProtoMessage() {}
 func (*VerticalPodAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{198}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{215}
 }
 func (m *VerticalPodAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5623,7 +6099,7 @@ var xxx_messageInfo_VerticalPodAutoscaler proto.InternalMessageInfo
 func (m *Volume) Reset()      { *m = Volume{} }
 func (*Volume) ProtoMessage() {}
 func (*Volume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{199}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{216}
 }
 func (m *Volume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5651,7 +6127,7 @@ var xxx_messageInfo_Volume proto.InternalMessageInfo
 func (m *VolumeType) Reset()      { *m = VolumeType{} }
 func (*VolumeType) ProtoMessage() {}
 func (*VolumeType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{200}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{217}
 }
 func (m *VolumeType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5679,7 +6155,7 @@ var xxx_messageInfo_VolumeType proto.InternalMessageInfo
 func (m *WatchCacheSizes) Reset()      { *m = WatchCacheSizes{} }
 func (*WatchCacheSizes) ProtoMessage() {}
 func (*WatchCacheSizes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{201}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{218}
 }
 func (m *WatchCacheSizes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5707,7 +6183,7 @@ var xxx_messageInfo_WatchCacheSizes proto.InternalMessageInfo
 func (m *Worker) Reset()      { *m = Worker{} }
 func (*Worker) ProtoMessage() {}
 func (*Worker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{202}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{219}
 }
 func (m *Worker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5735,7 +6211,7 @@ var xxx_messageInfo_Worker proto.InternalMessageInfo
 func (m *WorkerControlPlane) Reset()      { *m = WorkerControlPlane{} }
 func (*WorkerControlPlane) ProtoMessage() {}
 func (*WorkerControlPlane) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{203}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{220}
 }
 func (m *WorkerControlPlane) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5763,7 +6239,7 @@ var xxx_messageInfo_WorkerControlPlane proto.InternalMessageInfo
 func (m *WorkerKubernetes) Reset()      { *m = WorkerKubernetes{} }
 func (*WorkerKubernetes) ProtoMessage() {}
 func (*WorkerKubernetes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{204}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{221}
 }
 func (m *WorkerKubernetes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5791,7 +6267,7 @@ var xxx_messageInfo_WorkerKubernetes proto.InternalMessageInfo
 func (m *WorkerSystemComponents) Reset()      { *m = WorkerSystemComponents{} }
 func (*WorkerSystemComponents) ProtoMessage() {}
 func (*WorkerSystemComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{205}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{222}
 }
 func (m *WorkerSystemComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5819,7 +6295,7 @@ var xxx_messageInfo_WorkerSystemComponents proto.InternalMessageInfo
 func (m *WorkersSettings) Reset()      { *m = WorkersSettings{} }
 func (*WorkersSettings) ProtoMessage() {}
 func (*WorkersSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{206}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{223}
 }
 func (m *WorkersSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5857,6 +6333,7 @@ func init() {
 	proto.RegisterType((*AuditConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AuditConfig")
 	proto.RegisterType((*AuditPolicy)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AuditPolicy")
 	proto.RegisterType((*AuthorizerKubeconfigReference)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AuthorizerKubeconfigReference")
+	proto.RegisterType((*AutoHibernation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AutoHibernation")
 	proto.RegisterType((*AvailabilityZone)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.AvailabilityZone")
 	proto.RegisterType((*Backup)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Backup")
 	proto.RegisterType((*BackupBucket)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.BackupBucket")
@@ -5875,6 +6352,7 @@ func init() {
 	proto.RegisterType((*CRI)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CRI")
 	proto.RegisterType((*CapabilityDefinition)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CapabilityDefinition")
 	proto.RegisterType((*CapabilityValues)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CapabilityValues")
+	proto.RegisterType((*CertificateExpiration)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CertificateExpiration")
 	proto.RegisterType((*CloudProfile)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CloudProfile")
 	proto.RegisterType((*CloudProfileList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CloudProfileList")
 	proto.RegisterType((*CloudProfileReference)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.CloudProfileReference")
@@ -5886,6 +6364,8 @@ func init() {
 	proto.RegisterType((*ControlPlane)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControlPlane")
 	proto.RegisterType((*ControlPlaneAutoscaling)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControlPlaneAutoscaling")
 	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControlPlaneAutoscaling.MinAllowedEntry")
+	proto.RegisterType((*ControlPlaneQuotaAccounting)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControlPlaneQuotaAccounting")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControlPlaneQuotaAccounting.ResourcesEntry")
 	proto.RegisterType((*ControllerDeployment)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControllerDeployment")
 	proto.RegisterType((*ControllerDeploymentList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControllerDeploymentList")
 	proto.RegisterType((*ControllerInstallation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ControllerInstallation")
@@ -5924,6 +6404,8 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.GardenerResourceData.LabelsEntry")
 	proto.RegisterType((*HelmControllerDeployment)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.HelmControllerDeployment")
 	proto.RegisterType((*Hibernation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Hibernation")
+	proto.RegisterType((*HibernationCalendar)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.HibernationCalendar")
+	proto.RegisterType((*HibernationCalendarDays)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.HibernationCalendarDays")
 	proto.RegisterType((*HibernationSchedule)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.HibernationSchedule")
 	proto.RegisterType((*HighAvailability)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.HighAvailability")
 	proto.RegisterType((*HorizontalPodAutoscalerConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.HorizontalPodAutoscalerConfig")
@@ -5968,6 +6450,8 @@ func init() {
 	proto.RegisterType((*MaintenanceAutoRotation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceAutoRotation")
 	proto.RegisterType((*MaintenanceAutoUpdate)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceAutoUpdate")
 	proto.RegisterType((*MaintenanceCredentialsAutoRotation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceCredentialsAutoRotation")
+	proto.RegisterType((*MaintenanceDeferral)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceDeferral")
+	proto.RegisterType((*MaintenanceFreeze)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceFreeze")
 	proto.RegisterType((*MaintenanceRotationConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceRotationConfig")
 	proto.RegisterType((*MaintenanceTimeWindow)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceTimeWindow")
 	proto.RegisterType((*ManualWorkerPoolRollout)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ManualWorkerPoolRollout")
@@ -5983,6 +6467,8 @@ func init() {
 	proto.RegisterType((*NginxIngress)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.NginxIngress")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.NginxIngress.ConfigEntry")
 	proto.RegisterType((*NodeLocalDNS)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.NodeLocalDNS")
+	proto.RegisterType((*NotificationSubscription)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.NotificationSubscription")
+	proto.RegisterType((*NotificationWebhook)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.NotificationWebhook")
 	proto.RegisterType((*OCIRepository)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.OCIRepository")
 	proto.RegisterType((*OIDCConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.OIDCConfig")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.OIDCConfig.RequiredClaimsEntry")
@@ -5992,6 +6478,7 @@ func init() {
 	proto.RegisterType((*PendingWorkerUpdates)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.PendingWorkerUpdates")
 	proto.RegisterType((*PendingWorkersRollout)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.PendingWorkersRollout")
 	proto.RegisterType((*Project)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Project")
+	proto.RegisterType((*ProjectLifecycle)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectLifecycle")
 	proto.RegisterType((*ProjectList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectList")
 	proto.RegisterType((*ProjectMember)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectMember")
 	proto.RegisterType((*ProjectSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectSpec")
@@ -5999,13 +6486,21 @@ func init() {
 	proto.RegisterType((*ProjectTolerations)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ProjectTolerations")
 	proto.RegisterType((*Provider)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Provider")
 	proto.RegisterType((*Quota)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Quota")
+	proto.RegisterType((*QuotaAccounting)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaAccounting")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaAccounting.LoadBalancerEntry")
 	proto.RegisterType((*QuotaList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaList")
+	proto.RegisterType((*QuotaShootUsage)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaShootUsage")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaShootUsage.UsedEntry")
 	proto.RegisterType((*QuotaSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaSpec")
 	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaSpec.MetricsEntry")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaSpec.SoftMetricsEntry")
+	proto.RegisterType((*QuotaStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaStatus")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaStatus.UsedEntry")
 	proto.RegisterType((*Region)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Region")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Region.LabelsEntry")
 	proto.RegisterType((*ResourceData)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ResourceData")
 	proto.RegisterType((*ResourceWatchCacheSize)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ResourceWatchCacheSize")
+	proto.RegisterType((*RolloutWave)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.RolloutWave")
 	proto.RegisterType((*SSHAccess)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SSHAccess")
 	proto.RegisterType((*SecretBinding)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SecretBinding")
 	proto.RegisterType((*SecretBindingList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SecretBindingList")
@@ -6017,6 +6512,9 @@ func init() {
 	proto.RegisterType((*SeedList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedList")
 	proto.RegisterType((*SeedNetworks)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedNetworks")
 	proto.RegisterType((*SeedProvider)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedProvider")
+	proto.RegisterType((*SeedResourceUsage)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedResourceUsage")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedResourceUsage.ControlPlaneRequestsEntry")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedResourceUsage.NodeAllocatableEntry")
 	proto.RegisterType((*SeedSelector)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedSelector")
 	proto.RegisterType((*SeedSettingDependencyWatchdog)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingDependencyWatchdog")
 	proto.RegisterType((*SeedSettingDependencyWatchdogProber)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SeedSettingDependencyWatchdogProber")
@@ -6065,6 +6563,8 @@ func init() {
 	proto.RegisterType((*StructuredAuthorization)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.StructuredAuthorization")
 	proto.RegisterType((*SystemComponents)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.SystemComponents")
 	proto.RegisterType((*Toleration)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Toleration")
+	proto.RegisterType((*UpcomingMaintenance)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.UpcomingMaintenance")
+	proto.RegisterType((*VersionRollout)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.VersionRollout")
 	proto.RegisterType((*VerticalPodAutoscaler)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.VerticalPodAutoscaler")
 	proto.RegisterMapType((map[string]bool)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.VerticalPodAutoscaler.FeatureGatesEntry")
 	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.VerticalPodAutoscaler.MaxAllowedEntry")
@@ -6086,955 +6586,1045 @@ func init() {
 }
 
 var fileDescriptor_ca37af0df9a5bbd2 = []byte{
-	// 15154 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x70, 0x24, 0xd9,
-	0x55, 0x20, 0xec, 0x2c, 0xbd, 0x8f, 0x1e, 0x2d, 0xdd, 0x7e, 0x69, 0xd4, 0x8f, 0x6a, 0xe7, 0xd8,
-	0xa6, 0x87, 0xb1, 0xd5, 0x78, 0xfc, 0x18, 0x7b, 0xcc, 0x78, 0x2c, 0x95, 0xa4, 0x6e, 0xb9, 0x25,
-	0xb5, 0x7c, 0x4a, 0x3d, 0x33, 0x1e, 0xc3, 0x98, 0xec, 0xaa, 0xab, 0x52, 0x4e, 0x67, 0x65, 0xd6,
-	0x64, 0x66, 0xa9, 0xa5, 0x19, 0x1b, 0x63, 0x83, 0x8d, 0x6d, 0x0c, 0x1f, 0x98, 0x87, 0x3f, 0xdb,
-	0x10, 0xd8, 0x26, 0x30, 0xdf, 0xf7, 0xf1, 0xad, 0xd9, 0x80, 0x60, 0x23, 0x80, 0x80, 0x00, 0x22,
-	0x58, 0x0c, 0x81, 0x59, 0x02, 0xc3, 0x2e, 0x04, 0x20, 0xb0, 0xd6, 0x0b, 0x1b, 0xfb, 0x8e, 0x25,
-	0x62, 0x89, 0xe8, 0x25, 0xd8, 0x8d, 0xfb, 0xc8, 0xcc, 0x9b, 0xaf, 0x52, 0x29, 0x4b, 0x92, 0x67,
-	0x16, 0x7e, 0x49, 0x75, 0xcf, 0xbd, 0xe7, 0xdc, 0x57, 0x9e, 0x7b, 0xce, 0xb9, 0xe7, 0x9e, 0x03,
-	0xf3, 0x0d, 0xd3, 0xdf, 0x6a, 0xdf, 0x99, 0xad, 0x39, 0xcd, 0x6b, 0x0d, 0xc3, 0xad, 0x53, 0x9b,
-	0xba, 0xd1, 0x3f, 0xad, 0xbb, 0x8d, 0x6b, 0x46, 0xcb, 0xf4, 0xae, 0xd5, 0x1c, 0x97, 0x5e, 0xdb,
-	0x7e, 0xfd, 0x1d, 0xea, 0x1b, 0xaf, 0xbf, 0xd6, 0x60, 0x30, 0xc3, 0xa7, 0xf5, 0xd9, 0x96, 0xeb,
-	0xf8, 0x0e, 0x79, 0x24, 0xc2, 0x31, 0x1b, 0x34, 0x8d, 0xfe, 0x69, 0xdd, 0x6d, 0xcc, 0x32, 0x1c,
-	0xb3, 0x0c, 0xc7, 0xac, 0xc4, 0x31, 0xf3, 0x3a, 0x95, 0xae, 0xd3, 0x70, 0xae, 0x71, 0x54, 0x77,
-	0xda, 0x9b, 0xfc, 0x17, 0xff, 0xc1, 0xff, 0x13, 0x24, 0x66, 0x1e, 0xba, 0xfb, 0x16, 0x6f, 0xd6,
-	0x74, 0x58, 0x67, 0xae, 0x19, 0x6d, 0xdf, 0xf1, 0x6a, 0x86, 0x65, 0xda, 0x8d, 0x6b, 0xdb, 0xa9,
-	0xde, 0xcc, 0xe8, 0x4a, 0x55, 0xd9, 0xed, 0x8e, 0x75, 0xdc, 0x3b, 0x46, 0x2d, 0xab, 0xce, 0x8d,
-	0xa8, 0x0e, 0xdd, 0xf1, 0xa9, 0xed, 0x99, 0x8e, 0xed, 0xbd, 0x8e, 0x8d, 0x84, 0xba, 0xdb, 0xea,
-	0xdc, 0xc4, 0x2a, 0x64, 0x61, 0x7a, 0x63, 0x84, 0xa9, 0x69, 0xd4, 0xb6, 0x4c, 0x9b, 0xba, 0xbb,
-	0x41, 0xf3, 0x6b, 0x2e, 0xf5, 0x9c, 0xb6, 0x5b, 0xa3, 0x87, 0x6a, 0xe5, 0x5d, 0x6b, 0x52, 0xdf,
-	0xc8, 0xa2, 0x75, 0x2d, 0xaf, 0x95, 0xdb, 0xb6, 0x7d, 0xb3, 0x99, 0x26, 0xf3, 0xe6, 0x83, 0x1a,
-	0x78, 0xb5, 0x2d, 0xda, 0x34, 0x52, 0xed, 0xde, 0x90, 0xd7, 0xae, 0xed, 0x9b, 0xd6, 0x35, 0xd3,
-	0xf6, 0x3d, 0xdf, 0x4d, 0x36, 0xd2, 0x3f, 0xae, 0xc1, 0xe4, 0xdc, 0xfa, 0x72, 0x95, 0xcf, 0xe0,
-	0x8a, 0xd3, 0x68, 0x98, 0x76, 0x83, 0x3c, 0x0c, 0x23, 0xdb, 0xd4, 0xbd, 0xe3, 0x78, 0xa6, 0xbf,
-	0x3b, 0xad, 0x5d, 0xd1, 0xae, 0x0e, 0xcc, 0x8f, 0xef, 0xef, 0x95, 0x47, 0x9e, 0x0c, 0x0a, 0x31,
-	0x82, 0x93, 0x65, 0x38, 0xbd, 0xe5, 0xfb, 0xad, 0xb9, 0x5a, 0x8d, 0x7a, 0x5e, 0x58, 0x63, 0xba,
-	0xc4, 0x9b, 0x9d, 0xdf, 0xdf, 0x2b, 0x9f, 0xbe, 0xb1, 0xb1, 0xb1, 0x9e, 0x00, 0x63, 0x56, 0x1b,
-	0xfd, 0xe7, 0x35, 0x98, 0x0a, 0x3b, 0x83, 0xf4, 0xf9, 0x36, 0xf5, 0x7c, 0x8f, 0x20, 0x9c, 0x6b,
-	0x1a, 0x3b, 0x6b, 0x8e, 0xbd, 0xda, 0xf6, 0x0d, 0xdf, 0xb4, 0x1b, 0xcb, 0xf6, 0xa6, 0x65, 0x36,
-	0xb6, 0x7c, 0xd9, 0xb5, 0x99, 0xfd, 0xbd, 0xf2, 0xb9, 0xd5, 0xcc, 0x1a, 0x98, 0xd3, 0x92, 0x75,
-	0xba, 0x69, 0xec, 0xa4, 0x10, 0x2a, 0x9d, 0x5e, 0x4d, 0x83, 0x31, 0xab, 0x8d, 0xfe, 0x26, 0x98,
-	0x12, 0xe3, 0x40, 0xea, 0xf9, 0xae, 0x59, 0xf3, 0x4d, 0xc7, 0x26, 0x57, 0xa0, 0xdf, 0x36, 0x9a,
-	0x94, 0xf7, 0x70, 0x64, 0x7e, 0xec, 0xcb, 0x7b, 0xe5, 0x57, 0xec, 0xef, 0x95, 0xfb, 0xd7, 0x8c,
-	0x26, 0x45, 0x0e, 0xd1, 0xff, 0x47, 0x09, 0x2e, 0xa6, 0xda, 0x3d, 0x65, 0xfa, 0x5b, 0xb7, 0x5a,
-	0xec, 0x3f, 0x8f, 0xfc, 0x80, 0x06, 0x53, 0x46, 0xb2, 0x02, 0x47, 0x38, 0xfa, 0xc8, 0xe2, 0xec,
-	0xe1, 0x3f, 0xf0, 0xd9, 0x14, 0xb5, 0xf9, 0x07, 0x64, 0xbf, 0xd2, 0x03, 0xc0, 0x34, 0x69, 0xf2,
-	0x51, 0x0d, 0x86, 0x1c, 0xd1, 0xb9, 0xe9, 0xd2, 0x95, 0xbe, 0xab, 0xa3, 0x8f, 0x7c, 0xfb, 0x91,
-	0x74, 0x43, 0x19, 0xf4, 0xac, 0xfc, 0xbb, 0x68, 0xfb, 0xee, 0xee, 0xfc, 0x29, 0xd9, 0xbd, 0x21,
-	0x59, 0x8a, 0x01, 0xf9, 0x99, 0xc7, 0x60, 0x4c, 0xad, 0x49, 0x26, 0xa1, 0xef, 0x2e, 0x15, 0x5b,
-	0x75, 0x04, 0xd9, 0xbf, 0xe4, 0x0c, 0x0c, 0x6c, 0x1b, 0x56, 0x9b, 0xf2, 0x25, 0x1d, 0x41, 0xf1,
-	0xe3, 0xb1, 0xd2, 0x5b, 0x34, 0xfd, 0x11, 0x18, 0x98, 0xab, 0xd7, 0x1d, 0x9b, 0x3c, 0x04, 0x43,
-	0xd4, 0x36, 0xee, 0x58, 0xb4, 0xce, 0x1b, 0x0e, 0x47, 0xf4, 0x16, 0x45, 0x31, 0x06, 0x70, 0xfd,
-	0x47, 0x4b, 0x30, 0xc8, 0x1b, 0x79, 0xe4, 0x93, 0x1a, 0x9c, 0xbe, 0xdb, 0xbe, 0x43, 0x5d, 0x9b,
-	0xfa, 0xd4, 0x5b, 0x30, 0xbc, 0xad, 0x3b, 0x8e, 0xe1, 0xd6, 0xe5, 0xc2, 0x5c, 0x2f, 0x32, 0x23,
-	0x37, 0xd3, 0xe8, 0xc4, 0x1e, 0xcc, 0x00, 0x60, 0x16, 0x71, 0xb2, 0x0d, 0x63, 0x76, 0xc3, 0xb4,
-	0x77, 0x96, 0xed, 0x86, 0x4b, 0x3d, 0x8f, 0x0f, 0x7a, 0xf4, 0x91, 0x77, 0x14, 0xe9, 0xcc, 0x9a,
-	0x82, 0x67, 0x7e, 0x72, 0x7f, 0xaf, 0x3c, 0xa6, 0x96, 0x60, 0x8c, 0x8e, 0xfe, 0x0f, 0x1a, 0x9c,
-	0x9a, 0xab, 0x37, 0x4d, 0x8f, 0x71, 0xda, 0x75, 0xab, 0xdd, 0x30, 0xbb, 0xd8, 0xfa, 0xe4, 0x5d,
-	0x30, 0x58, 0x73, 0xec, 0x4d, 0xb3, 0x21, 0xfb, 0xf9, 0xba, 0x59, 0xc1, 0xb9, 0x66, 0x55, 0xce,
-	0xc5, 0xbb, 0x27, 0x39, 0xde, 0x2c, 0x1a, 0xf7, 0x16, 0x03, 0x86, 0x3e, 0x0f, 0xfb, 0x7b, 0xe5,
-	0xc1, 0x0a, 0x47, 0x80, 0x12, 0x11, 0xb9, 0x0a, 0xc3, 0x75, 0xd3, 0x13, 0x8b, 0xd9, 0xc7, 0x17,
-	0x73, 0x6c, 0x7f, 0xaf, 0x3c, 0xbc, 0x20, 0xcb, 0x30, 0x84, 0x92, 0x15, 0x38, 0xc3, 0x66, 0x50,
-	0xb4, 0xab, 0xd2, 0x9a, 0x4b, 0x7d, 0xd6, 0xb5, 0xe9, 0x7e, 0xde, 0xdd, 0xe9, 0xfd, 0xbd, 0xf2,
-	0x99, 0x9b, 0x19, 0x70, 0xcc, 0x6c, 0xa5, 0x2f, 0xc1, 0xf0, 0x9c, 0x45, 0x5d, 0xc6, 0x10, 0xc8,
-	0x63, 0x30, 0x41, 0x9b, 0x86, 0x69, 0x21, 0xad, 0x51, 0x73, 0x9b, 0xba, 0xde, 0xb4, 0x76, 0xa5,
-	0xef, 0xea, 0xc8, 0x3c, 0xd9, 0xdf, 0x2b, 0x4f, 0x2c, 0xc6, 0x20, 0x98, 0xa8, 0xa9, 0x7f, 0x50,
-	0x83, 0xd1, 0xb9, 0x76, 0xdd, 0xf4, 0xc5, 0xb8, 0x88, 0x0b, 0xa3, 0x06, 0xfb, 0xb9, 0xee, 0x58,
-	0x66, 0x6d, 0x57, 0x6e, 0xae, 0x27, 0x0a, 0x7d, 0x6e, 0x11, 0x9a, 0xf9, 0x53, 0xfb, 0x7b, 0xe5,
-	0x51, 0xa5, 0x00, 0x55, 0x22, 0xfa, 0x16, 0xa8, 0x30, 0xf2, 0x6e, 0x18, 0x13, 0xc3, 0x5d, 0x35,
-	0x5a, 0x48, 0x37, 0x65, 0x1f, 0x1e, 0x54, 0xd6, 0x2a, 0x20, 0x34, 0x7b, 0xeb, 0xce, 0x73, 0xb4,
-	0xe6, 0x23, 0xdd, 0xa4, 0x2e, 0xb5, 0x6b, 0x54, 0x6c, 0x9b, 0x8a, 0xd2, 0x18, 0x63, 0xa8, 0xf4,
-	0x1f, 0xd6, 0xe0, 0xd2, 0x5c, 0xdb, 0xdf, 0x72, 0x5c, 0xf3, 0x05, 0xea, 0x46, 0xd3, 0x1d, 0x62,
-	0x20, 0x6f, 0x87, 0x09, 0x23, 0xac, 0xb0, 0x16, 0x6d, 0xa7, 0x73, 0x72, 0x3b, 0x4d, 0xcc, 0xc5,
-	0xa0, 0x98, 0xa8, 0x4d, 0x1e, 0x01, 0xf0, 0xa2, 0xb5, 0xe5, 0x3c, 0x60, 0x9e, 0xc8, 0xb6, 0xa0,
-	0xac, 0xaa, 0x52, 0x4b, 0xff, 0x4b, 0x76, 0x14, 0x6e, 0x1b, 0xa6, 0x65, 0xdc, 0x31, 0x2d, 0xd3,
-	0xdf, 0x7d, 0xc6, 0xb1, 0x69, 0x17, 0xbb, 0xf9, 0x36, 0x9c, 0x6f, 0xdb, 0x86, 0x68, 0x67, 0xd1,
-	0x55, 0xb1, 0x7f, 0x37, 0x76, 0x5b, 0x54, 0x70, 0xc9, 0x91, 0xf9, 0x0b, 0xfb, 0x7b, 0xe5, 0xf3,
-	0xb7, 0xb3, 0xab, 0x60, 0x5e, 0x5b, 0x76, 0xea, 0x29, 0xa0, 0x27, 0x1d, 0xab, 0xdd, 0x94, 0x58,
-	0xfb, 0x38, 0x56, 0x7e, 0xea, 0xdd, 0xce, 0xac, 0x81, 0x39, 0x2d, 0xf5, 0x2f, 0x96, 0x60, 0x70,
-	0xde, 0xa8, 0xdd, 0x6d, 0xb7, 0xc8, 0x6b, 0x61, 0xb8, 0xe5, 0x3a, 0xdb, 0x66, 0x9d, 0xba, 0x72,
-	0x6c, 0x93, 0x72, 0x6c, 0xc3, 0xeb, 0xb2, 0x1c, 0xc3, 0x1a, 0xc4, 0x84, 0x89, 0xe0, 0xff, 0x4a,
-	0x0f, 0x5f, 0x2e, 0xff, 0x12, 0xd6, 0x63, 0x88, 0x30, 0x81, 0x98, 0xe8, 0x30, 0xe8, 0xd2, 0x06,
-	0x3b, 0xea, 0xfa, 0x78, 0xb7, 0xf8, 0xd7, 0x8e, 0xbc, 0x04, 0x25, 0x84, 0xbc, 0x17, 0x26, 0x6a,
-	0x2e, 0xad, 0x53, 0xdb, 0x37, 0x0d, 0xcb, 0x63, 0x9b, 0x73, 0xa0, 0xfb, 0xcd, 0xc9, 0x3b, 0x51,
-	0x89, 0x35, 0xc7, 0x04, 0x3a, 0xfd, 0xcb, 0x25, 0x18, 0x13, 0x13, 0x35, 0xdf, 0xae, 0xdd, 0xa5,
-	0x3e, 0xf9, 0x0e, 0x18, 0x66, 0xf2, 0x5d, 0xdd, 0xf0, 0x0d, 0xf9, 0x21, 0x7c, 0x4b, 0xee, 0xd0,
-	0xf9, 0x37, 0xc8, 0x6a, 0x47, 0xd4, 0x57, 0xa9, 0x6f, 0x44, 0xfb, 0x2f, 0x2a, 0xc3, 0x10, 0x2b,
-	0xd9, 0x84, 0x7e, 0xaf, 0x45, 0x6b, 0x72, 0x62, 0x17, 0x8a, 0x7c, 0xea, 0x6a, 0x8f, 0xab, 0x2d,
-	0x5a, 0x8b, 0xb6, 0x2b, 0xfb, 0x85, 0x1c, 0x3f, 0xb1, 0x61, 0xd0, 0xf3, 0x0d, 0xbf, 0xed, 0xf1,
-	0xf9, 0x1d, 0x7d, 0x64, 0xa9, 0x67, 0x4a, 0x1c, 0xdb, 0xfc, 0x84, 0xa4, 0x35, 0x28, 0x7e, 0xa3,
-	0xa4, 0xa2, 0xff, 0x1b, 0x0d, 0x26, 0xd5, 0xea, 0x2b, 0xa6, 0xe7, 0x93, 0x6f, 0x4b, 0x4d, 0xe7,
-	0x6c, 0x77, 0xd3, 0xc9, 0x5a, 0xf3, 0xc9, 0x0c, 0x77, 0x6b, 0x50, 0xa2, 0x4c, 0x25, 0x85, 0x01,
-	0xd3, 0xa7, 0xcd, 0x40, 0x4a, 0x79, 0x47, 0xaf, 0x23, 0x9c, 0x1f, 0x97, 0xc4, 0x06, 0x96, 0x19,
-	0x5a, 0x14, 0xd8, 0xf5, 0xef, 0x80, 0x33, 0x6a, 0xad, 0x60, 0x5f, 0x33, 0x96, 0xe1, 0xef, 0xb6,
-	0x52, 0x2c, 0x83, 0x7d, 0x82, 0xc8, 0x21, 0xe4, 0x35, 0xe1, 0x1e, 0x17, 0x9c, 0x29, 0x9c, 0xbb,
-	0xf8, 0x3e, 0xd7, 0xff, 0x55, 0x5f, 0x7c, 0xee, 0xd8, 0x32, 0x92, 0xed, 0xc4, 0x97, 0x3b, 0xfa,
-	0xc8, 0x8d, 0x5e, 0x07, 0x18, 0x74, 0xfd, 0xa5, 0xc2, 0x03, 0xae, 0xc2, 0xb0, 0x47, 0x69, 0x5d,
-	0x39, 0x97, 0xf9, 0x69, 0x5e, 0x95, 0x65, 0x18, 0x42, 0x8f, 0x9d, 0x13, 0x90, 0x55, 0x18, 0xf6,
-	0xb6, 0x1c, 0x87, 0xb5, 0x9a, 0x1e, 0xec, 0x1e, 0xb5, 0xe8, 0xaf, 0x6c, 0x88, 0x21, 0x0a, 0xfd,
-	0x73, 0xfd, 0x40, 0xd2, 0x1f, 0x8f, 0x3a, 0xb7, 0xa2, 0x44, 0xae, 0x6c, 0x2f, 0x73, 0x2b, 0xbf,
-	0xc3, 0x04, 0x62, 0xf2, 0x02, 0x8c, 0x5b, 0x86, 0xe7, 0xdf, 0x6a, 0x31, 0x35, 0x30, 0xd8, 0x82,
-	0xa3, 0x8f, 0xcc, 0x15, 0xd9, 0x43, 0x2b, 0x2a, 0xa2, 0xf9, 0xa9, 0xfd, 0xbd, 0xf2, 0x78, 0xac,
-	0x08, 0xe3, 0xa4, 0xc8, 0x73, 0x30, 0xc2, 0x0a, 0x16, 0x5d, 0xd7, 0x71, 0x25, 0xfb, 0x79, 0xbc,
-	0x28, 0x5d, 0x8e, 0x44, 0xa8, 0xa5, 0xe1, 0x4f, 0x8c, 0xd0, 0x93, 0x77, 0x02, 0x71, 0xee, 0x70,
-	0xc3, 0x40, 0xfd, 0xba, 0xd0, 0x79, 0xd9, 0x60, 0xd9, 0x6e, 0xea, 0x9b, 0x9f, 0x91, 0xdb, 0x9c,
-	0xdc, 0x4a, 0xd5, 0xc0, 0x8c, 0x56, 0xe4, 0x2e, 0x90, 0x50, 0x6f, 0x16, 0xc2, 0xc3, 0x01, 0x3b,
-	0x2d, 0xac, 0x24, 0xb7, 0xc3, 0x39, 0x46, 0xec, 0x7a, 0x0a, 0x05, 0x66, 0xa0, 0xd5, 0x7f, 0xab,
-	0x04, 0xa3, 0x62, 0x8b, 0x08, 0xdd, 0xe6, 0xf8, 0x8f, 0x1e, 0x1a, 0x3b, 0x7a, 0x2a, 0xc5, 0xb9,
-	0x09, 0xef, 0x70, 0xee, 0xc9, 0xd3, 0x4c, 0x9c, 0x3c, 0x8b, 0xbd, 0x12, 0xea, 0x7c, 0xf0, 0xfc,
-	0xb1, 0x06, 0xa7, 0x94, 0xda, 0x27, 0x70, 0xee, 0xd4, 0xe3, 0xe7, 0xce, 0x13, 0x3d, 0x8e, 0x2f,
-	0xe7, 0xd8, 0xf9, 0xb5, 0xf8, 0xb8, 0xf8, 0x99, 0xf0, 0x08, 0xc0, 0x1d, 0xce, 0x4f, 0x14, 0x51,
-	0x39, 0x5c, 0xf3, 0xf9, 0x10, 0x82, 0x4a, 0xad, 0x18, 0x93, 0x2d, 0x75, 0x64, 0xb2, 0x2a, 0x0f,
-	0xec, 0xeb, 0x9d, 0x07, 0xfe, 0xbb, 0x3e, 0x98, 0x4a, 0x2d, 0x63, 0x9a, 0x2f, 0x69, 0xdf, 0x20,
-	0xbe, 0x54, 0xfa, 0x46, 0xf0, 0xa5, 0xbe, 0x42, 0x7c, 0xa9, 0xfb, 0x73, 0xd2, 0x05, 0xd2, 0x34,
-	0x1b, 0xa2, 0x59, 0xd5, 0x37, 0x5c, 0x7f, 0xc3, 0x6c, 0x52, 0xc9, 0xc1, 0xbe, 0xb9, 0xbb, 0x4f,
-	0x80, 0xb5, 0x10, 0x8c, 0x6c, 0x35, 0x85, 0x09, 0x33, 0xb0, 0xeb, 0xdf, 0x5d, 0x82, 0xa1, 0x79,
-	0xc3, 0xe3, 0x3d, 0x7d, 0x3f, 0x8c, 0x49, 0xd4, 0xcb, 0x4d, 0xa3, 0x41, 0x7b, 0xb1, 0x96, 0x48,
-	0x94, 0xab, 0x0a, 0x3a, 0xa1, 0x70, 0xaa, 0x25, 0x18, 0x23, 0x47, 0x76, 0x61, 0xb4, 0x19, 0x29,
-	0x57, 0x72, 0x89, 0x97, 0x7a, 0xa7, 0xce, 0xb0, 0x09, 0xad, 0x5a, 0x29, 0x40, 0x95, 0x96, 0xfe,
-	0x2c, 0x9c, 0xce, 0xe8, 0x71, 0x17, 0x7a, 0xe5, 0xab, 0x61, 0x68, 0x9b, 0xba, 0x5e, 0x24, 0x25,
-	0x8e, 0xee, 0xef, 0x95, 0x87, 0x9e, 0x14, 0x45, 0x18, 0xc0, 0xf4, 0x37, 0x33, 0x81, 0x22, 0xd9,
-	0xa7, 0x6e, 0xec, 0x8f, 0x03, 0x00, 0x95, 0x39, 0x74, 0x7c, 0xb1, 0x95, 0x9e, 0x80, 0x81, 0xd6,
-	0x96, 0xe1, 0x05, 0x2d, 0x1e, 0x0a, 0x58, 0xcf, 0x3a, 0x2b, 0xbc, 0xbf, 0x57, 0x9e, 0x56, 0xe5,
-	0x24, 0xd9, 0x88, 0xc3, 0x50, 0xb4, 0x63, 0x3b, 0x8c, 0x6d, 0xf2, 0x8a, 0xd3, 0x6c, 0x59, 0x94,
-	0x41, 0xf9, 0x0e, 0x2b, 0x15, 0xdb, 0x61, 0x2b, 0x29, 0x4c, 0x98, 0x81, 0x3d, 0xa0, 0xb9, 0x6c,
-	0x9b, 0xbe, 0x69, 0x84, 0x34, 0xfb, 0x8a, 0xd3, 0x8c, 0x63, 0xc2, 0x0c, 0xec, 0xe4, 0xe3, 0x1a,
-	0xcc, 0xc4, 0x8b, 0x97, 0x4c, 0xdb, 0xf4, 0xb6, 0x68, 0x9d, 0x13, 0xef, 0x3f, 0x34, 0xf1, 0xcb,
-	0xfb, 0x7b, 0xe5, 0x99, 0x95, 0x5c, 0x8c, 0xd8, 0x81, 0x1a, 0xf9, 0x7e, 0x0d, 0x2e, 0x24, 0xe6,
-	0xc5, 0x35, 0x1b, 0x0d, 0xea, 0xca, 0xde, 0x1c, 0xfe, 0x03, 0x2f, 0xef, 0xef, 0x95, 0x2f, 0xac,
-	0xe4, 0xa3, 0xc4, 0x4e, 0xf4, 0xc8, 0xe7, 0x35, 0x38, 0xd7, 0xa2, 0x76, 0xdd, 0xb4, 0x1b, 0x4f,
-	0x39, 0xee, 0x5d, 0xea, 0x7a, 0xe8, 0x58, 0x96, 0xd3, 0xf6, 0xbd, 0xe9, 0x41, 0x7e, 0x26, 0x2e,
-	0x17, 0xf9, 0xe6, 0xd6, 0xb3, 0x30, 0xce, 0x5f, 0x96, 0x5b, 0xf4, 0x5c, 0x26, 0xd8, 0xc3, 0x9c,
-	0x8e, 0xe8, 0xbf, 0xa9, 0x41, 0x5f, 0x05, 0x97, 0xc9, 0xc3, 0xb1, 0x4f, 0xe4, 0xbc, 0xfa, 0x89,
-	0xdc, 0xdf, 0x2b, 0x0f, 0x55, 0x70, 0x59, 0xf9, 0x18, 0xbf, 0x5f, 0x83, 0xa9, 0x9a, 0x63, 0xfb,
-	0x06, 0x9b, 0x3b, 0x14, 0xb2, 0x77, 0x70, 0xce, 0x17, 0xd2, 0xd5, 0x2b, 0x09, 0x64, 0x91, 0x2d,
-	0x3e, 0x09, 0xf1, 0x30, 0x4d, 0x59, 0xff, 0x82, 0x06, 0x67, 0x2a, 0x46, 0x4b, 0x5a, 0xaa, 0x16,
-	0xe8, 0xa6, 0xc9, 0x36, 0x48, 0x37, 0x17, 0x0f, 0x64, 0x0b, 0x06, 0xb9, 0x31, 0xdc, 0xeb, 0xc5,
-	0xd4, 0x10, 0xd1, 0x7e, 0x92, 0xe3, 0x12, 0x66, 0x1a, 0xf1, 0x3f, 0x4a, 0xfc, 0xfa, 0xe3, 0x30,
-	0x99, 0xac, 0x47, 0xca, 0x81, 0x8c, 0x24, 0x6c, 0xa3, 0x23, 0x49, 0xf1, 0xe6, 0xb1, 0xe1, 0xff,
-	0xfb, 0x73, 0xe5, 0x57, 0x7c, 0xd7, 0x9f, 0x5f, 0x79, 0x85, 0xfe, 0x27, 0x1a, 0x8c, 0x55, 0x2c,
-	0xa7, 0x5d, 0x5f, 0x77, 0x9d, 0x4d, 0xd3, 0xa2, 0x2f, 0x0f, 0x23, 0x8c, 0xda, 0xe3, 0x3c, 0x51,
-	0x98, 0x1b, 0x45, 0xd4, 0x8a, 0x2f, 0x13, 0xa3, 0x88, 0xda, 0xe5, 0x1c, 0xe9, 0xf4, 0x3d, 0x70,
-	0x56, 0xad, 0x15, 0x59, 0x74, 0xaf, 0x40, 0xff, 0x5d, 0xd3, 0xae, 0x27, 0x37, 0xe6, 0x4d, 0xd3,
-	0xae, 0x23, 0x87, 0x84, 0x5b, 0xb7, 0x94, 0x7b, 0x66, 0xfd, 0xed, 0x48, 0x7c, 0xda, 0xb8, 0xec,
-	0x7b, 0x15, 0x86, 0x6b, 0xc6, 0x7c, 0xdb, 0xae, 0x5b, 0xe1, 0xae, 0x67, 0x53, 0x50, 0x99, 0x13,
-	0x65, 0x18, 0x42, 0xc9, 0x0b, 0x00, 0xd1, 0xe5, 0x49, 0x2f, 0x42, 0x40, 0x74, 0x2f, 0x53, 0xa5,
-	0xbe, 0x6f, 0xda, 0x0d, 0x2f, 0xda, 0x57, 0x11, 0x0c, 0x15, 0x6a, 0xe4, 0xfd, 0x30, 0xae, 0x4a,
-	0x24, 0xc2, 0x8a, 0x5b, 0x70, 0x19, 0x62, 0xa2, 0xcf, 0x59, 0x49, 0x78, 0x5c, 0x2d, 0xf5, 0x30,
-	0x4e, 0x8d, 0xec, 0x86, 0xf2, 0x97, 0xb0, 0x21, 0xf7, 0x17, 0xd7, 0x50, 0x54, 0xd1, 0xe7, 0x8c,
-	0x24, 0x3e, 0x16, 0xb3, 0x69, 0xc7, 0x48, 0x65, 0xd8, 0x8d, 0x06, 0x8e, 0xcb, 0x6e, 0x44, 0x61,
-	0x48, 0x58, 0xce, 0x82, 0xe3, 0xe6, 0xb1, 0x22, 0x03, 0x14, 0x46, 0xb8, 0xe8, 0x36, 0x50, 0xfc,
-	0xf6, 0x30, 0xc0, 0x4d, 0xb6, 0x61, 0x8c, 0x09, 0xd6, 0x55, 0x6a, 0xd1, 0x9a, 0xef, 0xb8, 0xd3,
-	0x43, 0xc5, 0x6f, 0xdb, 0xaa, 0x0a, 0x1e, 0x21, 0xc5, 0xaa, 0x25, 0x18, 0xa3, 0x13, 0x1a, 0x16,
-	0x87, 0x73, 0x0d, 0x8b, 0x6d, 0x18, 0xdd, 0x56, 0x6e, 0x0a, 0x46, 0xf8, 0x24, 0xbc, 0xbd, 0x48,
-	0xc7, 0xa2, 0x6b, 0x83, 0xf9, 0xd3, 0x92, 0xd0, 0xa8, 0x7a, 0xc5, 0xa0, 0xd2, 0x21, 0x77, 0x60,
-	0xe8, 0x8e, 0x90, 0x41, 0xa7, 0x81, 0xcf, 0xc5, 0xdb, 0x7a, 0x10, 0xad, 0x85, 0x9c, 0x2b, 0x7f,
-	0x60, 0x80, 0x98, 0x3c, 0x0b, 0x83, 0x96, 0xd9, 0x34, 0x7d, 0x6f, 0x7a, 0x94, 0x93, 0x28, 0xb4,
-	0xb4, 0x2b, 0x1c, 0x83, 0x38, 0xac, 0xc4, 0xff, 0x28, 0xb1, 0x92, 0x4f, 0x69, 0x70, 0x5a, 0x6e,
-	0xc3, 0xf0, 0xd0, 0x32, 0xa9, 0x37, 0x3d, 0xc6, 0xe7, 0xf0, 0x46, 0x6f, 0x87, 0x64, 0x74, 0x40,
-	0xcf, 0x5f, 0x90, 0xb3, 0x79, 0x7a, 0x35, 0x4d, 0x0c, 0xb3, 0x7a, 0xa0, 0x7f, 0x7c, 0x0a, 0xa6,
-	0x2a, 0x56, 0xdb, 0xf3, 0xa9, 0x3b, 0x27, 0x1d, 0x6d, 0xa8, 0x4b, 0x3e, 0xa4, 0xc1, 0x39, 0xfe,
-	0xef, 0x82, 0x73, 0xcf, 0x5e, 0xa0, 0x96, 0xb1, 0x3b, 0xb7, 0xc9, 0x6a, 0xd4, 0xeb, 0x87, 0x3b,
-	0x3c, 0x16, 0xda, 0x52, 0x4d, 0xe6, 0x17, 0x4a, 0xd5, 0x4c, 0x8c, 0x98, 0x43, 0x89, 0x7c, 0x9f,
-	0x06, 0x0f, 0x64, 0x80, 0x16, 0xa8, 0x45, 0xfd, 0x40, 0xf8, 0x3f, 0x6c, 0x3f, 0x2e, 0xed, 0xef,
-	0x95, 0x1f, 0xa8, 0xe6, 0x21, 0xc5, 0x7c, 0x7a, 0xe4, 0x07, 0x34, 0x98, 0xc9, 0x80, 0x2e, 0x19,
-	0xa6, 0xd5, 0x76, 0x03, 0xbd, 0xe0, 0xb0, 0xdd, 0xe1, 0xe2, 0x79, 0x35, 0x17, 0x2b, 0x76, 0xa0,
-	0x48, 0x3e, 0x00, 0x67, 0x43, 0xe8, 0x6d, 0xdb, 0xa6, 0xb4, 0x1e, 0xd3, 0x12, 0x0e, 0xdb, 0x95,
-	0x07, 0xf6, 0xf7, 0xca, 0x67, 0xab, 0x59, 0x08, 0x31, 0x9b, 0x0e, 0x69, 0xc0, 0xa5, 0x08, 0xe0,
-	0x9b, 0x96, 0xf9, 0x82, 0x50, 0x64, 0xb6, 0x5c, 0xea, 0x6d, 0x39, 0x56, 0x9d, 0xb3, 0x62, 0x6d,
-	0xfe, 0x95, 0xfb, 0x7b, 0xe5, 0x4b, 0xd5, 0x4e, 0x15, 0xb1, 0x33, 0x1e, 0x52, 0x87, 0x31, 0xaf,
-	0x66, 0xd8, 0xcb, 0xb6, 0x4f, 0xdd, 0x6d, 0xc3, 0x92, 0xa6, 0xf2, 0xc3, 0x0e, 0x50, 0x30, 0x40,
-	0x05, 0x0f, 0xc6, 0xb0, 0x92, 0xb7, 0xc0, 0x30, 0xdd, 0x69, 0x19, 0x76, 0x9d, 0x0a, 0xa6, 0x3b,
-	0x32, 0x7f, 0x91, 0x1d, 0xf5, 0x8b, 0xb2, 0xec, 0xfe, 0x5e, 0x79, 0x2c, 0xf8, 0x7f, 0xd5, 0xa9,
-	0x53, 0x0c, 0x6b, 0x93, 0xf7, 0xc1, 0x19, 0xee, 0x09, 0x54, 0xa7, 0xfc, 0x08, 0xf1, 0x02, 0x5d,
-	0x71, 0xb8, 0x50, 0x3f, 0xb9, 0x97, 0xc0, 0x6a, 0x06, 0x3e, 0xcc, 0xa4, 0xc2, 0x96, 0xa1, 0x69,
-	0xec, 0x5c, 0x77, 0x8d, 0x1a, 0xdd, 0x6c, 0x5b, 0x1b, 0xd4, 0x6d, 0x9a, 0xb6, 0x30, 0x96, 0xd0,
-	0x9a, 0x63, 0xd7, 0x19, 0xa3, 0xd6, 0xae, 0x0e, 0x88, 0x65, 0x58, 0xed, 0x54, 0x11, 0x3b, 0xe3,
-	0x21, 0x6f, 0x84, 0x31, 0xb3, 0x61, 0x3b, 0x2e, 0xdd, 0x30, 0x4c, 0xdb, 0xf7, 0xa6, 0x81, 0x0b,
-	0xd9, 0x7c, 0x5a, 0x97, 0x95, 0x72, 0x8c, 0xd5, 0x22, 0xdb, 0x40, 0x6c, 0x7a, 0x6f, 0xdd, 0xa9,
-	0xf3, 0x2d, 0x70, 0xbb, 0xc5, 0x37, 0xb2, 0x64, 0xb3, 0x87, 0x9d, 0x1a, 0xae, 0x4a, 0xaf, 0xa5,
-	0xb0, 0x61, 0x06, 0x05, 0xb2, 0x04, 0xa4, 0x69, 0xec, 0x2c, 0x36, 0x5b, 0xfe, 0xee, 0x7c, 0xdb,
-	0xba, 0x2b, 0xb9, 0xc6, 0x18, 0x9f, 0x0b, 0x61, 0x68, 0x4a, 0x41, 0x31, 0xa3, 0x05, 0x31, 0xe0,
-	0x82, 0x18, 0xcf, 0x82, 0x41, 0x9b, 0x8e, 0xed, 0x51, 0xdf, 0x53, 0x36, 0xe9, 0xf4, 0x38, 0xf7,
-	0x07, 0xe1, 0x8a, 0xed, 0x72, 0x7e, 0x35, 0xec, 0x84, 0x23, 0xee, 0x11, 0x37, 0x71, 0x80, 0x47,
-	0xdc, 0xa3, 0x30, 0xee, 0xf9, 0x86, 0xeb, 0xb7, 0x5b, 0x72, 0x19, 0x4e, 0xf1, 0x65, 0xe0, 0x76,
-	0xc8, 0xaa, 0x0a, 0xc0, 0x78, 0x3d, 0xb6, 0x7c, 0xc2, 0x78, 0x2d, 0xdb, 0x4d, 0x46, 0xcb, 0x57,
-	0x55, 0xca, 0x31, 0x56, 0x8b, 0xdc, 0x86, 0xf3, 0x4d, 0x63, 0x27, 0xfc, 0x7c, 0xd7, 0x0d, 0xd7,
-	0xb0, 0x2c, 0x6a, 0x99, 0x5e, 0x73, 0x7a, 0x8a, 0xf7, 0x94, 0x3b, 0x20, 0xac, 0x66, 0x57, 0xc1,
-	0xbc, 0xb6, 0xd2, 0x45, 0x6e, 0xc1, 0x35, 0xcc, 0x18, 0x4a, 0x12, 0x73, 0x91, 0x4b, 0x82, 0x31,
-	0xab, 0x0d, 0xf9, 0x09, 0x0d, 0xca, 0xfc, 0xfc, 0x33, 0x2c, 0xf6, 0x71, 0x5c, 0x77, 0x9d, 0x76,
-	0x6b, 0xde, 0xa8, 0xdd, 0x75, 0x36, 0x37, 0x83, 0x8d, 0x33, 0x7d, 0xba, 0xd0, 0x76, 0x7b, 0x70,
-	0x7f, 0xaf, 0x5c, 0x5e, 0xee, 0x8c, 0x1a, 0x0f, 0xa2, 0x4d, 0x7e, 0x48, 0x83, 0x0b, 0xf2, 0xc3,
-	0xcd, 0xec, 0xdb, 0x99, 0x42, 0x7d, 0xe3, 0x3b, 0x6e, 0x35, 0x1f, 0x2d, 0x76, 0xa2, 0x49, 0x7e,
-	0x44, 0x83, 0x8b, 0x76, 0x02, 0x88, 0xd4, 0xa3, 0xdc, 0xb6, 0xea, 0xb4, 0xfd, 0xe9, 0xb3, 0x85,
-	0x3a, 0x75, 0x65, 0x7f, 0xaf, 0x7c, 0x71, 0xad, 0x03, 0x5e, 0xec, 0x48, 0x55, 0xff, 0xef, 0xfd,
-	0x30, 0x9d, 0x12, 0x46, 0x02, 0x97, 0xc5, 0x03, 0x8f, 0x1b, 0xed, 0x88, 0x8e, 0x9b, 0x16, 0x5c,
-	0x09, 0x2b, 0x5c, 0x6f, 0xb5, 0x33, 0x69, 0x95, 0x38, 0xad, 0x57, 0xed, 0xef, 0x95, 0xaf, 0x54,
-	0x0f, 0xa8, 0x8b, 0x07, 0x62, 0xcb, 0x3f, 0xca, 0xfb, 0x4e, 0xe8, 0x28, 0x7f, 0x1f, 0x9c, 0x51,
-	0x00, 0x2e, 0x35, 0xea, 0xbb, 0x3d, 0x88, 0x12, 0xfc, 0x04, 0xab, 0x66, 0xe0, 0xc3, 0x4c, 0x2a,
-	0xb9, 0xe7, 0xe7, 0xc0, 0x49, 0x9c, 0x9f, 0xfa, 0x5e, 0x1f, 0x8c, 0x54, 0x1c, 0xbb, 0x2e, 0x4c,
-	0x5c, 0xaf, 0x8f, 0xf9, 0x57, 0x5c, 0x52, 0xd5, 0xa0, 0xfb, 0x7b, 0xe5, 0xf1, 0xb0, 0xa2, 0xa2,
-	0x17, 0xbd, 0x35, 0xbc, 0x7a, 0x14, 0xc6, 0x85, 0x57, 0xc6, 0xef, 0x0c, 0xef, 0xef, 0x95, 0x4f,
-	0x85, 0xcd, 0xe2, 0xd7, 0x88, 0xec, 0x70, 0xb4, 0x0c, 0xcf, 0xdf, 0x70, 0x0d, 0xdb, 0x33, 0x7b,
-	0xb0, 0x31, 0x87, 0x77, 0x3b, 0x2b, 0x29, 0x6c, 0x98, 0x41, 0x81, 0x3c, 0x07, 0x13, 0xac, 0xf4,
-	0x76, 0xab, 0x6e, 0xf8, 0xb4, 0xa0, 0x69, 0x39, 0xf4, 0x96, 0x5b, 0x89, 0x61, 0xc2, 0x04, 0x66,
-	0xe1, 0x8f, 0x62, 0x78, 0x8e, 0xcd, 0xd7, 0x33, 0xe6, 0x8f, 0xc2, 0x4a, 0x51, 0x42, 0xc9, 0x43,
-	0x30, 0xd4, 0xa4, 0x9e, 0x67, 0x34, 0x28, 0x17, 0xf0, 0x46, 0x22, 0x1d, 0x79, 0x55, 0x14, 0x63,
-	0x00, 0x27, 0xaf, 0x85, 0x81, 0x9a, 0x53, 0xa7, 0xde, 0xf4, 0x10, 0x3f, 0xc3, 0xd8, 0x71, 0x3e,
-	0x50, 0x61, 0x05, 0xf7, 0xf7, 0xca, 0x23, 0xfc, 0x26, 0x8c, 0xfd, 0x42, 0x51, 0x49, 0xff, 0x49,
-	0x0d, 0x26, 0x93, 0x76, 0xcf, 0x2e, 0xfc, 0x68, 0x4e, 0xce, 0x25, 0x45, 0xff, 0x94, 0x06, 0x63,
-	0xac, 0x87, 0xae, 0x63, 0xad, 0x5b, 0x86, 0x4d, 0xc9, 0x47, 0x34, 0x98, 0xdc, 0x32, 0x1b, 0x5b,
-	0xaa, 0xc7, 0xa0, 0xd4, 0xbc, 0x0a, 0xd9, 0x0d, 0x6f, 0x24, 0x70, 0xcd, 0x9f, 0xd9, 0xdf, 0x2b,
-	0x4f, 0x26, 0x4b, 0x31, 0x45, 0x53, 0xff, 0xab, 0x12, 0x9c, 0x57, 0x7b, 0x36, 0x17, 0x3d, 0xc6,
-	0x20, 0x7f, 0xac, 0x01, 0x34, 0x4d, 0x7b, 0xce, 0xb2, 0x9c, 0x7b, 0xdc, 0xcd, 0x99, 0xe9, 0xb2,
-	0xef, 0x29, 0x6a, 0xaf, 0xce, 0xa0, 0x30, 0xbb, 0x1a, 0x62, 0x17, 0x77, 0xd6, 0x4f, 0x07, 0x76,
-	0xb0, 0x08, 0x70, 0x7f, 0xaf, 0x5c, 0x4e, 0xbf, 0x00, 0x99, 0x45, 0xf9, 0xcc, 0x62, 0xc5, 0xf4,
-	0xfc, 0x0f, 0xfd, 0x65, 0xc7, 0x2a, 0xe2, 0xee, 0x3a, 0x1a, 0xc8, 0x4c, 0x13, 0x4e, 0x25, 0x08,
-	0x67, 0xb8, 0x80, 0x2f, 0xa8, 0x2e, 0xe0, 0x07, 0x30, 0xa9, 0xd9, 0xe0, 0xd1, 0xc7, 0xec, 0xbb,
-	0xda, 0x86, 0xed, 0xb3, 0x99, 0x56, 0x5c, 0xc6, 0xff, 0xa2, 0x04, 0x67, 0xe4, 0x04, 0x58, 0x4c,
-	0xdb, 0x6c, 0x59, 0xce, 0x6e, 0x93, 0xda, 0x27, 0xe1, 0x16, 0x18, 0x7c, 0x04, 0xa5, 0xdc, 0x8f,
-	0xa0, 0x99, 0xfa, 0x08, 0xfa, 0x8a, 0x7c, 0x04, 0x21, 0xaf, 0x38, 0xc0, 0xc6, 0x86, 0x70, 0xce,
-	0xb4, 0x59, 0x47, 0xaf, 0xf3, 0x0d, 0x13, 0x39, 0xef, 0x72, 0xfe, 0x34, 0x2c, 0xcc, 0x08, 0xcb,
-	0x99, 0x35, 0x30, 0xa7, 0xa5, 0xfe, 0x37, 0x1a, 0x4c, 0x67, 0xcd, 0xef, 0x09, 0x98, 0xc5, 0x9b,
-	0x71, 0xb3, 0xf8, 0x8d, 0x1e, 0xbe, 0x8d, 0x58, 0xd7, 0x73, 0xcc, 0xe3, 0x7f, 0x5d, 0x82, 0x73,
-	0x51, 0xf5, 0x65, 0xdb, 0xf3, 0x0d, 0xcb, 0x12, 0x02, 0xdf, 0xf1, 0xef, 0xa5, 0x56, 0xec, 0x76,
-	0x63, 0xad, 0xb7, 0xa1, 0xaa, 0x7d, 0xcf, 0x75, 0xf9, 0xd9, 0x49, 0xb8, 0xfc, 0xac, 0x1f, 0x21,
-	0xcd, 0xce, 0xde, 0x3f, 0xff, 0x51, 0x83, 0x99, 0xec, 0x86, 0x27, 0xb0, 0xa9, 0x9c, 0xf8, 0xa6,
-	0x7a, 0xe7, 0xd1, 0x8d, 0x3a, 0x67, 0x5b, 0xfd, 0x7c, 0x29, 0x6f, 0xb4, 0xfc, 0x8a, 0x64, 0x13,
-	0x4e, 0xb9, 0xb4, 0x61, 0x7a, 0xbe, 0x54, 0x3c, 0x0e, 0xe7, 0xcd, 0x1f, 0x5c, 0x8d, 0x9e, 0xc2,
-	0x38, 0x0e, 0x4c, 0x22, 0x25, 0x6b, 0x30, 0xe4, 0x51, 0x5a, 0x67, 0xf8, 0x4b, 0xdd, 0xe3, 0x0f,
-	0x85, 0x88, 0xaa, 0x68, 0x8b, 0x01, 0x12, 0xf2, 0x6d, 0x30, 0x5e, 0x0f, 0xbf, 0xa8, 0x43, 0x7a,
-	0x1f, 0x71, 0x6d, 0x7b, 0x41, 0x6d, 0x8d, 0x71, 0x64, 0xfa, 0xdf, 0x6b, 0x70, 0xb1, 0xd3, 0xde,
-	0x22, 0xcf, 0x03, 0xd4, 0x02, 0xa9, 0xd0, 0x93, 0x87, 0xe7, 0xe3, 0x05, 0xd7, 0x52, 0x60, 0x89,
-	0x3e, 0xd0, 0xb0, 0xc8, 0x43, 0x85, 0x48, 0x86, 0x23, 0x68, 0xe9, 0x98, 0x1c, 0x41, 0xf5, 0xff,
-	0xa4, 0xa9, 0xac, 0x48, 0x5d, 0xdb, 0x97, 0x1b, 0x2b, 0x52, 0xfb, 0x9e, 0x7b, 0xe5, 0xfa, 0xd5,
-	0x12, 0x5c, 0xc9, 0x6e, 0xa2, 0x9c, 0xe7, 0xef, 0x80, 0xc1, 0x96, 0x78, 0x71, 0x23, 0x1e, 0x1f,
-	0x5c, 0x65, 0x9c, 0x45, 0xbc, 0x87, 0xb9, 0xbf, 0x57, 0x9e, 0xc9, 0x62, 0xf4, 0xf2, 0x25, 0x8d,
-	0x6c, 0x47, 0xcc, 0xc4, 0xdd, 0x90, 0x10, 0xda, 0xdf, 0xd0, 0x25, 0x73, 0x31, 0xee, 0x50, 0xab,
-	0xeb, 0xeb, 0xa0, 0x0f, 0x6a, 0x30, 0x11, 0xdb, 0xd1, 0xde, 0xf4, 0x00, 0xdf, 0xa3, 0x85, 0x7c,
-	0xe6, 0x62, 0x9f, 0x4a, 0x24, 0x0d, 0xc4, 0x8a, 0x3d, 0x4c, 0x10, 0x4c, 0xb0, 0x59, 0x75, 0x56,
-	0x5f, 0x76, 0x6c, 0x56, 0xed, 0x7c, 0x0e, 0x9b, 0xfd, 0xf1, 0x52, 0xde, 0x68, 0x39, 0x9b, 0xbd,
-	0x07, 0x23, 0x81, 0x18, 0x19, 0xb0, 0x8b, 0xa5, 0x5e, 0xfb, 0x24, 0xd0, 0xcd, 0x4f, 0xc9, 0xfe,
-	0x8c, 0x04, 0x25, 0x1e, 0x46, 0xb4, 0xc8, 0xf7, 0x68, 0x00, 0xd1, 0xc2, 0xc8, 0x8f, 0x6a, 0xe3,
-	0xe8, 0xa6, 0x43, 0x11, 0x6b, 0x26, 0xd8, 0x27, 0xad, 0x6c, 0x0a, 0x85, 0xae, 0xfe, 0xaf, 0xfb,
-	0x81, 0xa4, 0xfb, 0xde, 0xdd, 0xcd, 0xff, 0x01, 0x42, 0x6e, 0x0b, 0x26, 0x5d, 0x26, 0x2d, 0xd6,
-	0x4c, 0x8b, 0x06, 0x06, 0xb0, 0x62, 0x96, 0x0f, 0xae, 0x56, 0x61, 0x02, 0x17, 0xa6, 0xb0, 0x93,
-	0x57, 0xc3, 0x50, 0xcb, 0x35, 0x9b, 0x86, 0xbb, 0xcb, 0x95, 0xe2, 0x61, 0x71, 0x2d, 0xb9, 0x2e,
-	0x8a, 0x30, 0x80, 0x91, 0xf7, 0xc1, 0x88, 0x65, 0x6e, 0xd2, 0xda, 0x6e, 0xcd, 0xa2, 0xf2, 0xd6,
-	0xe3, 0xd6, 0xd1, 0xac, 0xf9, 0x4a, 0x80, 0x56, 0x3a, 0x93, 0x06, 0x3f, 0x31, 0x22, 0x48, 0x96,
-	0xe1, 0xf4, 0x3d, 0xee, 0xde, 0x64, 0x51, 0xcf, 0xab, 0xb6, 0x5b, 0x2d, 0xc7, 0xf5, 0x69, 0x9d,
-	0xdf, 0x8d, 0x0c, 0x0b, 0x1b, 0xed, 0x53, 0x69, 0x30, 0x66, 0xb5, 0x21, 0x8f, 0x03, 0x18, 0x6d,
-	0xdf, 0x11, 0x4f, 0x5f, 0xa7, 0x87, 0xb9, 0xd6, 0x7e, 0x89, 0x2d, 0xf6, 0x5c, 0x58, 0x7a, 0x7f,
-	0xaf, 0x3c, 0x2a, 0x6d, 0x7f, 0x7c, 0x69, 0x94, 0x06, 0xe4, 0x3d, 0x70, 0xa6, 0x26, 0x40, 0x15,
-	0xa7, 0xd9, 0x32, 0x7c, 0x53, 0x6a, 0xc4, 0x23, 0x1c, 0xd1, 0x37, 0xed, 0xef, 0x95, 0xcf, 0x54,
-	0x32, 0xe0, 0x49, 0x94, 0x99, 0x48, 0xf4, 0x8f, 0x97, 0xe0, 0x42, 0x87, 0x09, 0x22, 0xc8, 0x3e,
-	0x3c, 0xb9, 0x7e, 0x72, 0x9b, 0xbd, 0x51, 0x7c, 0x2c, 0xb2, 0xf0, 0xfe, 0x5e, 0xf9, 0xc1, 0x0e,
-	0x08, 0xaa, 0x6c, 0x9f, 0xd3, 0xc6, 0x2e, 0x46, 0x68, 0xc8, 0x32, 0x0c, 0xd6, 0xa3, 0x6b, 0xcc,
-	0x91, 0xf9, 0xd7, 0xb3, 0xa3, 0x40, 0x5c, 0x38, 0x74, 0x8b, 0x4d, 0x22, 0x20, 0x2b, 0x30, 0x24,
-	0xdc, 0x63, 0xa9, 0x3c, 0x56, 0x1e, 0xe1, 0x26, 0x13, 0x51, 0xd4, 0x2d, 0xb2, 0x00, 0x85, 0xfe,
-	0x77, 0x1a, 0x0c, 0x55, 0x1c, 0x97, 0x2e, 0xac, 0x55, 0xc9, 0x2e, 0x8c, 0x2a, 0xb1, 0x17, 0x24,
-	0x8b, 0x2d, 0xc8, 0x73, 0x38, 0x46, 0x45, 0xb5, 0x0f, 0x5e, 0x8b, 0x86, 0x05, 0xa8, 0xd2, 0x22,
-	0xcf, 0xb3, 0x39, 0xbf, 0xe7, 0x9a, 0x3e, 0x23, 0xdc, 0x8b, 0xbf, 0x94, 0x20, 0x8c, 0x01, 0x2e,
-	0xb1, 0xdb, 0xc3, 0x9f, 0x18, 0x51, 0xd1, 0xd7, 0x19, 0x7b, 0x49, 0x76, 0x93, 0x3c, 0x06, 0xfd,
-	0x4d, 0xa7, 0x1e, 0xac, 0xfb, 0x6b, 0x02, 0xe6, 0xb1, 0xea, 0xd4, 0xd9, 0xdc, 0x9e, 0x4b, 0xb7,
-	0xe0, 0x57, 0x83, 0xbc, 0x8d, 0xbe, 0x06, 0x93, 0x49, 0xfa, 0xe4, 0x31, 0x98, 0xa8, 0x39, 0xcd,
-	0xa6, 0x63, 0x57, 0xdb, 0x9b, 0x9b, 0xe6, 0x0e, 0x8d, 0x3d, 0xe3, 0xad, 0xc4, 0x20, 0x98, 0xa8,
-	0xa9, 0x7f, 0x56, 0x83, 0x3e, 0xb6, 0x2e, 0x3a, 0x0c, 0xd6, 0x9d, 0xa6, 0x61, 0xda, 0xb2, 0x57,
-	0xdc, 0xe1, 0x60, 0x81, 0x97, 0xa0, 0x84, 0x90, 0x16, 0x8c, 0x04, 0x12, 0x59, 0x4f, 0x2f, 0x06,
-	0x16, 0xd6, 0xaa, 0xe1, 0xfb, 0xad, 0xf0, 0x98, 0x08, 0x4a, 0x3c, 0x8c, 0x88, 0xe8, 0x06, 0x4c,
-	0x2d, 0xac, 0x55, 0x97, 0xed, 0x9a, 0xd5, 0xae, 0xd3, 0xc5, 0x1d, 0xfe, 0x87, 0xf1, 0x39, 0x53,
-	0x94, 0xc8, 0x71, 0x72, 0x3e, 0x27, 0x2b, 0x61, 0x00, 0x63, 0xd5, 0xa8, 0x68, 0x21, 0x5f, 0xb5,
-	0xf2, 0x6a, 0x12, 0x09, 0x06, 0x30, 0xfd, 0xf7, 0xfa, 0x60, 0x54, 0xe9, 0x10, 0xb1, 0x60, 0x48,
-	0x0c, 0xd7, 0xeb, 0x25, 0x72, 0x41, 0xaa, 0xd7, 0x82, 0xba, 0x98, 0x50, 0x0f, 0x03, 0x12, 0x2a,
-	0xcf, 0x2e, 0x75, 0xe0, 0xd9, 0xb3, 0xb1, 0xc7, 0xc1, 0xe2, 0x93, 0x9c, 0xc8, 0x7f, 0x18, 0x4c,
-	0x2e, 0xca, 0xe3, 0x49, 0xb8, 0xd8, 0x0f, 0x27, 0x8e, 0xa6, 0x4d, 0x18, 0x78, 0xc1, 0xb1, 0xa9,
-	0x27, 0x6d, 0xe1, 0x47, 0x34, 0x40, 0xee, 0x18, 0xf9, 0x0c, 0xc3, 0x8b, 0x02, 0x3d, 0x71, 0x53,
-	0x4f, 0xdd, 0xc4, 0x71, 0xf3, 0x66, 0x55, 0x1b, 0x52, 0xbe, 0x50, 0x76, 0xea, 0x55, 0x5c, 0x87,
-	0xc7, 0xd7, 0x60, 0x4a, 0x41, 0x91, 0x77, 0xb0, 0x9f, 0xd7, 0x00, 0x16, 0x0c, 0xdf, 0x10, 0x9e,
-	0x3f, 0x5d, 0x38, 0x97, 0x5e, 0x8c, 0x9d, 0xe4, 0xc3, 0xa9, 0x77, 0x8f, 0xfd, 0x9e, 0xf9, 0x42,
-	0x30, 0xe5, 0xa1, 0x86, 0x20, 0xb0, 0x57, 0xcd, 0x17, 0x28, 0x72, 0x38, 0x79, 0x18, 0x46, 0xa8,
-	0x5d, 0x73, 0x77, 0x5b, 0xec, 0x30, 0x13, 0x66, 0x25, 0xce, 0x15, 0x16, 0x83, 0x42, 0x8c, 0xe0,
-	0xfa, 0xeb, 0x21, 0xae, 0xe6, 0x75, 0xe1, 0xfb, 0xfe, 0x0f, 0x1a, 0x9c, 0x5f, 0x68, 0x1b, 0xd6,
-	0x5c, 0x8b, 0x7d, 0x1c, 0x86, 0xb5, 0xe4, 0x08, 0x17, 0x12, 0xa6, 0xfb, 0xbc, 0x16, 0x86, 0x03,
-	0xc1, 0x2a, 0xf9, 0x30, 0x3a, 0x60, 0xce, 0x18, 0xd6, 0x20, 0x06, 0x0c, 0x7b, 0x81, 0xa8, 0x5f,
-	0xea, 0x41, 0xd4, 0x0f, 0x48, 0x84, 0xa2, 0x7e, 0x88, 0x56, 0x18, 0xdc, 0xf8, 0xa6, 0xa8, 0x52,
-	0x77, 0xdb, 0xac, 0xd1, 0xb9, 0x5a, 0xcd, 0x69, 0xdb, 0xbe, 0x27, 0x03, 0x1d, 0x48, 0x83, 0x5b,
-	0x56, 0x0d, 0xcc, 0x69, 0xa9, 0x7f, 0x59, 0x83, 0xfe, 0xc5, 0x8d, 0xca, 0x02, 0xf9, 0x36, 0xe8,
-	0x0f, 0xd9, 0x54, 0x41, 0x4f, 0x31, 0x86, 0x47, 0xd8, 0x06, 0xc5, 0x7a, 0xaf, 0x32, 0x26, 0xc7,
-	0xb1, 0x92, 0x3b, 0x30, 0x48, 0xb7, 0x29, 0xeb, 0x6a, 0xe9, 0x48, 0xf0, 0x73, 0x36, 0xba, 0xc8,
-	0x31, 0xa2, 0xc4, 0xac, 0x7f, 0x42, 0x03, 0x88, 0xaa, 0x90, 0xef, 0xcc, 0x3a, 0x11, 0x6f, 0x1e,
-	0xa1, 0xc5, 0xbb, 0xf3, 0xb1, 0xa8, 0xff, 0xda, 0x00, 0x3c, 0xc0, 0xba, 0x23, 0xb7, 0xaa, 0xe9,
-	0xd8, 0x37, 0xe9, 0xee, 0x3f, 0xbd, 0xb2, 0xf8, 0xa7, 0x57, 0x16, 0x47, 0xf8, 0xca, 0xe2, 0x3d,
-	0xf0, 0x00, 0xdb, 0x72, 0x12, 0x4c, 0xb9, 0xcb, 0xd9, 0xba, 0x4b, 0x5b, 0x86, 0x4b, 0xeb, 0xfc,
-	0x50, 0x18, 0x16, 0x4e, 0x74, 0x73, 0x79, 0x95, 0x30, 0xbf, 0xbd, 0xfe, 0x04, 0x4c, 0x46, 0x7b,
-	0x77, 0xce, 0x47, 0xea, 0xf9, 0x8c, 0x1f, 0xc7, 0x15, 0xdb, 0x91, 0x40, 0x4a, 0x4b, 0x2b, 0xa3,
-	0x71, 0x04, 0xf2, 0xab, 0x3c, 0x14, 0x82, 0xfb, 0x1a, 0x4c, 0x2e, 0xee, 0xb4, 0x4c, 0x97, 0x87,
-	0xaf, 0x10, 0x87, 0x17, 0x79, 0x28, 0x7a, 0x0d, 0xa5, 0xc5, 0xaf, 0x1e, 0x93, 0x2f, 0xa2, 0xc8,
-	0x26, 0x4c, 0x50, 0xde, 0x9c, 0xab, 0xae, 0x86, 0x5f, 0xe4, 0xfb, 0x10, 0x31, 0x5b, 0x62, 0x58,
-	0x30, 0x81, 0x95, 0x54, 0x61, 0xa2, 0x66, 0x19, 0x9e, 0x67, 0x6e, 0x9a, 0xb5, 0xe8, 0x15, 0xdf,
-	0xc8, 0xfc, 0xc3, 0xfc, 0x60, 0x8d, 0x41, 0xee, 0xef, 0x95, 0xcf, 0xca, 0x7e, 0xc6, 0x01, 0x98,
-	0x40, 0xa1, 0x7f, 0xba, 0x04, 0xe3, 0x8b, 0x3b, 0x2d, 0xc7, 0x6b, 0xbb, 0x94, 0x57, 0x3d, 0x01,
-	0x63, 0xdc, 0x43, 0x30, 0xb4, 0x65, 0xd8, 0x75, 0x8b, 0xba, 0xf2, 0xdc, 0x0e, 0xe7, 0xf6, 0x86,
-	0x28, 0xc6, 0x00, 0x4e, 0x5e, 0x04, 0xf0, 0x6a, 0x5b, 0xb4, 0xde, 0xe6, 0xdc, 0xb5, 0xaf, 0x38,
-	0x77, 0x8d, 0x8d, 0xb1, 0x1a, 0xa2, 0x94, 0x72, 0x58, 0xf8, 0x1b, 0x15, 0x72, 0xfa, 0x9f, 0x6a,
-	0x30, 0x15, 0x6b, 0x77, 0x02, 0x36, 0xa6, 0xcd, 0xb8, 0x8d, 0x69, 0xae, 0xe7, 0xb1, 0xe6, 0x98,
-	0x96, 0x3e, 0x5a, 0x82, 0xf3, 0x39, 0x73, 0x92, 0xf2, 0x37, 0xd7, 0x4e, 0xc8, 0xdf, 0xbc, 0x0d,
-	0xa3, 0xbe, 0x63, 0xc9, 0xc7, 0xa6, 0xc1, 0x0c, 0x14, 0x3a, 0xc3, 0x37, 0x42, 0x34, 0x91, 0x37,
-	0x79, 0x54, 0xe6, 0xa1, 0x4a, 0x47, 0xff, 0x4d, 0x0d, 0x46, 0x42, 0x53, 0xf6, 0x4b, 0xca, 0x0b,
-	0xa0, 0xfb, 0x30, 0x53, 0xfa, 0xef, 0x95, 0xe0, 0x5c, 0x88, 0x3b, 0x60, 0x73, 0x55, 0x9f, 0xf1,
-	0x8d, 0x83, 0xed, 0x61, 0x17, 0x63, 0x2f, 0x61, 0x86, 0xd3, 0x0f, 0x43, 0x5b, 0x6d, 0xb7, 0xe5,
-	0x78, 0x81, 0x20, 0x2d, 0xb4, 0x1c, 0x51, 0x84, 0x01, 0x8c, 0xac, 0xc1, 0x80, 0xc7, 0xe8, 0xc9,
-	0xc3, 0xf2, 0x90, 0xb3, 0xc1, 0xf5, 0x0f, 0xde, 0x5f, 0x14, 0x68, 0xc8, 0x8b, 0x2a, 0x0f, 0x1f,
-	0x28, 0x6e, 0x71, 0x65, 0x23, 0xa9, 0x87, 0xa2, 0x74, 0x74, 0x5f, 0xd3, 0xc9, 0xc2, 0xa9, 0xaf,
-	0xc0, 0xa4, 0x74, 0xaa, 0x16, 0xdb, 0xc6, 0xae, 0x51, 0xf2, 0x96, 0xd8, 0xce, 0x78, 0x55, 0xc2,
-	0x0f, 0xe8, 0x4c, 0xb2, 0x7e, 0xb4, 0x63, 0x74, 0x0f, 0x86, 0xaf, 0xcb, 0x4e, 0x92, 0x19, 0x28,
-	0x99, 0xc1, 0x5a, 0x80, 0xc4, 0x51, 0x5a, 0x5e, 0xc0, 0x92, 0xd9, 0xc5, 0x8b, 0x24, 0xf5, 0x58,
-	0xea, 0xeb, 0x7c, 0x2c, 0xe9, 0x5f, 0x2f, 0xc1, 0x99, 0x80, 0x6a, 0x30, 0xc6, 0x05, 0x79, 0xc5,
-	0x7f, 0x80, 0x56, 0x75, 0xb0, 0x7d, 0xf4, 0x16, 0xf4, 0x73, 0x06, 0x58, 0xe8, 0xea, 0x3f, 0x44,
-	0xc8, 0xba, 0x83, 0x1c, 0x11, 0x79, 0x1f, 0x0c, 0x5a, 0x4c, 0x45, 0x09, 0x9e, 0x0a, 0x15, 0xb2,
-	0x26, 0x67, 0x0d, 0x57, 0x68, 0x3e, 0x32, 0xc2, 0x5f, 0x78, 0x7b, 0x2b, 0x0a, 0x51, 0xd2, 0x9c,
-	0x79, 0x2b, 0x8c, 0x2a, 0xd5, 0x0e, 0x15, 0xde, 0xef, 0xb3, 0x25, 0x98, 0xbe, 0x41, 0xad, 0x66,
-	0xa6, 0xbf, 0x46, 0x19, 0x06, 0x6a, 0x5b, 0x86, 0x2b, 0x22, 0x47, 0x8e, 0x89, 0x4d, 0x5e, 0x61,
-	0x05, 0x28, 0xca, 0x99, 0xc6, 0x12, 0x7b, 0x1c, 0xf9, 0x76, 0x65, 0x26, 0xa3, 0x90, 0xa2, 0xef,
-	0x0d, 0x63, 0x8e, 0x46, 0x03, 0x8f, 0x55, 0x60, 0xc7, 0xcb, 0x3b, 0xab, 0xb7, 0xd6, 0xb2, 0x9e,
-	0x45, 0x92, 0x17, 0x60, 0xdc, 0xa9, 0x99, 0x48, 0x5b, 0x8e, 0x67, 0xfa, 0x8e, 0xbb, 0x2b, 0x17,
-	0xad, 0xd0, 0xd1, 0x72, 0xab, 0xb2, 0x1c, 0x21, 0x12, 0x77, 0x9e, 0xb1, 0x22, 0x8c, 0x93, 0xd2,
-	0xbf, 0xa4, 0xc1, 0xe8, 0x0d, 0xf3, 0x0e, 0x75, 0x85, 0xdf, 0x38, 0x37, 0xeb, 0xc4, 0x62, 0x20,
-	0x8e, 0x66, 0xc5, 0x3f, 0x24, 0x3b, 0x30, 0x22, 0xcf, 0xe1, 0xf0, 0xd5, 0xeb, 0xf5, 0x62, 0x4e,
-	0x4e, 0x21, 0x69, 0x79, 0xbe, 0x29, 0x1f, 0x7e, 0x50, 0xe2, 0x61, 0x44, 0x4c, 0x7f, 0x11, 0x4e,
-	0x67, 0x34, 0x62, 0x0b, 0xc9, 0x5d, 0xa7, 0xe5, 0x47, 0x13, 0x70, 0x2b, 0xb6, 0x90, 0xbc, 0x9c,
-	0x3c, 0x00, 0x7d, 0xd4, 0xae, 0xcb, 0x2f, 0x66, 0x68, 0x7f, 0xaf, 0xdc, 0xb7, 0x68, 0xd7, 0x91,
-	0x95, 0x31, 0x26, 0x6e, 0x39, 0x31, 0x89, 0x8d, 0x33, 0xf1, 0x15, 0x59, 0x86, 0x21, 0x94, 0xbb,
-	0xa5, 0x25, 0x3d, 0xb0, 0x98, 0x6a, 0x32, 0xb9, 0x99, 0xe0, 0x2d, 0xbd, 0x38, 0x7e, 0x25, 0xf9,
-	0xd4, 0xfc, 0xb4, 0x9c, 0x90, 0x14, 0xc7, 0xc3, 0x14, 0x5d, 0xfd, 0x97, 0xfb, 0xe1, 0xd2, 0x0d,
-	0xc7, 0x35, 0x5f, 0x70, 0x6c, 0xdf, 0xb0, 0xd6, 0x9d, 0x7a, 0xe4, 0x94, 0x2b, 0x8f, 0xac, 0x0f,
-	0x6b, 0x70, 0xbe, 0xd6, 0x6a, 0x4b, 0x1f, 0x69, 0xe9, 0xd7, 0xba, 0x4e, 0x5d, 0xd3, 0x29, 0xfa,
-	0x50, 0x88, 0xbb, 0x93, 0x57, 0xd6, 0x6f, 0x67, 0xa1, 0xc4, 0x3c, 0x5a, 0xfc, 0xbd, 0x52, 0xdd,
-	0xb9, 0x67, 0xf3, 0xce, 0x55, 0x7d, 0x3e, 0x9b, 0x2f, 0x44, 0x8b, 0x50, 0xf0, 0xbd, 0xd2, 0x42,
-	0x26, 0x46, 0xcc, 0xa1, 0x44, 0x3e, 0x00, 0x67, 0xa5, 0x2f, 0x38, 0x52, 0xa3, 0x6e, 0xda, 0xd4,
-	0xf3, 0xc4, 0x63, 0x87, 0x1e, 0x1e, 0xe4, 0x2c, 0x67, 0x21, 0xc4, 0x6c, 0x3a, 0xe4, 0x59, 0x00,
-	0x6f, 0xd7, 0xae, 0xc9, 0xf9, 0x2f, 0xe6, 0x3d, 0x2b, 0x44, 0xe4, 0x10, 0x0b, 0x2a, 0x18, 0x99,
-	0xa2, 0xe5, 0x87, 0x9b, 0x72, 0x90, 0x7b, 0x40, 0x73, 0x45, 0x2b, 0xda, 0x43, 0x11, 0x5c, 0xff,
-	0x84, 0x06, 0x13, 0xcb, 0xf6, 0xba, 0x65, 0xd4, 0xa8, 0x70, 0x06, 0xf5, 0xc8, 0x35, 0x18, 0xf1,
-	0xc2, 0x6b, 0x24, 0xc1, 0x11, 0xa2, 0xef, 0x33, 0xbc, 0x40, 0x8a, 0xea, 0xf0, 0x57, 0x02, 0xa6,
-	0x2d, 0x0f, 0xbb, 0x25, 0xc7, 0x15, 0x88, 0xe4, 0x77, 0x27, 0x5e, 0x09, 0xa4, 0xc1, 0x98, 0xd5,
-	0x46, 0xff, 0x39, 0x0d, 0xce, 0xc4, 0xbb, 0x23, 0xfd, 0x30, 0x7e, 0x4c, 0x83, 0x33, 0xb1, 0xc7,
-	0xfc, 0x12, 0xdc, 0x4b, 0xf8, 0xb3, 0xf5, 0x0c, 0x7c, 0xc2, 0x2f, 0x39, 0x0b, 0x82, 0x99, 0xf4,
-	0xf5, 0xff, 0x5f, 0x83, 0x21, 0x19, 0x0a, 0x95, 0xbc, 0x26, 0x61, 0xf2, 0x0f, 0x8f, 0xb6, 0x84,
-	0xd9, 0x7f, 0x97, 0x3b, 0x95, 0xc8, 0xa3, 0x49, 0x9e, 0x32, 0x85, 0x6c, 0xc6, 0x92, 0x70, 0x74,
-	0xce, 0xc5, 0x9c, 0x4b, 0x82, 0xfb, 0x24, 0x85, 0x98, 0xfe, 0x39, 0x0d, 0xa6, 0x52, 0xad, 0xba,
-	0x10, 0x47, 0x4f, 0xd0, 0xcd, 0xf6, 0xab, 0xfd, 0x6c, 0x4b, 0xfa, 0x8c, 0xdf, 0x5b, 0xc2, 0x1a,
-	0x7f, 0x02, 0xfa, 0xef, 0xc3, 0x30, 0x62, 0x36, 0x9b, 0x6d, 0x9f, 0xdf, 0x7c, 0x0e, 0x44, 0xe6,
-	0xe6, 0xe5, 0xa0, 0x10, 0x23, 0x38, 0xb1, 0xa5, 0xa4, 0x25, 0x4e, 0xc1, 0x95, 0x62, 0x2b, 0xa7,
-	0x0e, 0x70, 0x96, 0x49, 0x45, 0x42, 0x1c, 0xca, 0x12, 0xc4, 0x3e, 0xa2, 0x01, 0x78, 0xbe, 0x6b,
-	0xda, 0x0d, 0x56, 0x28, 0xa5, 0x31, 0x3c, 0x02, 0xb2, 0xd5, 0x10, 0xa9, 0x20, 0x1e, 0x85, 0x47,
-	0x0d, 0x01, 0xa8, 0x50, 0x26, 0x73, 0x52, 0x08, 0x15, 0x47, 0xe6, 0xeb, 0x12, 0xe2, 0xf6, 0xa5,
-	0x0c, 0xf7, 0x5d, 0x41, 0x28, 0x92, 0x52, 0x67, 0x1e, 0x85, 0x91, 0x90, 0xde, 0x41, 0x42, 0xdd,
-	0x98, 0x22, 0xd4, 0xcd, 0x3c, 0x0e, 0xa7, 0x12, 0xdd, 0x3d, 0x94, 0x4c, 0xf8, 0x67, 0x1a, 0x90,
-	0xf8, 0xe8, 0x4f, 0xc0, 0x72, 0xd0, 0x88, 0x5b, 0x0e, 0xe6, 0x7b, 0x5f, 0xb2, 0x1c, 0xd3, 0xc1,
-	0xcf, 0x10, 0xe0, 0x91, 0xa2, 0xc3, 0xc8, 0xe9, 0xf2, 0xe4, 0x67, 0x82, 0x4a, 0x14, 0x81, 0x40,
-	0x7e, 0xb9, 0x3d, 0x08, 0x2a, 0x37, 0x13, 0xb8, 0x22, 0x41, 0x25, 0x09, 0xc1, 0x14, 0x5d, 0xf2,
-	0x31, 0x0d, 0x26, 0x8d, 0x78, 0xa4, 0xe8, 0x60, 0x66, 0x0a, 0x05, 0x9c, 0x4b, 0x44, 0x9d, 0x8e,
-	0xfa, 0x92, 0x00, 0x78, 0x98, 0x22, 0x4b, 0xde, 0x08, 0x63, 0x46, 0xcb, 0x9c, 0x6b, 0xd7, 0x4d,
-	0xa6, 0x79, 0x06, 0x01, 0x75, 0xb9, 0x35, 0x64, 0x6e, 0x7d, 0x39, 0x2c, 0xc7, 0x58, 0xad, 0x30,
-	0x24, 0x73, 0x25, 0xf2, 0x76, 0xee, 0x25, 0x24, 0xb3, 0x9c, 0xc3, 0x28, 0x24, 0xb3, 0x9c, 0x3a,
-	0x95, 0x08, 0xb1, 0x01, 0x1c, 0xb3, 0x5e, 0x93, 0x24, 0x07, 0x8b, 0x5f, 0xa2, 0xdc, 0x5a, 0x5e,
-	0xa8, 0x48, 0x8a, 0x5c, 0x7c, 0x88, 0x7e, 0xa3, 0x42, 0x81, 0x7c, 0x4a, 0x83, 0x71, 0xc9, 0xbb,
-	0x25, 0xcd, 0x21, 0xbe, 0x44, 0xcf, 0x14, 0xdd, 0x2f, 0x89, 0x3d, 0x39, 0x8b, 0x2a, 0x72, 0xc1,
-	0x77, 0xc2, 0x00, 0x16, 0x31, 0x18, 0xc6, 0xfb, 0xc1, 0x65, 0x00, 0x2f, 0x76, 0x8b, 0x25, 0x3b,
-	0x38, 0x5c, 0x5c, 0x06, 0xa8, 0x66, 0xe0, 0x93, 0x2f, 0xa3, 0x32, 0x20, 0x98, 0x49, 0x9f, 0xc9,
-	0xb5, 0xa7, 0xee, 0x19, 0x7e, 0x6d, 0xab, 0x62, 0xd4, 0xb6, 0xf8, 0x25, 0xa6, 0x78, 0xce, 0x5b,
-	0x70, 0x5f, 0x3f, 0x15, 0x47, 0x35, 0x7f, 0x7a, 0x7f, 0xaf, 0x7c, 0x2a, 0x51, 0x88, 0x49, 0x82,
-	0xc4, 0x81, 0x61, 0x57, 0xa6, 0x4b, 0x90, 0x11, 0x18, 0x8a, 0x65, 0x08, 0x48, 0xe6, 0x5e, 0x10,
-	0x9a, 0x51, 0xf0, 0x0b, 0x43, 0x22, 0xa4, 0x01, 0x97, 0x84, 0x6e, 0x38, 0x67, 0x3b, 0xf6, 0x6e,
-	0xd3, 0x69, 0x7b, 0x73, 0x6d, 0x7f, 0x8b, 0xda, 0x7e, 0x60, 0x0a, 0x1f, 0xe5, 0xc7, 0x28, 0x7f,
-	0xe9, 0xb7, 0xd8, 0xa9, 0x22, 0x76, 0xc6, 0x43, 0x9e, 0x86, 0x61, 0x7e, 0xd1, 0xb7, 0xb1, 0xb1,
-	0xc2, 0x5f, 0x06, 0x1f, 0x5e, 0x5c, 0xe6, 0x43, 0x58, 0x94, 0x38, 0x30, 0xc4, 0x46, 0xee, 0xc2,
-	0x90, 0x25, 0xf2, 0x5d, 0xf0, 0x17, 0xc2, 0x05, 0x99, 0x62, 0x32, 0x77, 0x86, 0x50, 0xa0, 0xe5,
-	0x0f, 0x0c, 0x28, 0x90, 0x16, 0x5c, 0xa9, 0xd3, 0x4d, 0xa3, 0x6d, 0xf9, 0x6b, 0x8e, 0x8f, 0xfc,
-	0x59, 0x5d, 0x68, 0xf1, 0x0c, 0x1e, 0x81, 0x4f, 0xf0, 0x18, 0x80, 0xfc, 0xc1, 0xe2, 0xc2, 0x01,
-	0x75, 0xf1, 0x40, 0x6c, 0x64, 0x17, 0x1e, 0x94, 0x75, 0xf8, 0x3b, 0xbe, 0xda, 0x16, 0x9b, 0xe5,
-	0x34, 0xd1, 0x53, 0x9c, 0xe8, 0x37, 0xed, 0xef, 0x95, 0x1f, 0x5c, 0x38, 0xb8, 0x3a, 0x76, 0x83,
-	0x93, 0x3f, 0x8d, 0xa2, 0x89, 0x2b, 0xa0, 0xe9, 0xc9, 0xe2, 0x73, 0x9c, 0xbc, 0x4e, 0x12, 0x3e,
-	0x7c, 0xc9, 0x52, 0x4c, 0xd1, 0x24, 0x5f, 0xd4, 0x60, 0xda, 0xf3, 0xdd, 0x76, 0xcd, 0x6f, 0xbb,
-	0xb4, 0x9e, 0xd8, 0xa1, 0x53, 0xbc, 0x43, 0x85, 0x04, 0xb8, 0x6a, 0x0e, 0x4e, 0x1e, 0x8e, 0x60,
-	0x3a, 0x0f, 0x8a, 0xb9, 0x7d, 0x21, 0x5f, 0xd0, 0xe0, 0x7c, 0x1c, 0xc8, 0x74, 0x7a, 0xd1, 0x4f,
-	0x52, 0xfc, 0x92, 0xa5, 0x9a, 0x8d, 0x52, 0x68, 0xf0, 0x39, 0x40, 0xcc, 0xeb, 0x48, 0xf2, 0x6a,
-	0xfd, 0xf4, 0x09, 0x5f, 0xad, 0xcf, 0xbc, 0x03, 0x48, 0xfa, 0xf8, 0x38, 0x48, 0x0e, 0x1c, 0x56,
-	0xe5, 0xc0, 0xcf, 0x0c, 0xc0, 0x05, 0x76, 0x2a, 0x45, 0xda, 0xcf, 0xaa, 0x61, 0x1b, 0x8d, 0x97,
-	0xa6, 0xc4, 0xf4, 0x25, 0x0d, 0xce, 0x6f, 0x65, 0x9b, 0x76, 0xa4, 0xfe, 0xf5, 0xae, 0x42, 0x26,
-	0xb8, 0x4e, 0xd6, 0x22, 0xc1, 0xb0, 0x3b, 0x56, 0xc1, 0xbc, 0x4e, 0x91, 0x77, 0xc0, 0xa4, 0xed,
-	0xd4, 0x69, 0x65, 0x79, 0x01, 0x57, 0x0d, 0xef, 0x6e, 0x35, 0xf0, 0xf4, 0x19, 0x10, 0xdf, 0xeb,
-	0x5a, 0x02, 0x86, 0xa9, 0xda, 0x64, 0x1b, 0x48, 0xcb, 0xa9, 0x2f, 0x6e, 0x8b, 0xbc, 0x30, 0xbd,
-	0xf9, 0xf9, 0x72, 0x6f, 0x83, 0xf5, 0x14, 0x36, 0xcc, 0xa0, 0xc0, 0x6d, 0x53, 0xac, 0x33, 0xab,
-	0x8e, 0x6d, 0xfa, 0x8e, 0xcb, 0x03, 0x6c, 0xf4, 0x64, 0xa2, 0xe1, 0xb6, 0xa9, 0xb5, 0x4c, 0x8c,
-	0x98, 0x43, 0x49, 0xff, 0x6f, 0x1a, 0x9c, 0x62, 0xdb, 0x62, 0xdd, 0x75, 0x76, 0x76, 0x5f, 0x8a,
-	0x1b, 0xf2, 0x21, 0xe9, 0x68, 0x29, 0x6c, 0x3b, 0x67, 0x15, 0x27, 0xcb, 0x11, 0xde, 0xe7, 0xc8,
-	0xaf, 0x52, 0x35, 0x2b, 0xf7, 0xe5, 0x9b, 0x95, 0xf5, 0x4f, 0x95, 0x84, 0xe6, 0x12, 0x98, 0x75,
-	0x5f, 0x92, 0xdf, 0xe1, 0xa3, 0x30, 0xce, 0xca, 0x56, 0x8d, 0x9d, 0xf5, 0x85, 0x27, 0x1d, 0x2b,
-	0x78, 0x42, 0xce, 0x6d, 0xed, 0x37, 0x55, 0x00, 0xc6, 0xeb, 0x91, 0xc7, 0x60, 0xa8, 0x25, 0xe2,
-	0xd4, 0x49, 0x9d, 0xf9, 0x8a, 0xf0, 0x46, 0xe4, 0x45, 0xf7, 0xf7, 0xca, 0x53, 0xd1, 0x15, 0x6f,
-	0x10, 0x2d, 0x2f, 0x68, 0xa0, 0xff, 0xf2, 0x39, 0xe0, 0xc8, 0x2d, 0xea, 0xbf, 0x14, 0xe7, 0xe4,
-	0xf5, 0x30, 0x5a, 0x6b, 0xb5, 0x2b, 0x4b, 0xd5, 0x77, 0xb5, 0x1d, 0x6e, 0x0b, 0xe1, 0xe9, 0x93,
-	0x18, 0xf7, 0xae, 0xac, 0xdf, 0x0e, 0x8a, 0x51, 0xad, 0xc3, 0xb8, 0x43, 0xad, 0xd5, 0x96, 0xfc,
-	0x76, 0x5d, 0x7d, 0x64, 0xc3, 0xb9, 0x43, 0x65, 0xfd, 0x76, 0x0c, 0x86, 0xa9, 0xda, 0xe4, 0x03,
-	0x30, 0x46, 0xe5, 0x87, 0x7b, 0xc3, 0x70, 0xeb, 0x92, 0x2f, 0x2c, 0x17, 0x1d, 0x7c, 0x38, 0xb5,
-	0x01, 0x37, 0x10, 0x1a, 0xe0, 0xa2, 0x42, 0x02, 0x63, 0x04, 0xc9, 0x7b, 0xe0, 0x81, 0xe0, 0x37,
-	0x5b, 0x65, 0xa7, 0x9e, 0x64, 0x14, 0x03, 0xc2, 0xef, 0x66, 0x31, 0xaf, 0x12, 0xe6, 0xb7, 0x27,
-	0x3f, 0xab, 0xc1, 0xb9, 0x10, 0x6a, 0xda, 0x66, 0xb3, 0xdd, 0x44, 0x5a, 0xb3, 0x0c, 0xb3, 0x29,
-	0xf5, 0xbe, 0xa7, 0x8e, 0x6c, 0xa0, 0x71, 0xf4, 0x82, 0x59, 0x65, 0xc3, 0x30, 0xa7, 0x4b, 0xe4,
-	0x73, 0x1a, 0x5c, 0x09, 0x40, 0xeb, 0x2e, 0xf5, 0xbc, 0xb6, 0x4b, 0xa3, 0x00, 0x06, 0x72, 0x4a,
-	0x86, 0x0a, 0xf1, 0x4e, 0x2e, 0x00, 0x2f, 0x1e, 0x80, 0x1b, 0x0f, 0xa4, 0xae, 0x6e, 0x97, 0xaa,
-	0xb3, 0xe9, 0x4b, 0x45, 0xf1, 0xb8, 0xb6, 0x0b, 0x23, 0x81, 0x31, 0x82, 0xe4, 0xe7, 0x34, 0x38,
-	0xaf, 0x16, 0xa8, 0xbb, 0x45, 0x68, 0x88, 0x4f, 0x1f, 0x59, 0x67, 0x12, 0xf8, 0x85, 0x84, 0x97,
-	0x03, 0xc4, 0xbc, 0x5e, 0x31, 0xb6, 0xdd, 0xe4, 0x1b, 0x53, 0x68, 0x91, 0x03, 0x82, 0x6d, 0x8b,
-	0xbd, 0xea, 0x61, 0x00, 0x23, 0x6f, 0x84, 0xb1, 0x96, 0x53, 0x5f, 0x37, 0xeb, 0x1e, 0x8f, 0xa1,
-	0xc7, 0x75, 0xbd, 0x3e, 0x31, 0x1d, 0xeb, 0x4e, 0x7d, 0x7d, 0x79, 0x41, 0x94, 0x63, 0xac, 0x16,
-	0x99, 0x05, 0xd8, 0x34, 0x4c, 0xab, 0x7a, 0xcf, 0x68, 0xdd, 0x0a, 0x82, 0x32, 0x71, 0x5b, 0xc4,
-	0x52, 0x58, 0x8a, 0x4a, 0x0d, 0xb6, 0x7e, 0x8c, 0xef, 0x20, 0x15, 0x61, 0xcf, 0xb9, 0x7a, 0x74,
-	0x14, 0xeb, 0x17, 0x20, 0x14, 0x1d, 0xbe, 0xa9, 0x90, 0xc0, 0x18, 0x41, 0xf2, 0x61, 0x0d, 0x26,
-	0xbc, 0x5d, 0xcf, 0xa7, 0xcd, 0xb0, 0x0f, 0xa7, 0x8e, 0xba, 0x0f, 0xdc, 0x26, 0x5e, 0x8d, 0x11,
-	0xc1, 0x04, 0x51, 0x1e, 0xde, 0xaa, 0x69, 0x34, 0xe8, 0xf5, 0xca, 0x0d, 0xb3, 0xb1, 0x15, 0x86,
-	0xa4, 0x59, 0xa7, 0x6e, 0x8d, 0xda, 0x3e, 0x57, 0xac, 0x06, 0x64, 0x78, 0xab, 0xfc, 0x6a, 0xd8,
-	0x09, 0x07, 0x79, 0x16, 0x66, 0x24, 0x78, 0xc5, 0xb9, 0x97, 0xa2, 0x20, 0xa2, 0x48, 0x71, 0x0f,
-	0xca, 0xe5, 0xdc, 0x5a, 0xd8, 0x01, 0x03, 0x59, 0x86, 0xd3, 0x1e, 0x75, 0xf9, 0x9d, 0xa0, 0x88,
-	0x48, 0xba, 0xde, 0xb6, 0x2c, 0x8f, 0xab, 0x36, 0xf2, 0x9d, 0x52, 0x35, 0x0d, 0xc6, 0xac, 0x36,
-	0xe4, 0xf1, 0xf0, 0x2d, 0xf3, 0x2e, 0x2b, 0x78, 0xd7, 0x7a, 0x95, 0x6b, 0x22, 0x03, 0xc2, 0x52,
-	0x82, 0x71, 0x10, 0x26, 0xeb, 0xb2, 0xd3, 0x3c, 0x28, 0x9a, 0x6f, 0xbb, 0x9e, 0xcf, 0x63, 0x3b,
-	0x0d, 0x88, 0xd3, 0x1c, 0x55, 0x00, 0xc6, 0xeb, 0x91, 0xc7, 0x60, 0xc2, 0xa3, 0xb5, 0x9a, 0xd3,
-	0x6c, 0x49, 0x3d, 0x99, 0x07, 0x60, 0x1a, 0x96, 0x2b, 0x18, 0x83, 0x60, 0xa2, 0x26, 0xd9, 0x85,
-	0xd3, 0x61, 0x08, 0xe7, 0x15, 0xa7, 0xb1, 0x6a, 0xec, 0x70, 0xe1, 0xf8, 0x5c, 0x91, 0xb8, 0x14,
-	0x62, 0xba, 0x2a, 0x69, 0x74, 0x98, 0x45, 0x83, 0xac, 0xc0, 0x99, 0x44, 0xf1, 0x92, 0x69, 0x51,
-	0x6f, 0xfa, 0x3c, 0x1f, 0x36, 0x37, 0x76, 0x55, 0x32, 0xe0, 0x98, 0xd9, 0x8a, 0xdc, 0x82, 0xb3,
-	0x2d, 0xd7, 0xf1, 0x69, 0xcd, 0xbf, 0xc9, 0x04, 0x02, 0x4b, 0x0e, 0xd0, 0x9b, 0x9e, 0xe6, 0x73,
-	0xc1, 0xef, 0x43, 0xd7, 0xb3, 0x2a, 0x60, 0x76, 0x3b, 0xf2, 0x19, 0x0d, 0x2e, 0x7b, 0xbe, 0x4b,
-	0x8d, 0xa6, 0x69, 0x37, 0x2a, 0x8e, 0x6d, 0x53, 0xce, 0x98, 0x96, 0xeb, 0xd1, 0x33, 0xbf, 0x07,
-	0x0a, 0x9d, 0x22, 0xfa, 0xfe, 0x5e, 0xf9, 0x72, 0xb5, 0x23, 0x66, 0x3c, 0x80, 0x32, 0x79, 0x11,
-	0xa0, 0x49, 0x9b, 0x8e, 0xbb, 0xcb, 0x38, 0xd2, 0xf4, 0x4c, 0x71, 0x7d, 0x77, 0x35, 0xc4, 0x22,
-	0x3e, 0xff, 0xd8, 0x4d, 0x6e, 0x04, 0x44, 0x85, 0x1c, 0x9b, 0x6a, 0xc6, 0x6f, 0x65, 0x14, 0x35,
-	0xe5, 0xa3, 0xb9, 0xc0, 0x57, 0x8e, 0x4f, 0xf5, 0x6a, 0x56, 0x05, 0xcc, 0x6e, 0x47, 0x3c, 0x98,
-	0xe2, 0x5f, 0xa8, 0x3c, 0xc9, 0xaf, 0x57, 0xe6, 0x1a, 0x74, 0xfa, 0x62, 0xa1, 0xc9, 0x65, 0xb2,
-	0xff, 0xd4, 0x72, 0x12, 0x19, 0xa6, 0xf1, 0x47, 0x44, 0x8d, 0x9d, 0x88, 0xe8, 0xa5, 0x5e, 0x89,
-	0x2a, 0xc8, 0x30, 0x8d, 0x5f, 0xdf, 0x2b, 0xc1, 0xd9, 0xcc, 0x53, 0x92, 0x31, 0x0f, 0x31, 0xc5,
-	0x73, 0x41, 0x7a, 0x3c, 0x79, 0xed, 0xc9, 0x99, 0xc7, 0x6a, 0x1c, 0x84, 0xc9, 0xba, 0x4c, 0x86,
-	0xe5, 0xd4, 0x96, 0xaa, 0x51, 0xfb, 0x52, 0x24, 0xc3, 0x2e, 0x27, 0x60, 0x98, 0xaa, 0x4d, 0x2a,
-	0x72, 0x3e, 0x96, 0xaa, 0xcb, 0x4c, 0x0d, 0xf4, 0x96, 0x5c, 0x1a, 0x68, 0x07, 0xd1, 0xf8, 0x54,
-	0x20, 0xa6, 0xeb, 0xb3, 0x51, 0xb0, 0x1f, 0x6a, 0x2f, 0xfa, 0xa3, 0x51, 0xac, 0xc5, 0x41, 0x98,
-	0xac, 0x1b, 0xe8, 0xe9, 0xb1, 0x2e, 0x0c, 0x44, 0xa3, 0x58, 0x4b, 0xc0, 0x30, 0x55, 0x5b, 0xff,
-	0xf3, 0x7e, 0x78, 0xb0, 0x0b, 0xc9, 0x92, 0x34, 0xb3, 0xa7, 0xfb, 0xf0, 0x3c, 0xaf, 0xbb, 0xe5,
-	0x69, 0xe5, 0x2c, 0xcf, 0xe1, 0xe9, 0x75, 0xbb, 0x9c, 0x5e, 0xde, 0x72, 0x1e, 0x9e, 0x64, 0xf7,
-	0xcb, 0xdf, 0xcc, 0x5e, 0xfe, 0x82, 0xb3, 0x7a, 0xe0, 0x76, 0x69, 0xe5, 0x6c, 0x97, 0x82, 0xb3,
-	0xda, 0xc5, 0xf6, 0xfa, 0x8b, 0x7e, 0x78, 0x55, 0x37, 0x52, 0x6e, 0xc1, 0xfd, 0x95, 0xc1, 0x5b,
-	0x8e, 0x75, 0x7f, 0xe5, 0x3d, 0x42, 0x3f, 0xc6, 0xfd, 0xd5, 0x91, 0x7d, 0x1e, 0xcf, 0xfe, 0xca,
-	0x9b, 0xd5, 0xe3, 0xda, 0x5f, 0x79, 0xb3, 0xda, 0xc5, 0xfe, 0xfa, 0xdb, 0xe4, 0xf9, 0x10, 0x8a,
-	0xda, 0xcb, 0xd0, 0x57, 0x6b, 0xb5, 0x0b, 0x32, 0x29, 0xee, 0x65, 0x58, 0x59, 0xbf, 0x8d, 0x0c,
-	0x07, 0x41, 0x18, 0x14, 0xfb, 0xa7, 0x20, 0x0b, 0xe2, 0x9e, 0xa3, 0x62, 0x4b, 0xa2, 0xc4, 0xc4,
-	0xa6, 0x8a, 0xb6, 0xb6, 0x68, 0x93, 0xba, 0x86, 0x55, 0xf5, 0x1d, 0xd7, 0x68, 0x14, 0xe5, 0x36,
-	0xe2, 0x06, 0x25, 0x81, 0x0b, 0x53, 0xd8, 0xd9, 0x84, 0xb4, 0xcc, 0x7a, 0x41, 0xfe, 0xc2, 0x27,
-	0x64, 0x7d, 0x79, 0x01, 0x19, 0x0e, 0xfd, 0xd7, 0x86, 0x41, 0x49, 0x8e, 0x40, 0x3e, 0xae, 0xc1,
-	0x54, 0x2d, 0x19, 0x48, 0xb4, 0x17, 0x7f, 0xa8, 0x54, 0x54, 0x52, 0xb1, 0xe5, 0x53, 0xc5, 0x98,
-	0x26, 0x4b, 0xbe, 0x4b, 0x13, 0x46, 0xbe, 0xf0, 0x36, 0x4f, 0x4e, 0xeb, 0xf5, 0x23, 0xba, 0xf7,
-	0x8e, 0xac, 0x85, 0xd1, 0x15, 0x6b, 0x9c, 0x20, 0xf9, 0x9c, 0x06, 0x67, 0xef, 0x66, 0xdd, 0x4d,
-	0xc8, 0xc9, 0xbf, 0x55, 0xb4, 0x2b, 0x39, 0x97, 0x1d, 0x42, 0x82, 0xcc, 0xac, 0x80, 0xd9, 0x1d,
-	0x09, 0x67, 0x29, 0x34, 0xd7, 0xca, 0xef, 0xb4, 0xf0, 0x2c, 0x25, 0xec, 0xbe, 0xd1, 0x2c, 0x85,
-	0x00, 0x8c, 0x13, 0x24, 0x2d, 0x18, 0xb9, 0x1b, 0xd8, 0xc8, 0xa5, 0x5d, 0xac, 0x52, 0x94, 0xba,
-	0x62, 0x68, 0x17, 0xfe, 0x5e, 0x61, 0x21, 0x46, 0x44, 0xc8, 0x16, 0x0c, 0xdd, 0x15, 0xbc, 0x42,
-	0xda, 0xb3, 0xe6, 0x7a, 0xd6, 0xfe, 0x85, 0x59, 0x45, 0x16, 0x61, 0x80, 0x5e, 0x7d, 0x4b, 0x30,
-	0x7c, 0xc0, 0x13, 0xb7, 0xcf, 0x68, 0x70, 0x76, 0x9b, 0xba, 0xbe, 0x59, 0x4b, 0xde, 0x0c, 0x8d,
-	0x14, 0xb7, 0x50, 0x3c, 0x99, 0x85, 0x50, 0x6c, 0x93, 0x4c, 0x10, 0x66, 0x77, 0x81, 0x3c, 0x09,
-	0xfd, 0xd4, 0xaf, 0xd5, 0x65, 0x00, 0xf1, 0xb7, 0x14, 0x7d, 0xf3, 0x2b, 0xde, 0xbd, 0xb0, 0xff,
-	0x90, 0xe3, 0xd3, 0xff, 0x5a, 0x83, 0x94, 0x6d, 0x9a, 0xfc, 0xa0, 0x06, 0x63, 0x9b, 0xd4, 0xf0,
-	0xdb, 0x2e, 0xbd, 0x2e, 0x7d, 0x42, 0xfb, 0xae, 0x8e, 0x3e, 0xf2, 0xe4, 0x51, 0x98, 0xc4, 0x67,
-	0x97, 0x14, 0xc4, 0xc2, 0x59, 0x25, 0x4c, 0x78, 0xa2, 0x82, 0x30, 0xd6, 0x83, 0x99, 0x27, 0x60,
-	0x2a, 0xd5, 0xf0, 0x50, 0xd7, 0x94, 0xbf, 0xaa, 0x41, 0x56, 0xea, 0x7f, 0xf2, 0x2c, 0x0c, 0x18,
-	0xf5, 0x7a, 0x98, 0xb2, 0xf5, 0xad, 0xc5, 0xfc, 0xa6, 0xea, 0x6a, 0x78, 0x23, 0xfe, 0x13, 0x05,
-	0x5a, 0xb2, 0x04, 0xc4, 0x88, 0xdd, 0x4b, 0xaf, 0x46, 0x71, 0x35, 0xf8, 0x75, 0xda, 0x5c, 0x0a,
-	0x8a, 0x19, 0x2d, 0xf4, 0x8f, 0x6a, 0x40, 0xd2, 0x29, 0x72, 0x88, 0x0b, 0xc3, 0x72, 0xff, 0x06,
-	0xab, 0xb4, 0x50, 0xf0, 0x35, 0x5d, 0xec, 0x69, 0x68, 0xe4, 0x84, 0x27, 0x0b, 0x3c, 0x0c, 0xe9,
-	0xe8, 0x7f, 0xaf, 0x41, 0x94, 0x86, 0x91, 0xbc, 0x09, 0x46, 0xeb, 0xd4, 0xab, 0xb9, 0x66, 0xcb,
-	0x8f, 0x1e, 0x92, 0x86, 0x0f, 0xd2, 0x16, 0x22, 0x10, 0xaa, 0xf5, 0x88, 0x0e, 0x83, 0xbe, 0xe1,
-	0xdd, 0x5d, 0x5e, 0x90, 0xca, 0x1e, 0x3f, 0x9a, 0x37, 0x78, 0x09, 0x4a, 0x48, 0x14, 0xef, 0xb6,
-	0xaf, 0x8b, 0x78, 0xb7, 0x64, 0xf3, 0x08, 0x82, 0xfb, 0x92, 0x83, 0x03, 0xfb, 0xea, 0x3f, 0x5d,
-	0x82, 0x53, 0xac, 0xca, 0xaa, 0x61, 0xda, 0x3e, 0xb5, 0xf9, 0xb3, 0xa9, 0x82, 0x93, 0xd0, 0x80,
-	0x71, 0x3f, 0xf6, 0xea, 0xf9, 0xf0, 0x8f, 0x6a, 0x43, 0x4f, 0xaf, 0xf8, 0x5b, 0xe7, 0x38, 0x5e,
-	0xf2, 0xd6, 0xe0, 0xdd, 0x9a, 0x50, 0x8b, 0x1f, 0x0c, 0xb6, 0x2a, 0x7f, 0x8c, 0x76, 0x5f, 0x3e,
-	0x21, 0x0f, 0x73, 0x77, 0xc6, 0x9e, 0xa8, 0x3d, 0x0a, 0xe3, 0xf2, 0x85, 0x84, 0x08, 0x5c, 0x2c,
-	0xd5, 0x62, 0x7e, 0xac, 0x2c, 0xa9, 0x00, 0x8c, 0xd7, 0xd3, 0xff, 0xb0, 0x04, 0xf1, 0x0c, 0xa1,
-	0x45, 0x67, 0x29, 0x1d, 0xb5, 0xb9, 0x74, 0x6c, 0x51, 0x9b, 0x45, 0x0a, 0x7f, 0xee, 0xce, 0x2d,
-	0xef, 0xd9, 0xd5, 0xf4, 0xdd, 0xbc, 0x1c, 0xc3, 0x1a, 0xd1, 0xb4, 0xf6, 0x1f, 0x7a, 0x5a, 0xdf,
-	0x24, 0x3d, 0x7f, 0x07, 0x62, 0xb1, 0xb3, 0x03, 0xcf, 0xdf, 0xa9, 0x58, 0x43, 0xe5, 0x95, 0xdd,
-	0x1c, 0xc8, 0x1c, 0x3b, 0x6c, 0x5d, 0x64, 0x5c, 0x6f, 0x6f, 0xc3, 0xf1, 0x0d, 0x8b, 0x4f, 0xa7,
-	0x34, 0xba, 0xae, 0xaa, 0x00, 0x8c, 0xd7, 0xd3, 0xd7, 0xe0, 0x95, 0x2b, 0x8e, 0x51, 0x9f, 0x37,
-	0x2c, 0xb6, 0x75, 0x5d, 0xe9, 0x96, 0xe7, 0xf1, 0x93, 0x79, 0xdd, 0x75, 0x7c, 0xa7, 0xe6, 0x58,
-	0xec, 0xdc, 0x34, 0xc2, 0x00, 0xc7, 0xfc, 0x22, 0x32, 0x38, 0x37, 0x65, 0x80, 0x60, 0x0c, 0xe0,
-	0xfa, 0xef, 0x68, 0x30, 0x24, 0xf3, 0xee, 0x74, 0xf1, 0xb0, 0x74, 0x13, 0x06, 0xb8, 0x76, 0xd4,
-	0x8b, 0x54, 0xca, 0xf3, 0xdf, 0xc6, 0x12, 0x76, 0x89, 0x94, 0x77, 0x3c, 0x49, 0xa9, 0x40, 0xcf,
-	0xfd, 0x51, 0xdd, 0xda, 0x96, 0xe9, 0x53, 0xee, 0x76, 0x23, 0x37, 0xbe, 0xf0, 0x47, 0x55, 0xca,
-	0x31, 0x56, 0x4b, 0xff, 0xaf, 0x03, 0x70, 0x25, 0xc8, 0x21, 0x94, 0x14, 0xd5, 0x42, 0x9e, 0xbb,
-	0x1b, 0x26, 0x35, 0xe2, 0x49, 0x19, 0x02, 0x9b, 0x6a, 0x31, 0x2d, 0xf9, 0xbc, 0x92, 0xb6, 0x48,
-	0x45, 0x87, 0x59, 0x34, 0x44, 0xc8, 0x78, 0x5e, 0x7c, 0x83, 0x1a, 0x96, 0xbf, 0x15, 0xd0, 0x2e,
-	0xf5, 0x12, 0x32, 0x3e, 0x8d, 0x0f, 0x33, 0xa9, 0x70, 0x97, 0x8e, 0x20, 0x99, 0x92, 0x4b, 0x0d,
-	0xd5, 0x9f, 0xa4, 0x87, 0xe7, 0x46, 0xab, 0x99, 0x18, 0x31, 0x87, 0x12, 0x37, 0x37, 0x1a, 0x3b,
-	0xdc, 0x7a, 0x81, 0xd4, 0x77, 0x4d, 0x9e, 0x78, 0x2d, 0xbc, 0xab, 0x58, 0x8d, 0x83, 0x30, 0x59,
-	0x97, 0x3c, 0x06, 0x13, 0xdc, 0x45, 0x26, 0x8a, 0x41, 0x3a, 0x10, 0x45, 0xa2, 0x5a, 0x8b, 0x41,
-	0x30, 0x51, 0x93, 0x7c, 0xb7, 0x06, 0x67, 0x4c, 0xf5, 0x31, 0x4d, 0x30, 0xfa, 0xc1, 0x5e, 0x72,
-	0x59, 0x88, 0x6d, 0x9c, 0x81, 0x16, 0x33, 0x89, 0x91, 0x15, 0x38, 0x23, 0x5f, 0x44, 0xc7, 0xf7,
-	0x80, 0x08, 0x50, 0xc7, 0xd7, 0x74, 0x21, 0x03, 0x8e, 0x99, 0xad, 0xf4, 0x0f, 0x96, 0x60, 0xec,
-	0x90, 0x49, 0x74, 0xdb, 0x8a, 0xcc, 0xd1, 0xc3, 0xbb, 0x45, 0x95, 0x6a, 0x17, 0x62, 0x07, 0x79,
-	0x1a, 0x26, 0xda, 0x7c, 0x22, 0x82, 0xf0, 0x6d, 0xf2, 0x9b, 0xfe, 0x16, 0xb6, 0x72, 0xb7, 0x63,
-	0x90, 0xfb, 0x7b, 0xe5, 0x19, 0x15, 0x7d, 0x1c, 0x8a, 0x09, 0x3c, 0xfa, 0x6f, 0x94, 0x80, 0xa8,
-	0xd5, 0x97, 0x2c, 0x63, 0xdb, 0x71, 0xc9, 0x17, 0x34, 0x18, 0xab, 0xa9, 0x69, 0xcb, 0x84, 0x80,
-	0xf5, 0x74, 0xaf, 0x83, 0x15, 0xe8, 0x67, 0xd5, 0x7c, 0x64, 0x42, 0x10, 0x0e, 0x9e, 0x5b, 0x8f,
-	0xa9, 0xa0, 0xfb, 0x89, 0xdf, 0x18, 0xeb, 0xd3, 0xcc, 0x87, 0x35, 0x98, 0x4a, 0x61, 0xca, 0x90,
-	0x8c, 0x9f, 0x89, 0x07, 0x6e, 0x3f, 0x92, 0x04, 0xa5, 0xaa, 0x7c, 0xfd, 0x93, 0x03, 0x70, 0x3a,
-	0x63, 0x45, 0xb9, 0x8b, 0x0d, 0x4d, 0x48, 0x97, 0xbd, 0xb8, 0xd8, 0xa4, 0x24, 0xd5, 0xd0, 0xc5,
-	0x26, 0x09, 0xc1, 0x14, 0x5d, 0xf2, 0x24, 0xf4, 0xd5, 0x5c, 0x53, 0x6e, 0xda, 0x47, 0x0b, 0x4d,
-	0x01, 0x2e, 0xcf, 0x8f, 0x4a, 0x8a, 0x7d, 0x15, 0x5c, 0x46, 0x86, 0x90, 0x9d, 0xc5, 0xea, 0x31,
-	0x12, 0x08, 0xac, 0xfc, 0x2c, 0x56, 0x4f, 0x1b, 0x0f, 0xe3, 0xf5, 0xc8, 0xd3, 0x30, 0x2d, 0x35,
-	0xd5, 0x20, 0x92, 0x89, 0x63, 0x7b, 0x3e, 0xe3, 0xf8, 0xbe, 0x94, 0x29, 0xb8, 0xf7, 0xeb, 0xcd,
-	0x9c, 0x3a, 0x98, 0xdb, 0x9a, 0x7c, 0x27, 0x4c, 0xc4, 0xb8, 0x47, 0x10, 0x4a, 0xad, 0xe0, 0x93,
-	0x19, 0x15, 0x93, 0xe0, 0x95, 0xf1, 0x32, 0x4c, 0x50, 0x23, 0xff, 0x97, 0x06, 0x53, 0xe1, 0x46,
-	0xdd, 0x15, 0x5b, 0x3e, 0xc8, 0x20, 0xb9, 0x74, 0x34, 0x5f, 0x90, 0x92, 0xde, 0x37, 0x49, 0x08,
-	0xd3, 0xb4, 0xf5, 0x1f, 0x19, 0x04, 0x35, 0xa3, 0x38, 0x59, 0xed, 0xc5, 0x2e, 0x19, 0xed, 0x81,
-	0xc0, 0x36, 0xb9, 0x0a, 0x7d, 0x8d, 0x56, 0xbb, 0xa0, 0x61, 0x32, 0x44, 0x77, 0x9d, 0xa1, 0x6b,
-	0xb4, 0xda, 0xe4, 0xc9, 0xd0, 0xd4, 0x59, 0xcc, 0x18, 0x19, 0x3e, 0x95, 0x4c, 0x98, 0x3b, 0x03,
-	0xf6, 0xde, 0x9f, 0xcb, 0xde, 0x9b, 0x30, 0xe4, 0x49, 0x3b, 0xe8, 0x40, 0xf1, 0xd8, 0x97, 0xca,
-	0x4c, 0x4b, 0xbb, 0xa7, 0xb0, 0xd0, 0x04, 0x66, 0xd1, 0x80, 0x06, 0x53, 0x04, 0xdb, 0xfc, 0x5c,
-	0x92, 0x51, 0x96, 0xb8, 0x22, 0x78, 0x9b, 0x97, 0xa0, 0x84, 0xa4, 0x84, 0xb9, 0xa1, 0x6e, 0x84,
-	0x39, 0xf2, 0xd9, 0x24, 0xff, 0x1e, 0xe6, 0xbb, 0xef, 0x5d, 0x3d, 0x0e, 0xe7, 0xff, 0x30, 0xc6,
-	0xfd, 0xbd, 0xd1, 0xe1, 0xa7, 0x2c, 0x16, 0x79, 0x10, 0x06, 0x78, 0x14, 0x25, 0x29, 0x07, 0x84,
-	0xc6, 0x0d, 0x1e, 0x47, 0x07, 0x05, 0x8c, 0x54, 0x65, 0xec, 0xc1, 0x62, 0x9b, 0x9e, 0xfb, 0x36,
-	0x4a, 0x7a, 0x4a, 0xa0, 0xc2, 0x2b, 0xb1, 0x37, 0x91, 0x59, 0x3a, 0xc4, 0x6d, 0x18, 0x6a, 0x9a,
-	0x36, 0x77, 0xf7, 0x28, 0x66, 0x44, 0x17, 0x2e, 0x58, 0x02, 0x05, 0x06, 0xb8, 0xf4, 0xaf, 0xf5,
-	0x31, 0x06, 0x11, 0x29, 0xf5, 0xbb, 0x22, 0x7a, 0xaf, 0x7c, 0x7d, 0xad, 0x15, 0x37, 0x02, 0x2a,
-	0x48, 0xe7, 0x42, 0x84, 0xc2, 0x51, 0x21, 0xfa, 0x8d, 0x0a, 0x31, 0x46, 0xda, 0x37, 0x9b, 0xf4,
-	0x29, 0xd3, 0xae, 0x3b, 0xf7, 0xe4, 0xf4, 0xf6, 0x4a, 0x7a, 0x23, 0x44, 0x28, 0x48, 0x47, 0xbf,
-	0x51, 0x21, 0xc6, 0x8e, 0x24, 0x9e, 0x41, 0xc4, 0xe6, 0x99, 0xa0, 0x65, 0xdf, 0x44, 0x9e, 0x77,
-	0xe9, 0x77, 0xcc, 0x8f, 0xa4, 0x4a, 0x4e, 0x1d, 0xcc, 0x6d, 0x4d, 0x3e, 0xa8, 0xc1, 0x18, 0x1b,
-	0x63, 0x10, 0x84, 0x4f, 0x2e, 0xde, 0xcd, 0x23, 0x98, 0xd2, 0x00, 0xa5, 0xe4, 0x09, 0x4a, 0x09,
-	0xc6, 0x48, 0xea, 0x3f, 0xad, 0xc1, 0xf9, 0x9c, 0xb6, 0xe4, 0x63, 0x1a, 0x8c, 0x2a, 0xb1, 0x3a,
-	0xe5, 0x8a, 0x3f, 0xd9, 0x63, 0xf7, 0x94, 0xe8, 0x83, 0xb1, 0x9e, 0x0a, 0xcf, 0x5e, 0x25, 0x34,
-	0xa1, 0x4a, 0x5b, 0xff, 0x59, 0x0d, 0xce, 0x66, 0x6e, 0x1b, 0x72, 0x1d, 0xa6, 0x22, 0xd7, 0x61,
-	0x55, 0xa0, 0x1a, 0x8e, 0xce, 0xc3, 0x9b, 0xc9, 0x0a, 0x98, 0x6e, 0x23, 0x72, 0x11, 0xa6, 0x04,
-	0x36, 0xe9, 0x77, 0xac, 0xaa, 0xa5, 0x2a, 0x18, 0xb3, 0xda, 0xe8, 0xbf, 0xd1, 0x07, 0xfa, 0xc1,
-	0x43, 0x26, 0x1f, 0xd1, 0x60, 0xdc, 0xb9, 0xe3, 0x51, 0x77, 0x3b, 0x9e, 0xdb, 0x69, 0xb5, 0xc7,
-	0x29, 0x0e, 0x08, 0xa8, 0x37, 0x1e, 0xb7, 0x54, 0x3a, 0x18, 0x27, 0x4b, 0xde, 0x0f, 0xe0, 0x79,
-	0x5b, 0x37, 0xe9, 0x6e, 0xcb, 0x30, 0x83, 0xfb, 0xb1, 0x23, 0xee, 0x84, 0x08, 0x28, 0x51, 0xbd,
-	0x21, 0x89, 0xa0, 0x42, 0x90, 0x7c, 0x52, 0x83, 0x29, 0xea, 0xd7, 0xea, 0xb1, 0x78, 0x96, 0xf2,
-	0x9c, 0x3f, 0xe2, 0x6e, 0xf0, 0xeb, 0xba, 0x74, 0xec, 0xcc, 0x34, 0x79, 0xfd, 0x7b, 0x35, 0x78,
-	0x20, 0x17, 0x0f, 0x79, 0x0e, 0x26, 0xdc, 0x20, 0x76, 0x66, 0x2f, 0x71, 0x4e, 0xb8, 0xe8, 0x88,
-	0x31, 0x4c, 0x98, 0xc0, 0xac, 0xbf, 0x27, 0xb6, 0xf5, 0x23, 0x36, 0xc5, 0xce, 0xa4, 0x3b, 0xb4,
-	0x11, 0x46, 0x83, 0x08, 0xcf, 0xa4, 0x79, 0x56, 0x88, 0x02, 0x46, 0x2e, 0xa9, 0x41, 0x6a, 0x42,
-	0xb9, 0x2a, 0x08, 0x54, 0xa3, 0xff, 0x2a, 0x67, 0x00, 0x76, 0xdb, 0xb0, 0x44, 0xd8, 0x89, 0x75,
-	0xc7, 0xb1, 0x02, 0x06, 0xf5, 0x79, 0x0d, 0xce, 0xc5, 0x62, 0x52, 0x78, 0x12, 0x12, 0xa8, 0x7e,
-	0xcb, 0x3d, 0x47, 0xc5, 0x08, 0x30, 0xce, 0x5f, 0x96, 0x3d, 0x3b, 0x97, 0x09, 0xf6, 0x30, 0xa7,
-	0x23, 0xfa, 0x7b, 0xe1, 0x7c, 0x8e, 0xe7, 0x1b, 0x59, 0x80, 0x31, 0xef, 0x9e, 0xd1, 0x9a, 0xa7,
-	0x5b, 0xc6, 0xb6, 0x29, 0x43, 0xda, 0x89, 0x07, 0x12, 0x63, 0x55, 0xa5, 0xfc, 0x7e, 0xe2, 0x37,
-	0xc6, 0x5a, 0xe9, 0x3e, 0x80, 0x7c, 0x48, 0x63, 0xda, 0x0d, 0xb2, 0x09, 0xc3, 0x86, 0x45, 0x5d,
-	0x3f, 0x8a, 0xfb, 0xfa, 0xad, 0x85, 0x6e, 0x48, 0x24, 0x0e, 0xf1, 0x70, 0x34, 0xf8, 0x85, 0x21,
-	0x6e, 0xfd, 0xff, 0xd5, 0xe0, 0x5c, 0x76, 0x10, 0xb3, 0x2e, 0x0c, 0x12, 0x4d, 0x18, 0x75, 0xa3,
-	0x66, 0xf2, 0x7b, 0x2e, 0x1a, 0xc2, 0x39, 0xb4, 0x47, 0x2b, 0x3d, 0x41, 0x15, 0x3f, 0xcf, 0x37,
-	0xc5, 0xa8, 0x7b, 0x2d, 0xa3, 0x46, 0xeb, 0x15, 0xcb, 0x69, 0xd7, 0xe5, 0x5b, 0x93, 0x97, 0x47,
-	0x92, 0x97, 0xec, 0xbe, 0x1f, 0x6f, 0xbe, 0xa9, 0x1c, 0x9a, 0x07, 0xe7, 0x9b, 0xca, 0x6e, 0xf8,
-	0x32, 0x49, 0x84, 0x92, 0xdd, 0xf9, 0x9c, 0x90, 0x13, 0xff, 0x79, 0x30, 0x6f, 0xb4, 0x3c, 0x11,
-	0xca, 0x55, 0x18, 0xae, 0x19, 0xf3, 0x6d, 0xbb, 0x1e, 0xfa, 0x57, 0xf2, 0x6f, 0xa9, 0x32, 0x27,
-	0xca, 0x30, 0x84, 0x92, 0x6d, 0x80, 0xe8, 0xb4, 0x97, 0x1b, 0x65, 0xa9, 0xb7, 0xbb, 0xdb, 0xc0,
-	0xf6, 0x2d, 0x8e, 0xb5, 0xa8, 0x1c, 0x15, 0x4a, 0xe4, 0xfd, 0x30, 0xae, 0x0a, 0x07, 0xc2, 0x0a,
-	0x52, 0x30, 0xa6, 0x66, 0xcc, 0xba, 0x1f, 0xde, 0x71, 0xa9, 0xa5, 0x1e, 0xc6, 0xa9, 0x91, 0x5d,
-	0x18, 0x6b, 0x46, 0x7a, 0x4c, 0x10, 0x63, 0xef, 0x89, 0x1e, 0xb5, 0xbd, 0xe8, 0x76, 0x5a, 0x29,
-	0xf4, 0x30, 0x46, 0x8a, 0xb4, 0x61, 0x74, 0x9b, 0xc7, 0x5b, 0x17, 0x94, 0x07, 0x8b, 0x07, 0xf5,
-	0x7c, 0x32, 0x44, 0x13, 0x31, 0xa2, 0xa8, 0xcc, 0x43, 0x95, 0x0e, 0x79, 0x1e, 0x06, 0x5b, 0x86,
-	0x4b, 0xed, 0xc0, 0x8b, 0x62, 0xb9, 0x98, 0x8b, 0x4f, 0xb4, 0xd1, 0x22, 0x2e, 0x18, 0x7e, 0x92,
-	0xeb, 0x9c, 0x00, 0x4a, 0x42, 0x19, 0x61, 0x8b, 0x86, 0x8f, 0x2b, 0x2e, 0xe8, 0xb3, 0x30, 0x68,
-	0xf1, 0xab, 0x2e, 0xe9, 0x7f, 0xf1, 0x58, 0x91, 0xd1, 0x89, 0xcb, 0x32, 0x61, 0x54, 0x10, 0xff,
-	0xa3, 0xc4, 0xaa, 0xff, 0x9d, 0x06, 0x17, 0x3b, 0xb1, 0x25, 0x6e, 0xba, 0xac, 0x25, 0x3e, 0xc3,
-	0x5e, 0x4c, 0x97, 0x29, 0x6e, 0x1b, 0x9a, 0x2e, 0x93, 0x10, 0x4c, 0xd1, 0x25, 0xef, 0x04, 0x22,
-	0x64, 0x58, 0x5a, 0xbf, 0xce, 0x68, 0x08, 0x0d, 0xaa, 0xc4, 0x5f, 0x09, 0x85, 0x69, 0x70, 0x6f,
-	0xa5, 0x6a, 0x60, 0x46, 0x2b, 0xfd, 0x97, 0x4b, 0x00, 0x6b, 0xd4, 0xbf, 0xe7, 0xb8, 0x77, 0xd9,
-	0x19, 0x7f, 0x31, 0x76, 0x69, 0x37, 0xfc, 0x8d, 0x8b, 0x04, 0x7b, 0x11, 0xfa, 0x5b, 0x4e, 0xdd,
-	0x93, 0x9a, 0x3f, 0xef, 0x08, 0x7f, 0x24, 0xc5, 0x4b, 0x49, 0x19, 0x06, 0xb8, 0xbb, 0xa1, 0x34,
-	0x5d, 0xf1, 0x2b, 0x3f, 0x7e, 0xad, 0x89, 0xa2, 0x9c, 0x71, 0x48, 0x19, 0x4d, 0xc4, 0x93, 0xd7,
-	0xaa, 0x63, 0x22, 0xfc, 0xbf, 0x28, 0xc3, 0x10, 0x4a, 0x1e, 0x03, 0x30, 0x5b, 0x4b, 0x46, 0xd3,
-	0xb4, 0x4c, 0xf9, 0xb9, 0x8e, 0xf0, 0xbb, 0x28, 0x58, 0x5e, 0x0f, 0x4a, 0xef, 0xef, 0x95, 0x87,
-	0xe5, 0xaf, 0x5d, 0x54, 0x6a, 0xeb, 0x5f, 0xd2, 0x60, 0x32, 0x9a, 0x3c, 0xb9, 0x55, 0x82, 0x9e,
-	0x8b, 0x30, 0xdc, 0xb9, 0x3d, 0x17, 0x59, 0x3e, 0x3a, 0xf7, 0x5c, 0x98, 0x8e, 0xf3, 0x7a, 0xfe,
-	0x7a, 0x18, 0xa5, 0x22, 0xd8, 0xd8, 0xf2, 0x02, 0x0a, 0x1e, 0x37, 0x22, 0x54, 0xc9, 0xc5, 0xa8,
-	0x18, 0xd5, 0x3a, 0xfa, 0x3f, 0xf4, 0xc1, 0xd8, 0x5a, 0xc3, 0xb4, 0x77, 0x82, 0xa8, 0x6a, 0xa1,
-	0xcb, 0x8b, 0x76, 0x3c, 0x2e, 0x2f, 0x4f, 0xc3, 0xb4, 0xa5, 0x5e, 0x30, 0x0b, 0xc1, 0xc9, 0xb0,
-	0x1b, 0xe1, 0x0c, 0x70, 0x0b, 0xc2, 0x4a, 0x4e, 0x1d, 0xcc, 0x6d, 0x4d, 0x7c, 0x18, 0xac, 0x05,
-	0xe9, 0x58, 0x0b, 0x47, 0x0a, 0x53, 0xe7, 0x62, 0x56, 0x0d, 0x9a, 0x13, 0xf2, 0x3c, 0xb9, 0x3d,
-	0x25, 0x2d, 0xf2, 0x21, 0x0d, 0xce, 0xd2, 0x1d, 0x11, 0x34, 0x6a, 0xc3, 0x35, 0x36, 0x37, 0xcd,
-	0x9a, 0x7c, 0x6b, 0x2b, 0x76, 0xe2, 0xca, 0xfe, 0x5e, 0xf9, 0xec, 0x62, 0x56, 0x85, 0xfb, 0x7b,
-	0xe5, 0x6b, 0x99, 0x31, 0xbc, 0xf8, 0x6a, 0x66, 0x36, 0xc1, 0x6c, 0x52, 0x33, 0x6f, 0x85, 0xd1,
-	0x43, 0x44, 0x68, 0x88, 0x45, 0xea, 0xfa, 0x95, 0x12, 0x8c, 0xb1, 0xed, 0xb6, 0xe2, 0xd4, 0x0c,
-	0x6b, 0x61, 0xad, 0x4a, 0x1e, 0x4a, 0x06, 0x28, 0x0d, 0x2f, 0xf7, 0x53, 0x41, 0x4a, 0x57, 0xe0,
-	0xcc, 0xa6, 0xe3, 0xd6, 0xe8, 0x46, 0x65, 0x7d, 0xc3, 0x91, 0x6e, 0x9f, 0x0b, 0x6b, 0x55, 0x69,
-	0x25, 0xe0, 0x97, 0x8d, 0x4b, 0x19, 0x70, 0xcc, 0x6c, 0x45, 0x6e, 0xc1, 0xd9, 0xa8, 0xfc, 0x76,
-	0x4b, 0x3c, 0x15, 0x62, 0xe8, 0xfa, 0xa2, 0xa7, 0x4e, 0x4b, 0x59, 0x15, 0x30, 0xbb, 0x1d, 0x31,
-	0xe0, 0x82, 0xbc, 0xd5, 0x5c, 0x72, 0xdc, 0x7b, 0x86, 0x5b, 0x8f, 0xa3, 0x15, 0x69, 0x4e, 0xf8,
-	0x3d, 0xeb, 0x42, 0x7e, 0x35, 0xec, 0x84, 0x43, 0xff, 0x7b, 0x0d, 0xe2, 0xe1, 0x5f, 0xc9, 0x03,
-	0xd0, 0xe7, 0xca, 0x6c, 0x9f, 0x32, 0x0c, 0x2a, 0x53, 0x11, 0x58, 0x19, 0x99, 0x05, 0x70, 0xa3,
-	0x18, 0xb4, 0xa5, 0x28, 0x0b, 0x8e, 0x12, 0x3d, 0x56, 0xa9, 0xc1, 0x50, 0xf9, 0x46, 0x43, 0x32,
-	0x3c, 0x8e, 0x6a, 0xc3, 0x68, 0x20, 0x2b, 0xe3, 0xe9, 0x8e, 0xcc, 0x06, 0xf5, 0x82, 0x8b, 0x20,
-	0x91, 0xee, 0x88, 0x97, 0xa0, 0x84, 0x10, 0x03, 0xc6, 0x5b, 0x6d, 0x4b, 0x46, 0x32, 0x63, 0xaa,
-	0x8f, 0x30, 0xd8, 0x5f, 0xcd, 0xca, 0xe5, 0xc9, 0x57, 0x3f, 0x33, 0xa1, 0xe7, 0xba, 0x8a, 0x02,
-	0xe3, 0x18, 0xf5, 0x1f, 0x1f, 0x04, 0x25, 0xb0, 0xd5, 0x21, 0xa4, 0xd0, 0x9f, 0xd2, 0xe0, 0x4c,
-	0xcd, 0x32, 0xa9, 0xed, 0x27, 0x62, 0xc4, 0x88, 0xe3, 0xe3, 0x76, 0xa1, 0x88, 0x5b, 0x2d, 0x6a,
-	0x2f, 0x2f, 0xc8, 0x87, 0x65, 0x95, 0x0c, 0xe4, 0xf2, 0xf1, 0x5d, 0x06, 0x04, 0x33, 0x3b, 0xc3,
-	0xc7, 0xc3, 0xcb, 0x97, 0x17, 0xd4, 0xb8, 0xb5, 0x15, 0x59, 0x86, 0x21, 0x94, 0x71, 0xde, 0x86,
-	0xeb, 0xb4, 0x5b, 0x5e, 0x85, 0xbf, 0x1f, 0x17, 0x8b, 0xc2, 0x39, 0xef, 0xf5, 0xa8, 0x18, 0xd5,
-	0x3a, 0xe4, 0x8d, 0x30, 0x26, 0x7e, 0xae, 0xbb, 0x74, 0xd3, 0xdc, 0x91, 0x87, 0x12, 0x37, 0x51,
-	0x5e, 0x57, 0xca, 0x31, 0x56, 0x8b, 0x47, 0x4e, 0xf4, 0xbc, 0x36, 0x75, 0x6f, 0xe3, 0x8a, 0x4c,
-	0x09, 0x2f, 0x22, 0x27, 0x06, 0x85, 0x18, 0xc1, 0xc9, 0x27, 0x35, 0x98, 0x70, 0xe9, 0xf3, 0x6d,
-	0xd3, 0x65, 0x22, 0x8c, 0x61, 0x36, 0x3d, 0x19, 0x5d, 0x0c, 0x7b, 0x8b, 0x68, 0x36, 0x8b, 0x31,
-	0xa4, 0x82, 0x41, 0x86, 0x4e, 0x54, 0x71, 0x20, 0x26, 0x7a, 0xc0, 0xa6, 0xca, 0x33, 0x1b, 0xb6,
-	0x69, 0x37, 0xe6, 0xac, 0x86, 0x27, 0xf3, 0xde, 0x09, 0x6b, 0x7f, 0x54, 0x8c, 0x6a, 0x1d, 0xf2,
-	0x28, 0x8c, 0xb7, 0x3d, 0xc6, 0xf6, 0x9a, 0x54, 0xcc, 0xef, 0x48, 0xe4, 0x65, 0x76, 0x5b, 0x05,
-	0x60, 0xbc, 0x1e, 0x79, 0x0c, 0x26, 0x82, 0x02, 0x39, 0xcb, 0x20, 0x12, 0x21, 0x71, 0xaf, 0x80,
-	0x18, 0x04, 0x13, 0x35, 0x67, 0xe6, 0xe0, 0x74, 0xc6, 0x30, 0x0f, 0xc5, 0x5b, 0xff, 0x97, 0x06,
-	0x67, 0xe3, 0xa6, 0xc6, 0xc0, 0xd8, 0x99, 0x9d, 0xe1, 0x45, 0x3b, 0xd6, 0x0c, 0x2f, 0xdf, 0x80,
-	0x4c, 0x36, 0xfa, 0xcf, 0x94, 0xe0, 0x95, 0x07, 0x7e, 0x97, 0xe4, 0x27, 0x34, 0x18, 0xa5, 0x3b,
-	0xbe, 0x6b, 0x84, 0x41, 0x36, 0xd8, 0x26, 0xdd, 0x3c, 0x16, 0x26, 0x30, 0xbb, 0x18, 0x11, 0x12,
-	0x1b, 0x37, 0x54, 0xa5, 0x14, 0x08, 0xaa, 0xfd, 0x61, 0xdc, 0x56, 0x24, 0x27, 0x53, 0xdd, 0x51,
-	0x25, 0x17, 0x94, 0x90, 0x99, 0xb7, 0xc3, 0x64, 0x12, 0xf3, 0xa1, 0xf6, 0xca, 0x17, 0x35, 0xc8,
-	0x0c, 0x84, 0x4b, 0x2a, 0x30, 0x65, 0xb4, 0x7d, 0x27, 0x76, 0xa3, 0x2e, 0x45, 0x49, 0x6e, 0xbf,
-	0x9d, 0x4b, 0x02, 0x31, 0x5d, 0x5f, 0x98, 0xf3, 0xed, 0xb6, 0x61, 0xc5, 0xd1, 0x08, 0x81, 0x4b,
-	0x9a, 0xf3, 0x53, 0x60, 0xcc, 0x6a, 0xa3, 0xff, 0x33, 0x0d, 0xce, 0x66, 0x9a, 0x25, 0xbb, 0xb0,
-	0xc5, 0x65, 0x6f, 0xfb, 0xd2, 0x71, 0x6e, 0x7b, 0xfd, 0x97, 0x4a, 0x30, 0xb4, 0xee, 0x3a, 0xec,
-	0x68, 0x3b, 0x01, 0x0b, 0x9c, 0x11, 0xb3, 0xc0, 0x15, 0xb2, 0x2f, 0xc8, 0xce, 0xe6, 0x9a, 0xdc,
-	0xcc, 0x84, 0xc9, 0x6d, 0xae, 0x17, 0x22, 0x9d, 0x6d, 0x6c, 0x5f, 0xd1, 0x60, 0x54, 0xd6, 0x3c,
-	0x01, 0xa3, 0xda, 0x77, 0xc4, 0x8d, 0x6a, 0x6f, 0xeb, 0x61, 0x5c, 0x39, 0x56, 0xb4, 0xcf, 0x68,
-	0x30, 0x2e, 0x6b, 0xac, 0xd2, 0xe6, 0x1d, 0xea, 0x92, 0x25, 0x18, 0xf2, 0xda, 0x7c, 0x21, 0xe5,
-	0x80, 0x2e, 0xa8, 0xe2, 0x91, 0x7b, 0xc7, 0xa8, 0xb1, 0xee, 0x57, 0x45, 0x15, 0x25, 0x71, 0xba,
-	0x28, 0xc0, 0xa0, 0x31, 0xdb, 0xfb, 0xae, 0x63, 0xa5, 0x12, 0x46, 0xa0, 0x63, 0x51, 0xe4, 0x10,
-	0xa6, 0xe7, 0xb1, 0xbf, 0x81, 0x0e, 0xc7, 0xf5, 0x3c, 0x06, 0xf6, 0x50, 0x94, 0xeb, 0x5f, 0x1a,
-	0x08, 0x27, 0x9b, 0x2b, 0xf5, 0x37, 0x60, 0xa4, 0xe6, 0x52, 0xc3, 0xa7, 0xf5, 0xf9, 0xdd, 0x6e,
-	0x3a, 0xc7, 0xe5, 0x80, 0x4a, 0xd0, 0x02, 0xa3, 0xc6, 0xec, 0xc8, 0x55, 0x5d, 0xab, 0x4b, 0x91,
-	0x74, 0x92, 0xeb, 0x56, 0xfd, 0xad, 0x30, 0xe0, 0xdc, 0xb3, 0xc3, 0x67, 0x59, 0x1d, 0x09, 0xf3,
-	0xa1, 0xdc, 0x62, 0xb5, 0x51, 0x34, 0x52, 0x13, 0xa6, 0xf4, 0x77, 0x48, 0x98, 0x62, 0xc1, 0x50,
-	0x93, 0x2f, 0x43, 0x4f, 0x79, 0xb4, 0x63, 0x0b, 0x1a, 0x2d, 0x91, 0xf8, 0xed, 0x61, 0x40, 0x82,
-	0x89, 0x4e, 0x76, 0x60, 0xd1, 0x51, 0x45, 0xa7, 0xd0, 0xcc, 0x83, 0x11, 0x9c, 0xec, 0xc6, 0x33,
-	0xf1, 0x0c, 0x15, 0xb7, 0x93, 0xca, 0xee, 0x29, 0xc9, 0x77, 0xc4, 0xd4, 0xe7, 0x65, 0xe3, 0x21,
-	0x5f, 0xd4, 0xe0, 0x7c, 0x3d, 0x3b, 0x57, 0xa2, 0x74, 0x52, 0x29, 0x74, 0x29, 0x9e, 0x93, 0x7e,
-	0x71, 0xbe, 0x2c, 0x27, 0x2c, 0x2f, 0x3f, 0x23, 0xe6, 0x75, 0x46, 0xff, 0xbe, 0xfe, 0xf0, 0x6b,
-	0x92, 0x96, 0x8e, 0x6c, 0x3b, 0x94, 0x56, 0xc4, 0x0e, 0x45, 0xde, 0x10, 0x64, 0xee, 0x13, 0xdb,
-	0xf5, 0x52, 0x32, 0x73, 0xdf, 0x98, 0x24, 0x1d, 0xcb, 0xd6, 0xd7, 0x86, 0xd3, 0x9e, 0x6f, 0x58,
-	0xb4, 0x6a, 0xca, 0xcb, 0x41, 0xcf, 0x37, 0x9a, 0xad, 0x02, 0xa9, 0xf3, 0x44, 0x88, 0x94, 0x34,
-	0x2a, 0xcc, 0xc2, 0x4f, 0xbe, 0x87, 0x87, 0xbd, 0x34, 0x2c, 0x7e, 0x15, 0x2f, 0x52, 0x16, 0x47,
-	0xc4, 0x0f, 0xff, 0xd0, 0x44, 0x06, 0xb5, 0xcc, 0xc6, 0x87, 0xb9, 0x94, 0xc8, 0x8b, 0x70, 0x96,
-	0x1d, 0x80, 0x73, 0x35, 0xdf, 0xdc, 0x36, 0xfd, 0xdd, 0xa8, 0x0b, 0x87, 0xcf, 0x97, 0xc7, 0xb5,
-	0xed, 0x95, 0x2c, 0x64, 0x98, 0x4d, 0x43, 0xff, 0x5b, 0x0d, 0x48, 0x7a, 0xaf, 0x13, 0x0b, 0x86,
-	0xeb, 0x41, 0xcc, 0x12, 0xed, 0x48, 0xf2, 0x59, 0x85, 0x47, 0x48, 0x18, 0xea, 0x24, 0xa4, 0x40,
-	0x1c, 0x18, 0xb9, 0xb7, 0x65, 0xfa, 0xd4, 0x32, 0x3d, 0xff, 0x88, 0xd2, 0x67, 0x85, 0xd9, 0x18,
-	0x9e, 0x0a, 0x10, 0x63, 0x44, 0x43, 0xff, 0x44, 0x3f, 0x0c, 0x87, 0xb9, 0x77, 0x0f, 0x7e, 0xe0,
-	0xd0, 0x06, 0x52, 0x53, 0x42, 0x71, 0xf6, 0x62, 0x33, 0xe5, 0x32, 0x50, 0x25, 0x85, 0x0c, 0x33,
-	0x08, 0x90, 0x17, 0xe1, 0x8c, 0x69, 0x6f, 0xba, 0x46, 0x18, 0x68, 0xb4, 0x12, 0x18, 0xca, 0x0a,
-	0x10, 0xe6, 0x5a, 0xf4, 0x72, 0x06, 0x3a, 0xcc, 0x24, 0x42, 0x28, 0x0c, 0x89, 0xf4, 0xe7, 0xc1,
-	0xad, 0x4b, 0x21, 0x5b, 0xbd, 0x90, 0x35, 0x23, 0xf6, 0x1e, 0xc8, 0x9e, 0x01, 0x6e, 0x11, 0x16,
-	0x5a, 0xfc, 0x1f, 0x5c, 0x48, 0xc9, 0x7d, 0x5f, 0x29, 0x4e, 0x2f, 0xba, 0xdb, 0x12, 0x61, 0xa1,
-	0xe3, 0x85, 0x98, 0x24, 0xa8, 0xff, 0xae, 0x06, 0x03, 0x22, 0xfa, 0xde, 0xf1, 0x8b, 0x9a, 0xef,
-	0x8d, 0x89, 0x9a, 0x8f, 0x17, 0x19, 0x24, 0xef, 0x6a, 0x9e, 0xa0, 0xa9, 0xff, 0x8e, 0x06, 0x23,
-	0xbc, 0xc6, 0x09, 0xc8, 0x7e, 0xcf, 0xc6, 0x65, 0xbf, 0xb7, 0x16, 0x1e, 0x4d, 0x8e, 0xe4, 0xf7,
-	0xbb, 0x7d, 0x72, 0x2c, 0x5c, 0xb4, 0x5a, 0x86, 0xd3, 0xf2, 0x49, 0xfa, 0x8a, 0xb9, 0x49, 0xd9,
-	0x16, 0x5f, 0x30, 0x76, 0x3d, 0xf9, 0x48, 0x4a, 0x84, 0x7b, 0x4a, 0x83, 0x31, 0xab, 0x0d, 0xf9,
-	0x15, 0x8d, 0x09, 0x31, 0xbe, 0x6b, 0xd6, 0x7a, 0xba, 0x0c, 0x0e, 0xfb, 0x36, 0xbb, 0x2a, 0x90,
-	0x09, 0xdd, 0xf4, 0x76, 0x24, 0xcd, 0xf0, 0xd2, 0xfb, 0x7b, 0xe5, 0x72, 0x86, 0xcd, 0x38, 0x4a,
-	0x62, 0xef, 0xf9, 0x1f, 0xfa, 0xcb, 0x8e, 0x55, 0xb8, 0x36, 0x16, 0xf4, 0x98, 0xdc, 0x80, 0x01,
-	0xaf, 0xe6, 0xb4, 0x82, 0xa0, 0x06, 0x0f, 0x66, 0xd9, 0x06, 0x93, 0x66, 0xc1, 0x70, 0x82, 0xab,
-	0xac, 0x25, 0x0a, 0x04, 0x33, 0xcf, 0xc1, 0x98, 0xda, 0xf3, 0x0c, 0xdd, 0x77, 0x21, 0xee, 0xab,
-	0x7a, 0x48, 0xb7, 0x4c, 0x55, 0x57, 0xfe, 0xa3, 0x3e, 0x18, 0x44, 0xda, 0x90, 0xc9, 0x0a, 0x0f,
-	0xd0, 0x39, 0xcd, 0x20, 0x5b, 0x78, 0xa9, 0xf8, 0x0b, 0x58, 0x35, 0x1d, 0xd5, 0x33, 0x8e, 0xad,
-	0xcc, 0x41, 0x2c, 0x61, 0xb8, 0x1d, 0xa6, 0x70, 0xeb, 0x2b, 0xee, 0xca, 0x2e, 0x06, 0xd6, 0x4d,
-	0xd2, 0x36, 0xf2, 0x43, 0x1a, 0x10, 0xa3, 0x56, 0xa3, 0x9e, 0x87, 0xd4, 0x63, 0x73, 0x2f, 0x84,
-	0x55, 0xc1, 0x65, 0x8b, 0xc5, 0xa3, 0x4f, 0x62, 0x8b, 0xc4, 0xb6, 0x14, 0xc8, 0xc3, 0x0c, 0xe2,
-	0xbd, 0x24, 0x92, 0xfb, 0x7d, 0x0d, 0xc6, 0x62, 0x79, 0xfa, 0x9a, 0x91, 0x2d, 0xbd, 0xb8, 0xcb,
-	0x4e, 0xf0, 0xee, 0xf2, 0x42, 0x87, 0x4a, 0xc2, 0x3e, 0x7f, 0x2b, 0x4c, 0x34, 0x73, 0x34, 0x29,
-	0xfd, 0xf4, 0x4f, 0x69, 0x70, 0x2e, 0x18, 0x50, 0x3c, 0xa3, 0x00, 0xb9, 0x0a, 0xc3, 0x46, 0xcb,
-	0xe4, 0x86, 0x5e, 0xd5, 0x54, 0x3e, 0xb7, 0xbe, 0xcc, 0xcb, 0x30, 0x84, 0xc6, 0xd2, 0xa3, 0x97,
-	0x0e, 0x4c, 0x8f, 0xfe, 0x6a, 0x25, 0xe1, 0xfb, 0x40, 0x24, 0xbb, 0x84, 0x84, 0x85, 0x1b, 0xb5,
-	0xfe, 0x66, 0x18, 0xa9, 0x56, 0x6f, 0x88, 0x25, 0x3d, 0xc4, 0x8d, 0x8f, 0xfe, 0xb1, 0x3e, 0x18,
-	0x97, 0xa9, 0x51, 0x4c, 0x6e, 0x02, 0x3a, 0x81, 0x73, 0x6e, 0x03, 0x46, 0xbc, 0xf0, 0x0e, 0xa3,
-	0x94, 0xcf, 0xa7, 0xc2, 0x6b, 0x89, 0x64, 0x7e, 0xcb, 0xe8, 0xfa, 0x22, 0x42, 0x44, 0x6e, 0xc2,
-	0xe0, 0xf3, 0x8c, 0xe7, 0x06, 0xdf, 0x6a, 0x57, 0xac, 0x2f, 0xfc, 0x10, 0x39, 0xbb, 0xf6, 0x50,
-	0xa2, 0x20, 0x1e, 0x7f, 0x18, 0xcc, 0x85, 0xc0, 0x5e, 0x82, 0xe4, 0xc6, 0x66, 0x36, 0x90, 0x2a,
-	0xc5, 0xc6, 0x08, 0x7e, 0x61, 0x48, 0x88, 0x27, 0xe7, 0x8d, 0xb5, 0x78, 0x99, 0x24, 0xe7, 0x8d,
-	0xf5, 0x39, 0xe7, 0xb8, 0x7e, 0x2b, 0x9c, 0xcd, 0x9c, 0x8c, 0x83, 0x45, 0x6c, 0xfd, 0x9f, 0x97,
-	0xa0, 0xbf, 0x4a, 0x69, 0xfd, 0x04, 0x76, 0xe6, 0xb3, 0x31, 0x09, 0xec, 0x5b, 0x0b, 0xa7, 0x07,
-	0xce, 0xb3, 0xf4, 0x6d, 0x26, 0x2c, 0x7d, 0x6f, 0x2f, 0x4c, 0xa1, 0xb3, 0x99, 0xef, 0xaf, 0x4b,
-	0x30, 0xc4, 0xaa, 0x2d, 0xac, 0x55, 0x49, 0x53, 0xd9, 0xca, 0xa5, 0xe2, 0xe2, 0xb3, 0x44, 0x77,
-	0xd0, 0x26, 0x66, 0x5f, 0x8e, 0x29, 0x53, 0x2e, 0xc9, 0x41, 0x2e, 0x1f, 0x01, 0x39, 0xe9, 0xdf,
-	0xcc, 0x89, 0x06, 0x19, 0x9d, 0x30, 0x24, 0x44, 0xee, 0x29, 0x3a, 0x69, 0x7f, 0x71, 0xdf, 0xdd,
-	0x6c, 0xa2, 0x1d, 0xd4, 0x53, 0xfd, 0x3f, 0x68, 0x70, 0x2a, 0xd1, 0xaa, 0x0b, 0xa5, 0xf1, 0x78,
-	0x18, 0xe0, 0x7b, 0x61, 0x42, 0x79, 0x53, 0x10, 0xdd, 0x0f, 0x77, 0xc5, 0x08, 0xf9, 0x05, 0x5a,
-	0x25, 0xd6, 0x1c, 0x13, 0xe8, 0xf4, 0xaf, 0x6b, 0xec, 0x23, 0xce, 0x98, 0xa2, 0x2e, 0x86, 0x1c,
-	0xe5, 0xf6, 0x2b, 0x75, 0xcc, 0xed, 0x77, 0x11, 0xfa, 0x99, 0xe8, 0xa5, 0x3a, 0x05, 0x31, 0x89,
-	0x0c, 0x79, 0x29, 0xa9, 0xa5, 0x86, 0xd8, 0xdf, 0xfd, 0x10, 0xc3, 0xfb, 0xcc, 0x03, 0x86, 0xf9,
-	0xdb, 0x1a, 0x0c, 0xb3, 0x61, 0x9e, 0x00, 0xf7, 0xfd, 0xf6, 0x38, 0xf7, 0x7d, 0x4b, 0xd1, 0x4d,
-	0x9b, 0xc3, 0x74, 0xff, 0xa6, 0x04, 0x3c, 0x39, 0xb9, 0x74, 0x60, 0x52, 0x5c, 0x93, 0xb4, 0x1c,
-	0xa7, 0xaa, 0x2b, 0xd2, 0xb3, 0x29, 0x61, 0xf5, 0x56, 0xbc, 0x9b, 0x5e, 0x1b, 0x73, 0x5e, 0x8a,
-	0x49, 0x2f, 0x19, 0x0e, 0x4c, 0x2f, 0xc0, 0xb8, 0xb7, 0xe5, 0x38, 0xfe, 0x42, 0xf4, 0x75, 0x16,
-	0xbe, 0xe1, 0xe0, 0x71, 0x20, 0x82, 0xa1, 0x88, 0xbb, 0xe2, 0xaa, 0x8a, 0x1b, 0xe3, 0xa4, 0xc8,
-	0x2c, 0xc0, 0x1d, 0xcb, 0xa9, 0xdd, 0x15, 0xbe, 0x53, 0xe2, 0xdd, 0x3f, 0xf7, 0xce, 0x98, 0x0f,
-	0x4b, 0x51, 0xa9, 0xd1, 0x93, 0x9b, 0xd8, 0xd7, 0x35, 0x31, 0xd3, 0x87, 0x60, 0x02, 0x27, 0xe8,
-	0x69, 0xf7, 0x1a, 0x18, 0x74, 0xb9, 0x12, 0x22, 0x57, 0x2c, 0xfc, 0xf8, 0x84, 0x6a, 0x82, 0x12,
-	0xca, 0xb6, 0x87, 0xd0, 0xac, 0xfa, 0xa3, 0x1b, 0x0d, 0x55, 0x1f, 0xd2, 0x7f, 0x49, 0x0e, 0x33,
-	0xcc, 0x6f, 0xdf, 0x82, 0x71, 0xae, 0xba, 0x24, 0x12, 0xeb, 0xbf, 0xa1, 0xcb, 0x6f, 0x44, 0x6d,
-	0x1a, 0xf9, 0xfd, 0xc6, 0x8a, 0x31, 0x4e, 0x80, 0x3c, 0x0a, 0xe3, 0xc1, 0xe8, 0x84, 0xfb, 0x6d,
-	0x29, 0x7a, 0x7c, 0xbd, 0xae, 0x02, 0x30, 0x5e, 0x4f, 0xff, 0x74, 0x09, 0x2e, 0x89, 0xbe, 0x73,
-	0xd3, 0xce, 0x02, 0x6d, 0x51, 0xbb, 0x4e, 0xed, 0xda, 0x2e, 0x17, 0xe4, 0xeb, 0x4e, 0x83, 0xbc,
-	0x08, 0x83, 0xf7, 0x28, 0xad, 0x87, 0x77, 0x24, 0x4f, 0x15, 0x3e, 0x9d, 0xf3, 0x48, 0x3c, 0xc5,
-	0xd1, 0x8b, 0xeb, 0x66, 0xf1, 0x3f, 0x4a, 0x92, 0x8c, 0x78, 0xcb, 0x75, 0xee, 0x84, 0xf2, 0xe6,
-	0xd1, 0x13, 0x5f, 0xe7, 0xe8, 0x05, 0x71, 0xf1, 0x3f, 0x4a, 0x92, 0xfa, 0x3a, 0x3c, 0xd8, 0x45,
-	0xd3, 0xc3, 0xe8, 0x15, 0x07, 0x61, 0x14, 0xa3, 0x3f, 0x0c, 0xc6, 0x3f, 0xd5, 0xe0, 0x55, 0x0a,
-	0xca, 0xc5, 0x1d, 0xa6, 0xea, 0x54, 0x8c, 0x96, 0x51, 0x33, 0xfd, 0x5d, 0x11, 0x7e, 0xf2, 0x50,
-	0x09, 0xb9, 0x3f, 0xa6, 0xc1, 0x90, 0x70, 0xf9, 0x0b, 0xd8, 0xef, 0xb3, 0x3d, 0x4e, 0x79, 0x6e,
-	0x97, 0x82, 0x44, 0x85, 0xc1, 0xd8, 0xc4, 0x6f, 0x0f, 0x03, 0xfa, 0xfa, 0xbf, 0x1c, 0x80, 0x6f,
-	0xee, 0x1e, 0x11, 0xf9, 0xba, 0x06, 0x51, 0x62, 0x7f, 0x69, 0x84, 0x6f, 0x1e, 0x6f, 0xe7, 0x43,
-	0x73, 0x93, 0xb4, 0x60, 0x3c, 0x15, 0x88, 0x22, 0x61, 0xf9, 0x11, 0x59, 0xb2, 0xa2, 0x81, 0x91,
-	0xff, 0x4f, 0x83, 0x31, 0x76, 0x2c, 0x85, 0xcc, 0x45, 0x2c, 0x53, 0xeb, 0x98, 0x47, 0xba, 0xa6,
-	0x90, 0x4c, 0x84, 0xac, 0x53, 0x41, 0x18, 0xeb, 0x1b, 0xb9, 0x1d, 0xbf, 0x5f, 0x14, 0x3a, 0xe8,
-	0xe5, 0x2c, 0xb9, 0x44, 0xb9, 0x8a, 0x08, 0x3d, 0x55, 0xf2, 0xee, 0x0e, 0x67, 0x2c, 0x98, 0x88,
-	0xcf, 0xfc, 0x71, 0xda, 0xe1, 0x66, 0x9e, 0x80, 0xa9, 0xd4, 0xe8, 0x0f, 0x65, 0xf1, 0xf9, 0xca,
-	0x20, 0x94, 0x95, 0xa9, 0xce, 0x8a, 0x3c, 0x45, 0x3e, 0xab, 0xc1, 0xa8, 0x61, 0xdb, 0xd2, 0x73,
-	0x2a, 0xd8, 0xbf, 0xf5, 0x1e, 0x57, 0x35, 0x8b, 0xd4, 0xec, 0x5c, 0x44, 0x26, 0xe1, 0x1a, 0xa4,
-	0x40, 0x50, 0xed, 0x4d, 0x07, 0xf7, 0xdf, 0xd2, 0x89, 0xb9, 0xff, 0x92, 0xf7, 0x07, 0x07, 0x71,
-	0x5f, 0xf1, 0x18, 0x34, 0x07, 0xcc, 0x0d, 0x3f, 0xd7, 0x73, 0xcc, 0x9e, 0x3f, 0xa0, 0xf1, 0x43,
-	0x36, 0x0a, 0x10, 0x26, 0xcf, 0xa4, 0x42, 0x5e, 0x9c, 0x07, 0x46, 0x1f, 0x0b, 0xcf, 0xee, 0xa8,
-	0x08, 0xe3, 0xe4, 0xc9, 0x8f, 0x6a, 0x30, 0xf6, 0x82, 0x63, 0x1b, 0x96, 0x74, 0xe4, 0x96, 0x9a,
-	0xcd, 0x7b, 0x8f, 0x67, 0x5e, 0x42, 0x32, 0xc2, 0x79, 0x53, 0x2d, 0xc1, 0x58, 0x37, 0x98, 0xc0,
-	0x24, 0xc2, 0x26, 0x0c, 0x46, 0xf2, 0xb4, 0x1a, 0x32, 0x61, 0xe6, 0xed, 0x30, 0x99, 0xdc, 0x83,
-	0x87, 0xfa, 0x9e, 0x36, 0xe0, 0xe1, 0x43, 0xf4, 0xb7, 0xcb, 0xa3, 0x4f, 0xff, 0x8d, 0xfe, 0xd8,
-	0x51, 0x9a, 0xbb, 0x3d, 0xba, 0x30, 0xc6, 0x7f, 0x2e, 0xf1, 0x31, 0x0b, 0x16, 0x6d, 0x1e, 0xd7,
-	0x86, 0x3d, 0xda, 0x2f, 0xba, 0xef, 0xe4, 0xbe, 0xe8, 0x97, 0xda, 0x27, 0xd5, 0xf3, 0xce, 0x9c,
-	0x17, 0xb6, 0x00, 0xb9, 0x60, 0x51, 0x1e, 0x36, 0x1e, 0x83, 0xd7, 0xf4, 0xcc, 0x20, 0x92, 0xbc,
-	0x22, 0xd2, 0x3d, 0x29, 0x8a, 0x31, 0x80, 0xeb, 0x2b, 0xb1, 0xc3, 0x62, 0xc3, 0x69, 0x39, 0x96,
-	0xd3, 0xd8, 0x9d, 0xbb, 0x67, 0xb8, 0x14, 0x9d, 0xb6, 0x2f, 0xb1, 0x75, 0x2b, 0x20, 0x7e, 0x70,
-	0x00, 0xae, 0x28, 0xe8, 0x32, 0x03, 0xee, 0x1e, 0xe6, 0x31, 0xc4, 0xe7, 0x92, 0x71, 0x71, 0x4b,
-	0xc5, 0xbd, 0x58, 0x0f, 0xea, 0x57, 0xb1, 0x38, 0xb9, 0x64, 0x4f, 0x03, 0x68, 0x1a, 0x3b, 0x32,
-	0x48, 0xa3, 0x3c, 0x2d, 0xea, 0xc7, 0xd2, 0xc1, 0xd5, 0x90, 0x8c, 0xe8, 0xde, 0xd3, 0x81, 0x21,
-	0x35, 0x02, 0x1c, 0x91, 0x04, 0xa8, 0x8c, 0xa8, 0xe7, 0x40, 0xc0, 0x33, 0x4d, 0x38, 0x95, 0xe8,
-	0xf9, 0xb1, 0x5e, 0x64, 0x7e, 0x65, 0x28, 0x50, 0x90, 0x65, 0xf4, 0xc8, 0x5f, 0xd4, 0xe0, 0x01,
-	0x9a, 0x27, 0x70, 0x4a, 0x6d, 0xf9, 0xe9, 0xe3, 0x12, 0x68, 0x65, 0x2e, 0xbd, 0x3c, 0x30, 0xe6,
-	0xf7, 0x8c, 0xec, 0x02, 0x78, 0xe1, 0x37, 0xdd, 0x4b, 0x48, 0x9a, 0x4c, 0x26, 0x21, 0xe3, 0x65,
-	0x84, 0xbf, 0x51, 0x21, 0x46, 0x3e, 0xaf, 0xc1, 0x19, 0x2b, 0x83, 0xc3, 0x49, 0x8e, 0x59, 0x3d,
-	0x86, 0xb3, 0x45, 0xb8, 0xc0, 0x64, 0x41, 0x30, 0xb3, 0x2b, 0xe4, 0xa7, 0x73, 0xa3, 0x87, 0x0b,
-	0xc9, 0x64, 0xe3, 0x38, 0xbe, 0xc1, 0x02, 0x81, 0xc4, 0x3f, 0xad, 0x01, 0xa9, 0xa7, 0x94, 0x6f,
-	0xe9, 0xfd, 0xf8, 0xae, 0x23, 0x37, 0x31, 0x08, 0x1f, 0xa6, 0x74, 0x39, 0x66, 0x74, 0x82, 0xaf,
-	0xb3, 0x9f, 0xc1, 0xf3, 0xe5, 0x1b, 0xe3, 0x5e, 0xd7, 0x39, 0xeb, 0x38, 0x11, 0xeb, 0x9c, 0x05,
-	0xc1, 0xcc, 0xae, 0xe8, 0xbf, 0x35, 0x22, 0x6c, 0xc1, 0xdc, 0xc9, 0xe4, 0x59, 0x18, 0xbc, 0x63,
-	0xd4, 0xee, 0xca, 0x0b, 0xde, 0x82, 0x6e, 0x4f, 0xf3, 0x1c, 0x83, 0xb0, 0xc2, 0x88, 0xff, 0x51,
-	0x62, 0x25, 0xcf, 0x40, 0x5f, 0xdd, 0x0e, 0x9e, 0xf0, 0xbf, 0xad, 0x87, 0x0b, 0x8c, 0x28, 0x10,
-	0xca, 0xc2, 0x5a, 0x15, 0x19, 0x52, 0x62, 0xc3, 0xb0, 0x2d, 0x4d, 0xa7, 0xd2, 0xba, 0xf5, 0x8e,
-	0xa2, 0x04, 0x42, 0x13, 0x6c, 0x68, 0xf8, 0x0d, 0x4a, 0x30, 0xa4, 0xc1, 0xe8, 0x25, 0x2e, 0x50,
-	0x0b, 0xd3, 0x0b, 0xaf, 0x9c, 0xd4, 0xd8, 0xcc, 0xc9, 0x6b, 0x27, 0x0a, 0x83, 0xbe, 0x61, 0xda,
-	0x7e, 0xf0, 0x1c, 0xff, 0xf1, 0xa2, 0xd4, 0x36, 0x18, 0x96, 0xc8, 0x42, 0xca, 0x7f, 0x7a, 0x28,
-	0x91, 0x93, 0x3b, 0x30, 0x28, 0x9e, 0xe4, 0xcb, 0x4f, 0xa8, 0xf0, 0x05, 0x9e, 0x78, 0xe5, 0x2f,
-	0xb6, 0x81, 0xf8, 0x1f, 0x25, 0x66, 0xf2, 0x1c, 0x0c, 0x7b, 0x81, 0xbf, 0xdb, 0x70, 0x6f, 0x53,
-	0x17, 0x3a, 0xbb, 0xc9, 0x07, 0xc6, 0xd2, 0xcb, 0x2d, 0xc4, 0x4f, 0xee, 0xc0, 0x90, 0x29, 0x55,
-	0xaa, 0x91, 0xe2, 0xdb, 0x2e, 0x50, 0x97, 0xb8, 0xb6, 0x11, 0x68, 0x4a, 0x01, 0xe2, 0x3c, 0xa7,
-	0x16, 0xf8, 0x06, 0x3a, 0xb5, 0x90, 0xe7, 0x01, 0x68, 0x60, 0x42, 0xf7, 0xa6, 0x47, 0x8b, 0x6f,
-	0x19, 0xc5, 0x10, 0x1f, 0x08, 0x44, 0x61, 0x91, 0x87, 0x0a, 0x11, 0xf2, 0xa2, 0x6a, 0xb3, 0x1b,
-	0xeb, 0x2d, 0xca, 0x48, 0x3a, 0x6e, 0x4e, 0x74, 0x37, 0x18, 0x9a, 0x8b, 0x14, 0x53, 0x9a, 0xfe,
-	0x15, 0x00, 0x88, 0xee, 0x8d, 0xc9, 0x26, 0x0c, 0x07, 0x54, 0x7a, 0x89, 0xf3, 0x73, 0x5d, 0x82,
-	0xc5, 0xf6, 0x0a, 0x7e, 0x61, 0x88, 0x9b, 0x54, 0xb2, 0xa2, 0x97, 0x45, 0xc9, 0xaf, 0xbb, 0x8b,
-	0x5c, 0xf6, 0x3c, 0x40, 0x2d, 0x8a, 0xdf, 0xdc, 0x57, 0x7c, 0xad, 0xc2, 0xd8, 0xce, 0xd1, 0x5a,
-	0x29, 0xe1, 0x9f, 0x15, 0x22, 0x39, 0x6e, 0xef, 0xfd, 0x85, 0xdc, 0xde, 0x1f, 0x87, 0x53, 0xd2,
-	0xcd, 0x70, 0x99, 0xdf, 0x32, 0xfa, 0xbb, 0xf2, 0x65, 0x28, 0x77, 0x40, 0xad, 0xc4, 0x41, 0x98,
-	0xac, 0x4b, 0x7e, 0x5d, 0x83, 0xe1, 0x9a, 0x14, 0xd0, 0x24, 0x6f, 0x5b, 0xe9, 0xcd, 0x6b, 0x60,
-	0x36, 0x90, 0xf7, 0x84, 0x20, 0xff, 0x64, 0xc0, 0x55, 0x83, 0xe2, 0x23, 0x12, 0xe3, 0xc3, 0x5e,
-	0x93, 0xdf, 0xd3, 0x60, 0xd4, 0xb0, 0x2c, 0xa7, 0x66, 0xf8, 0x3c, 0xf2, 0xa7, 0x78, 0xb2, 0x7a,
-	0xab, 0xc7, 0x51, 0xcc, 0x45, 0x18, 0xc5, 0x40, 0xde, 0x1d, 0x5a, 0x02, 0x22, 0xc8, 0x11, 0x8d,
-	0x45, 0xed, 0x3e, 0xf9, 0x7f, 0x34, 0x78, 0x95, 0x78, 0x27, 0x5c, 0x61, 0x32, 0xd7, 0xa6, 0x59,
-	0x33, 0x7c, 0x2a, 0xc2, 0x11, 0x07, 0x6f, 0xd4, 0x84, 0x93, 0xfe, 0xf0, 0xa1, 0x9d, 0xf4, 0xaf,
-	0xee, 0xef, 0x95, 0x5f, 0x55, 0xe9, 0x02, 0x37, 0x76, 0xd5, 0x03, 0xf2, 0x02, 0x8c, 0x5b, 0x6a,
-	0x6a, 0x01, 0xc9, 0xe4, 0x0b, 0x5d, 0xbf, 0xc6, 0x72, 0x14, 0x08, 0x03, 0x43, 0xac, 0x08, 0xe3,
-	0xa4, 0x66, 0xee, 0xc2, 0x78, 0x6c, 0xa3, 0x1d, 0xab, 0xe1, 0xda, 0x86, 0xc9, 0xe4, 0x7e, 0x38,
-	0x56, 0x3d, 0xef, 0x26, 0x8c, 0x84, 0xc2, 0x02, 0xb9, 0xa4, 0x10, 0x8a, 0x44, 0xaf, 0x9b, 0x74,
-	0x57, 0x50, 0x2d, 0xc7, 0x6c, 0x28, 0xc2, 0x48, 0xc8, 0x03, 0xb3, 0x4a, 0x84, 0xfa, 0x1f, 0xc8,
-	0x5b, 0xd5, 0x0d, 0xda, 0x6c, 0x59, 0x86, 0x4f, 0x5f, 0xfe, 0x8e, 0x4e, 0xfa, 0xbf, 0xd7, 0xc4,
-	0x79, 0x23, 0x44, 0x1b, 0x62, 0xc0, 0x68, 0x53, 0xe4, 0xb5, 0xe4, 0x61, 0x5c, 0xb5, 0xe2, 0x01,
-	0x64, 0x57, 0x23, 0x34, 0xa8, 0xe2, 0x24, 0xf7, 0x60, 0x24, 0x10, 0x06, 0x03, 0x4b, 0xcd, 0x52,
-	0x6f, 0xc2, 0x59, 0x28, 0x77, 0x86, 0x47, 0x6b, 0x50, 0xe2, 0x61, 0x44, 0x4b, 0x37, 0x80, 0xa4,
-	0xdb, 0x90, 0x87, 0xa2, 0x07, 0x73, 0x5a, 0x3c, 0x13, 0x55, 0xea, 0xd1, 0x5c, 0x60, 0x64, 0x2d,
-	0xe5, 0x19, 0x59, 0xf5, 0xdf, 0x2c, 0xc1, 0x19, 0xa9, 0x7a, 0xce, 0xd5, 0x6a, 0x4e, 0xdb, 0xf6,
-	0xa3, 0x77, 0xcc, 0x22, 0x38, 0x80, 0x24, 0xc2, 0xc5, 0x49, 0x11, 0x39, 0x00, 0x25, 0x84, 0xdc,
-	0x12, 0xd6, 0x4f, 0xbb, 0xbe, 0xe1, 0xdc, 0xa5, 0x76, 0xc4, 0x25, 0xd4, 0x28, 0x1c, 0x8b, 0x59,
-	0x15, 0x30, 0xbb, 0x1d, 0xd9, 0x06, 0xd2, 0x34, 0x76, 0x92, 0xd8, 0x8a, 0xe5, 0x37, 0xe4, 0xfa,
-	0xe2, 0x6a, 0x0a, 0x1b, 0x66, 0x50, 0x60, 0x07, 0x29, 0x93, 0xe4, 0x5a, 0x3e, 0xad, 0x8b, 0x21,
-	0x06, 0x4e, 0x1d, 0xfc, 0x20, 0x9d, 0x8b, 0x83, 0x30, 0x59, 0x57, 0xff, 0xf0, 0x20, 0x3c, 0x10,
-	0x9f, 0x44, 0xf6, 0x85, 0x06, 0xef, 0xf7, 0x9f, 0x08, 0x1e, 0xa7, 0x89, 0x89, 0x7c, 0x28, 0xf9,
-	0x38, 0x6d, 0x5a, 0x75, 0x1a, 0x0a, 0x02, 0x58, 0xaa, 0x0f, 0xd5, 0xbe, 0x01, 0x8f, 0xf1, 0x73,
-	0x5e, 0x5f, 0xf7, 0x1d, 0x6b, 0xd0, 0x81, 0x8f, 0x6b, 0x30, 0x13, 0x2f, 0x5e, 0x32, 0x6d, 0xd3,
-	0xdb, 0x92, 0x29, 0x8d, 0x0e, 0xff, 0x36, 0x8e, 0x27, 0x45, 0x5f, 0xc9, 0xc5, 0x88, 0x1d, 0xa8,
-	0x91, 0xef, 0xd7, 0xe0, 0x42, 0x62, 0x5e, 0x62, 0x09, 0x96, 0x0e, 0xff, 0x4c, 0x8e, 0x47, 0x8f,
-	0x59, 0xc9, 0x47, 0x89, 0x9d, 0xe8, 0x75, 0x0a, 0x29, 0x3a, 0xf8, 0x52, 0x09, 0x29, 0xfa, 0x0b,
-	0x25, 0x18, 0xe0, 0x7e, 0x53, 0x2f, 0x8f, 0x17, 0x4d, 0xbc, 0xab, 0xb9, 0x0e, 0xb5, 0x8d, 0x84,
-	0x43, 0xed, 0x13, 0xc5, 0x49, 0x74, 0xf6, 0xa8, 0x7d, 0x37, 0x9c, 0xe3, 0xd5, 0xe6, 0xea, 0xdc,
-	0xd0, 0xe6, 0xd1, 0xfa, 0x5c, 0xbd, 0xce, 0xd5, 0xdb, 0x83, 0xef, 0xc8, 0x2e, 0x41, 0x5f, 0xdb,
-	0xb5, 0x92, 0x31, 0x6a, 0x6f, 0xe3, 0x0a, 0xb2, 0x72, 0xfd, 0x87, 0x4b, 0x30, 0xc9, 0x71, 0x2b,
-	0x2c, 0x86, 0x6c, 0xc3, 0x70, 0x10, 0x27, 0x57, 0xae, 0xcd, 0x4a, 0xe1, 0xa1, 0x65, 0xb0, 0x2e,
-	0xa1, 0xb1, 0x85, 0x51, 0xb3, 0x43, 0x5a, 0xe4, 0x23, 0x1a, 0x4c, 0xd2, 0x30, 0x52, 0xf0, 0x9c,
-	0xcf, 0xb4, 0xe6, 0x5e, 0x62, 0xd1, 0x2f, 0x26, 0x70, 0xc9, 0xb4, 0xa9, 0x89, 0x52, 0x4c, 0xd1,
-	0xd4, 0xff, 0x6c, 0x00, 0xa6, 0xf3, 0x7a, 0x4f, 0x3e, 0xa9, 0xc1, 0xb9, 0x5a, 0x24, 0xfa, 0xce,
-	0xb5, 0xfd, 0x2d, 0xc7, 0x0d, 0xb2, 0xb6, 0x14, 0xb6, 0xcb, 0x54, 0xe6, 0xc2, 0xe9, 0xe1, 0xa9,
-	0x8a, 0x2a, 0x99, 0x14, 0x30, 0x87, 0x32, 0x79, 0x31, 0x16, 0x66, 0xba, 0xaf, 0x78, 0xb4, 0x73,
-	0xb1, 0x1f, 0xa3, 0x60, 0xd2, 0x41, 0xa7, 0x3a, 0x05, 0x99, 0xfe, 0x50, 0x2a, 0xd8, 0x76, 0x0f,
-	0xcf, 0x16, 0x32, 0x43, 0xdc, 0x74, 0x11, 0x68, 0xfb, 0x93, 0x1a, 0x4c, 0x79, 0xc9, 0x23, 0x56,
-	0x32, 0xe1, 0xd5, 0x62, 0xc2, 0x58, 0xce, 0x79, 0x2d, 0xcc, 0x07, 0x69, 0x70, 0x9a, 0x7c, 0x4e,
-	0xf8, 0xed, 0xc1, 0xe2, 0x9d, 0x4a, 0xc7, 0xd7, 0x8e, 0x75, 0xaa, 0xab, 0xf0, 0xdb, 0x1f, 0x2c,
-	0xc1, 0x79, 0xbe, 0xcc, 0x37, 0xdb, 0x77, 0xa8, 0xf0, 0x02, 0xfb, 0x47, 0x17, 0x4a, 0xe8, 0x77,
-	0x34, 0x18, 0xe1, 0x73, 0xf0, 0x32, 0x79, 0x8d, 0xca, 0xfb, 0x9a, 0xe3, 0x69, 0xfd, 0xdb, 0x1a,
-	0x4c, 0xa5, 0xf2, 0xd4, 0x75, 0xf5, 0x96, 0xf1, 0xc4, 0x9c, 0x80, 0x5f, 0x1d, 0xe5, 0xc6, 0xed,
-	0x8b, 0x42, 0x78, 0x24, 0xf3, 0xe2, 0xea, 0x4f, 0xc1, 0x78, 0xcc, 0xd1, 0x5a, 0x09, 0x76, 0x99,
-	0x15, 0xa6, 0x53, 0x8d, 0x65, 0x59, 0xea, 0x14, 0x85, 0x33, 0xda, 0xf2, 0x69, 0xce, 0xf6, 0x8f,
-	0x67, 0xcb, 0x13, 0xb9, 0xe5, 0x83, 0xfb, 0x24, 0x1e, 0x42, 0xd3, 0xeb, 0xe5, 0x3e, 0x89, 0xc7,
-	0xe2, 0x94, 0x21, 0x6f, 0xc5, 0xff, 0x28, 0xb1, 0x92, 0x77, 0xc4, 0x03, 0xda, 0xae, 0x45, 0x4a,
-	0xe6, 0x99, 0x64, 0x18, 0x5a, 0xbe, 0x25, 0x53, 0xb5, 0x09, 0x8a, 0x1b, 0x29, 0x71, 0x96, 0x15,
-	0xca, 0xa0, 0xb5, 0xb0, 0x56, 0x15, 0x91, 0x0e, 0xc3, 0x9b, 0xa8, 0xb8, 0xe9, 0xbd, 0xff, 0x24,
-	0x4c, 0xef, 0x2e, 0x8c, 0x6e, 0x99, 0x77, 0xa8, 0x6b, 0x0b, 0x79, 0x6a, 0xa0, 0xb8, 0xa8, 0x78,
-	0x23, 0x42, 0x23, 0xec, 0x11, 0x4a, 0x01, 0xaa, 0x44, 0x88, 0x1b, 0x0b, 0xcb, 0x3d, 0x58, 0x5c,
-	0x2a, 0x89, 0x6c, 0xe4, 0xd1, 0x38, 0x73, 0x42, 0x72, 0xdb, 0x00, 0x76, 0x18, 0xab, 0xb6, 0x97,
-	0x1b, 0xaa, 0x28, 0xe2, 0xad, 0x10, 0x3a, 0xa2, 0xdf, 0xa8, 0x50, 0x60, 0xf3, 0xda, 0x8c, 0x52,
-	0x37, 0x48, 0x7b, 0xe7, 0x13, 0x3d, 0xa6, 0xb4, 0x90, 0x76, 0x1e, 0x25, 0x37, 0x85, 0x4a, 0x84,
-	0x8d, 0xb1, 0x19, 0x26, 0x2c, 0x90, 0xf6, 0xcc, 0x42, 0x63, 0x8c, 0xd2, 0x1e, 0x88, 0x31, 0x46,
-	0xbf, 0x51, 0xa1, 0x40, 0x9e, 0x53, 0x2e, 0x32, 0xa1, 0xb8, 0xb5, 0xac, 0xab, 0x4b, 0xcc, 0x37,
-	0x45, 0x46, 0xa3, 0x51, 0xfe, 0x9d, 0x5e, 0x50, 0x0c, 0x46, 0x3c, 0x91, 0x03, 0xe3, 0x1d, 0x29,
-	0x03, 0x52, 0xf4, 0xbc, 0x63, 0xac, 0xe3, 0xf3, 0x8e, 0x0a, 0x93, 0xce, 0x94, 0x37, 0x98, 0x9c,
-	0x21, 0x8c, 0x47, 0xb7, 0x31, 0xd5, 0x24, 0x10, 0xd3, 0xf5, 0x05, 0xc3, 0xa7, 0x75, 0xde, 0x76,
-	0x42, 0x65, 0xf8, 0xa2, 0x0c, 0x43, 0x28, 0xd9, 0x86, 0x31, 0x4f, 0x79, 0x2b, 0x32, 0x7d, 0xaa,
-	0xd7, 0xbb, 0x4c, 0xf9, 0x4e, 0x84, 0x3b, 0x65, 0xaa, 0x25, 0x18, 0xa3, 0x13, 0xbf, 0x68, 0x9b,
-	0x3c, 0xd9, 0x8b, 0x36, 0xd2, 0x8e, 0xbb, 0x81, 0x4f, 0x1d, 0x49, 0xc4, 0x9a, 0x03, 0xdd, 0xc4,
-	0xd9, 0xd2, 0xd2, 0x9d, 0x96, 0xe3, 0xb5, 0x5d, 0xca, 0xfd, 0x4f, 0xf9, 0xf2, 0x90, 0x68, 0x69,
-	0x17, 0x93, 0x40, 0x4c, 0xd7, 0xe7, 0xba, 0x9f, 0xb7, 0xeb, 0xf9, 0xb4, 0xc9, 0x8e, 0x2d, 0xc7,
-	0xa6, 0xb6, 0xef, 0x4d, 0x9f, 0x2e, 0xae, 0xfb, 0x55, 0x13, 0xb8, 0xc4, 0xb1, 0x93, 0x2c, 0xc5,
-	0x14, 0x4d, 0xb6, 0x73, 0xd4, 0x98, 0x37, 0xd3, 0x67, 0x8a, 0xef, 0x1c, 0x35, 0x9e, 0x8e, 0xd8,
-	0x39, 0x6a, 0x09, 0xc6, 0xe8, 0x90, 0x47, 0x61, 0x5c, 0xfa, 0x21, 0x51, 0x97, 0xcf, 0xe0, 0xd9,
-	0x28, 0x2c, 0x69, 0x55, 0x05, 0x60, 0xbc, 0x1e, 0xf9, 0x00, 0x8c, 0xa9, 0x67, 0xe7, 0xf4, 0xb9,
-	0xa3, 0x0e, 0xd0, 0x2f, 0x7a, 0xae, 0x82, 0x62, 0x04, 0x09, 0xc2, 0x39, 0xe5, 0x15, 0xa3, 0xfa,
-	0x7d, 0x9f, 0xe7, 0x43, 0x10, 0xfa, 0x6c, 0x66, 0x0d, 0xcc, 0x69, 0x49, 0x7e, 0x3c, 0xfb, 0xde,
-	0x7e, 0x9a, 0x6f, 0xe9, 0xf5, 0x23, 0xb9, 0xb7, 0x7f, 0xca, 0xf4, 0xb7, 0x6e, 0xb5, 0x44, 0x0c,
-	0xb5, 0x43, 0x5e, 0xe1, 0xeb, 0x7f, 0xa4, 0x01, 0x84, 0x96, 0x9b, 0x93, 0xb8, 0x33, 0xa9, 0xc7,
-	0x8c, 0x59, 0xf3, 0x3d, 0x59, 0x9a, 0x72, 0xf3, 0xaf, 0xe8, 0x5f, 0xd5, 0x60, 0x22, 0xaa, 0x76,
-	0x02, 0xaa, 0x51, 0x2d, 0xae, 0x1a, 0xbd, 0xbd, 0xb7, 0x71, 0xe5, 0xe8, 0x47, 0xff, 0xb3, 0xa4,
-	0x8e, 0x8a, 0x4b, 0xbf, 0xdb, 0x31, 0x1f, 0x04, 0x46, 0xfa, 0x46, 0x2f, 0x3e, 0x08, 0x6a, 0x9c,
-	0x91, 0x68, 0xbc, 0x19, 0x3e, 0x09, 0xdf, 0x19, 0x93, 0x3f, 0x7b, 0x88, 0xf0, 0x13, 0x0a, 0x9b,
-	0x01, 0x69, 0x31, 0x01, 0x07, 0x09, 0xa3, 0xcf, 0xab, 0xc7, 0x53, 0x0f, 0x39, 0x53, 0x62, 0x03,
-	0xee, 0xec, 0xfd, 0xf1, 0x0b, 0x53, 0x30, 0xaa, 0x18, 0x39, 0x13, 0x1e, 0x15, 0xda, 0x49, 0x78,
-	0x54, 0xf8, 0x30, 0x5a, 0x0b, 0xd3, 0xd5, 0x06, 0xd3, 0xde, 0x23, 0xcd, 0xf0, 0x58, 0x8c, 0x12,
-	0xe1, 0x7a, 0xa8, 0x92, 0x61, 0xc2, 0x5b, 0xb8, 0xc7, 0xfa, 0x8e, 0xc0, 0xcf, 0xa5, 0xd3, 0xbe,
-	0x7a, 0x23, 0x40, 0x20, 0xff, 0xd3, 0xba, 0x8c, 0x45, 0x1f, 0xfa, 0x70, 0x2f, 0x7b, 0x37, 0x42,
-	0x18, 0x2a, 0xf5, 0xd2, 0x37, 0xf4, 0x03, 0x27, 0x76, 0x43, 0xcf, 0xb6, 0x01, 0x2b, 0x58, 0x74,
-	0xdd, 0x28, 0x59, 0xef, 0xe3, 0x45, 0x09, 0x73, 0x2c, 0xd1, 0x36, 0x08, 0x8b, 0x3c, 0x54, 0x88,
-	0xe4, 0x38, 0xd6, 0x0c, 0x15, 0x72, 0xac, 0x69, 0xc3, 0x69, 0x97, 0xfa, 0xee, 0x6e, 0x65, 0xb7,
-	0xc6, 0x93, 0xb8, 0xb8, 0x3e, 0xd7, 0xe0, 0x87, 0x8b, 0x85, 0x86, 0xc4, 0x34, 0x2a, 0xcc, 0xc2,
-	0x1f, 0x13, 0x80, 0x47, 0x3a, 0x0a, 0xc0, 0x6f, 0x82, 0x51, 0x9f, 0xd6, 0xb6, 0x6c, 0xb3, 0x66,
-	0x58, 0xcb, 0x0b, 0x32, 0x52, 0x79, 0x24, 0xcb, 0x45, 0x20, 0x54, 0xeb, 0x91, 0x79, 0xe8, 0x6b,
-	0x9b, 0x75, 0xa9, 0x01, 0x7c, 0x4b, 0x78, 0x5d, 0xb0, 0xbc, 0x70, 0x7f, 0xaf, 0xfc, 0xca, 0xc8,
-	0x53, 0x25, 0x1c, 0xd5, 0xb5, 0xd6, 0xdd, 0xc6, 0x35, 0x7f, 0xb7, 0x45, 0xbd, 0xd9, 0xdb, 0xcb,
-	0x0b, 0xc8, 0x1a, 0x67, 0x39, 0x1d, 0x8d, 0x1d, 0xc2, 0xe9, 0xe8, 0xd3, 0x1a, 0x9c, 0x36, 0x92,
-	0x37, 0x1d, 0xd4, 0x9b, 0x1e, 0x2f, 0xce, 0x2d, 0xb3, 0x6f, 0x4f, 0xe6, 0x2f, 0xc8, 0xf1, 0x9d,
-	0x9e, 0x4b, 0x93, 0xc3, 0xac, 0x3e, 0x10, 0x17, 0x48, 0xd3, 0x6c, 0x88, 0x3d, 0x10, 0xad, 0xfa,
-	0x44, 0x31, 0xbb, 0xcd, 0x6a, 0x0a, 0x13, 0x66, 0x60, 0x27, 0xf7, 0xe2, 0xa9, 0x42, 0x4f, 0xf5,
-	0x20, 0x13, 0x27, 0xae, 0x34, 0x3a, 0x27, 0x06, 0x0d, 0x6f, 0x5b, 0x15, 0x33, 0x83, 0xbc, 0x71,
-	0xe4, 0xa3, 0x9e, 0x2c, 0x7e, 0xdb, 0x9a, 0x8d, 0x11, 0x3b, 0x50, 0xe3, 0x01, 0x19, 0x19, 0x58,
-	0xd1, 0xcd, 0xa7, 0xa7, 0x8a, 0x47, 0x94, 0x59, 0x89, 0xa3, 0x12, 0x5b, 0x33, 0x51, 0x88, 0x49,
-	0x82, 0x64, 0x09, 0x88, 0xbc, 0x2b, 0x8a, 0x94, 0x33, 0x6f, 0x9a, 0x70, 0x47, 0x00, 0xbe, 0xa4,
-	0x8b, 0x29, 0x28, 0x66, 0xb4, 0x20, 0x7e, 0xcc, 0x56, 0xd2, 0x83, 0x96, 0x93, 0xcc, 0x0e, 0xd4,
-	0xd1, 0x62, 0xf2, 0x3d, 0x5a, 0x2a, 0x51, 0xbb, 0x50, 0x6e, 0x6e, 0xf4, 0x9e, 0xa8, 0x5d, 0x92,
-	0xef, 0x26, 0x5d, 0xfb, 0x17, 0x34, 0x38, 0xdf, 0xcc, 0x4e, 0x8b, 0xc9, 0x75, 0x9e, 0xc2, 0x69,
-	0x7a, 0x33, 0x51, 0x72, 0x33, 0x46, 0x5e, 0x1a, 0x4e, 0xcc, 0xeb, 0x88, 0xfe, 0x87, 0x9a, 0x34,
-	0x45, 0x9f, 0xa0, 0x5f, 0xd4, 0x71, 0x5f, 0x58, 0xeb, 0x4f, 0xc1, 0x74, 0x35, 0x08, 0xa7, 0x5a,
-	0x4f, 0x64, 0x4d, 0x78, 0x1b, 0x8c, 0x8b, 0xab, 0xa0, 0x55, 0xa3, 0xb5, 0x16, 0xdd, 0x1b, 0x84,
-	0x71, 0x31, 0x2a, 0x2a, 0x10, 0xe3, 0x75, 0xf5, 0xaf, 0x69, 0x70, 0x3e, 0x8e, 0xd9, 0x71, 0xcd,
-	0x17, 0x7a, 0x47, 0x4c, 0x3e, 0xaa, 0xc1, 0xe8, 0xdd, 0xf0, 0x96, 0x2a, 0x10, 0xdd, 0x0a, 0xbd,
-	0x1d, 0x09, 0x7a, 0x45, 0x5d, 0xe5, 0xda, 0x2b, 0x9d, 0x8a, 0x33, 0x02, 0x7a, 0xa8, 0x92, 0xd6,
-	0xff, 0x8b, 0x06, 0x29, 0xf3, 0x01, 0xb9, 0x03, 0x43, 0x8c, 0xc8, 0xc2, 0x5a, 0x55, 0xee, 0x89,
-	0xb7, 0x15, 0x93, 0x2a, 0x39, 0x0a, 0x71, 0x29, 0x22, 0x7f, 0x60, 0x80, 0x98, 0x6c, 0x8b, 0x40,
-	0x04, 0x41, 0x4a, 0x25, 0xb9, 0x3d, 0x0a, 0x89, 0xed, 0x6a, 0x6a, 0x26, 0xa1, 0xd6, 0xab, 0x25,
-	0x18, 0xa3, 0xa3, 0xaf, 0x00, 0x44, 0x26, 0x9f, 0x9e, 0xfd, 0x0c, 0x7f, 0xfd, 0x0c, 0x9c, 0xed,
-	0xf9, 0x55, 0xe4, 0x87, 0x34, 0x38, 0x47, 0xb7, 0xcd, 0x9a, 0x3f, 0xb7, 0xe9, 0x53, 0xf7, 0xd6,
-	0xad, 0xd5, 0x8d, 0x2d, 0x97, 0x7a, 0x5b, 0x8e, 0x55, 0xef, 0xc6, 0xab, 0x32, 0xc3, 0x05, 0x8c,
-	0x9b, 0x26, 0x16, 0x33, 0x31, 0x62, 0x0e, 0x25, 0x6e, 0xee, 0xda, 0x16, 0x86, 0x00, 0x64, 0x3a,
-	0x57, 0xdb, 0xf5, 0x7c, 0x19, 0x21, 0x51, 0x98, 0xbb, 0x92, 0x40, 0x4c, 0xd7, 0x4f, 0x22, 0xe1,
-	0xf9, 0x02, 0xb9, 0xdc, 0xae, 0xa5, 0x91, 0x70, 0x20, 0xa6, 0xeb, 0xab, 0x48, 0xc4, 0x4a, 0xb1,
-	0x43, 0x71, 0x20, 0x8d, 0x24, 0x04, 0x62, 0xba, 0x3e, 0xa9, 0xc3, 0x45, 0x97, 0xd6, 0x9c, 0x66,
-	0x93, 0xda, 0x75, 0x3e, 0x29, 0xab, 0x86, 0xdb, 0x30, 0xed, 0x25, 0xd7, 0xe0, 0x15, 0xf9, 0xed,
-	0x81, 0xc6, 0x53, 0xfb, 0x5e, 0xc4, 0x0e, 0xf5, 0xb0, 0x23, 0x16, 0xd2, 0x84, 0x53, 0x6d, 0xce,
-	0xff, 0x5d, 0x1e, 0x41, 0x6d, 0xdb, 0xb0, 0xe4, 0x15, 0xc1, 0x61, 0x57, 0x8c, 0x1f, 0xd4, 0xb7,
-	0xe3, 0xa8, 0x30, 0x89, 0x9b, 0xec, 0x32, 0xf1, 0x5c, 0x76, 0x47, 0x21, 0x39, 0x5c, 0x88, 0xa4,
-	0x14, 0xd1, 0x53, 0xe8, 0x30, 0x8b, 0x06, 0x59, 0x86, 0xd3, 0xbe, 0xe1, 0x36, 0xa8, 0x5f, 0x59,
-	0xbf, 0xbd, 0x4e, 0xdd, 0x1a, 0xe3, 0xb1, 0x96, 0x90, 0xd6, 0x35, 0x81, 0x6a, 0x23, 0x0d, 0xc6,
-	0xac, 0x36, 0xe4, 0x03, 0xf0, 0xea, 0xf8, 0xa4, 0xae, 0x38, 0xf7, 0xa8, 0x3b, 0xef, 0xb4, 0xed,
-	0x7a, 0x1c, 0x39, 0x70, 0xe4, 0x0f, 0xed, 0xef, 0x95, 0x5f, 0x8d, 0xdd, 0x34, 0xc0, 0xee, 0xf0,
-	0xa6, 0x3b, 0x70, 0xbb, 0xd5, 0xca, 0xec, 0xc0, 0x68, 0x5e, 0x07, 0x72, 0x1a, 0x60, 0x77, 0x78,
-	0x09, 0xc2, 0x39, 0x31, 0x31, 0x22, 0x11, 0xb5, 0x42, 0x71, 0x8c, 0x53, 0xe4, 0xdf, 0xef, 0x46,
-	0x66, 0x0d, 0xcc, 0x69, 0xc9, 0xce, 0x94, 0xab, 0x79, 0xc3, 0x4f, 0x91, 0x19, 0xe7, 0x64, 0x5e,
-	0xbb, 0xbf, 0x57, 0xbe, 0x8a, 0x5d, 0xb6, 0xc1, 0xae, 0xb1, 0x67, 0x74, 0x25, 0x9a, 0x88, 0x54,
-	0x57, 0x26, 0xf2, 0xba, 0x92, 0xdf, 0x06, 0xbb, 0xc6, 0x4e, 0xbe, 0x4f, 0x83, 0x07, 0x6a, 0xad,
-	0xf6, 0x0d, 0xd3, 0xf3, 0x9d, 0x86, 0x6b, 0x34, 0x17, 0x68, 0xcd, 0xd8, 0xbd, 0x61, 0x58, 0x9b,
-	0x2b, 0xe6, 0x26, 0x95, 0x4a, 0xc7, 0x61, 0x3f, 0x1c, 0xfe, 0x02, 0xb8, 0xb2, 0x7e, 0x3b, 0x1b,
-	0x29, 0xe6, 0xd3, 0x23, 0x3f, 0xa2, 0xc1, 0xc5, 0x26, 0xef, 0x62, 0x4e, 0x87, 0x26, 0x0b, 0x75,
-	0x88, 0x73, 0xb1, 0xd5, 0x0e, 0x78, 0xb1, 0x23, 0x55, 0x3e, 0x49, 0xa2, 0xc2, 0x5c, 0xa3, 0xe1,
-	0xd2, 0x06, 0xc7, 0x1a, 0x72, 0x97, 0xa9, 0xe2, 0x93, 0xb4, 0x9a, 0x87, 0x14, 0xf3, 0xe9, 0x91,
-	0xe7, 0xe0, 0x72, 0x2e, 0xb0, 0xe2, 0xb4, 0x6d, 0x9f, 0x5f, 0xc2, 0xf4, 0xcd, 0xeb, 0xfb, 0x7b,
-	0xe5, 0xcb, 0xab, 0x1d, 0x6b, 0xe2, 0x01, 0x98, 0x78, 0x10, 0x94, 0x58, 0x3c, 0x82, 0xd3, 0x5c,
-	0x12, 0x7b, 0x4f, 0xa1, 0xc4, 0xc3, 0x47, 0x18, 0x84, 0xe0, 0xab, 0xf1, 0x20, 0x04, 0x67, 0x78,
-	0xaf, 0xde, 0x7d, 0x74, 0xbd, 0xfa, 0x86, 0x44, 0x1e, 0x88, 0x9d, 0xc9, 0x41, 0xee, 0x2e, 0xa1,
-	0xa3, 0x88, 0x75, 0x3d, 0xcb, 0xd7, 0x35, 0x7e, 0x26, 0x67, 0xd4, 0xc3, 0x8e, 0x58, 0x5e, 0x76,
-	0xf1, 0x0d, 0x3e, 0xad, 0x81, 0x7c, 0xae, 0x4a, 0x2e, 0xc6, 0x9c, 0x9b, 0x86, 0x13, 0x8e, 0x4d,
-	0x41, 0x96, 0xe1, 0x52, 0x66, 0x96, 0xe1, 0xd7, 0x28, 0x41, 0xad, 0x47, 0x22, 0x95, 0x4c, 0x60,
-	0x8e, 0xa2, 0x5a, 0x93, 0x87, 0x61, 0x24, 0x54, 0xdd, 0xa5, 0x49, 0x95, 0x67, 0xf8, 0x89, 0x74,
-	0xfc, 0x08, 0xae, 0xff, 0xbe, 0x06, 0x10, 0x25, 0xcf, 0x26, 0x0f, 0x06, 0xb1, 0x79, 0x44, 0x07,
-	0xc3, 0x7b, 0x09, 0x35, 0x3e, 0xcf, 0xc1, 0x6f, 0x2f, 0x88, 0x0e, 0x83, 0x6d, 0x9e, 0x2e, 0x54,
-	0xbe, 0x97, 0xe0, 0x8e, 0x36, 0xb7, 0x79, 0x09, 0x4a, 0x08, 0xb9, 0x0d, 0x43, 0x4d, 0xd3, 0xe6,
-	0x4f, 0x5b, 0xfa, 0x0b, 0x3d, 0x6d, 0xe1, 0x5a, 0xc7, 0xaa, 0x40, 0x81, 0x01, 0x2e, 0xfd, 0x17,
-	0x35, 0x38, 0x15, 0x8f, 0x32, 0xce, 0x23, 0xfc, 0xc8, 0xe0, 0xb3, 0x32, 0xb9, 0x01, 0x6f, 0x2a,
-	0x63, 0x5e, 0x62, 0x00, 0x8b, 0xdf, 0x81, 0xf7, 0x70, 0xc7, 0x91, 0x1d, 0xec, 0xfc, 0x80, 0xeb,
-	0x86, 0x1f, 0x3b, 0x0d, 0x83, 0x62, 0x93, 0x33, 0x6d, 0x21, 0x23, 0xd6, 0xd7, 0xcd, 0xe2, 0xf9,
-	0x3b, 0x8a, 0x04, 0x00, 0x52, 0xb3, 0x98, 0x96, 0x3a, 0x66, 0x31, 0x45, 0xe8, 0xab, 0xb9, 0x66,
-	0x2f, 0xfe, 0x4e, 0x15, 0x5c, 0x16, 0xfe, 0x4e, 0x15, 0x5c, 0x46, 0x86, 0x8c, 0xf8, 0x31, 0x47,
-	0xa0, 0xfe, 0xe2, 0x86, 0x26, 0x31, 0x01, 0x8a, 0x3b, 0xd0, 0x44, 0x47, 0x57, 0xa0, 0x20, 0x73,
-	0xc1, 0x40, 0xf1, 0xb7, 0x50, 0x72, 0xca, 0xbb, 0xc9, 0x5c, 0x10, 0x7c, 0x48, 0x83, 0xb9, 0x1f,
-	0xd2, 0x26, 0x0c, 0xc9, 0x4f, 0x41, 0xaa, 0x1d, 0x6f, 0xeb, 0x21, 0x57, 0xbf, 0x92, 0x15, 0x4c,
-	0x14, 0x60, 0x80, 0x9c, 0xe9, 0xb2, 0x4d, 0x63, 0xc7, 0x6c, 0xb6, 0x9b, 0x5c, 0xd7, 0x18, 0x50,
-	0xab, 0xf2, 0x62, 0x0c, 0xe0, 0xbc, 0xaa, 0x78, 0x42, 0xc6, 0x75, 0x03, 0xb5, 0xaa, 0x28, 0xc6,
-	0x00, 0x4e, 0x9e, 0x81, 0xe1, 0xa6, 0xb1, 0x53, 0x6d, 0xbb, 0x0d, 0x2a, 0xdd, 0x80, 0xf2, 0x4d,
-	0x4f, 0x6d, 0xdf, 0xb4, 0x66, 0x4d, 0xdb, 0xf7, 0x7c, 0x77, 0x76, 0xd9, 0xf6, 0x6f, 0xb9, 0x55,
-	0x9f, 0xbb, 0x19, 0xf1, 0x5d, 0xb7, 0x2a, 0xb1, 0x60, 0x88, 0x8f, 0x58, 0x30, 0xd1, 0x34, 0x76,
-	0x6e, 0xdb, 0x86, 0x48, 0x4a, 0x21, 0x65, 0xf9, 0x22, 0x14, 0xb8, 0xed, 0x6f, 0x35, 0x86, 0x0b,
-	0x13, 0xb8, 0x33, 0x5c, 0x4e, 0xc7, 0x8e, 0xcb, 0xe5, 0x74, 0x2e, 0x0c, 0xca, 0x20, 0x2e, 0x0e,
-	0x1e, 0xc8, 0x0c, 0x87, 0xd8, 0x31, 0xe0, 0xc2, 0xb3, 0x61, 0xc0, 0x85, 0x89, 0xe2, 0x3e, 0x92,
-	0x1d, 0x82, 0x2d, 0xb4, 0x61, 0xb4, 0x6e, 0xf8, 0x86, 0x28, 0xf5, 0xa6, 0x4f, 0x15, 0xbf, 0x03,
-	0x5f, 0x08, 0xd1, 0x44, 0x2c, 0x29, 0x2a, 0xf3, 0x50, 0xa5, 0x43, 0x6e, 0xc1, 0x59, 0xf6, 0xb1,
-	0x5a, 0xd4, 0x8f, 0xaa, 0x70, 0xcb, 0xdc, 0x24, 0xff, 0x7e, 0xf8, 0xa3, 0xbc, 0x9b, 0x59, 0x15,
-	0x30, 0xbb, 0x5d, 0x14, 0xba, 0x77, 0x2a, 0x3b, 0x74, 0x2f, 0xf9, 0x44, 0x96, 0x73, 0x0f, 0xe1,
-	0x73, 0xfa, 0xce, 0xe2, 0xbc, 0xa1, 0xb0, 0x8b, 0xcf, 0xbf, 0xd0, 0x60, 0x5a, 0xee, 0x32, 0xe9,
-	0x90, 0x63, 0x51, 0x77, 0xd5, 0xb0, 0x8d, 0x06, 0x75, 0xa5, 0x35, 0x7e, 0xa3, 0x07, 0xfe, 0x90,
-	0xc2, 0x19, 0x46, 0xc2, 0x78, 0xd5, 0xfe, 0x5e, 0xf9, 0xca, 0x41, 0xb5, 0x30, 0xb7, 0x6f, 0xc4,
-	0x85, 0x21, 0x6f, 0xd7, 0xab, 0xf9, 0x96, 0x27, 0x25, 0xdd, 0xeb, 0x3d, 0x70, 0xd6, 0xaa, 0xc0,
-	0x24, 0x58, 0x6b, 0x94, 0x8b, 0x52, 0x94, 0x62, 0x40, 0x88, 0xfc, 0x90, 0x06, 0x53, 0xf2, 0x8a,
-	0x4e, 0x89, 0x34, 0x74, 0xb6, 0xf8, 0xb3, 0xa0, 0x4a, 0x12, 0x59, 0xe0, 0x84, 0xc3, 0x6d, 0x56,
-	0x29, 0x28, 0xa6, 0xa9, 0xb3, 0x43, 0xb5, 0xe5, 0x9a, 0x8e, 0x6b, 0xfa, 0xbb, 0xdc, 0xdd, 0x69,
-	0x20, 0xc8, 0x08, 0x20, 0xca, 0x30, 0x84, 0x92, 0x2a, 0x4c, 0x08, 0xdb, 0x50, 0xd5, 0x77, 0x0d,
-	0x9f, 0x36, 0x76, 0xa5, 0x4f, 0xd2, 0xc3, 0x3c, 0x67, 0x73, 0x0c, 0x72, 0x7f, 0xaf, 0x7c, 0x56,
-	0xae, 0x4d, 0x1c, 0x80, 0x09, 0x14, 0xe4, 0x7d, 0x09, 0x17, 0xb1, 0xe9, 0xe2, 0xf9, 0x1c, 0x03,
-	0x79, 0xbc, 0x7b, 0x47, 0xb1, 0x5e, 0x83, 0xe7, 0xf5, 0x90, 0x53, 0x67, 0xe6, 0x31, 0x18, 0x53,
-	0x77, 0xcd, 0xa1, 0x62, 0xf6, 0xf9, 0x40, 0xd2, 0x83, 0x3d, 0xee, 0xb0, 0x46, 0xfa, 0x4f, 0x69,
-	0x30, 0x99, 0x94, 0x5d, 0xc8, 0x16, 0x0c, 0x49, 0x46, 0x26, 0xa9, 0xce, 0x15, 0xf5, 0x8d, 0xb6,
-	0xa8, 0x7c, 0x0d, 0x2d, 0x44, 0x61, 0x59, 0x84, 0x01, 0x7a, 0xf5, 0xdd, 0x43, 0xa9, 0xc3, 0xbb,
-	0x87, 0xc7, 0xe1, 0x5c, 0x36, 0x4b, 0x63, 0x8a, 0x84, 0xc1, 0xf4, 0x2b, 0x69, 0x1a, 0x0f, 0x15,
-	0x09, 0xae, 0x74, 0xa1, 0x80, 0xe9, 0xef, 0x87, 0x64, 0x2e, 0x39, 0xf2, 0x1c, 0x8c, 0x78, 0xde,
-	0x96, 0xf0, 0x66, 0x93, 0x83, 0x2c, 0x76, 0xa1, 0x14, 0xe4, 0xf5, 0x11, 0xba, 0x4f, 0xf8, 0x13,
-	0x23, 0xf4, 0xf3, 0x4f, 0x7f, 0xf9, 0x6b, 0x97, 0x5f, 0xf1, 0x07, 0x5f, 0xbb, 0xfc, 0x8a, 0x3f,
-	0xf9, 0xda, 0xe5, 0x57, 0x7c, 0xd7, 0xfe, 0x65, 0xed, 0xcb, 0xfb, 0x97, 0xb5, 0x3f, 0xd8, 0xbf,
-	0xac, 0xfd, 0xc9, 0xfe, 0x65, 0xed, 0xaf, 0xf6, 0x2f, 0x6b, 0x3f, 0xf8, 0x6f, 0x2f, 0xbf, 0xe2,
-	0x99, 0x47, 0x22, 0xea, 0xd7, 0x02, 0xa2, 0xd1, 0x3f, 0xad, 0xbb, 0x0d, 0xa6, 0x1a, 0x7b, 0x81,
-	0x6e, 0xcc, 0xa9, 0xff, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1d, 0x8d, 0x90, 0x21, 0x4c, 0x2d,
-	0x01, 0x00,
+	// 16602 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7b, 0x70, 0x24, 0xc9,
+	0x5d, 0x20, 0xec, 0xea, 0xd6, 0x33, 0xf5, 0x98, 0x51, 0x8e, 0x66, 0xa6, 0x47, 0x3b, 0x3b, 0x3d,
+	0xae, 0x5d, 0xfb, 0xdb, 0xc5, 0xb6, 0x06, 0xaf, 0xdf, 0x6b, 0xd6, 0xbb, 0x52, 0x4b, 0x9a, 0x91,
+	0x47, 0xd2, 0xc8, 0xbf, 0x96, 0x76, 0xd6, 0x6b, 0x58, 0x53, 0xd3, 0x9d, 0x6a, 0xd5, 0x4e, 0x77,
+	0x55, 0x6f, 0x55, 0xb5, 0x46, 0x5a, 0xbf, 0xb0, 0xc1, 0x60, 0xcc, 0xe3, 0x03, 0x3e, 0xc0, 0xd8,
+	0x86, 0xc0, 0x40, 0x60, 0xbe, 0xef, 0xe3, 0x0e, 0xee, 0xb8, 0x80, 0x0b, 0x20, 0xce, 0x01, 0x44,
+	0x70, 0xd8, 0x71, 0xf8, 0x8e, 0xc0, 0x70, 0x07, 0x01, 0x08, 0x2c, 0x0c, 0xdc, 0x1d, 0xf7, 0x8a,
+	0x23, 0xe2, 0xb8, 0x98, 0x23, 0xe0, 0x22, 0x5f, 0x55, 0x99, 0xf5, 0x68, 0xb5, 0xaa, 0x25, 0x79,
+	0xf7, 0xe0, 0x2f, 0xa9, 0xf3, 0x97, 0xf9, 0xfb, 0x65, 0x66, 0xe5, 0xe3, 0x97, 0xbf, 0x27, 0x9a,
+	0x6f, 0xd8, 0xc1, 0x76, 0xe7, 0xce, 0x6c, 0xcd, 0x6d, 0x5d, 0x6b, 0x58, 0x5e, 0x9d, 0x38, 0xc4,
+	0x8b, 0xfe, 0x69, 0xdf, 0x6d, 0x5c, 0xb3, 0xda, 0xb6, 0x7f, 0xad, 0xe6, 0x7a, 0xe4, 0xda, 0xce,
+	0xeb, 0xef, 0x90, 0xc0, 0x7a, 0xfd, 0xb5, 0x06, 0x85, 0x59, 0x01, 0xa9, 0xcf, 0xb6, 0x3d, 0x37,
+	0x70, 0xf1, 0x63, 0x11, 0x8e, 0x59, 0xd9, 0x34, 0xfa, 0xa7, 0x7d, 0xb7, 0x31, 0x4b, 0x71, 0xcc,
+	0x52, 0x1c, 0xb3, 0x02, 0xc7, 0xcc, 0xeb, 0x54, 0xba, 0x6e, 0xc3, 0xbd, 0xc6, 0x50, 0xdd, 0xe9,
+	0x6c, 0xb1, 0x5f, 0xec, 0x07, 0xfb, 0x8f, 0x93, 0x98, 0x79, 0xf4, 0xee, 0x5b, 0xfd, 0x59, 0xdb,
+	0xa5, 0x9d, 0xb9, 0x66, 0x75, 0x02, 0xd7, 0xaf, 0x59, 0x4d, 0xdb, 0x69, 0x5c, 0xdb, 0x49, 0xf4,
+	0x66, 0xc6, 0x54, 0xaa, 0x8a, 0x6e, 0x77, 0xad, 0xe3, 0xdd, 0xb1, 0x6a, 0x69, 0x75, 0x6e, 0x44,
+	0x75, 0xc8, 0x6e, 0x40, 0x1c, 0xdf, 0x76, 0x1d, 0xff, 0x75, 0x74, 0x24, 0xc4, 0xdb, 0x51, 0xe7,
+	0x46, 0xab, 0x90, 0x86, 0xe9, 0x8d, 0x11, 0xa6, 0x96, 0x55, 0xdb, 0xb6, 0x1d, 0xe2, 0xed, 0xc9,
+	0xe6, 0xd7, 0x3c, 0xe2, 0xbb, 0x1d, 0xaf, 0x46, 0x8e, 0xd4, 0xca, 0xbf, 0xd6, 0x22, 0x81, 0x95,
+	0x46, 0xeb, 0x5a, 0x56, 0x2b, 0xaf, 0xe3, 0x04, 0x76, 0x2b, 0x49, 0xe6, 0xcd, 0x87, 0x35, 0xf0,
+	0x6b, 0xdb, 0xa4, 0x65, 0x25, 0xda, 0xbd, 0x21, 0xab, 0x5d, 0x27, 0xb0, 0x9b, 0xd7, 0x6c, 0x27,
+	0xf0, 0x03, 0x2f, 0xde, 0xc8, 0xfc, 0xb8, 0x81, 0xce, 0xce, 0xad, 0x2f, 0x57, 0xd9, 0x0c, 0xae,
+	0xb8, 0x8d, 0x86, 0xed, 0x34, 0xf0, 0x6b, 0xd0, 0xe8, 0x0e, 0xf1, 0xee, 0xb8, 0xbe, 0x1d, 0xec,
+	0x95, 0x8c, 0xab, 0xc6, 0x23, 0x83, 0xf3, 0x13, 0x07, 0xfb, 0xe5, 0xd1, 0xa7, 0x65, 0x21, 0x44,
+	0x70, 0xbc, 0x8c, 0xce, 0x6d, 0x07, 0x41, 0x7b, 0xae, 0x56, 0x23, 0xbe, 0x1f, 0xd6, 0x28, 0x15,
+	0x58, 0xb3, 0x8b, 0x07, 0xfb, 0xe5, 0x73, 0x37, 0x36, 0x36, 0xd6, 0x63, 0x60, 0x48, 0x6b, 0x63,
+	0xfe, 0x9c, 0x81, 0xa6, 0xc2, 0xce, 0x00, 0x79, 0xa1, 0x43, 0xfc, 0xc0, 0xc7, 0x80, 0x2e, 0xb4,
+	0xac, 0xdd, 0x35, 0xd7, 0x59, 0xed, 0x04, 0x56, 0x60, 0x3b, 0x8d, 0x65, 0x67, 0xab, 0x69, 0x37,
+	0xb6, 0x03, 0xd1, 0xb5, 0x99, 0x83, 0xfd, 0xf2, 0x85, 0xd5, 0xd4, 0x1a, 0x90, 0xd1, 0x92, 0x76,
+	0xba, 0x65, 0xed, 0x26, 0x10, 0x2a, 0x9d, 0x5e, 0x4d, 0x82, 0x21, 0xad, 0x8d, 0xf9, 0x26, 0x34,
+	0xc5, 0xc7, 0x01, 0xc4, 0x0f, 0x3c, 0xbb, 0x16, 0xd8, 0xae, 0x83, 0xaf, 0xa2, 0x01, 0xc7, 0x6a,
+	0x11, 0xd6, 0xc3, 0xd1, 0xf9, 0xf1, 0xcf, 0xef, 0x97, 0x5f, 0x71, 0xb0, 0x5f, 0x1e, 0x58, 0xb3,
+	0x5a, 0x04, 0x18, 0xc4, 0xfc, 0x1f, 0x05, 0x74, 0x39, 0xd1, 0xee, 0xb6, 0x1d, 0x6c, 0xdf, 0x6a,
+	0xd3, 0xff, 0x7c, 0xfc, 0xdd, 0x06, 0x9a, 0xb2, 0xe2, 0x15, 0x18, 0xc2, 0xb1, 0xc7, 0x16, 0x67,
+	0x8f, 0xbe, 0xc1, 0x67, 0x13, 0xd4, 0xe6, 0x2f, 0x89, 0x7e, 0x25, 0x07, 0x00, 0x49, 0xd2, 0xf8,
+	0x63, 0x06, 0x1a, 0x76, 0x79, 0xe7, 0x4a, 0x85, 0xab, 0xc5, 0x47, 0xc6, 0x1e, 0xfb, 0x86, 0x63,
+	0xe9, 0x86, 0x32, 0xe8, 0x59, 0xf1, 0x77, 0xd1, 0x09, 0xbc, 0xbd, 0xf9, 0x33, 0xa2, 0x7b, 0xc3,
+	0xa2, 0x14, 0x24, 0xf9, 0x99, 0xc7, 0xd1, 0xb8, 0x5a, 0x13, 0x9f, 0x45, 0xc5, 0xbb, 0x84, 0x2f,
+	0xd5, 0x51, 0xa0, 0xff, 0xe2, 0x69, 0x34, 0xb8, 0x63, 0x35, 0x3b, 0x84, 0x7d, 0xd2, 0x51, 0xe0,
+	0x3f, 0x1e, 0x2f, 0xbc, 0xd5, 0x30, 0x1f, 0x43, 0x83, 0x73, 0xf5, 0xba, 0xeb, 0xe0, 0x47, 0xd1,
+	0x30, 0x71, 0xac, 0x3b, 0x4d, 0x52, 0x67, 0x0d, 0x47, 0x22, 0x7a, 0x8b, 0xbc, 0x18, 0x24, 0xdc,
+	0xfc, 0x81, 0x02, 0x1a, 0x62, 0x8d, 0x7c, 0xfc, 0x7d, 0x06, 0x3a, 0x77, 0xb7, 0x73, 0x87, 0x78,
+	0x0e, 0x09, 0x88, 0xbf, 0x60, 0xf9, 0xdb, 0x77, 0x5c, 0xcb, 0xab, 0x8b, 0x0f, 0x73, 0x3d, 0xcf,
+	0x8c, 0xdc, 0x4c, 0xa2, 0xe3, 0x6b, 0x30, 0x05, 0x00, 0x69, 0xc4, 0xf1, 0x0e, 0x1a, 0x77, 0x1a,
+	0xb6, 0xb3, 0xbb, 0xec, 0x34, 0x3c, 0xe2, 0xfb, 0x6c, 0xd0, 0x63, 0x8f, 0x3d, 0x95, 0xa7, 0x33,
+	0x6b, 0x0a, 0x9e, 0xf9, 0xb3, 0x07, 0xfb, 0xe5, 0x71, 0xb5, 0x04, 0x34, 0x3a, 0xe6, 0xdf, 0x1a,
+	0xe8, 0xcc, 0x5c, 0xbd, 0x65, 0xfb, 0xf4, 0xa4, 0x5d, 0x6f, 0x76, 0x1a, 0x76, 0x0f, 0x4b, 0x1f,
+	0xbf, 0x0b, 0x0d, 0xd5, 0x5c, 0x67, 0xcb, 0x6e, 0x88, 0x7e, 0xbe, 0x6e, 0x96, 0x9f, 0x5c, 0xb3,
+	0xea, 0xc9, 0xc5, 0xba, 0x27, 0x4e, 0xbc, 0x59, 0xb0, 0xee, 0x2d, 0xca, 0x03, 0x7d, 0x1e, 0x1d,
+	0xec, 0x97, 0x87, 0x2a, 0x0c, 0x01, 0x08, 0x44, 0xf8, 0x11, 0x34, 0x52, 0xb7, 0x7d, 0xfe, 0x31,
+	0x8b, 0xec, 0x63, 0x8e, 0x1f, 0xec, 0x97, 0x47, 0x16, 0x44, 0x19, 0x84, 0x50, 0xbc, 0x82, 0xa6,
+	0xe9, 0x0c, 0xf2, 0x76, 0x55, 0x52, 0xf3, 0x48, 0x40, 0xbb, 0x56, 0x1a, 0x60, 0xdd, 0x2d, 0x1d,
+	0xec, 0x97, 0xa7, 0x6f, 0xa6, 0xc0, 0x21, 0xb5, 0x95, 0xb9, 0x84, 0x46, 0xe6, 0x9a, 0xc4, 0xa3,
+	0x07, 0x02, 0x7e, 0x1c, 0x4d, 0x92, 0x96, 0x65, 0x37, 0x81, 0xd4, 0x88, 0xbd, 0x43, 0x3c, 0xbf,
+	0x64, 0x5c, 0x2d, 0x3e, 0x32, 0x3a, 0x8f, 0x0f, 0xf6, 0xcb, 0x93, 0x8b, 0x1a, 0x04, 0x62, 0x35,
+	0xcd, 0x0f, 0x1b, 0x68, 0x6c, 0xae, 0x53, 0xb7, 0x03, 0x3e, 0x2e, 0xec, 0xa1, 0x31, 0x8b, 0xfe,
+	0x5c, 0x77, 0x9b, 0x76, 0x6d, 0x4f, 0x2c, 0xae, 0x27, 0x73, 0x6d, 0xb7, 0x08, 0xcd, 0xfc, 0x99,
+	0x83, 0xfd, 0xf2, 0x98, 0x52, 0x00, 0x2a, 0x11, 0x73, 0x1b, 0xa9, 0x30, 0xfc, 0x6e, 0x34, 0xce,
+	0x87, 0xbb, 0x6a, 0xb5, 0x81, 0x6c, 0x89, 0x3e, 0x3c, 0xa4, 0x7c, 0x2b, 0x49, 0x68, 0xf6, 0xd6,
+	0x9d, 0xe7, 0x49, 0x2d, 0x00, 0xb2, 0x45, 0x3c, 0xe2, 0xd4, 0x08, 0x5f, 0x36, 0x15, 0xa5, 0x31,
+	0x68, 0xa8, 0xcc, 0xff, 0xc7, 0x40, 0x0f, 0xce, 0x75, 0x82, 0x6d, 0xd7, 0xb3, 0x5f, 0x24, 0x5e,
+	0x34, 0xdd, 0x21, 0x06, 0xfc, 0x0e, 0x34, 0x69, 0x85, 0x15, 0xd6, 0xa2, 0xe5, 0x74, 0x41, 0x2c,
+	0xa7, 0xc9, 0x39, 0x0d, 0x0a, 0xb1, 0xda, 0xf8, 0x31, 0x84, 0xfc, 0xe8, 0xdb, 0xb2, 0x33, 0x60,
+	0x1e, 0x8b, 0xb6, 0x48, 0xf9, 0xaa, 0x4a, 0x2d, 0xf3, 0x47, 0xe9, 0x62, 0xee, 0x04, 0xee, 0x0d,
+	0x9b, 0x6e, 0x30, 0x2b, 0xb0, 0x8f, 0x74, 0x46, 0x60, 0x0b, 0x8d, 0xd9, 0xf5, 0x26, 0xd9, 0xb0,
+	0x5b, 0xc4, 0xed, 0x04, 0x62, 0x69, 0xcf, 0x66, 0x2e, 0x6d, 0xf6, 0xa5, 0x28, 0xcf, 0x40, 0x27,
+	0x70, 0xa1, 0xe3, 0x31, 0x7a, 0xfc, 0x0b, 0x2d, 0x47, 0x68, 0x40, 0xc5, 0x69, 0xfe, 0x31, 0xbd,
+	0xac, 0x77, 0x2c, 0xbb, 0x69, 0xdd, 0xb1, 0x9b, 0x76, 0xb0, 0xf7, 0xac, 0xeb, 0x90, 0x1e, 0xf6,
+	0xdb, 0x26, 0xba, 0xd8, 0x71, 0x2c, 0xde, 0xae, 0x49, 0x56, 0x79, 0x37, 0x36, 0xf6, 0xda, 0x84,
+	0x9f, 0xe3, 0xa3, 0xf3, 0x0f, 0x1c, 0xec, 0x97, 0x2f, 0x6e, 0xa6, 0x57, 0x81, 0xac, 0xb6, 0xf4,
+	0x5e, 0x56, 0x40, 0x4f, 0xbb, 0xcd, 0x4e, 0x4b, 0x60, 0x2d, 0x32, 0xac, 0xec, 0x5e, 0xde, 0x4c,
+	0xad, 0x01, 0x19, 0x2d, 0xcd, 0xcf, 0x16, 0xd0, 0xd0, 0xbc, 0x55, 0xbb, 0xdb, 0x69, 0xe3, 0xd7,
+	0xa2, 0x91, 0xb6, 0xe7, 0xee, 0xd8, 0x75, 0xe2, 0x89, 0xb1, 0x9d, 0x15, 0x63, 0x1b, 0x59, 0x17,
+	0xe5, 0x10, 0xd6, 0xc0, 0x36, 0x9a, 0x94, 0xff, 0x57, 0xfa, 0x38, 0x5b, 0xd8, 0x5e, 0x5d, 0xd7,
+	0x10, 0x41, 0x0c, 0x31, 0x36, 0xd1, 0x90, 0x47, 0x1a, 0xf4, 0x32, 0x2e, 0xb2, 0x6e, 0xb1, 0xf3,
+	0x08, 0x58, 0x09, 0x08, 0x08, 0x7e, 0x2f, 0x9a, 0xac, 0x79, 0xa4, 0x4e, 0x9c, 0xc0, 0xb6, 0x9a,
+	0x3e, 0xdd, 0x3e, 0x83, 0xbd, 0x6f, 0x1f, 0xd6, 0x89, 0x8a, 0xd6, 0x1c, 0x62, 0xe8, 0xcc, 0xcf,
+	0x17, 0xd0, 0x38, 0x9f, 0xa8, 0xf9, 0x4e, 0xed, 0x2e, 0x09, 0xf0, 0x37, 0xa2, 0x11, 0xba, 0x9a,
+	0xea, 0x56, 0x60, 0x89, 0xad, 0xfa, 0xb5, 0xbd, 0xad, 0x3d, 0x4e, 0x7d, 0x95, 0x04, 0x56, 0xb4,
+	0x43, 0xa2, 0x32, 0x08, 0xb1, 0xe2, 0x2d, 0x34, 0xe0, 0xb7, 0x49, 0x4d, 0x4c, 0xec, 0x42, 0x9e,
+	0xc3, 0x48, 0xed, 0x71, 0xb5, 0x4d, 0x6a, 0xd1, 0x72, 0xa5, 0xbf, 0x80, 0xe1, 0xc7, 0x0e, 0x1a,
+	0xf2, 0x03, 0x2b, 0xe8, 0xf8, 0x6c, 0x7e, 0xc7, 0x1e, 0x5b, 0xea, 0x9b, 0x12, 0xc3, 0x36, 0x3f,
+	0x29, 0x68, 0x0d, 0xf1, 0xdf, 0x20, 0xa8, 0x98, 0xff, 0xce, 0x40, 0x67, 0xd5, 0xea, 0x2b, 0xb6,
+	0x1f, 0xe0, 0xaf, 0x4f, 0x4c, 0x67, 0x8f, 0x5b, 0x99, 0xb6, 0x66, 0x93, 0x19, 0xae, 0x56, 0x59,
+	0xa2, 0x4c, 0x25, 0x41, 0x83, 0x76, 0x40, 0x5a, 0x92, 0x8f, 0x7a, 0xaa, 0xdf, 0x11, 0xce, 0x4f,
+	0x08, 0x62, 0x83, 0xcb, 0x14, 0x2d, 0x70, 0xec, 0xe6, 0x37, 0xa2, 0x69, 0xb5, 0x96, 0x5c, 0xd7,
+	0xf4, 0xc8, 0x08, 0xf6, 0xda, 0x89, 0x23, 0x83, 0x6e, 0x41, 0x60, 0x10, 0xfc, 0xea, 0x70, 0x8d,
+	0xf3, 0xb3, 0x33, 0x9c, 0x3b, 0x7d, 0x9d, 0x9b, 0xff, 0xa6, 0xa8, 0xcf, 0x1d, 0xfd, 0x8c, 0x78,
+	0x27, 0xb6, 0x73, 0xc7, 0x1e, 0xbb, 0xd1, 0xef, 0x00, 0x65, 0xd7, 0x5f, 0x2a, 0x67, 0xc0, 0x23,
+	0x68, 0xc4, 0x27, 0xa4, 0xae, 0x70, 0x0e, 0x8c, 0xdf, 0xa8, 0x8a, 0x32, 0x08, 0xa1, 0x27, 0x7e,
+	0x12, 0xe0, 0x55, 0x34, 0xe2, 0x6f, 0xbb, 0x2e, 0x6d, 0x55, 0x1a, 0xea, 0x1d, 0x35, 0xef, 0xaf,
+	0x68, 0x08, 0x21, 0x0a, 0xf3, 0x33, 0x03, 0x08, 0x27, 0x37, 0x8f, 0x3a, 0xb7, 0xbc, 0xa4, 0x64,
+	0xf4, 0x3d, 0xb7, 0x62, 0x1f, 0xc6, 0x10, 0xe3, 0x17, 0xd1, 0x44, 0xd3, 0xf2, 0x83, 0x5b, 0x6d,
+	0xc2, 0x2f, 0x45, 0xf1, 0x15, 0xe7, 0xf2, 0xac, 0xa1, 0x15, 0x15, 0xd1, 0xfc, 0xd4, 0xc1, 0x7e,
+	0x79, 0x42, 0x2b, 0x02, 0x9d, 0x14, 0x7e, 0x1e, 0x8d, 0xd2, 0x82, 0x45, 0xcf, 0x73, 0x3d, 0x71,
+	0xfc, 0x3c, 0x91, 0x97, 0x2e, 0x43, 0xc2, 0x1f, 0xce, 0xe1, 0x4f, 0x88, 0xd0, 0xe3, 0x77, 0x22,
+	0xec, 0xde, 0x61, 0xa2, 0x8b, 0xfa, 0x75, 0xe2, 0xc8, 0xc1, 0xd2, 0xd5, 0x54, 0x9c, 0x9f, 0x11,
+	0xcb, 0x1c, 0xdf, 0x4a, 0xd4, 0x80, 0x94, 0x56, 0xf8, 0x2e, 0xc2, 0xe1, 0xcb, 0x9e, 0xb3, 0x37,
+	0x87, 0xac, 0xb4, 0xb0, 0x92, 0x58, 0x0e, 0x17, 0x28, 0xb1, 0xeb, 0x09, 0x14, 0x90, 0x82, 0xd6,
+	0xfc, 0xf5, 0x02, 0x1a, 0xe3, 0x4b, 0x84, 0xbf, 0xbe, 0x4e, 0xfe, 0xea, 0x21, 0xda, 0xd5, 0x53,
+	0xc9, 0x7f, 0x9a, 0xb0, 0x0e, 0x67, 0xde, 0x3c, 0xad, 0xd8, 0xcd, 0xb3, 0xd8, 0x2f, 0xa1, 0xee,
+	0x17, 0xcf, 0xef, 0x1a, 0xe8, 0x8c, 0x52, 0xfb, 0x14, 0xee, 0x9d, 0xba, 0x7e, 0xef, 0x3c, 0xd9,
+	0xe7, 0xf8, 0x32, 0xae, 0x9d, 0xcf, 0xe9, 0xe3, 0x62, 0x77, 0xc2, 0x63, 0x08, 0xdd, 0x61, 0xe7,
+	0x89, 0xc2, 0xcc, 0x87, 0xdf, 0x7c, 0x3e, 0x84, 0x80, 0x52, 0x4b, 0x3b, 0x64, 0x0b, 0x5d, 0x0f,
+	0x59, 0xf5, 0x0c, 0x2c, 0xf6, 0x7f, 0x06, 0xfe, 0x59, 0x11, 0x4d, 0x25, 0x3e, 0x63, 0xf2, 0x5c,
+	0x32, 0xbe, 0x4a, 0xe7, 0x52, 0xe1, 0xab, 0x71, 0x2e, 0x15, 0x73, 0x9d, 0x4b, 0xbd, 0xdf, 0x93,
+	0x1e, 0xc2, 0x2d, 0xbb, 0xc1, 0x9b, 0x55, 0x03, 0xcb, 0x0b, 0xe8, 0xa3, 0x47, 0x9c, 0x60, 0x5f,
+	0xd3, 0xdb, 0x16, 0xa0, 0x2d, 0xf8, 0x41, 0xb6, 0x9a, 0xc0, 0x04, 0x29, 0xd8, 0xcd, 0x6f, 0x2e,
+	0xa0, 0xe1, 0x79, 0xcb, 0x67, 0x3d, 0xfd, 0x00, 0x1a, 0x17, 0xa8, 0x97, 0x5b, 0x56, 0x83, 0xf4,
+	0x23, 0xcf, 0x11, 0x28, 0x57, 0x15, 0x74, 0xfc, 0x49, 0xac, 0x96, 0x80, 0x46, 0x0e, 0xef, 0xa1,
+	0xb1, 0x56, 0xf4, 0xb8, 0x2a, 0x15, 0xfa, 0xe1, 0x7c, 0x55, 0xea, 0x14, 0x1b, 0x7f, 0x55, 0x2a,
+	0x05, 0xa0, 0xd2, 0x32, 0x9f, 0x43, 0xe7, 0x52, 0x7a, 0xdc, 0xc3, 0xbb, 0xf2, 0x55, 0x68, 0x98,
+	0x0a, 0x2f, 0x22, 0x2e, 0x71, 0x8c, 0x3e, 0x8c, 0x9f, 0xe6, 0x45, 0x20, 0x61, 0xe6, 0x9b, 0x11,
+	0xd6, 0xf1, 0x53, 0xaa, 0xbd, 0x48, 0x48, 0x07, 0x11, 0xaa, 0xcc, 0x81, 0x1b, 0xf0, 0xa5, 0xf4,
+	0x24, 0x1a, 0x6c, 0x6f, 0x5b, 0xbe, 0x6c, 0xf1, 0xa8, 0x3c, 0x7a, 0xd6, 0x69, 0xe1, 0xfd, 0xfd,
+	0x72, 0x49, 0xe5, 0x93, 0x44, 0x23, 0x06, 0x03, 0xde, 0x8e, 0xae, 0x30, 0xba, 0xc8, 0x2b, 0x6e,
+	0xab, 0xdd, 0x24, 0x14, 0xca, 0x56, 0x58, 0x21, 0xdf, 0x0a, 0x5b, 0x49, 0x60, 0x82, 0x14, 0xec,
+	0x92, 0xe6, 0xb2, 0x63, 0x07, 0xb6, 0x15, 0xd2, 0x2c, 0xe6, 0xa7, 0xa9, 0x63, 0x82, 0x14, 0xec,
+	0xf8, 0xe3, 0x06, 0x9a, 0xd1, 0x8b, 0x97, 0x6c, 0xc7, 0xf6, 0xb7, 0x49, 0x7d, 0xc3, 0x16, 0xdb,
+	0xf0, 0x68, 0xc4, 0xaf, 0x1c, 0xec, 0x97, 0x67, 0x56, 0x32, 0x31, 0x42, 0x17, 0x6a, 0xf8, 0xbb,
+	0x0c, 0xf4, 0x40, 0x6c, 0x5e, 0x3c, 0xbb, 0xd1, 0x20, 0x1e, 0xa9, 0xe7, 0xdc, 0xe0, 0xe5, 0x83,
+	0xfd, 0xf2, 0x03, 0x2b, 0xd9, 0x28, 0xa1, 0x1b, 0x3d, 0xfc, 0x63, 0x06, 0xba, 0xd0, 0x26, 0x4e,
+	0xdd, 0x76, 0x1a, 0xb7, 0x5d, 0xef, 0x2e, 0x95, 0xc5, 0xb9, 0xcd, 0xa6, 0xdb, 0x09, 0xfc, 0xd2,
+	0x10, 0xbb, 0x13, 0x97, 0xf3, 0xec, 0xb9, 0xf5, 0x34, 0x8c, 0xf3, 0x57, 0xc4, 0x12, 0xbd, 0x90,
+	0x0a, 0xf6, 0x21, 0xa3, 0x23, 0xe6, 0xaf, 0x19, 0xa8, 0x58, 0x81, 0x65, 0xfc, 0x1a, 0x6d, 0x8b,
+	0x5c, 0x54, 0xb7, 0xc8, 0xfd, 0xfd, 0xf2, 0x70, 0x05, 0x96, 0x95, 0xcd, 0xf8, 0x5d, 0x06, 0x9a,
+	0xaa, 0xb9, 0x4e, 0x60, 0xd1, 0xb9, 0x03, 0xce, 0x7b, 0xcb, 0x7b, 0x3e, 0xd7, 0x5b, 0xbd, 0x12,
+	0x43, 0x16, 0x69, 0x0b, 0xe2, 0x10, 0x1f, 0x92, 0x94, 0xcd, 0x1f, 0x37, 0xd0, 0x74, 0xc5, 0x6a,
+	0x0b, 0x49, 0xd5, 0x02, 0xd9, 0xb2, 0x1d, 0xbb, 0x37, 0xd5, 0x08, 0xde, 0x46, 0x43, 0x4c, 0x5c,
+	0xef, 0xf7, 0x23, 0x6a, 0x88, 0x68, 0x3f, 0xcd, 0x70, 0x71, 0x31, 0x0d, 0xff, 0x1f, 0x04, 0x7e,
+	0xf3, 0x09, 0x74, 0x36, 0x5e, 0x0f, 0x97, 0x25, 0x8f, 0xc4, 0xa5, 0xb7, 0xa3, 0x71, 0xf6, 0xe6,
+	0xf1, 0x91, 0x1f, 0xfa, 0x4c, 0xf9, 0x15, 0xdf, 0xf4, 0x87, 0x57, 0x5f, 0x61, 0xfe, 0xb5, 0x81,
+	0xce, 0x57, 0x88, 0x17, 0xd8, 0x5b, 0x76, 0xcd, 0x0a, 0xc8, 0xe2, 0x6e, 0xdb, 0xf6, 0xac, 0x1e,
+	0x07, 0xb9, 0x8e, 0xa6, 0x6b, 0x51, 0x53, 0x21, 0xce, 0x14, 0x7a, 0xb3, 0x91, 0xf9, 0xcb, 0xa2,
+	0xc5, 0x74, 0x25, 0xa5, 0x0e, 0xa4, 0xb6, 0xc4, 0xcf, 0xa3, 0x49, 0x12, 0xf6, 0x20, 0xef, 0x39,
+	0x23, 0xe5, 0xab, 0x8b, 0x1a, 0x26, 0x88, 0x61, 0x36, 0x7f, 0xcf, 0x40, 0xe3, 0x95, 0xa6, 0xdb,
+	0xa9, 0xaf, 0x7b, 0xee, 0x96, 0xdd, 0x24, 0x2f, 0x0f, 0xf1, 0x93, 0xda, 0xe3, 0xac, 0x47, 0x00,
+	0x13, 0x07, 0xa9, 0x15, 0x5f, 0x26, 0xe2, 0x20, 0xb5, 0xcb, 0x19, 0x7c, 0xf9, 0x7b, 0xd0, 0x79,
+	0xb5, 0x56, 0x24, 0x6d, 0xbf, 0x8a, 0x06, 0xee, 0xda, 0x4e, 0x3d, 0xbe, 0x5a, 0x6f, 0xda, 0x4e,
+	0x1d, 0x18, 0x24, 0x5c, 0xcf, 0x85, 0xcc, 0xdb, 0xfa, 0x7f, 0x22, 0x7d, 0xda, 0x18, 0xd7, 0xff,
+	0x08, 0x1a, 0xa9, 0x59, 0xf3, 0x1d, 0xa7, 0xde, 0x0c, 0xb7, 0x02, 0x9d, 0x82, 0xca, 0x1c, 0x2f,
+	0x83, 0x10, 0x8a, 0x5f, 0x44, 0x28, 0x52, 0x6c, 0xf5, 0xc3, 0xfe, 0x44, 0x3a, 0xb3, 0x2a, 0x09,
+	0x02, 0xdb, 0x69, 0xf8, 0xd1, 0xba, 0x8a, 0x60, 0xa0, 0x50, 0xc3, 0x1f, 0x40, 0x13, 0x2a, 0x2f,
+	0xc6, 0xe5, 0xd7, 0x39, 0x3f, 0x83, 0xc6, 0xf4, 0x9d, 0x17, 0x84, 0x27, 0xd4, 0x52, 0x1f, 0x74,
+	0x6a, 0x78, 0x2f, 0xe4, 0x3c, 0xb9, 0xf4, 0x7c, 0x20, 0xff, 0xdb, 0x4c, 0x65, 0xfa, 0xa6, 0x05,
+	0xf1, 0x71, 0x4d, 0x9a, 0xaf, 0x91, 0x4a, 0x91, 0x98, 0x0d, 0x9e, 0x94, 0xc4, 0x8c, 0xa0, 0x61,
+	0x2e, 0x33, 0x94, 0x17, 0xed, 0xe3, 0x79, 0x06, 0xc8, 0xc5, 0x8f, 0x91, 0x16, 0x86, 0xff, 0xf6,
+	0x41, 0xe2, 0xa6, 0x9a, 0x50, 0xfa, 0xa4, 0xa8, 0x92, 0x26, 0xa9, 0x05, 0xae, 0x57, 0x1a, 0xce,
+	0xaf, 0x09, 0xad, 0x2a, 0x78, 0x38, 0xff, 0xae, 0x96, 0x80, 0x46, 0x27, 0x14, 0xa9, 0x8e, 0x64,
+	0x8a, 0x54, 0x3b, 0x68, 0x6c, 0x47, 0xd1, 0x91, 0x8c, 0xb2, 0x49, 0x78, 0x47, 0x9e, 0x8e, 0x45,
+	0x0a, 0x93, 0xf9, 0x73, 0x82, 0xd0, 0x98, 0xaa, 0x5c, 0x51, 0xe9, 0xe0, 0x3b, 0x68, 0xf8, 0x0e,
+	0xe7, 0xbe, 0x4b, 0x88, 0xcd, 0xc5, 0xdb, 0xfb, 0x78, 0x54, 0x70, 0x0e, 0x5f, 0xfc, 0x00, 0x89,
+	0x18, 0x3f, 0x87, 0x86, 0x9a, 0x76, 0xcb, 0x0e, 0xfc, 0xd2, 0xd8, 0x55, 0x23, 0xef, 0xa7, 0x5d,
+	0x61, 0x18, 0xf8, 0x35, 0xcd, 0xff, 0x07, 0x81, 0x15, 0x7f, 0xc2, 0x40, 0xe7, 0xc4, 0x32, 0x0c,
+	0xaf, 0x6b, 0x9b, 0xf8, 0xa5, 0xf1, 0xab, 0xc5, 0xbc, 0xc2, 0xe5, 0x34, 0xd6, 0x64, 0xfe, 0x01,
+	0x31, 0x9b, 0xe7, 0x56, 0x93, 0xc4, 0x20, 0xad, 0x07, 0xf8, 0x23, 0x06, 0x3a, 0xf3, 0x42, 0xc7,
+	0x0d, 0xac, 0xb9, 0x5a, 0xcd, 0xa5, 0x5b, 0xc3, 0x69, 0x94, 0x26, 0xf2, 0x0b, 0xa9, 0xde, 0xa5,
+	0xa3, 0x9a, 0x3f, 0x77, 0xb0, 0x5f, 0x3e, 0x13, 0x2b, 0x84, 0x38, 0x41, 0xf3, 0xe3, 0x53, 0x68,
+	0xaa, 0xd2, 0xec, 0xf8, 0x01, 0xf1, 0xe6, 0x84, 0x25, 0x16, 0xf1, 0x68, 0xd7, 0x2e, 0xb0, 0x7f,
+	0x17, 0xdc, 0x7b, 0xce, 0x02, 0x69, 0x5a, 0x7b, 0x73, 0x5b, 0xb4, 0x46, 0xbd, 0x5e, 0x32, 0x72,
+	0xe9, 0x26, 0x99, 0x3e, 0xaf, 0x9a, 0x8a, 0x11, 0x32, 0x28, 0xe1, 0xef, 0x30, 0xd0, 0xa5, 0x14,
+	0xd0, 0x02, 0x69, 0x92, 0x80, 0xe4, 0xd4, 0x91, 0x3e, 0x78, 0xb0, 0x5f, 0xbe, 0x54, 0xcd, 0x42,
+	0x0a, 0xd9, 0xf4, 0xa8, 0x49, 0xcd, 0x4c, 0x0a, 0x74, 0xc9, 0xb2, 0x9b, 0x1d, 0x4f, 0xb2, 0x4b,
+	0x47, 0xed, 0x0e, 0x7b, 0x1d, 0x55, 0x33, 0xb1, 0x42, 0x17, 0x8a, 0xf8, 0x43, 0xe8, 0x7c, 0x08,
+	0xdd, 0x74, 0x1c, 0x42, 0xea, 0xda, 0x23, 0xed, 0xa8, 0x5d, 0xb9, 0x74, 0xb0, 0x5f, 0x3e, 0x5f,
+	0x4d, 0x43, 0x08, 0xe9, 0x74, 0x70, 0x03, 0x3d, 0x18, 0x01, 0x02, 0xbb, 0x69, 0xbf, 0xc8, 0x79,
+	0xbc, 0x6d, 0x8f, 0xf8, 0xdb, 0x6e, 0xb3, 0xce, 0xee, 0x03, 0x63, 0xfe, 0x95, 0x07, 0xfb, 0xe5,
+	0x07, 0xab, 0xdd, 0x2a, 0x42, 0x77, 0x3c, 0xb8, 0x8e, 0xc6, 0xfd, 0x9a, 0xe5, 0x2c, 0x3b, 0x01,
+	0xf1, 0x76, 0xac, 0x66, 0x69, 0x28, 0xd7, 0x00, 0xf9, 0x29, 0xac, 0xe0, 0x01, 0x0d, 0x2b, 0x7e,
+	0x2b, 0x1a, 0x21, 0xbb, 0x6d, 0xcb, 0xa9, 0x13, 0x7e, 0xf2, 0x8f, 0xce, 0x5f, 0xa6, 0xfc, 0xc6,
+	0xa2, 0x28, 0xbb, 0xbf, 0x5f, 0x1e, 0x97, 0xff, 0xaf, 0xba, 0x75, 0x02, 0x61, 0x6d, 0xfc, 0x7e,
+	0x34, 0xcd, 0x4c, 0xc5, 0xea, 0x84, 0xdd, 0x63, 0xbe, 0x64, 0xa1, 0x47, 0x72, 0xf5, 0x93, 0x99,
+	0x91, 0xac, 0xa6, 0xe0, 0x83, 0x54, 0x2a, 0xf4, 0x33, 0xb4, 0xac, 0xdd, 0xeb, 0x9e, 0x55, 0x23,
+	0x5b, 0x9d, 0xe6, 0x06, 0xf1, 0x5a, 0x36, 0x37, 0x40, 0xa8, 0x92, 0x9a, 0xeb, 0xd4, 0xe9, 0x6d,
+	0x41, 0x0d, 0xd3, 0xd8, 0x67, 0x58, 0xed, 0x56, 0x11, 0xba, 0xe3, 0xc1, 0x6f, 0x44, 0xe3, 0x76,
+	0xc3, 0x71, 0x3d, 0xb2, 0x61, 0xd9, 0x4e, 0xe0, 0x97, 0x10, 0x7b, 0xe3, 0xb0, 0x69, 0x5d, 0x56,
+	0xca, 0x41, 0xab, 0x85, 0x77, 0x10, 0x76, 0xc8, 0xbd, 0x75, 0xb7, 0xce, 0x96, 0xc0, 0x66, 0x9b,
+	0x2d, 0xe4, 0xd2, 0x58, 0xae, 0xa9, 0x61, 0x92, 0x8c, 0xb5, 0x04, 0x36, 0x48, 0xa1, 0x80, 0x97,
+	0x10, 0x6e, 0x59, 0xbb, 0x8b, 0xad, 0x76, 0xb0, 0x37, 0xdf, 0x69, 0xde, 0x15, 0xa7, 0xc6, 0x38,
+	0x9b, 0x0b, 0x2e, 0xe7, 0x4b, 0x40, 0x21, 0xa5, 0x05, 0xb6, 0xd0, 0x03, 0x7c, 0x3c, 0x0b, 0x16,
+	0x69, 0xb9, 0x8e, 0x4f, 0x02, 0x5f, 0x59, 0xa4, 0xec, 0xc0, 0x1e, 0xe1, 0x72, 0x85, 0xe5, 0xec,
+	0x6a, 0xd0, 0x0d, 0x87, 0x6e, 0x32, 0x39, 0x79, 0x88, 0xc9, 0xe4, 0x5b, 0xd0, 0x84, 0x1f, 0x58,
+	0x5e, 0xd0, 0x69, 0x8b, 0xcf, 0x70, 0x86, 0x7d, 0x06, 0x26, 0x06, 0xae, 0xaa, 0x00, 0xd0, 0xeb,
+	0xd1, 0xcf, 0xc7, 0x75, 0x07, 0xa2, 0xdd, 0xd9, 0xe8, 0xf3, 0x55, 0x95, 0x72, 0xd0, 0x6a, 0x51,
+	0xfb, 0x8f, 0x96, 0xb5, 0x1b, 0x6e, 0xdf, 0x75, 0xcb, 0xb3, 0x9a, 0x4d, 0xd2, 0xb4, 0xfd, 0x56,
+	0x69, 0x8a, 0xf5, 0x94, 0xd9, 0x7f, 0xac, 0xa6, 0x57, 0x81, 0xac, 0xb6, 0xc2, 0x86, 0x72, 0xc1,
+	0xb3, 0x6c, 0x0d, 0x25, 0xd6, 0x6c, 0x28, 0xe3, 0x60, 0x48, 0x6b, 0x83, 0x7f, 0xc4, 0x40, 0x65,
+	0x76, 0x09, 0x5b, 0x4d, 0xba, 0x39, 0xae, 0x7b, 0x6e, 0xa7, 0x4d, 0x05, 0xf0, 0xee, 0xd6, 0x96,
+	0x5c, 0x38, 0xa5, 0x73, 0xb9, 0x96, 0xdb, 0x43, 0x07, 0xfb, 0xe5, 0xf2, 0x72, 0x77, 0xd4, 0x70,
+	0x18, 0x6d, 0xfc, 0xbd, 0x06, 0x7a, 0x40, 0x6c, 0xdc, 0xd4, 0xbe, 0x4d, 0xe7, 0xea, 0x1b, 0x5b,
+	0x71, 0xab, 0xd9, 0x68, 0xa1, 0x1b, 0x4d, 0xfc, 0xfd, 0x06, 0xba, 0xec, 0xc4, 0x80, 0x40, 0x7c,
+	0x12, 0x48, 0x0b, 0xa4, 0xf3, 0xb9, 0x3a, 0x75, 0xf5, 0x60, 0xbf, 0x7c, 0x79, 0xad, 0x0b, 0x5e,
+	0xe8, 0x4a, 0xd5, 0xfc, 0xef, 0x03, 0xa8, 0x94, 0x60, 0x46, 0xa4, 0x4d, 0xeb, 0xa1, 0xd7, 0x8d,
+	0x71, 0x4c, 0xd7, 0x4d, 0x1b, 0x5d, 0x0d, 0x2b, 0x5c, 0x6f, 0x77, 0x52, 0x69, 0x15, 0x18, 0xad,
+	0x87, 0x0f, 0xf6, 0xcb, 0x57, 0xab, 0x87, 0xd4, 0x85, 0x43, 0xb1, 0x65, 0x5f, 0xe5, 0xc5, 0x53,
+	0xba, 0xca, 0xdf, 0x8f, 0xa6, 0x15, 0x80, 0x47, 0xac, 0xfa, 0x5e, 0x1f, 0xac, 0x04, 0xbb, 0xc1,
+	0xaa, 0x29, 0xf8, 0x20, 0x95, 0x4a, 0xe6, 0xfd, 0x39, 0x78, 0x1a, 0xf7, 0xa7, 0xb9, 0x5f, 0x44,
+	0xa3, 0x15, 0xd7, 0xa9, 0x73, 0x09, 0xe3, 0xeb, 0x35, 0xf3, 0x96, 0x07, 0xd5, 0xb7, 0xd8, 0xfd,
+	0xfd, 0xf2, 0x44, 0x58, 0x51, 0x79, 0x9c, 0xbd, 0x2d, 0xd4, 0xfc, 0x72, 0x09, 0xc7, 0x2b, 0x75,
+	0x95, 0xed, 0xfd, 0xfd, 0xf2, 0x99, 0xb0, 0x99, 0xae, 0xc5, 0xa5, 0x97, 0x23, 0x15, 0x38, 0x6f,
+	0x78, 0x96, 0xe3, 0xdb, 0x7d, 0x88, 0xde, 0x42, 0xd5, 0xda, 0x4a, 0x02, 0x1b, 0xa4, 0x50, 0xa0,
+	0xe2, 0x3e, 0x5a, 0xba, 0xd9, 0xae, 0x5b, 0x01, 0xc9, 0x29, 0xd9, 0x0f, 0xc5, 0x7d, 0x2b, 0x1a,
+	0x26, 0x88, 0x61, 0xe6, 0xe6, 0x40, 0x96, 0xef, 0x3a, 0xa5, 0xc1, 0xb8, 0x39, 0x90, 0xe5, 0x73,
+	0x73, 0x20, 0xcb, 0xe7, 0xe6, 0x92, 0x2d, 0xe2, 0xfb, 0x54, 0x7f, 0x36, 0xc4, 0x2a, 0x86, 0x0f,
+	0xf5, 0x55, 0x5e, 0x0c, 0x12, 0x8e, 0x5f, 0x8b, 0x06, 0x6b, 0x6e, 0x9d, 0xf8, 0xa5, 0x61, 0x76,
+	0x87, 0xd1, 0xeb, 0x7c, 0xb0, 0x42, 0x0b, 0xee, 0xef, 0x97, 0x47, 0x99, 0x22, 0x92, 0xfe, 0x02,
+	0x5e, 0x89, 0xda, 0x66, 0x9e, 0x8d, 0x8b, 0x9d, 0x7b, 0x30, 0x63, 0x3a, 0x3d, 0x8b, 0x20, 0xf3,
+	0x13, 0x54, 0x22, 0xea, 0x3a, 0x81, 0xe7, 0x36, 0xd7, 0x9b, 0x96, 0x43, 0xf0, 0xb7, 0x1a, 0xe8,
+	0xec, 0xb6, 0xdd, 0xd8, 0x56, 0x0d, 0x36, 0x4b, 0x46, 0x7e, 0xe1, 0xe5, 0x8d, 0x18, 0xae, 0xf9,
+	0xe9, 0x83, 0xfd, 0xf2, 0xd9, 0x78, 0x29, 0x24, 0x68, 0x9a, 0x7f, 0x52, 0x40, 0x17, 0xd5, 0x9e,
+	0xcd, 0x45, 0xde, 0x3a, 0xf8, 0x77, 0x0d, 0x84, 0x5a, 0xb6, 0x33, 0xd7, 0x6c, 0xba, 0xf7, 0x98,
+	0x8d, 0x2b, 0x7d, 0x50, 0xbf, 0x27, 0xaf, 0xba, 0x20, 0x85, 0xc2, 0xec, 0x6a, 0x88, 0x9d, 0x9b,
+	0x0c, 0x3c, 0x23, 0x85, 0x71, 0x11, 0xe0, 0xfe, 0x7e, 0xb9, 0x9c, 0x74, 0x11, 0x9a, 0x05, 0xe1,
+	0x87, 0x43, 0x05, 0xa6, 0x1f, 0xf9, 0xe3, 0xae, 0x55, 0xb8, 0xe9, 0x40, 0x34, 0x90, 0x99, 0x16,
+	0x3a, 0x13, 0x23, 0x9c, 0xe2, 0x23, 0xb0, 0xa0, 0xfa, 0x08, 0x1c, 0x72, 0x48, 0xcd, 0x4a, 0xaf,
+	0xa0, 0xd9, 0x77, 0x75, 0x2c, 0x27, 0xa0, 0x33, 0xad, 0xf8, 0x14, 0x7c, 0xa1, 0x88, 0x1e, 0x50,
+	0x27, 0x20, 0xf6, 0x64, 0xc7, 0x75, 0x34, 0xbd, 0xc5, 0x9f, 0x7c, 0x1b, 0x6e, 0x93, 0x78, 0x96,
+	0x53, 0xe3, 0x6a, 0x5e, 0xbe, 0x72, 0xbf, 0x96, 0x9e, 0x76, 0x4b, 0x29, 0xf0, 0xfb, 0x19, 0xe5,
+	0x90, 0x8a, 0x8d, 0x7e, 0xcc, 0x51, 0xd9, 0x4d, 0x29, 0x4b, 0x7e, 0xae, 0xdf, 0x6f, 0x19, 0x1b,
+	0x4a, 0x38, 0xe5, 0xc2, 0x47, 0xe3, 0xb6, 0xf8, 0x9c, 0xa3, 0x61, 0xf9, 0x31, 0x7d, 0xcd, 0x68,
+	0x24, 0x33, 0x4d, 0x34, 0xa9, 0x53, 0x3d, 0xd1, 0x6f, 0xf9, 0x47, 0x05, 0x34, 0x2d, 0x26, 0xa0,
+	0x49, 0x25, 0x07, 0xed, 0xa6, 0xbb, 0xd7, 0x22, 0xce, 0x69, 0x58, 0xd8, 0xca, 0x03, 0xad, 0x90,
+	0x79, 0xa0, 0xb5, 0x12, 0x07, 0x5a, 0x31, 0xcf, 0x81, 0x16, 0x9e, 0xfb, 0x87, 0x08, 0x6d, 0x01,
+	0x5d, 0xb0, 0x1d, 0xda, 0xd1, 0xeb, 0x6c, 0xc1, 0x44, 0x96, 0xfa, 0xec, 0xae, 0x19, 0xe1, 0x22,
+	0xa1, 0xe5, 0xd4, 0x1a, 0x90, 0xd1, 0xd2, 0xfc, 0x0b, 0x03, 0x95, 0xd2, 0xe6, 0xf7, 0x14, 0xf4,
+	0x2c, 0x2d, 0x5d, 0xcf, 0x72, 0xa3, 0x8f, 0xbd, 0xa1, 0x75, 0x3d, 0x43, 0xdf, 0xf2, 0xe7, 0x05,
+	0x74, 0x21, 0xaa, 0xbe, 0xec, 0xf8, 0x81, 0xd5, 0x6c, 0x72, 0xe6, 0xfd, 0xe4, 0xd7, 0x52, 0x5b,
+	0x53, 0x97, 0xad, 0xf5, 0x37, 0x54, 0xb5, 0xef, 0x99, 0xd6, 0x73, 0xbb, 0x31, 0xeb, 0xb9, 0xf5,
+	0x63, 0xa4, 0xd9, 0xdd, 0x90, 0xee, 0x2f, 0x0d, 0x34, 0x93, 0xde, 0xf0, 0x14, 0x16, 0x95, 0xab,
+	0x2f, 0xaa, 0x77, 0x1e, 0xdf, 0xa8, 0x33, 0x96, 0xd5, 0xcf, 0x15, 0xb2, 0x46, 0xcb, 0x74, 0x6e,
+	0x5b, 0xe8, 0x8c, 0x47, 0x1a, 0xb6, 0x1f, 0x88, 0x47, 0xe4, 0xd1, 0x5c, 0x77, 0xa4, 0x95, 0xc1,
+	0x19, 0xd0, 0x71, 0x40, 0x1c, 0x29, 0x5e, 0x43, 0xc3, 0x54, 0x03, 0x42, 0xf1, 0x17, 0x7a, 0xc7,
+	0x1f, 0x32, 0x84, 0x55, 0xde, 0x16, 0x24, 0x12, 0xfc, 0xf5, 0x68, 0xa2, 0x1e, 0xee, 0xa8, 0x23,
+	0x1a, 0xf2, 0x31, 0xc9, 0xc9, 0x82, 0xda, 0x1a, 0x74, 0x64, 0xe6, 0xdf, 0x18, 0xe8, 0x72, 0xb7,
+	0xb5, 0x85, 0x5f, 0x40, 0xa8, 0x26, 0x39, 0x7c, 0x5f, 0x30, 0x42, 0x4f, 0xe4, 0xfc, 0x96, 0x1c,
+	0x4b, 0xb4, 0x41, 0xc3, 0x22, 0x1f, 0x14, 0x22, 0x29, 0x36, 0xd5, 0x85, 0x13, 0xb2, 0xa9, 0x36,
+	0xff, 0x93, 0xa1, 0x1e, 0x45, 0xea, 0xb7, 0x7d, 0xb9, 0x1d, 0x45, 0x6a, 0xdf, 0x33, 0x75, 0xf8,
+	0x5f, 0x2a, 0xa0, 0xab, 0xe9, 0x4d, 0x94, 0xfb, 0xfc, 0x29, 0x34, 0xd4, 0xe6, 0xee, 0x75, 0xdc,
+	0x8f, 0xe7, 0x11, 0x7a, 0xb2, 0x70, 0xe7, 0xb7, 0xfb, 0xfb, 0xe5, 0x99, 0xb4, 0x83, 0x9e, 0x43,
+	0x41, 0xb4, 0xc3, 0x76, 0x4c, 0xd9, 0xc8, 0x1f, 0x60, 0x6f, 0xe8, 0xf1, 0x70, 0xb1, 0xee, 0x90,
+	0x66, 0xcf, 0xfa, 0xc5, 0x0f, 0x1b, 0x68, 0x52, 0x5b, 0xd1, 0x7e, 0x69, 0xf0, 0x6a, 0x31, 0xaf,
+	0xf9, 0xa9, 0xb6, 0x55, 0x22, 0x6e, 0x40, 0x2b, 0xf6, 0x21, 0x46, 0x30, 0x76, 0xcc, 0xaa, 0xb3,
+	0xfa, 0xb2, 0x3b, 0x66, 0xd5, 0xce, 0x67, 0x1c, 0xb3, 0x3f, 0x5c, 0xc8, 0x1a, 0x2d, 0x3b, 0x66,
+	0xef, 0xa9, 0xbc, 0x36, 0x3f, 0x2e, 0x96, 0xfa, 0xed, 0x13, 0x47, 0x37, 0x3f, 0x95, 0xe0, 0xa9,
+	0x15, 0x6e, 0x18, 0x7f, 0x8b, 0x81, 0x50, 0xf4, 0x61, 0xc4, 0xa6, 0xda, 0x38, 0xbe, 0xe9, 0x50,
+	0xd8, 0x9a, 0x49, 0xba, 0xa5, 0xa3, 0xdf, 0xa0, 0xd0, 0x35, 0xff, 0xed, 0x00, 0xc2, 0xc9, 0xbe,
+	0xf7, 0x66, 0x4a, 0x72, 0x08, 0x93, 0xdb, 0x46, 0x67, 0x3d, 0xca, 0x2d, 0xd6, 0xec, 0xc8, 0x9d,
+	0x32, 0x9f, 0x14, 0x8b, 0x3d, 0x91, 0x21, 0x86, 0x0b, 0x12, 0xd8, 0xa9, 0x25, 0x6b, 0xdb, 0xb3,
+	0x5b, 0x96, 0xb7, 0xc7, 0x04, 0x1c, 0x23, 0x5c, 0xcf, 0xbd, 0xce, 0x8b, 0x40, 0xc2, 0xf0, 0xfb,
+	0xd1, 0x68, 0xd3, 0xde, 0x22, 0xb5, 0xbd, 0x5a, 0x93, 0x08, 0x0d, 0xd6, 0xad, 0xe3, 0xf9, 0xe6,
+	0x2b, 0x12, 0xad, 0xb0, 0xcb, 0x96, 0x3f, 0x21, 0x22, 0x48, 0xe5, 0xed, 0xf7, 0x98, 0xa5, 0x60,
+	0x93, 0xf8, 0x7e, 0xb5, 0xd3, 0x6e, 0xbb, 0x5e, 0x40, 0xea, 0x4c, 0xcf, 0x35, 0xc2, 0xe5, 0xed,
+	0xb7, 0x93, 0x60, 0x48, 0x6b, 0x83, 0x9f, 0x40, 0xc8, 0xea, 0x04, 0x2e, 0xf7, 0x61, 0x2d, 0x8d,
+	0x30, 0x09, 0x0c, 0x55, 0xab, 0xa2, 0xb9, 0xb0, 0xf4, 0xfe, 0x7e, 0x79, 0x4c, 0xc8, 0x71, 0xd9,
+	0xa7, 0x51, 0x1a, 0xe0, 0xf7, 0xa0, 0xe9, 0x1a, 0x07, 0x51, 0x33, 0x4b, 0x2b, 0xb0, 0x85, 0x74,
+	0x63, 0x94, 0x21, 0xfa, 0xbf, 0x98, 0xdd, 0x5a, 0x0a, 0x3c, 0x8e, 0x32, 0x15, 0x89, 0xf9, 0xf1,
+	0x02, 0x7a, 0xa0, 0xcb, 0x04, 0x61, 0x40, 0xa3, 0xe1, 0xf7, 0x13, 0xcb, 0xec, 0x8d, 0x7c, 0xb3,
+	0x88, 0xc2, 0xfb, 0xfb, 0xe5, 0x87, 0xba, 0x20, 0xa8, 0xd2, 0x75, 0x4e, 0x1a, 0x7b, 0x10, 0xa1,
+	0xc1, 0xcb, 0x68, 0xa8, 0x1e, 0xa9, 0xa4, 0x47, 0xe7, 0x5f, 0x4f, 0xaf, 0x02, 0xae, 0x3c, 0xea,
+	0x15, 0x9b, 0x40, 0x80, 0x57, 0xd0, 0x30, 0xb7, 0x34, 0x27, 0xe2, 0x5a, 0x79, 0x8c, 0x89, 0xbf,
+	0x78, 0x51, 0xaf, 0xc8, 0x24, 0x0a, 0x6a, 0x61, 0x38, 0x5c, 0xa1, 0x4a, 0xa7, 0xb5, 0x2a, 0x35,
+	0x11, 0x57, 0x02, 0xad, 0x88, 0x23, 0x36, 0xe7, 0x99, 0xc3, 0x30, 0x2a, 0x62, 0x1a, 0xe9, 0x1a,
+	0x1e, 0x16, 0x80, 0x4a, 0x0b, 0xbf, 0x40, 0xe7, 0xfc, 0x9e, 0x67, 0x33, 0xfb, 0x86, 0x7e, 0x0c,
+	0xf0, 0x38, 0x61, 0x90, 0xb8, 0xf8, 0x6a, 0x0f, 0x7f, 0x42, 0x44, 0xc5, 0x5c, 0x47, 0x58, 0xd4,
+	0x56, 0xe5, 0x55, 0x8f, 0xa3, 0x81, 0x96, 0x5b, 0x97, 0xdf, 0xfd, 0xd5, 0xf2, 0xf0, 0xa0, 0xca,
+	0xdc, 0xfb, 0xfb, 0xe5, 0x0b, 0xc9, 0x16, 0x14, 0x02, 0xac, 0x8d, 0xb9, 0x86, 0xce, 0x0a, 0x78,
+	0x48, 0x90, 0xfa, 0xec, 0xd7, 0xdc, 0x56, 0xcb, 0x75, 0xaa, 0x9d, 0xad, 0x2d, 0x7b, 0x97, 0x68,
+	0x3e, 0xfb, 0x15, 0x0d, 0x02, 0xb1, 0x9a, 0xe6, 0xa7, 0x0d, 0x54, 0xa4, 0xdf, 0xc5, 0x44, 0x43,
+	0x75, 0xb7, 0x65, 0xd9, 0x8e, 0xe8, 0x15, 0xb3, 0x60, 0x59, 0x60, 0x25, 0x20, 0x20, 0xb8, 0x8d,
+	0x46, 0x25, 0x47, 0xd6, 0x97, 0xf3, 0xcd, 0xc2, 0x5a, 0x35, 0x74, 0x85, 0x0c, 0xaf, 0x09, 0x59,
+	0xe2, 0x43, 0x44, 0xc4, 0xb4, 0xd0, 0xd4, 0xc2, 0x5a, 0x75, 0xd9, 0xa9, 0x35, 0x3b, 0x75, 0xb2,
+	0xb8, 0xcb, 0xfe, 0xd0, 0x73, 0xce, 0xe6, 0x25, 0x62, 0x9c, 0xec, 0x9c, 0x13, 0x95, 0x40, 0xc2,
+	0x68, 0x35, 0xc2, 0x5b, 0x94, 0x0a, 0x51, 0x35, 0x81, 0x04, 0x24, 0xcc, 0xfc, 0xcd, 0x22, 0x1a,
+	0x53, 0x3a, 0x84, 0x9b, 0x68, 0x98, 0x0f, 0xd7, 0xef, 0x27, 0x4c, 0x49, 0xa2, 0xd7, 0x9c, 0x3a,
+	0x9f, 0x50, 0x1f, 0x24, 0x09, 0xf5, 0xcc, 0x2e, 0x74, 0x39, 0xb3, 0x67, 0xb5, 0x48, 0x00, 0x7c,
+	0x4b, 0x4e, 0x66, 0x47, 0x01, 0xc0, 0x97, 0xc5, 0xf5, 0xc4, 0xbd, 0x55, 0x46, 0x62, 0x57, 0xd3,
+	0x16, 0x1a, 0x7c, 0xd1, 0x75, 0x88, 0x5f, 0x1a, 0x3c, 0xce, 0x01, 0x32, 0x1b, 0x63, 0xea, 0xcc,
+	0xef, 0x03, 0x47, 0x8f, 0xbd, 0x84, 0xd7, 0x28, 0xbf, 0x6e, 0xde, 0xac, 0xbe, 0x86, 0x94, 0x1d,
+	0x4a, 0x6f, 0xbd, 0x8a, 0xe7, 0xb2, 0x60, 0x3a, 0xf4, 0x51, 0x90, 0xc7, 0xa5, 0xfc, 0xc7, 0x0c,
+	0x84, 0x16, 0xac, 0xc0, 0xe2, 0xa6, 0x64, 0x3d, 0x98, 0x30, 0x5f, 0xd6, 0x6e, 0xf2, 0x91, 0x84,
+	0x0b, 0xf1, 0x80, 0x6f, 0xbf, 0x28, 0xa7, 0x3c, 0x7c, 0x21, 0x70, 0xec, 0x55, 0xfb, 0x45, 0x02,
+	0x0c, 0x4e, 0x35, 0xe7, 0xc4, 0xa9, 0x79, 0x7b, 0x6d, 0x7a, 0x99, 0x71, 0xb1, 0x12, 0x3b, 0x15,
+	0x16, 0x65, 0x21, 0x44, 0x70, 0xf3, 0xf5, 0x48, 0x7f, 0xe6, 0xf5, 0xe0, 0x46, 0xf2, 0xb7, 0x06,
+	0xba, 0xb8, 0xd0, 0xb1, 0x9a, 0x73, 0x6d, 0xba, 0x39, 0xac, 0xe6, 0x92, 0xcb, 0xcd, 0x81, 0xe8,
+	0xdb, 0xe7, 0xb5, 0x68, 0x44, 0x32, 0x56, 0xf1, 0x18, 0x03, 0xf2, 0x70, 0x86, 0xb0, 0x06, 0xb6,
+	0xa8, 0x33, 0x93, 0x60, 0xf5, 0x0b, 0x7d, 0xb0, 0xfa, 0x92, 0x84, 0x2c, 0x81, 0x10, 0x2d, 0x17,
+	0xb8, 0xb1, 0x45, 0x41, 0x83, 0x20, 0xd9, 0x35, 0x22, 0x24, 0xaf, 0x7e, 0xa9, 0xa8, 0x0a, 0xdc,
+	0xd2, 0x6a, 0x40, 0x46, 0x4b, 0xf3, 0xf3, 0x06, 0x1a, 0x58, 0xdc, 0xa8, 0x2c, 0xe0, 0xaf, 0x47,
+	0x03, 0xe1, 0x31, 0x95, 0xd3, 0xf4, 0x90, 0xe2, 0xe1, 0xb2, 0x41, 0xfe, 0xbd, 0x57, 0xe9, 0x21,
+	0xc7, 0xb0, 0xe2, 0x3b, 0x68, 0x88, 0xec, 0x10, 0xda, 0xd5, 0xc2, 0xb1, 0xe0, 0x67, 0xc7, 0xe8,
+	0x22, 0xc3, 0x08, 0x02, 0xb3, 0xf9, 0x9d, 0x06, 0x42, 0x51, 0x15, 0xfc, 0xc1, 0xb4, 0x1b, 0xf1,
+	0xe6, 0x31, 0x6a, 0x2f, 0xba, 0x5f, 0x8b, 0xe6, 0xe7, 0x06, 0xd1, 0x25, 0xda, 0x1d, 0xb1, 0x54,
+	0x6d, 0xd7, 0xb9, 0x49, 0xf6, 0xfe, 0xc1, 0x61, 0xe9, 0x1f, 0x1c, 0x96, 0x8e, 0xd1, 0x61, 0xe9,
+	0x3d, 0xe8, 0x12, 0x5d, 0x72, 0x02, 0x4c, 0x98, 0xf9, 0xe0, 0xba, 0x47, 0xda, 0x96, 0x47, 0xea,
+	0xec, 0x52, 0x18, 0xe1, 0x06, 0x91, 0x73, 0x59, 0x95, 0x20, 0xbb, 0xbd, 0xf9, 0x24, 0x3a, 0x1b,
+	0xad, 0xdd, 0xb9, 0x00, 0x88, 0x1f, 0xd0, 0xf3, 0x58, 0x7f, 0xd8, 0x8e, 0x4a, 0x2e, 0x2d, 0xf9,
+	0x18, 0xd5, 0x11, 0x88, 0x5d, 0x79, 0x24, 0x04, 0x7f, 0x56, 0x40, 0x67, 0xb9, 0xaf, 0x09, 0x8d,
+	0x04, 0xc3, 0x2f, 0x2f, 0xaa, 0x46, 0x96, 0x8e, 0x85, 0x86, 0xae, 0x46, 0x8e, 0x3b, 0x17, 0xe2,
+	0x2d, 0xd5, 0xe9, 0x65, 0xc1, 0x0a, 0xf2, 0xec, 0x0f, 0xac, 0x3b, 0xbc, 0x50, 0x2c, 0x10, 0xc3,
+	0x8a, 0xab, 0x68, 0xb2, 0xd6, 0xb4, 0x7c, 0x9f, 0x7b, 0xdd, 0x44, 0xc1, 0x5f, 0x5e, 0xc3, 0x2e,
+	0x56, 0x0d, 0x72, 0x7f, 0xbf, 0x7c, 0x5e, 0xf4, 0x53, 0x07, 0x40, 0x0c, 0x05, 0xb6, 0xd1, 0xb0,
+	0xc7, 0x9d, 0xbe, 0xc4, 0x22, 0x9f, 0xcf, 0x65, 0x0e, 0x2e, 0xe6, 0x84, 0x63, 0xe2, 0x6c, 0x90,
+	0xf8, 0x01, 0x12, 0xbf, 0xf9, 0xc9, 0x02, 0x9a, 0x58, 0xdc, 0x6d, 0xbb, 0x7e, 0xc7, 0x23, 0xac,
+	0x57, 0xa7, 0x20, 0xf7, 0x7b, 0x14, 0x0d, 0x6f, 0x5b, 0xd4, 0xbb, 0xc3, 0x2b, 0x15, 0xf4, 0xcf,
+	0x78, 0x83, 0x17, 0x83, 0x84, 0xe3, 0xf7, 0x21, 0x44, 0xa3, 0x1a, 0xd6, 0x3b, 0xec, 0x20, 0x2f,
+	0xe6, 0x3f, 0xc8, 0xb5, 0x31, 0x56, 0x43, 0x94, 0x82, 0xe5, 0x0b, 0x7f, 0x83, 0x42, 0xce, 0xfc,
+	0x7d, 0x03, 0x4d, 0x69, 0xed, 0x4e, 0x41, 0x9c, 0xb5, 0xa5, 0x8b, 0xb3, 0xe6, 0xfa, 0x1e, 0x6b,
+	0x86, 0x14, 0xeb, 0x63, 0x05, 0x74, 0x31, 0x63, 0x4e, 0x12, 0xbe, 0x12, 0xc6, 0x29, 0xf9, 0x4a,
+	0x74, 0xd0, 0x58, 0xc0, 0x14, 0xd7, 0x6a, 0x2c, 0xc1, 0x5c, 0xec, 0xc2, 0x46, 0x88, 0x26, 0xf2,
+	0x84, 0x88, 0xca, 0x7c, 0x50, 0xe9, 0x50, 0xb7, 0xca, 0xd1, 0x50, 0x6a, 0xfe, 0x92, 0x32, 0x1e,
+	0xe9, 0x3d, 0x7c, 0x9d, 0xf9, 0x9b, 0x05, 0x74, 0x21, 0xc4, 0x2d, 0x4f, 0x54, 0x2a, 0xe4, 0xef,
+	0x45, 0xf4, 0x76, 0x59, 0xf3, 0xe2, 0x1a, 0x49, 0xba, 0x73, 0xb7, 0x3b, 0x5e, 0xdb, 0xf5, 0x25,
+	0xcf, 0xce, 0x1f, 0x54, 0xbc, 0x08, 0x24, 0x0c, 0xaf, 0xa1, 0x41, 0x9f, 0xd2, 0x2b, 0x0d, 0xe4,
+	0x99, 0x0d, 0xf6, 0xd4, 0x61, 0xfd, 0x05, 0x8e, 0x06, 0xbf, 0x4f, 0xbd, 0x2e, 0x06, 0xf3, 0x0b,
+	0x77, 0xe9, 0x48, 0xea, 0x21, 0xd7, 0x1e, 0xa9, 0x86, 0xba, 0x09, 0x53, 0xcd, 0x15, 0x74, 0x36,
+	0x6e, 0x60, 0x81, 0xdf, 0xaa, 0xad, 0x8c, 0x87, 0x63, 0xe6, 0x63, 0xe9, 0x06, 0x19, 0xac, 0x85,
+	0xe9, 0xa3, 0x91, 0xeb, 0xa2, 0x93, 0x78, 0x06, 0x15, 0x6c, 0xf9, 0x2d, 0x90, 0xc0, 0x51, 0x58,
+	0x5e, 0x80, 0x82, 0xdd, 0x83, 0x37, 0x9d, 0x7a, 0x03, 0x16, 0xbb, 0xdf, 0x80, 0xe6, 0x57, 0x0a,
+	0x68, 0x5a, 0x52, 0x95, 0x63, 0x5c, 0x10, 0xd6, 0x04, 0x87, 0x3c, 0xe0, 0x0e, 0x17, 0xc5, 0xde,
+	0x42, 0x03, 0xec, 0x00, 0xcc, 0x65, 0x65, 0x10, 0x22, 0xa4, 0xdd, 0x01, 0x86, 0x08, 0xbf, 0x1f,
+	0x0d, 0x35, 0xe9, 0x6b, 0x48, 0xba, 0xb9, 0xe5, 0x12, 0x5c, 0xa7, 0x0d, 0x97, 0x3f, 0xb2, 0x84,
+	0x55, 0x4a, 0xa8, 0x28, 0xe6, 0x85, 0x20, 0x68, 0xce, 0xbc, 0x0d, 0x8d, 0x29, 0xd5, 0x8e, 0x14,
+	0x36, 0xf4, 0xd3, 0x05, 0x54, 0xba, 0x41, 0x9a, 0xad, 0x54, 0xd3, 0x90, 0x32, 0x1a, 0xac, 0x6d,
+	0x5b, 0x1e, 0x8f, 0x48, 0x3b, 0xce, 0x17, 0x79, 0x85, 0x16, 0x00, 0x2f, 0xa7, 0x8f, 0x23, 0xcd,
+	0xa5, 0xf9, 0x1d, 0xca, 0x4c, 0x46, 0xa1, 0x8a, 0xdf, 0x1b, 0xc6, 0x32, 0x8e, 0x06, 0xae, 0x55,
+	0xa0, 0xd7, 0xcb, 0x3b, 0xab, 0xb7, 0xd6, 0xd2, 0x9c, 0x99, 0x69, 0x7c, 0x12, 0xb7, 0x66, 0x03,
+	0x69, 0xbb, 0xbe, 0x1d, 0xb8, 0xde, 0x9e, 0xf8, 0x68, 0xb9, 0xae, 0x96, 0x5b, 0x95, 0xe5, 0x08,
+	0x11, 0x57, 0xaf, 0x6a, 0x45, 0xa0, 0x93, 0x32, 0x3f, 0x5d, 0x44, 0x63, 0x6a, 0xdc, 0xc4, 0x57,
+	0xc5, 0xe3, 0x26, 0x8e, 0xa5, 0xc6, 0x4c, 0xdc, 0x45, 0xa3, 0xe2, 0x1e, 0x0e, 0x0d, 0x96, 0xae,
+	0xe7, 0xb3, 0x8d, 0x0b, 0x49, 0x8b, 0xfb, 0x4d, 0xd9, 0xf8, 0xb2, 0xc4, 0x87, 0x88, 0x18, 0xe5,
+	0x1b, 0xfd, 0xc0, 0xda, 0x9b, 0xbb, 0x67, 0xdd, 0x25, 0x9b, 0x4e, 0x60, 0x37, 0x73, 0xbc, 0x71,
+	0xd8, 0xc9, 0x5e, 0xd5, 0xb0, 0x40, 0x0c, 0x2b, 0x73, 0x10, 0xb3, 0xf4, 0xa0, 0x92, 0xa5, 0x81,
+	0xfc, 0x0e, 0x62, 0xb1, 0xf8, 0x94, 0xdc, 0x41, 0x2c, 0x56, 0x08, 0x71, 0x82, 0xe6, 0x67, 0x0d,
+	0x74, 0x4e, 0xf9, 0x5d, 0xb1, 0x9a, 0xc4, 0xa9, 0x5b, 0x5e, 0x0f, 0x27, 0x44, 0x8b, 0xee, 0xff,
+	0x3d, 0xf9, 0x6d, 0x6e, 0xf6, 0xf9, 0x6d, 0x24, 0xe1, 0x05, 0x6b, 0xcf, 0x57, 0x4f, 0x87, 0x3d,
+	0x1f, 0x18, 0x19, 0xf3, 0xdd, 0xe8, 0x62, 0x46, 0x75, 0xfc, 0x10, 0x1a, 0xbc, 0x43, 0x1a, 0xa1,
+	0x90, 0x35, 0xe4, 0x76, 0xe6, 0x69, 0x21, 0x70, 0x18, 0xbe, 0x84, 0x8a, 0xc4, 0xa9, 0x8b, 0xf3,
+	0x6c, 0xf8, 0x60, 0xbf, 0x5c, 0x5c, 0x74, 0xea, 0x40, 0xcb, 0xcc, 0xbf, 0xd1, 0xe7, 0x40, 0x2e,
+	0x0a, 0xba, 0x75, 0x99, 0x8f, 0x85, 0xc0, 0x2b, 0xef, 0x27, 0xba, 0x75, 0x59, 0x79, 0x17, 0x9c,
+	0xf4, 0xda, 0x6e, 0xba, 0xda, 0x73, 0x80, 0x5d, 0xdb, 0x2b, 0xa2, 0x0c, 0x42, 0x28, 0xf3, 0xf8,
+	0xb8, 0x6b, 0xb7, 0xe5, 0x88, 0xf8, 0xe9, 0x27, 0x3d, 0x3e, 0x54, 0x00, 0xe8, 0xf5, 0xa8, 0x0b,
+	0xcc, 0xb6, 0xe8, 0x35, 0x89, 0x5a, 0x0f, 0x86, 0x36, 0xb3, 0xf8, 0x46, 0x02, 0x0a, 0x29, 0x2d,
+	0x98, 0x01, 0x6d, 0xdc, 0x56, 0x94, 0x3e, 0xbc, 0xcf, 0xc6, 0x2d, 0x09, 0xfb, 0x31, 0x51, 0x8d,
+	0x5f, 0x8d, 0xf3, 0x25, 0xf1, 0x95, 0x12, 0x97, 0x2c, 0x24, 0xe8, 0x9a, 0xbf, 0x34, 0x80, 0x1e,
+	0xbc, 0x41, 0x43, 0xb8, 0xba, 0x4e, 0x60, 0x35, 0xd7, 0xdd, 0x7a, 0xe4, 0x3e, 0x20, 0xb8, 0xa4,
+	0x8f, 0x1a, 0xe8, 0x62, 0xad, 0xdd, 0x11, 0xde, 0x1c, 0xc2, 0x02, 0x7f, 0x9d, 0x78, 0xb6, 0x9b,
+	0xd7, 0xa5, 0x91, 0x39, 0xbe, 0x54, 0xd6, 0x37, 0xd3, 0x50, 0x42, 0x16, 0x2d, 0xe6, 0x59, 0x59,
+	0x77, 0xef, 0x39, 0xac, 0x73, 0xd5, 0x80, 0xcd, 0xe6, 0x8b, 0xd1, 0x2a, 0xc8, 0xe9, 0x59, 0xb9,
+	0x90, 0x8a, 0x11, 0x32, 0x28, 0x51, 0x7f, 0x03, 0xe1, 0xb5, 0x02, 0xc4, 0xaa, 0xdb, 0x0e, 0xf1,
+	0x7d, 0xee, 0x96, 0xd5, 0x87, 0xeb, 0xe0, 0x72, 0x1a, 0x42, 0x48, 0xa7, 0x83, 0x9f, 0x43, 0xc8,
+	0xdf, 0x73, 0x6a, 0x62, 0xfe, 0xf3, 0xd9, 0xf9, 0xf3, 0x57, 0x59, 0x88, 0x05, 0x14, 0x8c, 0x54,
+	0x8c, 0x10, 0x84, 0x8b, 0x72, 0x88, 0xf9, 0x6a, 0x30, 0x31, 0x42, 0xb4, 0x86, 0x22, 0x38, 0x15,
+	0x0c, 0x4e, 0x2e, 0x3b, 0xeb, 0x4d, 0xab, 0x46, 0xb8, 0xd9, 0xba, 0x8f, 0xaf, 0xa1, 0x51, 0x3f,
+	0x54, 0x92, 0xf2, 0x4b, 0x28, 0xba, 0x12, 0x24, 0x00, 0xa2, 0x3a, 0xcc, 0x9f, 0xc9, 0x76, 0x04,
+	0x7f, 0xb5, 0xe4, 0x7a, 0x1c, 0x91, 0xd8, 0xf8, 0xdc, 0x9f, 0x29, 0x09, 0x86, 0xb4, 0x36, 0xe6,
+	0xcf, 0x1a, 0x68, 0x5a, 0xef, 0x8e, 0xb0, 0x32, 0xfa, 0x41, 0x03, 0x4d, 0x6b, 0x51, 0x5f, 0x04,
+	0xb8, 0x9f, 0x38, 0x99, 0xeb, 0x29, 0xf8, 0xb8, 0x07, 0x45, 0x1a, 0x04, 0x52, 0xe9, 0x9b, 0xff,
+	0xc8, 0x40, 0xc3, 0x22, 0xaa, 0x37, 0xb5, 0xf6, 0xd7, 0x14, 0x5a, 0x21, 0x37, 0x15, 0x53, 0x6a,
+	0xed, 0x31, 0x93, 0x29, 0xc1, 0x0d, 0x09, 0xc6, 0x26, 0x97, 0x46, 0x44, 0x10, 0x8e, 0x58, 0x2b,
+	0xcd, 0x74, 0x4a, 0x94, 0x81, 0x42, 0xcc, 0xfc, 0x8c, 0x81, 0xa6, 0x12, 0xad, 0x7a, 0x78, 0x01,
+	0x9d, 0xa2, 0x43, 0xc0, 0x97, 0x06, 0xe8, 0x92, 0x0c, 0xe8, 0x41, 0xdc, 0xe4, 0xba, 0xa6, 0x53,
+	0x10, 0xb9, 0xbc, 0x06, 0x8d, 0xda, 0xad, 0x56, 0x27, 0x60, 0x7a, 0xfd, 0xc1, 0x48, 0x99, 0xb2,
+	0x2c, 0x0b, 0x21, 0x82, 0x63, 0x47, 0x30, 0xf7, 0xfc, 0x72, 0x5f, 0xc9, 0xf7, 0xe5, 0xd4, 0x01,
+	0xce, 0x52, 0x46, 0x9c, 0x73, 0xe0, 0x69, 0xbc, 0xff, 0xb7, 0x1a, 0x08, 0xf9, 0x81, 0x67, 0x3b,
+	0x0d, 0x5a, 0x28, 0x1e, 0x00, 0x70, 0x0c, 0x64, 0xab, 0x21, 0x52, 0x4e, 0x3c, 0x8a, 0xf4, 0x1d,
+	0x02, 0x40, 0xa1, 0x8c, 0xe7, 0xc4, 0xbb, 0x87, 0xdf, 0xd9, 0xaf, 0x8b, 0xbd, 0xf0, 0x1e, 0x4c,
+	0x31, 0x4d, 0xe7, 0x84, 0xa2, 0x87, 0xd1, 0xcc, 0x5b, 0xd0, 0x68, 0x48, 0xef, 0xb0, 0x77, 0xc4,
+	0xb8, 0xf2, 0x8e, 0x98, 0x79, 0x02, 0x9d, 0x89, 0x75, 0xf7, 0x48, 0xcf, 0x90, 0x3f, 0x30, 0x10,
+	0xd6, 0x47, 0x7f, 0x0a, 0xc2, 0xaa, 0x86, 0x2e, 0xac, 0x9a, 0xef, 0xff, 0x93, 0x65, 0x48, 0xab,
+	0x7e, 0x0a, 0x23, 0x96, 0xf4, 0x20, 0x4c, 0x02, 0x22, 0x6e, 0x7e, 0xca, 0xa8, 0x44, 0x01, 0x5b,
+	0xc4, 0xce, 0xed, 0x83, 0x51, 0xb9, 0x19, 0xc3, 0x15, 0x31, 0x2a, 0x71, 0x08, 0x24, 0xe8, 0xe2,
+	0x6f, 0x37, 0xd0, 0x59, 0x4b, 0x4f, 0x7a, 0x20, 0x67, 0x26, 0x1f, 0x4f, 0xaf, 0xe3, 0x8a, 0xfa,
+	0x12, 0x03, 0xf8, 0x90, 0x20, 0x4b, 0x1d, 0x82, 0xad, 0xb6, 0x4d, 0xc3, 0xf6, 0x13, 0xa7, 0x16,
+	0x46, 0x5e, 0x67, 0x02, 0xb8, 0xb9, 0xf5, 0xe5, 0xb0, 0x1c, 0xb4, 0x5a, 0x61, 0x76, 0x81, 0x4a,
+	0x64, 0xcb, 0xdf, 0x4f, 0x76, 0x01, 0x31, 0x87, 0x51, 0x76, 0x01, 0x31, 0x75, 0x2a, 0x11, 0xec,
+	0x20, 0xe4, 0xda, 0xf5, 0x9a, 0x20, 0x39, 0x94, 0x5f, 0x45, 0x78, 0x6b, 0x79, 0xa1, 0x22, 0x28,
+	0x32, 0xf6, 0x21, 0xfa, 0x0d, 0x0a, 0x05, 0x1a, 0x33, 0x64, 0x42, 0x9c, 0xdd, 0x82, 0xe6, 0x30,
+	0xfb, 0x44, 0xcf, 0xe6, 0x5d, 0x2f, 0xb1, 0x35, 0x39, 0x0b, 0x2a, 0x72, 0x7e, 0xee, 0x84, 0xf1,
+	0x7e, 0x34, 0x18, 0xe8, 0xfd, 0x60, 0x3c, 0x80, 0xaf, 0xe9, 0x68, 0x45, 0x07, 0x47, 0xf2, 0xf3,
+	0x00, 0xd5, 0x14, 0x7c, 0xc2, 0x87, 0x33, 0x05, 0x02, 0xa9, 0xf4, 0xd9, 0x5b, 0xf5, 0x9e, 0x15,
+	0xd4, 0xb6, 0x2b, 0x56, 0x6d, 0x9b, 0xa9, 0xe8, 0x79, 0xe0, 0x81, 0x9c, 0xeb, 0xfa, 0xb6, 0x8e,
+	0x8a, 0xbf, 0x55, 0x63, 0x85, 0x10, 0x27, 0x88, 0x5d, 0xaa, 0x92, 0xe7, 0x99, 0x7f, 0x4a, 0x28,
+	0x3f, 0x4b, 0x91, 0x48, 0x23, 0xc4, 0x9f, 0x66, 0xf2, 0x17, 0x84, 0x44, 0xa8, 0x4f, 0x32, 0x17,
+	0x47, 0xcc, 0x39, 0xae, 0xb3, 0xd7, 0x72, 0x3b, 0x3e, 0x0d, 0xa9, 0x46, 0x9c, 0x40, 0x2a, 0x7a,
+	0xc6, 0xd8, 0x35, 0xca, 0x7c, 0x92, 0x17, 0xbb, 0x55, 0x84, 0xee, 0x78, 0xf0, 0x33, 0x68, 0x84,
+	0xa9, 0xb1, 0x37, 0x36, 0x56, 0x4a, 0xe3, 0x47, 0x39, 0xa3, 0x43, 0x76, 0x99, 0x0d, 0x61, 0x51,
+	0xe0, 0x80, 0x10, 0x1b, 0xbe, 0x8b, 0x86, 0x9b, 0x3c, 0x75, 0x53, 0x69, 0x22, 0xff, 0xa1, 0x18,
+	0x4f, 0x03, 0xc5, 0x65, 0x36, 0xe2, 0x07, 0x48, 0x0a, 0xd4, 0xb5, 0xba, 0x4e, 0xb6, 0xac, 0x4e,
+	0x33, 0x58, 0x73, 0x03, 0x60, 0x0e, 0xc0, 0xa1, 0x90, 0x5d, 0x86, 0xab, 0x98, 0x64, 0xc1, 0x62,
+	0x99, 0x6b, 0xf5, 0xc2, 0x21, 0x75, 0xe1, 0x50, 0x6c, 0x78, 0x0f, 0x3d, 0x24, 0xea, 0x30, 0x8f,
+	0xe3, 0xda, 0x36, 0x9d, 0xe5, 0x24, 0xd1, 0x33, 0x8c, 0x28, 0xb5, 0x3e, 0x7c, 0x68, 0xe1, 0xf0,
+	0xea, 0xd0, 0x0b, 0x4e, 0xe6, 0xc4, 0x49, 0x62, 0x0a, 0xce, 0xd2, 0xd9, 0xfc, 0x73, 0x1c, 0x57,
+	0x96, 0x72, 0x0b, 0xd5, 0x78, 0x29, 0x24, 0x68, 0xe2, 0xcf, 0x1a, 0xa8, 0xe4, 0x07, 0x5e, 0xa7,
+	0x16, 0x74, 0x3c, 0x52, 0x8f, 0xad, 0xd0, 0xa9, 0xab, 0x46, 0x5e, 0x06, 0xae, 0x9a, 0x81, 0x93,
+	0x05, 0x4e, 0x29, 0x65, 0x41, 0x21, 0xb3, 0x2f, 0xf8, 0xc7, 0x0d, 0x74, 0x51, 0x07, 0xd2, 0x37,
+	0x3d, 0xef, 0x27, 0xce, 0xaf, 0xd7, 0xab, 0xa6, 0xa3, 0xe4, 0x2f, 0xf8, 0x0c, 0x20, 0x64, 0x75,
+	0x24, 0x6e, 0x38, 0x72, 0xee, 0x94, 0x0d, 0x47, 0x66, 0x9e, 0x42, 0x38, 0x79, 0x7d, 0x1c, 0xc6,
+	0x07, 0x8e, 0xa8, 0x7c, 0xe0, 0xa7, 0x06, 0xd1, 0x03, 0xf4, 0x56, 0x8a, 0x5e, 0x3f, 0xab, 0x96,
+	0x63, 0x35, 0x5e, 0x9a, 0x1c, 0xd3, 0xcf, 0x18, 0xe8, 0xe2, 0x76, 0xba, 0x68, 0x47, 0xbc, 0xbf,
+	0xde, 0x95, 0x4b, 0xb2, 0xd8, 0x4d, 0x5a, 0xc4, 0x0f, 0xec, 0xae, 0x55, 0x20, 0xab, 0x53, 0xf8,
+	0x29, 0x74, 0xd6, 0x71, 0xeb, 0xa4, 0xb2, 0xbc, 0x00, 0xab, 0x96, 0x7f, 0xb7, 0x2a, 0xed, 0xd8,
+	0x06, 0xf9, 0x7e, 0x5d, 0x8b, 0xc1, 0x20, 0x51, 0x9b, 0x46, 0x05, 0x68, 0xbb, 0xf5, 0xc5, 0x1d,
+	0x9e, 0xe2, 0xac, 0x3f, 0x2b, 0x76, 0x26, 0xe7, 0x5b, 0x4f, 0x60, 0x83, 0x14, 0x0a, 0x4c, 0x36,
+	0x45, 0x3b, 0xb3, 0xea, 0x3a, 0x76, 0xe0, 0x7a, 0x2c, 0x14, 0x50, 0x5f, 0x22, 0x1a, 0x26, 0x9b,
+	0x5a, 0x4b, 0xc5, 0x08, 0x19, 0x94, 0xcc, 0xff, 0x66, 0xa0, 0x33, 0x74, 0x59, 0xac, 0x7b, 0xee,
+	0xee, 0xde, 0x4b, 0x71, 0x41, 0x3e, 0x2a, 0xcc, 0x88, 0xb9, 0x6c, 0xe7, 0xbc, 0x62, 0x42, 0x3c,
+	0xca, 0xfa, 0x1c, 0x59, 0x0d, 0xab, 0x9a, 0x8c, 0x62, 0xb6, 0x26, 0xc3, 0xfc, 0x44, 0x81, 0xbf,
+	0x5c, 0xa4, 0x5c, 0xf9, 0x25, 0xb9, 0x0f, 0xdf, 0x82, 0x26, 0x68, 0xd9, 0xaa, 0xb5, 0xbb, 0xbe,
+	0xf0, 0xb4, 0xdb, 0x94, 0xc1, 0x2e, 0x98, 0x14, 0xfa, 0xa6, 0x0a, 0x00, 0xbd, 0x1e, 0x7e, 0x9c,
+	0xda, 0xda, 0xb2, 0xb0, 0x9e, 0xe2, 0xcd, 0x7c, 0x95, 0xdb, 0xda, 0xb2, 0xa2, 0xfb, 0xfb, 0xe5,
+	0xa9, 0xc8, 0xaa, 0x40, 0x14, 0x82, 0x6c, 0x60, 0xfe, 0xd2, 0x05, 0xc4, 0x90, 0x37, 0x49, 0xf0,
+	0x52, 0x9c, 0x93, 0xd7, 0xa3, 0xb1, 0x5a, 0xbb, 0x53, 0x59, 0xaa, 0x32, 0x47, 0x77, 0x61, 0x4a,
+	0xcc, 0x4e, 0xef, 0xca, 0xfa, 0xa6, 0x2c, 0x06, 0xb5, 0x0e, 0x3d, 0x1d, 0x6a, 0xed, 0x8e, 0x38,
+	0x6f, 0xd7, 0x55, 0x17, 0x32, 0x76, 0x3a, 0x54, 0xd6, 0x37, 0x35, 0x18, 0x24, 0x6a, 0xe3, 0x0f,
+	0xa1, 0x71, 0x22, 0x36, 0xee, 0x0d, 0x9a, 0x3c, 0x90, 0x9f, 0x0b, 0xcb, 0x79, 0x07, 0x1f, 0x4e,
+	0xad, 0x3c, 0x0d, 0xf8, 0x0b, 0x70, 0x51, 0x21, 0x01, 0x1a, 0x41, 0x6a, 0x55, 0x26, 0x7f, 0xd3,
+	0xaf, 0xec, 0xd6, 0xe3, 0x07, 0xc5, 0x20, 0xb7, 0x2a, 0x5b, 0xcc, 0xaa, 0x04, 0xd9, 0xed, 0xf1,
+	0x4f, 0x1b, 0xe8, 0x42, 0x08, 0xb5, 0x1d, 0xbb, 0xd5, 0x69, 0x01, 0xa9, 0x35, 0x2d, 0xbb, 0x25,
+	0xde, 0x7d, 0xb7, 0x8f, 0x6d, 0xa0, 0x3a, 0x7a, 0x7e, 0x58, 0xa5, 0xc3, 0x20, 0xa3, 0x4b, 0xf8,
+	0x33, 0x06, 0xba, 0x2a, 0x41, 0xeb, 0x1e, 0xf1, 0x7d, 0xaa, 0x95, 0x08, 0x43, 0xad, 0x88, 0x29,
+	0x19, 0xce, 0x75, 0x76, 0x32, 0x06, 0x78, 0xf1, 0x10, 0xdc, 0x70, 0x28, 0x75, 0x75, 0xb9, 0x54,
+	0xdd, 0xad, 0xa0, 0x34, 0x72, 0xa2, 0xcb, 0x85, 0x92, 0x00, 0x8d, 0x20, 0xfe, 0x59, 0x03, 0x5d,
+	0x54, 0x0b, 0xd4, 0xd5, 0xc2, 0x5f, 0x88, 0xcf, 0x1c, 0x5b, 0x67, 0x62, 0xf8, 0x39, 0x87, 0x97,
+	0x01, 0x84, 0xac, 0x5e, 0xd1, 0x63, 0xbb, 0xc5, 0x16, 0x26, 0x7f, 0x45, 0x0e, 0xf2, 0x63, 0x9b,
+	0xaf, 0x55, 0x1f, 0x24, 0x8c, 0xca, 0x4f, 0xda, 0x6e, 0x7d, 0xdd, 0xae, 0xfb, 0x2c, 0xe4, 0x28,
+	0x7b, 0xeb, 0x15, 0xf9, 0x74, 0xac, 0xbb, 0xf5, 0xf5, 0xe5, 0x05, 0x5e, 0x0e, 0x5a, 0x2d, 0xea,
+	0x53, 0x40, 0xd5, 0x57, 0xd5, 0x7b, 0x56, 0xfb, 0x96, 0x0c, 0x1f, 0xc7, 0x64, 0x11, 0x4b, 0x61,
+	0x29, 0x28, 0x35, 0xe8, 0xf7, 0xa3, 0xe7, 0x0e, 0x10, 0x9e, 0x1f, 0xa3, 0x34, 0x79, 0x4c, 0xdf,
+	0x4f, 0x22, 0xe4, 0x1d, 0xbe, 0xa9, 0x90, 0x00, 0x8d, 0x20, 0xd5, 0x9c, 0x4d, 0xfa, 0x7b, 0x7e,
+	0x40, 0x5a, 0x61, 0x1f, 0xce, 0x1c, 0x77, 0x1f, 0xb8, 0x36, 0x5c, 0x23, 0x02, 0x31, 0xa2, 0x2c,
+	0x10, 0x5f, 0xcb, 0x6a, 0x90, 0xeb, 0x15, 0xaa, 0x8b, 0x0c, 0x83, 0x67, 0xad, 0x13, 0xaf, 0x46,
+	0x7d, 0x19, 0xcf, 0xb2, 0x2f, 0xc5, 0x03, 0xf1, 0x65, 0x57, 0x83, 0x6e, 0x38, 0xf0, 0x73, 0x68,
+	0x46, 0x80, 0x57, 0xdc, 0x7b, 0x09, 0x0a, 0x3c, 0xde, 0x1d, 0xb3, 0x0f, 0x5e, 0xce, 0xac, 0x05,
+	0x5d, 0x30, 0x50, 0x2d, 0x91, 0x4f, 0x3c, 0xa6, 0x13, 0xe4, 0x01, 0x9c, 0xd7, 0x3b, 0xcd, 0xa6,
+	0x5f, 0xc2, 0x91, 0x17, 0x5e, 0x35, 0x09, 0x86, 0xb4, 0x36, 0xf8, 0x89, 0xd0, 0x53, 0x7f, 0x8f,
+	0x16, 0xbc, 0x6b, 0xbd, 0xca, 0x5e, 0x22, 0x83, 0x5c, 0x52, 0x02, 0x3a, 0x08, 0xe2, 0x75, 0xe9,
+	0x6d, 0x2e, 0x8b, 0xe6, 0x3b, 0x9e, 0x1f, 0xb0, 0x28, 0x74, 0x83, 0xfc, 0x36, 0x07, 0x15, 0x00,
+	0x7a, 0x3d, 0xea, 0xf4, 0xe4, 0x93, 0x5a, 0xcd, 0x6d, 0xb5, 0xc5, 0x3b, 0x99, 0x85, 0x8a, 0x1b,
+	0x11, 0x5f, 0x50, 0x83, 0x40, 0xac, 0x26, 0xde, 0x43, 0xe7, 0xc2, 0x58, 0xff, 0x2b, 0x6e, 0x83,
+	0x06, 0x0d, 0xa4, 0xcc, 0xf1, 0x85, 0x3c, 0x51, 0x57, 0xf8, 0x74, 0x55, 0x92, 0xe8, 0x20, 0x8d,
+	0x06, 0xcd, 0xdc, 0x1a, 0x2b, 0x5e, 0xb2, 0xa9, 0xdd, 0xc8, 0x45, 0x36, 0x6c, 0x26, 0xec, 0xaa,
+	0xa4, 0xc0, 0x21, 0xb5, 0x15, 0xbe, 0x85, 0xce, 0xb7, 0x3d, 0x37, 0x20, 0xb5, 0xe0, 0x26, 0xf1,
+	0x1c, 0xd2, 0x14, 0x03, 0xf4, 0x4b, 0x25, 0x36, 0x17, 0x4c, 0x1f, 0xba, 0x9e, 0x56, 0x01, 0xd2,
+	0xdb, 0xe1, 0x4f, 0x19, 0xe8, 0x8a, 0x1f, 0x78, 0xc4, 0x6a, 0xd9, 0x4e, 0xa3, 0xe2, 0x3a, 0x0e,
+	0x61, 0x07, 0x93, 0x92, 0xcc, 0xb3, 0x74, 0x29, 0xd7, 0x2d, 0x62, 0x1e, 0xec, 0x97, 0xaf, 0x54,
+	0xbb, 0x62, 0x86, 0x43, 0x28, 0x53, 0xfb, 0xda, 0x16, 0x69, 0xb9, 0xde, 0x1e, 0x3d, 0x91, 0x4a,
+	0x33, 0xf9, 0xdf, 0xbb, 0xab, 0x21, 0x16, 0xbe, 0xfd, 0x35, 0x4d, 0x6e, 0x04, 0x04, 0x85, 0x1c,
+	0x9d, 0x6a, 0x7a, 0xde, 0x8a, 0x78, 0x8f, 0xca, 0xa6, 0x79, 0x80, 0x7d, 0x39, 0x36, 0xd5, 0xab,
+	0x69, 0x15, 0x20, 0xbd, 0x1d, 0xf6, 0xd1, 0x14, 0xdb, 0xa1, 0xe2, 0x26, 0xbf, 0x5e, 0x99, 0x6b,
+	0x90, 0xd2, 0xe5, 0x5c, 0x93, 0x4b, 0x79, 0xff, 0xa9, 0xe5, 0x38, 0x32, 0x48, 0xe2, 0x8f, 0x88,
+	0x5a, 0xbb, 0x11, 0xd1, 0x07, 0xfb, 0x25, 0x6a, 0xed, 0x26, 0x88, 0x2a, 0x45, 0xe6, 0x7e, 0x01,
+	0x9d, 0x4f, 0xbd, 0x25, 0xe9, 0xe1, 0xc1, 0xa7, 0x78, 0x4e, 0xe6, 0x51, 0x15, 0x6a, 0x4f, 0x76,
+	0x78, 0xac, 0xea, 0x20, 0x88, 0xd7, 0xa5, 0x3c, 0x2c, 0xa3, 0xb6, 0x54, 0x8d, 0xda, 0x17, 0x22,
+	0x1e, 0x76, 0x39, 0x06, 0x83, 0x44, 0x6d, 0x5c, 0x11, 0xf3, 0xb1, 0x54, 0x5d, 0xa6, 0xcf, 0x40,
+	0x7f, 0xc9, 0x23, 0xf2, 0x75, 0x10, 0x8d, 0x4f, 0x05, 0x42, 0xb2, 0x3e, 0x1d, 0x05, 0xfd, 0xa1,
+	0xf6, 0x62, 0x20, 0x1a, 0xc5, 0x9a, 0x0e, 0x82, 0x78, 0x5d, 0xf9, 0x4e, 0xd7, 0xba, 0x30, 0x18,
+	0x8d, 0x62, 0x2d, 0x06, 0x83, 0x44, 0x6d, 0xf3, 0x0f, 0x07, 0xd0, 0x43, 0x3d, 0x70, 0x96, 0xb8,
+	0x95, 0x3e, 0xdd, 0x47, 0x3f, 0xf3, 0x7a, 0xfb, 0x3c, 0xed, 0x8c, 0xcf, 0x73, 0x74, 0x7a, 0xbd,
+	0x7e, 0x4e, 0x3f, 0xeb, 0x73, 0x1e, 0x9d, 0x64, 0xef, 0x9f, 0xbf, 0x95, 0xfe, 0xf9, 0x73, 0xce,
+	0xea, 0xa1, 0xcb, 0xa5, 0x9d, 0xb1, 0x5c, 0x72, 0xce, 0x6a, 0x0f, 0xcb, 0xeb, 0x8f, 0x06, 0xd0,
+	0xc3, 0xbd, 0x70, 0xb9, 0x39, 0xd7, 0x57, 0xca, 0xd9, 0x72, 0xa2, 0xeb, 0x2b, 0x2b, 0xc4, 0xc2,
+	0x09, 0xae, 0xaf, 0xae, 0xc7, 0xe7, 0xc9, 0xac, 0xaf, 0xac, 0x59, 0x3d, 0xa9, 0xf5, 0x95, 0x35,
+	0xab, 0x3d, 0xac, 0xaf, 0xbf, 0x8a, 0xdf, 0x0f, 0x21, 0xab, 0xbd, 0x8c, 0x8a, 0xb5, 0x76, 0x27,
+	0xe7, 0x21, 0xc5, 0xcc, 0x1c, 0x2b, 0xeb, 0x9b, 0x40, 0x71, 0x60, 0x40, 0x43, 0x7c, 0xfd, 0xe4,
+	0x3c, 0x82, 0x98, 0xb1, 0x32, 0x5f, 0x92, 0x20, 0x30, 0xd1, 0xa9, 0x22, 0xed, 0x6d, 0xd2, 0x22,
+	0x9e, 0xd5, 0xac, 0x06, 0xae, 0x67, 0x35, 0xf2, 0x9e, 0x36, 0x5c, 0x83, 0x12, 0xc3, 0x05, 0x09,
+	0xec, 0x74, 0x42, 0xda, 0x76, 0xbd, 0x34, 0x90, 0x7f, 0x42, 0xd6, 0x97, 0x17, 0x80, 0xe2, 0x30,
+	0x3f, 0x37, 0x82, 0x94, 0x5c, 0x32, 0x54, 0x9e, 0x35, 0x55, 0x8b, 0x87, 0x3c, 0xee, 0xc7, 0x1e,
+	0x2a, 0x11, 0x3f, 0x99, 0x2f, 0xf9, 0x44, 0x31, 0x24, 0xc9, 0xe2, 0x6f, 0x32, 0xb8, 0x90, 0x2f,
+	0xd4, 0xe6, 0x89, 0x69, 0xbd, 0x7e, 0x4c, 0x7a, 0xef, 0x48, 0x5a, 0x18, 0x02, 0x40, 0x27, 0x48,
+	0x25, 0x2a, 0xe7, 0xef, 0xa6, 0xe9, 0x26, 0x4a, 0x03, 0xf9, 0x63, 0xa6, 0x74, 0x51, 0x76, 0x70,
+	0x0e, 0x32, 0xb5, 0x02, 0xa4, 0x77, 0x24, 0x9c, 0xa5, 0x50, 0x5c, 0x5b, 0x1a, 0xec, 0x6f, 0x96,
+	0x62, 0x72, 0xdf, 0x68, 0x96, 0x42, 0x00, 0xe8, 0x04, 0x69, 0x48, 0x88, 0xbb, 0x52, 0x46, 0x5e,
+	0x1a, 0xca, 0xaf, 0x66, 0x8f, 0x09, 0xda, 0xb9, 0xbd, 0x57, 0x58, 0x08, 0x11, 0x11, 0xbc, 0x8d,
+	0x86, 0xef, 0xf2, 0xb3, 0xa2, 0x34, 0x9c, 0xdf, 0x35, 0x40, 0x3b, 0x6e, 0xb8, 0x58, 0x45, 0x14,
+	0x81, 0x44, 0xaf, 0xba, 0xaf, 0x8c, 0x1c, 0xe2, 0xc0, 0xf9, 0x29, 0x03, 0x9d, 0xdf, 0x21, 0x5e,
+	0x60, 0xd7, 0xe2, 0x9a, 0xa1, 0xd1, 0xfc, 0x12, 0x8a, 0xa7, 0xd3, 0x10, 0xf2, 0x65, 0x92, 0x0a,
+	0x82, 0xf4, 0x2e, 0xe0, 0xa7, 0xd1, 0x00, 0x09, 0x6a, 0x75, 0x91, 0xea, 0xe0, 0xad, 0x79, 0x3d,
+	0xda, 0xb9, 0xab, 0x15, 0xfd, 0x0f, 0x18, 0x3e, 0xf3, 0xcf, 0x0d, 0x94, 0x90, 0x4d, 0xe3, 0xef,
+	0x31, 0xd0, 0xf8, 0x16, 0xb1, 0x82, 0x8e, 0x47, 0xae, 0x0b, 0x9b, 0x50, 0x6a, 0xb0, 0xf2, 0xf4,
+	0x71, 0x88, 0xc4, 0x67, 0x97, 0x14, 0xc4, 0xdc, 0x58, 0x25, 0xcc, 0x0f, 0xa5, 0x82, 0x40, 0xeb,
+	0xc1, 0xcc, 0x93, 0x68, 0x2a, 0xd1, 0xf0, 0x48, 0x6a, 0xca, 0x7f, 0x61, 0xa0, 0x73, 0x51, 0x5f,
+	0x16, 0x2c, 0x7f, 0xfb, 0x8e, 0x4b, 0xe5, 0xcf, 0xcf, 0xa1, 0x41, 0xab, 0x5e, 0x0f, 0x73, 0x7b,
+	0xbf, 0x2d, 0x9f, 0xdd, 0x54, 0x5d, 0x0d, 0xde, 0xc5, 0x7e, 0x02, 0x47, 0x4b, 0xcd, 0xe6, 0x2d,
+	0x4d, 0x2f, 0xbd, 0x1a, 0x45, 0x8d, 0x61, 0xea, 0xb4, 0xb9, 0x04, 0x14, 0x52, 0x5a, 0x98, 0x1f,
+	0x33, 0x10, 0x4e, 0x66, 0x14, 0xc3, 0x1e, 0x1a, 0x11, 0xeb, 0x57, 0x7e, 0xa5, 0x85, 0x9c, 0x0e,
+	0x9c, 0x9a, 0xe3, 0x73, 0x64, 0x84, 0x27, 0x0a, 0x7c, 0x08, 0xe9, 0x50, 0x07, 0x86, 0x28, 0x5f,
+	0x2f, 0x7e, 0x13, 0x1a, 0xab, 0x13, 0xbf, 0xe6, 0xd9, 0xed, 0x20, 0x72, 0x93, 0x0e, 0x7d, 0x20,
+	0x17, 0x22, 0x10, 0xa8, 0xf5, 0x68, 0xac, 0x9a, 0xc0, 0xf2, 0xef, 0x2e, 0x2f, 0x88, 0xc7, 0x1e,
+	0xbb, 0x9a, 0x37, 0x58, 0x09, 0x08, 0x48, 0x14, 0x99, 0xbb, 0xd8, 0x43, 0x64, 0x6e, 0xea, 0x48,
+	0xd3, 0x77, 0x18, 0x72, 0x7c, 0x78, 0x08, 0x72, 0xf3, 0x87, 0x8a, 0xe8, 0x0c, 0xad, 0x42, 0x23,
+	0x4e, 0x04, 0xc4, 0x61, 0x9e, 0x7a, 0x39, 0x27, 0xa1, 0x81, 0x26, 0x02, 0xcd, 0xa7, 0xff, 0xe8,
+	0x2e, 0xe3, 0xa1, 0xa5, 0x97, 0xee, 0xc9, 0xaf, 0xe3, 0xc5, 0x6f, 0x93, 0xae, 0x92, 0xfc, 0x59,
+	0xfc, 0x90, 0x5c, 0xaa, 0xcc, 0xff, 0xf1, 0xbe, 0x08, 0x90, 0x10, 0x26, 0x79, 0xd6, 0xbc, 0x22,
+	0xdf, 0x82, 0x26, 0x84, 0x87, 0x04, 0x0f, 0xb1, 0x2e, 0x9e, 0xc5, 0xec, 0x5a, 0x59, 0x52, 0x01,
+	0xa0, 0xd7, 0xc3, 0x2f, 0xa0, 0x91, 0x3a, 0xd9, 0x22, 0x9e, 0x67, 0x35, 0xfb, 0xb9, 0xd3, 0x94,
+	0x69, 0x5e, 0x10, 0xe8, 0x84, 0x4f, 0xaa, 0xf8, 0x05, 0x21, 0x19, 0xf3, 0xb7, 0x0b, 0x48, 0xcf,
+	0x5e, 0x9d, 0xf7, 0xc3, 0x24, 0x43, 0xda, 0x17, 0x4e, 0x2c, 0xa4, 0xfd, 0x6b, 0xd1, 0x48, 0xdb,
+	0x73, 0x99, 0x05, 0xb9, 0x50, 0xed, 0x87, 0x9b, 0x6f, 0x5d, 0x94, 0x43, 0x58, 0x23, 0xfa, 0x92,
+	0x03, 0x47, 0xfe, 0x92, 0x6f, 0x12, 0xc6, 0xc6, 0x83, 0x5a, 0x62, 0x01, 0x69, 0x6c, 0x3c, 0xa5,
+	0x35, 0x54, 0x7c, 0x49, 0xe7, 0x90, 0xc8, 0x82, 0x46, 0x97, 0x82, 0x48, 0x7a, 0xe0, 0x6f, 0xb8,
+	0x81, 0xd5, 0x2c, 0x19, 0x91, 0x9c, 0x77, 0x55, 0x05, 0x80, 0x5e, 0xcf, 0x5c, 0x43, 0xaf, 0x5c,
+	0x71, 0xad, 0xfa, 0xbc, 0xd5, 0xa4, 0x9f, 0xd1, 0x13, 0x96, 0x80, 0x3e, 0x63, 0x06, 0xa8, 0x68,
+	0xd3, 0xad, 0xb9, 0x4d, 0x7a, 0x55, 0x5b, 0x61, 0xf4, 0x77, 0xa6, 0xfb, 0x94, 0x57, 0xb5, 0x88,
+	0x9e, 0x0e, 0x12, 0x6e, 0x7e, 0xc1, 0x40, 0xc3, 0x22, 0x33, 0x5a, 0x0f, 0xee, 0xd3, 0xd4, 0xc3,
+	0x9d, 0x65, 0xd2, 0xee, 0x83, 0x11, 0x66, 0xb9, 0xd9, 0xb5, 0x94, 0x8a, 0x3c, 0x1d, 0x2b, 0xfd,
+	0x17, 0x38, 0x7a, 0x66, 0x02, 0xeb, 0xd5, 0xb6, 0xed, 0x80, 0x30, 0x4b, 0x1f, 0xb1, 0xd7, 0xb8,
+	0x09, 0xac, 0x52, 0x0e, 0x5a, 0x2d, 0xf3, 0xbf, 0x0e, 0xa2, 0xab, 0x02, 0x71, 0x82, 0x3b, 0x0c,
+	0x8f, 0xf9, 0xbd, 0x30, 0xed, 0x1c, 0xcb, 0x58, 0x23, 0xc5, 0xb8, 0xf9, 0x1e, 0xe6, 0x17, 0x95,
+	0xc4, 0x72, 0x2a, 0x3a, 0x48, 0xa3, 0xc1, 0xf3, 0x69, 0xb0, 0xe2, 0x1b, 0xc4, 0x6a, 0x06, 0xdb,
+	0x92, 0x76, 0xa1, 0x9f, 0x7c, 0x1a, 0x49, 0x7c, 0x90, 0x4a, 0x85, 0x59, 0x91, 0x08, 0x40, 0xc5,
+	0x23, 0x96, 0x6a, 0xc2, 0xd2, 0x87, 0x87, 0xd3, 0x6a, 0x2a, 0x46, 0xc8, 0xa0, 0xc4, 0x24, 0x9c,
+	0xd6, 0x2e, 0x13, 0x98, 0x00, 0x09, 0x3c, 0x9b, 0xa5, 0xc6, 0x0c, 0xd5, 0x23, 0xab, 0x3a, 0x08,
+	0xe2, 0x75, 0xa9, 0x96, 0x83, 0x59, 0xe5, 0x44, 0x41, 0x7d, 0x07, 0xa3, 0xd0, 0x6e, 0x6b, 0x1a,
+	0x04, 0x62, 0x35, 0xf1, 0x37, 0x1b, 0x68, 0xda, 0x56, 0xfd, 0x77, 0xe4, 0xe8, 0x87, 0xfa, 0x49,
+	0xf4, 0xc3, 0x97, 0x71, 0x0a, 0x5a, 0x48, 0x25, 0x46, 0x15, 0x1e, 0xc2, 0xef, 0x5f, 0x5f, 0x03,
+	0x3c, 0xe2, 0x23, 0xfb, 0xa6, 0x0b, 0x29, 0x70, 0x48, 0x6d, 0x65, 0x7e, 0xb8, 0x80, 0xc6, 0x8f,
+	0x98, 0xe0, 0xbd, 0xa3, 0xb0, 0x39, 0x7d, 0x78, 0xe7, 0xaa, 0x54, 0x7b, 0xe0, 0x74, 0xf0, 0x33,
+	0x68, 0xb2, 0xc3, 0x26, 0x42, 0xc6, 0x43, 0x2c, 0x15, 0xc3, 0x3c, 0x09, 0x93, 0x9b, 0x1a, 0x84,
+	0x06, 0xea, 0x55, 0xd1, 0xeb, 0x50, 0x88, 0xe1, 0x31, 0x7f, 0xb5, 0x80, 0xb0, 0x5a, 0x7d, 0xa9,
+	0x69, 0xed, 0xb8, 0x1e, 0xb5, 0x59, 0x1c, 0xaf, 0xa9, 0x89, 0x25, 0x39, 0x4f, 0xf7, 0x4c, 0xbf,
+	0x83, 0xe5, 0xe8, 0x67, 0xd5, 0x8c, 0x91, 0x9c, 0xf7, 0x96, 0x41, 0x05, 0xc6, 0x55, 0xd0, 0xfd,
+	0xd8, 0x6f, 0xd0, 0xfa, 0x34, 0xf3, 0x51, 0x03, 0x4d, 0x25, 0x30, 0xa5, 0x30, 0xe3, 0xcf, 0xea,
+	0x99, 0x10, 0x8e, 0x25, 0x79, 0xb6, 0xca, 0xd2, 0xff, 0xe8, 0x20, 0x3a, 0x97, 0xf2, 0x45, 0x99,
+	0x55, 0x0f, 0x89, 0x31, 0xb4, 0xfd, 0x58, 0xf5, 0x24, 0x98, 0xe3, 0xd0, 0xaa, 0x27, 0x0e, 0x81,
+	0x04, 0x5d, 0xfc, 0x34, 0x2a, 0xd6, 0x3c, 0x5b, 0x2c, 0xda, 0xb7, 0xe4, 0x9a, 0x02, 0x58, 0x9e,
+	0x1f, 0x13, 0x14, 0x69, 0x36, 0x76, 0xa0, 0x08, 0xe9, 0x5d, 0xac, 0x5e, 0x23, 0x92, 0x47, 0x66,
+	0x77, 0xb1, 0x7a, 0xdb, 0xf8, 0xa0, 0xd7, 0xc3, 0xcf, 0xa0, 0x92, 0x78, 0x1c, 0x8b, 0x2e, 0x56,
+	0x5c, 0xc7, 0x0f, 0xe8, 0x89, 0x1f, 0x08, 0x9e, 0x82, 0x19, 0xdc, 0xde, 0xcc, 0xa8, 0x03, 0x99,
+	0xad, 0xf1, 0x07, 0xd1, 0xa4, 0x76, 0x7a, 0xc8, 0xd8, 0x84, 0x39, 0xbd, 0x74, 0x54, 0x4c, 0xfc,
+	0xac, 0xd4, 0xcb, 0x20, 0x46, 0x0d, 0xff, 0xdf, 0x54, 0xfa, 0x15, 0xae, 0x17, 0xbe, 0xe4, 0x65,
+	0x8e, 0xdf, 0xa5, 0xe3, 0xd9, 0x41, 0x4a, 0xea, 0xf9, 0x38, 0x21, 0x48, 0xd2, 0x36, 0xbf, 0x7f,
+	0x08, 0x8d, 0x29, 0x49, 0x8f, 0xf1, 0x6a, 0x3f, 0xa2, 0xd0, 0x68, 0x0d, 0x48, 0x71, 0xe8, 0x2a,
+	0x2a, 0x36, 0xda, 0x9d, 0x52, 0xa1, 0x3f, 0x74, 0xd7, 0x29, 0xba, 0x46, 0xbb, 0x83, 0x9f, 0x0e,
+	0xa5, 0xab, 0xf9, 0xe4, 0x9f, 0xa1, 0x77, 0x66, 0x4c, 0xc2, 0x2a, 0x8f, 0xf7, 0x81, 0x2e, 0xce,
+	0xfd, 0xc3, 0xbe, 0x10, 0xbd, 0x0e, 0xe6, 0x0f, 0x26, 0xab, 0xcc, 0xb4, 0x10, 0xb5, 0x72, 0xa1,
+	0x90, 0xf8, 0x01, 0x92, 0x06, 0x7d, 0x7b, 0x76, 0xd8, 0xbd, 0x24, 0xc2, 0x96, 0xb1, 0xb7, 0xe7,
+	0x26, 0x2b, 0x01, 0x01, 0x49, 0x30, 0x73, 0xc3, 0xbd, 0x30, 0x73, 0xf8, 0xd3, 0xf1, 0xf3, 0x7b,
+	0xe4, 0x6a, 0x31, 0xaf, 0x51, 0xb1, 0x32, 0x9c, 0xff, 0xc3, 0x0e, 0xee, 0x6f, 0x8b, 0x2e, 0x3f,
+	0xe5, 0x63, 0xd1, 0xc0, 0x0a, 0x2c, 0x2c, 0x59, 0x3c, 0xb0, 0x02, 0x8b, 0x16, 0x05, 0x1c, 0x86,
+	0xab, 0x22, 0x98, 0x67, 0xbe, 0x45, 0xcf, 0xcc, 0x29, 0x05, 0x3d, 0x25, 0xf2, 0xe7, 0x55, 0xcd,
+	0x0d, 0x33, 0xed, 0x0d, 0xb1, 0x49, 0x83, 0x29, 0x3b, 0xb4, 0x49, 0x4e, 0xb9, 0x3d, 0xb7, 0xfa,
+	0xe2, 0x28, 0x40, 0xe2, 0x32, 0xbf, 0x5c, 0x44, 0x63, 0xca, 0x03, 0x97, 0x3a, 0x32, 0x5b, 0x9d,
+	0xc0, 0x15, 0x0e, 0xdf, 0x46, 0x7e, 0xb9, 0xa3, 0x82, 0x74, 0x2e, 0x44, 0xc8, 0x6d, 0x23, 0xa2,
+	0xdf, 0xa0, 0x10, 0xa3, 0xa4, 0x03, 0xbb, 0x45, 0x6e, 0xdb, 0x4e, 0xdd, 0xbd, 0x57, 0x2a, 0x1c,
+	0x0b, 0xe9, 0x8d, 0x10, 0x21, 0x27, 0x1d, 0xfd, 0x06, 0x85, 0x18, 0xbd, 0x92, 0x58, 0x4a, 0x1e,
+	0x87, 0xe5, 0xea, 0x17, 0x7d, 0x13, 0xe1, 0xe8, 0xb8, 0xa9, 0x33, 0xbb, 0x92, 0x2a, 0x19, 0x75,
+	0x20, 0xb3, 0x35, 0x4d, 0x56, 0x30, 0x4e, 0xc7, 0x28, 0xa3, 0x5a, 0x96, 0x06, 0xfa, 0x30, 0x38,
+	0xd1, 0xa7, 0x54, 0xa2, 0x14, 0x67, 0x82, 0x52, 0x02, 0x1a, 0x49, 0xf3, 0x27, 0x0d, 0x74, 0x31,
+	0xa3, 0x2d, 0xf5, 0xe0, 0x1c, 0x53, 0x82, 0xdf, 0x8a, 0x2f, 0xfe, 0x74, 0x9f, 0xdd, 0x53, 0xc2,
+	0x79, 0x6a, 0x3d, 0xe5, 0xc6, 0xc4, 0x11, 0x10, 0x54, 0xda, 0xe6, 0x4f, 0x1b, 0xe8, 0x7c, 0xea,
+	0xb2, 0xc1, 0xd7, 0xd1, 0x54, 0x64, 0xad, 0xac, 0x32, 0x54, 0x23, 0xd1, 0x7d, 0x78, 0x33, 0x5e,
+	0x01, 0x92, 0x6d, 0x78, 0xa2, 0xd6, 0x04, 0xc3, 0x26, 0x4c, 0x9d, 0xd5, 0x67, 0xa9, 0x0a, 0x86,
+	0xb4, 0x36, 0xe6, 0xaf, 0x16, 0x91, 0x79, 0xf8, 0x90, 0xa9, 0xdb, 0xd4, 0x84, 0x7b, 0x87, 0x6a,
+	0x22, 0xf5, 0xc4, 0x77, 0xab, 0x7d, 0x4e, 0xb1, 0x24, 0xa0, 0x2a, 0x59, 0x6e, 0xa9, 0x74, 0x40,
+	0x27, 0x8b, 0x3f, 0x80, 0x90, 0xef, 0x6f, 0xdf, 0x24, 0x7b, 0x6d, 0xcb, 0x96, 0x2a, 0xb9, 0x63,
+	0xee, 0x04, 0x8f, 0x61, 0x51, 0xbd, 0x21, 0x88, 0x80, 0x42, 0x10, 0x7f, 0x9f, 0x81, 0xa6, 0xa8,
+	0xc0, 0x5f, 0x0b, 0x10, 0x5b, 0x2a, 0x9e, 0x44, 0x37, 0x98, 0x86, 0x30, 0x19, 0x8c, 0x36, 0x49,
+	0xde, 0xfc, 0x9c, 0x81, 0xce, 0x29, 0x78, 0xa4, 0x40, 0x8f, 0x86, 0x7d, 0xd8, 0xf2, 0x08, 0x79,
+	0x91, 0xc4, 0xc3, 0x3e, 0x2c, 0xb1, 0x52, 0x10, 0x50, 0x7c, 0x0b, 0x0d, 0x76, 0x58, 0xc4, 0xa4,
+	0xa3, 0x0b, 0xe7, 0xc2, 0xcb, 0x85, 0x07, 0x4b, 0xe2, 0x78, 0xa8, 0x39, 0xad, 0xdb, 0x0e, 0xc3,
+	0x01, 0x72, 0x8e, 0x9a, 0xbb, 0xf6, 0x86, 0xa5, 0xa0, 0xd4, 0xa0, 0x31, 0x43, 0xa7, 0x94, 0x01,
+	0xf0, 0xee, 0xf5, 0xf0, 0x9c, 0xbd, 0x25, 0x43, 0x08, 0xf5, 0xd1, 0x71, 0x2d, 0xdc, 0xd0, 0x32,
+	0x0f, 0x0d, 0x74, 0xf4, 0xc8, 0x51, 0x21, 0x17, 0x18, 0x86, 0x12, 0x5a, 0x45, 0xc8, 0x23, 0xb5,
+	0x8e, 0xc7, 0x82, 0xd6, 0x09, 0x9e, 0xed, 0x75, 0x74, 0x0e, 0x20, 0x2c, 0xbd, 0xcf, 0x84, 0x08,
+	0xb1, 0x61, 0x47, 0x60, 0x50, 0x10, 0x50, 0xe3, 0x2c, 0x6a, 0xb4, 0xba, 0xe4, 0x7a, 0x35, 0x52,
+	0x57, 0xdf, 0x05, 0x23, 0x7c, 0xa1, 0xdc, 0x88, 0x03, 0x21, 0x59, 0xdf, 0xfc, 0x36, 0x03, 0x5d,
+	0xca, 0x5c, 0x70, 0x54, 0x58, 0xeb, 0x89, 0x92, 0xbe, 0x62, 0xf0, 0xb0, 0x37, 0x06, 0x68, 0x98,
+	0x20, 0x86, 0xd9, 0x7c, 0x8f, 0x76, 0x46, 0x46, 0xf7, 0x59, 0x6f, 0x51, 0xa1, 0x1e, 0x54, 0x23,
+	0x38, 0x25, 0xa6, 0x9e, 0xea, 0xa8, 0x2e, 0xae, 0x5a, 0x4e, 0xc7, 0x6a, 0xf2, 0x90, 0x28, 0xeb,
+	0xae, 0xdb, 0x94, 0x37, 0xd9, 0x8f, 0x19, 0xe8, 0x82, 0x16, 0x2f, 0xc5, 0x17, 0x10, 0x29, 0x23,
+	0x58, 0xee, 0x3b, 0x62, 0x8b, 0xc4, 0x38, 0x7f, 0x45, 0xf4, 0xec, 0x42, 0x2a, 0xd8, 0x87, 0x8c,
+	0x8e, 0x98, 0xef, 0x45, 0x17, 0x33, 0xac, 0x32, 0xf1, 0x02, 0x1a, 0xf7, 0xef, 0x59, 0xed, 0x79,
+	0xb2, 0x6d, 0xed, 0xd8, 0x22, 0xc2, 0x27, 0x77, 0xde, 0x19, 0xaf, 0x2a, 0xe5, 0xf7, 0x63, 0xbf,
+	0x41, 0x6b, 0x65, 0x06, 0x08, 0x09, 0x27, 0x2f, 0xea, 0xff, 0xbb, 0x85, 0x46, 0xac, 0x26, 0xf1,
+	0x82, 0x28, 0xe2, 0xf6, 0xd7, 0xe5, 0xd2, 0xde, 0x09, 0x1c, 0x5c, 0xab, 0x20, 0x7f, 0x41, 0x88,
+	0xdb, 0xfc, 0xff, 0x0c, 0x74, 0x21, 0x3d, 0xa6, 0x63, 0x4f, 0x71, 0xcb, 0xc6, 0xbc, 0xa8, 0x59,
+	0xa9, 0xd0, 0x57, 0xf0, 0xfc, 0x50, 0x71, 0xa1, 0xf4, 0x04, 0x54, 0xfc, 0x2c, 0xd3, 0x1f, 0xa5,
+	0xee, 0xb7, 0xad, 0x1a, 0xa9, 0x57, 0x9a, 0x6e, 0xa7, 0x2e, 0xfc, 0xa0, 0x5e, 0x1e, 0xe9, 0xb5,
+	0xd2, 0xfb, 0x7e, 0xb2, 0x99, 0xfe, 0x32, 0x68, 0x1e, 0x9e, 0xe9, 0x2f, 0xbd, 0xe1, 0xcb, 0x24,
+	0x05, 0x55, 0x7a, 0xe7, 0x33, 0xc2, 0xa1, 0xfc, 0xe7, 0xa1, 0xac, 0xd1, 0xd2, 0x8f, 0x41, 0xc3,
+	0xcf, 0xd5, 0xac, 0xf9, 0x0e, 0x8d, 0xa0, 0x2c, 0xb7, 0x02, 0xed, 0x79, 0x65, 0x8e, 0x97, 0x41,
+	0x08, 0xc5, 0x3b, 0x08, 0x45, 0x6c, 0x61, 0xa9, 0x90, 0xff, 0xb1, 0x9f, 0xd4, 0x85, 0xf3, 0x9b,
+	0x3a, 0x2a, 0x07, 0x85, 0x12, 0xfe, 0x00, 0x9a, 0x10, 0x93, 0xce, 0xb8, 0x48, 0x7e, 0xb9, 0xe7,
+	0x0c, 0x31, 0xac, 0xa9, 0x81, 0x42, 0xfd, 0xab, 0x5a, 0xea, 0x83, 0x4e, 0x0d, 0xef, 0xa1, 0xf1,
+	0x56, 0xf4, 0xe0, 0x95, 0x21, 0x47, 0x9f, 0xec, 0x53, 0x2c, 0x10, 0x59, 0x4e, 0x28, 0x85, 0x3e,
+	0x68, 0xa4, 0x68, 0x8c, 0xe3, 0x1d, 0x96, 0xe9, 0x82, 0x53, 0x1e, 0xca, 0x1f, 0xe3, 0xf8, 0xe9,
+	0x10, 0x4d, 0x74, 0x10, 0x45, 0x65, 0x3e, 0xa8, 0x74, 0xf0, 0x0b, 0x68, 0xa8, 0x6d, 0x79, 0xd4,
+	0xd3, 0x65, 0x38, 0xff, 0x53, 0x52, 0x5d, 0x68, 0xd1, 0x29, 0x18, 0x6e, 0xc9, 0x75, 0x46, 0x00,
+	0x04, 0xa1, 0x94, 0x90, 0x5a, 0x23, 0x27, 0x15, 0x26, 0xf9, 0x39, 0x34, 0xd4, 0x64, 0x3a, 0x51,
+	0x61, 0x1b, 0xf4, 0x78, 0x9e, 0xd1, 0x71, 0xad, 0x2a, 0x97, 0x3e, 0xf1, 0xff, 0x41, 0x60, 0xa5,
+	0xd9, 0x96, 0x2e, 0x77, 0x3b, 0x96, 0x98, 0x8c, 0xbb, 0x16, 0xdb, 0x86, 0xfd, 0xc8, 0xb8, 0x13,
+	0xa7, 0x6d, 0x28, 0xe3, 0x8e, 0x43, 0x20, 0x41, 0x17, 0xbf, 0x13, 0x61, 0xfe, 0xd8, 0x21, 0xf5,
+	0xeb, 0x94, 0x06, 0x7f, 0x6a, 0x17, 0x98, 0x07, 0x5b, 0x98, 0x4c, 0xfe, 0x56, 0xa2, 0x06, 0xa4,
+	0xb4, 0x32, 0x7f, 0xa9, 0x80, 0xd0, 0x1a, 0x09, 0x68, 0xae, 0x30, 0x7a, 0xc7, 0x5f, 0xd6, 0xb4,
+	0xbb, 0x23, 0x5f, 0xbd, 0xc0, 0xd8, 0x97, 0xd1, 0x40, 0xdb, 0xad, 0xf3, 0x7b, 0x46, 0x74, 0x84,
+	0x39, 0xf0, 0xb1, 0x52, 0x1a, 0xbb, 0x93, 0x99, 0xc2, 0x0a, 0x7e, 0x99, 0xe9, 0x86, 0x99, 0xfe,
+	0x1b, 0x78, 0x39, 0x3d, 0x21, 0x45, 0xa4, 0x1b, 0xbf, 0x34, 0x18, 0x9d, 0x90, 0x52, 0x13, 0x0e,
+	0x21, 0x14, 0x3f, 0x8e, 0x90, 0xdd, 0x5e, 0xb2, 0x5a, 0x76, 0xd3, 0x16, 0xdb, 0x75, 0x94, 0x29,
+	0x2d, 0xd1, 0xf2, 0xba, 0x2c, 0xbd, 0xbf, 0x5f, 0x1e, 0x11, 0xbf, 0xf6, 0x40, 0xa9, 0x6d, 0xfe,
+	0x8c, 0x81, 0xce, 0x46, 0x93, 0x27, 0x96, 0x8a, 0xec, 0x39, 0x4f, 0x80, 0x90, 0xd9, 0x73, 0x9e,
+	0x5f, 0xa9, 0x7b, 0xcf, 0xf9, 0x8b, 0x28, 0xab, 0xe7, 0xaf, 0x47, 0x63, 0x84, 0x07, 0xc2, 0x5b,
+	0x5e, 0x00, 0x19, 0x58, 0x94, 0xc9, 0x1c, 0x16, 0xa3, 0x62, 0x50, 0xeb, 0x98, 0x7f, 0x5b, 0x44,
+	0xe3, 0x6b, 0x0d, 0xdb, 0xd9, 0x95, 0x11, 0xff, 0x42, 0x73, 0x2c, 0xe3, 0x64, 0xcc, 0xb1, 0x9e,
+	0x41, 0xa5, 0xa6, 0x6a, 0x89, 0xc0, 0x19, 0x27, 0xcb, 0x69, 0x84, 0x33, 0xc0, 0x44, 0x4d, 0x2b,
+	0x19, 0x75, 0x20, 0xb3, 0x35, 0x0e, 0xd0, 0x50, 0x4d, 0x26, 0xc2, 0xce, 0x1d, 0xc5, 0x4e, 0x9d,
+	0x8b, 0x59, 0x35, 0xa0, 0x53, 0x78, 0xe6, 0x89, 0xe5, 0x29, 0x68, 0x51, 0xfd, 0xf8, 0x79, 0xb2,
+	0xcb, 0x03, 0x9a, 0x6d, 0x78, 0xd6, 0xd6, 0x96, 0x5d, 0x13, 0x7e, 0xe0, 0x7c, 0x25, 0xae, 0x50,
+	0x4b, 0xc3, 0xc5, 0xb4, 0x0a, 0xf7, 0xf7, 0xcb, 0xd7, 0x52, 0xe3, 0xcb, 0xb1, 0xaf, 0x99, 0xda,
+	0x04, 0xd2, 0x49, 0xd1, 0x60, 0xd6, 0x47, 0x88, 0x1e, 0xa2, 0x45, 0x91, 0xfb, 0xe5, 0x02, 0x1a,
+	0xa7, 0xcb, 0x8d, 0x46, 0xaa, 0x6d, 0xd2, 0x1c, 0x66, 0x8f, 0xc6, 0xe3, 0x35, 0x87, 0x56, 0x20,
+	0x89, 0x98, 0xcd, 0x2b, 0x68, 0x7a, 0x8b, 0x3e, 0x13, 0x37, 0x2a, 0xeb, 0x1b, 0xae, 0x30, 0x49,
+	0x5e, 0x58, 0xab, 0x96, 0x0a, 0x91, 0x56, 0x7a, 0x29, 0x05, 0x0e, 0xa9, 0xad, 0xa8, 0x6f, 0x58,
+	0x54, 0xbe, 0xd9, 0xe6, 0x6e, 0x6c, 0x14, 0x5d, 0x31, 0x72, 0xc3, 0x5b, 0x4a, 0xab, 0x00, 0xe9,
+	0xed, 0xa8, 0x8b, 0xa9, 0x50, 0x7f, 0x2f, 0xb9, 0xde, 0x3d, 0xcb, 0xab, 0xeb, 0x68, 0x79, 0x82,
+	0x29, 0xa6, 0x90, 0x5f, 0xc8, 0xae, 0x06, 0xdd, 0x70, 0xd0, 0x5c, 0x1a, 0xa5, 0x35, 0x37, 0x08,
+	0xf3, 0x78, 0x54, 0x3b, 0x77, 0x22, 0x1b, 0xa6, 0xc3, 0xdf, 0x26, 0x65, 0x34, 0x48, 0x63, 0x51,
+	0x6a, 0x7b, 0x9f, 0x86, 0xa8, 0xf4, 0x81, 0x97, 0xd3, 0x0a, 0x01, 0xe3, 0x1a, 0x8a, 0x51, 0x05,
+	0x7e, 0xd7, 0xf3, 0x72, 0xea, 0xdc, 0xec, 0x31, 0x6b, 0x2f, 0xb9, 0xdd, 0x79, 0xce, 0x0f, 0x5e,
+	0x04, 0x12, 0x86, 0x3d, 0x34, 0x7c, 0x8f, 0xdc, 0xd9, 0x76, 0xdd, 0xbb, 0xfd, 0x58, 0x82, 0xa9,
+	0x23, 0xbd, 0xcd, 0xd1, 0x45, 0xab, 0x43, 0x14, 0x80, 0x24, 0xc4, 0x42, 0x4d, 0xa7, 0xb4, 0xa0,
+	0x6f, 0xf0, 0x8e, 0xd7, 0x2c, 0x19, 0xfa, 0x1b, 0x7c, 0x13, 0x56, 0x80, 0x96, 0x53, 0x5b, 0x8f,
+	0xb6, 0xb5, 0x47, 0x77, 0xf9, 0x06, 0x69, 0xb5, 0x9b, 0x51, 0xdc, 0x55, 0x66, 0xeb, 0xb1, 0xae,
+	0x83, 0x20, 0x5e, 0x97, 0x05, 0x71, 0x70, 0x9d, 0x80, 0x38, 0x2c, 0x44, 0xa3, 0x0c, 0x99, 0xcf,
+	0xe4, 0xae, 0x51, 0x31, 0xa8, 0x75, 0xa8, 0x39, 0xa5, 0x1e, 0xd2, 0x9c, 0x06, 0x7a, 0xf6, 0x44,
+	0xb2, 0x6c, 0x11, 0xe8, 0x99, 0xbe, 0xf3, 0x68, 0x19, 0x95, 0x50, 0x79, 0x61, 0x45, 0xd1, 0xb3,
+	0x49, 0x2e, 0x9d, 0x91, 0xa5, 0x80, 0x3c, 0x0d, 0x55, 0x60, 0x35, 0x4a, 0xc5, 0x08, 0xd5, 0x86,
+	0xd5, 0x00, 0x5a, 0xc6, 0xb2, 0x05, 0xda, 0x0d, 0xe2, 0x4b, 0xb5, 0x2f, 0xcf, 0x16, 0xc8, 0x4a,
+	0x40, 0x40, 0xb0, 0x85, 0x26, 0xda, 0x9d, 0xa6, 0x08, 0x95, 0x48, 0xdf, 0xaf, 0xfc, 0xf3, 0x3d,
+	0x92, 0x96, 0x0a, 0x9b, 0x6d, 0xe1, 0xd4, 0x7c, 0xd8, 0xeb, 0x2a, 0x0a, 0xd0, 0x31, 0x9a, 0x3f,
+	0x3c, 0x84, 0x94, 0xc8, 0x79, 0x47, 0x78, 0x4a, 0xfc, 0x84, 0x41, 0x93, 0x7f, 0xda, 0xc4, 0x09,
+	0x62, 0x41, 0xa8, 0x38, 0x0f, 0xb0, 0x99, 0x2b, 0xa4, 0x5f, 0x9b, 0x38, 0xcb, 0x0b, 0xc2, 0x73,
+	0xb5, 0x92, 0x82, 0x5c, 0x78, 0xf7, 0xa6, 0x40, 0x20, 0xb5, 0x33, 0x6c, 0x3c, 0xac, 0x7c, 0x79,
+	0x41, 0x8d, 0xcc, 0x5d, 0x11, 0x65, 0x10, 0x42, 0xe9, 0xd2, 0x69, 0x78, 0x6e, 0xa7, 0xed, 0x57,
+	0x58, 0x80, 0x8a, 0x81, 0x68, 0xe9, 0x5c, 0x8f, 0x8a, 0x41, 0xad, 0x43, 0x95, 0x94, 0xfc, 0xe7,
+	0xba, 0x47, 0xb6, 0xec, 0x5d, 0xc1, 0x59, 0x30, 0x85, 0xc4, 0x75, 0xa5, 0x1c, 0xb4, 0x5a, 0x2c,
+	0x34, 0xab, 0xef, 0x77, 0x88, 0xb7, 0x09, 0x2b, 0x4c, 0x03, 0x2a, 0xd2, 0x22, 0x2d, 0xcb, 0x42,
+	0x88, 0xe0, 0x54, 0x70, 0x3c, 0x49, 0x23, 0xd4, 0xd9, 0x1e, 0xe5, 0x43, 0x2d, 0xbb, 0xe5, 0x97,
+	0x86, 0xf3, 0x87, 0x4b, 0x8d, 0x3e, 0xf4, 0x2c, 0x68, 0x48, 0xf9, 0x2d, 0x17, 0x9a, 0x4c, 0xea,
+	0x40, 0x88, 0xf5, 0x80, 0x4e, 0x95, 0x6f, 0x37, 0x1c, 0xdb, 0x69, 0xcc, 0x35, 0x1b, 0xbe, 0x48,
+	0x1b, 0xcb, 0x75, 0x7b, 0x51, 0x31, 0xa8, 0x75, 0xa8, 0xbd, 0x44, 0xc7, 0xa7, 0x77, 0x57, 0x8b,
+	0xf0, 0xf9, 0x1d, 0x8d, 0xcc, 0x58, 0x37, 0x55, 0x00, 0xe8, 0xf5, 0xa8, 0xf5, 0x96, 0x2c, 0x10,
+	0xb3, 0x8c, 0x58, 0x4b, 0xc6, 0x34, 0x6e, 0x6a, 0x10, 0x88, 0xd5, 0x9c, 0x99, 0x43, 0xe7, 0x52,
+	0x86, 0x79, 0xa4, 0x0b, 0xf2, 0xef, 0x0c, 0x74, 0x5e, 0x57, 0x2c, 0x48, 0xd5, 0x46, 0x7a, 0x82,
+	0x34, 0xe3, 0x44, 0x13, 0xa4, 0x7d, 0x15, 0x12, 0xc1, 0x99, 0x3f, 0x55, 0x40, 0xaf, 0x3c, 0x74,
+	0x5f, 0xe2, 0x1f, 0x31, 0xd0, 0x18, 0xd9, 0x0d, 0x3c, 0x2b, 0x8c, 0xe2, 0x43, 0x17, 0xe9, 0xd6,
+	0x89, 0x1c, 0x02, 0xb3, 0x8b, 0x11, 0x21, 0xbe, 0x70, 0xc3, 0xf7, 0xb0, 0x02, 0x01, 0xb5, 0x3f,
+	0xf4, 0xb4, 0xe5, 0xb9, 0x3d, 0x55, 0x7b, 0x77, 0x71, 0x0a, 0x0a, 0xc8, 0xcc, 0x3b, 0x68, 0x06,
+	0x32, 0x1d, 0xf3, 0x91, 0xd6, 0xca, 0x67, 0x0d, 0x94, 0x1a, 0x69, 0x9b, 0x0a, 0xe1, 0xa9, 0x94,
+	0x51, 0xb3, 0x9f, 0x11, 0xef, 0x01, 0x26, 0x84, 0x9f, 0x8b, 0x03, 0x21, 0x59, 0x9f, 0x2b, 0xef,
+	0xa8, 0x70, 0x5a, 0x47, 0xc3, 0x79, 0x07, 0xa1, 0xbc, 0x4b, 0x80, 0x21, 0xad, 0x8d, 0xf9, 0x8f,
+	0x0d, 0x74, 0x3e, 0x55, 0xb6, 0xdc, 0x03, 0xd3, 0x92, 0xbe, 0xec, 0x0b, 0x27, 0xb9, 0xec, 0xcd,
+	0x5f, 0x2c, 0x20, 0x1a, 0x63, 0x8a, 0x5e, 0x6d, 0xa7, 0x20, 0x46, 0xb5, 0x34, 0x31, 0x6a, 0x2e,
+	0x21, 0x91, 0xe8, 0x6c, 0xa6, 0xdc, 0xd4, 0x8e, 0xc9, 0x4d, 0xe7, 0xfa, 0x21, 0xd2, 0x5d, 0x50,
+	0x4a, 0x83, 0x14, 0x8b, 0x9a, 0x51, 0xea, 0xec, 0x0e, 0x3a, 0x17, 0xa5, 0xb5, 0xa3, 0x53, 0xec,
+	0x07, 0x56, 0xab, 0x9d, 0xe3, 0xf0, 0x62, 0xeb, 0x6e, 0x31, 0x89, 0x0a, 0xd2, 0xf0, 0x9b, 0x5f,
+	0x34, 0xd0, 0x58, 0xd8, 0x97, 0x13, 0x97, 0xd2, 0x7e, 0xa3, 0x2e, 0xa5, 0x7d, 0x7b, 0x1f, 0x73,
+	0x9c, 0x21, 0x96, 0xfd, 0x94, 0x81, 0x26, 0x44, 0x8d, 0x55, 0xd2, 0xba, 0x43, 0x3c, 0xbc, 0x84,
+	0x86, 0xfd, 0x0e, 0x5b, 0x54, 0x62, 0x40, 0x0f, 0x28, 0x03, 0x9a, 0xf5, 0xee, 0x58, 0x35, 0xda,
+	0xfd, 0x2a, 0xaf, 0x12, 0x71, 0xcf, 0xa2, 0x00, 0x64, 0x63, 0xba, 0x0f, 0x3d, 0xb7, 0x99, 0x48,
+	0xc8, 0x04, 0x6e, 0x93, 0x00, 0x83, 0xd0, 0xb7, 0x01, 0xfd, 0xab, 0xbd, 0x0d, 0x28, 0xd8, 0x07,
+	0x5e, 0x6e, 0x1e, 0xa0, 0x70, 0xb2, 0x99, 0x94, 0xe8, 0x06, 0x1a, 0xad, 0x79, 0xc4, 0x0a, 0x48,
+	0x7d, 0x7e, 0xaf, 0x97, 0xce, 0x31, 0x9e, 0xa4, 0x22, 0x5b, 0x40, 0xd4, 0x98, 0x5e, 0xff, 0xaa,
+	0x53, 0x47, 0x21, 0xe2, 0x94, 0x32, 0x1d, 0x3a, 0xbe, 0x0e, 0x0d, 0xba, 0xf7, 0x9c, 0xd0, 0x07,
+	0xb5, 0x2b, 0x61, 0x36, 0x94, 0x5b, 0xb4, 0x36, 0xf0, 0x46, 0x6a, 0x42, 0xb2, 0x81, 0x2e, 0x09,
+	0xc9, 0x9a, 0x68, 0xb8, 0xc5, 0x3e, 0x83, 0x4c, 0x1f, 0xd6, 0xcf, 0xb6, 0xe2, 0x1f, 0x34, 0xfa,
+	0x44, 0xfc, 0x37, 0x8d, 0x18, 0xc5, 0xff, 0xa1, 0x6c, 0x9c, 0x23, 0x45, 0x84, 0x2a, 0x1b, 0x17,
+	0xca, 0x0d, 0x21, 0x82, 0xd3, 0x94, 0xed, 0x6a, 0xa6, 0xbb, 0xe1, 0xfc, 0x82, 0x77, 0xd1, 0x3d,
+	0x25, 0xb9, 0x1d, 0x9f, 0xfa, 0xac, 0x6c, 0x77, 0x34, 0x60, 0xec, 0xc5, 0x7a, 0x7a, 0xda, 0x63,
+	0x61, 0x1e, 0x97, 0xcb, 0x1c, 0x27, 0x23, 0x93, 0xf2, 0x7c, 0x59, 0x4c, 0x58, 0x56, 0xaa, 0x65,
+	0xc8, 0xea, 0x0c, 0xfe, 0x5e, 0x03, 0xe1, 0x56, 0x5c, 0xad, 0x4d, 0x25, 0xbc, 0xc5, 0xbc, 0x5e,
+	0x23, 0x09, 0x25, 0x79, 0x24, 0x0e, 0x4d, 0x80, 0x7c, 0x48, 0x21, 0x8e, 0x3f, 0x69, 0xa0, 0xe9,
+	0xed, 0x64, 0x22, 0x22, 0x1a, 0x4b, 0xec, 0x38, 0x72, 0x54, 0x49, 0x7c, 0xf3, 0x97, 0x45, 0xbf,
+	0xa6, 0x53, 0x80, 0x3e, 0xa4, 0x76, 0x21, 0x35, 0xa3, 0xd4, 0xd8, 0x29, 0x67, 0x94, 0xc2, 0x2f,
+	0xa0, 0xd1, 0xa6, 0xbc, 0x56, 0x4a, 0xe3, 0xf9, 0x05, 0xe0, 0xf1, 0x2b, 0x8a, 0xef, 0xa5, 0xf0,
+	0x27, 0x44, 0x54, 0xa8, 0xd1, 0xd6, 0x84, 0xa3, 0x48, 0x16, 0xfc, 0xd2, 0x44, 0x1f, 0x12, 0xbf,
+	0x0c, 0xf1, 0x4d, 0xa4, 0x58, 0x52, 0x6b, 0xf8, 0xa0, 0x53, 0x36, 0xff, 0x6e, 0x28, 0xbc, 0x01,
+	0x84, 0xb8, 0x37, 0x5d, 0x18, 0x6f, 0xe4, 0x11, 0xc6, 0xe3, 0x37, 0xc8, 0xc4, 0xd1, 0xfc, 0x88,
+	0x7d, 0x30, 0x9e, 0x38, 0x7a, 0x5c, 0x90, 0xd6, 0x92, 0x45, 0x77, 0xd0, 0x39, 0x3f, 0xa0, 0x59,
+	0x82, 0x6c, 0x61, 0x21, 0xc1, 0xef, 0xf6, 0x62, 0xbe, 0xbb, 0xbd, 0x9a, 0x44, 0x05, 0x69, 0xf8,
+	0xf1, 0xb7, 0xb0, 0xb8, 0xd4, 0x56, 0x93, 0x19, 0xae, 0xb1, 0x3d, 0xad, 0x10, 0x3f, 0xba, 0x27,
+	0xa8, 0x88, 0x3a, 0x9d, 0x8e, 0x0f, 0x32, 0x29, 0xe1, 0xf7, 0xa1, 0xf3, 0x94, 0x81, 0x9c, 0xab,
+	0x05, 0xf6, 0x8e, 0x1d, 0xec, 0x45, 0x5d, 0x38, 0x7a, 0xba, 0x66, 0x26, 0x72, 0x5c, 0x49, 0x43,
+	0x06, 0xe9, 0x34, 0xb0, 0x8b, 0xa6, 0x98, 0x39, 0xf2, 0x0e, 0xa9, 0x87, 0x85, 0xa5, 0xa1, 0x23,
+	0x13, 0xe6, 0x6f, 0x82, 0x38, 0x22, 0x48, 0xe2, 0x66, 0x39, 0xaa, 0x65, 0x69, 0xda, 0xbc, 0x0f,
+	0xe7, 0xcb, 0x51, 0x3d, 0x97, 0x8d, 0x12, 0xba, 0xd1, 0xc3, 0x2f, 0xb0, 0x44, 0x40, 0xd2, 0xcd,
+	0x8a, 0xdf, 0x2e, 0x4f, 0xe4, 0x8c, 0xa6, 0xcd, 0xb1, 0x68, 0x09, 0x80, 0x04, 0x62, 0x50, 0x88,
+	0x98, 0x7f, 0x65, 0x20, 0x9c, 0xbc, 0x13, 0x71, 0x93, 0x79, 0xbf, 0xf2, 0x40, 0x6e, 0xc6, 0xb1,
+	0xe4, 0x95, 0x0d, 0x59, 0xcd, 0x30, 0xfe, 0x5b, 0x48, 0x01, 0xbb, 0x68, 0xf4, 0xde, 0xb6, 0x1d,
+	0x90, 0xa6, 0xed, 0x07, 0xc7, 0x94, 0xc6, 0x36, 0x4c, 0x51, 0x75, 0x5b, 0x22, 0x86, 0x88, 0x86,
+	0xf9, 0x9d, 0x03, 0x68, 0x44, 0x6a, 0xcc, 0x7a, 0x70, 0xc1, 0xec, 0x20, 0x5c, 0x53, 0xe2, 0x93,
+	0xf7, 0xa3, 0xac, 0x63, 0xef, 0xb6, 0x4a, 0x02, 0x19, 0xa4, 0x10, 0xc0, 0xef, 0xa3, 0xce, 0x73,
+	0x5b, 0x9e, 0x15, 0x46, 0x5f, 0xaf, 0x48, 0x0d, 0x4d, 0x0e, 0xc2, 0x4c, 0xf2, 0xb7, 0x9c, 0x82,
+	0x0e, 0x52, 0x89, 0x60, 0x82, 0x86, 0xef, 0xf1, 0xc7, 0xad, 0x50, 0xf7, 0xe7, 0x52, 0x12, 0xf3,
+	0xf7, 0xb1, 0x22, 0xe7, 0x16, 0xef, 0x65, 0x89, 0x9b, 0xe7, 0xca, 0xe0, 0xff, 0x4b, 0x4b, 0x88,
+	0xd2, 0x60, 0xfe, 0x5b, 0xf8, 0xb6, 0x8e, 0x4a, 0xe4, 0xca, 0xd0, 0x0b, 0x21, 0x4e, 0xd0, 0xfc,
+	0x67, 0x05, 0x34, 0xc8, 0x43, 0x12, 0x9f, 0xfc, 0xf3, 0xf8, 0xbd, 0xda, 0xf3, 0x38, 0xd7, 0xee,
+	0x66, 0x5d, 0xcd, 0x7c, 0x1c, 0x37, 0x62, 0x8f, 0xe3, 0x27, 0xf3, 0x93, 0xe8, 0xfe, 0x34, 0xfe,
+	0x0f, 0x45, 0x74, 0x86, 0xd5, 0x13, 0xd9, 0x4f, 0xa8, 0xc2, 0xfb, 0x3b, 0x0d, 0x34, 0xa1, 0xae,
+	0x64, 0x79, 0x7a, 0xdc, 0xea, 0x37, 0x27, 0x40, 0x8c, 0x50, 0xc4, 0x5f, 0xa8, 0x95, 0x7c, 0xd0,
+	0x89, 0xe3, 0xdf, 0x35, 0xd0, 0xb8, 0xaa, 0xf2, 0x14, 0x87, 0xcb, 0x66, 0xee, 0x29, 0x89, 0x7a,
+	0x30, 0xab, 0x2a, 0x59, 0xb9, 0x18, 0xed, 0x59, 0x69, 0xcf, 0xa2, 0x82, 0xee, 0xef, 0x97, 0xcb,
+	0x29, 0x8a, 0x4a, 0x69, 0x02, 0x47, 0x1f, 0xde, 0x1f, 0xf9, 0xe3, 0xae, 0x55, 0x98, 0xf4, 0x48,
+	0x1b, 0xc6, 0x8c, 0x8b, 0xa6, 0x12, 0xe4, 0x53, 0x64, 0x6d, 0x0b, 0xba, 0x27, 0xcc, 0x11, 0x9d,
+	0x3e, 0x54, 0xd9, 0xdc, 0x17, 0x0c, 0x34, 0xca, 0x26, 0xe0, 0x14, 0x04, 0x0f, 0xcf, 0xe9, 0x82,
+	0x87, 0xb7, 0xe5, 0xfe, 0x58, 0x19, 0x62, 0x87, 0x8f, 0xca, 0x75, 0xcb, 0xbc, 0xe2, 0x37, 0xfd,
+	0xde, 0x7c, 0x78, 0xaf, 0xa9, 0xef, 0x55, 0x11, 0xef, 0x5d, 0xde, 0x31, 0xa9, 0x6f, 0xd6, 0x9f,
+	0x37, 0xd0, 0x40, 0xc7, 0x67, 0xe1, 0xee, 0x8b, 0x79, 0xcd, 0xd4, 0x63, 0xdd, 0x9c, 0xdd, 0xf4,
+	0x49, 0x9d, 0xaf, 0x35, 0x90, 0x5d, 0xa4, 0x45, 0xc7, 0xb4, 0xc6, 0x58, 0x77, 0x67, 0x1a, 0x68,
+	0x34, 0x24, 0x73, 0xa2, 0x6b, 0xea, 0x2f, 0x87, 0xc4, 0x9a, 0x62, 0xf2, 0x95, 0x65, 0x74, 0x4e,
+	0x04, 0xe1, 0xa2, 0xaf, 0x16, 0x7a, 0x7f, 0xd1, 0x74, 0xb5, 0x22, 0x46, 0x03, 0x0f, 0x70, 0x9b,
+	0x04, 0x43, 0x5a, 0x1b, 0xfc, 0xcb, 0x06, 0x95, 0x64, 0x04, 0x9e, 0x5d, 0xeb, 0xcb, 0xc4, 0x30,
+	0xec, 0xdb, 0xec, 0x2a, 0x47, 0xc6, 0x67, 0x7e, 0x33, 0x12, 0x69, 0xb0, 0xd2, 0x63, 0x9a, 0x7c,
+	0xd9, 0x63, 0x7c, 0x03, 0x0d, 0xfa, 0x35, 0xb7, 0x2d, 0xc3, 0xb8, 0x3d, 0x94, 0xa6, 0xac, 0x8c,
+	0xeb, 0x29, 0xc3, 0x85, 0x5e, 0xa5, 0x2d, 0x81, 0x23, 0xc0, 0xff, 0xca, 0x40, 0x63, 0xbe, 0xbb,
+	0x15, 0x88, 0x8e, 0x8a, 0x7b, 0x7c, 0xad, 0xbf, 0xb9, 0xa8, 0x46, 0x08, 0xf9, 0x7c, 0xbc, 0x5b,
+	0x2a, 0x0f, 0x14, 0xc8, 0x31, 0xcd, 0x89, 0xda, 0x7b, 0x6c, 0x51, 0x05, 0x63, 0xbf, 0x59, 0x38,
+	0x84, 0x42, 0x32, 0x44, 0x03, 0x2a, 0xce, 0x99, 0xe7, 0xd1, 0xb8, 0x3a, 0xb4, 0x93, 0x5c, 0xfd,
+	0x33, 0x0e, 0x3a, 0x1b, 0x9f, 0xca, 0x13, 0xdd, 0x6d, 0xdf, 0x3d, 0x80, 0xc6, 0x94, 0x5b, 0x1d,
+	0xff, 0x53, 0x79, 0x3c, 0xf5, 0x61, 0x7f, 0xaf, 0xe0, 0x3b, 0x8d, 0xa3, 0x09, 0xdf, 0x45, 0x43,
+	0x3e, 0x3d, 0x0c, 0xfb, 0x4a, 0x13, 0x18, 0x3b, 0x53, 0x15, 0xf6, 0x86, 0xa1, 0x06, 0x41, 0x82,
+	0x0a, 0x02, 0xb8, 0x39, 0xe3, 0xe2, 0x6e, 0x8d, 0x90, 0x3a, 0xa9, 0xb3, 0x17, 0x7b, 0x5e, 0x41,
+	0xc0, 0x4a, 0x12, 0x15, 0xa4, 0xe1, 0x3f, 0xbd, 0xe3, 0xf7, 0x77, 0x8a, 0x68, 0x08, 0x48, 0xa3,
+	0x37, 0x5b, 0x1b, 0x1b, 0x0d, 0xbe, 0xe8, 0x3a, 0x61, 0x72, 0xf9, 0x7c, 0x79, 0xd1, 0x94, 0x94,
+	0xd9, 0xcf, 0xba, 0x8e, 0x72, 0x6a, 0xd1, 0x5f, 0x3e, 0x70, 0x0a, 0xd8, 0x41, 0x43, 0x4d, 0xeb,
+	0x0e, 0x69, 0x4a, 0x23, 0xe7, 0x5c, 0x62, 0x5e, 0x3e, 0xb0, 0xd9, 0x15, 0x86, 0x28, 0x66, 0x83,
+	0xc6, 0x0b, 0x41, 0x50, 0x61, 0x72, 0x53, 0xab, 0x56, 0x23, 0xbe, 0x0f, 0xc4, 0xa7, 0x9b, 0x91,
+	0xbf, 0xbe, 0x07, 0xf2, 0xcb, 0x4d, 0xe7, 0xe2, 0xd8, 0x22, 0xc9, 0x55, 0x02, 0xe4, 0x43, 0x0a,
+	0x71, 0x6a, 0x92, 0xa6, 0x74, 0xfd, 0x48, 0x5a, 0xd4, 0x7f, 0x6d, 0xa0, 0x71, 0xb9, 0x75, 0x58,
+	0x92, 0xd6, 0x56, 0x64, 0x8e, 0x93, 0xdf, 0x75, 0x43, 0x06, 0x6a, 0x7a, 0xa0, 0x4b, 0x25, 0x6e,
+	0xe2, 0x73, 0x2b, 0x4c, 0x86, 0x9b, 0xe3, 0x91, 0x9a, 0x92, 0xed, 0xd6, 0xfc, 0x84, 0x81, 0x2e,
+	0xc8, 0x01, 0xe9, 0x59, 0x0f, 0xa9, 0x75, 0x8a, 0xd5, 0xb6, 0x99, 0xad, 0x88, 0x6a, 0x6d, 0x33,
+	0xb7, 0xbe, 0xcc, 0xca, 0x20, 0x84, 0xd2, 0x28, 0x55, 0x72, 0x33, 0x08, 0xe6, 0x2d, 0xe4, 0x40,
+	0x25, 0x6e, 0x08, 0x6b, 0xe0, 0x57, 0x09, 0x2f, 0x6d, 0x1e, 0xcf, 0x2a, 0x64, 0xf3, 0x42, 0xc2,
+	0xdc, 0xef, 0x9a, 0xea, 0x81, 0xc7, 0x84, 0xe6, 0xf7, 0xb6, 0xb5, 0x43, 0xf0, 0x5b, 0xd1, 0x88,
+	0xd0, 0xa5, 0x48, 0x73, 0x55, 0x2a, 0x86, 0x1b, 0x11, 0x8a, 0x16, 0xe6, 0xde, 0xce, 0xce, 0x18,
+	0x51, 0x00, 0x61, 0x6d, 0x5c, 0x47, 0xe3, 0xbe, 0x6b, 0xdd, 0x95, 0xd7, 0x54, 0xce, 0xe8, 0x44,
+	0xcc, 0x72, 0xa6, 0xaa, 0xe0, 0x01, 0x0d, 0xab, 0xf9, 0x66, 0x34, 0x5a, 0xad, 0xde, 0xe0, 0x4b,
+	0xf0, 0x08, 0x96, 0x8a, 0xe6, 0xb7, 0x17, 0xd1, 0x84, 0x48, 0x37, 0x6b, 0x33, 0xad, 0xf7, 0x29,
+	0x3c, 0x93, 0x37, 0xd0, 0xa8, 0x1f, 0x9a, 0x6d, 0x15, 0xb2, 0x39, 0xa1, 0xd0, 0x12, 0x4b, 0x5a,
+	0x6c, 0x85, 0xa9, 0xc9, 0x25, 0x00, 0x22, 0x44, 0xf8, 0x26, 0x1a, 0x7a, 0x81, 0x1e, 0xff, 0xf2,
+	0x6c, 0xe9, 0x89, 0xb9, 0x0a, 0x0f, 0x0e, 0x76, 0x73, 0xf8, 0x20, 0x50, 0x60, 0x9f, 0x45, 0x3e,
+	0x63, 0x32, 0xa4, 0x7e, 0x12, 0x0f, 0x69, 0x33, 0x2b, 0x85, 0x52, 0x7c, 0x21, 0xcb, 0x5f, 0x10,
+	0x12, 0x32, 0x7f, 0xdf, 0x40, 0x53, 0x5a, 0x8b, 0x53, 0x78, 0x90, 0x6d, 0xe9, 0x0f, 0xb2, 0xb9,
+	0xbe, 0x47, 0x99, 0xf1, 0x30, 0x7b, 0x1b, 0x3a, 0x9f, 0x3a, 0x19, 0x87, 0x4b, 0xe8, 0xcc, 0x7f,
+	0x52, 0x40, 0x03, 0x55, 0x42, 0xea, 0xa7, 0xb0, 0x32, 0x9f, 0xd3, 0x04, 0x38, 0x5f, 0x97, 0x6f,
+	0x32, 0x48, 0x3d, 0x53, 0x7e, 0xb3, 0x15, 0x93, 0xdf, 0xbc, 0x23, 0x37, 0x85, 0xee, 0xe2, 0x9b,
+	0x3f, 0x2f, 0xa0, 0x61, 0x5a, 0x8d, 0x1a, 0xfb, 0xb6, 0x94, 0xa5, 0x5c, 0xc8, 0x2f, 0x7d, 0x13,
+	0xe8, 0x0e, 0x5b, 0xc4, 0x74, 0xe7, 0xd8, 0x22, 0x8d, 0x75, 0xa9, 0xd8, 0xcf, 0xce, 0xd1, 0xc8,
+	0x09, 0x07, 0x6e, 0x46, 0x54, 0x66, 0xc9, 0x86, 0x90, 0x10, 0xbe, 0xa7, 0x88, 0xb4, 0x07, 0xae,
+	0x16, 0x8f, 0x97, 0x68, 0x17, 0xe9, 0xb6, 0xf9, 0x1f, 0x0d, 0x74, 0x26, 0xd6, 0xaa, 0x07, 0x99,
+	0xf3, 0xc9, 0x1c, 0x80, 0xef, 0x45, 0x93, 0x4a, 0xd0, 0x84, 0xc8, 0x24, 0xb6, 0xa7, 0x83, 0x90,
+	0xd9, 0x0c, 0x56, 0xb4, 0xe6, 0x10, 0x43, 0x67, 0x7e, 0xc5, 0x40, 0xe7, 0x63, 0x83, 0x15, 0x02,
+	0xe5, 0xc3, 0x87, 0xfc, 0x6a, 0x34, 0x54, 0x77, 0xa9, 0x16, 0x59, 0x5a, 0x06, 0xcb, 0x95, 0xbb,
+	0xc0, 0x4a, 0x41, 0x40, 0xa9, 0x4b, 0x08, 0x65, 0x15, 0x55, 0x67, 0x16, 0xca, 0x41, 0x02, 0x2b,
+	0xc5, 0xb5, 0xc4, 0x10, 0x07, 0x7a, 0x1f, 0x62, 0x68, 0xc2, 0x79, 0xc8, 0x30, 0x7f, 0xc3, 0x40,
+	0x23, 0x74, 0x98, 0xa7, 0x70, 0xfa, 0x7e, 0x83, 0x7e, 0xfa, 0xbe, 0x35, 0xef, 0xa2, 0xcd, 0x38,
+	0x74, 0xff, 0xa2, 0x80, 0xc6, 0x29, 0x58, 0x38, 0xde, 0x28, 0x2e, 0x35, 0x46, 0x86, 0x33, 0xd0,
+	0x55, 0xe1, 0x91, 0x13, 0x33, 0xae, 0x51, 0xbc, 0x72, 0x5e, 0xab, 0x39, 0xdd, 0x68, 0xdc, 0x56,
+	0x8a, 0xe3, 0xcd, 0x8b, 0x68, 0x82, 0x3d, 0xb9, 0x16, 0xa2, 0xdd, 0x99, 0xdb, 0xa8, 0x8b, 0xf1,
+	0x57, 0x72, 0x28, 0xdc, 0x3c, 0xb6, 0xaa, 0xe2, 0x06, 0x9d, 0x14, 0x35, 0x48, 0xbf, 0xd3, 0x74,
+	0x6b, 0x77, 0xb9, 0xcf, 0xcf, 0x60, 0x14, 0x32, 0x61, 0x3e, 0x2c, 0x05, 0xa5, 0x46, 0x5f, 0xee,
+	0x4d, 0x5f, 0x31, 0xf8, 0x4c, 0x1f, 0xe1, 0x10, 0x38, 0x45, 0x0f, 0xb1, 0x57, 0xa3, 0x21, 0x8f,
+	0x3d, 0x9a, 0x4a, 0x45, 0x7d, 0xf3, 0xf1, 0xa7, 0x14, 0x08, 0x28, 0x5d, 0x1e, 0xfc, 0x25, 0x38,
+	0x10, 0x19, 0x4e, 0xa9, 0xef, 0x37, 0xf3, 0x4f, 0x06, 0x29, 0x87, 0x12, 0xb9, 0x9b, 0x73, 0x01,
+	0xeb, 0x5f, 0x1b, 0x68, 0x5a, 0x95, 0xcd, 0xcb, 0x04, 0xe2, 0x42, 0xfc, 0xf0, 0xde, 0xbc, 0xab,
+	0x5a, 0xa3, 0x32, 0x5b, 0x49, 0xa1, 0xc0, 0x5f, 0x7f, 0x77, 0xa4, 0x85, 0x48, 0x5a, 0x95, 0x63,
+	0x12, 0x52, 0xa4, 0x0e, 0x10, 0xff, 0xa9, 0xc1, 0x93, 0x67, 0xd0, 0x30, 0xb0, 0x35, 0x2b, 0x10,
+	0x29, 0x42, 0x72, 0xa7, 0xd0, 0x4f, 0x0e, 0x7a, 0x4d, 0x47, 0xce, 0xc7, 0xfb, 0x0d, 0x62, 0xbc,
+	0x67, 0x62, 0xd0, 0x63, 0x1a, 0x6a, 0x7c, 0x44, 0x33, 0xf7, 0xd0, 0xa5, 0xcc, 0xc9, 0x3f, 0x51,
+	0x39, 0x9a, 0x87, 0xa6, 0xd3, 0x26, 0xe0, 0x44, 0x45, 0x27, 0xbf, 0x28, 0x76, 0x72, 0x95, 0x34,
+	0x49, 0x2d, 0x70, 0x69, 0x0e, 0x82, 0x09, 0x26, 0x4d, 0x90, 0x05, 0xe2, 0x1a, 0x78, 0x43, 0x8f,
+	0xd7, 0x80, 0xda, 0x34, 0xd2, 0x6c, 0x69, 0xc5, 0xa0, 0x13, 0xa0, 0x0e, 0x01, 0x72, 0x03, 0x73,
+	0xcf, 0xe8, 0x42, 0x14, 0x40, 0x71, 0x5d, 0x05, 0x80, 0x5e, 0x8f, 0x3a, 0x5d, 0x3d, 0xc8, 0xfb,
+	0xce, 0x94, 0x9f, 0x0b, 0xa4, 0x4d, 0x9c, 0x3a, 0x71, 0x6a, 0x7b, 0xec, 0x6d, 0x5d, 0x77, 0xa9,
+	0xda, 0x79, 0xe8, 0x1e, 0x21, 0xf5, 0xd0, 0xda, 0xf0, 0x76, 0x6e, 0x06, 0x34, 0x8b, 0xc4, 0x6d,
+	0x86, 0x9e, 0x1b, 0x91, 0xf3, 0xff, 0x41, 0x90, 0xa4, 0xc4, 0xdb, 0x9e, 0x7b, 0x27, 0x7c, 0x52,
+	0x1d, 0x3f, 0xf1, 0x75, 0x86, 0x9e, 0x13, 0xe7, 0xff, 0x83, 0x20, 0x69, 0xae, 0xa3, 0x87, 0x7a,
+	0x68, 0x7a, 0x94, 0xa7, 0xf3, 0x61, 0x18, 0xf9, 0xe8, 0x8f, 0x82, 0xf1, 0xf7, 0x0d, 0xf4, 0xb0,
+	0x82, 0x92, 0x0a, 0x0f, 0x7d, 0xbf, 0x62, 0xb5, 0xad, 0x1a, 0x5d, 0xa4, 0x2c, 0x6b, 0x0d, 0xb7,
+	0x7e, 0x7a, 0x55, 0x1c, 0x67, 0x6a, 0xc2, 0x65, 0x6a, 0x0e, 0x36, 0xcc, 0xbd, 0x31, 0x25, 0x87,
+	0xf1, 0x5c, 0x9f, 0x53, 0x9e, 0xd9, 0x25, 0xc1, 0x2b, 0x87, 0x63, 0xe3, 0xbf, 0x7d, 0x90, 0xf4,
+	0xcd, 0x7f, 0x39, 0x88, 0xbe, 0xa6, 0x77, 0x44, 0xf8, 0x2b, 0x06, 0x1a, 0x95, 0xfb, 0x54, 0x5e,
+	0x24, 0xad, 0x93, 0xed, 0x7c, 0x78, 0x0a, 0x8a, 0x6b, 0xe5, 0xb6, 0xe4, 0xb6, 0xc3, 0xf2, 0x63,
+	0x3a, 0x60, 0xa3, 0x81, 0xe1, 0xff, 0xdf, 0x40, 0xe3, 0xf4, 0xb8, 0x0d, 0x0f, 0x17, 0xfe, 0x99,
+	0xda, 0x27, 0x3c, 0xd2, 0x35, 0x85, 0x64, 0x2c, 0xd3, 0x85, 0x0a, 0x02, 0xad, 0x6f, 0x78, 0x53,
+	0xb7, 0xd4, 0xe5, 0x62, 0x96, 0x2b, 0x69, 0xac, 0xb7, 0x62, 0xac, 0x13, 0xfa, 0x9f, 0x64, 0x59,
+	0xe1, 0xce, 0x34, 0xd1, 0xa4, 0x3e, 0xf3, 0x27, 0x7a, 0xa7, 0x3c, 0x89, 0xa6, 0x12, 0xa3, 0x3f,
+	0x92, 0x10, 0xf6, 0x8b, 0x43, 0xa8, 0xac, 0x4c, 0x75, 0x5a, 0xf4, 0x78, 0x1a, 0x6c, 0x73, 0xcc,
+	0x72, 0x1c, 0xe1, 0x0f, 0x25, 0xd7, 0x6f, 0xbd, 0xcf, 0xaf, 0x9a, 0x46, 0x6a, 0x76, 0x2e, 0x22,
+	0x13, 0x73, 0xf8, 0x51, 0x20, 0xa0, 0xf6, 0xa6, 0x8b, 0x67, 0x76, 0xe1, 0xd4, 0x3c, 0xb3, 0xf1,
+	0x07, 0x24, 0xaf, 0x59, 0xcc, 0x1f, 0x47, 0xfa, 0x90, 0xb9, 0x61, 0xac, 0x6b, 0x86, 0x26, 0xe2,
+	0xbb, 0x0d, 0x76, 0xc9, 0x46, 0x41, 0xfe, 0xc5, 0x9d, 0x94, 0xcb, 0x7c, 0xe4, 0xd0, 0x0c, 0x02,
+	0xe1, 0xdd, 0x1d, 0x15, 0x81, 0x4e, 0x1e, 0xff, 0x80, 0x81, 0xc6, 0x5f, 0x74, 0x1d, 0xab, 0x29,
+	0x7c, 0xec, 0xc5, 0xe3, 0xfd, 0xbd, 0x27, 0x33, 0x2f, 0x21, 0x19, 0x2e, 0x58, 0x56, 0x4b, 0x40,
+	0xeb, 0x06, 0x7d, 0x13, 0xf0, 0xd0, 0xa7, 0x43, 0xd1, 0x93, 0x51, 0x0d, 0x7b, 0x4a, 0x5d, 0xc3,
+	0xe2, 0x6b, 0xf0, 0x48, 0xfb, 0x69, 0x03, 0xbd, 0xe6, 0x08, 0xfd, 0xed, 0xf1, 0xea, 0x33, 0x7f,
+	0x75, 0x00, 0x3d, 0x7c, 0x38, 0x5a, 0xfe, 0xe2, 0x3d, 0x44, 0x3f, 0xf6, 0x99, 0xd8, 0x66, 0xe6,
+	0x47, 0xb4, 0x7d, 0x52, 0x0b, 0xf6, 0x78, 0x77, 0x74, 0xf1, 0xf4, 0x76, 0xf4, 0x4b, 0x6d, 0x4b,
+	0xf5, 0xbd, 0x32, 0xe7, 0xd1, 0x79, 0xe5, 0x83, 0x89, 0xac, 0x64, 0x54, 0x45, 0x42, 0x53, 0x77,
+	0xd9, 0xbe, 0x2d, 0x13, 0x50, 0x2a, 0x2c, 0xdd, 0xd3, 0xbc, 0x18, 0x24, 0xdc, 0x5c, 0xd1, 0x2e,
+	0x8b, 0x0d, 0xb7, 0xed, 0x36, 0xdd, 0xc6, 0xde, 0xdc, 0x3d, 0xcb, 0x23, 0xe0, 0x76, 0x02, 0x81,
+	0xad, 0x57, 0x06, 0xf1, 0xc3, 0x83, 0xe8, 0xaa, 0x82, 0x2e, 0x35, 0x4f, 0xd7, 0x11, 0xf0, 0xd1,
+	0xa5, 0xad, 0xa7, 0xd3, 0x2a, 0xe4, 0xf7, 0x4d, 0x3d, 0xac, 0x5f, 0xf9, 0xd2, 0x6b, 0xe1, 0x7d,
+	0x03, 0xa1, 0x96, 0xb5, 0x2b, 0x12, 0xad, 0x94, 0x8a, 0xc7, 0x72, 0x93, 0xa6, 0x77, 0x70, 0x35,
+	0x24, 0xc3, 0xbb, 0xf7, 0x8c, 0xd4, 0x15, 0x44, 0x80, 0x63, 0xe2, 0x00, 0x95, 0x11, 0xf5, 0x9d,
+	0x3f, 0x6c, 0xa6, 0x85, 0xce, 0xc4, 0x7a, 0x7e, 0xa2, 0x0f, 0xe4, 0x2f, 0x0e, 0xa3, 0x71, 0x65,
+	0x2a, 0x7d, 0xfc, 0xf3, 0x06, 0xba, 0x44, 0xb2, 0x18, 0x4e, 0xf1, 0x5a, 0x7e, 0xe6, 0xa4, 0x18,
+	0xda, 0xf9, 0x07, 0x0f, 0xf6, 0xcb, 0x97, 0x32, 0xc1, 0x90, 0xdd, 0x33, 0x1a, 0x56, 0xda, 0x0f,
+	0xf7, 0x74, 0x3f, 0x61, 0xa5, 0x53, 0x0f, 0x09, 0x11, 0xf3, 0x36, 0xfc, 0x0d, 0x0a, 0x31, 0x1a,
+	0x32, 0x73, 0xba, 0x99, 0x72, 0xc2, 0x89, 0x13, 0xb3, 0x7a, 0x02, 0x77, 0x0b, 0x37, 0x12, 0x4f,
+	0x83, 0x40, 0x6a, 0x57, 0xf0, 0x4f, 0x66, 0x26, 0x1d, 0xe4, 0x9c, 0xc9, 0xc6, 0x49, 0xec, 0xc1,
+	0x1c, 0xf9, 0x07, 0x3f, 0x69, 0x20, 0x5c, 0x4f, 0x3c, 0xbe, 0x85, 0x7f, 0xc7, 0xbb, 0x8e, 0x5d,
+	0xc4, 0xc0, 0xad, 0xfc, 0x93, 0xe5, 0x90, 0xd2, 0x09, 0xf6, 0x9d, 0x83, 0x94, 0x33, 0xbf, 0x34,
+	0x72, 0x2c, 0xdf, 0x39, 0xed, 0x3a, 0xe1, 0xdf, 0x39, 0x0d, 0x02, 0xa9, 0x5d, 0x31, 0x7f, 0x7d,
+	0x94, 0xab, 0x3b, 0x98, 0xa5, 0xe6, 0x73, 0x68, 0xe8, 0x8e, 0x55, 0xbb, 0xdb, 0x91, 0x0e, 0xcf,
+	0xb9, 0x1c, 0x03, 0xe6, 0x19, 0x06, 0x2e, 0x85, 0xe1, 0xff, 0x83, 0xc0, 0x8a, 0x9f, 0x45, 0xc5,
+	0xba, 0x23, 0xa3, 0x2b, 0xbe, 0xbd, 0x0f, 0x1d, 0x5d, 0x14, 0x1f, 0x87, 0x86, 0x22, 0xa2, 0x48,
+	0xb1, 0x83, 0x46, 0x1c, 0xa1, 0x1d, 0x10, 0xd2, 0xad, 0xa7, 0xf2, 0x12, 0x08, 0xb5, 0x0c, 0xa1,
+	0x6e, 0x43, 0x96, 0x40, 0x48, 0x83, 0xd2, 0x8b, 0xd9, 0x08, 0xe4, 0xa6, 0x17, 0x6a, 0x55, 0xd5,
+	0xfc, 0x6a, 0x71, 0xcd, 0x2a, 0xa1, 0x79, 0x09, 0x6d, 0x27, 0x90, 0x91, 0x12, 0x9f, 0xc8, 0x4b,
+	0x6d, 0x83, 0x62, 0x89, 0x94, 0x00, 0xec, 0xa7, 0x0f, 0x02, 0x39, 0xbe, 0x83, 0x86, 0x78, 0xb4,
+	0xc4, 0xd2, 0x70, 0x7f, 0x3a, 0x6a, 0x1e, 0x80, 0x91, 0x2f, 0x03, 0xfe, 0x3f, 0x08, 0xcc, 0xf8,
+	0x79, 0xaa, 0x44, 0x12, 0x1e, 0x21, 0x23, 0xfd, 0x4d, 0x5d, 0xe8, 0x0e, 0x22, 0x62, 0xbf, 0xf1,
+	0x5f, 0x10, 0xe2, 0xc7, 0x77, 0xd0, 0xb0, 0x2d, 0x9e, 0x54, 0xa3, 0xf9, 0x97, 0x9d, 0x7c, 0x2e,
+	0xb1, 0xd7, 0x86, 0xf8, 0x01, 0x12, 0x71, 0x96, 0x9d, 0x19, 0xfa, 0x2a, 0xda, 0x99, 0x51, 0x87,
+	0x33, 0x22, 0xb5, 0x44, 0x7e, 0x69, 0x2c, 0xff, 0x92, 0x51, 0x74, 0x4d, 0x92, 0x21, 0x0a, 0x8b,
+	0x7c, 0x50, 0x88, 0xe0, 0xf7, 0xa9, 0x32, 0xbb, 0xf1, 0xfe, 0x02, 0xc0, 0x26, 0x43, 0x1a, 0x47,
+	0xea, 0x6f, 0x09, 0xf2, 0x15, 0x51, 0x9a, 0xf9, 0xc5, 0x31, 0x84, 0x22, 0xd3, 0x08, 0x1a, 0x82,
+	0x59, 0x52, 0xe9, 0x27, 0x04, 0xf3, 0x75, 0x01, 0xe6, 0xcb, 0x4b, 0xfe, 0x82, 0x10, 0x37, 0x0d,
+	0x60, 0x92, 0xcc, 0x40, 0xc0, 0xe5, 0x28, 0xe7, 0x7b, 0xce, 0x3e, 0xa0, 0x3b, 0x07, 0x16, 0x4f,
+	0xc1, 0x39, 0x30, 0xc3, 0x19, 0x77, 0x20, 0x97, 0x33, 0xee, 0x13, 0xe8, 0x8c, 0xb0, 0xd5, 0x5f,
+	0x66, 0x8a, 0xf4, 0x60, 0x4f, 0xc4, 0x7b, 0x62, 0x2e, 0x5a, 0x15, 0x1d, 0x04, 0xf1, 0xba, 0xf8,
+	0x57, 0x0c, 0x1a, 0x59, 0x8b, 0x33, 0x68, 0xa5, 0xa1, 0xfc, 0x0e, 0xcb, 0xd1, 0xd7, 0x9f, 0x95,
+	0xfc, 0x1e, 0x67, 0xe4, 0x9f, 0x96, 0xa7, 0xaa, 0x2c, 0x3e, 0x26, 0x36, 0x3e, 0xec, 0x35, 0xfe,
+	0x4d, 0x2a, 0x23, 0x50, 0x94, 0x80, 0xc3, 0xf9, 0x3d, 0xa3, 0x94, 0x51, 0x24, 0x34, 0x7f, 0xa1,
+	0x3d, 0xfe, 0xf1, 0x6b, 0xfd, 0xd4, 0xee, 0xe3, 0xff, 0xd7, 0x40, 0x0f, 0xf3, 0xe8, 0x5f, 0x15,
+	0xe2, 0x09, 0xa7, 0x6e, 0x92, 0x12, 0xcb, 0xa4, 0x34, 0x72, 0x64, 0x8b, 0xe9, 0x47, 0x0e, 0xf6,
+	0xcb, 0x0f, 0x57, 0x7a, 0xc0, 0x0d, 0x3d, 0xf5, 0x80, 0x5a, 0x18, 0x34, 0xd5, 0xf4, 0xa0, 0xa5,
+	0xd1, 0xfc, 0x16, 0x06, 0x5a, 0x9e, 0x51, 0x2e, 0x60, 0xd0, 0x8a, 0x40, 0x27, 0x85, 0x3f, 0x88,
+	0x26, 0x3c, 0x55, 0x5d, 0xcb, 0xe2, 0x6f, 0xe5, 0x4d, 0xe3, 0x19, 0xd7, 0xfd, 0x72, 0xfa, 0x5a,
+	0x11, 0xe8, 0xe4, 0x66, 0xee, 0xa2, 0x09, 0x6d, 0xa1, 0x9f, 0xb4, 0x53, 0xc3, 0xa9, 0x2a, 0x62,
+	0x6f, 0xa2, 0xd1, 0x90, 0x59, 0xc1, 0x0f, 0x2a, 0x84, 0x22, 0xd6, 0x8f, 0x26, 0xef, 0x60, 0x54,
+	0xcb, 0x9a, 0x0c, 0x87, 0x0b, 0x29, 0x59, 0x72, 0x27, 0x81, 0xd0, 0xfc, 0x2d, 0xa1, 0xd5, 0x0d,
+	0xa3, 0x21, 0xbe, 0xec, 0x6d, 0x09, 0xcd, 0x7f, 0x6f, 0xf0, 0xfb, 0x8e, 0xb3, 0x56, 0xd4, 0x85,
+	0xa6, 0x65, 0x3b, 0x76, 0xab, 0xd3, 0x62, 0xa9, 0xa0, 0x8c, 0xfc, 0x49, 0xa8, 0x56, 0x23, 0x34,
+	0xa0, 0xe2, 0xc4, 0xf7, 0xd0, 0xa8, 0x64, 0x46, 0xa5, 0xa4, 0x68, 0xa9, 0x3f, 0xe6, 0x30, 0xe4,
+	0x7b, 0xc3, 0xab, 0x5d, 0x96, 0xf8, 0x10, 0xd1, 0x32, 0x2d, 0x84, 0x93, 0x6d, 0xa8, 0x9c, 0x4b,
+	0x86, 0xbe, 0x31, 0xf4, 0x04, 0xfa, 0x89, 0xf0, 0x37, 0x52, 0xc8, 0x5b, 0xc8, 0x12, 0xf2, 0x9a,
+	0xbf, 0x56, 0x40, 0xd3, 0xe2, 0xe9, 0x2b, 0xfc, 0x40, 0xa3, 0xe8, 0x68, 0x3c, 0xe4, 0xa0, 0x20,
+	0xc2, 0xd8, 0x59, 0x1e, 0x8f, 0x10, 0x04, 0x84, 0x06, 0x68, 0x65, 0x5c, 0x50, 0x7d, 0xc3, 0xbd,
+	0x4b, 0x9c, 0xe8, 0x94, 0x52, 0x03, 0xb4, 0x2e, 0xa6, 0x55, 0x80, 0xf4, 0x76, 0x78, 0x87, 0x86,
+	0x7b, 0xd9, 0x8d, 0x63, 0x1b, 0xc8, 0x65, 0x39, 0x7e, 0x81, 0xc7, 0x74, 0x89, 0x63, 0x83, 0x14,
+	0x0a, 0xf4, 0x22, 0xa7, 0x9c, 0x64, 0x3b, 0x20, 0x75, 0x3e, 0x44, 0x69, 0x37, 0xc5, 0x23, 0x9e,
+	0xe8, 0x20, 0x88, 0xd7, 0x35, 0x3f, 0x3a, 0x84, 0x2e, 0xe9, 0x93, 0x48, 0x77, 0xa8, 0x8c, 0x0a,
+	0xf8, 0xa4, 0x0c, 0xd9, 0xc1, 0x27, 0xf2, 0xd1, 0x78, 0xc8, 0x8e, 0x92, 0x6a, 0x97, 0x27, 0x1a,
+	0x69, 0xe1, 0x3b, 0xbe, 0x0a, 0x21, 0xfe, 0x32, 0x62, 0xba, 0x15, 0x4f, 0x34, 0x94, 0xe1, 0xc7,
+	0x0d, 0x34, 0xa3, 0x17, 0x2f, 0xd9, 0x8e, 0xed, 0x6f, 0x8b, 0x4c, 0xec, 0x47, 0x8f, 0x18, 0x72,
+	0xe5, 0x60, 0xbf, 0x3c, 0xb3, 0x92, 0x89, 0x11, 0xba, 0x50, 0x63, 0x71, 0x34, 0x62, 0xf3, 0xa2,
+	0xe5, 0x85, 0x1f, 0xcc, 0x17, 0x47, 0x63, 0x25, 0x1b, 0x25, 0x74, 0xa3, 0xd7, 0x2d, 0xdb, 0xcc,
+	0xd0, 0x4b, 0x25, 0xdb, 0x0c, 0x8d, 0x39, 0xc0, 0x4c, 0x13, 0x5f, 0x1e, 0x31, 0x07, 0x58, 0x57,
+	0x4f, 0x36, 0xe6, 0x00, 0x27, 0xd1, 0xdd, 0x68, 0xfd, 0xdd, 0xe8, 0x02, 0xab, 0x36, 0x57, 0x67,
+	0x82, 0x3e, 0x9f, 0xd4, 0xe7, 0xea, 0x75, 0xf6, 0xbc, 0x3e, 0x5c, 0x47, 0x27, 0x42, 0x27, 0x17,
+	0xd2, 0x43, 0x27, 0x9b, 0xff, 0xbc, 0x88, 0xce, 0x32, 0xdc, 0xca, 0x11, 0x83, 0x77, 0xd0, 0x88,
+	0x4c, 0xa1, 0x24, 0xbe, 0xcd, 0x4a, 0xee, 0xa1, 0xa5, 0x1c, 0x5d, 0xfc, 0xc5, 0x28, 0x7f, 0xc1,
+	0x88, 0xa7, 0x24, 0x7e, 0x3b, 0x4b, 0xc2, 0x6c, 0x63, 0x73, 0x01, 0x7d, 0xb5, 0xf7, 0x93, 0xcf,
+	0x72, 0x31, 0x86, 0x6b, 0x7e, 0x9a, 0x25, 0x00, 0x8e, 0x95, 0x42, 0x82, 0x26, 0xdb, 0x4a, 0xb5,
+	0x34, 0xee, 0x5a, 0x3e, 0x41, 0xf3, 0x65, 0xc6, 0x48, 0xc3, 0x18, 0x6d, 0xa5, 0x54, 0xb0, 0x0f,
+	0x19, 0x1d, 0x31, 0xff, 0x60, 0x10, 0x95, 0xb2, 0x66, 0x98, 0x46, 0x00, 0x56, 0xdb, 0xd1, 0x50,
+	0xa8, 0xae, 0x27, 0xb3, 0x53, 0xe7, 0x96, 0x5d, 0x55, 0xe6, 0xc2, 0x4f, 0x38, 0x13, 0xeb, 0xb1,
+	0x42, 0x01, 0x32, 0x28, 0xe3, 0xf7, 0x69, 0xe9, 0xf4, 0x8a, 0xf9, 0xb3, 0x3a, 0xf2, 0x3d, 0x13,
+	0x25, 0xcd, 0x93, 0x9d, 0xea, 0x96, 0x4c, 0xef, 0x23, 0x89, 0xa4, 0x82, 0x7d, 0x78, 0x2f, 0xa5,
+	0x06, 0xf7, 0xed, 0x21, 0xa1, 0x20, 0xcd, 0xe8, 0xe7, 0xc7, 0xd9, 0x00, 0x71, 0x51, 0xac, 0xe6,
+	0x63, 0x18, 0x33, 0x78, 0x0a, 0x2e, 0x62, 0x49, 0x82, 0x93, 0xe4, 0x33, 0xd2, 0x0c, 0x0e, 0xe5,
+	0xef, 0x54, 0x32, 0x8f, 0xa0, 0xd6, 0xa9, 0x9e, 0xd2, 0x0c, 0x7e, 0xb8, 0x80, 0x2e, 0xb2, 0xcf,
+	0x4c, 0xa5, 0x44, 0xdc, 0x52, 0xee, 0xef, 0x5d, 0x10, 0x65, 0x1a, 0x7e, 0x84, 0xcd, 0xc1, 0xcb,
+	0x24, 0xfc, 0x08, 0xeb, 0x6b, 0x86, 0xc3, 0xc5, 0x6f, 0x50, 0x0f, 0x3e, 0x0a, 0x57, 0x53, 0x2e,
+	0xf5, 0xe4, 0x82, 0x7d, 0x6a, 0xbe, 0x00, 0xaf, 0x42, 0xc3, 0x3b, 0x42, 0x4a, 0x59, 0x8c, 0x02,
+	0x86, 0x4a, 0xd9, 0xa4, 0x84, 0x99, 0xb7, 0xd1, 0x84, 0xe6, 0x6f, 0xa1, 0xe4, 0x6a, 0x49, 0xcb,
+	0x32, 0xa3, 0xa6, 0x62, 0x29, 0x74, 0x4b, 0x22, 0x13, 0x2d, 0xf9, 0xe4, 0xc9, 0xf6, 0xf7, 0x67,
+	0xc9, 0x63, 0xb1, 0xe4, 0xa5, 0xce, 0x8d, 0x65, 0x80, 0xf1, 0xfb, 0xd1, 0xb9, 0xb1, 0x54, 0x32,
+	0x22, 0x63, 0x13, 0xff, 0x1f, 0x04, 0x56, 0xfc, 0x94, 0x9e, 0x8f, 0x69, 0x2d, 0x7a, 0x08, 0x4f,
+	0xc7, 0xb3, 0x28, 0xb1, 0x25, 0x99, 0xa8, 0x8d, 0x81, 0x6b, 0xed, 0xf8, 0x5d, 0xf6, 0x96, 0x5c,
+	0x21, 0x51, 0xd7, 0xaa, 0xf3, 0xc3, 0x9a, 0xb6, 0x4e, 0x57, 0x4f, 0x0c, 0x9c, 0x86, 0x7a, 0xc2,
+	0x43, 0x63, 0x4a, 0xb4, 0xd0, 0xd2, 0x60, 0x7e, 0x76, 0x56, 0x0d, 0x06, 0xca, 0x64, 0x26, 0x4a,
+	0x01, 0xa8, 0x44, 0xb0, 0xa7, 0x65, 0x95, 0x1b, 0xca, 0xcf, 0x95, 0x44, 0x7a, 0x84, 0x68, 0x9c,
+	0x19, 0x19, 0xe5, 0x1c, 0x84, 0x9c, 0x30, 0xd5, 0x52, 0x3f, 0x5a, 0xbc, 0x28, 0x61, 0x13, 0x67,
+	0x3a, 0xa2, 0xdf, 0xa0, 0x50, 0xa0, 0xf3, 0xaa, 0xc4, 0x87, 0x2d, 0x8d, 0xe4, 0x9f, 0x57, 0x25,
+	0xf4, 0xac, 0x90, 0x45, 0x45, 0x05, 0xa0, 0x12, 0xa1, 0x63, 0x6c, 0x85, 0xf9, 0x36, 0x4b, 0xa3,
+	0xf9, 0xc7, 0x18, 0x65, 0xed, 0xe4, 0x63, 0x8c, 0x7e, 0x83, 0x42, 0x81, 0x6a, 0x2c, 0x43, 0x65,
+	0x2f, 0xca, 0x2f, 0xd1, 0xeb, 0x49, 0xd1, 0xfb, 0xa6, 0x48, 0xb0, 0x35, 0xc6, 0xf6, 0xe9, 0x03,
+	0x8a, 0x50, 0x2b, 0x11, 0x69, 0x40, 0xd6, 0x55, 0xbc, 0xbc, 0xc6, 0xbb, 0x7a, 0x79, 0x55, 0xd0,
+	0x14, 0x77, 0x1a, 0x15, 0xae, 0xd8, 0xec, 0x40, 0x98, 0x88, 0x34, 0x56, 0xd5, 0x38, 0x10, 0x92,
+	0xf5, 0xf9, 0x81, 0x4f, 0xea, 0xac, 0xed, 0xa4, 0x7a, 0xe0, 0xf3, 0x32, 0x08, 0xa1, 0x78, 0x07,
+	0x8d, 0xfb, 0x8a, 0x3f, 0x4d, 0xe9, 0x4c, 0xbf, 0xfa, 0x5e, 0x8e, 0x47, 0x44, 0x44, 0x50, 0x4a,
+	0x40, 0xa3, 0xa3, 0x2b, 0x23, 0xcf, 0x9e, 0xae, 0x32, 0x92, 0xa6, 0x36, 0x54, 0x4d, 0xe5, 0xa7,
+	0x8e, 0x25, 0xee, 0xe5, 0xa1, 0xa6, 0xf4, 0xf4, 0xd3, 0x92, 0xdd, 0xb6, 0xeb, 0xd3, 0x50, 0x8f,
+	0xd4, 0x38, 0x97, 0x7d, 0x1e, 0x1c, 0x7d, 0xda, 0xc5, 0x38, 0x10, 0x92, 0xf5, 0xd9, 0xfb, 0xd4,
+	0xdf, 0xf3, 0x03, 0xd2, 0xa2, 0xd7, 0x96, 0xeb, 0x10, 0x6a, 0x72, 0x70, 0x2e, 0xff, 0xfb, 0xb4,
+	0x1a, 0xc3, 0xc5, 0xaf, 0x9d, 0x78, 0x29, 0x24, 0x68, 0xd2, 0x95, 0xa3, 0x7a, 0xdd, 0x95, 0xa6,
+	0xf3, 0xaf, 0x1c, 0xd5, 0x7f, 0x8d, 0xaf, 0x1c, 0xb5, 0x04, 0x34, 0x3a, 0xd4, 0xff, 0x4a, 0xd8,
+	0x6a, 0x11, 0x8f, 0xcd, 0xe0, 0xf9, 0x28, 0x21, 0x4b, 0x55, 0x05, 0x80, 0x5e, 0x0f, 0x7f, 0x08,
+	0x8d, 0xab, 0x77, 0x67, 0xe9, 0xc2, 0x71, 0xe7, 0x97, 0xe4, 0x3d, 0x57, 0x41, 0x1a, 0x41, 0x0c,
+	0xe8, 0x82, 0xe2, 0xcc, 0xac, 0xee, 0xef, 0x8b, 0x6c, 0x08, 0xfc, 0x3d, 0x9b, 0x5a, 0x03, 0x32,
+	0x5a, 0xe2, 0x1f, 0x4e, 0xb7, 0x6d, 0x28, 0x5d, 0x2d, 0xe6, 0xcd, 0x6a, 0x9b, 0x30, 0x60, 0xb8,
+	0x6d, 0x07, 0xdb, 0xb7, 0xd8, 0xa3, 0xc8, 0x3f, 0xaa, 0x99, 0x83, 0xf9, 0x3b, 0x54, 0x0d, 0x22,
+	0xa5, 0x4b, 0xa7, 0xa1, 0xd7, 0xa9, 0x6b, 0x02, 0xb7, 0xf9, 0xbe, 0xa4, 0x61, 0x99, 0xe9, 0x83,
+	0xcd, 0x2f, 0x19, 0x68, 0x32, 0xaa, 0x76, 0x0a, 0x4f, 0xa3, 0x9a, 0xfe, 0x34, 0x7a, 0x47, 0x7f,
+	0xe3, 0xca, 0x78, 0x1f, 0xfd, 0xaf, 0x82, 0x3a, 0x2a, 0xc6, 0xfd, 0xee, 0x68, 0x76, 0x1a, 0x94,
+	0xf4, 0x8d, 0x7e, 0xec, 0x34, 0xd4, 0xf0, 0x48, 0xd1, 0x78, 0x53, 0xec, 0x36, 0x3e, 0xa8, 0xf1,
+	0x9f, 0x7d, 0x84, 0x12, 0x0c, 0x99, 0x4d, 0x49, 0x9a, 0x4f, 0xc0, 0x61, 0xcc, 0xe8, 0x0b, 0xea,
+	0xf5, 0xd4, 0x47, 0xca, 0x5f, 0x6d, 0xc0, 0xdd, 0x2d, 0x64, 0x7e, 0xe1, 0x1c, 0x1a, 0x53, 0x04,
+	0xb1, 0x31, 0xab, 0x13, 0xe3, 0x34, 0xac, 0x4e, 0x02, 0x96, 0x84, 0xce, 0x0f, 0x3c, 0x6e, 0xc8,
+	0x56, 0x38, 0x0e, 0x9a, 0xe1, 0xb5, 0x58, 0x89, 0x30, 0x83, 0x4a, 0x86, 0x32, 0x6f, 0xe1, 0x1a,
+	0x2b, 0x1e, 0x83, 0x2d, 0x50, 0xb7, 0x75, 0xf5, 0x46, 0x84, 0x24, 0xff, 0x4f, 0xea, 0x22, 0x95,
+	0x62, 0x68, 0xe7, 0xbe, 0xec, 0xdf, 0x08, 0x61, 0xa0, 0xd4, 0x4b, 0x5a, 0x31, 0x0c, 0x9e, 0x9e,
+	0x15, 0xc3, 0x0b, 0x08, 0xd1, 0x82, 0x45, 0xcf, 0x73, 0xbd, 0xbe, 0x6c, 0x0b, 0x57, 0x24, 0x96,
+	0x68, 0x19, 0x84, 0x45, 0x3e, 0x28, 0x44, 0x32, 0x8c, 0x8f, 0x86, 0x73, 0x19, 0x1f, 0x75, 0xd0,
+	0x39, 0x8f, 0x04, 0xde, 0x5e, 0x85, 0x66, 0x40, 0xa8, 0x06, 0x96, 0x17, 0xb0, 0x17, 0xfc, 0x48,
+	0xbe, 0x58, 0x7e, 0x90, 0x44, 0x05, 0x69, 0xf8, 0x35, 0x06, 0x78, 0xb4, 0x2b, 0x03, 0xfc, 0x26,
+	0x34, 0x16, 0x90, 0xda, 0xb6, 0x63, 0xd7, 0xac, 0xe6, 0xf2, 0x82, 0xc8, 0xd1, 0x16, 0xf1, 0x72,
+	0x11, 0x08, 0xd4, 0x7a, 0x78, 0x1e, 0x15, 0x3b, 0x76, 0x5d, 0xbc, 0x00, 0xbe, 0x36, 0x54, 0x69,
+	0x2c, 0x2f, 0xdc, 0xdf, 0x2f, 0xbf, 0x32, 0xb2, 0xe6, 0x09, 0x47, 0x75, 0xad, 0x7d, 0xb7, 0x71,
+	0x8d, 0xe5, 0xbe, 0x9c, 0xdd, 0x5c, 0x5e, 0x00, 0xda, 0x38, 0xcd, 0x30, 0x6b, 0xfc, 0x08, 0x86,
+	0x59, 0x9f, 0x34, 0xd0, 0x39, 0x2b, 0xae, 0x8d, 0x21, 0x32, 0xa9, 0xc4, 0x3b, 0x73, 0x5f, 0x11,
+	0x09, 0x0d, 0xcf, 0xfc, 0x03, 0x62, 0x7c, 0xe7, 0xe6, 0x92, 0xe4, 0x20, 0xad, 0x0f, 0x54, 0x6e,
+	0xd3, 0xb2, 0x1b, 0x7c, 0x0d, 0x44, 0x5f, 0x7d, 0x32, 0x9f, 0xdc, 0x66, 0x35, 0x81, 0x09, 0x52,
+	0xb0, 0xe3, 0x7b, 0x68, 0x4c, 0x61, 0x92, 0x4a, 0x67, 0xfa, 0xe0, 0x89, 0x63, 0x2a, 0x0d, 0x91,
+	0x88, 0x33, 0x2a, 0x00, 0x95, 0x52, 0xa8, 0x11, 0x56, 0xc4, 0x0c, 0x42, 0x2b, 0xca, 0x46, 0x7d,
+	0x36, 0xbf, 0x46, 0x38, 0x1d, 0x23, 0x74, 0xa1, 0xc6, 0xc2, 0xba, 0x53, 0xb0, 0xf2, 0x36, 0x2f,
+	0x4d, 0xe5, 0x0f, 0x2c, 0xb5, 0xa2, 0xa3, 0xe2, 0x4b, 0x33, 0x56, 0x08, 0x71, 0x82, 0x78, 0x09,
+	0x61, 0xa1, 0xcf, 0x8a, 0x1e, 0x67, 0x7e, 0x09, 0x33, 0x63, 0x05, 0xf6, 0x49, 0x17, 0x13, 0x50,
+	0x48, 0x69, 0x81, 0x03, 0x4d, 0x56, 0xd2, 0xc7, 0x2b, 0x27, 0x9e, 0xdc, 0xba, 0xab, 0xc4, 0xe4,
+	0x5b, 0x0c, 0x34, 0x69, 0xab, 0x79, 0xe7, 0x7c, 0xf1, 0xb8, 0xb9, 0x91, 0xcf, 0x36, 0x59, 0xc5,
+	0x24, 0xc8, 0x33, 0x59, 0xb0, 0x0e, 0x81, 0x18, 0x4d, 0xfc, 0xe3, 0x06, 0xba, 0xc8, 0x93, 0xe0,
+	0x71, 0x0d, 0xf6, 0xba, 0xeb, 0x36, 0x85, 0x12, 0xbb, 0x74, 0x3e, 0xbf, 0xe2, 0x6a, 0x35, 0x1d,
+	0x25, 0x13, 0x63, 0x5c, 0xcc, 0x00, 0x42, 0x56, 0x47, 0xa8, 0xe2, 0xe6, 0x5c, 0xa7, 0x5d, 0x73,
+	0x5b, 0xb6, 0xd3, 0x50, 0x97, 0xdc, 0x85, 0xfc, 0xe9, 0x7a, 0x37, 0x93, 0xe8, 0xf8, 0xe9, 0x9f,
+	0x02, 0x80, 0x34, 0xe2, 0xb8, 0x89, 0xce, 0xc6, 0xf3, 0x9c, 0x94, 0x2e, 0x1e, 0x79, 0x17, 0xb2,
+	0x87, 0x70, 0x3c, 0x8d, 0x0a, 0x24, 0x30, 0x9b, 0xbf, 0x6d, 0x08, 0x69, 0xfc, 0x29, 0x9a, 0xaf,
+	0x9d, 0xb4, 0x5d, 0x81, 0x79, 0x1b, 0x95, 0xaa, 0x32, 0x2f, 0x45, 0x3d, 0x96, 0x32, 0xf3, 0xed,
+	0x2c, 0xd3, 0xc0, 0x96, 0xdd, 0x58, 0xb5, 0xda, 0x6b, 0x91, 0xea, 0x44, 0x4d, 0x0c, 0x10, 0x01,
+	0x41, 0xaf, 0x6b, 0x7e, 0xd9, 0x40, 0x17, 0x75, 0xcc, 0xae, 0x67, 0xbf, 0xd8, 0x3f, 0x62, 0xfc,
+	0x31, 0x03, 0x8d, 0xdd, 0x0d, 0x15, 0x75, 0x92, 0x7b, 0x7d, 0x57, 0xce, 0x8c, 0x52, 0xac, 0x57,
+	0xc4, 0x53, 0x34, 0x7f, 0xe1, 0x33, 0x3f, 0x64, 0x0e, 0x22, 0xa0, 0x0f, 0x2a, 0x69, 0xf3, 0xbf,
+	0x18, 0x28, 0x21, 0x41, 0xa1, 0x9e, 0x0e, 0x94, 0x08, 0x4d, 0xe1, 0x6d, 0xe4, 0xf7, 0x74, 0xa8,
+	0x70, 0x14, 0x5c, 0x2f, 0x24, 0x7e, 0x80, 0x44, 0x4c, 0x65, 0x32, 0x8e, 0x92, 0x14, 0x5d, 0x2c,
+	0x8f, 0xa7, 0xf2, 0xe5, 0x97, 0x8a, 0xf0, 0x70, 0xc9, 0x86, 0x5a, 0x02, 0x1a, 0x1d, 0x73, 0x05,
+	0xa1, 0x48, 0xea, 0xd5, 0xb7, 0x39, 0xe8, 0x17, 0x8a, 0x28, 0x6d, 0xaf, 0x53, 0x23, 0xca, 0x7b,
+	0xb6, 0x53, 0x77, 0xef, 0xb1, 0x3b, 0x3f, 0x87, 0xe6, 0x29, 0xfc, 0x72, 0xb7, 0x23, 0x34, 0xa0,
+	0xe2, 0xa4, 0x51, 0xc9, 0xdc, 0x76, 0x28, 0x18, 0x2c, 0x44, 0x51, 0xc9, 0x42, 0x86, 0xdc, 0x07,
+	0xa5, 0x06, 0xd5, 0xde, 0x6c, 0x59, 0x76, 0x93, 0xd4, 0x23, 0xb8, 0xc8, 0x6b, 0xc8, 0x4e, 0x8f,
+	0xa5, 0x18, 0x0c, 0x12, 0xb5, 0xf1, 0x0b, 0x2c, 0x38, 0x22, 0xf1, 0x3c, 0x4b, 0x7a, 0x64, 0x5f,
+	0xef, 0x53, 0x36, 0xbf, 0x20, 0xd0, 0x71, 0x96, 0x57, 0xfe, 0x82, 0x90, 0x0c, 0x7e, 0x1e, 0x4d,
+	0xd2, 0x43, 0x8c, 0xdf, 0x33, 0x39, 0xcd, 0xc4, 0xc2, 0x70, 0x7d, 0x2b, 0x1a, 0x26, 0x88, 0x61,
+	0x66, 0x92, 0x12, 0xa9, 0xbe, 0x14, 0x57, 0xc6, 0x7b, 0xd0, 0xa8, 0x1f, 0xb2, 0x84, 0x47, 0xff,
+	0x88, 0x51, 0x98, 0xc5, 0x90, 0x1b, 0x8c, 0xf0, 0xe1, 0x3a, 0x1a, 0xbc, 0x67, 0xed, 0x84, 0xbe,
+	0xd2, 0xb9, 0xf4, 0x1c, 0x4a, 0x64, 0xe1, 0x48, 0x52, 0x42, 0x7f, 0xf9, 0xc0, 0x91, 0x9b, 0xbf,
+	0x32, 0x8d, 0xce, 0xf7, 0xed, 0xde, 0xfd, 0x11, 0x03, 0x5d, 0x20, 0x3b, 0x76, 0x2d, 0x98, 0xdb,
+	0x0a, 0x88, 0x77, 0xeb, 0xd6, 0xea, 0xc6, 0xb6, 0x47, 0xfc, 0x6d, 0xb7, 0x59, 0xcf, 0x19, 0x85,
+	0x98, 0xc9, 0x0f, 0x17, 0x53, 0x31, 0x42, 0x06, 0x25, 0x26, 0x93, 0xde, 0xe1, 0xd2, 0x3a, 0xa0,
+	0x82, 0x91, 0x8e, 0xe7, 0x07, 0x22, 0xfa, 0x32, 0x97, 0x49, 0xc7, 0x81, 0x90, 0xac, 0x1f, 0x47,
+	0xc2, 0x22, 0xae, 0xb3, 0xc5, 0x6c, 0x24, 0x91, 0x30, 0x20, 0x24, 0xeb, 0xab, 0x48, 0xf8, 0x59,
+	0xe2, 0xd4, 0xf8, 0xc2, 0x8c, 0x21, 0x09, 0x81, 0x90, 0xac, 0x8f, 0xeb, 0xe8, 0xb2, 0x47, 0x6a,
+	0x6e, 0xab, 0x45, 0x9c, 0x3a, 0x9b, 0x94, 0x55, 0xcb, 0x6b, 0xd8, 0xce, 0x92, 0x67, 0xb1, 0x8a,
+	0x4c, 0xc5, 0x67, 0xcc, 0x5f, 0x3d, 0xd8, 0x2f, 0x5f, 0x86, 0x2e, 0xf5, 0xa0, 0x2b, 0x16, 0xdc,
+	0x42, 0x67, 0x3a, 0x6c, 0x89, 0x7b, 0x2c, 0xda, 0xe9, 0x8e, 0xd5, 0x2c, 0x0d, 0xe7, 0xfa, 0x62,
+	0x8c, 0x9b, 0xde, 0xd4, 0x51, 0x41, 0x1c, 0x37, 0xde, 0x43, 0xe7, 0xc2, 0xee, 0x28, 0x24, 0x47,
+	0x72, 0x91, 0x14, 0xef, 0xe8, 0x04, 0x3a, 0x48, 0xa3, 0x41, 0x73, 0x83, 0x04, 0x96, 0xd7, 0x20,
+	0x41, 0x65, 0x7d, 0x73, 0x9d, 0x78, 0x35, 0xe2, 0x04, 0x76, 0x93, 0x3f, 0xa9, 0x0d, 0x8e, 0x6a,
+	0x23, 0x09, 0x86, 0xb4, 0x36, 0xf8, 0x43, 0xe8, 0x55, 0xfa, 0xa4, 0xae, 0xb8, 0xf7, 0x88, 0x37,
+	0xef, 0x76, 0x9c, 0xba, 0x8e, 0x1c, 0x31, 0xe4, 0x8f, 0x1e, 0xec, 0x97, 0x5f, 0x05, 0xbd, 0x34,
+	0x80, 0xde, 0xf0, 0x26, 0x3b, 0xb0, 0xd9, 0x6e, 0xa7, 0x76, 0x60, 0x2c, 0xab, 0x03, 0x19, 0x0d,
+	0xa0, 0x37, 0xbc, 0x54, 0xfe, 0xcf, 0x27, 0x66, 0x95, 0xb4, 0x5c, 0x6f, 0x4f, 0xa1, 0x38, 0xce,
+	0x28, 0xb2, 0xfd, 0xbb, 0x91, 0x5a, 0x03, 0x32, 0x5a, 0x52, 0xae, 0xe7, 0x91, 0xac, 0xe1, 0x27,
+	0xc8, 0x4c, 0x30, 0x32, 0xaf, 0x3d, 0xd8, 0x2f, 0x3f, 0x02, 0x3d, 0xb6, 0x81, 0x9e, 0xb1, 0xa7,
+	0x74, 0x25, 0x9a, 0x88, 0x44, 0x57, 0x26, 0xb3, 0xba, 0x92, 0xdd, 0x06, 0x7a, 0xc6, 0x8e, 0xbf,
+	0xc3, 0x40, 0x97, 0x6a, 0xed, 0xce, 0x0d, 0xdb, 0x0f, 0xdc, 0x86, 0x67, 0xb5, 0x16, 0x48, 0xcd,
+	0xda, 0xbb, 0x61, 0x35, 0xb7, 0x68, 0xb6, 0x9a, 0xd2, 0x99, 0x5c, 0x1b, 0x87, 0x85, 0x32, 0xa8,
+	0xac, 0x6f, 0xa6, 0x23, 0x85, 0x6c, 0x7a, 0xf8, 0xfb, 0x0d, 0x74, 0xb9, 0xc5, 0xba, 0x98, 0xd1,
+	0xa1, 0xb3, 0xb9, 0x3a, 0xc4, 0x4e, 0xb1, 0xd5, 0x2e, 0x78, 0xa1, 0x2b, 0x55, 0x36, 0x49, 0xbc,
+	0xc2, 0x5c, 0xa3, 0xe1, 0x91, 0x06, 0xc3, 0x1a, 0x9e, 0x2e, 0x53, 0xf9, 0x27, 0x69, 0x35, 0x0b,
+	0x29, 0x64, 0xd3, 0xc3, 0xcf, 0xa3, 0x2b, 0x99, 0xc0, 0x0a, 0x35, 0x13, 0x64, 0x9a, 0xd2, 0xe2,
+	0xbc, 0x79, 0xb0, 0x5f, 0xbe, 0xb2, 0xda, 0xb5, 0x26, 0x1c, 0x82, 0x89, 0x45, 0x73, 0xd2, 0x02,
+	0xab, 0x9c, 0x63, 0xcc, 0xc2, 0x7b, 0xf2, 0x30, 0x0b, 0xc7, 0x19, 0x4d, 0xe5, 0x4b, 0x7a, 0x34,
+	0x95, 0x69, 0xd6, 0xab, 0x77, 0x1f, 0x5f, 0xaf, 0xbe, 0x2a, 0x21, 0x54, 0xb4, 0x3b, 0x99, 0x78,
+	0xfc, 0xb6, 0xe3, 0x82, 0x04, 0xfe, 0x5d, 0xcf, 0xb3, 0xef, 0xaa, 0xdf, 0xc9, 0x29, 0xf5, 0xa0,
+	0x2b, 0x96, 0x97, 0x5d, 0xa0, 0x96, 0x4f, 0x1a, 0x48, 0xf8, 0xdd, 0x53, 0xe3, 0x3d, 0xc5, 0x02,
+	0x71, 0x24, 0x66, 0x7d, 0x78, 0x59, 0xc4, 0x2a, 0x2e, 0x44, 0x50, 0x2d, 0x72, 0x77, 0x94, 0x30,
+	0x63, 0x34, 0x12, 0x1a, 0x70, 0xcc, 0x51, 0xc6, 0x0c, 0x9a, 0xf4, 0x3b, 0x94, 0xaf, 0x09, 0xbd,
+	0x07, 0x4b, 0x54, 0x1c, 0x09, 0xe2, 0x22, 0x38, 0xcd, 0x64, 0x22, 0x30, 0x50, 0x4a, 0xf8, 0x21,
+	0x19, 0x64, 0x8c, 0x77, 0x30, 0x64, 0x89, 0xd5, 0x40, 0x63, 0x87, 0x3b, 0x71, 0x51, 0x5f, 0xad,
+	0x8e, 0xcf, 0xfc, 0x6f, 0xb9, 0xe3, 0x15, 0xb3, 0x86, 0xdb, 0x64, 0x25, 0x20, 0x20, 0x78, 0x13,
+	0x0d, 0xb7, 0x6c, 0x87, 0xf9, 0xc8, 0x0d, 0xe4, 0xf2, 0x91, 0x63, 0xef, 0xe2, 0x55, 0x8e, 0x02,
+	0x24, 0x2e, 0xf3, 0xe7, 0x0d, 0x74, 0x46, 0xcf, 0x60, 0xc2, 0x42, 0x95, 0x89, 0x40, 0xf1, 0x22,
+	0xd5, 0x19, 0x6b, 0x2a, 0xe2, 0x53, 0x83, 0x84, 0xe9, 0x86, 0x2a, 0x7d, 0x28, 0x22, 0xd3, 0x13,
+	0xa9, 0x1c, 0xa2, 0x13, 0xfc, 0xc1, 0x73, 0x68, 0x88, 0x2f, 0x72, 0xfa, 0x5a, 0x48, 0x09, 0x5a,
+	0x78, 0x33, 0x7f, 0xaa, 0xce, 0x3c, 0x91, 0xcc, 0x1e, 0xa1, 0xae, 0xe0, 0xf3, 0x1d, 0xa7, 0x1e,
+	0x65, 0xf8, 0x67, 0x8e, 0xdb, 0x73, 0xbc, 0x0c, 0x42, 0x28, 0x35, 0x4a, 0xac, 0x79, 0x76, 0x3f,
+	0x46, 0x89, 0x15, 0x58, 0xe6, 0x46, 0x89, 0x15, 0x58, 0x06, 0x8a, 0x8c, 0x4a, 0x83, 0x15, 0x6b,
+	0xbd, 0x81, 0xfc, 0xd2, 0x60, 0x3e, 0x01, 0x8a, 0xcd, 0xde, 0x64, 0x57, 0x7b, 0x3d, 0x99, 0x15,
+	0x69, 0x30, 0xbf, 0x53, 0xa5, 0x98, 0xf2, 0x5e, 0xb2, 0x22, 0xc9, 0x8d, 0x34, 0x94, 0xb9, 0x91,
+	0xb6, 0xd0, 0xb0, 0xd8, 0x0a, 0xa5, 0xe1, 0xfc, 0x92, 0x24, 0x61, 0x04, 0x1d, 0x3d, 0x50, 0x45,
+	0x01, 0x48, 0xe4, 0xf4, 0x2d, 0xdb, 0xb2, 0x76, 0xa9, 0x83, 0x29, 0x7b, 0x6b, 0x0c, 0xaa, 0x55,
+	0x59, 0x31, 0x48, 0x38, 0xab, 0xca, 0x7d, 0x51, 0x4b, 0xa3, 0xb1, 0xaa, 0xbc, 0x18, 0x24, 0x1c,
+	0x3f, 0x8b, 0x46, 0x5a, 0xd6, 0x6e, 0xb5, 0xe3, 0x85, 0x1e, 0xd9, 0xd9, 0xc2, 0xd1, 0x4e, 0x60,
+	0x37, 0x67, 0x6d, 0x27, 0xf0, 0x03, 0x6f, 0x76, 0xd9, 0x09, 0x6e, 0x79, 0xd5, 0x80, 0xd9, 0x02,
+	0xb2, 0x55, 0xb7, 0x2a, 0xb0, 0x40, 0x88, 0x0f, 0x37, 0xd1, 0x64, 0xcb, 0xda, 0xdd, 0x74, 0x2c,
+	0x9e, 0xf0, 0x4a, 0xf0, 0xf2, 0x79, 0x28, 0x30, 0x01, 0xfd, 0xaa, 0x86, 0x0b, 0x62, 0xb8, 0x53,
+	0xec, 0xc2, 0xc7, 0x4f, 0xca, 0x2e, 0x7c, 0x2e, 0x8c, 0x2e, 0xc3, 0xb5, 0x7b, 0x97, 0x52, 0xe3,
+	0xba, 0x76, 0x8d, 0x1c, 0xf3, 0x5c, 0x18, 0x39, 0x66, 0x32, 0xbf, 0x21, 0x73, 0x97, 0xa8, 0x31,
+	0x1d, 0x34, 0x56, 0xb7, 0x02, 0x8b, 0x97, 0x52, 0xf5, 0x5b, 0x6e, 0x43, 0x95, 0x85, 0x10, 0x4d,
+	0x74, 0x24, 0x45, 0x65, 0x3e, 0xa8, 0x74, 0xa8, 0x77, 0x2f, 0xdd, 0xac, 0x4d, 0x12, 0x44, 0x55,
+	0x98, 0xec, 0xf8, 0x2c, 0xdb, 0x3f, 0xcc, 0xbb, 0xf7, 0x66, 0x5a, 0x05, 0x48, 0x6f, 0x17, 0x85,
+	0xd9, 0x9f, 0x4a, 0x0f, 0xb3, 0x4f, 0x33, 0xed, 0x26, 0x2d, 0xf0, 0xf0, 0x55, 0x23, 0xef, 0xcd,
+	0xc0, 0xcf, 0x86, 0xdc, 0x76, 0x78, 0xbf, 0x60, 0xa0, 0x92, 0x58, 0x65, 0xc2, 0x6a, 0xae, 0x49,
+	0xbc, 0x55, 0xcb, 0xb1, 0x1a, 0xc4, 0x2b, 0x9d, 0xcb, 0x1f, 0x0c, 0x6c, 0x35, 0x03, 0x67, 0x18,
+	0xd2, 0xe7, 0xe1, 0x83, 0xfd, 0xf2, 0xd5, 0xc3, 0x6a, 0x41, 0x66, 0xdf, 0xb0, 0x87, 0x86, 0xfd,
+	0x3d, 0xbf, 0x16, 0x34, 0x7d, 0xc1, 0xe9, 0x5e, 0xef, 0xe3, 0x64, 0xad, 0x72, 0x4c, 0xfc, 0x68,
+	0x0d, 0x0f, 0x1f, 0x51, 0x0a, 0x92, 0x10, 0x0d, 0x05, 0x34, 0x25, 0xf4, 0xe8, 0x4a, 0xc8, 0xb4,
+	0xf3, 0xf9, 0xfd, 0x0b, 0x2b, 0x71, 0x64, 0xd2, 0x52, 0x8e, 0xc9, 0xac, 0x12, 0x50, 0x48, 0x52,
+	0xa7, 0x97, 0x6a, 0xdb, 0xb3, 0x5d, 0x8f, 0xea, 0xff, 0x2f, 0xb0, 0xc3, 0x53, 0x64, 0xef, 0xe1,
+	0x65, 0x10, 0x42, 0x71, 0x15, 0x4d, 0x72, 0xd9, 0x50, 0x35, 0xf0, 0xac, 0x80, 0x34, 0xf6, 0x84,
+	0xe1, 0xe0, 0x6b, 0xe8, 0x09, 0xb2, 0xa9, 0x41, 0xee, 0xef, 0x97, 0xcf, 0x8b, 0x6f, 0xa3, 0x03,
+	0x20, 0x86, 0x02, 0xbf, 0x3f, 0x66, 0xc7, 0x59, 0xba, 0x6a, 0xf4, 0x77, 0xcb, 0x1d, 0xc5, 0x9a,
+	0xb3, 0xdf, 0x28, 0xa0, 0x7d, 0xe4, 0xeb, 0x9b, 0x79, 0x1c, 0x8d, 0xab, 0xab, 0xe6, 0x28, 0x6d,
+	0xcd, 0x00, 0xe1, 0xe4, 0x60, 0x4f, 0x3a, 0x3e, 0x9b, 0xf9, 0x13, 0x06, 0x3a, 0x1b, 0xe7, 0x5d,
+	0xf0, 0x36, 0x1a, 0x16, 0x07, 0x59, 0xc9, 0xc8, 0x6f, 0x96, 0x24, 0x8e, 0x48, 0x11, 0x28, 0x9e,
+	0xb1, 0xc2, 0xa2, 0x08, 0x24, 0x7a, 0xd5, 0x39, 0xa9, 0xd0, 0xc5, 0x39, 0xe9, 0x09, 0x74, 0x21,
+	0xfd, 0x48, 0xa3, 0x0f, 0x09, 0x1a, 0xc7, 0xe6, 0x9e, 0x10, 0x8d, 0x87, 0x0f, 0x09, 0xf6, 0xe8,
+	0x02, 0x0e, 0x33, 0x3f, 0x80, 0xe2, 0x69, 0xe3, 0xf1, 0xf3, 0x68, 0xd4, 0xf7, 0xb7, 0xb9, 0xc9,
+	0x69, 0xc9, 0xe8, 0x43, 0xe5, 0x29, 0x73, 0xf0, 0xf1, 0xb7, 0x4f, 0xf8, 0x13, 0x22, 0xf4, 0xf3,
+	0xcf, 0x7c, 0xfe, 0xcb, 0x57, 0x5e, 0xf1, 0x5b, 0x5f, 0xbe, 0xf2, 0x8a, 0xdf, 0xfb, 0xf2, 0x95,
+	0x57, 0x7c, 0xd3, 0xc1, 0x15, 0xe3, 0xf3, 0x07, 0x57, 0x8c, 0xdf, 0x3a, 0xb8, 0x62, 0xfc, 0xde,
+	0xc1, 0x15, 0xe3, 0x4f, 0x0e, 0xae, 0x18, 0xdf, 0xf3, 0xa7, 0x57, 0x5e, 0xf1, 0xec, 0x63, 0x11,
+	0xf5, 0x6b, 0x92, 0x68, 0xf4, 0x0f, 0xb5, 0xf5, 0xa1, 0xd4, 0xe5, 0xdb, 0x98, 0x51, 0xff, 0xdf,
+	0x03, 0x00, 0x5f, 0x1d, 0x14, 0xd5, 0x17, 0x4d, 0x01, 0x00,
 }
 
 func (m *APIServerLogging) Marshal() (dAtA []byte, err error) {
@@ -7458,6 +8048,49 @@ func (m *AuthorizerKubeconfigReference) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoHibernation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoHibernation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoHibernation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IdleTimeout != nil {
+		{
+			size, err := m.IdleTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i--
+	if m.Enabled {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *AvailabilityZone) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8376,6 +9009,52 @@ func (m CapabilityValues) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CertificateExpiration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CertificateExpiration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CertificateExpiration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ExpirationTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	i--
+	if m.CertificateAuthority {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *CloudProfile) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8519,6 +9198,18 @@ func (m *CloudProfileSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.QuotaAccounting != nil {
+		{
+			size, err := m.QuotaAccounting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	if len(m.MachineCapabilities) > 0 {
 		for iNdEx := len(m.MachineCapabilities) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -9162,6 +9853,65 @@ func (m *ControlPlaneAutoscaling) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ControlPlaneQuotaAccounting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ControlPlaneQuotaAccounting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ControlPlaneQuotaAccounting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Resources) > 0 {
+		keysForResources := make([]string, 0, len(m.Resources))
+		for k := range m.Resources {
+			keysForResources = append(keysForResources, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForResources)
+		for iNdEx := len(keysForResources) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Resources[k8s_io_api_core_v1.ResourceName(keysForResources[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForResources[iNdEx])
+			copy(dAtA[i:], keysForResources[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForResources[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.FailureToleranceType != nil {
+		i -= len(*m.FailureToleranceType)
+		copy(dAtA[i:], *m.FailureToleranceType)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.FailureToleranceType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ControllerDeployment) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10458,6 +11208,18 @@ func (m *ExpirableVersion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Rollout != nil {
+		{
+			size, err := m.Rollout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.Classification != nil {
 		i -= len(*m.Classification)
 		copy(dAtA[i:], *m.Classification)
@@ -10956,6 +11718,30 @@ func (m *Hibernation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoHibernation != nil {
+		{
+			size, err := m.AutoHibernation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StayAwakeUntil != nil {
+		{
+			size, err := m.StayAwakeUntil.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Schedules) > 0 {
 		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -10983,6 +11769,83 @@ func (m *Hibernation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HibernationCalendar) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HibernationCalendar) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HibernationCalendar) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Days) > 0 {
+		for iNdEx := len(m.Days) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Days[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HibernationCalendarDays) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HibernationCalendarDays) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HibernationCalendarDays) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.End != nil {
+		i -= len(*m.End)
+		copy(dAtA[i:], *m.End)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.End)))
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Begin)
+	copy(dAtA[i:], m.Begin)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Begin)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HibernationSchedule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11003,6 +11866,24 @@ func (m *HibernationSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HibernateCalendars) > 0 {
+		for iNdEx := len(m.HibernateCalendars) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.HibernateCalendars[iNdEx])
+			copy(dAtA[i:], m.HibernateCalendars[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.HibernateCalendars[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SkipCalendars) > 0 {
+		for iNdEx := len(m.SkipCalendars) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SkipCalendars[iNdEx])
+			copy(dAtA[i:], m.SkipCalendars[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.SkipCalendars[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Location != nil {
 		i -= len(*m.Location)
 		copy(dAtA[i:], *m.Location)
@@ -12776,6 +13657,18 @@ func (m *LastMaintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Deferral != nil {
+		{
+			size, err := m.Deferral.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.FailureReason != nil {
 		i -= len(*m.FailureReason)
 		copy(dAtA[i:], *m.FailureReason)
@@ -13625,6 +14518,118 @@ func (m *MaintenanceCredentialsAutoRotation) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *MaintenanceDeferral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceDeferral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceDeferral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Operations[iNdEx])
+			copy(dAtA[i:], m.Operations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Operations[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Until.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Freeze)
+	copy(dAtA[i:], m.Freeze)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Freeze)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MaintenanceFreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceFreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HoldForcedUpdates != nil {
+		i--
+		if *m.HoldForcedUpdates {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Recurrence != nil {
+		i -= len(*m.Recurrence)
+		copy(dAtA[i:], *m.Recurrence)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Recurrence)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Begin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MaintenanceRotationConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -14352,6 +15357,113 @@ func (m *NodeLocalDNS) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NotificationSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NotificationSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotificationSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Webhook.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Reasons) > 0 {
+		for iNdEx := len(m.Reasons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reasons[iNdEx])
+			copy(dAtA[i:], m.Reasons[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reasons[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Types) > 0 {
+		for iNdEx := len(m.Types) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Types[iNdEx])
+			copy(dAtA[i:], m.Types[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Types[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Kinds) > 0 {
+		for iNdEx := len(m.Kinds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Kinds[iNdEx])
+			copy(dAtA[i:], m.Kinds[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kinds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *NotificationWebhook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NotificationWebhook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotificationWebhook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ContentType != nil {
+		i -= len(*m.ContentType)
+		copy(dAtA[i:], *m.ContentType)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.ContentType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PayloadTemplate != nil {
+		i -= len(*m.PayloadTemplate)
+		copy(dAtA[i:], *m.PayloadTemplate)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.PayloadTemplate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *OCIRepository) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -14767,6 +15879,41 @@ func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProjectLifecycle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectLifecycle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectLifecycle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationTimestamp != nil {
+		{
+			size, err := m.ExpirationTimestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ProjectList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -14881,6 +16028,72 @@ func (m *ProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Notifications) > 0 {
+		for iNdEx := len(m.Notifications) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Notifications[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.Lifecycle != nil {
+		{
+			size, err := m.Lifecycle.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.AutoHibernation != nil {
+		{
+			size, err := m.AutoHibernation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.HibernationCalendars) > 0 {
+		for iNdEx := len(m.HibernationCalendars) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HibernationCalendars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.MaintenanceFreezes) > 0 {
+		for iNdEx := len(m.MaintenanceFreezes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaintenanceFreezes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.DualApprovalForDeletion) > 0 {
 		for iNdEx := len(m.DualApprovalForDeletion) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -14989,6 +16202,44 @@ func (m *ProjectStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.ArchivedAutoDeleteTimestamp != nil {
+		{
+			size, err := m.ArchivedAutoDeleteTimestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ArchivedTimestamp != nil {
+		{
+			size, err := m.ArchivedTimestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.LastActivityTimestamp != nil {
 		{
 			size, err := m.LastActivityTimestamp.MarshalToSizedBuffer(dAtA[:i])
@@ -15185,6 +16436,16 @@ func (m *Quota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -15208,6 +16469,72 @@ func (m *Quota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QuotaAccounting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaAccounting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaAccounting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LoadBalancer) > 0 {
+		keysForLoadBalancer := make([]string, 0, len(m.LoadBalancer))
+		for k := range m.LoadBalancer {
+			keysForLoadBalancer = append(keysForLoadBalancer, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForLoadBalancer)
+		for iNdEx := len(keysForLoadBalancer) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.LoadBalancer[k8s_io_api_core_v1.ResourceName(keysForLoadBalancer[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForLoadBalancer[iNdEx])
+			copy(dAtA[i:], keysForLoadBalancer[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForLoadBalancer[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ControlPlanes) > 0 {
+		for iNdEx := len(m.ControlPlanes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ControlPlanes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QuotaList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15255,6 +16582,68 @@ func (m *QuotaList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QuotaShootUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaShootUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaShootUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Used) > 0 {
+		keysForUsed := make([]string, 0, len(m.Used))
+		for k := range m.Used {
+			keysForUsed = append(keysForUsed, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+		for iNdEx := len(keysForUsed) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Used[k8s_io_api_core_v1.ResourceName(keysForUsed[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForUsed[iNdEx])
+			copy(dAtA[i:], keysForUsed[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForUsed[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QuotaSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15275,6 +16664,47 @@ func (m *QuotaSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GracePeriod != nil {
+		{
+			size, err := m.GracePeriod.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SoftMetrics) > 0 {
+		keysForSoftMetrics := make([]string, 0, len(m.SoftMetrics))
+		for k := range m.SoftMetrics {
+			keysForSoftMetrics = append(keysForSoftMetrics, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForSoftMetrics)
+		for iNdEx := len(keysForSoftMetrics) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.SoftMetrics[k8s_io_api_core_v1.ResourceName(keysForSoftMetrics[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForSoftMetrics[iNdEx])
+			copy(dAtA[i:], keysForSoftMetrics[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForSoftMetrics[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -15322,6 +16752,84 @@ func (m *QuotaSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QuotaStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LimitsExceededSince != nil {
+		{
+			size, err := m.LimitsExceededSince.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Shoots) > 0 {
+		for iNdEx := len(m.Shoots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shoots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Used) > 0 {
+		keysForUsed := make([]string, 0, len(m.Used))
+		for k := range m.Used {
+			keysForUsed = append(keysForUsed, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+		for iNdEx := len(keysForUsed) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Used[k8s_io_api_core_v1.ResourceName(keysForUsed[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForUsed[iNdEx])
+			copy(dAtA[i:], keysForUsed[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForUsed[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Region) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15483,6 +16991,50 @@ func (m *ResourceWatchCacheSize) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *RolloutWave) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutWave) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutWave) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SoakDuration != nil {
+		{
+			size, err := m.SoakDuration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Purposes) > 0 {
+		for iNdEx := len(m.Purposes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Purposes[iNdEx])
+			copy(dAtA[i:], m.Purposes[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Purposes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SSHAccess) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -16043,6 +17595,87 @@ func (m *SeedProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SeedResourceUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SeedResourceUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SeedResourceUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeAllocatable) > 0 {
+		keysForNodeAllocatable := make([]string, 0, len(m.NodeAllocatable))
+		for k := range m.NodeAllocatable {
+			keysForNodeAllocatable = append(keysForNodeAllocatable, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForNodeAllocatable)
+		for iNdEx := len(keysForNodeAllocatable) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.NodeAllocatable[k8s_io_api_core_v1.ResourceName(keysForNodeAllocatable[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForNodeAllocatable[iNdEx])
+			copy(dAtA[i:], keysForNodeAllocatable[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForNodeAllocatable[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ControlPlaneRequests) > 0 {
+		keysForControlPlaneRequests := make([]string, 0, len(m.ControlPlaneRequests))
+		for k := range m.ControlPlaneRequests {
+			keysForControlPlaneRequests = append(keysForControlPlaneRequests, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForControlPlaneRequests)
+		for iNdEx := len(keysForControlPlaneRequests) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.ControlPlaneRequests[k8s_io_api_core_v1.ResourceName(keysForControlPlaneRequests[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForControlPlaneRequests[iNdEx])
+			copy(dAtA[i:], keysForControlPlaneRequests[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForControlPlaneRequests[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SeedSelector) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -16955,6 +18588,18 @@ func (m *SeedStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ResourceUsage != nil {
+		{
+			size, err := m.ResourceUsage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.LastOperation != nil {
 		{
 			size, err := m.LastOperation.MarshalToSizedBuffer(dAtA[:i])
@@ -17500,6 +19145,20 @@ func (m *ShootCredentials) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CertificateExpirations) > 0 {
+		for iNdEx := len(m.CertificateExpirations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CertificateExpirations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.EncryptionAtRest != nil {
 		{
 			size, err := m.EncryptionAtRest.MarshalToSizedBuffer(dAtA[:i])
@@ -18301,6 +19960,34 @@ func (m *ShootStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastActivityTime != nil {
+		{
+			size, err := m.LastActivityTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.UpcomingMaintenance != nil {
+		{
+			size, err := m.UpcomingMaintenance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
 	if m.ManualWorkerPoolRollout != nil {
 		{
 			size, err := m.ManualWorkerPoolRollout.MarshalToSizedBuffer(dAtA[:i])
@@ -18729,6 +20416,126 @@ func (m *Toleration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UpcomingMaintenance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpcomingMaintenance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpcomingMaintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LastUpdateTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Deferral != nil {
+		{
+			size, err := m.Deferral.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FailedOperations) > 0 {
+		for iNdEx := len(m.FailedOperations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FailedOperations[iNdEx])
+			copy(dAtA[i:], m.FailedOperations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.FailedOperations[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Operations[iNdEx])
+			copy(dAtA[i:], m.Operations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Operations[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.WindowStart.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VersionRollout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VersionRollout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VersionRollout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Waves) > 0 {
+		for iNdEx := len(m.Waves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Waves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *VerticalPodAutoscaler) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -19752,6 +21559,20 @@ func (m *AuthorizerKubeconfigReference) Size() (n int) {
 	return n
 }
 
+func (m *AutoHibernation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	if m.IdleTimeout != nil {
+		l = m.IdleTimeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *AvailabilityZone) Size() (n int) {
 	if m == nil {
 		return 0
@@ -20096,6 +21917,20 @@ func (m CapabilityValues) Size() (n int) {
 	return n
 }
 
+func (m *CertificateExpiration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = m.ExpirationTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *CloudProfile) Size() (n int) {
 	if m == nil {
 		return 0
@@ -20199,6 +22034,10 @@ func (m *CloudProfileSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.QuotaAccounting != nil {
+		l = m.QuotaAccounting.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -20394,6 +22233,28 @@ func (m *ControlPlaneAutoscaling) Size() (n int) {
 	return n
 }
 
+func (m *ControlPlaneQuotaAccounting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FailureToleranceType != nil {
+		l = len(*m.FailureToleranceType)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Resources) > 0 {
+		for k, v := range m.Resources {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func (m *ControllerDeployment) Size() (n int) {
 	if m == nil {
 		return 0
@@ -20897,6 +22758,10 @@ func (m *ExpirableVersion) Size() (n int) {
 		l = len(*m.Classification)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Rollout != nil {
+		l = m.Rollout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -21085,6 +22950,46 @@ func (m *Hibernation) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.StayAwakeUntil != nil {
+		l = m.StayAwakeUntil.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.AutoHibernation != nil {
+		l = m.AutoHibernation.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *HibernationCalendar) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Days) > 0 {
+		for _, e := range m.Days {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HibernationCalendarDays) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Begin)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.End != nil {
+		l = len(*m.End)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -21106,6 +23011,18 @@ func (m *HibernationSchedule) Size() (n int) {
 		l = len(*m.Location)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.SkipCalendars) > 0 {
+		for _, s := range m.SkipCalendars {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.HibernateCalendars) > 0 {
+		for _, s := range m.HibernateCalendars {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -21751,6 +23668,10 @@ func (m *LastMaintenance) Size() (n int) {
 		l = len(*m.FailureReason)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Deferral != nil {
+		l = m.Deferral.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -22055,6 +23976,47 @@ func (m *MaintenanceCredentialsAutoRotation) Size() (n int) {
 	return n
 }
 
+func (m *MaintenanceDeferral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Freeze)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Until.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Operations) > 0 {
+		for _, s := range m.Operations {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MaintenanceFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Begin.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.End.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Recurrence != nil {
+		l = len(*m.Recurrence)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.HoldForcedUpdates != nil {
+		n += 2
+	}
+	return n
+}
+
 func (m *MaintenanceRotationConfig) Size() (n int) {
 	if m == nil {
 		return 0
@@ -22340,6 +24302,56 @@ func (m *NodeLocalDNS) Size() (n int) {
 	return n
 }
 
+func (m *NotificationSubscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Kinds) > 0 {
+		for _, s := range m.Kinds {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Types) > 0 {
+		for _, s := range m.Types {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Reasons) > 0 {
+		for _, s := range m.Reasons {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.Webhook.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *NotificationWebhook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.PayloadTemplate != nil {
+		l = len(*m.PayloadTemplate)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ContentType != nil {
+		l = len(*m.ContentType)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *OCIRepository) Size() (n int) {
 	if m == nil {
 		return 0
@@ -22513,6 +24525,19 @@ func (m *Project) Size() (n int) {
 	return n
 }
 
+func (m *ProjectLifecycle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExpirationTimestamp != nil {
+		l = m.ExpirationTimestamp.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *ProjectList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -22591,6 +24616,32 @@ func (m *ProjectSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.MaintenanceFreezes) > 0 {
+		for _, e := range m.MaintenanceFreezes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.HibernationCalendars) > 0 {
+		for _, e := range m.HibernationCalendars {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.AutoHibernation != nil {
+		l = m.AutoHibernation.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Lifecycle != nil {
+		l = m.Lifecycle.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Notifications) > 0 {
+		for _, e := range m.Notifications {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -22615,6 +24666,20 @@ func (m *ProjectStatus) Size() (n int) {
 		l = m.LastActivityTimestamp.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.ArchivedTimestamp != nil {
+		l = m.ArchivedTimestamp.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ArchivedAutoDeleteTimestamp != nil {
+		l = m.ArchivedAutoDeleteTimestamp.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -22678,6 +24743,32 @@ func (m *Quota) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Spec.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *QuotaAccounting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ControlPlanes) > 0 {
+		for _, e := range m.ControlPlanes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.LoadBalancer) > 0 {
+		for k, v := range m.LoadBalancer {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -22698,6 +24789,28 @@ func (m *QuotaList) Size() (n int) {
 	return n
 }
 
+func (m *QuotaShootUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Used) > 0 {
+		for k, v := range m.Used {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func (m *QuotaSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -22718,6 +24831,47 @@ func (m *QuotaSpec) Size() (n int) {
 	}
 	l = m.Scope.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.SoftMetrics) > 0 {
+		for k, v := range m.SoftMetrics {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.GracePeriod != nil {
+		l = m.GracePeriod.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *QuotaStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Used) > 0 {
+		for k, v := range m.Used {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Shoots) > 0 {
+		for _, e := range m.Shoots {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.LimitsExceededSince != nil {
+		l = m.LimitsExceededSince.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -22781,6 +24935,25 @@ func (m *ResourceWatchCacheSize) Size() (n int) {
 	return n
 }
 
+func (m *RolloutWave) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Purposes) > 0 {
+		for _, s := range m.Purposes {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.SoakDuration != nil {
+		l = m.SoakDuration.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *SSHAccess) Size() (n int) {
 	if m == nil {
 		return 0
@@ -22989,6 +25162,33 @@ func (m *SeedProvider) Size() (n int) {
 	return n
 }
 
+func (m *SeedResourceUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ControlPlaneRequests) > 0 {
+		for k, v := range m.ControlPlaneRequests {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.NodeAllocatable) > 0 {
+		for k, v := range m.NodeAllocatable {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func (m *SeedSelector) Size() (n int) {
 	if m == nil {
 		return 0
@@ -23357,6 +25557,10 @@ func (m *SeedStatus) Size() (n int) {
 		l = m.LastOperation.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.ResourceUsage != nil {
+		l = m.ResourceUsage.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -23521,6 +25725,12 @@ func (m *ShootCredentials) Size() (n int) {
 		l = m.EncryptionAtRest.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.CertificateExpirations) > 0 {
+		for _, e := range m.CertificateExpirations {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -23892,6 +26102,14 @@ func (m *ShootStatus) Size() (n int) {
 		l = m.ManualWorkerPoolRollout.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if m.UpcomingMaintenance != nil {
+		l = m.UpcomingMaintenance.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.LastActivityTime != nil {
+		l = m.LastActivityTime.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -23968,6 +26186,52 @@ func (m *Toleration) Size() (n int) {
 	return n
 }
 
+func (m *UpcomingMaintenance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.WindowStart.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Operations) > 0 {
+		for _, s := range m.Operations {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.FailedOperations) > 0 {
+		for _, s := range m.FailedOperations {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Deferral != nil {
+		l = m.Deferral.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = m.LastUpdateTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *VersionRollout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StartTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Waves) > 0 {
+		for _, e := range m.Waves {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *VerticalPodAutoscaler) Size() (n int) {
 	if m == nil {
 		return 0
@@ -24414,6 +26678,17 @@ func (this *AuthorizerKubeconfigReference) String() string {
 	}, "")
 	return s
 }
+func (this *AutoHibernation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AutoHibernation{`,
+		`Enabled:` + fmt.Sprintf("%v", this.Enabled) + `,`,
+		`IdleTimeout:` + strings.Replace(fmt.Sprintf("%v", this.IdleTimeout), "Duration", "v11.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *AvailabilityZone) String() string {
 	if this == nil {
 		return "nil"
@@ -24639,6 +26914,18 @@ func (this *CapabilityDefinition) String() string {
 	}, "")
 	return s
 }
+func (this *CertificateExpiration) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CertificateExpiration{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`CertificateAuthority:` + fmt.Sprintf("%v", this.CertificateAuthority) + `,`,
+		`ExpirationTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ExpirationTime), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *CloudProfile) String() string {
 	if this == nil {
 		return "nil"
@@ -24719,6 +27006,7 @@ func (this *CloudProfileSpec) String() string {
 		`Bastion:` + strings.Replace(this.Bastion.String(), "Bastion", "Bastion", 1) + `,`,
 		`Limits:` + strings.Replace(this.Limits.String(), "Limits", "Limits", 1) + `,`,
 		`MachineCapabilities:` + repeatedStringForMachineCapabilities + `,`,
+		`QuotaAccounting:` + strings.Replace(this.QuotaAccounting.String(), "QuotaAccounting", "QuotaAccounting", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -24824,6 +27112,27 @@ func (this *ControlPlaneAutoscaling) String() string {
 	}, "")
 	return s
 }
+func (this *ControlPlaneQuotaAccounting) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForResources := make([]string, 0, len(this.Resources))
+	for k := range this.Resources {
+		keysForResources = append(keysForResources, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForResources)
+	mapStringForResources := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForResources {
+		mapStringForResources += fmt.Sprintf("%v: %v,", k, this.Resources[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForResources += "}"
+	s := strings.Join([]string{`&ControlPlaneQuotaAccounting{`,
+		`FailureToleranceType:` + valueToStringGenerated(this.FailureToleranceType) + `,`,
+		`Resources:` + mapStringForResources + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ControllerDeployment) String() string {
 	if this == nil {
 		return "nil"
@@ -25170,6 +27479,7 @@ func (this *ExpirableVersion) String() string {
 		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
 		`ExpirationDate:` + strings.Replace(fmt.Sprintf("%v", this.ExpirationDate), "Time", "v11.Time", 1) + `,`,
 		`Classification:` + valueToStringGenerated(this.Classification) + `,`,
+		`Rollout:` + strings.Replace(this.Rollout.String(), "VersionRollout", "VersionRollout", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25318,6 +27628,35 @@ func (this *Hibernation) String() string {
 	s := strings.Join([]string{`&Hibernation{`,
 		`Enabled:` + valueToStringGenerated(this.Enabled) + `,`,
 		`Schedules:` + repeatedStringForSchedules + `,`,
+		`StayAwakeUntil:` + strings.Replace(fmt.Sprintf("%v", this.StayAwakeUntil), "Time", "v11.Time", 1) + `,`,
+		`AutoHibernation:` + strings.Replace(this.AutoHibernation.String(), "AutoHibernation", "AutoHibernation", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HibernationCalendar) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDays := "[]HibernationCalendarDays{"
+	for _, f := range this.Days {
+		repeatedStringForDays += strings.Replace(strings.Replace(f.String(), "HibernationCalendarDays", "HibernationCalendarDays", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDays += "}"
+	s := strings.Join([]string{`&HibernationCalendar{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Days:` + repeatedStringForDays + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HibernationCalendarDays) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HibernationCalendarDays{`,
+		`Begin:` + fmt.Sprintf("%v", this.Begin) + `,`,
+		`End:` + valueToStringGenerated(this.End) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25330,6 +27669,8 @@ func (this *HibernationSchedule) String() string {
 		`Start:` + valueToStringGenerated(this.Start) + `,`,
 		`End:` + valueToStringGenerated(this.End) + `,`,
 		`Location:` + valueToStringGenerated(this.Location) + `,`,
+		`SkipCalendars:` + fmt.Sprintf("%v", this.SkipCalendars) + `,`,
+		`HibernateCalendars:` + fmt.Sprintf("%v", this.HibernateCalendars) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25709,6 +28050,7 @@ func (this *LastMaintenance) String() string {
 		`TriggeredTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.TriggeredTime), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
 		`State:` + fmt.Sprintf("%v", this.State) + `,`,
 		`FailureReason:` + valueToStringGenerated(this.FailureReason) + `,`,
+		`Deferral:` + strings.Replace(this.Deferral.String(), "MaintenanceDeferral", "MaintenanceDeferral", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -25923,6 +28265,32 @@ func (this *MaintenanceCredentialsAutoRotation) String() string {
 	}, "")
 	return s
 }
+func (this *MaintenanceDeferral) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MaintenanceDeferral{`,
+		`Freeze:` + fmt.Sprintf("%v", this.Freeze) + `,`,
+		`Until:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Until), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`Operations:` + fmt.Sprintf("%v", this.Operations) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MaintenanceFreeze) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MaintenanceFreeze{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Begin:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Begin), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`End:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.End), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`Recurrence:` + valueToStringGenerated(this.Recurrence) + `,`,
+		`HoldForcedUpdates:` + valueToStringGenerated(this.HoldForcedUpdates) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *MaintenanceRotationConfig) String() string {
 	if this == nil {
 		return "nil"
@@ -26125,6 +28493,32 @@ func (this *NodeLocalDNS) String() string {
 	}, "")
 	return s
 }
+func (this *NotificationSubscription) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NotificationSubscription{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Kinds:` + fmt.Sprintf("%v", this.Kinds) + `,`,
+		`Types:` + fmt.Sprintf("%v", this.Types) + `,`,
+		`Reasons:` + fmt.Sprintf("%v", this.Reasons) + `,`,
+		`Webhook:` + strings.Replace(strings.Replace(this.Webhook.String(), "NotificationWebhook", "NotificationWebhook", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NotificationWebhook) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NotificationWebhook{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`PayloadTemplate:` + valueToStringGenerated(this.PayloadTemplate) + `,`,
+		`ContentType:` + valueToStringGenerated(this.ContentType) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *OCIRepository) String() string {
 	if this == nil {
 		return "nil"
@@ -26234,6 +28628,16 @@ func (this *Project) String() string {
 	}, "")
 	return s
 }
+func (this *ProjectLifecycle) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectLifecycle{`,
+		`ExpirationTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.ExpirationTimestamp), "Time", "v11.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ProjectList) String() string {
 	if this == nil {
 		return "nil"
@@ -26276,6 +28680,21 @@ func (this *ProjectSpec) String() string {
 		repeatedStringForDualApprovalForDeletion += strings.Replace(strings.Replace(f.String(), "DualApprovalForDeletion", "DualApprovalForDeletion", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForDualApprovalForDeletion += "}"
+	repeatedStringForMaintenanceFreezes := "[]MaintenanceFreeze{"
+	for _, f := range this.MaintenanceFreezes {
+		repeatedStringForMaintenanceFreezes += strings.Replace(strings.Replace(f.String(), "MaintenanceFreeze", "MaintenanceFreeze", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMaintenanceFreezes += "}"
+	repeatedStringForHibernationCalendars := "[]HibernationCalendar{"
+	for _, f := range this.HibernationCalendars {
+		repeatedStringForHibernationCalendars += strings.Replace(strings.Replace(f.String(), "HibernationCalendar", "HibernationCalendar", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHibernationCalendars += "}"
+	repeatedStringForNotifications := "[]NotificationSubscription{"
+	for _, f := range this.Notifications {
+		repeatedStringForNotifications += strings.Replace(strings.Replace(f.String(), "NotificationSubscription", "NotificationSubscription", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForNotifications += "}"
 	s := strings.Join([]string{`&ProjectSpec{`,
 		`CreatedBy:` + strings.Replace(fmt.Sprintf("%v", this.CreatedBy), "Subject", "v14.Subject", 1) + `,`,
 		`Description:` + valueToStringGenerated(this.Description) + `,`,
@@ -26285,6 +28704,11 @@ func (this *ProjectSpec) String() string {
 		`Namespace:` + valueToStringGenerated(this.Namespace) + `,`,
 		`Tolerations:` + strings.Replace(this.Tolerations.String(), "ProjectTolerations", "ProjectTolerations", 1) + `,`,
 		`DualApprovalForDeletion:` + repeatedStringForDualApprovalForDeletion + `,`,
+		`MaintenanceFreezes:` + repeatedStringForMaintenanceFreezes + `,`,
+		`HibernationCalendars:` + repeatedStringForHibernationCalendars + `,`,
+		`AutoHibernation:` + strings.Replace(this.AutoHibernation.String(), "AutoHibernation", "AutoHibernation", 1) + `,`,
+		`Lifecycle:` + strings.Replace(this.Lifecycle.String(), "ProjectLifecycle", "ProjectLifecycle", 1) + `,`,
+		`Notifications:` + repeatedStringForNotifications + `,`,
 		`}`,
 	}, "")
 	return s
@@ -26293,12 +28717,20 @@ func (this *ProjectStatus) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForConditions := "[]Condition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "Condition", "Condition", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForConditions += "}"
 	s := strings.Join([]string{`&ProjectStatus{`,
 		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
 		`StaleSinceTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.StaleSinceTimestamp), "Time", "v11.Time", 1) + `,`,
 		`StaleAutoDeleteTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.StaleAutoDeleteTimestamp), "Time", "v11.Time", 1) + `,`,
 		`LastActivityTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.LastActivityTimestamp), "Time", "v11.Time", 1) + `,`,
+		`ArchivedTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.ArchivedTimestamp), "Time", "v11.Time", 1) + `,`,
+		`ArchivedAutoDeleteTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.ArchivedAutoDeleteTimestamp), "Time", "v11.Time", 1) + `,`,
+		`Conditions:` + repeatedStringForConditions + `,`,
 		`}`,
 	}, "")
 	return s
@@ -26350,6 +28782,33 @@ func (this *Quota) String() string {
 	s := strings.Join([]string{`&Quota{`,
 		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "QuotaSpec", "QuotaSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "QuotaStatus", "QuotaStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QuotaAccounting) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForControlPlanes := "[]ControlPlaneQuotaAccounting{"
+	for _, f := range this.ControlPlanes {
+		repeatedStringForControlPlanes += strings.Replace(strings.Replace(f.String(), "ControlPlaneQuotaAccounting", "ControlPlaneQuotaAccounting", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForControlPlanes += "}"
+	keysForLoadBalancer := make([]string, 0, len(this.LoadBalancer))
+	for k := range this.LoadBalancer {
+		keysForLoadBalancer = append(keysForLoadBalancer, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLoadBalancer)
+	mapStringForLoadBalancer := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForLoadBalancer {
+		mapStringForLoadBalancer += fmt.Sprintf("%v: %v,", k, this.LoadBalancer[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForLoadBalancer += "}"
+	s := strings.Join([]string{`&QuotaAccounting{`,
+		`ControlPlanes:` + repeatedStringForControlPlanes + `,`,
+		`LoadBalancer:` + mapStringForLoadBalancer + `,`,
 		`}`,
 	}, "")
 	return s
@@ -26370,6 +28829,28 @@ func (this *QuotaList) String() string {
 	}, "")
 	return s
 }
+func (this *QuotaShootUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForUsed := make([]string, 0, len(this.Used))
+	for k := range this.Used {
+		keysForUsed = append(keysForUsed, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+	mapStringForUsed := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForUsed {
+		mapStringForUsed += fmt.Sprintf("%v: %v,", k, this.Used[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForUsed += "}"
+	s := strings.Join([]string{`&QuotaShootUsage{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Used:` + mapStringForUsed + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *QuotaSpec) String() string {
 	if this == nil {
 		return "nil"
@@ -26384,10 +28865,49 @@ func (this *QuotaSpec) String() string {
 		mapStringForMetrics += fmt.Sprintf("%v: %v,", k, this.Metrics[k8s_io_api_core_v1.ResourceName(k)])
 	}
 	mapStringForMetrics += "}"
+	keysForSoftMetrics := make([]string, 0, len(this.SoftMetrics))
+	for k := range this.SoftMetrics {
+		keysForSoftMetrics = append(keysForSoftMetrics, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSoftMetrics)
+	mapStringForSoftMetrics := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForSoftMetrics {
+		mapStringForSoftMetrics += fmt.Sprintf("%v: %v,", k, this.SoftMetrics[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForSoftMetrics += "}"
 	s := strings.Join([]string{`&QuotaSpec{`,
 		`ClusterLifetimeDays:` + valueToStringGenerated(this.ClusterLifetimeDays) + `,`,
 		`Metrics:` + mapStringForMetrics + `,`,
 		`Scope:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Scope), "ObjectReference", "v1.ObjectReference", 1), `&`, ``, 1) + `,`,
+		`SoftMetrics:` + mapStringForSoftMetrics + `,`,
+		`GracePeriod:` + strings.Replace(fmt.Sprintf("%v", this.GracePeriod), "Duration", "v11.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QuotaStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForShoots := "[]QuotaShootUsage{"
+	for _, f := range this.Shoots {
+		repeatedStringForShoots += strings.Replace(strings.Replace(f.String(), "QuotaShootUsage", "QuotaShootUsage", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForShoots += "}"
+	keysForUsed := make([]string, 0, len(this.Used))
+	for k := range this.Used {
+		keysForUsed = append(keysForUsed, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+	mapStringForUsed := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForUsed {
+		mapStringForUsed += fmt.Sprintf("%v: %v,", k, this.Used[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForUsed += "}"
+	s := strings.Join([]string{`&QuotaStatus{`,
+		`Used:` + mapStringForUsed + `,`,
+		`Shoots:` + repeatedStringForShoots + `,`,
+		`LimitsExceededSince:` + strings.Replace(fmt.Sprintf("%v", this.LimitsExceededSince), "Time", "v11.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -26448,6 +28968,17 @@ func (this *ResourceWatchCacheSize) String() string {
 	}, "")
 	return s
 }
+func (this *RolloutWave) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutWave{`,
+		`Purposes:` + fmt.Sprintf("%v", this.Purposes) + `,`,
+		`SoakDuration:` + strings.Replace(fmt.Sprintf("%v", this.SoakDuration), "Duration", "v11.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SSHAccess) String() string {
 	if this == nil {
 		return "nil"
@@ -26600,6 +29131,37 @@ func (this *SeedProvider) String() string {
 	}, "")
 	return s
 }
+func (this *SeedResourceUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForControlPlaneRequests := make([]string, 0, len(this.ControlPlaneRequests))
+	for k := range this.ControlPlaneRequests {
+		keysForControlPlaneRequests = append(keysForControlPlaneRequests, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForControlPlaneRequests)
+	mapStringForControlPlaneRequests := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForControlPlaneRequests {
+		mapStringForControlPlaneRequests += fmt.Sprintf("%v: %v,", k, this.ControlPlaneRequests[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForControlPlaneRequests += "}"
+	keysForNodeAllocatable := make([]string, 0, len(this.NodeAllocatable))
+	for k := range this.NodeAllocatable {
+		keysForNodeAllocatable = append(keysForNodeAllocatable, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNodeAllocatable)
+	mapStringForNodeAllocatable := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForNodeAllocatable {
+		mapStringForNodeAllocatable += fmt.Sprintf("%v: %v,", k, this.NodeAllocatable[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForNodeAllocatable += "}"
+	s := strings.Join([]string{`&SeedResourceUsage{`,
+		`ControlPlaneRequests:` + mapStringForControlPlaneRequests + `,`,
+		`NodeAllocatable:` + mapStringForNodeAllocatable + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SeedSelector) String() string {
 	if this == nil {
 		return "nil"
@@ -26904,6 +29466,7 @@ func (this *SeedStatus) String() string {
 		`Allocatable:` + mapStringForAllocatable + `,`,
 		`ClientCertificateExpirationTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.ClientCertificateExpirationTimestamp), "Time", "v11.Time", 1) + `,`,
 		`LastOperation:` + strings.Replace(this.LastOperation.String(), "LastOperation", "LastOperation", 1) + `,`,
+		`ResourceUsage:` + strings.Replace(this.ResourceUsage.String(), "SeedResourceUsage", "SeedResourceUsage", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -27017,9 +29580,15 @@ func (this *ShootCredentials) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForCertificateExpirations := "[]CertificateExpiration{"
+	for _, f := range this.CertificateExpirations {
+		repeatedStringForCertificateExpirations += strings.Replace(strings.Replace(f.String(), "CertificateExpiration", "CertificateExpiration", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCertificateExpirations += "}"
 	s := strings.Join([]string{`&ShootCredentials{`,
 		`Rotation:` + strings.Replace(this.Rotation.String(), "ShootCredentialsRotation", "ShootCredentialsRotation", 1) + `,`,
 		`EncryptionAtRest:` + strings.Replace(this.EncryptionAtRest.String(), "EncryptionAtRest", "EncryptionAtRest", 1) + `,`,
+		`CertificateExpirations:` + repeatedStringForCertificateExpirations + `,`,
 		`}`,
 	}, "")
 	return s
@@ -27252,6 +29821,8 @@ func (this *ShootStatus) String() string {
 		`Networking:` + strings.Replace(this.Networking.String(), "NetworkingStatus", "NetworkingStatus", 1) + `,`,
 		`InPlaceUpdates:` + strings.Replace(this.InPlaceUpdates.String(), "InPlaceUpdatesStatus", "InPlaceUpdatesStatus", 1) + `,`,
 		`ManualWorkerPoolRollout:` + strings.Replace(this.ManualWorkerPoolRollout.String(), "ManualWorkerPoolRollout", "ManualWorkerPoolRollout", 1) + `,`,
+		`UpcomingMaintenance:` + strings.Replace(this.UpcomingMaintenance.String(), "UpcomingMaintenance", "UpcomingMaintenance", 1) + `,`,
+		`LastActivityTime:` + strings.Replace(fmt.Sprintf("%v", this.LastActivityTime), "Time", "v11.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -27315,6 +29886,36 @@ func (this *Toleration) String() string {
 	}, "")
 	return s
 }
+func (this *UpcomingMaintenance) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpcomingMaintenance{`,
+		`WindowStart:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.WindowStart), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`Operations:` + fmt.Sprintf("%v", this.Operations) + `,`,
+		`FailedOperations:` + fmt.Sprintf("%v", this.FailedOperations) + `,`,
+		`Deferral:` + strings.Replace(this.Deferral.String(), "MaintenanceDeferral", "MaintenanceDeferral", 1) + `,`,
+		`LastUpdateTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastUpdateTime), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VersionRollout) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForWaves := "[]RolloutWave{"
+	for _, f := range this.Waves {
+		repeatedStringForWaves += strings.Replace(strings.Replace(f.String(), "RolloutWave", "RolloutWave", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForWaves += "}"
+	s := strings.Join([]string{`&VersionRollout{`,
+		`StartTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartTime), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`Waves:` + repeatedStringForWaves + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *VerticalPodAutoscaler) String() string {
 	if this == nil {
 		return "nil"
@@ -28733,7 +31334,7 @@ func (m *AuthorizerKubeconfigReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
+func (m *AutoHibernation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28756,17 +31357,17 @@ func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AvailabilityZone: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoHibernation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AvailabilityZone: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoHibernation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28776,29 +31377,17 @@ func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnavailableMachineTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdleTimeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28808,55 +31397,27 @@ func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnavailableMachineTypes = append(m.UnavailableMachineTypes, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnavailableVolumeTypes", wireType)
+			if m.IdleTimeout == nil {
+				m.IdleTimeout = &v11.Duration{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.IdleTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.UnavailableVolumeTypes = append(m.UnavailableVolumeTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -28879,7 +31440,7 @@ func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Backup) Unmarshal(dAtA []byte) error {
+func (m *AvailabilityZone) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -28902,15 +31463,15 @@ func (m *Backup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Backup: wiretype end group for non-group")
+			return fmt.Errorf("proto: AvailabilityZone: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Backup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AvailabilityZone: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -28938,13 +31499,13 @@ func (m *Backup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provider = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnavailableMachineTypes", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -28954,31 +31515,27 @@ func (m *Backup) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProviderConfig == nil {
-				m.ProviderConfig = &runtime.RawExtension{}
-			}
-			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.UnavailableMachineTypes = append(m.UnavailableMachineTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnavailableVolumeTypes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -29006,44 +31563,7 @@ func (m *Backup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Region = &s
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CredentialsRef", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.CredentialsRef == nil {
-				m.CredentialsRef = &v1.ObjectReference{}
-			}
-			if err := m.CredentialsRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.UnavailableVolumeTypes = append(m.UnavailableVolumeTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29066,7 +31586,7 @@ func (m *Backup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupBucket) Unmarshal(dAtA []byte) error {
+func (m *Backup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29089,17 +31609,17 @@ func (m *BackupBucket) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupBucket: wiretype end group for non-group")
+			return fmt.Errorf("proto: Backup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Backup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29109,28 +31629,27 @@ func (m *BackupBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29157,13 +31676,49 @@ func (m *BackupBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ProviderConfig == nil {
+				m.ProviderConfig = &runtime.RawExtension{}
+			}
+			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Region = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialsRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29190,7 +31745,10 @@ func (m *BackupBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CredentialsRef == nil {
+				m.CredentialsRef = &v1.ObjectReference{}
+			}
+			if err := m.CredentialsRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -29215,7 +31773,7 @@ func (m *BackupBucket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
+func (m *BackupBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29238,15 +31796,15 @@ func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupBucketList: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupBucketList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29273,13 +31831,13 @@ func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -29306,66 +31864,15 @@ func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, BackupBucket{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BackupBucketProvider) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BackupBucketProvider: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupBucketProvider: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -29375,55 +31882,24 @@ func (m *BackupBucketProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -29446,7 +31922,7 @@ func (m *BackupBucketProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
+func (m *BackupBucketList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -29469,15 +31945,246 @@ func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BackupBucketSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackupBucketList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BackupBucketSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackupBucketList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, BackupBucket{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BackupBucketProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackupBucketProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackupBucketProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BackupBucketSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackupBucketSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackupBucketSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31405,7 +34112,7 @@ func (m *CapabilityValues) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloudProfile) Unmarshal(dAtA []byte) error {
+func (m *CertificateExpiration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31428,17 +34135,17 @@ func (m *CloudProfile) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloudProfile: wiretype end group for non-group")
+			return fmt.Errorf("proto: CertificateExpiration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloudProfile: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CertificateExpiration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31448,28 +34155,47 @@ func (m *CloudProfile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertificateAuthority", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CertificateAuthority = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31496,7 +34222,7 @@ func (m *CloudProfile) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExpirationTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -31521,7 +34247,7 @@ func (m *CloudProfile) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloudProfileList) Unmarshal(dAtA []byte) error {
+func (m *CloudProfile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31544,15 +34270,15 @@ func (m *CloudProfileList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloudProfileList: wiretype end group for non-group")
+			return fmt.Errorf("proto: CloudProfile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloudProfileList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CloudProfile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31579,13 +34305,13 @@ func (m *CloudProfileList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31612,8 +34338,7 @@ func (m *CloudProfileList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, CloudProfile{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -31638,7 +34363,7 @@ func (m *CloudProfileList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloudProfileReference) Unmarshal(dAtA []byte) error {
+func (m *CloudProfileList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31661,17 +34386,17 @@ func (m *CloudProfileReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloudProfileReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: CloudProfileList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloudProfileReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CloudProfileList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31681,29 +34406,30 @@ func (m *CloudProfileReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31713,23 +34439,25 @@ func (m *CloudProfileReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, CloudProfile{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31752,7 +34480,7 @@ func (m *CloudProfileReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
+func (m *CloudProfileReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31775,15 +34503,15 @@ func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloudProfileSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: CloudProfileReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloudProfileSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CloudProfileReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CABundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31811,14 +34539,13 @@ func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.CABundle = &s
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kubernetes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31828,98 +34555,79 @@ func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Kubernetes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MachineImages", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MachineImages = append(m.MachineImages, MachineImage{})
-			if err := m.MachineImages[len(m.MachineImages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MachineTypes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MachineTypes = append(m.MachineTypes, MachineType{})
-			if err := m.MachineTypes[len(m.MachineTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloudProfileSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloudProfileSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CABundle", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -31929,31 +34637,28 @@ func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProviderConfig == nil {
-				m.ProviderConfig = &runtime.RawExtension{}
-			}
-			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.CABundle = &s
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kubernetes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31980,14 +34685,13 @@ func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Regions = append(m.Regions, Region{})
-			if err := m.Regions[len(m.Regions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Kubernetes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeedSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineImages", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32014,48 +34718,14 @@ func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SeedSelector == nil {
-				m.SeedSelector = &SeedSelector{}
-			}
-			if err := m.SeedSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MachineImages = append(m.MachineImages, MachineImage{})
+			if err := m.MachineImages[len(m.MachineImages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VolumeTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineTypes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32082,14 +34752,186 @@ func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VolumeTypes = append(m.VolumeTypes, VolumeType{})
-			if err := m.VolumeTypes[len(m.VolumeTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MachineTypes = append(m.MachineTypes, MachineType{})
+			if err := m.MachineTypes[len(m.MachineTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bastion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProviderConfig == nil {
+				m.ProviderConfig = &runtime.RawExtension{}
+			}
+			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Regions = append(m.Regions, Region{})
+			if err := m.Regions[len(m.Regions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeedSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SeedSelector == nil {
+				m.SeedSelector = &SeedSelector{}
+			}
+			if err := m.SeedSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VolumeTypes = append(m.VolumeTypes, VolumeType{})
+			if err := m.VolumeTypes[len(m.VolumeTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bastion", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -32193,6 +35035,42 @@ func (m *CloudProfileSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaAccounting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.QuotaAccounting == nil {
+				m.QuotaAccounting = &QuotaAccounting{}
+			}
+			if err := m.QuotaAccounting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -33727,7 +36605,7 @@ func (m *ControlPlaneAutoscaling) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ControllerDeployment) Unmarshal(dAtA []byte) error {
+func (m *ControlPlaneQuotaAccounting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -33750,48 +36628,15 @@ func (m *ControllerDeployment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ControllerDeployment: wiretype end group for non-group")
+			return fmt.Errorf("proto: ControlPlaneQuotaAccounting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ControllerDeployment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ControlPlaneQuotaAccounting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureToleranceType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -33819,115 +36664,12 @@ func (m *ControllerDeployment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			s := FailureToleranceType(dAtA[iNdEx:postIndex])
+			m.FailureToleranceType = &s
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InjectGardenKubeconfig", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.InjectGardenKubeconfig = &b
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ControllerDeploymentList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ControllerDeploymentList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ControllerDeploymentList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -33954,43 +36696,105 @@ func (m *ControllerDeploymentList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			if m.Resources == nil {
+				m.Resources = make(k8s_io_api_core_v1.ResourceList)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Items = append(m.Items, ControllerDeployment{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Resources[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34013,7 +36817,7 @@ func (m *ControllerDeploymentList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ControllerInstallation) Unmarshal(dAtA []byte) error {
+func (m *ControllerDeployment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34036,10 +36840,10 @@ func (m *ControllerInstallation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ControllerInstallation: wiretype end group for non-group")
+			return fmt.Errorf("proto: ControllerDeployment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ControllerInstallation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ControllerDeployment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -34077,9 +36881,9 @@ func (m *ControllerInstallation) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -34089,28 +36893,27 @@ func (m *ControllerInstallation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34137,10 +36940,31 @@ func (m *ControllerInstallation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InjectGardenKubeconfig", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.InjectGardenKubeconfig = &b
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -34162,7 +36986,7 @@ func (m *ControllerInstallation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ControllerInstallationList) Unmarshal(dAtA []byte) error {
+func (m *ControllerDeploymentList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34185,10 +37009,10 @@ func (m *ControllerInstallationList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ControllerInstallationList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ControllerDeploymentList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ControllerInstallationList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ControllerDeploymentList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -34253,7 +37077,7 @@ func (m *ControllerInstallationList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ControllerInstallation{})
+			m.Items = append(m.Items, ControllerDeployment{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -34279,7 +37103,7 @@ func (m *ControllerInstallationList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ControllerInstallationSpec) Unmarshal(dAtA []byte) error {
+func (m *ControllerInstallation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34302,15 +37126,15 @@ func (m *ControllerInstallationSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ControllerInstallationSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ControllerInstallation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ControllerInstallationSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ControllerInstallation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34337,13 +37161,13 @@ func (m *ControllerInstallationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RegistrationRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeedRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34370,13 +37194,13 @@ func (m *ControllerInstallationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SeedRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34403,10 +37227,7 @@ func (m *ControllerInstallationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeploymentRef == nil {
-				m.DeploymentRef = &v1.ObjectReference{}
-			}
-			if err := m.DeploymentRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -34431,7 +37252,7 @@ func (m *ControllerInstallationSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ControllerInstallationStatus) Unmarshal(dAtA []byte) error {
+func (m *ControllerInstallationList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34454,15 +37275,15 @@ func (m *ControllerInstallationStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ControllerInstallationStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: ControllerInstallationList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ControllerInstallationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ControllerInstallationList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34489,14 +37310,13 @@ func (m *ControllerInstallationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34523,10 +37343,8 @@ func (m *ControllerInstallationStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProviderStatus == nil {
-				m.ProviderStatus = &runtime.RawExtension{}
-			}
-			if err := m.ProviderStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, ControllerInstallation{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -34551,7 +37369,7 @@ func (m *ControllerInstallationStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ControllerRegistration) Unmarshal(dAtA []byte) error {
+func (m *ControllerInstallationSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34574,48 +37392,15 @@ func (m *ControllerRegistration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ControllerRegistration: wiretype end group for non-group")
+			return fmt.Errorf("proto: ControllerInstallationSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ControllerRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ControllerInstallationSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34642,7 +37427,76 @@ func (m *ControllerRegistration) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RegistrationRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeedRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SeedRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeploymentRef == nil {
+				m.DeploymentRef = &v1.ObjectReference{}
+			}
+			if err := m.DeploymentRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -34667,7 +37521,7 @@ func (m *ControllerRegistration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ControllerRegistrationDeployment) Unmarshal(dAtA []byte) error {
+func (m *ControllerInstallationStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34690,48 +37544,15 @@ func (m *ControllerRegistrationDeployment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ControllerRegistrationDeployment: wiretype end group for non-group")
+			return fmt.Errorf("proto: ControllerInstallationStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ControllerRegistrationDeployment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ControllerInstallationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := ControllerDeploymentPolicy(dAtA[iNdEx:postIndex])
-			m.Policy = &s
-			iNdEx = postIndex
-		case 4:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeedSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34758,16 +37579,14 @@ func (m *ControllerRegistrationDeployment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SeedSelector == nil {
-				m.SeedSelector = &v11.LabelSelector{}
-			}
-			if err := m.SeedSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentRefs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34794,8 +37613,10 @@ func (m *ControllerRegistrationDeployment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeploymentRefs = append(m.DeploymentRefs, DeploymentRef{})
-			if err := m.DeploymentRefs[len(m.DeploymentRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ProviderStatus == nil {
+				m.ProviderStatus = &runtime.RawExtension{}
+			}
+			if err := m.ProviderStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -34820,7 +37641,7 @@ func (m *ControllerRegistrationDeployment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ControllerRegistrationList) Unmarshal(dAtA []byte) error {
+func (m *ControllerRegistration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34843,15 +37664,15 @@ func (m *ControllerRegistrationList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ControllerRegistrationList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ControllerRegistration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ControllerRegistrationList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ControllerRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34878,13 +37699,13 @@ func (m *ControllerRegistrationList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34911,8 +37732,7 @@ func (m *ControllerRegistrationList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ControllerRegistration{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -34937,7 +37757,7 @@ func (m *ControllerRegistrationList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ControllerRegistrationSpec) Unmarshal(dAtA []byte) error {
+func (m *ControllerRegistrationDeployment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -34960,15 +37780,48 @@ func (m *ControllerRegistrationSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ControllerRegistrationSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ControllerRegistrationDeployment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ControllerRegistrationSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ControllerRegistrationDeployment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := ControllerDeploymentPolicy(dAtA[iNdEx:postIndex])
+			m.Policy = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeedSelector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -34995,14 +37848,16 @@ func (m *ControllerRegistrationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, ControllerResource{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SeedSelector == nil {
+				m.SeedSelector = &v11.LabelSelector{}
+			}
+			if err := m.SeedSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deployment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentRefs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35029,10 +37884,8 @@ func (m *ControllerRegistrationSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Deployment == nil {
-				m.Deployment = &ControllerRegistrationDeployment{}
-			}
-			if err := m.Deployment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DeploymentRefs = append(m.DeploymentRefs, DeploymentRef{})
+			if err := m.DeploymentRefs[len(m.DeploymentRefs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -35057,7 +37910,7 @@ func (m *ControllerRegistrationSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ControllerResource) Unmarshal(dAtA []byte) error {
+func (m *ControllerRegistrationList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35080,17 +37933,17 @@ func (m *ControllerResource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ControllerResource: wiretype end group for non-group")
+			return fmt.Errorf("proto: ControllerRegistrationList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ControllerResource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ControllerRegistrationList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -35100,59 +37953,296 @@ func (m *ControllerResource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReconcileTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ControllerRegistration{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ControllerRegistrationSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ControllerRegistrationSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ControllerRegistrationSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, ControllerResource{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deployment == nil {
+				m.Deployment = &ControllerRegistrationDeployment{}
+			}
+			if err := m.Deployment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ControllerResource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ControllerResource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ControllerResource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReconcileTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37395,6 +40485,42 @@ func (m *ExpirableVersion) Unmarshal(dAtA []byte) error {
 			s := VersionClassification(dAtA[iNdEx:postIndex])
 			m.Classification = &s
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Rollout == nil {
+				m.Rollout = &VersionRollout{}
+			}
+			if err := m.Rollout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -38903,6 +42029,78 @@ func (m *Hibernation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StayAwakeUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StayAwakeUntil == nil {
+				m.StayAwakeUntil = &v11.Time{}
+			}
+			if err := m.StayAwakeUntil.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoHibernation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoHibernation == nil {
+				m.AutoHibernation = &AutoHibernation{}
+			}
+			if err := m.AutoHibernation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -38924,7 +42122,7 @@ func (m *Hibernation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HibernationSchedule) Unmarshal(dAtA []byte) error {
+func (m *HibernationCalendar) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -38947,15 +42145,15 @@ func (m *HibernationSchedule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HibernationSchedule: wiretype end group for non-group")
+			return fmt.Errorf("proto: HibernationCalendar: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HibernationSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HibernationCalendar: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -38983,8 +42181,123 @@ func (m *HibernationSchedule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Start = &s
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Days", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Days = append(m.Days, HibernationCalendarDays{})
+			if err := m.Days[len(m.Days)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HibernationCalendarDays) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HibernationCalendarDays: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HibernationCalendarDays: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Begin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -39019,9 +42332,125 @@ func (m *HibernationSchedule) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.End = &s
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HibernationSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HibernationSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HibernationSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Start = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.End = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -39052,6 +42481,70 @@ func (m *HibernationSchedule) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.Location = &s
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipCalendars", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SkipCalendars = append(m.SkipCalendars, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HibernateCalendars", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HibernateCalendars = append(m.HibernateCalendars, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -44289,6 +47782,42 @@ func (m *LastMaintenance) Unmarshal(dAtA []byte) error {
 			s := string(dAtA[iNdEx:postIndex])
 			m.FailureReason = &s
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deferral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deferral == nil {
+				m.Deferral = &MaintenanceDeferral{}
+			}
+			if err := m.Deferral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -46746,7 +50275,7 @@ func (m *MaintenanceCredentialsAutoRotation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MaintenanceRotationConfig) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceDeferral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46769,15 +50298,47 @@ func (m *MaintenanceRotationConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MaintenanceRotationConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceDeferral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MaintenanceRotationConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceDeferral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RotationPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Freeze", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Freeze = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -46804,13 +50365,42 @@ func (m *MaintenanceRotationConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RotationPeriod == nil {
-				m.RotationPeriod = &v11.Duration{}
-			}
-			if err := m.RotationPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Until.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operations = append(m.Operations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -46832,7 +50422,7 @@ func (m *MaintenanceRotationConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceFreeze) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -46855,47 +50445,335 @@ func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MaintenanceTimeWindow: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceFreeze: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MaintenanceTimeWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Begin = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Begin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recurrence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := MaintenanceFreezeRecurrence(dAtA[iNdEx:postIndex])
+			m.Recurrence = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldForcedUpdates", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.HoldForcedUpdates = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaintenanceRotationConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaintenanceRotationConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaintenanceRotationConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotationPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RotationPeriod == nil {
+				m.RotationPeriod = &v11.Duration{}
+			}
+			if err := m.RotationPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaintenanceTimeWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaintenanceTimeWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Begin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48844,7 +52722,7 @@ func (m *NodeLocalDNS) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OCIRepository) Unmarshal(dAtA []byte) error {
+func (m *NotificationSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48867,15 +52745,15 @@ func (m *OCIRepository) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OCIRepository: wiretype end group for non-group")
+			return fmt.Errorf("proto: NotificationSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OCIRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NotificationSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48903,12 +52781,11 @@ func (m *OCIRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Ref = &s
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repository", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kinds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48936,12 +52813,11 @@ func (m *OCIRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Repository = &s
+			m.Kinds = append(m.Kinds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -48969,12 +52845,11 @@ func (m *OCIRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Tag = &s
+			m.Types = append(m.Types, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reasons", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49002,12 +52877,11 @@ func (m *OCIRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Digest = &s
+			m.Reasons = append(m.Reasons, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PullSecretRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Webhook", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -49034,10 +52908,7 @@ func (m *OCIRepository) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PullSecretRef == nil {
-				m.PullSecretRef = &v1.LocalObjectReference{}
-			}
-			if err := m.PullSecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Webhook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -49062,7 +52933,7 @@ func (m *OCIRepository) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
+func (m *NotificationWebhook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -49085,15 +52956,15 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OIDCConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: NotificationWebhook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OIDCConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NotificationWebhook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CABundle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49121,14 +52992,13 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.CABundle = &s
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientAuthentication", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadTemplate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49138,31 +53008,28 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientAuthentication == nil {
-				m.ClientAuthentication = &OpenIDConnectClientAuthentication{}
-			}
-			if err := m.ClientAuthentication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.PayloadTemplate = &s
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49191,11 +53058,61 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.ClientID = &s
+			m.ContentType = &s
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OCIRepository) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OCIRepository: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OCIRepository: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupsClaim", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ref", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49224,11 +53141,11 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.GroupsClaim = &s
+			m.Ref = &s
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupsPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repository", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49257,11 +53174,11 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.GroupsPrefix = &s
+			m.Repository = &s
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuerURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -49290,13 +53207,13 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.IssuerURL = &s
+			m.Tag = &s
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredClaims", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49306,124 +53223,30 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RequiredClaims == nil {
-				m.RequiredClaims = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.RequiredClaims[mapkey] = mapvalue
+			s := string(dAtA[iNdEx:postIndex])
+			m.Digest = &s
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SigningAlgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullSecretRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49433,27 +53256,441 @@ func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SigningAlgs = append(m.SigningAlgs, string(dAtA[iNdEx:postIndex]))
+			if m.PullSecretRef == nil {
+				m.PullSecretRef = &v1.LocalObjectReference{}
+			}
+			if err := m.PullSecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OIDCConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OIDCConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OIDCConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameClaim", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CABundle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.CABundle = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientAuthentication", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientAuthentication == nil {
+				m.ClientAuthentication = &OpenIDConnectClientAuthentication{}
+			}
+			if err := m.ClientAuthentication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ClientID = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupsClaim", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.GroupsClaim = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupsPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.GroupsPrefix = &s
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.IssuerURL = &s
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RequiredClaims == nil {
+				m.RequiredClaims = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RequiredClaims[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningAlgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningAlgs = append(m.SigningAlgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameClaim", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -50251,6 +54488,92 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProjectLifecycle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectLifecycle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectLifecycle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTimestamp == nil {
+				m.ExpirationTimestamp = &v11.Time{}
+			}
+			if err := m.ExpirationTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ProjectList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -50819,61 +55142,11 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceFreezes", wireType)
 			}
-			m.ObservedGeneration = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50883,16 +55156,31 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObservedGeneration |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaintenanceFreezes = append(m.MaintenanceFreezes, MaintenanceFreeze{})
+			if err := m.MaintenanceFreezes[len(m.MaintenanceFreezes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HibernationCalendars", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -50902,27 +55190,29 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = ProjectPhase(dAtA[iNdEx:postIndex])
+			m.HibernationCalendars = append(m.HibernationCalendars, HibernationCalendar{})
+			if err := m.HibernationCalendars[len(m.HibernationCalendars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StaleSinceTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoHibernation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -50949,16 +55239,16 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StaleSinceTimestamp == nil {
-				m.StaleSinceTimestamp = &v11.Time{}
+			if m.AutoHibernation == nil {
+				m.AutoHibernation = &AutoHibernation{}
 			}
-			if err := m.StaleSinceTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AutoHibernation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StaleAutoDeleteTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lifecycle", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -50985,16 +55275,16 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StaleAutoDeleteTimestamp == nil {
-				m.StaleAutoDeleteTimestamp = &v11.Time{}
+			if m.Lifecycle == nil {
+				m.Lifecycle = &ProjectLifecycle{}
 			}
-			if err := m.StaleAutoDeleteTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Lifecycle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastActivityTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Notifications", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51021,10 +55311,8 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastActivityTimestamp == nil {
-				m.LastActivityTimestamp = &v11.Time{}
-			}
-			if err := m.LastActivityTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Notifications = append(m.Notifications, NotificationSubscription{})
+			if err := m.Notifications[len(m.Notifications)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -51049,7 +55337,7 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectTolerations) Unmarshal(dAtA []byte) error {
+func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -51072,15 +55360,66 @@ func (m *ProjectTolerations) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectTolerations: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectTolerations: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Defaults", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = ProjectPhase(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleSinceTimestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51107,14 +55446,278 @@ func (m *ProjectTolerations) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Defaults = append(m.Defaults, Toleration{})
-			if err := m.Defaults[len(m.Defaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.StaleSinceTimestamp == nil {
+				m.StaleSinceTimestamp = &v11.Time{}
+			}
+			if err := m.StaleSinceTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleAutoDeleteTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StaleAutoDeleteTimestamp == nil {
+				m.StaleAutoDeleteTimestamp = &v11.Time{}
+			}
+			if err := m.StaleAutoDeleteTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastActivityTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastActivityTimestamp == nil {
+				m.LastActivityTimestamp = &v11.Time{}
+			}
+			if err := m.LastActivityTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArchivedTimestamp == nil {
+				m.ArchivedTimestamp = &v11.Time{}
+			}
+			if err := m.ArchivedTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedAutoDeleteTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArchivedAutoDeleteTimestamp == nil {
+				m.ArchivedAutoDeleteTimestamp = &v11.Time{}
+			}
+			if err := m.ArchivedAutoDeleteTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectTolerations) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectTolerations: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectTolerations: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Defaults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Defaults = append(m.Defaults, Toleration{})
+			if err := m.Defaults[len(m.Defaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51486,92 +56089,9 @@ func (m *Quota) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuotaList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuotaList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuotaList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51598,8 +56118,7 @@ func (m *QuotaList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Quota{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -51624,7 +56143,7 @@ func (m *QuotaList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
+func (m *QuotaAccounting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -51647,17 +56166,17 @@ func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuotaSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuotaAccounting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuotaSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuotaAccounting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterLifetimeDays", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControlPlanes", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -51667,15 +56186,29 @@ func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ClusterLifetimeDays = &v
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ControlPlanes = append(m.ControlPlanes, ControlPlaneQuotaAccounting{})
+			if err := m.ControlPlanes[len(m.ControlPlanes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LoadBalancer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51702,8 +56235,8 @@ func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metrics == nil {
-				m.Metrics = make(k8s_io_api_core_v1.ResourceList)
+			if m.LoadBalancer == nil {
+				m.LoadBalancer = make(k8s_io_api_core_v1.ResourceList)
 			}
 			var mapkey k8s_io_api_core_v1.ResourceName
 			mapvalue := &resource.Quantity{}
@@ -51800,11 +56333,981 @@ func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Metrics[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			m.LoadBalancer[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Quota{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaShootUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaShootUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaShootUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Used == nil {
+				m.Used = make(k8s_io_api_core_v1.ResourceList)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Used[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterLifetimeDays", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClusterLifetimeDays = &v
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metrics == nil {
+				m.Metrics = make(k8s_io_api_core_v1.ResourceList)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metrics[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftMetrics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SoftMetrics == nil {
+				m.SoftMetrics = make(k8s_io_api_core_v1.ResourceList)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SoftMetrics[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GracePeriod == nil {
+				m.GracePeriod = &v11.Duration{}
+			}
+			if err := m.GracePeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Used == nil {
+				m.Used = make(k8s_io_api_core_v1.ResourceList)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Used[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shoots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shoots = append(m.Shoots, QuotaShootUsage{})
+			if err := m.Shoots[len(m.Shoots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitsExceededSince", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -51831,7 +57334,10 @@ func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.LimitsExceededSince == nil {
+				m.LimitsExceededSince = &v11.Time{}
+			}
+			if err := m.LimitsExceededSince.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -52383,6 +57889,124 @@ func (m *ResourceWatchCacheSize) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RolloutWave) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutWave: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutWave: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purposes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purposes = append(m.Purposes, ShootPurpose(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoakDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SoakDuration == nil {
+				m.SoakDuration = &v11.Duration{}
+			}
+			if err := m.SoakDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SSHAccess) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -53928,18 +59552,261 @@ func (m *SeedProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProviderConfig == nil {
-				m.ProviderConfig = &runtime.RawExtension{}
+			if m.ProviderConfig == nil {
+				m.ProviderConfig = &runtime.RawExtension{}
+			}
+			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zones = append(m.Zones, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SeedResourceUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeedResourceUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeedResourceUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControlPlaneRequests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ControlPlaneRequests == nil {
+				m.ControlPlaneRequests = make(k8s_io_api_core_v1.ResourceList)
 			}
-			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.ControlPlaneRequests[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAllocatable", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -53949,55 +59816,120 @@ func (m *SeedProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Region = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Zones", wireType)
+			if m.NodeAllocatable == nil {
+				m.NodeAllocatable = make(k8s_io_api_core_v1.ResourceList)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Zones = append(m.Zones, string(dAtA[iNdEx:postIndex]))
+			m.NodeAllocatable[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -57203,6 +63135,42 @@ func (m *SeedStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceUsage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceUsage == nil {
+				m.ResourceUsage = &SeedResourceUsage{}
+			}
+			if err := m.ResourceUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -58485,6 +64453,40 @@ func (m *ShootCredentials) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertificateExpirations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CertificateExpirations = append(m.CertificateExpirations, CertificateExpiration{})
+			if err := m.CertificateExpirations[len(m.CertificateExpirations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -61237,83 +67239,471 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncryptedResources = append(m.EncryptedResources, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Networking", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Networking == nil {
-				m.Networking = &NetworkingStatus{}
-			}
-			if err := m.Networking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 20:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InPlaceUpdates", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.InPlaceUpdates == nil {
-				m.InPlaceUpdates = &InPlaceUpdatesStatus{}
-			}
-			if err := m.InPlaceUpdates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EncryptedResources = append(m.EncryptedResources, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Networking", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Networking == nil {
+				m.Networking = &NetworkingStatus{}
+			}
+			if err := m.Networking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InPlaceUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InPlaceUpdates == nil {
+				m.InPlaceUpdates = &InPlaceUpdatesStatus{}
+			}
+			if err := m.InPlaceUpdates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManualWorkerPoolRollout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ManualWorkerPoolRollout == nil {
+				m.ManualWorkerPoolRollout = &ManualWorkerPoolRollout{}
+			}
+			if err := m.ManualWorkerPoolRollout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpcomingMaintenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpcomingMaintenance == nil {
+				m.UpcomingMaintenance = &UpcomingMaintenance{}
+			}
+			if err := m.UpcomingMaintenance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastActivityTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastActivityTime == nil {
+				m.LastActivityTime = &v11.Time{}
+			}
+			if err := m.LastActivityTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShootTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShootTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShootTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StructuredAuthentication) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StructuredAuthentication: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StructuredAuthentication: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigMapName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StructuredAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StructuredAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StructuredAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigMapName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 21:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ManualWorkerPoolRollout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kubeconfigs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -61340,10 +67730,8 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ManualWorkerPoolRollout == nil {
-				m.ManualWorkerPoolRollout = &ManualWorkerPoolRollout{}
-			}
-			if err := m.ManualWorkerPoolRollout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Kubeconfigs = append(m.Kubeconfigs, AuthorizerKubeconfigReference{})
+			if err := m.Kubeconfigs[len(m.Kubeconfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -61368,7 +67756,7 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShootTemplate) Unmarshal(dAtA []byte) error {
+func (m *SystemComponents) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -61391,15 +67779,15 @@ func (m *ShootTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShootTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: SystemComponents: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShootTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SystemComponents: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoreDNS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -61426,13 +67814,16 @@ func (m *ShootTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CoreDNS == nil {
+				m.CoreDNS = &CoreDNS{}
+			}
+			if err := m.CoreDNS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeLocalDNS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -61459,7 +67850,10 @@ func (m *ShootTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.NodeLocalDNS == nil {
+				m.NodeLocalDNS = &NodeLocalDNS{}
+			}
+			if err := m.NodeLocalDNS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -61484,7 +67878,7 @@ func (m *ShootTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StructuredAuthentication) Unmarshal(dAtA []byte) error {
+func (m *Toleration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -61507,15 +67901,15 @@ func (m *StructuredAuthentication) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StructuredAuthentication: wiretype end group for non-group")
+			return fmt.Errorf("proto: Toleration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StructuredAuthentication: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Toleration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -61543,7 +67937,40 @@ func (m *StructuredAuthentication) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigMapName = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Value = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -61566,7 +67993,7 @@ func (m *StructuredAuthentication) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StructuredAuthorization) Unmarshal(dAtA []byte) error {
+func (m *UpcomingMaintenance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -61589,17 +68016,17 @@ func (m *StructuredAuthorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StructuredAuthorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpcomingMaintenance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StructuredAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpcomingMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -61609,29 +68036,30 @@ func (m *StructuredAuthorization) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigMapName = string(dAtA[iNdEx:postIndex])
+			if err := m.WindowStart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kubeconfigs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -61641,79 +68069,59 @@ func (m *StructuredAuthorization) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kubeconfigs = append(m.Kubeconfigs, AuthorizerKubeconfigReference{})
-			if err := m.Kubeconfigs[len(m.Kubeconfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Operations = append(m.Operations, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedOperations", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SystemComponents) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SystemComponents: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SystemComponents: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.FailedOperations = append(m.FailedOperations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoreDNS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deferral", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -61740,16 +68148,16 @@ func (m *SystemComponents) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CoreDNS == nil {
-				m.CoreDNS = &CoreDNS{}
+			if m.Deferral == nil {
+				m.Deferral = &MaintenanceDeferral{}
 			}
-			if err := m.CoreDNS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deferral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeLocalDNS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -61776,10 +68184,7 @@ func (m *SystemComponents) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeLocalDNS == nil {
-				m.NodeLocalDNS = &NodeLocalDNS{}
-			}
-			if err := m.NodeLocalDNS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -61804,7 +68209,7 @@ func (m *SystemComponents) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Toleration) Unmarshal(dAtA []byte) error {
+func (m *VersionRollout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -61827,17 +68232,17 @@ func (m *Toleration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Toleration: wiretype end group for non-group")
+			return fmt.Errorf("proto: VersionRollout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Toleration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VersionRollout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -61847,29 +68252,30 @@ func (m *Toleration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Waves", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -61879,24 +68285,25 @@ func (m *Toleration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.Value = &s
+			m.Waves = append(m.Waves, RolloutWave{})
+			if err := m.Waves[len(m.Waves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
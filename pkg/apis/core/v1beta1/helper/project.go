@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// MaintenanceFreezeActiveUntil returns the end of the occurrence of the given maintenance freeze which contains the
+// given point in time. The second return value is false if the freeze is not active at that point in time.
+func MaintenanceFreezeActiveUntil(freeze gardencorev1beta1.MaintenanceFreeze, now time.Time) (time.Time, bool) {
+	var (
+		begin    = freeze.Begin.Time
+		duration = freeze.End.Sub(begin)
+		offsets  = []int{0}
+	)
+
+	if freeze.Recurrence != nil && *freeze.Recurrence == gardencorev1beta1.MaintenanceFreezeRecurrenceYearly {
+		// Yearly freezes are shorter than one year, hence only the occurrences starting in the previous and in the
+		// current year can contain the given point in time.
+		offsets = []int{now.Year() - begin.Year() - 1, now.Year() - begin.Year()}
+	}
+
+	for _, offset := range offsets {
+		if offset < 0 {
+			continue
+		}
+
+		occurrenceBegin := begin.AddDate(offset, 0, 0)
+		occurrenceEnd := occurrenceBegin.Add(duration)
+		if !now.Before(occurrenceBegin) && now.Before(occurrenceEnd) {
+			return occurrenceEnd, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// GetActiveMaintenanceFreeze returns the maintenance freeze out of the given list which is active at the given point in
+// time. If multiple freezes are active, the one lasting longest is returned. The second return value is the end of
+// the active freeze. If no freeze is active, nil is returned.
+func GetActiveMaintenanceFreeze(freezes []gardencorev1beta1.MaintenanceFreeze, now time.Time) (*gardencorev1beta1.MaintenanceFreeze, time.Time) {
+	var (
+		active *gardencorev1beta1.MaintenanceFreeze
+		until  time.Time
+	)
+
+	for i := range freezes {
+		if end, ok := MaintenanceFreezeActiveUntil(freezes[i], now); ok && end.After(until) {
+			active, until = &freezes[i], end
+		}
+	}
+
+	return active, until
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+)
+
+var _ = Describe("Helper", func() {
+	var (
+		date = func(year int, month time.Month, day int) time.Time {
+			return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+		}
+
+		yearEnd = gardencorev1beta1.MaintenanceFreeze{
+			Name:       "year-end",
+			Begin:      metav1.NewTime(date(2025, time.December, 20)),
+			End:        metav1.NewTime(date(2026, time.January, 6)),
+			Recurrence: ptr.To(gardencorev1beta1.MaintenanceFreezeRecurrenceYearly),
+		}
+		release = gardencorev1beta1.MaintenanceFreeze{
+			Name:  "release",
+			Begin: metav1.NewTime(date(2026, time.December, 1)),
+			End:   metav1.NewTime(date(2027, time.January, 10)),
+		}
+	)
+
+	DescribeTable("#MaintenanceFreezeActiveUntil",
+		func(freeze gardencorev1beta1.MaintenanceFreeze, now time.Time, expectedUntil time.Time, expectedActive bool) {
+			until, active := MaintenanceFreezeActiveUntil(freeze, now)
+			Expect(active).To(Equal(expectedActive))
+			Expect(until).To(Equal(expectedUntil))
+		},
+
+		Entry("before a one-time freeze", release, date(2026, time.November, 30), time.Time{}, false),
+		Entry("at the begin of a one-time freeze", release, date(2026, time.December, 1), date(2027, time.January, 10), true),
+		Entry("at the end of a one-time freeze", release, date(2027, time.January, 10), time.Time{}, false),
+		Entry("before the first occurrence of a yearly freeze", yearEnd, date(2024, time.December, 24), time.Time{}, false),
+		Entry("during the first occurrence of a yearly freeze", yearEnd, date(2025, time.December, 24), date(2026, time.January, 6), true),
+		Entry("between occurrences of a yearly freeze", yearEnd, date(2026, time.June, 1), time.Time{}, false),
+		Entry("during a later occurrence of a yearly freeze (begin year)", yearEnd, date(2027, time.December, 31), date(2028, time.January, 6), true),
+		Entry("during a later occurrence of a yearly freeze (end year)", yearEnd, date(2028, time.January, 2), date(2028, time.January, 6), true),
+	)
+
+	Describe("#GetActiveMaintenanceFreeze", func() {
+		It("should return nil if no freeze is active", func() {
+			freeze, until := GetActiveMaintenanceFreeze([]gardencorev1beta1.MaintenanceFreeze{yearEnd, release}, date(2026, time.June, 1))
+			Expect(freeze).To(BeNil())
+			Expect(until).To(BeZero())
+		})
+
+		It("should return the active freeze lasting longest", func() {
+			freeze, until := GetActiveMaintenanceFreeze([]gardencorev1beta1.MaintenanceFreeze{yearEnd, release}, date(2026, time.December, 24))
+			Expect(freeze).To(PointTo(Equal(release)))
+			Expect(until).To(Equal(date(2027, time.January, 10)))
+		})
+	})
+})
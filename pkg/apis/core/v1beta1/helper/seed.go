@@ -169,6 +169,26 @@ func CalculateSeedUsage(shootList []*gardencorev1beta1.Shoot) map[string]int {
 	return m
 }
 
+// SeedResourceUtilization returns the utilization of the given resource of the seed in percent, i.e., the share of the
+// nodes' allocatable resources which is requested by the shoot control planes. The second return value is false if
+// the seed does not publish the resource usage for the given resource.
+func SeedResourceUtilization(seed *gardencorev1beta1.Seed, resourceName corev1.ResourceName) (int64, bool) {
+	if seed.Status.ResourceUsage == nil {
+		return 0, false
+	}
+
+	requests, ok := seed.Status.ResourceUsage.ControlPlaneRequests[resourceName]
+	if !ok {
+		return 0, false
+	}
+	allocatable, ok := seed.Status.ResourceUsage.NodeAllocatable[resourceName]
+	if !ok || allocatable.MilliValue() <= 0 {
+		return 0, false
+	}
+
+	return requests.MilliValue() * 100 / allocatable.MilliValue(), true
+}
+
 // HasShootReconciliationsDisabledAnnotation returns true if shoot reconciliations are currently disabled for the given seed.
 func HasShootReconciliationsDisabledAnnotation(seed *gardencorev1beta1.Seed) bool {
 	if seed == nil {
@@ -272,6 +272,45 @@ var _ = Describe("Helper", func() {
 		),
 	)
 
+	Describe("#SeedResourceUtilization", func() {
+		var seed *gardencorev1beta1.Seed
+
+		BeforeEach(func() {
+			seed = &gardencorev1beta1.Seed{
+				Status: gardencorev1beta1.SeedStatus{
+					ResourceUsage: &gardencorev1beta1.SeedResourceUsage{
+						ControlPlaneRequests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("3"),
+							corev1.ResourceMemory: resource.MustParse("16Gi"),
+						},
+						NodeAllocatable: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("4"),
+							corev1.ResourceMemory: resource.MustParse("0"),
+						},
+					},
+				},
+			}
+		})
+
+		It("should return the utilization in percent", func() {
+			utilization, ok := SeedResourceUtilization(seed, corev1.ResourceCPU)
+			Expect(ok).To(BeTrue())
+			Expect(utilization).To(Equal(int64(75)))
+		})
+
+		It("should return false if the allocatable resources are zero", func() {
+			_, ok := SeedResourceUtilization(seed, corev1.ResourceMemory)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should return false if the resource usage is not published", func() {
+			seed.Status.ResourceUsage = nil
+
+			_, ok := SeedResourceUtilization(seed, corev1.ResourceCPU)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
 	Describe("#CalculateSeedUsage", func() {
 		type shootCase struct {
 			specSeedName, statusSeedName string
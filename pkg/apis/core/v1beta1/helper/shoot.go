@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"slices"
 	"strconv"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
@@ -28,6 +29,31 @@ func HibernationIsEnabled(shoot *gardencorev1beta1.Shoot) bool {
 	return shoot.Spec.Hibernation != nil && shoot.Spec.Hibernation.Enabled != nil && *shoot.Spec.Hibernation.Enabled
 }
 
+// DefaultAutoHibernationIdleTimeout is the default duration without any observed activity after which a shoot is
+// hibernated automatically.
+const DefaultAutoHibernationIdleTimeout = 4 * time.Hour
+
+// GetAutoHibernationIdleTimeout returns the idle timeout after which the given shoot is hibernated automatically. The
+// configuration of the shoot takes precedence over the one of the given project, which may be nil. The second return
+// value is false if automatic hibernation is not enabled for the shoot.
+func GetAutoHibernationIdleTimeout(shoot *gardencorev1beta1.Shoot, project *gardencorev1beta1.Project) (time.Duration, bool) {
+	var autoHibernation *gardencorev1beta1.AutoHibernation
+	if shoot.Spec.Hibernation != nil && shoot.Spec.Hibernation.AutoHibernation != nil {
+		autoHibernation = shoot.Spec.Hibernation.AutoHibernation
+	} else if project != nil {
+		autoHibernation = project.Spec.AutoHibernation
+	}
+
+	if autoHibernation == nil || !autoHibernation.Enabled {
+		return 0, false
+	}
+
+	if autoHibernation.IdleTimeout == nil {
+		return DefaultAutoHibernationIdleTimeout, true
+	}
+	return autoHibernation.IdleTimeout.Duration, true
+}
+
 // ShootWantsClusterAutoscaler checks if the given Shoot needs a cluster autoscaler.
 // This is determined by checking whether one of the Shoot workers has a different
 // Maximum than Minimum.
@@ -47,6 +47,48 @@ var _ = Describe("Helper", func() {
 		}, true),
 	)
 
+	Describe("#GetAutoHibernationIdleTimeout", func() {
+		var (
+			shoot   *gardencorev1beta1.Shoot
+			project *gardencorev1beta1.Project
+		)
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{}
+			project = &gardencorev1beta1.Project{}
+		})
+
+		It("should return false if automatic hibernation is not configured", func() {
+			_, enabled := GetAutoHibernationIdleTimeout(shoot, project)
+			Expect(enabled).To(BeFalse())
+
+			_, enabled = GetAutoHibernationIdleTimeout(shoot, nil)
+			Expect(enabled).To(BeFalse())
+		})
+
+		It("should return the default idle timeout if it is enabled in the project", func() {
+			project.Spec.AutoHibernation = &gardencorev1beta1.AutoHibernation{Enabled: true}
+
+			idleTimeout, enabled := GetAutoHibernationIdleTimeout(shoot, project)
+			Expect(enabled).To(BeTrue())
+			Expect(idleTimeout).To(Equal(DefaultAutoHibernationIdleTimeout))
+		})
+
+		It("should prefer the configuration of the shoot", func() {
+			project.Spec.AutoHibernation = &gardencorev1beta1.AutoHibernation{Enabled: true}
+			shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{AutoHibernation: &gardencorev1beta1.AutoHibernation{Enabled: false}}
+
+			_, enabled := GetAutoHibernationIdleTimeout(shoot, project)
+			Expect(enabled).To(BeFalse())
+
+			shoot.Spec.Hibernation.AutoHibernation = &gardencorev1beta1.AutoHibernation{Enabled: true, IdleTimeout: &metav1.Duration{Duration: time.Hour}}
+
+			idleTimeout, enabled := GetAutoHibernationIdleTimeout(shoot, project)
+			Expect(enabled).To(BeTrue())
+			Expect(idleTimeout).To(Equal(time.Hour))
+		})
+	})
+
 	DescribeTable("#ShootWantsClusterAutoscaler",
 		func(shoot *gardencorev1beta1.Shoot, wantsAutoscaler bool) {
 			actualWantsAutoscaler, err := ShootWantsClusterAutoscaler(shoot)
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -95,6 +96,10 @@ type CloudProfileSpec struct {
 	// During maintenance upgrades, the image that matches most capabilities will be selected.
 	// +optional
 	MachineCapabilities []CapabilityDefinition `json:"machineCapabilities,omitempty" protobuf:"bytes,12,rep,name=machineCapabilities"`
+	// QuotaAccounting configures the resources which are accounted against Quotas for the control planes and load
+	// balancers of Shoot clusters using this CloudProfile, in addition to the resources of their worker pools.
+	// +optional
+	QuotaAccounting *QuotaAccounting `json:"quotaAccounting,omitempty" protobuf:"bytes,13,opt,name=quotaAccounting"`
 }
 
 // SeedSelector contains constraints for selecting seed to be usable for shoots using a profile
@@ -170,6 +175,29 @@ type ExpirableVersion struct {
 	// To get the currently valid classification, use CurrentLifecycleClassification().
 	// +optional
 	Classification *VersionClassification `json:"classification,omitempty" protobuf:"bytes,3,opt,name=classification,casttype=VersionClassification"`
+	// Rollout configures a staged rollout of this version to shoots grouped by their purpose. If unset, the version is
+	// offered to all shoots at once.
+	// +optional
+	Rollout *VersionRollout `json:"rollout,omitempty" protobuf:"bytes,4,opt,name=rollout"`
+}
+
+// VersionRollout contains the configuration for a staged rollout of a version in waves.
+type VersionRollout struct {
+	// StartTime is the time at which the version is offered to the first wave.
+	StartTime metav1.Time `json:"startTime" protobuf:"bytes,1,opt,name=startTime"`
+	// Waves is the ordered list of rollout waves. Shoots whose purpose is not part of any wave belong to the last wave.
+	Waves []RolloutWave `json:"waves" protobuf:"bytes,2,rep,name=waves"`
+}
+
+// RolloutWave is a group of shoots to which a version is offered at the same time.
+type RolloutWave struct {
+	// Purposes are the purposes of the shoots belonging to this wave.
+	Purposes []ShootPurpose `json:"purposes" protobuf:"bytes,1,rep,name=purposes,casttype=ShootPurpose"`
+	// SoakDuration is the duration for which the version must have been offered to the previous wave, and for which the
+	// shoots of the previous waves running the version must have reported healthy conditions, before the version is
+	// offered to this wave. It is ignored for the first wave.
+	// +optional
+	SoakDuration *metav1.Duration `json:"soakDuration,omitempty" protobuf:"bytes,2,opt,name=soakDuration"`
 }
 
 // MachineType contains certain properties of a machine type.
@@ -289,6 +317,28 @@ type Limits struct {
 	MaxNodesTotal *int32 `json:"maxNodesTotal,omitempty" protobuf:"varint,1,opt,name=maxNodesTotal"`
 }
 
+// QuotaAccounting configures the resources which are accounted against Quotas for Shoot clusters.
+type QuotaAccounting struct {
+	// ControlPlanes are the resources accounted for the control plane of a Shoot depending on its failure tolerance.
+	// +optional
+	ControlPlanes []ControlPlaneQuotaAccounting `json:"controlPlanes,omitempty" protobuf:"bytes,1,rep,name=controlPlanes"`
+	// LoadBalancer are the resources accounted for each load balancer deducible from the Shoot specification, i.e., one
+	// for the Shoot plus one if the nginx-ingress addon is enabled. LoadBalancer services created in the Shoot cluster
+	// are not accounted.
+	// +optional
+	LoadBalancer corev1.ResourceList `json:"loadBalancer,omitempty" protobuf:"bytes,2,rep,name=loadBalancer,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+}
+
+// ControlPlaneQuotaAccounting contains the resources accounted for a control plane with a certain failure tolerance.
+type ControlPlaneQuotaAccounting struct {
+	// FailureToleranceType is the failure tolerance type of the control plane. If not set, the resources are accounted
+	// for control planes which are not highly available.
+	// +optional
+	FailureToleranceType *FailureToleranceType `json:"failureToleranceType,omitempty" protobuf:"bytes,1,opt,name=failureToleranceType,casttype=FailureToleranceType"`
+	// Resources are the resources accounted for the control plane.
+	Resources corev1.ResourceList `json:"resources" protobuf:"bytes,2,rep,name=resources,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+}
+
 const (
 	// VolumeClassStandard is a constant for the standard volume class.
 	VolumeClassStandard string = "standard"
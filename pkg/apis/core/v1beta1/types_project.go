@@ -79,6 +79,25 @@ type ProjectSpec struct {
 	// DualApprovalForDeletion contains configuration for the dual approval concept for resource deletion.
 	// +optional
 	DualApprovalForDeletion []DualApprovalForDeletion `json:"dualApprovalForDeletion,omitempty" protobuf:"bytes,8,opt,name=dualApprovalForDeletion"`
+	// MaintenanceFreezes is a list of periods during which automatic version updates and credentials rotations are not
+	// performed for the shoots of this project.
+	// +optional
+	MaintenanceFreezes []MaintenanceFreeze `json:"maintenanceFreezes,omitempty" protobuf:"bytes,9,rep,name=maintenanceFreezes"`
+	// HibernationCalendars is a list of named calendars which can be referenced by the hibernation schedules of the
+	// shoots of this project.
+	// +optional
+	HibernationCalendars []HibernationCalendar `json:"hibernationCalendars,omitempty" protobuf:"bytes,10,rep,name=hibernationCalendars"`
+	// AutoHibernation contains the configuration for hibernating the shoots of this project automatically when they are
+	// idle. It can be overridden in the shoots.
+	// +optional
+	AutoHibernation *AutoHibernation `json:"autoHibernation,omitempty" protobuf:"bytes,11,opt,name=autoHibernation"`
+	// Lifecycle contains the lifecycle policy of this project.
+	// +optional
+	Lifecycle *ProjectLifecycle `json:"lifecycle,omitempty" protobuf:"bytes,12,opt,name=lifecycle"`
+	// Notifications is a list of subscriptions which route selected events of this project and its shoots to external
+	// sinks.
+	// +optional
+	Notifications []NotificationSubscription `json:"notifications,omitempty" protobuf:"bytes,13,rep,name=notifications"`
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -98,6 +117,18 @@ type ProjectStatus struct {
 	// LastActivityTimestamp contains the timestamp from the last activity performed in this project.
 	// +optional
 	LastActivityTimestamp *metav1.Time `json:"lastActivityTimestamp,omitempty" protobuf:"bytes,5,opt,name=lastActivityTimestamp"`
+	// ArchivedTimestamp contains the timestamp when the project was archived because it expired or was inactive.
+	// +optional
+	ArchivedTimestamp *metav1.Time `json:"archivedTimestamp,omitempty" protobuf:"bytes,6,opt,name=archivedTimestamp"`
+	// ArchivedAutoDeleteTimestamp contains the timestamp when the project will be automatically deleted because it is
+	// archived.
+	// +optional
+	ArchivedAutoDeleteTimestamp *metav1.Time `json:"archivedAutoDeleteTimestamp,omitempty" protobuf:"bytes,7,opt,name=archivedAutoDeleteTimestamp"`
+	// Conditions represents the latest available observations of a Project's current state.
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,8,rep,name=conditions"`
 }
 
 // ProjectMember is a member of a project.
@@ -152,6 +183,96 @@ type DualApprovalForDeletion struct {
 	IncludeServiceAccounts *bool `json:"includeServiceAccounts,omitempty" protobuf:"varint,3,opt,name=includeServiceAccounts"`
 }
 
+// MaintenanceFreeze is a period during which automatic updates of Kubernetes and machine image versions as well as
+// automatic credentials rotations are not performed for shoots.
+type MaintenanceFreeze struct {
+	// Name is the name of the maintenance freeze.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Begin is the start (inclusive) of the maintenance freeze.
+	Begin metav1.Time `json:"begin" protobuf:"bytes,2,opt,name=begin"`
+	// End is the end (exclusive) of the maintenance freeze.
+	End metav1.Time `json:"end" protobuf:"bytes,3,opt,name=end"`
+	// Recurrence defines whether the maintenance freeze repeats. Only `Yearly` is supported. If unset, the maintenance
+	// freeze is active only once.
+	// +optional
+	Recurrence *MaintenanceFreezeRecurrence `json:"recurrence,omitempty" protobuf:"bytes,4,opt,name=recurrence,casttype=MaintenanceFreezeRecurrence"`
+	// HoldForcedUpdates defines whether forceful updates of expired Kubernetes and machine image versions are held back
+	// during the maintenance freeze as well. Defaults to false, i.e., expired versions are still updated. It can only be
+	// set for landscape-wide maintenance freezes in the gardener-controller-manager configuration, not in Projects.
+	// +optional
+	HoldForcedUpdates *bool `json:"holdForcedUpdates,omitempty" protobuf:"varint,5,opt,name=holdForcedUpdates"`
+}
+
+// MaintenanceFreezeRecurrence defines how a maintenance freeze repeats.
+type MaintenanceFreezeRecurrence string
+
+const (
+	// MaintenanceFreezeRecurrenceYearly is a constant for a maintenance freeze repeating every year.
+	MaintenanceFreezeRecurrenceYearly MaintenanceFreezeRecurrence = "Yearly"
+)
+
+// HibernationCalendar is a named list of days, e.g., public holidays or company shutdown days, which can be referenced
+// by hibernation schedules of shoots.
+type HibernationCalendar struct {
+	// Name is the name of the hibernation calendar.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Days is a list of days or ranges of days of the calendar.
+	Days []HibernationCalendarDays `json:"days" protobuf:"bytes,2,rep,name=days"`
+}
+
+// HibernationCalendarDays is a single day or a range of days. Days are evaluated in the location of the hibernation
+// schedule referencing the calendar.
+type HibernationCalendarDays struct {
+	// Begin is the first day of the range in the format `YYYY-MM-DD`.
+	Begin string `json:"begin" protobuf:"bytes,1,opt,name=begin"`
+	// End is the last day (inclusive) of the range in the format `YYYY-MM-DD`. If unset, the range only consists of the
+	// day of Begin.
+	// +optional
+	End *string `json:"end,omitempty" protobuf:"bytes,2,opt,name=end"`
+}
+
+// ProjectLifecycle contains the lifecycle policy of a project.
+type ProjectLifecycle struct {
+	// ExpirationTimestamp is the point in time at which the project expires. Expired projects are archived, i.e., their
+	// shoots are hibernated and no new shoots can be created, and are deleted after the archival period. The archival
+	// can be reverted by extending or removing the expiration timestamp before the project is deleted.
+	// +optional
+	ExpirationTimestamp *metav1.Time `json:"expirationTimestamp,omitempty" protobuf:"bytes,1,opt,name=expirationTimestamp"`
+}
+
+// NotificationSubscription routes selected events of a project and its shoots to a sink.
+type NotificationSubscription struct {
+	// Name is the name of the subscription.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Kinds restricts the subscription to events about objects of the given kinds (`Project` or `Shoot`). Events about
+	// all kinds are selected if empty.
+	// +optional
+	Kinds []string `json:"kinds,omitempty" protobuf:"bytes,2,rep,name=kinds"`
+	// Types restricts the subscription to events of the given types (`Normal` or `Warning`). Events of all types are
+	// selected if empty.
+	// +optional
+	Types []string `json:"types,omitempty" protobuf:"bytes,3,rep,name=types"`
+	// Reasons restricts the subscription to events with the given reasons. Events with all reasons are selected if empty.
+	// +optional
+	Reasons []string `json:"reasons,omitempty" protobuf:"bytes,4,rep,name=reasons"`
+	// Webhook is the HTTP webhook to which the selected events are sent.
+	Webhook NotificationWebhook `json:"webhook" protobuf:"bytes,5,opt,name=webhook"`
+}
+
+// NotificationWebhook is an HTTP webhook to which events are sent.
+type NotificationWebhook struct {
+	// URL is the URL of the webhook. Events are sent with HTTP POST requests.
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// PayloadTemplate is a Go template for the request body. It can access the fields `.Project`, `.Namespace`,
+	// `.Kind`, `.Name`, `.Type`, `.Reason`, `.Message`, `.Count` and `.Timestamp` of the event. The `json` function
+	// renders a value as JSON, e.g., to quote strings. If not set, the event is sent as a JSON object with these fields.
+	// +optional
+	PayloadTemplate *string `json:"payloadTemplate,omitempty" protobuf:"bytes,2,opt,name=payloadTemplate"`
+	// ContentType is the content type of the request body (defaults to `application/json`).
+	// +optional
+	ContentType *string `json:"contentType,omitempty" protobuf:"bytes,3,opt,name=contentType"`
+}
+
 const (
 	// ProjectMemberAdmin is a const for a role that provides full admin access.
 	ProjectMemberAdmin = "admin"
@@ -179,6 +300,12 @@ const (
 	ProjectFailed ProjectPhase = "Failed"
 	// ProjectTerminating indicates that the project is in termination process.
 	ProjectTerminating ProjectPhase = "Terminating"
+	// ProjectArchived indicates that the project is archived and will be deleted eventually.
+	ProjectArchived ProjectPhase = "Archived"
+
+	// ProjectDeletionScheduled is a constant for a condition type indicating that the project is scheduled for automatic
+	// deletion.
+	ProjectDeletionScheduled ConditionType = "DeletionScheduled"
 
 	// ProjectEventNamespaceReconcileFailed indicates that the namespace reconciliation has failed.
 	ProjectEventNamespaceReconcileFailed = "NamespaceReconcileFailed"
@@ -190,4 +317,13 @@ const (
 	ProjectEventNamespaceDeletionFailed = "NamespaceDeletionFailed"
 	// ProjectEventNamespaceMarkedForDeletion indicates that the namespace has been successfully marked for deletion.
 	ProjectEventNamespaceMarkedForDeletion = "NamespaceMarkedForDeletion"
+	// ProjectEventArchived indicates that the project has been archived.
+	ProjectEventArchived = "Archived"
+	// ProjectEventUnarchived indicates that the archival of the project has been reverted.
+	ProjectEventUnarchived = "Unarchived"
+	// ProjectEventDeletionScheduled notifies about the upcoming automatic deletion of the project.
+	ProjectEventDeletionScheduled = "DeletionScheduled"
+	// ProjectEventDeletionNotConfirmed indicates that the automatic deletion of the project is blocked because it still
+	// contains shoots and the deletion was not confirmed.
+	ProjectEventDeletionNotConfirmed = "DeletionNotConfirmed"
 )
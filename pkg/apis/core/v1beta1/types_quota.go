@@ -22,6 +22,9 @@ type Quota struct {
 	// Spec defines the Quota constraints.
 	// +optional
 	Spec QuotaSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// Status contains the current usage of the Quota.
+	// +optional
+	Status QuotaStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -46,4 +49,37 @@ type QuotaSpec struct {
 	Metrics corev1.ResourceList `json:"metrics" protobuf:"bytes,2,rep,name=metrics,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
 	// Scope is the scope of the Quota object, either 'project', 'secret' or 'workloadidentity'. This field is immutable.
 	Scope corev1.ObjectReference `json:"scope" protobuf:"bytes,3,opt,name=scope"` // TODO: When graduating the API to v1 consider reworking this field as described in https://github.com/gardener/gardener/issues/9773#issuecomment-2293340267
+	// SoftMetrics is a list of thresholds for the resources in Metrics. Exceeding them does not reject requests, but
+	// results in warnings and events.
+	// +optional
+	SoftMetrics corev1.ResourceList `json:"softMetrics,omitempty" protobuf:"bytes,4,rep,name=softMetrics,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// GracePeriod is the duration during which the limits in Metrics may be exceeded before requests get rejected. It
+	// starts when the resources allocated by the Shoots exceed the limits for the first time.
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty" protobuf:"bytes,5,opt,name=gracePeriod"`
+}
+
+// QuotaStatus holds the most recently observed status of the Quota.
+type QuotaStatus struct {
+	// Used is the amount of the constrained resources which is allocated by the Shoots referencing the Quota.
+	// +optional
+	Used corev1.ResourceList `json:"used,omitempty" protobuf:"bytes,1,rep,name=used,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// Shoots is the list of Shoots allocating resources of the Quota.
+	// +optional
+	Shoots []QuotaShootUsage `json:"shoots,omitempty" protobuf:"bytes,2,rep,name=shoots"`
+	// LimitsExceededSince is the time since the resources allocated by the Shoots exceed at least one of the limits in
+	// Metrics. It determines the end of the grace period.
+	// +optional
+	LimitsExceededSince *metav1.Time `json:"limitsExceededSince,omitempty" protobuf:"bytes,3,opt,name=limitsExceededSince"`
+}
+
+// QuotaShootUsage contains the resources allocated by a Shoot referencing a Quota.
+type QuotaShootUsage struct {
+	// Name is the name of the Shoot.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Namespace is the namespace of the Shoot.
+	Namespace string `json:"namespace" protobuf:"bytes,2,opt,name=namespace"`
+	// Used is the amount of the constrained resources which is allocated by the Shoot.
+	// +optional
+	Used corev1.ResourceList `json:"used,omitempty" protobuf:"bytes,3,rep,name=used,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
 }
@@ -125,6 +125,21 @@ type SeedStatus struct {
 	// LastOperation holds information about the last operation on the Seed.
 	// +optional
 	LastOperation *LastOperation `json:"lastOperation,omitempty" protobuf:"bytes,9,opt,name=lastOperation"`
+	// ResourceUsage contains the aggregated resource requests of the shoot control planes and the allocatable
+	// resources of the nodes of the seed cluster.
+	// +optional
+	ResourceUsage *SeedResourceUsage `json:"resourceUsage,omitempty" protobuf:"bytes,10,opt,name=resourceUsage"`
+}
+
+// SeedResourceUsage contains the aggregated resource requests of the shoot control planes and the allocatable
+// resources of the nodes of a seed cluster.
+type SeedResourceUsage struct {
+	// ControlPlaneRequests are the aggregated resource requests of all pods in the shoot control plane namespaces.
+	// +optional
+	ControlPlaneRequests corev1.ResourceList `json:"controlPlaneRequests,omitempty" protobuf:"bytes,1,rep,name=controlPlaneRequests,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// NodeAllocatable are the aggregated allocatable resources of all nodes of the seed cluster.
+	// +optional
+	NodeAllocatable corev1.ResourceList `json:"nodeAllocatable,omitempty" protobuf:"bytes,2,rep,name=nodeAllocatable,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
 }
 
 // Backup contains the object store configuration for backups for shoot (currently only etcd).
@@ -229,6 +229,14 @@ type ShootStatus struct {
 	// ManualWorkerPoolRollout contains information about the worker pool rollout progress.
 	// +optional
 	ManualWorkerPoolRollout *ManualWorkerPoolRollout `json:"manualWorkerPoolRollout,omitempty" protobuf:"bytes,21,opt,name=manualWorkerPoolRollout"`
+	// UpcomingMaintenance contains a preview of the operations which are expected to be performed in the next
+	// maintenance time window of the Shoot.
+	// +optional
+	UpcomingMaintenance *UpcomingMaintenance `json:"upcomingMaintenance,omitempty" protobuf:"bytes,22,opt,name=upcomingMaintenance"`
+	// LastActivityTime is the most recent time at which user requests or workload changes were observed in the
+	// kube-apiserver of the Shoot. It is only maintained for awake Shoots with enabled automatic hibernation.
+	// +optional
+	LastActivityTime *metav1.Time `json:"lastActivityTime,omitempty" protobuf:"bytes,23,opt,name=lastActivityTime"`
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -242,6 +250,42 @@ type LastMaintenance struct {
 	// FailureReason holds the information about the last maintenance operation failure reason.
 	// +optional
 	FailureReason *string `json:"failureReason,omitempty" protobuf:"bytes,4,opt,name=failureReason"`
+	// Deferral contains information about maintenance operations which were deferred because of an active maintenance
+	// freeze.
+	// +optional
+	Deferral *MaintenanceDeferral `json:"deferral,omitempty" protobuf:"bytes,5,opt,name=deferral"`
+}
+
+// MaintenanceDeferral contains information about maintenance operations which were deferred because of an active
+// maintenance freeze.
+type MaintenanceDeferral struct {
+	// Freeze is the name of the active maintenance freeze.
+	Freeze string `json:"freeze" protobuf:"bytes,1,opt,name=freeze"`
+	// Until is the end of the active maintenance freeze.
+	Until metav1.Time `json:"until" protobuf:"bytes,2,opt,name=until"`
+	// Operations are human-readable descriptions of the deferred maintenance operations.
+	// +optional
+	Operations []string `json:"operations,omitempty" protobuf:"bytes,3,rep,name=operations"`
+}
+
+// UpcomingMaintenance contains a preview of the operations which are expected to be performed in the next maintenance
+// time window of the Shoot.
+type UpcomingMaintenance struct {
+	// WindowStart is the begin of the next maintenance time window of the Shoot.
+	WindowStart metav1.Time `json:"windowStart" protobuf:"bytes,1,opt,name=windowStart"`
+	// Operations are human-readable descriptions of the maintenance operations which are expected to be performed.
+	// +optional
+	Operations []string `json:"operations,omitempty" protobuf:"bytes,2,rep,name=operations"`
+	// FailedOperations are human-readable descriptions of the maintenance operations which are expected to fail, e.g.,
+	// because no suitable version to update to is available.
+	// +optional
+	FailedOperations []string `json:"failedOperations,omitempty" protobuf:"bytes,3,rep,name=failedOperations"`
+	// Deferral contains information about maintenance operations which are expected to be deferred because of a
+	// maintenance freeze active at the begin of the next maintenance time window.
+	// +optional
+	Deferral *MaintenanceDeferral `json:"deferral,omitempty" protobuf:"bytes,4,opt,name=deferral"`
+	// LastUpdateTime is the time when the preview was last updated.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime" protobuf:"bytes,5,opt,name=lastUpdateTime"`
 }
 
 // NetworkingStatus contains information about cluster networking such as CIDRs.
@@ -287,6 +331,21 @@ type ShootCredentials struct {
 	// EncryptionAtRest contains information about Shoot data encryption at rest.
 	// +optional
 	EncryptionAtRest *EncryptionAtRest `json:"encryptionAtRest,omitempty" protobuf:"bytes,2,opt,name=encryptionAtRest"`
+	// CertificateExpirations contains a summary of the expiration times of the certificates managed by gardenlet for
+	// the Shoot.
+	// +optional
+	CertificateExpirations []CertificateExpiration `json:"certificateExpirations,omitempty" protobuf:"bytes,3,rep,name=certificateExpirations"`
+}
+
+// CertificateExpiration contains information about the expiration of a certificate.
+type CertificateExpiration struct {
+	// Name is the name of the certificate.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// CertificateAuthority states whether the certificate is a certificate authority.
+	// +optional
+	CertificateAuthority bool `json:"certificateAuthority,omitempty" protobuf:"varint,2,opt,name=certificateAuthority"`
+	// ExpirationTime is the time when the certificate expires.
+	ExpirationTime metav1.Time `json:"expirationTime" protobuf:"bytes,3,opt,name=expirationTime"`
 }
 
 // ShootCredentialsRotation contains information about the rotation of credentials.
@@ -611,6 +670,24 @@ type Hibernation struct {
 	// Schedules determine the hibernation schedules.
 	// +optional
 	Schedules []HibernationSchedule `json:"schedules,omitempty" protobuf:"bytes,2,rep,name=schedules"`
+	// StayAwakeUntil is the point in time until which the Shoot is not hibernated by its hibernation schedules. Once it
+	// has passed, the Shoot is hibernated if it would be hibernated according to its schedules at that time.
+	// +optional
+	StayAwakeUntil *metav1.Time `json:"stayAwakeUntil,omitempty" protobuf:"bytes,3,opt,name=stayAwakeUntil"`
+	// AutoHibernation contains the configuration for hibernating the Shoot automatically when it is idle. If set, it
+	// takes precedence over the configuration in the Shoot's Project.
+	// +optional
+	AutoHibernation *AutoHibernation `json:"autoHibernation,omitempty" protobuf:"bytes,4,opt,name=autoHibernation"`
+}
+
+// AutoHibernation contains the configuration for hibernating shoots automatically when they are idle, i.e., when
+// neither user requests nor workload changes have been observed in their kube-apiserver for some time.
+type AutoHibernation struct {
+	// Enabled specifies whether shoots are hibernated automatically when they are idle.
+	Enabled bool `json:"enabled" protobuf:"varint,1,opt,name=enabled"`
+	// IdleTimeout is the duration without any observed activity after which a shoot is hibernated. Defaults to 4h.
+	// +optional
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty" protobuf:"bytes,2,opt,name=idleTimeout"`
 }
 
 // HibernationSchedule determines the hibernation schedule of a Shoot.
@@ -626,6 +703,14 @@ type HibernationSchedule struct {
 	// Location is the time location in which both start and shall be evaluated.
 	// +optional
 	Location *string `json:"location,omitempty" protobuf:"bytes,3,opt,name=location"`
+	// SkipCalendars is a list of names of hibernation calendars of the Shoot's Project. Start and end times falling on
+	// the days of these calendars are skipped.
+	// +optional
+	SkipCalendars []string `json:"skipCalendars,omitempty" protobuf:"bytes,4,rep,name=skipCalendars"`
+	// HibernateCalendars is a list of names of hibernation calendars of the Shoot's Project. The Shoot is hibernated at
+	// the beginning of each day of these calendars and end times falling on these days are skipped.
+	// +optional
+	HibernateCalendars []string `json:"hibernateCalendars,omitempty" protobuf:"bytes,5,rep,name=hibernateCalendars"`
 }
 
 // Kubernetes contains the version and configuration variables for the Shoot control plane.
@@ -2078,6 +2163,8 @@ const (
 	ShootEventSchedulingSuccessful = "SchedulingSuccessful"
 	// ShootEventSchedulingFailed indicates that a scheduling decision failed.
 	ShootEventSchedulingFailed = "SchedulingFailed"
+	// ShootEventSchedulingScores indicates the scores of the seed candidates of a scheduling decision.
+	ShootEventSchedulingScores = "SchedulingScores"
 )
 
 const (
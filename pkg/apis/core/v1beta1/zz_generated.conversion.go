@@ -142,6 +142,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AutoHibernation)(nil), (*core.AutoHibernation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AutoHibernation_To_core_AutoHibernation(a.(*AutoHibernation), b.(*core.AutoHibernation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AutoHibernation)(nil), (*AutoHibernation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AutoHibernation_To_v1beta1_AutoHibernation(a.(*core.AutoHibernation), b.(*AutoHibernation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AvailabilityZone)(nil), (*core.AvailabilityZone)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AvailabilityZone_To_core_AvailabilityZone(a.(*AvailabilityZone), b.(*core.AvailabilityZone), scope)
 	}); err != nil {
@@ -312,6 +322,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CertificateExpiration)(nil), (*core.CertificateExpiration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CertificateExpiration_To_core_CertificateExpiration(a.(*CertificateExpiration), b.(*core.CertificateExpiration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.CertificateExpiration)(nil), (*CertificateExpiration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_CertificateExpiration_To_v1beta1_CertificateExpiration(a.(*core.CertificateExpiration), b.(*CertificateExpiration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudProfile)(nil), (*core.CloudProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CloudProfile_To_core_CloudProfile(a.(*CloudProfile), b.(*core.CloudProfile), scope)
 	}); err != nil {
@@ -412,6 +432,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControlPlaneQuotaAccounting)(nil), (*core.ControlPlaneQuotaAccounting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ControlPlaneQuotaAccounting_To_core_ControlPlaneQuotaAccounting(a.(*ControlPlaneQuotaAccounting), b.(*core.ControlPlaneQuotaAccounting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControlPlaneQuotaAccounting)(nil), (*ControlPlaneQuotaAccounting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControlPlaneQuotaAccounting_To_v1beta1_ControlPlaneQuotaAccounting(a.(*core.ControlPlaneQuotaAccounting), b.(*ControlPlaneQuotaAccounting), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerDeploymentList)(nil), (*core.ControllerDeploymentList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ControllerDeploymentList_To_core_ControllerDeploymentList(a.(*ControllerDeploymentList), b.(*core.ControllerDeploymentList), scope)
 	}); err != nil {
@@ -762,6 +792,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HibernationCalendar)(nil), (*core.HibernationCalendar)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_HibernationCalendar_To_core_HibernationCalendar(a.(*HibernationCalendar), b.(*core.HibernationCalendar), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.HibernationCalendar)(nil), (*HibernationCalendar)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_HibernationCalendar_To_v1beta1_HibernationCalendar(a.(*core.HibernationCalendar), b.(*HibernationCalendar), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HibernationCalendarDays)(nil), (*core.HibernationCalendarDays)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_HibernationCalendarDays_To_core_HibernationCalendarDays(a.(*HibernationCalendarDays), b.(*core.HibernationCalendarDays), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.HibernationCalendarDays)(nil), (*HibernationCalendarDays)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_HibernationCalendarDays_To_v1beta1_HibernationCalendarDays(a.(*core.HibernationCalendarDays), b.(*HibernationCalendarDays), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HibernationSchedule)(nil), (*core.HibernationSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_HibernationSchedule_To_core_HibernationSchedule(a.(*HibernationSchedule), b.(*core.HibernationSchedule), scope)
 	}); err != nil {
@@ -1137,6 +1187,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceDeferral)(nil), (*core.MaintenanceDeferral)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceDeferral_To_core_MaintenanceDeferral(a.(*MaintenanceDeferral), b.(*core.MaintenanceDeferral), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceDeferral)(nil), (*MaintenanceDeferral)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceDeferral_To_v1beta1_MaintenanceDeferral(a.(*core.MaintenanceDeferral), b.(*MaintenanceDeferral), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceFreeze)(nil), (*core.MaintenanceFreeze)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceFreeze_To_core_MaintenanceFreeze(a.(*MaintenanceFreeze), b.(*core.MaintenanceFreeze), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceFreeze)(nil), (*MaintenanceFreeze)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceFreeze_To_v1beta1_MaintenanceFreeze(a.(*core.MaintenanceFreeze), b.(*MaintenanceFreeze), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MaintenanceRotationConfig)(nil), (*core.MaintenanceRotationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MaintenanceRotationConfig_To_core_MaintenanceRotationConfig(a.(*MaintenanceRotationConfig), b.(*core.MaintenanceRotationConfig), scope)
 	}); err != nil {
@@ -1277,6 +1347,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NotificationSubscription)(nil), (*core.NotificationSubscription)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NotificationSubscription_To_core_NotificationSubscription(a.(*NotificationSubscription), b.(*core.NotificationSubscription), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NotificationSubscription)(nil), (*NotificationSubscription)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NotificationSubscription_To_v1beta1_NotificationSubscription(a.(*core.NotificationSubscription), b.(*NotificationSubscription), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NotificationWebhook)(nil), (*core.NotificationWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NotificationWebhook_To_core_NotificationWebhook(a.(*NotificationWebhook), b.(*core.NotificationWebhook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NotificationWebhook)(nil), (*NotificationWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NotificationWebhook_To_v1beta1_NotificationWebhook(a.(*core.NotificationWebhook), b.(*NotificationWebhook), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*OCIRepository)(nil), (*core.OCIRepository)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_OCIRepository_To_core_OCIRepository(a.(*OCIRepository), b.(*core.OCIRepository), scope)
 	}); err != nil {
@@ -1347,6 +1437,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectLifecycle)(nil), (*core.ProjectLifecycle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectLifecycle_To_core_ProjectLifecycle(a.(*ProjectLifecycle), b.(*core.ProjectLifecycle), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectLifecycle)(nil), (*ProjectLifecycle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectLifecycle_To_v1beta1_ProjectLifecycle(a.(*core.ProjectLifecycle), b.(*ProjectLifecycle), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectList)(nil), (*core.ProjectList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ProjectList_To_core_ProjectList(a.(*ProjectList), b.(*core.ProjectList), scope)
 	}); err != nil {
@@ -1397,6 +1497,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*QuotaAccounting)(nil), (*core.QuotaAccounting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QuotaAccounting_To_core_QuotaAccounting(a.(*QuotaAccounting), b.(*core.QuotaAccounting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.QuotaAccounting)(nil), (*QuotaAccounting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_QuotaAccounting_To_v1beta1_QuotaAccounting(a.(*core.QuotaAccounting), b.(*QuotaAccounting), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*QuotaList)(nil), (*core.QuotaList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_QuotaList_To_core_QuotaList(a.(*QuotaList), b.(*core.QuotaList), scope)
 	}); err != nil {
@@ -1407,6 +1517,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*QuotaShootUsage)(nil), (*core.QuotaShootUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QuotaShootUsage_To_core_QuotaShootUsage(a.(*QuotaShootUsage), b.(*core.QuotaShootUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.QuotaShootUsage)(nil), (*QuotaShootUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_QuotaShootUsage_To_v1beta1_QuotaShootUsage(a.(*core.QuotaShootUsage), b.(*QuotaShootUsage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*QuotaSpec)(nil), (*core.QuotaSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_QuotaSpec_To_core_QuotaSpec(a.(*QuotaSpec), b.(*core.QuotaSpec), scope)
 	}); err != nil {
@@ -1417,6 +1537,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*QuotaStatus)(nil), (*core.QuotaStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QuotaStatus_To_core_QuotaStatus(a.(*QuotaStatus), b.(*core.QuotaStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.QuotaStatus)(nil), (*QuotaStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_QuotaStatus_To_v1beta1_QuotaStatus(a.(*core.QuotaStatus), b.(*QuotaStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Region)(nil), (*core.Region)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Region_To_core_Region(a.(*Region), b.(*core.Region), scope)
 	}); err != nil {
@@ -1447,6 +1577,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RolloutWave)(nil), (*core.RolloutWave)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RolloutWave_To_core_RolloutWave(a.(*RolloutWave), b.(*core.RolloutWave), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RolloutWave)(nil), (*RolloutWave)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RolloutWave_To_v1beta1_RolloutWave(a.(*core.RolloutWave), b.(*RolloutWave), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SSHAccess)(nil), (*core.SSHAccess)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SSHAccess_To_core_SSHAccess(a.(*SSHAccess), b.(*core.SSHAccess), scope)
 	}); err != nil {
@@ -1557,6 +1697,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedResourceUsage)(nil), (*core.SeedResourceUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedResourceUsage_To_core_SeedResourceUsage(a.(*SeedResourceUsage), b.(*core.SeedResourceUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedResourceUsage)(nil), (*SeedResourceUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedResourceUsage_To_v1beta1_SeedResourceUsage(a.(*core.SeedResourceUsage), b.(*SeedResourceUsage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedSelector)(nil), (*core.SeedSelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedSelector_To_core_SeedSelector(a.(*SeedSelector), b.(*core.SeedSelector), scope)
 	}); err != nil {
@@ -1957,6 +2107,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*UpcomingMaintenance)(nil), (*core.UpcomingMaintenance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_UpcomingMaintenance_To_core_UpcomingMaintenance(a.(*UpcomingMaintenance), b.(*core.UpcomingMaintenance), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.UpcomingMaintenance)(nil), (*UpcomingMaintenance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_UpcomingMaintenance_To_v1beta1_UpcomingMaintenance(a.(*core.UpcomingMaintenance), b.(*UpcomingMaintenance), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VersionRollout)(nil), (*core.VersionRollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VersionRollout_To_core_VersionRollout(a.(*VersionRollout), b.(*core.VersionRollout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VersionRollout)(nil), (*VersionRollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VersionRollout_To_v1beta1_VersionRollout(a.(*core.VersionRollout), b.(*VersionRollout), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VerticalPodAutoscaler)(nil), (*core.VerticalPodAutoscaler)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_VerticalPodAutoscaler_To_core_VerticalPodAutoscaler(a.(*VerticalPodAutoscaler), b.(*core.VerticalPodAutoscaler), scope)
 	}); err != nil {
@@ -2335,6 +2505,28 @@ func Convert_core_AuthorizerKubeconfigReference_To_v1beta1_AuthorizerKubeconfigR
 	return autoConvert_core_AuthorizerKubeconfigReference_To_v1beta1_AuthorizerKubeconfigReference(in, out, s)
 }
 
+func autoConvert_v1beta1_AutoHibernation_To_core_AutoHibernation(in *AutoHibernation, out *core.AutoHibernation, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.IdleTimeout = (*metav1.Duration)(unsafe.Pointer(in.IdleTimeout))
+	return nil
+}
+
+// Convert_v1beta1_AutoHibernation_To_core_AutoHibernation is an autogenerated conversion function.
+func Convert_v1beta1_AutoHibernation_To_core_AutoHibernation(in *AutoHibernation, out *core.AutoHibernation, s conversion.Scope) error {
+	return autoConvert_v1beta1_AutoHibernation_To_core_AutoHibernation(in, out, s)
+}
+
+func autoConvert_core_AutoHibernation_To_v1beta1_AutoHibernation(in *core.AutoHibernation, out *AutoHibernation, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.IdleTimeout = (*metav1.Duration)(unsafe.Pointer(in.IdleTimeout))
+	return nil
+}
+
+// Convert_core_AutoHibernation_To_v1beta1_AutoHibernation is an autogenerated conversion function.
+func Convert_core_AutoHibernation_To_v1beta1_AutoHibernation(in *core.AutoHibernation, out *AutoHibernation, s conversion.Scope) error {
+	return autoConvert_core_AutoHibernation_To_v1beta1_AutoHibernation(in, out, s)
+}
+
 func autoConvert_v1beta1_AvailabilityZone_To_core_AvailabilityZone(in *AvailabilityZone, out *core.AvailabilityZone, s conversion.Scope) error {
 	out.Name = in.Name
 	out.UnavailableMachineTypes = *(*[]string)(unsafe.Pointer(&in.UnavailableMachineTypes))
@@ -2765,6 +2957,30 @@ func Convert_core_CapabilityDefinition_To_v1beta1_CapabilityDefinition(in *core.
 	return autoConvert_core_CapabilityDefinition_To_v1beta1_CapabilityDefinition(in, out, s)
 }
 
+func autoConvert_v1beta1_CertificateExpiration_To_core_CertificateExpiration(in *CertificateExpiration, out *core.CertificateExpiration, s conversion.Scope) error {
+	out.Name = in.Name
+	out.CertificateAuthority = in.CertificateAuthority
+	out.ExpirationTime = in.ExpirationTime
+	return nil
+}
+
+// Convert_v1beta1_CertificateExpiration_To_core_CertificateExpiration is an autogenerated conversion function.
+func Convert_v1beta1_CertificateExpiration_To_core_CertificateExpiration(in *CertificateExpiration, out *core.CertificateExpiration, s conversion.Scope) error {
+	return autoConvert_v1beta1_CertificateExpiration_To_core_CertificateExpiration(in, out, s)
+}
+
+func autoConvert_core_CertificateExpiration_To_v1beta1_CertificateExpiration(in *core.CertificateExpiration, out *CertificateExpiration, s conversion.Scope) error {
+	out.Name = in.Name
+	out.CertificateAuthority = in.CertificateAuthority
+	out.ExpirationTime = in.ExpirationTime
+	return nil
+}
+
+// Convert_core_CertificateExpiration_To_v1beta1_CertificateExpiration is an autogenerated conversion function.
+func Convert_core_CertificateExpiration_To_v1beta1_CertificateExpiration(in *core.CertificateExpiration, out *CertificateExpiration, s conversion.Scope) error {
+	return autoConvert_core_CertificateExpiration_To_v1beta1_CertificateExpiration(in, out, s)
+}
+
 func autoConvert_v1beta1_CloudProfile_To_core_CloudProfile(in *CloudProfile, out *core.CloudProfile, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_CloudProfileSpec_To_core_CloudProfileSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -2850,6 +3066,7 @@ func autoConvert_v1beta1_CloudProfileSpec_To_core_CloudProfileSpec(in *CloudProf
 	out.Bastion = (*core.Bastion)(unsafe.Pointer(in.Bastion))
 	out.Limits = (*core.Limits)(unsafe.Pointer(in.Limits))
 	out.MachineCapabilities = *(*[]core.CapabilityDefinition)(unsafe.Pointer(&in.MachineCapabilities))
+	out.QuotaAccounting = (*core.QuotaAccounting)(unsafe.Pointer(in.QuotaAccounting))
 	return nil
 }
 
@@ -2873,6 +3090,7 @@ func autoConvert_core_CloudProfileSpec_To_v1beta1_CloudProfileSpec(in *core.Clou
 	out.Bastion = (*Bastion)(unsafe.Pointer(in.Bastion))
 	out.Limits = (*Limits)(unsafe.Pointer(in.Limits))
 	out.MachineCapabilities = *(*[]CapabilityDefinition)(unsafe.Pointer(&in.MachineCapabilities))
+	out.QuotaAccounting = (*QuotaAccounting)(unsafe.Pointer(in.QuotaAccounting))
 	return nil
 }
 
@@ -3063,6 +3281,28 @@ func Convert_core_ControlPlaneAutoscaling_To_v1beta1_ControlPlaneAutoscaling(in
 	return autoConvert_core_ControlPlaneAutoscaling_To_v1beta1_ControlPlaneAutoscaling(in, out, s)
 }
 
+func autoConvert_v1beta1_ControlPlaneQuotaAccounting_To_core_ControlPlaneQuotaAccounting(in *ControlPlaneQuotaAccounting, out *core.ControlPlaneQuotaAccounting, s conversion.Scope) error {
+	out.FailureToleranceType = (*core.FailureToleranceType)(unsafe.Pointer(in.FailureToleranceType))
+	out.Resources = *(*v1.ResourceList)(unsafe.Pointer(&in.Resources))
+	return nil
+}
+
+// Convert_v1beta1_ControlPlaneQuotaAccounting_To_core_ControlPlaneQuotaAccounting is an autogenerated conversion function.
+func Convert_v1beta1_ControlPlaneQuotaAccounting_To_core_ControlPlaneQuotaAccounting(in *ControlPlaneQuotaAccounting, out *core.ControlPlaneQuotaAccounting, s conversion.Scope) error {
+	return autoConvert_v1beta1_ControlPlaneQuotaAccounting_To_core_ControlPlaneQuotaAccounting(in, out, s)
+}
+
+func autoConvert_core_ControlPlaneQuotaAccounting_To_v1beta1_ControlPlaneQuotaAccounting(in *core.ControlPlaneQuotaAccounting, out *ControlPlaneQuotaAccounting, s conversion.Scope) error {
+	out.FailureToleranceType = (*FailureToleranceType)(unsafe.Pointer(in.FailureToleranceType))
+	out.Resources = *(*v1.ResourceList)(unsafe.Pointer(&in.Resources))
+	return nil
+}
+
+// Convert_core_ControlPlaneQuotaAccounting_To_v1beta1_ControlPlaneQuotaAccounting is an autogenerated conversion function.
+func Convert_core_ControlPlaneQuotaAccounting_To_v1beta1_ControlPlaneQuotaAccounting(in *core.ControlPlaneQuotaAccounting, out *ControlPlaneQuotaAccounting, s conversion.Scope) error {
+	return autoConvert_core_ControlPlaneQuotaAccounting_To_v1beta1_ControlPlaneQuotaAccounting(in, out, s)
+}
+
 func autoConvert_v1beta1_ControllerDeployment_To_core_ControllerDeployment(in *ControllerDeployment, out *core.ControllerDeployment, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.Type = in.Type
@@ -3700,6 +3940,7 @@ func autoConvert_v1beta1_ExpirableVersion_To_core_ExpirableVersion(in *Expirable
 	out.Version = in.Version
 	out.ExpirationDate = (*metav1.Time)(unsafe.Pointer(in.ExpirationDate))
 	out.Classification = (*core.VersionClassification)(unsafe.Pointer(in.Classification))
+	out.Rollout = (*core.VersionRollout)(unsafe.Pointer(in.Rollout))
 	return nil
 }
 
@@ -3712,6 +3953,7 @@ func autoConvert_core_ExpirableVersion_To_v1beta1_ExpirableVersion(in *core.Expi
 	out.Version = in.Version
 	out.ExpirationDate = (*metav1.Time)(unsafe.Pointer(in.ExpirationDate))
 	out.Classification = (*VersionClassification)(unsafe.Pointer(in.Classification))
+	out.Rollout = (*VersionRollout)(unsafe.Pointer(in.Rollout))
 	return nil
 }
 
@@ -3927,6 +4169,8 @@ func autoConvert_core_HelmControllerDeployment_To_v1beta1_HelmControllerDeployme
 func autoConvert_v1beta1_Hibernation_To_core_Hibernation(in *Hibernation, out *core.Hibernation, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.Schedules = *(*[]core.HibernationSchedule)(unsafe.Pointer(&in.Schedules))
+	out.StayAwakeUntil = (*metav1.Time)(unsafe.Pointer(in.StayAwakeUntil))
+	out.AutoHibernation = (*core.AutoHibernation)(unsafe.Pointer(in.AutoHibernation))
 	return nil
 }
 
@@ -3938,6 +4182,8 @@ func Convert_v1beta1_Hibernation_To_core_Hibernation(in *Hibernation, out *core.
 func autoConvert_core_Hibernation_To_v1beta1_Hibernation(in *core.Hibernation, out *Hibernation, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.Schedules = *(*[]HibernationSchedule)(unsafe.Pointer(&in.Schedules))
+	out.StayAwakeUntil = (*metav1.Time)(unsafe.Pointer(in.StayAwakeUntil))
+	out.AutoHibernation = (*AutoHibernation)(unsafe.Pointer(in.AutoHibernation))
 	return nil
 }
 
@@ -3946,10 +4192,56 @@ func Convert_core_Hibernation_To_v1beta1_Hibernation(in *core.Hibernation, out *
 	return autoConvert_core_Hibernation_To_v1beta1_Hibernation(in, out, s)
 }
 
+func autoConvert_v1beta1_HibernationCalendar_To_core_HibernationCalendar(in *HibernationCalendar, out *core.HibernationCalendar, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Days = *(*[]core.HibernationCalendarDays)(unsafe.Pointer(&in.Days))
+	return nil
+}
+
+// Convert_v1beta1_HibernationCalendar_To_core_HibernationCalendar is an autogenerated conversion function.
+func Convert_v1beta1_HibernationCalendar_To_core_HibernationCalendar(in *HibernationCalendar, out *core.HibernationCalendar, s conversion.Scope) error {
+	return autoConvert_v1beta1_HibernationCalendar_To_core_HibernationCalendar(in, out, s)
+}
+
+func autoConvert_core_HibernationCalendar_To_v1beta1_HibernationCalendar(in *core.HibernationCalendar, out *HibernationCalendar, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Days = *(*[]HibernationCalendarDays)(unsafe.Pointer(&in.Days))
+	return nil
+}
+
+// Convert_core_HibernationCalendar_To_v1beta1_HibernationCalendar is an autogenerated conversion function.
+func Convert_core_HibernationCalendar_To_v1beta1_HibernationCalendar(in *core.HibernationCalendar, out *HibernationCalendar, s conversion.Scope) error {
+	return autoConvert_core_HibernationCalendar_To_v1beta1_HibernationCalendar(in, out, s)
+}
+
+func autoConvert_v1beta1_HibernationCalendarDays_To_core_HibernationCalendarDays(in *HibernationCalendarDays, out *core.HibernationCalendarDays, s conversion.Scope) error {
+	out.Begin = in.Begin
+	out.End = (*string)(unsafe.Pointer(in.End))
+	return nil
+}
+
+// Convert_v1beta1_HibernationCalendarDays_To_core_HibernationCalendarDays is an autogenerated conversion function.
+func Convert_v1beta1_HibernationCalendarDays_To_core_HibernationCalendarDays(in *HibernationCalendarDays, out *core.HibernationCalendarDays, s conversion.Scope) error {
+	return autoConvert_v1beta1_HibernationCalendarDays_To_core_HibernationCalendarDays(in, out, s)
+}
+
+func autoConvert_core_HibernationCalendarDays_To_v1beta1_HibernationCalendarDays(in *core.HibernationCalendarDays, out *HibernationCalendarDays, s conversion.Scope) error {
+	out.Begin = in.Begin
+	out.End = (*string)(unsafe.Pointer(in.End))
+	return nil
+}
+
+// Convert_core_HibernationCalendarDays_To_v1beta1_HibernationCalendarDays is an autogenerated conversion function.
+func Convert_core_HibernationCalendarDays_To_v1beta1_HibernationCalendarDays(in *core.HibernationCalendarDays, out *HibernationCalendarDays, s conversion.Scope) error {
+	return autoConvert_core_HibernationCalendarDays_To_v1beta1_HibernationCalendarDays(in, out, s)
+}
+
 func autoConvert_v1beta1_HibernationSchedule_To_core_HibernationSchedule(in *HibernationSchedule, out *core.HibernationSchedule, s conversion.Scope) error {
 	out.Start = (*string)(unsafe.Pointer(in.Start))
 	out.End = (*string)(unsafe.Pointer(in.End))
 	out.Location = (*string)(unsafe.Pointer(in.Location))
+	out.SkipCalendars = *(*[]string)(unsafe.Pointer(&in.SkipCalendars))
+	out.HibernateCalendars = *(*[]string)(unsafe.Pointer(&in.HibernateCalendars))
 	return nil
 }
 
@@ -3962,6 +4254,8 @@ func autoConvert_core_HibernationSchedule_To_v1beta1_HibernationSchedule(in *cor
 	out.Start = (*string)(unsafe.Pointer(in.Start))
 	out.End = (*string)(unsafe.Pointer(in.End))
 	out.Location = (*string)(unsafe.Pointer(in.Location))
+	out.SkipCalendars = *(*[]string)(unsafe.Pointer(&in.SkipCalendars))
+	out.HibernateCalendars = *(*[]string)(unsafe.Pointer(&in.HibernateCalendars))
 	return nil
 }
 
@@ -4711,6 +5005,7 @@ func autoConvert_v1beta1_LastMaintenance_To_core_LastMaintenance(in *LastMainten
 	out.TriggeredTime = in.TriggeredTime
 	out.State = core.LastOperationState(in.State)
 	out.FailureReason = (*string)(unsafe.Pointer(in.FailureReason))
+	out.Deferral = (*core.MaintenanceDeferral)(unsafe.Pointer(in.Deferral))
 	return nil
 }
 
@@ -4724,6 +5019,7 @@ func autoConvert_core_LastMaintenance_To_v1beta1_LastMaintenance(in *core.LastMa
 	out.TriggeredTime = in.TriggeredTime
 	out.State = LastOperationState(in.State)
 	out.FailureReason = (*string)(unsafe.Pointer(in.FailureReason))
+	out.Deferral = (*MaintenanceDeferral)(unsafe.Pointer(in.Deferral))
 	return nil
 }
 
@@ -5102,6 +5398,58 @@ func Convert_core_MaintenanceCredentialsAutoRotation_To_v1beta1_MaintenanceCrede
 	return autoConvert_core_MaintenanceCredentialsAutoRotation_To_v1beta1_MaintenanceCredentialsAutoRotation(in, out, s)
 }
 
+func autoConvert_v1beta1_MaintenanceDeferral_To_core_MaintenanceDeferral(in *MaintenanceDeferral, out *core.MaintenanceDeferral, s conversion.Scope) error {
+	out.Freeze = in.Freeze
+	out.Until = in.Until
+	out.Operations = *(*[]string)(unsafe.Pointer(&in.Operations))
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceDeferral_To_core_MaintenanceDeferral is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceDeferral_To_core_MaintenanceDeferral(in *MaintenanceDeferral, out *core.MaintenanceDeferral, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceDeferral_To_core_MaintenanceDeferral(in, out, s)
+}
+
+func autoConvert_core_MaintenanceDeferral_To_v1beta1_MaintenanceDeferral(in *core.MaintenanceDeferral, out *MaintenanceDeferral, s conversion.Scope) error {
+	out.Freeze = in.Freeze
+	out.Until = in.Until
+	out.Operations = *(*[]string)(unsafe.Pointer(&in.Operations))
+	return nil
+}
+
+// Convert_core_MaintenanceDeferral_To_v1beta1_MaintenanceDeferral is an autogenerated conversion function.
+func Convert_core_MaintenanceDeferral_To_v1beta1_MaintenanceDeferral(in *core.MaintenanceDeferral, out *MaintenanceDeferral, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceDeferral_To_v1beta1_MaintenanceDeferral(in, out, s)
+}
+
+func autoConvert_v1beta1_MaintenanceFreeze_To_core_MaintenanceFreeze(in *MaintenanceFreeze, out *core.MaintenanceFreeze, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Begin = in.Begin
+	out.End = in.End
+	out.Recurrence = (*core.MaintenanceFreezeRecurrence)(unsafe.Pointer(in.Recurrence))
+	out.HoldForcedUpdates = (*bool)(unsafe.Pointer(in.HoldForcedUpdates))
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceFreeze_To_core_MaintenanceFreeze is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceFreeze_To_core_MaintenanceFreeze(in *MaintenanceFreeze, out *core.MaintenanceFreeze, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceFreeze_To_core_MaintenanceFreeze(in, out, s)
+}
+
+func autoConvert_core_MaintenanceFreeze_To_v1beta1_MaintenanceFreeze(in *core.MaintenanceFreeze, out *MaintenanceFreeze, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Begin = in.Begin
+	out.End = in.End
+	out.Recurrence = (*MaintenanceFreezeRecurrence)(unsafe.Pointer(in.Recurrence))
+	out.HoldForcedUpdates = (*bool)(unsafe.Pointer(in.HoldForcedUpdates))
+	return nil
+}
+
+// Convert_core_MaintenanceFreeze_To_v1beta1_MaintenanceFreeze is an autogenerated conversion function.
+func Convert_core_MaintenanceFreeze_To_v1beta1_MaintenanceFreeze(in *core.MaintenanceFreeze, out *MaintenanceFreeze, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceFreeze_To_v1beta1_MaintenanceFreeze(in, out, s)
+}
+
 func autoConvert_v1beta1_MaintenanceRotationConfig_To_core_MaintenanceRotationConfig(in *MaintenanceRotationConfig, out *core.MaintenanceRotationConfig, s conversion.Scope) error {
 	out.RotationPeriod = (*metav1.Duration)(unsafe.Pointer(in.RotationPeriod))
 	return nil
@@ -5456,6 +5804,62 @@ func Convert_core_NodeLocalDNS_To_v1beta1_NodeLocalDNS(in *core.NodeLocalDNS, ou
 	return autoConvert_core_NodeLocalDNS_To_v1beta1_NodeLocalDNS(in, out, s)
 }
 
+func autoConvert_v1beta1_NotificationSubscription_To_core_NotificationSubscription(in *NotificationSubscription, out *core.NotificationSubscription, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kinds = *(*[]string)(unsafe.Pointer(&in.Kinds))
+	out.Types = *(*[]string)(unsafe.Pointer(&in.Types))
+	out.Reasons = *(*[]string)(unsafe.Pointer(&in.Reasons))
+	if err := Convert_v1beta1_NotificationWebhook_To_core_NotificationWebhook(&in.Webhook, &out.Webhook, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_NotificationSubscription_To_core_NotificationSubscription is an autogenerated conversion function.
+func Convert_v1beta1_NotificationSubscription_To_core_NotificationSubscription(in *NotificationSubscription, out *core.NotificationSubscription, s conversion.Scope) error {
+	return autoConvert_v1beta1_NotificationSubscription_To_core_NotificationSubscription(in, out, s)
+}
+
+func autoConvert_core_NotificationSubscription_To_v1beta1_NotificationSubscription(in *core.NotificationSubscription, out *NotificationSubscription, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kinds = *(*[]string)(unsafe.Pointer(&in.Kinds))
+	out.Types = *(*[]string)(unsafe.Pointer(&in.Types))
+	out.Reasons = *(*[]string)(unsafe.Pointer(&in.Reasons))
+	if err := Convert_core_NotificationWebhook_To_v1beta1_NotificationWebhook(&in.Webhook, &out.Webhook, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_NotificationSubscription_To_v1beta1_NotificationSubscription is an autogenerated conversion function.
+func Convert_core_NotificationSubscription_To_v1beta1_NotificationSubscription(in *core.NotificationSubscription, out *NotificationSubscription, s conversion.Scope) error {
+	return autoConvert_core_NotificationSubscription_To_v1beta1_NotificationSubscription(in, out, s)
+}
+
+func autoConvert_v1beta1_NotificationWebhook_To_core_NotificationWebhook(in *NotificationWebhook, out *core.NotificationWebhook, s conversion.Scope) error {
+	out.URL = in.URL
+	out.PayloadTemplate = (*string)(unsafe.Pointer(in.PayloadTemplate))
+	out.ContentType = (*string)(unsafe.Pointer(in.ContentType))
+	return nil
+}
+
+// Convert_v1beta1_NotificationWebhook_To_core_NotificationWebhook is an autogenerated conversion function.
+func Convert_v1beta1_NotificationWebhook_To_core_NotificationWebhook(in *NotificationWebhook, out *core.NotificationWebhook, s conversion.Scope) error {
+	return autoConvert_v1beta1_NotificationWebhook_To_core_NotificationWebhook(in, out, s)
+}
+
+func autoConvert_core_NotificationWebhook_To_v1beta1_NotificationWebhook(in *core.NotificationWebhook, out *NotificationWebhook, s conversion.Scope) error {
+	out.URL = in.URL
+	out.PayloadTemplate = (*string)(unsafe.Pointer(in.PayloadTemplate))
+	out.ContentType = (*string)(unsafe.Pointer(in.ContentType))
+	return nil
+}
+
+// Convert_core_NotificationWebhook_To_v1beta1_NotificationWebhook is an autogenerated conversion function.
+func Convert_core_NotificationWebhook_To_v1beta1_NotificationWebhook(in *core.NotificationWebhook, out *NotificationWebhook, s conversion.Scope) error {
+	return autoConvert_core_NotificationWebhook_To_v1beta1_NotificationWebhook(in, out, s)
+}
+
 func autoConvert_v1beta1_OCIRepository_To_core_OCIRepository(in *OCIRepository, out *core.OCIRepository, s conversion.Scope) error {
 	out.Ref = (*string)(unsafe.Pointer(in.Ref))
 	out.Repository = (*string)(unsafe.Pointer(in.Repository))
@@ -5642,6 +6046,26 @@ func Convert_core_Project_To_v1beta1_Project(in *core.Project, out *Project, s c
 	return autoConvert_core_Project_To_v1beta1_Project(in, out, s)
 }
 
+func autoConvert_v1beta1_ProjectLifecycle_To_core_ProjectLifecycle(in *ProjectLifecycle, out *core.ProjectLifecycle, s conversion.Scope) error {
+	out.ExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	return nil
+}
+
+// Convert_v1beta1_ProjectLifecycle_To_core_ProjectLifecycle is an autogenerated conversion function.
+func Convert_v1beta1_ProjectLifecycle_To_core_ProjectLifecycle(in *ProjectLifecycle, out *core.ProjectLifecycle, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectLifecycle_To_core_ProjectLifecycle(in, out, s)
+}
+
+func autoConvert_core_ProjectLifecycle_To_v1beta1_ProjectLifecycle(in *core.ProjectLifecycle, out *ProjectLifecycle, s conversion.Scope) error {
+	out.ExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	return nil
+}
+
+// Convert_core_ProjectLifecycle_To_v1beta1_ProjectLifecycle is an autogenerated conversion function.
+func Convert_core_ProjectLifecycle_To_v1beta1_ProjectLifecycle(in *core.ProjectLifecycle, out *ProjectLifecycle, s conversion.Scope) error {
+	return autoConvert_core_ProjectLifecycle_To_v1beta1_ProjectLifecycle(in, out, s)
+}
+
 func autoConvert_v1beta1_ProjectList_To_core_ProjectList(in *ProjectList, out *core.ProjectList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -5716,6 +6140,11 @@ func autoConvert_v1beta1_ProjectSpec_To_core_ProjectSpec(in *ProjectSpec, out *c
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	out.Tolerations = (*core.ProjectTolerations)(unsafe.Pointer(in.Tolerations))
 	out.DualApprovalForDeletion = *(*[]core.DualApprovalForDeletion)(unsafe.Pointer(&in.DualApprovalForDeletion))
+	out.MaintenanceFreezes = *(*[]core.MaintenanceFreeze)(unsafe.Pointer(&in.MaintenanceFreezes))
+	out.HibernationCalendars = *(*[]core.HibernationCalendar)(unsafe.Pointer(&in.HibernationCalendars))
+	out.AutoHibernation = (*core.AutoHibernation)(unsafe.Pointer(in.AutoHibernation))
+	out.Lifecycle = (*core.ProjectLifecycle)(unsafe.Pointer(in.Lifecycle))
+	out.Notifications = *(*[]core.NotificationSubscription)(unsafe.Pointer(&in.Notifications))
 	return nil
 }
 
@@ -5738,6 +6167,11 @@ func autoConvert_core_ProjectSpec_To_v1beta1_ProjectSpec(in *core.ProjectSpec, o
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	out.Tolerations = (*ProjectTolerations)(unsafe.Pointer(in.Tolerations))
 	out.DualApprovalForDeletion = *(*[]DualApprovalForDeletion)(unsafe.Pointer(&in.DualApprovalForDeletion))
+	out.MaintenanceFreezes = *(*[]MaintenanceFreeze)(unsafe.Pointer(&in.MaintenanceFreezes))
+	out.HibernationCalendars = *(*[]HibernationCalendar)(unsafe.Pointer(&in.HibernationCalendars))
+	out.AutoHibernation = (*AutoHibernation)(unsafe.Pointer(in.AutoHibernation))
+	out.Lifecycle = (*ProjectLifecycle)(unsafe.Pointer(in.Lifecycle))
+	out.Notifications = *(*[]NotificationSubscription)(unsafe.Pointer(&in.Notifications))
 	return nil
 }
 
@@ -5747,6 +6181,9 @@ func autoConvert_v1beta1_ProjectStatus_To_core_ProjectStatus(in *ProjectStatus,
 	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
 	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
 	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.ArchivedTimestamp = (*metav1.Time)(unsafe.Pointer(in.ArchivedTimestamp))
+	out.ArchivedAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.ArchivedAutoDeleteTimestamp))
+	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
@@ -5761,6 +6198,9 @@ func autoConvert_core_ProjectStatus_To_v1beta1_ProjectStatus(in *core.ProjectSta
 	out.StaleSinceTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleSinceTimestamp))
 	out.StaleAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.StaleAutoDeleteTimestamp))
 	out.LastActivityTimestamp = (*metav1.Time)(unsafe.Pointer(in.LastActivityTimestamp))
+	out.ArchivedTimestamp = (*metav1.Time)(unsafe.Pointer(in.ArchivedTimestamp))
+	out.ArchivedAutoDeleteTimestamp = (*metav1.Time)(unsafe.Pointer(in.ArchivedAutoDeleteTimestamp))
+	out.Conditions = *(*[]Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
@@ -5844,6 +6284,9 @@ func autoConvert_v1beta1_Quota_To_core_Quota(in *Quota, out *core.Quota, s conve
 	if err := Convert_v1beta1_QuotaSpec_To_core_QuotaSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_v1beta1_QuotaStatus_To_core_QuotaStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5857,6 +6300,9 @@ func autoConvert_core_Quota_To_v1beta1_Quota(in *core.Quota, out *Quota, s conve
 	if err := Convert_core_QuotaSpec_To_v1beta1_QuotaSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_core_QuotaStatus_To_v1beta1_QuotaStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -5865,6 +6311,28 @@ func Convert_core_Quota_To_v1beta1_Quota(in *core.Quota, out *Quota, s conversio
 	return autoConvert_core_Quota_To_v1beta1_Quota(in, out, s)
 }
 
+func autoConvert_v1beta1_QuotaAccounting_To_core_QuotaAccounting(in *QuotaAccounting, out *core.QuotaAccounting, s conversion.Scope) error {
+	out.ControlPlanes = *(*[]core.ControlPlaneQuotaAccounting)(unsafe.Pointer(&in.ControlPlanes))
+	out.LoadBalancer = *(*v1.ResourceList)(unsafe.Pointer(&in.LoadBalancer))
+	return nil
+}
+
+// Convert_v1beta1_QuotaAccounting_To_core_QuotaAccounting is an autogenerated conversion function.
+func Convert_v1beta1_QuotaAccounting_To_core_QuotaAccounting(in *QuotaAccounting, out *core.QuotaAccounting, s conversion.Scope) error {
+	return autoConvert_v1beta1_QuotaAccounting_To_core_QuotaAccounting(in, out, s)
+}
+
+func autoConvert_core_QuotaAccounting_To_v1beta1_QuotaAccounting(in *core.QuotaAccounting, out *QuotaAccounting, s conversion.Scope) error {
+	out.ControlPlanes = *(*[]ControlPlaneQuotaAccounting)(unsafe.Pointer(&in.ControlPlanes))
+	out.LoadBalancer = *(*v1.ResourceList)(unsafe.Pointer(&in.LoadBalancer))
+	return nil
+}
+
+// Convert_core_QuotaAccounting_To_v1beta1_QuotaAccounting is an autogenerated conversion function.
+func Convert_core_QuotaAccounting_To_v1beta1_QuotaAccounting(in *core.QuotaAccounting, out *QuotaAccounting, s conversion.Scope) error {
+	return autoConvert_core_QuotaAccounting_To_v1beta1_QuotaAccounting(in, out, s)
+}
+
 func autoConvert_v1beta1_QuotaList_To_core_QuotaList(in *QuotaList, out *core.QuotaList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]core.Quota)(unsafe.Pointer(&in.Items))
@@ -5887,10 +6355,36 @@ func Convert_core_QuotaList_To_v1beta1_QuotaList(in *core.QuotaList, out *QuotaL
 	return autoConvert_core_QuotaList_To_v1beta1_QuotaList(in, out, s)
 }
 
+func autoConvert_v1beta1_QuotaShootUsage_To_core_QuotaShootUsage(in *QuotaShootUsage, out *core.QuotaShootUsage, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.Used = *(*v1.ResourceList)(unsafe.Pointer(&in.Used))
+	return nil
+}
+
+// Convert_v1beta1_QuotaShootUsage_To_core_QuotaShootUsage is an autogenerated conversion function.
+func Convert_v1beta1_QuotaShootUsage_To_core_QuotaShootUsage(in *QuotaShootUsage, out *core.QuotaShootUsage, s conversion.Scope) error {
+	return autoConvert_v1beta1_QuotaShootUsage_To_core_QuotaShootUsage(in, out, s)
+}
+
+func autoConvert_core_QuotaShootUsage_To_v1beta1_QuotaShootUsage(in *core.QuotaShootUsage, out *QuotaShootUsage, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.Used = *(*v1.ResourceList)(unsafe.Pointer(&in.Used))
+	return nil
+}
+
+// Convert_core_QuotaShootUsage_To_v1beta1_QuotaShootUsage is an autogenerated conversion function.
+func Convert_core_QuotaShootUsage_To_v1beta1_QuotaShootUsage(in *core.QuotaShootUsage, out *QuotaShootUsage, s conversion.Scope) error {
+	return autoConvert_core_QuotaShootUsage_To_v1beta1_QuotaShootUsage(in, out, s)
+}
+
 func autoConvert_v1beta1_QuotaSpec_To_core_QuotaSpec(in *QuotaSpec, out *core.QuotaSpec, s conversion.Scope) error {
 	out.ClusterLifetimeDays = (*int32)(unsafe.Pointer(in.ClusterLifetimeDays))
 	out.Metrics = *(*v1.ResourceList)(unsafe.Pointer(&in.Metrics))
 	out.Scope = in.Scope
+	out.SoftMetrics = *(*v1.ResourceList)(unsafe.Pointer(&in.SoftMetrics))
+	out.GracePeriod = (*metav1.Duration)(unsafe.Pointer(in.GracePeriod))
 	return nil
 }
 
@@ -5903,6 +6397,8 @@ func autoConvert_core_QuotaSpec_To_v1beta1_QuotaSpec(in *core.QuotaSpec, out *Qu
 	out.ClusterLifetimeDays = (*int32)(unsafe.Pointer(in.ClusterLifetimeDays))
 	out.Metrics = *(*v1.ResourceList)(unsafe.Pointer(&in.Metrics))
 	out.Scope = in.Scope
+	out.SoftMetrics = *(*v1.ResourceList)(unsafe.Pointer(&in.SoftMetrics))
+	out.GracePeriod = (*metav1.Duration)(unsafe.Pointer(in.GracePeriod))
 	return nil
 }
 
@@ -5911,6 +6407,30 @@ func Convert_core_QuotaSpec_To_v1beta1_QuotaSpec(in *core.QuotaSpec, out *QuotaS
 	return autoConvert_core_QuotaSpec_To_v1beta1_QuotaSpec(in, out, s)
 }
 
+func autoConvert_v1beta1_QuotaStatus_To_core_QuotaStatus(in *QuotaStatus, out *core.QuotaStatus, s conversion.Scope) error {
+	out.Used = *(*v1.ResourceList)(unsafe.Pointer(&in.Used))
+	out.Shoots = *(*[]core.QuotaShootUsage)(unsafe.Pointer(&in.Shoots))
+	out.LimitsExceededSince = (*metav1.Time)(unsafe.Pointer(in.LimitsExceededSince))
+	return nil
+}
+
+// Convert_v1beta1_QuotaStatus_To_core_QuotaStatus is an autogenerated conversion function.
+func Convert_v1beta1_QuotaStatus_To_core_QuotaStatus(in *QuotaStatus, out *core.QuotaStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_QuotaStatus_To_core_QuotaStatus(in, out, s)
+}
+
+func autoConvert_core_QuotaStatus_To_v1beta1_QuotaStatus(in *core.QuotaStatus, out *QuotaStatus, s conversion.Scope) error {
+	out.Used = *(*v1.ResourceList)(unsafe.Pointer(&in.Used))
+	out.Shoots = *(*[]QuotaShootUsage)(unsafe.Pointer(&in.Shoots))
+	out.LimitsExceededSince = (*metav1.Time)(unsafe.Pointer(in.LimitsExceededSince))
+	return nil
+}
+
+// Convert_core_QuotaStatus_To_v1beta1_QuotaStatus is an autogenerated conversion function.
+func Convert_core_QuotaStatus_To_v1beta1_QuotaStatus(in *core.QuotaStatus, out *QuotaStatus, s conversion.Scope) error {
+	return autoConvert_core_QuotaStatus_To_v1beta1_QuotaStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_Region_To_core_Region(in *Region, out *core.Region, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Zones = *(*[]core.AvailabilityZone)(unsafe.Pointer(&in.Zones))
@@ -5983,6 +6503,28 @@ func Convert_core_ResourceWatchCacheSize_To_v1beta1_ResourceWatchCacheSize(in *c
 	return autoConvert_core_ResourceWatchCacheSize_To_v1beta1_ResourceWatchCacheSize(in, out, s)
 }
 
+func autoConvert_v1beta1_RolloutWave_To_core_RolloutWave(in *RolloutWave, out *core.RolloutWave, s conversion.Scope) error {
+	out.Purposes = *(*[]core.ShootPurpose)(unsafe.Pointer(&in.Purposes))
+	out.SoakDuration = (*metav1.Duration)(unsafe.Pointer(in.SoakDuration))
+	return nil
+}
+
+// Convert_v1beta1_RolloutWave_To_core_RolloutWave is an autogenerated conversion function.
+func Convert_v1beta1_RolloutWave_To_core_RolloutWave(in *RolloutWave, out *core.RolloutWave, s conversion.Scope) error {
+	return autoConvert_v1beta1_RolloutWave_To_core_RolloutWave(in, out, s)
+}
+
+func autoConvert_core_RolloutWave_To_v1beta1_RolloutWave(in *core.RolloutWave, out *RolloutWave, s conversion.Scope) error {
+	out.Purposes = *(*[]ShootPurpose)(unsafe.Pointer(&in.Purposes))
+	out.SoakDuration = (*metav1.Duration)(unsafe.Pointer(in.SoakDuration))
+	return nil
+}
+
+// Convert_core_RolloutWave_To_v1beta1_RolloutWave is an autogenerated conversion function.
+func Convert_core_RolloutWave_To_v1beta1_RolloutWave(in *core.RolloutWave, out *RolloutWave, s conversion.Scope) error {
+	return autoConvert_core_RolloutWave_To_v1beta1_RolloutWave(in, out, s)
+}
+
 func autoConvert_v1beta1_SSHAccess_To_core_SSHAccess(in *SSHAccess, out *core.SSHAccess, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	return nil
@@ -6275,6 +6817,28 @@ func Convert_core_SeedProvider_To_v1beta1_SeedProvider(in *core.SeedProvider, ou
 	return autoConvert_core_SeedProvider_To_v1beta1_SeedProvider(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedResourceUsage_To_core_SeedResourceUsage(in *SeedResourceUsage, out *core.SeedResourceUsage, s conversion.Scope) error {
+	out.ControlPlaneRequests = *(*v1.ResourceList)(unsafe.Pointer(&in.ControlPlaneRequests))
+	out.NodeAllocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.NodeAllocatable))
+	return nil
+}
+
+// Convert_v1beta1_SeedResourceUsage_To_core_SeedResourceUsage is an autogenerated conversion function.
+func Convert_v1beta1_SeedResourceUsage_To_core_SeedResourceUsage(in *SeedResourceUsage, out *core.SeedResourceUsage, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedResourceUsage_To_core_SeedResourceUsage(in, out, s)
+}
+
+func autoConvert_core_SeedResourceUsage_To_v1beta1_SeedResourceUsage(in *core.SeedResourceUsage, out *SeedResourceUsage, s conversion.Scope) error {
+	out.ControlPlaneRequests = *(*v1.ResourceList)(unsafe.Pointer(&in.ControlPlaneRequests))
+	out.NodeAllocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.NodeAllocatable))
+	return nil
+}
+
+// Convert_core_SeedResourceUsage_To_v1beta1_SeedResourceUsage is an autogenerated conversion function.
+func Convert_core_SeedResourceUsage_To_v1beta1_SeedResourceUsage(in *core.SeedResourceUsage, out *SeedResourceUsage, s conversion.Scope) error {
+	return autoConvert_core_SeedResourceUsage_To_v1beta1_SeedResourceUsage(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedSelector_To_core_SeedSelector(in *SeedSelector, out *core.SeedSelector, s conversion.Scope) error {
 	out.LabelSelector = in.LabelSelector
 	out.ProviderTypes = *(*[]string)(unsafe.Pointer(&in.ProviderTypes))
@@ -6637,6 +7201,7 @@ func autoConvert_v1beta1_SeedStatus_To_core_SeedStatus(in *SeedStatus, out *core
 	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
 	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*core.LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.ResourceUsage = (*core.SeedResourceUsage)(unsafe.Pointer(in.ResourceUsage))
 	return nil
 }
 
@@ -6655,6 +7220,7 @@ func autoConvert_core_SeedStatus_To_v1beta1_SeedStatus(in *core.SeedStatus, out
 	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
 	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.ResourceUsage = (*SeedResourceUsage)(unsafe.Pointer(in.ResourceUsage))
 	return nil
 }
 
@@ -6868,6 +7434,7 @@ func Convert_core_ShootAdvertisedAddress_To_v1beta1_ShootAdvertisedAddress(in *c
 func autoConvert_v1beta1_ShootCredentials_To_core_ShootCredentials(in *ShootCredentials, out *core.ShootCredentials, s conversion.Scope) error {
 	out.Rotation = (*core.ShootCredentialsRotation)(unsafe.Pointer(in.Rotation))
 	out.EncryptionAtRest = (*core.EncryptionAtRest)(unsafe.Pointer(in.EncryptionAtRest))
+	out.CertificateExpirations = *(*[]core.CertificateExpiration)(unsafe.Pointer(&in.CertificateExpirations))
 	return nil
 }
 
@@ -6879,6 +7446,7 @@ func Convert_v1beta1_ShootCredentials_To_core_ShootCredentials(in *ShootCredenti
 func autoConvert_core_ShootCredentials_To_v1beta1_ShootCredentials(in *core.ShootCredentials, out *ShootCredentials, s conversion.Scope) error {
 	out.Rotation = (*ShootCredentialsRotation)(unsafe.Pointer(in.Rotation))
 	out.EncryptionAtRest = (*EncryptionAtRest)(unsafe.Pointer(in.EncryptionAtRest))
+	out.CertificateExpirations = *(*[]CertificateExpiration)(unsafe.Pointer(&in.CertificateExpirations))
 	return nil
 }
 
@@ -7221,6 +7789,8 @@ func autoConvert_v1beta1_ShootStatus_To_core_ShootStatus(in *ShootStatus, out *c
 	out.Networking = (*core.NetworkingStatus)(unsafe.Pointer(in.Networking))
 	out.InPlaceUpdates = (*core.InPlaceUpdatesStatus)(unsafe.Pointer(in.InPlaceUpdates))
 	out.ManualWorkerPoolRollout = (*core.ManualWorkerPoolRollout)(unsafe.Pointer(in.ManualWorkerPoolRollout))
+	out.UpcomingMaintenance = (*core.UpcomingMaintenance)(unsafe.Pointer(in.UpcomingMaintenance))
+	out.LastActivityTime = (*metav1.Time)(unsafe.Pointer(in.LastActivityTime))
 	return nil
 }
 
@@ -7253,6 +7823,8 @@ func autoConvert_core_ShootStatus_To_v1beta1_ShootStatus(in *core.ShootStatus, o
 	out.Networking = (*NetworkingStatus)(unsafe.Pointer(in.Networking))
 	out.InPlaceUpdates = (*InPlaceUpdatesStatus)(unsafe.Pointer(in.InPlaceUpdates))
 	out.ManualWorkerPoolRollout = (*ManualWorkerPoolRollout)(unsafe.Pointer(in.ManualWorkerPoolRollout))
+	out.UpcomingMaintenance = (*UpcomingMaintenance)(unsafe.Pointer(in.UpcomingMaintenance))
+	out.LastActivityTime = (*metav1.Time)(unsafe.Pointer(in.LastActivityTime))
 	return nil
 }
 
@@ -7373,6 +7945,56 @@ func Convert_core_Toleration_To_v1beta1_Toleration(in *core.Toleration, out *Tol
 	return autoConvert_core_Toleration_To_v1beta1_Toleration(in, out, s)
 }
 
+func autoConvert_v1beta1_UpcomingMaintenance_To_core_UpcomingMaintenance(in *UpcomingMaintenance, out *core.UpcomingMaintenance, s conversion.Scope) error {
+	out.WindowStart = in.WindowStart
+	out.Operations = *(*[]string)(unsafe.Pointer(&in.Operations))
+	out.FailedOperations = *(*[]string)(unsafe.Pointer(&in.FailedOperations))
+	out.Deferral = (*core.MaintenanceDeferral)(unsafe.Pointer(in.Deferral))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_v1beta1_UpcomingMaintenance_To_core_UpcomingMaintenance is an autogenerated conversion function.
+func Convert_v1beta1_UpcomingMaintenance_To_core_UpcomingMaintenance(in *UpcomingMaintenance, out *core.UpcomingMaintenance, s conversion.Scope) error {
+	return autoConvert_v1beta1_UpcomingMaintenance_To_core_UpcomingMaintenance(in, out, s)
+}
+
+func autoConvert_core_UpcomingMaintenance_To_v1beta1_UpcomingMaintenance(in *core.UpcomingMaintenance, out *UpcomingMaintenance, s conversion.Scope) error {
+	out.WindowStart = in.WindowStart
+	out.Operations = *(*[]string)(unsafe.Pointer(&in.Operations))
+	out.FailedOperations = *(*[]string)(unsafe.Pointer(&in.FailedOperations))
+	out.Deferral = (*MaintenanceDeferral)(unsafe.Pointer(in.Deferral))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_core_UpcomingMaintenance_To_v1beta1_UpcomingMaintenance is an autogenerated conversion function.
+func Convert_core_UpcomingMaintenance_To_v1beta1_UpcomingMaintenance(in *core.UpcomingMaintenance, out *UpcomingMaintenance, s conversion.Scope) error {
+	return autoConvert_core_UpcomingMaintenance_To_v1beta1_UpcomingMaintenance(in, out, s)
+}
+
+func autoConvert_v1beta1_VersionRollout_To_core_VersionRollout(in *VersionRollout, out *core.VersionRollout, s conversion.Scope) error {
+	out.StartTime = in.StartTime
+	out.Waves = *(*[]core.RolloutWave)(unsafe.Pointer(&in.Waves))
+	return nil
+}
+
+// Convert_v1beta1_VersionRollout_To_core_VersionRollout is an autogenerated conversion function.
+func Convert_v1beta1_VersionRollout_To_core_VersionRollout(in *VersionRollout, out *core.VersionRollout, s conversion.Scope) error {
+	return autoConvert_v1beta1_VersionRollout_To_core_VersionRollout(in, out, s)
+}
+
+func autoConvert_core_VersionRollout_To_v1beta1_VersionRollout(in *core.VersionRollout, out *VersionRollout, s conversion.Scope) error {
+	out.StartTime = in.StartTime
+	out.Waves = *(*[]RolloutWave)(unsafe.Pointer(&in.Waves))
+	return nil
+}
+
+// Convert_core_VersionRollout_To_v1beta1_VersionRollout is an autogenerated conversion function.
+func Convert_core_VersionRollout_To_v1beta1_VersionRollout(in *core.VersionRollout, out *VersionRollout, s conversion.Scope) error {
+	return autoConvert_core_VersionRollout_To_v1beta1_VersionRollout(in, out, s)
+}
+
 func autoConvert_v1beta1_VerticalPodAutoscaler_To_core_VerticalPodAutoscaler(in *VerticalPodAutoscaler, out *core.VerticalPodAutoscaler, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.EvictAfterOOMThreshold = (*metav1.Duration)(unsafe.Pointer(in.EvictAfterOOMThreshold))
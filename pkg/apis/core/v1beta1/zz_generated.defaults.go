@@ -99,6 +99,10 @@ func SetObjectDefaults_Project(in *Project) {
 		a := &in.Spec.Members[i]
 		SetDefaults_ProjectMember(a)
 	}
+	for i := range in.Spec.Notifications {
+		a := &in.Spec.Notifications[i]
+		SetDefaults_NotificationWebhook(&a.Webhook)
+	}
 }
 
 func SetObjectDefaults_ProjectList(in *ProjectList) {
@@ -72,6 +72,7 @@ func ValidateCloudProfileSpec(spec *core.CloudProfileSpec, fldPath *field.Path)
 	allErrs = append(allErrs, validateCloudProfileRegions(spec.Regions, fldPath.Child("regions"))...)
 	allErrs = append(allErrs, validateCloudProfileBastion(spec, fldPath.Child("bastion"))...)
 	allErrs = append(allErrs, validateCloudProfileLimits(spec.Limits, fldPath.Child("limits"))...)
+	allErrs = append(allErrs, validateQuotaAccounting(spec.QuotaAccounting, fldPath.Child("quotaAccounting"))...)
 	if spec.SeedSelector != nil {
 		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&spec.SeedSelector.LabelSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("seedSelector"))...)
 	}
@@ -404,6 +405,37 @@ func validateCloudProfileLimits(limits *core.Limits, fldPath *field.Path) field.
 	return allErrs
 }
 
+func validateQuotaAccounting(quotaAccounting *core.QuotaAccounting, fldPath *field.Path) field.ErrorList {
+	if quotaAccounting == nil {
+		return nil
+	}
+
+	var (
+		allErrs               field.ErrorList
+		failureToleranceTypes = sets.New[string]()
+	)
+
+	for i, controlPlane := range quotaAccounting.ControlPlanes {
+		idxPath := fldPath.Child("controlPlanes").Index(i)
+
+		var failureToleranceType string
+		if controlPlane.FailureToleranceType != nil {
+			failureToleranceType = string(*controlPlane.FailureToleranceType)
+			allErrs = append(allErrs, ValidateFailureToleranceTypeValue(*controlPlane.FailureToleranceType, idxPath.Child("failureToleranceType"))...)
+		}
+		if failureToleranceTypes.Has(failureToleranceType) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("failureToleranceType"), failureToleranceType))
+		}
+		failureToleranceTypes.Insert(failureToleranceType)
+
+		allErrs = append(allErrs, validateQuotaResourceList(controlPlane.Resources, idxPath.Child("resources"))...)
+	}
+
+	allErrs = append(allErrs, validateQuotaResourceList(quotaAccounting.LoadBalancer, fldPath.Child("loadBalancer"))...)
+
+	return allErrs
+}
+
 func validateCloudProfileLimitsUpdate(newLimits, oldLimits *core.Limits, fldPath *field.Path) field.ErrorList {
 	if newLimits == nil || oldLimits == nil {
 		// adding and removing limits is allowed
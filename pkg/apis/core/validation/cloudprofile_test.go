@@ -12,6 +12,7 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -364,6 +365,67 @@ var _ = Describe("CloudProfile Validation Tests ", func() {
 					}))))
 				})
 
+				It("should allow valid rollouts for kubernetes versions", func() {
+					cloudProfile.Spec.Kubernetes.Versions = []core.ExpirableVersion{
+						{
+							Version: "1.1.0",
+							Rollout: &core.VersionRollout{
+								StartTime: metav1.Now(),
+								Waves: []core.RolloutWave{
+									{Purposes: []core.ShootPurpose{core.ShootPurposeEvaluation, core.ShootPurposeTesting}},
+									{Purposes: []core.ShootPurpose{core.ShootPurposeDevelopment}, SoakDuration: &metav1.Duration{Duration: 72 * time.Hour}},
+									{Purposes: []core.ShootPurpose{core.ShootPurposeProduction}, SoakDuration: &metav1.Duration{Duration: 168 * time.Hour}},
+								},
+							},
+						},
+					}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(BeEmpty())
+				})
+
+				It("should forbid invalid rollouts for kubernetes versions", func() {
+					cloudProfile.Spec.Kubernetes.Versions = []core.ExpirableVersion{
+						{
+							Version: "1.1.0",
+							Rollout: &core.VersionRollout{
+								StartTime: metav1.Now(),
+								Waves: []core.RolloutWave{
+									{Purposes: []core.ShootPurpose{core.ShootPurposeEvaluation, "foo"}},
+									{Purposes: []core.ShootPurpose{core.ShootPurposeEvaluation}, SoakDuration: &metav1.Duration{Duration: -time.Hour}},
+									{},
+								},
+							},
+						},
+						{
+							Version: "1.1.1",
+							Rollout: &core.VersionRollout{StartTime: metav1.Now()},
+						},
+					}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("spec.kubernetes.versions[0].rollout.waves[0].purposes[1]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.kubernetes.versions[0].rollout.waves[1].purposes[0]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.kubernetes.versions[0].rollout.waves[1].soakDuration"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.kubernetes.versions[0].rollout.waves[2].purposes"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.kubernetes.versions[1].rollout.waves"),
+						})),
+					))
+				})
+
 				It("only allow one supported version per minor version", func() {
 					cloudProfile.Spec.Kubernetes.Versions = []core.ExpirableVersion{
 						{
@@ -1292,6 +1354,50 @@ var _ = Describe("CloudProfile Validation Tests ", func() {
 				})
 			})
 
+			Context("quota accounting validation", func() {
+				It("should allow valid quota accounting", func() {
+					cloudProfile.Spec.QuotaAccounting = &core.QuotaAccounting{
+						ControlPlanes: []core.ControlPlaneQuotaAccounting{
+							{Resources: corev1.ResourceList{"cpu": resource.MustParse("2")}},
+							{FailureToleranceType: ptr.To(core.FailureToleranceTypeZone), Resources: corev1.ResourceList{"example.com/cost": resource.MustParse("30")}},
+						},
+						LoadBalancer: corev1.ResourceList{"example.com/cost": resource.MustParse("5")},
+					}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(BeEmpty())
+				})
+
+				It("should forbid invalid quota accounting", func() {
+					cloudProfile.Spec.QuotaAccounting = &core.QuotaAccounting{
+						ControlPlanes: []core.ControlPlaneQuotaAccounting{
+							{Resources: corev1.ResourceList{"foo": resource.MustParse("2")}},
+							{Resources: corev1.ResourceList{"cpu": resource.MustParse("2")}},
+							{FailureToleranceType: ptr.To[core.FailureToleranceType]("region"), Resources: corev1.ResourceList{"cpu": resource.MustParse("2")}},
+						},
+						LoadBalancer: corev1.ResourceList{"example.com/cost": resource.MustParse("-5")},
+					}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.quotaAccounting.controlPlanes[0].resources[foo]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.quotaAccounting.controlPlanes[1].failureToleranceType"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("spec.quotaAccounting.controlPlanes[2].failureToleranceType"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.quotaAccounting.loadBalancer[example.com/cost]"),
+						})),
+					))
+				})
+			})
+
 			It("should forbid unsupported seed selectors", func() {
 				cloudProfile.Spec.SeedSelector.MatchLabels["foo"] = "no/slash/allowed"
 
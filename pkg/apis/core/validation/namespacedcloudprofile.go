@@ -22,6 +22,7 @@ func ValidateNamespacedCloudProfile(namespacedCloudProfile *core.NamespacedCloud
 
 	allErrs = append(allErrs, validateNamespacedCloudProfileKubernetesVersions(namespacedCloudProfile.Spec.Kubernetes, field.NewPath("spec.kubernetes"))...)
 	allErrs = append(allErrs, ValidateMachineImages(namespacedCloudProfile.Spec.MachineImages, nil, field.NewPath("spec.machineImages"), true)...)
+	allErrs = append(allErrs, validateNamespacedCloudProfileMachineImageRollouts(namespacedCloudProfile.Spec.MachineImages, field.NewPath("spec.machineImages"))...)
 	allErrs = append(allErrs, validateVolumeTypes(namespacedCloudProfile.Spec.VolumeTypes, field.NewPath("spec.volumeTypes"))...)
 	allErrs = append(allErrs, validateMachineTypes(namespacedCloudProfile.Spec.MachineTypes, nil, field.NewPath("spec.machineTypes"))...)
 
@@ -104,7 +105,24 @@ func validateNamespacedCloudProfileKubernetesVersions(kubernetesSettings *core.K
 		if version.Classification != nil {
 			allErrs = append(allErrs, field.Forbidden(idxPath.Child("classification"), "must not provide a classification to a Kubernetes version in NamespacedCloudProfile"))
 		}
+		if version.Rollout != nil {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("rollout"), "must not provide a rollout to a Kubernetes version in NamespacedCloudProfile"))
+		}
 	}
 	allErrs = append(allErrs, validateKubernetesVersions(versions, fldPath)...)
 	return allErrs
 }
+
+func validateNamespacedCloudProfileMachineImageRollouts(machineImages []core.MachineImage, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, image := range machineImages {
+		for j, version := range image.Versions {
+			if version.Rollout != nil {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Index(i).Child("versions").Index(j).Child("rollout"), "must not provide a rollout to a machine image version in NamespacedCloudProfile"))
+			}
+		}
+	}
+
+	return allErrs
+}
@@ -261,9 +261,50 @@ var _ = Describe("NamespacedCloudProfile Validation Tests ", func() {
 						"Detail": Equal("must not provide a classification to a Kubernetes version in NamespacedCloudProfile"),
 					}))))
 				})
+
+				It("should forbid providing a rollout", func() {
+					namespacedCloudProfile.Spec.Kubernetes.Versions = []core.ExpirableVersion{
+						{
+							Version: "1.1.0",
+							Rollout: &core.VersionRollout{Waves: []core.RolloutWave{{Purposes: []core.ShootPurpose{core.ShootPurposeEvaluation}}}},
+						},
+					}
+
+					errorList := ValidateNamespacedCloudProfile(namespacedCloudProfile)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("spec.kubernetes.versions[0].rollout"),
+						"Detail": Equal("must not provide a rollout to a Kubernetes version in NamespacedCloudProfile"),
+					}))))
+				})
 			})
 
 			Context("machine image validation", func() {
+				It("should forbid providing a rollout", func() {
+					namespacedCloudProfile.Spec.MachineImages = []core.MachineImage{
+						{
+							Name: machineImageName,
+							Versions: []core.MachineImageVersion{
+								{
+									ExpirableVersion: core.ExpirableVersion{
+										Version: "3.4.6",
+										Rollout: &core.VersionRollout{Waves: []core.RolloutWave{{Purposes: []core.ShootPurpose{core.ShootPurposeEvaluation}}}},
+									},
+								},
+							},
+						},
+					}
+
+					errorList := ValidateNamespacedCloudProfile(namespacedCloudProfile)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("spec.machineImages[0].versions[0].rollout"),
+						"Detail": Equal("must not provide a rollout to a machine image version in NamespacedCloudProfile"),
+					}))))
+				})
+
 				It("should forbid duplicate names in list of machine images", func() {
 					namespacedCloudProfile.Spec.MachineImages = []core.MachineImage{
 						{
@@ -6,9 +6,12 @@ package validation
 
 import (
 	"fmt"
+	"net/url"
 	"slices"
 	"strings"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -23,6 +26,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/notification"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
 )
 
@@ -154,6 +158,15 @@ func ValidateProjectSpec(projectSpec *core.ProjectSpec, opts projectValidationOp
 	}
 
 	allErrs = append(allErrs, validateDualApprovalForDeletion(projectSpec.DualApprovalForDeletion, fldPath.Child("dualApprovalForDeletion"))...)
+	allErrs = append(allErrs, ValidateMaintenanceFreezes(projectSpec.MaintenanceFreezes, fldPath.Child("maintenanceFreezes"))...)
+	for i, freeze := range projectSpec.MaintenanceFreezes {
+		if freeze.HoldForcedUpdates != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("maintenanceFreezes").Index(i).Child("holdForcedUpdates"), "forced updates can only be held by landscape-wide maintenance freezes"))
+		}
+	}
+	allErrs = append(allErrs, ValidateHibernationCalendars(projectSpec.HibernationCalendars, fldPath.Child("hibernationCalendars"))...)
+	allErrs = append(allErrs, ValidateAutoHibernation(projectSpec.AutoHibernation, fldPath.Child("autoHibernation"))...)
+	allErrs = append(allErrs, validateNotifications(projectSpec.Notifications, fldPath.Child("notifications"))...)
 
 	return allErrs
 }
@@ -357,6 +370,142 @@ func validateDualApprovalForDeletion(dualApproval []core.DualApprovalForDeletion
 	return allErrs
 }
 
+var supportedMaintenanceFreezeRecurrences = sets.New(core.MaintenanceFreezeRecurrenceYearly)
+
+// ValidateMaintenanceFreezes validates the given maintenance freezes.
+func ValidateMaintenanceFreezes(freezes []core.MaintenanceFreeze, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs field.ErrorList
+		names   = sets.New[string]()
+	)
+
+	for i, freeze := range freezes {
+		idxPath := fldPath.Index(i)
+
+		if len(freeze.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "cannot be empty"))
+		} else {
+			if names.Has(freeze.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), freeze.Name))
+			}
+			names.Insert(freeze.Name)
+		}
+
+		if !freeze.End.After(freeze.Begin.Time) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("end"), freeze.End, "must be after begin"))
+		}
+
+		if freeze.Recurrence != nil {
+			if !supportedMaintenanceFreezeRecurrences.Has(*freeze.Recurrence) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("recurrence"), *freeze.Recurrence, sets.List(supportedMaintenanceFreezeRecurrences)))
+			} else if !freeze.End.Time.Before(freeze.Begin.AddDate(1, 0, 0)) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("end"), freeze.End, "yearly recurring maintenance freezes must be shorter than one year"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateHibernationCalendars validates the hibernation calendars of a project.
+func ValidateHibernationCalendars(calendars []core.HibernationCalendar, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs field.ErrorList
+		names   = sets.New[string]()
+	)
+
+	for i, calendar := range calendars {
+		idxPath := fldPath.Index(i)
+
+		if len(calendar.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "cannot be empty"))
+		} else {
+			if names.Has(calendar.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), calendar.Name))
+			}
+			names.Insert(calendar.Name)
+		}
+
+		if len(calendar.Days) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("days"), "must contain at least one day"))
+		}
+
+		for j, days := range calendar.Days {
+			daysPath := idxPath.Child("days").Index(j)
+
+			begin, err := time.Parse(time.DateOnly, days.Begin)
+			if err != nil {
+				allErrs = append(allErrs, field.Invalid(daysPath.Child("begin"), days.Begin, "must be a date in the format YYYY-MM-DD"))
+				continue
+			}
+
+			if days.End != nil {
+				if end, err := time.Parse(time.DateOnly, *days.End); err != nil {
+					allErrs = append(allErrs, field.Invalid(daysPath.Child("end"), *days.End, "must be a date in the format YYYY-MM-DD"))
+				} else if end.Before(begin) {
+					allErrs = append(allErrs, field.Invalid(daysPath.Child("end"), *days.End, "must not be before begin"))
+				}
+			}
+		}
+	}
+
+	return allErrs
+}
+
+var (
+	supportedNotificationKinds = sets.New("Project", "Shoot")
+	supportedNotificationTypes = sets.New(corev1.EventTypeNormal, corev1.EventTypeWarning)
+)
+
+func validateNotifications(subscriptions []core.NotificationSubscription, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs field.ErrorList
+		names   = sets.New[string]()
+	)
+
+	for i, subscription := range subscriptions {
+		idxPath := fldPath.Index(i)
+
+		if len(subscription.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "cannot be empty"))
+		} else {
+			if names.Has(subscription.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), subscription.Name))
+			}
+			names.Insert(subscription.Name)
+		}
+
+		for j, kind := range subscription.Kinds {
+			if !supportedNotificationKinds.Has(kind) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("kinds").Index(j), kind, sets.List(supportedNotificationKinds)))
+			}
+		}
+
+		for j, eventType := range subscription.Types {
+			if !supportedNotificationTypes.Has(eventType) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("types").Index(j), eventType, sets.List(supportedNotificationTypes)))
+			}
+		}
+
+		webhookPath := idxPath.Child("webhook")
+		if u, err := url.Parse(subscription.Webhook.URL); err != nil || len(u.Host) == 0 {
+			allErrs = append(allErrs, field.Invalid(webhookPath.Child("url"), subscription.Webhook.URL, "must be a valid absolute URL"))
+		} else if u.Scheme != "https" {
+			allErrs = append(allErrs, field.NotSupported(webhookPath.Child("url"), u.Scheme, []string{"https"}))
+		} else if err := notification.ValidateWebhookHost(u.Hostname()); err != nil {
+			allErrs = append(allErrs, field.Invalid(webhookPath.Child("url"), subscription.Webhook.URL, err.Error()))
+		}
+
+		if subscription.Webhook.PayloadTemplate != nil {
+			if _, err := notification.ParsePayloadTemplate(*subscription.Webhook.PayloadTemplate); err != nil {
+				allErrs = append(allErrs, field.Invalid(webhookPath.Child("payloadTemplate"), *subscription.Webhook.PayloadTemplate, fmt.Sprintf("must be a valid template: %v", err)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateProjectStatusUpdate validates the status field of a Project object.
 func ValidateProjectStatusUpdate(newProject, oldProject *core.Project) field.ErrorList {
 	allErrs := field.ErrorList{}
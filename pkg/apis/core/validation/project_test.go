@@ -7,6 +7,7 @@ package validation_test
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -625,6 +626,248 @@ var _ = Describe("Project Validation Tests", func() {
 			})
 		})
 
+		Context("maintenance freezes", func() {
+			var (
+				begin = metav1.Date(2026, 12, 20, 0, 0, 0, 0, time.UTC)
+				end   = metav1.Date(2027, 1, 6, 0, 0, 0, 0, time.UTC)
+			)
+
+			It("should allow valid maintenance freezes", func() {
+				project.Spec.MaintenanceFreezes = []core.MaintenanceFreeze{
+					{Name: "year-end", Begin: begin, End: end, Recurrence: ptr.To(core.MaintenanceFreezeRecurrenceYearly)},
+					{Name: "release", Begin: begin, End: end},
+				}
+
+				Expect(ValidateProject(project)).To(BeEmpty())
+			})
+
+			It("should forbid holding forced updates", func() {
+				project.Spec.MaintenanceFreezes = []core.MaintenanceFreeze{{Name: "freeze", Begin: begin, End: end, HoldForcedUpdates: ptr.To(false)}}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.maintenanceFreezes[0].holdForcedUpdates"),
+					})),
+				))
+			})
+
+			It("should forbid empty and duplicate names", func() {
+				project.Spec.MaintenanceFreezes = []core.MaintenanceFreeze{
+					{Begin: begin, End: end},
+					{Name: "freeze", Begin: begin, End: end},
+					{Name: "freeze", Begin: begin, End: end},
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.maintenanceFreezes[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.maintenanceFreezes[2].name"),
+					})),
+				))
+			})
+
+			It("should forbid an end which is not after the begin", func() {
+				project.Spec.MaintenanceFreezes = []core.MaintenanceFreeze{{Name: "freeze", Begin: end, End: begin}}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.maintenanceFreezes[0].end"),
+					})),
+				))
+			})
+
+			It("should forbid unsupported recurrences", func() {
+				project.Spec.MaintenanceFreezes = []core.MaintenanceFreeze{{Name: "freeze", Begin: begin, End: end, Recurrence: ptr.To[core.MaintenanceFreezeRecurrence]("Weekly")}}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.maintenanceFreezes[0].recurrence"),
+					})),
+				))
+			})
+
+			It("should forbid yearly recurring freezes lasting one year or longer", func() {
+				project.Spec.MaintenanceFreezes = []core.MaintenanceFreeze{{Name: "freeze", Begin: begin, End: metav1.NewTime(begin.AddDate(1, 0, 0)), Recurrence: ptr.To(core.MaintenanceFreezeRecurrenceYearly)}}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.maintenanceFreezes[0].end"),
+					})),
+				))
+			})
+		})
+
+		Context("hibernation calendars", func() {
+			It("should allow valid hibernation calendars", func() {
+				project.Spec.HibernationCalendars = []core.HibernationCalendar{
+					{Name: "holidays", Days: []core.HibernationCalendarDays{{Begin: "2026-12-25"}, {Begin: "2026-12-31", End: ptr.To("2027-01-01")}}},
+					{Name: "shutdown", Days: []core.HibernationCalendarDays{{Begin: "2026-08-03", End: ptr.To("2026-08-14")}}},
+				}
+
+				Expect(ValidateProject(project)).To(BeEmpty())
+			})
+
+			It("should forbid empty and duplicate names as well as calendars without days", func() {
+				project.Spec.HibernationCalendars = []core.HibernationCalendar{
+					{Days: []core.HibernationCalendarDays{{Begin: "2026-12-25"}}},
+					{Name: "holidays", Days: []core.HibernationCalendarDays{{Begin: "2026-12-25"}}},
+					{Name: "holidays"},
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.hibernationCalendars[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.hibernationCalendars[2].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.hibernationCalendars[2].days"),
+					})),
+				))
+			})
+
+			It("should forbid invalid dates and ranges ending before they begin", func() {
+				project.Spec.HibernationCalendars = []core.HibernationCalendar{{Name: "holidays", Days: []core.HibernationCalendarDays{
+					{Begin: "25.12.2026"},
+					{Begin: "2026-12-25", End: ptr.To("tomorrow")},
+					{Begin: "2026-12-31", End: ptr.To("2026-12-30")},
+				}}}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.hibernationCalendars[0].days[0].begin"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.hibernationCalendars[0].days[1].end"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.hibernationCalendars[0].days[2].end"),
+					})),
+				))
+			})
+		})
+
+		Context("notifications", func() {
+			It("should allow valid notification subscriptions", func() {
+				project.Spec.Notifications = []core.NotificationSubscription{
+					{Name: "all", Webhook: core.NotificationWebhook{URL: "https://example.com/hook"}},
+					{
+						Name:    "warnings",
+						Kinds:   []string{"Shoot"},
+						Types:   []string{"Warning"},
+						Reasons: []string{"ReconcileError"},
+						Webhook: core.NotificationWebhook{URL: "https://chat.example.com/hooks/123", PayloadTemplate: ptr.To(`{"text": {{ json .Message }}}`)},
+					},
+				}
+
+				Expect(ValidateProject(project)).To(BeEmpty())
+			})
+
+			It("should forbid empty and duplicate names as well as unsupported kinds and types", func() {
+				project.Spec.Notifications = []core.NotificationSubscription{
+					{Webhook: core.NotificationWebhook{URL: "https://example.com/hook"}},
+					{Name: "foo", Kinds: []string{"Seed"}, Webhook: core.NotificationWebhook{URL: "https://example.com/hook"}},
+					{Name: "foo", Types: []string{"Error"}, Webhook: core.NotificationWebhook{URL: "https://example.com/hook"}},
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.notifications[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.notifications[1].kinds[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.notifications[2].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.notifications[2].types[0]"),
+					})),
+				))
+			})
+
+			It("should forbid invalid webhook URLs and payload templates", func() {
+				project.Spec.Notifications = []core.NotificationSubscription{
+					{Name: "foo", Webhook: core.NotificationWebhook{URL: "/hook"}},
+					{Name: "bar", Webhook: core.NotificationWebhook{URL: "http://example.com/hook"}},
+					{Name: "baz", Webhook: core.NotificationWebhook{URL: "https://example.com/hook", PayloadTemplate: ptr.To(`{{ .Message `)}},
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.notifications[0].webhook.url"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.notifications[1].webhook.url"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.notifications[2].webhook.payloadTemplate"),
+					})),
+				))
+			})
+
+			It("should forbid webhook URLs with IP addresses or cluster-internal hosts", func() {
+				project.Spec.Notifications = []core.NotificationSubscription{
+					{Name: "foo", Webhook: core.NotificationWebhook{URL: "https://169.254.169.254/latest"}},
+					{Name: "bar", Webhook: core.NotificationWebhook{URL: "https://[::1]:8443/hook"}},
+					{Name: "baz", Webhook: core.NotificationWebhook{URL: "https://kube-apiserver.garden.svc.cluster.local/hook"}},
+					{Name: "qux", Webhook: core.NotificationWebhook{URL: "https://kubernetes/hook"}},
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.notifications[0].webhook.url"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.notifications[1].webhook.url"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.notifications[2].webhook.url"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.notifications[3].webhook.url"),
+					})),
+				))
+			})
+		})
+
+		It("should forbid auto hibernation idle timeouts shorter than 30m", func() {
+			project.Spec.AutoHibernation = &core.AutoHibernation{Enabled: true, IdleTimeout: &metav1.Duration{Duration: 10 * time.Minute}}
+
+			Expect(ValidateProject(project)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.autoHibernation.idleTimeout"),
+				})),
+			))
+		})
+
 		DescribeTable("namespace immutability",
 			func(old, new *string, matcher gomegatypes.GomegaMatcher) {
 				project.Spec.Namespace = old
@@ -6,9 +6,12 @@ package validation
 
 import (
 	"fmt"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -56,6 +59,69 @@ func ValidateQuotaSpec(quotaSpec *core.QuotaSpec, fldPath *field.Path) field.Err
 		allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(k.String(), v, keyPath)...)
 	}
 
+	softMetricsFldPath := fldPath.Child("softMetrics")
+	for k, v := range quotaSpec.SoftMetrics {
+		keyPath := softMetricsFldPath.Key(string(k))
+		limit, ok := quotaSpec.Metrics[k]
+		if !ok {
+			allErrs = append(allErrs, field.Invalid(keyPath, v.String(), fmt.Sprintf("%s must also be constrained in metrics", string(k))))
+		} else if v.Cmp(limit) > 0 {
+			allErrs = append(allErrs, field.Invalid(keyPath, v.String(), fmt.Sprintf("must not be greater than the limit %s in metrics", limit.String())))
+		}
+		allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(k.String(), v, keyPath)...)
+	}
+
+	allErrs = append(allErrs, ValidatePositiveDuration(quotaSpec.GracePeriod, fldPath.Child("gracePeriod"))...)
+
+	return allErrs
+}
+
+// ValidateQuotaStatusUpdate validates the status field of a Quota object.
+func ValidateQuotaStatusUpdate(newQuota, oldQuota *core.Quota) field.ErrorList {
+	allErrs := apivalidation.ValidateObjectMetaUpdate(&newQuota.ObjectMeta, &oldQuota.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateQuotaStatus(&newQuota.Status, field.NewPath("status"))...)
+
+	return allErrs
+}
+
+// ValidateQuotaStatus validates the status of a Quota object.
+func ValidateQuotaStatus(status *core.QuotaStatus, fldPath *field.Path) field.ErrorList {
+	allErrs := validateQuotaResourceList(status.Used, fldPath.Child("used"))
+
+	shoots := sets.New[string]()
+	for i, shoot := range status.Shoots {
+		idxPath := fldPath.Child("shoots").Index(i)
+
+		if len(shoot.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide the name of the shoot"))
+		}
+		if len(shoot.Namespace) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("namespace"), "must provide the namespace of the shoot"))
+		}
+		if key := shoot.Namespace + "/" + shoot.Name; shoots.Has(key) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, key))
+		} else {
+			shoots.Insert(key)
+		}
+
+		allErrs = append(allErrs, validateQuotaResourceList(shoot.Used, idxPath.Child("used"))...)
+	}
+
+	return allErrs
+}
+
+// validateQuotaResourceList validates that the given resources are supported quota metrics with valid quantities.
+func validateQuotaResourceList(resources corev1.ResourceList, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for k, v := range resources {
+		keyPath := fldPath.Key(string(k))
+		if !isValidQuotaMetric(k) {
+			allErrs = append(allErrs, field.Invalid(keyPath, v.String(), fmt.Sprintf("%s is no supported quota metric", string(k))))
+		}
+		allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(k.String(), v, keyPath)...)
+	}
+
 	return allErrs
 }
 
@@ -67,8 +133,17 @@ func isValidQuotaMetric(metric corev1.ResourceName) bool {
 		core.QuotaMetricMemory,
 		core.QuotaMetricStorageStandard,
 		core.QuotaMetricStoragePremium,
-		core.QuotaMetricLoadbalancer:
+		core.QuotaMetricLoadbalancer,
+		core.QuotaMetricControlPlaneNonHA,
+		core.QuotaMetricControlPlaneHANode,
+		core.QuotaMetricControlPlaneHAZone:
 		return true
 	}
-	return false
+	return isCustomQuotaMetric(metric)
+}
+
+// isCustomQuotaMetric returns whether the given metric is a custom metric, i.e., a qualified name with a prefix like
+// `example.com/premium-support`. Custom metrics are accounted according to the quota accounting of the CloudProfile.
+func isCustomQuotaMetric(metric corev1.ResourceName) bool {
+	return strings.Contains(string(metric), "/") && len(validation.IsQualifiedName(string(metric))) == 0
 }
@@ -5,6 +5,8 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -142,6 +144,70 @@ var _ = Describe("Quota Validation Tests ", func() {
 			Entry("should forbid zero cluster lifetime days", ptr.To[int32](0)),
 		)
 
+		It("should allow control plane and custom metrics", func() {
+			quota.Spec.Metrics["controlplane.nonha"] = resource.MustParse("10")
+			quota.Spec.Metrics["controlplane.ha.node"] = resource.MustParse("5")
+			quota.Spec.Metrics["controlplane.ha.zone"] = resource.MustParse("1")
+			quota.Spec.Metrics["example.com/cost"] = resource.MustParse("1000")
+
+			Expect(ValidateQuota(quota)).To(BeEmpty())
+		})
+
+		It("should forbid invalid custom metrics", func() {
+			quota.Spec.Metrics["example.com/"] = resource.MustParse("1")
+
+			Expect(ValidateQuota(quota)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.metrics[example.com/]"),
+			}))))
+		})
+
+		It("should allow soft metrics and a grace period", func() {
+			quota.Spec.SoftMetrics = corev1.ResourceList{
+				"cpu":    resource.MustParse("150"),
+				"memory": resource.MustParse("4000Gi"),
+			}
+			quota.Spec.GracePeriod = &metav1.Duration{Duration: 24 * time.Hour}
+
+			Expect(ValidateQuota(quota)).To(BeEmpty())
+		})
+
+		It("should forbid invalid soft metrics and a negative grace period", func() {
+			quota.Spec.SoftMetrics = corev1.ResourceList{
+				"cpu":          resource.MustParse("250"),
+				"gpu":          resource.MustParse("1"),
+				"memory":       resource.MustParse("-1"),
+				"loadbalancer": resource.MustParse("1"),
+			}
+			quota.Spec.GracePeriod = &metav1.Duration{Duration: -time.Hour}
+
+			Expect(ValidateQuota(quota)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("spec.softMetrics[cpu]"),
+					"Detail": Equal("must not be greater than the limit 200 in metrics"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("spec.softMetrics[gpu]"),
+					"Detail": Equal("gpu must also be constrained in metrics"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("spec.softMetrics[loadbalancer]"),
+					"Detail": Equal("loadbalancer must also be constrained in metrics"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.softMetrics[memory]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.gracePeriod"),
+				})),
+			))
+		})
+
 		It("should allow quota scope referencing WorkloadIdentity", func() {
 			quota.Spec.Scope = corev1.ObjectReference{
 				Kind:       "WorkloadIdentity",
@@ -152,4 +218,74 @@ var _ = Describe("Quota Validation Tests ", func() {
 			Expect(errorList).To(BeEmpty())
 		})
 	})
+
+	Describe("#ValidateQuotaStatusUpdate", func() {
+		var quota *core.Quota
+
+		BeforeEach(func() {
+			quota = &core.Quota{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "quota-1",
+					Namespace:       "my-namespace",
+					ResourceVersion: "1",
+				},
+				Status: core.QuotaStatus{
+					Used: corev1.ResourceList{
+						"cpu": resource.MustParse("20"),
+					},
+					Shoots: []core.QuotaShootUsage{{
+						Name:      "shoot",
+						Namespace: "garden-dev",
+						Used: corev1.ResourceList{
+							"cpu": resource.MustParse("20"),
+						},
+					}},
+				},
+			}
+		})
+
+		It("should not return any errors", func() {
+			Expect(ValidateQuotaStatusUpdate(quota, quota.DeepCopy())).To(BeEmpty())
+		})
+
+		It("should forbid unsupported metrics and negative values", func() {
+			quota.Status.Used = corev1.ResourceList{
+				"foo": resource.MustParse("1"),
+				"cpu": resource.MustParse("-1"),
+			}
+
+			Expect(ValidateQuotaStatusUpdate(quota, quota.DeepCopy())).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.used[foo]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.used[cpu]"),
+				})),
+			))
+		})
+
+		It("should forbid incomplete and duplicate shoots", func() {
+			quota.Status.Shoots = append(quota.Status.Shoots,
+				core.QuotaShootUsage{Name: "shoot", Namespace: "garden-dev"},
+				core.QuotaShootUsage{},
+			)
+
+			Expect(ValidateQuotaStatusUpdate(quota, quota.DeepCopy())).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("status.shoots[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("status.shoots[2].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("status.shoots[2].namespace"),
+				})),
+			))
+		})
+	})
 })
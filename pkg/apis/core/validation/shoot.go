@@ -2761,6 +2761,26 @@ func ValidateHibernation(annotations map[string]string, hibernation *core.Hibern
 	}
 
 	allErrs = append(allErrs, ValidateHibernationSchedules(hibernation.Schedules, fldPath.Child("schedules"))...)
+	allErrs = append(allErrs, ValidateAutoHibernation(hibernation.AutoHibernation, fldPath.Child("autoHibernation"))...)
+
+	return allErrs
+}
+
+// minAutoHibernationIdleTimeout is the minimum duration without any observed activity after which a shoot may be
+// hibernated automatically.
+const minAutoHibernationIdleTimeout = 30 * time.Minute
+
+// ValidateAutoHibernation validates the configuration for hibernating shoots automatically when they are idle.
+func ValidateAutoHibernation(autoHibernation *core.AutoHibernation, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if autoHibernation == nil || autoHibernation.IdleTimeout == nil {
+		return allErrs
+	}
+
+	if autoHibernation.IdleTimeout.Duration < minAutoHibernationIdleTimeout {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("idleTimeout"), autoHibernation.IdleTimeout.Duration.String(), fmt.Sprintf("must be at least %s", minAutoHibernationIdleTimeout)))
+	}
 
 	return allErrs
 }
@@ -2812,8 +2832,8 @@ func ValidateHibernationScheduleLocation(location string, fldPath *field.Path) f
 func ValidateHibernationSchedule(seenSpecs sets.Set[string], schedule *core.HibernationSchedule, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	if schedule.Start == nil && schedule.End == nil {
-		allErrs = append(allErrs, field.Required(fldPath.Child("start/end"), "either start or end has to be provided"))
+	if schedule.Start == nil && schedule.End == nil && len(schedule.HibernateCalendars) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("start/end"), "either start, end or hibernateCalendars has to be provided"))
 	}
 	if schedule.Start != nil {
 		allErrs = append(allErrs, ValidateHibernationCronSpec(seenSpecs, *schedule.Start, fldPath.Child("start"))...)
@@ -2824,6 +2844,28 @@ func ValidateHibernationSchedule(seenSpecs sets.Set[string], schedule *core.Hibe
 	if schedule.Location != nil {
 		allErrs = append(allErrs, ValidateHibernationScheduleLocation(*schedule.Location, fldPath.Child("location"))...)
 	}
+	allErrs = append(allErrs, validateHibernationCalendarNames(schedule.SkipCalendars, fldPath.Child("skipCalendars"))...)
+	allErrs = append(allErrs, validateHibernationCalendarNames(schedule.HibernateCalendars, fldPath.Child("hibernateCalendars"))...)
+
+	return allErrs
+}
+
+func validateHibernationCalendarNames(names []string, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		seen    = sets.New[string]()
+	)
+
+	for i, name := range names {
+		switch {
+		case len(name) == 0:
+			allErrs = append(allErrs, field.Required(fldPath.Index(i), "calendar name must not be empty"))
+		case seen.Has(name):
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), name))
+		default:
+			seen.Insert(name)
+		}
+	}
 
 	return allErrs
 }
@@ -9554,6 +9554,21 @@ var _ = Describe("Shoot Validation Tests", func() {
 		})
 	})
 
+	Describe("#ValidateAutoHibernation", func() {
+		DescribeTable("validate auto hibernation",
+			func(autoHibernation *core.AutoHibernation, matcher gomegatypes.GomegaMatcher) {
+				Expect(ValidateAutoHibernation(autoHibernation, field.NewPath("autoHibernation"))).To(matcher)
+			},
+			Entry("nil", nil, BeEmpty()),
+			Entry("without idle timeout", &core.AutoHibernation{Enabled: true}, BeEmpty()),
+			Entry("valid idle timeout", &core.AutoHibernation{Enabled: true, IdleTimeout: &metav1.Duration{Duration: 2 * time.Hour}}, BeEmpty()),
+			Entry("too short idle timeout", &core.AutoHibernation{Enabled: true, IdleTimeout: &metav1.Duration{Duration: 5 * time.Minute}}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("autoHibernation.idleTimeout"),
+			})))),
+		)
+	})
+
 	Describe("#ValidateHibernationSchedules", func() {
 		DescribeTable("validate hibernation schedules",
 			func(schedules []core.HibernationSchedule, matcher gomegatypes.GomegaMatcher) {
@@ -9654,6 +9669,22 @@ var _ = Describe("Shoot Validation Tests", func() {
 				ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
 					"Type": Equal(field.ErrorTypeRequired),
 				})))),
+			Entry("only hibernate calendars", sets.New[string](), &core.HibernationSchedule{HibernateCalendars: []string{"holidays"}}, BeEmpty()),
+			Entry("only skip calendars", sets.New[string](), &core.HibernationSchedule{SkipCalendars: []string{"holidays"}},
+				ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type": Equal(field.ErrorTypeRequired),
+				})))),
+			Entry("empty and duplicate calendar names", sets.New[string](), &core.HibernationSchedule{Start: ptr.To("1 * * * *"), SkipCalendars: []string{"holidays", "holidays"}, HibernateCalendars: []string{""}},
+				ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal(field.NewPath("skipCalendars").Index(1).String()),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal(field.NewPath("hibernateCalendars").Index(0).String()),
+					})),
+				)),
 			Entry("invalid start and end value", sets.New[string](), &core.HibernationSchedule{Start: ptr.To(""), End: ptr.To("")},
 				ConsistOf(
 					PointTo(MatchFields(IgnoreExtras, Fields{
@@ -191,6 +191,48 @@ func validateKubernetesVersions(versions []core.ExpirableVersion, fldPath *field
 		if version.Classification != nil && !supportedVersionClassifications.Has(string(*version.Classification)) {
 			allErrs = append(allErrs, field.NotSupported(idxPath.Child("classification"), *version.Classification, sets.List(supportedVersionClassifications)))
 		}
+
+		allErrs = append(allErrs, validateVersionRollout(version.Rollout, idxPath.Child("rollout"))...)
+	}
+
+	return allErrs
+}
+
+func validateVersionRollout(rollout *core.VersionRollout, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if rollout == nil {
+		return allErrs
+	}
+
+	if len(rollout.Waves) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("waves"), "must provide at least one rollout wave"))
+	}
+
+	var (
+		allowedPurposes = sets.New(append(sets.List(availableShootPurposes), string(core.ShootPurposeInfrastructure))...)
+		purposesFound   = sets.New[core.ShootPurpose]()
+	)
+
+	for i, wave := range rollout.Waves {
+		idxPath := fldPath.Child("waves").Index(i)
+
+		if len(wave.Purposes) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("purposes"), "must provide at least one purpose"))
+		}
+
+		for j, purpose := range wave.Purposes {
+			purposePath := idxPath.Child("purposes").Index(j)
+
+			if !allowedPurposes.Has(string(purpose)) {
+				allErrs = append(allErrs, field.NotSupported(purposePath, purpose, sets.List(allowedPurposes)))
+			} else if purposesFound.Has(purpose) {
+				allErrs = append(allErrs, field.Duplicate(purposePath, purpose))
+			}
+			purposesFound.Insert(purpose)
+		}
+
+		allErrs = append(allErrs, ValidatePositiveDuration(wave.SoakDuration, idxPath.Child("soakDuration"))...)
 	}
 
 	return allErrs
@@ -279,6 +321,8 @@ func ValidateMachineImageVersion(image core.MachineImage, machineVersion core.Ma
 		allErrs = append(allErrs, field.NotSupported(versionsPath.Child("classification"), *machineVersion.Classification, sets.List(supportedVersionClassifications)))
 	}
 
+	allErrs = append(allErrs, validateVersionRollout(machineVersion.Rollout, versionsPath.Child("rollout"))...)
+
 	allErrs = append(allErrs, validateMachineImageVersionFlavors(machineVersion, capabilities, versionsPath)...)
 
 	if machineVersion.KubeletVersionConstraint != nil {
@@ -0,0 +1,13 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package security
+
+// Field path constants that are specific to the internal API
+// representation.
+const (
+	// CredentialsBindingQuotas is the field selector path for finding
+	// the Quotas (<namespace>/<name>) referenced by a security.gardener.cloud/v1alpha1 CredentialsBinding.
+	CredentialsBindingQuotas = "quotas"
+)
@@ -210,9 +210,7 @@ func (e *etcdDeployer) Deploy(ctx context.Context) error {
 							Name: volumeNameData,
 							VolumeSource: corev1.VolumeSource{
 								HostPath: &corev1.HostPathVolumeSource{
-									// etcds managed by etcd-druid store their data in <data-dir>/new.etcd, so let's
-									// prepare for the take-over already now
-									Path: staticpodtranslator.StatefulSetVolumeClaimTemplateHostPath(etcd.Name(e.values.Role)) + "/new.etcd",
+									Path: DataDir(e.values.Role),
 									Type: ptr.To(corev1.HostPathDirectoryOrCreate),
 								},
 							},
@@ -291,3 +289,10 @@ const NamePrefix = "etcd-bootstrap-"
 func Name(role string) string {
 	return NamePrefix + role
 }
+
+// DataDir is the directory on the host in which the etcd with the given role stores its data.
+func DataDir(role string) string {
+	// etcds managed by etcd-druid store their data in <data-dir>/new.etcd, so let's prepare for the take-over already
+	// now
+	return staticpodtranslator.StatefulSetVolumeClaimTemplateHostPath(etcd.Name(role)) + "/new.etcd"
+}
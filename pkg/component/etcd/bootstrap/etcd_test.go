@@ -98,4 +98,10 @@ var _ = Describe("Etcd", func() {
 			Expect(Name("foo")).To(Equal("etcd-bootstrap-foo"))
 		})
 	})
+
+	Describe("#DataDir", func() {
+		It("should return the expected directory", func() {
+			Expect(DataDir("foo")).To(Equal("/var/lib/etcd-foo/data/new.etcd"))
+		})
+	})
 })
@@ -96,6 +96,7 @@ clientConnection:
   kubeconfig: ""
   qps: 0
 controllers:
+  healthCheck: {}
   operatingSystemConfig:
     kubernetesVersion: ` + kubernetesVersion.String() + `
     secretName: ` + oscSecretName + `
@@ -197,6 +198,7 @@ clientConnection:
   kubeconfig: ""
   qps: 0
 controllers:
+  healthCheck: {}
   operatingSystemConfig:
     kubernetesVersion: ` + kubernetesVersion.String() + `
     secretName: ` + oscSecretName + `
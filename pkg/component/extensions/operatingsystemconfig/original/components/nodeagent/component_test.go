@@ -150,6 +150,7 @@ clientConnection:
   kubeconfig: ""
   qps: 0
 controllers:
+  healthCheck: {}
   operatingSystemConfig:
     kubernetesVersion: null
     secretName: ` + oscSecretName + `
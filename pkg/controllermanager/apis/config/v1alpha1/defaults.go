@@ -10,6 +10,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 	"k8s.io/utils/ptr"
+
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
 )
 
 // SetDefaults_ControllerManagerConfiguration sets defaults for the configuration of the Gardener controller manager.
@@ -110,6 +112,16 @@ func SetDefaults_ProjectControllerConfiguration(obj *ProjectControllerConfigurat
 	}
 }
 
+// SetDefaults_ProjectLifecycleControllerConfiguration sets defaults for the ProjectLifecycleControllerConfiguration object.
+func SetDefaults_ProjectLifecycleControllerConfiguration(obj *ProjectLifecycleControllerConfiguration) {
+	if obj.ArchivalPeriodDays == nil {
+		obj.ArchivalPeriodDays = ptr.To(30)
+	}
+	if obj.DeletionNotificationDays == nil {
+		obj.DeletionNotificationDays = []int{7, 1}
+	}
+}
+
 // SetDefaults_ServerConfiguration sets defaults for the ServerConfiguration.
 func SetDefaults_ServerConfiguration(obj *ServerConfiguration) {
 	if obj.HealthProbes == nil {
@@ -184,6 +196,9 @@ func SetDefaults_QuotaControllerConfiguration(obj *QuotaControllerConfiguration)
 	if obj.ConcurrentSyncs == nil {
 		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
 	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Minute}
+	}
 }
 
 // SetDefaults_SecretBindingControllerConfiguration sets defaults for the SecretBindingControllerConfiguration.
@@ -245,6 +260,9 @@ func SetDefaults_ShootMaintenanceControllerConfiguration(obj *ShootMaintenanceCo
 	if obj.EnableShootControlPlaneRestarter == nil {
 		obj.EnableShootControlPlaneRestarter = ptr.To(true)
 	}
+	if obj.UpcomingMaintenanceSyncPeriod == nil {
+		obj.UpcomingMaintenanceSyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
 }
 
 // SetDefaults_ShootQuotaControllerConfiguration sets defaults for the ShootQuotaControllerConfiguration.
@@ -283,6 +301,36 @@ func SetDefaults_EventControllerConfiguration(obj *EventControllerConfiguration)
 	}
 }
 
+// SetDefaults_EventArchiveConfiguration sets defaults for the EventArchiveConfiguration.
+func SetDefaults_EventArchiveConfiguration(obj *EventArchiveConfiguration) {
+	if obj.BatchSize == nil {
+		obj.BatchSize = ptr.To(500)
+	}
+	if obj.FlushInterval == nil {
+		obj.FlushInterval = &metav1.Duration{Duration: time.Minute}
+	}
+}
+
+// SetDefaults_EventArchiveHTTPSink sets defaults for the EventArchiveHTTPSink.
+func SetDefaults_EventArchiveHTTPSink(obj *EventArchiveHTTPSink) {
+	if obj.Timeout == nil {
+		obj.Timeout = &metav1.Duration{Duration: 30 * time.Second}
+	}
+}
+
+// SetDefaults_NotificationControllerConfiguration sets defaults for the NotificationControllerConfiguration.
+func SetDefaults_NotificationControllerConfiguration(obj *NotificationControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if obj.DeduplicationWindow == nil {
+		obj.DeduplicationWindow = &metav1.Duration{Duration: 1 * time.Hour}
+	}
+	if obj.WebhookTimeout == nil {
+		obj.WebhookTimeout = &metav1.Duration{Duration: 10 * time.Second}
+	}
+}
+
 // SetDefaults_ShootStatusLabelControllerConfiguration sets defaults for the ShootStatusLabelControllerConfiguration.
 func SetDefaults_ShootStatusLabelControllerConfiguration(obj *ShootStatusLabelControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -297,6 +345,25 @@ func SetDefaults_ShootMigrationControllerConfiguration(obj *ShootMigrationContro
 	}
 }
 
+// SetDefaults_ShootRebalancingControllerConfiguration sets defaults for the ShootRebalancingControllerConfiguration.
+func SetDefaults_ShootRebalancingControllerConfiguration(obj *ShootRebalancingControllerConfiguration) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+	if obj.MaxMigrationsPerSeed == nil {
+		obj.MaxMigrationsPerSeed = ptr.To[int32](1)
+	}
+	if obj.MaxMigrations == nil {
+		obj.MaxMigrations = ptr.To[int32](5)
+	}
+	if obj.Scheduler == nil {
+		obj.Scheduler = &schedulerconfigv1alpha1.ShootSchedulerConfiguration{}
+	}
+	if len(obj.Scheduler.Strategy) == 0 {
+		obj.Scheduler.Strategy = schedulerconfigv1alpha1.SameRegion
+	}
+}
+
 // SetDefaults_ManagedSeedSetControllerConfiguration sets defaults for the ManagedSeedSetControllerConfiguration.
 func SetDefaults_ManagedSeedSetControllerConfiguration(obj *ManagedSeedSetControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -15,6 +15,7 @@ import (
 
 	. "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/logger"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
 )
 
 var _ = Describe("Defaults", func() {
@@ -189,6 +190,22 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.Project).To(Equal(expected))
 		})
 
+		It("should default ProjectLifecycleControllerConfiguration if it is set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					Project: &ProjectControllerConfiguration{
+						Lifecycle: &ProjectLifecycleControllerConfiguration{},
+					},
+				},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.Project.Lifecycle).To(Equal(&ProjectLifecycleControllerConfiguration{
+				ArchivalPeriodDays:       ptr.To(30),
+				DeletionNotificationDays: []int{7, 1},
+			}))
+		})
+
 		It("should default ProjectControllerConfiguration unset QuotaConfiguration correctly", func() {
 			obj = &ControllerManagerConfiguration{
 				Controllers: ControllerManagerControllerConfiguration{
@@ -224,6 +241,11 @@ var _ = Describe("Defaults", func() {
 						StaleSyncPeriod: &metav1.Duration{
 							Duration: 12 * time.Hour,
 						},
+						Lifecycle: &ProjectLifecycleControllerConfiguration{
+							InactivityArchivalDays:   ptr.To(180),
+							ArchivalPeriodDays:       ptr.To(14),
+							DeletionNotificationDays: []int{3},
+						},
 					},
 				},
 			}
@@ -423,6 +445,7 @@ var _ = Describe("Defaults", func() {
 		It("should default QuotaControllerConfiguration correctly", func() {
 			expected := &QuotaControllerConfiguration{
 				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+				SyncPeriod:      &metav1.Duration{Duration: time.Minute},
 			}
 			SetObjectDefaults_ControllerManagerConfiguration(obj)
 
@@ -434,6 +457,7 @@ var _ = Describe("Defaults", func() {
 				Controllers: ControllerManagerControllerConfiguration{
 					Quota: &QuotaControllerConfiguration{
 						ConcurrentSyncs: ptr.To(10),
+						SyncPeriod:      &metav1.Duration{Duration: 5 * time.Minute},
 					},
 				},
 			}
@@ -607,6 +631,7 @@ var _ = Describe("Defaults", func() {
 			expected := &ShootMaintenanceControllerConfiguration{
 				ConcurrentSyncs:                  ptr.To(DefaultControllerConcurrentSyncs),
 				EnableShootControlPlaneRestarter: ptr.To(true),
+				UpcomingMaintenanceSyncPeriod:    &metav1.Duration{Duration: time.Hour},
 			}
 			SetObjectDefaults_ControllerManagerConfiguration(obj)
 
@@ -619,6 +644,7 @@ var _ = Describe("Defaults", func() {
 					ShootMaintenance: ShootMaintenanceControllerConfiguration{
 						ConcurrentSyncs:                  ptr.To(10),
 						EnableShootControlPlaneRestarter: ptr.To(false),
+						UpcomingMaintenanceSyncPeriod:    &metav1.Duration{Duration: 30 * time.Minute},
 					},
 				},
 			}
@@ -747,6 +773,66 @@ var _ = Describe("Defaults", func() {
 
 			Expect(obj.Controllers.Event).To(Equal(expected))
 		})
+
+		It("should default the archive configuration", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					Event: &EventControllerConfiguration{
+						Archive: &EventArchiveConfiguration{HTTP: &EventArchiveHTTPSink{URL: "https://archive.example.com"}},
+					},
+				},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.Event.Archive).To(Equal(&EventArchiveConfiguration{
+				BatchSize:     ptr.To(500),
+				FlushInterval: &metav1.Duration{Duration: time.Minute},
+				HTTP: &EventArchiveHTTPSink{
+					URL:     "https://archive.example.com",
+					Timeout: &metav1.Duration{Duration: 30 * time.Second},
+				},
+			}))
+		})
+	})
+
+	Describe("NotificationControllerConfiguration defaulting", func() {
+		It("should default NotificationControllerConfiguration correctly if set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					Notification: &NotificationControllerConfiguration{},
+				},
+			}
+			expected := &NotificationControllerConfiguration{
+				ConcurrentSyncs:     ptr.To(DefaultControllerConcurrentSyncs),
+				DeduplicationWindow: &metav1.Duration{Duration: 1 * time.Hour},
+				WebhookTimeout:      &metav1.Duration{Duration: 10 * time.Second},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.Notification).To(Equal(expected))
+		})
+
+		It("should not default NotificationControllerConfiguration if not set", func() {
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.Notification).To(BeNil())
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					Notification: &NotificationControllerConfiguration{
+						ConcurrentSyncs:     ptr.To(10),
+						DeduplicationWindow: &metav1.Duration{Duration: 2 * time.Hour},
+						WebhookTimeout:      &metav1.Duration{Duration: 5 * time.Second},
+					},
+				},
+			}
+			expected := obj.Controllers.Notification.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.Notification).To(Equal(expected))
+		})
 	})
 
 	Describe("ShootStatusLabelControllerConfiguration defaulting", func() {
@@ -774,6 +860,44 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ShootRebalancingControllerConfiguration defaulting", func() {
+		It("should not default ShootRebalancingControllerConfiguration", func() {
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootRebalancing).To(BeNil())
+		})
+
+		It("should default ShootRebalancingControllerConfiguration correctly", func() {
+			obj.Controllers.ShootRebalancing = &ShootRebalancingControllerConfiguration{}
+			expected := &ShootRebalancingControllerConfiguration{
+				SyncPeriod:           &metav1.Duration{Duration: 10 * time.Minute},
+				MaxMigrationsPerSeed: ptr.To[int32](1),
+				MaxMigrations:        ptr.To[int32](5),
+				Scheduler:            &schedulerconfigv1alpha1.ShootSchedulerConfiguration{Strategy: schedulerconfigv1alpha1.SameRegion},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootRebalancing).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootRebalancing: &ShootRebalancingControllerConfiguration{
+						SyncPeriod:           &metav1.Duration{Duration: time.Hour},
+						MaxMigrationsPerSeed: ptr.To[int32](2),
+						MaxMigrations:        ptr.To[int32](10),
+						Scheduler:            &schedulerconfigv1alpha1.ShootSchedulerConfiguration{Strategy: schedulerconfigv1alpha1.MinimalDistance},
+					},
+				},
+			}
+			expected := obj.Controllers.ShootRebalancing.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootRebalancing).To(Equal(expected))
+		})
+	})
+
 	Describe("ShootMigrationControllerConfiguration defaulting", func() {
 		It("should default ShootMigrationControllerConfiguration correctly", func() {
 			expected := &ShootMigrationControllerConfiguration{
@@ -8,6 +8,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -67,6 +70,10 @@ type ControllerManagerControllerConfiguration struct {
 	// ExposureClass defines the configuration of the ExposureClass controller.
 	// +optional
 	ExposureClass *ExposureClassControllerConfiguration `json:"exposureClass,omitempty"`
+	// Notification defines the configuration of the Notification controller. If unset, the notification controller
+	// will be disabled.
+	// +optional
+	Notification *NotificationControllerConfiguration `json:"notification,omitempty"`
 	// Project defines the configuration of the Project controller.
 	// +optional
 	Project *ProjectControllerConfiguration `json:"project,omitempty"`
@@ -113,6 +120,10 @@ type ControllerManagerControllerConfiguration struct {
 	// ShootMigration defines the configuration of the ShootMigration controller. If unspecified, it is defaulted with `concurrentSyncs=5`.
 	// +optional
 	ShootMigration *ShootMigrationControllerConfiguration `json:"shootMigration,omitempty"`
+	// ShootRebalancing defines the configuration of the ShootRebalancing controller. If unset, the controller is
+	// disabled.
+	// +optional
+	ShootRebalancing *ShootRebalancingControllerConfiguration `json:"shootRebalancing,omitempty"`
 	// ManagedSeedSet defines the configuration of the ManagedSeedSet controller.
 	// +optional
 	ManagedSeedSet *ManagedSeedSetControllerConfiguration `json:"managedSeedSet,omitempty"`
@@ -188,6 +199,63 @@ type EventControllerConfiguration struct {
 	// TTLNonShootEvents is the time-to-live for all non-shoot related events (defaults to `1h`).
 	// +optional
 	TTLNonShootEvents *metav1.Duration `json:"ttlNonShootEvents,omitempty"`
+	// Archive defines the configuration for archiving events before they are deleted, either by this controller or
+	// because their time-to-live in the kube-apiserver expired. If unset, events are not archived.
+	// +optional
+	Archive *EventArchiveConfiguration `json:"archive,omitempty"`
+}
+
+// EventArchiveConfiguration defines the configuration for archiving events. Exactly one sink must be configured. Object
+// store buckets are not supported as sink since their clients and credentials are provider-specific, use the HTTP sink
+// with a log collector forwarding to a bucket instead.
+type EventArchiveConfiguration struct {
+	// BatchSize is the maximum number of events which are written to the sink at once (defaults to `500`).
+	// +optional
+	BatchSize *int `json:"batchSize,omitempty"`
+	// FlushInterval is the interval in which the collected events are written to the sink (defaults to `1m`).
+	// +optional
+	FlushInterval *metav1.Duration `json:"flushInterval,omitempty"`
+	// File configures archiving events to an append-only file.
+	// +optional
+	File *EventArchiveFileSink `json:"file,omitempty"`
+	// HTTP configures archiving events to an HTTP endpoint.
+	// +optional
+	HTTP *EventArchiveHTTPSink `json:"http,omitempty"`
+}
+
+// EventArchiveFileSink defines the configuration for archiving events to an append-only file.
+type EventArchiveFileSink struct {
+	// Path is the absolute path of the file to which the events are appended as JSON lines.
+	Path string `json:"path"`
+}
+
+// EventArchiveHTTPSink defines the configuration for archiving events to an HTTP endpoint.
+type EventArchiveHTTPSink struct {
+	// URL is the URL of the endpoint to which the events are sent as JSON lines with HTTP POST requests.
+	URL string `json:"url"`
+	// Timeout is the timeout for requests to the endpoint (defaults to `30s`).
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// NotificationControllerConfiguration defines the configuration of the Notification controller.
+type NotificationControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// DeduplicationWindow is the time window in which an event is sent only once to a sink, even if it recurs
+	// (defaults to `1h`).
+	// +optional
+	DeduplicationWindow *metav1.Duration `json:"deduplicationWindow,omitempty"`
+	// WebhookTimeout is the timeout for requests to webhooks (defaults to `10s`).
+	// +optional
+	WebhookTimeout *metav1.Duration `json:"webhookTimeout,omitempty"`
+	// BlockCIDRs is a list of additional networks (e.g., the pod and service networks of the cluster) to which no
+	// notifications are sent. Loopback, private, link-local, shared (100.64.0.0/10), unspecified and multicast addresses are
+	// always blocked.
+	// +optional
+	BlockCIDRs []string `json:"blockCIDRs,omitempty"`
 }
 
 // ExposureClassControllerConfiguration defines the configuration of the
@@ -224,6 +292,25 @@ type ProjectControllerConfiguration struct {
 	// StaleSyncPeriod is the duration how often the reconciliation loop for stale Projects is executed.
 	// +optional
 	StaleSyncPeriod *metav1.Duration `json:"staleSyncPeriod,omitempty"`
+	// Lifecycle is the configuration of the lifecycle reconciler which archives expired or inactive `Project`s and
+	// deletes them after the archival period. The reconciler is only enabled if this is set.
+	// +optional
+	Lifecycle *ProjectLifecycleControllerConfiguration `json:"lifecycle,omitempty"`
+}
+
+// ProjectLifecycleControllerConfiguration defines the configuration of the Project lifecycle reconciler.
+type ProjectLifecycleControllerConfiguration struct {
+	// InactivityArchivalDays is the number of days without any activity after which a `Project` is archived. If not set,
+	// `Project`s are not archived due to inactivity.
+	// +optional
+	InactivityArchivalDays *int `json:"inactivityArchivalDays,omitempty"`
+	// ArchivalPeriodDays is the number of days an archived `Project` is kept before it is deleted automatically.
+	// +optional
+	ArchivalPeriodDays *int `json:"archivalPeriodDays,omitempty"`
+	// DeletionNotificationDays is a list of numbers of days before the automatic deletion of a `Project` at which its
+	// owner and members are notified.
+	// +optional
+	DeletionNotificationDays []int `json:"deletionNotificationDays,omitempty"`
 }
 
 // QuotaConfiguration defines quota configurations.
@@ -242,6 +329,10 @@ type QuotaControllerConfiguration struct {
 	// events.
 	// +optional
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the usage of the Quotas is calculated and published in their status
+	// (defaults to '1m').
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
 }
 
 // SecretBindingControllerConfiguration defines the configuration of the
@@ -337,6 +428,14 @@ type ShootMaintenanceControllerConfiguration struct {
 	// EnableShootCoreAddonRestarter configures whether some core addons to be restarted during maintenance.
 	// +optional
 	EnableShootCoreAddonRestarter *bool `json:"enableShootCoreAddonRestarter"`
+	// MaintenanceFreezes is a list of periods during which automatic version updates and credentials rotations are not
+	// performed for any shoot of the landscape. Projects can define additional maintenance freezes for their shoots.
+	// +optional
+	MaintenanceFreezes []gardencorev1beta1.MaintenanceFreeze `json:"maintenanceFreezes,omitempty"`
+	// UpcomingMaintenanceSyncPeriod is the duration how often the preview of the upcoming maintenance published in the
+	// Shoot status is refreshed outside of the maintenance time window (defaults to '1h').
+	// +optional
+	UpcomingMaintenanceSyncPeriod *metav1.Duration `json:"upcomingMaintenanceSyncPeriod,omitempty"`
 }
 
 // ShootQuotaControllerConfiguration defines the configuration of the
@@ -419,6 +518,30 @@ type ShootMigrationControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// ShootRebalancingControllerConfiguration defines the configuration of the
+// ShootRebalancing controller.
+type ShootRebalancingControllerConfiguration struct {
+	// SyncPeriod is the duration how often seeds are checked for pressure (defaults to '10m').
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// MaxMigrationsPerSeed is the maximum number of concurrent control plane migrations away from a single seed
+	// (defaults to 1).
+	// +optional
+	MaxMigrationsPerSeed *int32 `json:"maxMigrationsPerSeed,omitempty"`
+	// MaxMigrations is the maximum number of concurrent control plane migrations in the whole landscape (defaults to 5).
+	// +optional
+	MaxMigrations *int32 `json:"maxMigrations,omitempty"`
+	// ResourceUtilizationThresholds are the maximum shares (in percent) of the seed nodes' allocatable resources which
+	// may be requested by shoot control planes. Seeds exceeding one of the thresholds are considered to be under pressure.
+	// Only `cpu` and `memory` are supported.
+	// +optional
+	ResourceUtilizationThresholds map[corev1.ResourceName]int32 `json:"resourceUtilizationThresholds,omitempty"`
+	// Scheduler is the configuration used for determining the destination seed of a control plane migration. If unset,
+	// the `SameRegion` strategy and all built-in filters are used.
+	// +optional
+	Scheduler *schedulerconfigv1alpha1.ShootSchedulerConfiguration `json:"scheduler,omitempty"`
+}
+
 // ManagedSeedSetControllerConfiguration defines the configuration of the
 // ManagedSeedSet controller.
 type ManagedSeedSetControllerConfiguration struct {
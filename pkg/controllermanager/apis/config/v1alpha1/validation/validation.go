@@ -5,13 +5,22 @@
 package validation
 
 import (
+	"fmt"
+	"net/netip"
+	"net/url"
+	"path/filepath"
+
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencorevalidation "github.com/gardener/gardener/pkg/apis/core/validation"
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/logger"
+	schedulerconfigvalidation "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1/validation"
 	validationutils "github.com/gardener/gardener/pkg/utils/validation"
 )
 
@@ -46,11 +55,29 @@ func validateControllerManagerControllerConfiguration(conf controllermanagerconf
 		allErrs = append(allErrs, validateProjectControllerConfiguration(conf.Project, projectFldPath)...)
 	}
 
+	eventFldPath := fldPath.Child("event")
+	if conf.Event != nil && conf.Event.Archive != nil {
+		allErrs = append(allErrs, validateEventArchiveConfiguration(conf.Event.Archive, eventFldPath.Child("archive"))...)
+	}
+
+	notificationFldPath := fldPath.Child("notification")
+	if conf.Notification != nil {
+		allErrs = append(allErrs, validateNotificationControllerConfiguration(conf.Notification, notificationFldPath)...)
+	}
+
 	shootStateFldPath := fldPath.Child("shootState")
 	if conf.ShootState != nil {
 		allErrs = append(allErrs, validateShootStateControllerConfiguration(conf.ShootState, shootStateFldPath)...)
 	}
 
+	shootMaintenanceFldPath := fldPath.Child("shootMaintenance")
+	allErrs = append(allErrs, validateShootMaintenanceControllerConfiguration(conf.ShootMaintenance, shootMaintenanceFldPath)...)
+
+	shootRebalancingFldPath := fldPath.Child("shootRebalancing")
+	if conf.ShootRebalancing != nil {
+		allErrs = append(allErrs, validateShootRebalancingControllerConfiguration(conf.ShootRebalancing, shootRebalancingFldPath)...)
+	}
+
 	return allErrs
 }
 
@@ -59,6 +86,29 @@ func validateProjectControllerConfiguration(conf *controllermanagerconfigv1alpha
 	for i, quotaConfig := range conf.Quotas {
 		allErrs = append(allErrs, validateProjectQuotaConfiguration(quotaConfig, fldPath.Child("quotas").Index(i))...)
 	}
+	if conf.Lifecycle != nil {
+		allErrs = append(allErrs, validateProjectLifecycleControllerConfiguration(conf.Lifecycle, fldPath.Child("lifecycle"))...)
+	}
+	return allErrs
+}
+
+func validateProjectLifecycleControllerConfiguration(conf *controllermanagerconfigv1alpha1.ProjectLifecycleControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.InactivityArchivalDays != nil && *conf.InactivityArchivalDays <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("inactivityArchivalDays"), *conf.InactivityArchivalDays, "must be greater than 0"))
+	}
+	if conf.ArchivalPeriodDays == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("archivalPeriodDays"), "must provide the archival period"))
+	} else if *conf.ArchivalPeriodDays <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("archivalPeriodDays"), *conf.ArchivalPeriodDays, "must be greater than 0"))
+	}
+	for i, days := range conf.DeletionNotificationDays {
+		if days <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("deletionNotificationDays").Index(i), days, "must be greater than 0"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -70,6 +120,59 @@ func validateProjectQuotaConfiguration(conf controllermanagerconfigv1alpha1.Quot
 	return allErrs
 }
 
+func validateEventArchiveConfiguration(conf *controllermanagerconfigv1alpha1.EventArchiveConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.BatchSize != nil && *conf.BatchSize <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("batchSize"), *conf.BatchSize, "must be greater than 0"))
+	}
+	if conf.FlushInterval != nil && conf.FlushInterval.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("flushInterval"), conf.FlushInterval.Duration.String(), "must be greater than 0"))
+	}
+
+	switch {
+	case conf.File == nil && conf.HTTP == nil:
+		allErrs = append(allErrs, field.Required(fldPath, "exactly one sink must be configured"))
+	case conf.File != nil && conf.HTTP != nil:
+		allErrs = append(allErrs, field.Forbidden(fldPath, "exactly one sink must be configured"))
+	}
+
+	if conf.File != nil && !filepath.IsAbs(conf.File.Path) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("file", "path"), conf.File.Path, "must be an absolute path"))
+	}
+
+	if conf.HTTP != nil {
+		if u, err := url.Parse(conf.HTTP.URL); err != nil || len(u.Host) == 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("http", "url"), conf.HTTP.URL, "must be a valid absolute URL"))
+		} else if u.Scheme != "http" && u.Scheme != "https" {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("http", "url"), u.Scheme, []string{"http", "https"}))
+		}
+		if conf.HTTP.Timeout != nil && conf.HTTP.Timeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("http", "timeout"), conf.HTTP.Timeout.Duration.String(), "must be greater than 0"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateNotificationControllerConfiguration(conf *controllermanagerconfigv1alpha1.NotificationControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.DeduplicationWindow != nil && conf.DeduplicationWindow.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("deduplicationWindow"), conf.DeduplicationWindow.Duration.String(), "must be greater than 0"))
+	}
+	if conf.WebhookTimeout != nil && conf.WebhookTimeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("webhookTimeout"), conf.WebhookTimeout.Duration.String(), "must be greater than 0"))
+	}
+	for i, cidr := range conf.BlockCIDRs {
+		if _, err := netip.ParsePrefix(cidr); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("blockCIDRs").Index(i), cidr, fmt.Sprintf("must be a valid CIDR: %v", err)))
+		}
+	}
+
+	return allErrs
+}
+
 func validateShootStateControllerConfiguration(conf *controllermanagerconfigv1alpha1.ShootStateControllerConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if conf.ConcurrentSyncs != nil {
@@ -77,3 +180,34 @@ func validateShootStateControllerConfiguration(conf *controllermanagerconfigv1al
 	}
 	return allErrs
 }
+
+func validateShootMaintenanceControllerConfiguration(conf controllermanagerconfigv1alpha1.ShootMaintenanceControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	freezes := make([]gardencore.MaintenanceFreeze, len(conf.MaintenanceFreezes))
+	for i := range conf.MaintenanceFreezes {
+		if err := gardencorev1beta1.Convert_v1beta1_MaintenanceFreeze_To_core_MaintenanceFreeze(&conf.MaintenanceFreezes[i], &freezes[i], nil); err != nil {
+			allErrs = append(allErrs, field.InternalError(fldPath.Child("maintenanceFreezes").Index(i), err))
+		}
+	}
+	allErrs = append(allErrs, gardencorevalidation.ValidateMaintenanceFreezes(freezes, fldPath.Child("maintenanceFreezes"))...)
+
+	return allErrs
+}
+
+func validateShootRebalancingControllerConfiguration(conf *controllermanagerconfigv1alpha1.ShootRebalancingControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.MaxMigrationsPerSeed != nil && *conf.MaxMigrationsPerSeed <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxMigrationsPerSeed"), *conf.MaxMigrationsPerSeed, "must be greater than 0"))
+	}
+	if conf.MaxMigrations != nil && *conf.MaxMigrations <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxMigrations"), *conf.MaxMigrations, "must be greater than 0"))
+	}
+	allErrs = append(allErrs, schedulerconfigvalidation.ValidateResourceUtilizationThresholds(conf.ResourceUtilizationThresholds, fldPath.Child("resourceUtilizationThresholds"))...)
+	if conf.Scheduler != nil {
+		allErrs = append(allErrs, schedulerconfigvalidation.ValidateShootSchedulerConfiguration(conf.Scheduler, fldPath.Child("scheduler"))...)
+	}
+
+	return allErrs
+}
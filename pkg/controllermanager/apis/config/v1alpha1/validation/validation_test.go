@@ -5,6 +5,8 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -14,8 +16,10 @@ import (
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 	"k8s.io/utils/ptr"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
 	. "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1/validation"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
 )
 
 var _ = Describe("#ValidateControllerManagerConfiguration", func() {
@@ -155,6 +159,179 @@ var _ = Describe("#ValidateControllerManagerConfiguration", func() {
 				))
 			})
 		})
+
+		Context("Lifecycle", func() {
+			BeforeEach(func() {
+				conf.Controllers.Project = &controllermanagerconfigv1alpha1.ProjectControllerConfiguration{
+					Lifecycle: &controllermanagerconfigv1alpha1.ProjectLifecycleControllerConfiguration{},
+				}
+			})
+
+			It("should pass because the lifecycle configuration is valid", func() {
+				conf.Controllers.Project.Lifecycle.InactivityArchivalDays = ptr.To(180)
+				conf.Controllers.Project.Lifecycle.ArchivalPeriodDays = ptr.To(30)
+				conf.Controllers.Project.Lifecycle.DeletionNotificationDays = []int{7, 1}
+
+				Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+			})
+
+			It("should fail because the archival period is not set", func() {
+				Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.project.lifecycle.archivalPeriodDays"),
+					})),
+				))
+			})
+
+			It("should fail because the lifecycle configuration is invalid", func() {
+				conf.Controllers.Project.Lifecycle.InactivityArchivalDays = ptr.To(0)
+				conf.Controllers.Project.Lifecycle.ArchivalPeriodDays = ptr.To(0)
+				conf.Controllers.Project.Lifecycle.DeletionNotificationDays = []int{7, 0}
+
+				Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.project.lifecycle.inactivityArchivalDays"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.project.lifecycle.archivalPeriodDays"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.project.lifecycle.deletionNotificationDays[1]"),
+					})),
+				))
+			})
+		})
+	})
+
+	Context("EventControllerConfiguration", func() {
+		Context("Archive", func() {
+			BeforeEach(func() {
+				conf.Controllers.Event = &controllermanagerconfigv1alpha1.EventControllerConfiguration{
+					Archive: &controllermanagerconfigv1alpha1.EventArchiveConfiguration{
+						BatchSize:     ptr.To(500),
+						FlushInterval: &metav1.Duration{Duration: time.Minute},
+						File:          &controllermanagerconfigv1alpha1.EventArchiveFileSink{Path: "/var/lib/events/archive.jsonl"},
+					},
+				}
+			})
+
+			It("should allow valid configurations", func() {
+				Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+
+				conf.Controllers.Event.Archive.File = nil
+				conf.Controllers.Event.Archive.HTTP = &controllermanagerconfigv1alpha1.EventArchiveHTTPSink{URL: "https://archive.example.com/events"}
+				Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+			})
+
+			It("should forbid invalid batch sizes and flush intervals", func() {
+				conf.Controllers.Event.Archive.BatchSize = ptr.To(0)
+				conf.Controllers.Event.Archive.FlushInterval.Duration = 0
+
+				Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.event.archive.batchSize"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.event.archive.flushInterval"),
+					})),
+				))
+			})
+
+			It("should require a sink", func() {
+				conf.Controllers.Event.Archive.File = nil
+
+				Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.event.archive"),
+					})),
+				))
+			})
+
+			It("should forbid multiple sinks", func() {
+				conf.Controllers.Event.Archive.HTTP = &controllermanagerconfigv1alpha1.EventArchiveHTTPSink{URL: "https://archive.example.com/events"}
+
+				Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("controllers.event.archive"),
+					})),
+				))
+			})
+
+			It("should forbid relative file paths", func() {
+				conf.Controllers.Event.Archive.File.Path = "archive.jsonl"
+
+				Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.event.archive.file.path"),
+					})),
+				))
+			})
+
+			It("should forbid invalid HTTP endpoints", func() {
+				conf.Controllers.Event.Archive.File = nil
+				conf.Controllers.Event.Archive.HTTP = &controllermanagerconfigv1alpha1.EventArchiveHTTPSink{URL: "ftp://archive.example.com", Timeout: &metav1.Duration{}}
+
+				Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("controllers.event.archive.http.url"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.event.archive.http.timeout"),
+					})),
+				))
+			})
+		})
+	})
+
+	Context("NotificationControllerConfiguration", func() {
+		BeforeEach(func() {
+			conf.Controllers.Notification = &controllermanagerconfigv1alpha1.NotificationControllerConfiguration{
+				DeduplicationWindow: &metav1.Duration{Duration: time.Hour},
+				WebhookTimeout:      &metav1.Duration{Duration: 10 * time.Second},
+			}
+		})
+
+		It("should allow valid configurations", func() {
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should forbid non-positive durations", func() {
+			conf.Controllers.Notification.DeduplicationWindow.Duration = 0
+			conf.Controllers.Notification.WebhookTimeout.Duration = -time.Second
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.notification.deduplicationWindow"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.notification.webhookTimeout"),
+				})),
+			))
+		})
+
+		It("should forbid invalid blocked CIDRs", func() {
+			conf.Controllers.Notification.BlockCIDRs = []string{"100.64.0.0/10", "foo"}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.notification.blockCIDRs[1]"),
+				})),
+			))
+		})
 	})
 
 	Context("ShootStateControllerConfiguration", func() {
@@ -193,4 +370,97 @@ var _ = Describe("#ValidateControllerManagerConfiguration", func() {
 			})
 		})
 	})
+
+	Context("ShootMaintenanceControllerConfiguration", func() {
+		var (
+			begin = metav1.Date(2026, 12, 20, 0, 0, 0, 0, time.UTC)
+			end   = metav1.Date(2027, 1, 6, 0, 0, 0, 0, time.UTC)
+		)
+
+		It("should allow valid maintenance freezes", func() {
+			conf.Controllers.ShootMaintenance.MaintenanceFreezes = []gardencorev1beta1.MaintenanceFreeze{
+				{Name: "year-end", Begin: begin, End: end, Recurrence: ptr.To(gardencorev1beta1.MaintenanceFreezeRecurrenceYearly)},
+			}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should forbid invalid maintenance freezes", func() {
+			conf.Controllers.ShootMaintenance.MaintenanceFreezes = []gardencorev1beta1.MaintenanceFreeze{
+				{Begin: begin, End: end},
+				{Name: "year-end", Begin: end, End: begin},
+			}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.shootMaintenance.maintenanceFreezes[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootMaintenance.maintenanceFreezes[1].end"),
+				})),
+			))
+		})
+	})
+
+	Context("ShootRebalancingControllerConfiguration", func() {
+		BeforeEach(func() {
+			conf.Controllers.ShootRebalancing = &controllermanagerconfigv1alpha1.ShootRebalancingControllerConfiguration{
+				MaxMigrationsPerSeed:          ptr.To[int32](1),
+				MaxMigrations:                 ptr.To[int32](5),
+				ResourceUtilizationThresholds: map[corev1.ResourceName]int32{corev1.ResourceCPU: 80},
+				Scheduler:                     &schedulerconfigv1alpha1.ShootSchedulerConfiguration{Strategy: schedulerconfigv1alpha1.SameRegion},
+			}
+		})
+
+		It("should allow a valid configuration", func() {
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should forbid invalid concurrency limits", func() {
+			conf.Controllers.ShootRebalancing.MaxMigrationsPerSeed = ptr.To[int32](0)
+			conf.Controllers.ShootRebalancing.MaxMigrations = ptr.To[int32](-1)
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootRebalancing.maxMigrationsPerSeed"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootRebalancing.maxMigrations"),
+				})),
+			))
+		})
+
+		It("should forbid invalid resource utilization thresholds", func() {
+			conf.Controllers.ShootRebalancing.ResourceUtilizationThresholds = map[corev1.ResourceName]int32{
+				corev1.ResourceCPU:     101,
+				corev1.ResourceStorage: 50,
+			}
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shootRebalancing.resourceUtilizationThresholds[cpu]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("controllers.shootRebalancing.resourceUtilizationThresholds[storage]"),
+				})),
+			))
+		})
+
+		It("should forbid an invalid scheduler configuration", func() {
+			conf.Controllers.ShootRebalancing.Scheduler.Strategy = "foo"
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("controllers.shootRebalancing.scheduler.strategy"),
+				})),
+			))
+		})
+	})
 })
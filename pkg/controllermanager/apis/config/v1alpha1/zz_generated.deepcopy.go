@@ -10,6 +10,9 @@
 package v1alpha1
 
 import (
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	apisconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	configv1alpha1 "k8s.io/component-base/config/v1alpha1"
@@ -209,6 +212,11 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(ExposureClassControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Notification != nil {
+		in, out := &in.Notification, &out.Notification
+		*out = new(NotificationControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Project != nil {
 		in, out := &in.Project, &out.Project
 		*out = new(ProjectControllerConfiguration)
@@ -281,6 +289,11 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(ShootMigrationControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootRebalancing != nil {
+		in, out := &in.ShootRebalancing, &out.ShootRebalancing
+		*out = new(ShootRebalancingControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ManagedSeedSet != nil {
 		in, out := &in.ManagedSeedSet, &out.ManagedSeedSet
 		*out = new(ManagedSeedSetControllerConfiguration)
@@ -346,6 +359,79 @@ func (in *CredentialsBindingControllerConfiguration) DeepCopy() *CredentialsBind
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventArchiveConfiguration) DeepCopyInto(out *EventArchiveConfiguration) {
+	*out = *in
+	if in.BatchSize != nil {
+		in, out := &in.BatchSize, &out.BatchSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.FlushInterval != nil {
+		in, out := &in.FlushInterval, &out.FlushInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(EventArchiveFileSink)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(EventArchiveHTTPSink)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventArchiveConfiguration.
+func (in *EventArchiveConfiguration) DeepCopy() *EventArchiveConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(EventArchiveConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventArchiveFileSink) DeepCopyInto(out *EventArchiveFileSink) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventArchiveFileSink.
+func (in *EventArchiveFileSink) DeepCopy() *EventArchiveFileSink {
+	if in == nil {
+		return nil
+	}
+	out := new(EventArchiveFileSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventArchiveHTTPSink) DeepCopyInto(out *EventArchiveHTTPSink) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventArchiveHTTPSink.
+func (in *EventArchiveHTTPSink) DeepCopy() *EventArchiveHTTPSink {
+	if in == nil {
+		return nil
+	}
+	out := new(EventArchiveHTTPSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventControllerConfiguration) DeepCopyInto(out *EventControllerConfiguration) {
 	*out = *in
@@ -359,6 +445,11 @@ func (in *EventControllerConfiguration) DeepCopyInto(out *EventControllerConfigu
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.Archive != nil {
+		in, out := &in.Archive, &out.Archive
+		*out = new(EventArchiveConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -441,6 +532,42 @@ func (in *NamespacedCloudProfileControllerConfiguration) DeepCopy() *NamespacedC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationControllerConfiguration) DeepCopyInto(out *NotificationControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.DeduplicationWindow != nil {
+		in, out := &in.DeduplicationWindow, &out.DeduplicationWindow
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.WebhookTimeout != nil {
+		in, out := &in.WebhookTimeout, &out.WebhookTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.BlockCIDRs != nil {
+		in, out := &in.BlockCIDRs, &out.BlockCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationControllerConfiguration.
+func (in *NotificationControllerConfiguration) DeepCopy() *NotificationControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectControllerConfiguration) DeepCopyInto(out *ProjectControllerConfiguration) {
 	*out = *in
@@ -476,6 +603,11 @@ func (in *ProjectControllerConfiguration) DeepCopyInto(out *ProjectControllerCon
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = new(ProjectLifecycleControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -489,6 +621,37 @@ func (in *ProjectControllerConfiguration) DeepCopy() *ProjectControllerConfigura
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectLifecycleControllerConfiguration) DeepCopyInto(out *ProjectLifecycleControllerConfiguration) {
+	*out = *in
+	if in.InactivityArchivalDays != nil {
+		in, out := &in.InactivityArchivalDays, &out.InactivityArchivalDays
+		*out = new(int)
+		**out = **in
+	}
+	if in.ArchivalPeriodDays != nil {
+		in, out := &in.ArchivalPeriodDays, &out.ArchivalPeriodDays
+		*out = new(int)
+		**out = **in
+	}
+	if in.DeletionNotificationDays != nil {
+		in, out := &in.DeletionNotificationDays, &out.DeletionNotificationDays
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectLifecycleControllerConfiguration.
+func (in *ProjectLifecycleControllerConfiguration) DeepCopy() *ProjectLifecycleControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectLifecycleControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QuotaConfiguration) DeepCopyInto(out *QuotaConfiguration) {
 	*out = *in
@@ -519,6 +682,11 @@ func (in *QuotaControllerConfiguration) DeepCopyInto(out *QuotaControllerConfigu
 		*out = new(int)
 		**out = **in
 	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -779,6 +947,18 @@ func (in *ShootMaintenanceControllerConfiguration) DeepCopyInto(out *ShootMainte
 		*out = new(bool)
 		**out = **in
 	}
+	if in.MaintenanceFreezes != nil {
+		in, out := &in.MaintenanceFreezes, &out.MaintenanceFreezes
+		*out = make([]v1beta1.MaintenanceFreeze, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UpcomingMaintenanceSyncPeriod != nil {
+		in, out := &in.UpcomingMaintenanceSyncPeriod, &out.UpcomingMaintenanceSyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -839,6 +1019,49 @@ func (in *ShootQuotaControllerConfiguration) DeepCopy() *ShootQuotaControllerCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootRebalancingControllerConfiguration) DeepCopyInto(out *ShootRebalancingControllerConfiguration) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxMigrationsPerSeed != nil {
+		in, out := &in.MaxMigrationsPerSeed, &out.MaxMigrationsPerSeed
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxMigrations != nil {
+		in, out := &in.MaxMigrations, &out.MaxMigrations
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ResourceUtilizationThresholds != nil {
+		in, out := &in.ResourceUtilizationThresholds, &out.ResourceUtilizationThresholds
+		*out = make(map[corev1.ResourceName]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Scheduler != nil {
+		in, out := &in.Scheduler, &out.Scheduler
+		*out = new(apisconfigv1alpha1.ShootSchedulerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootRebalancingControllerConfiguration.
+func (in *ShootRebalancingControllerConfiguration) DeepCopy() *ShootRebalancingControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootRebalancingControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootReferenceControllerConfiguration) DeepCopyInto(out *ShootReferenceControllerConfiguration) {
 	*out = *in
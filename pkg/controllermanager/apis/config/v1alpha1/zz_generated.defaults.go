@@ -47,12 +47,24 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	}
 	if in.Controllers.Event != nil {
 		SetDefaults_EventControllerConfiguration(in.Controllers.Event)
+		if in.Controllers.Event.Archive != nil {
+			SetDefaults_EventArchiveConfiguration(in.Controllers.Event.Archive)
+			if in.Controllers.Event.Archive.HTTP != nil {
+				SetDefaults_EventArchiveHTTPSink(in.Controllers.Event.Archive.HTTP)
+			}
+		}
 	}
 	if in.Controllers.ExposureClass != nil {
 		SetDefaults_ExposureClassControllerConfiguration(in.Controllers.ExposureClass)
 	}
+	if in.Controllers.Notification != nil {
+		SetDefaults_NotificationControllerConfiguration(in.Controllers.Notification)
+	}
 	if in.Controllers.Project != nil {
 		SetDefaults_ProjectControllerConfiguration(in.Controllers.Project)
+		if in.Controllers.Project.Lifecycle != nil {
+			SetDefaults_ProjectLifecycleControllerConfiguration(in.Controllers.Project.Lifecycle)
+		}
 	}
 	if in.Controllers.Quota != nil {
 		SetDefaults_QuotaControllerConfiguration(in.Controllers.Quota)
@@ -95,6 +107,9 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.ShootMigration != nil {
 		SetDefaults_ShootMigrationControllerConfiguration(in.Controllers.ShootMigration)
 	}
+	if in.Controllers.ShootRebalancing != nil {
+		SetDefaults_ShootRebalancingControllerConfiguration(in.Controllers.ShootRebalancing)
+	}
 	if in.Controllers.ManagedSeedSet != nil {
 		SetDefaults_ManagedSeedSetControllerConfiguration(in.Controllers.ManagedSeedSet)
 	}
@@ -5,12 +5,21 @@
 package event
 
 import (
+	"context"
+	"fmt"
+
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/gardener/gardener/pkg/controllerutils"
 	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
@@ -27,14 +36,62 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 	if r.Clock == nil {
 		r.Clock = clock.RealClock{}
 	}
+	if r.Archiver == nil && r.Config.Archive != nil {
+		archiver, err := NewArchiver(mgr.GetLogger().WithValues("controller", ControllerName, "component", "archiver"), *r.Config.Archive, r.Clock)
+		if err != nil {
+			return err
+		}
+		r.Archiver = archiver
+	}
 
-	return builder.
+	b := builder.
 		ControllerManagedBy(mgr).
 		Named(ControllerName).
 		For(&corev1.Event{}, builder.WithPredicates(predicateutils.ForEventTypes(predicateutils.Create))).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
 			ReconciliationTimeout:   controllerutils.DefaultReconciliationTimeout,
-		}).
-		Complete(r)
+		})
+
+	if r.Archiver != nil {
+		if err := mgr.Add(r.Archiver); err != nil {
+			return fmt.Errorf("failed adding event archiver to manager: %w", err)
+		}
+		b = b.Watches(&corev1.Event{}, r.ArchiveEventHandler())
+	}
+
+	return b.Complete(r)
+}
+
+// ArchiveEventHandler returns an event handler which adds events to the archive whenever they are created or changed,
+// i.e., before they are deleted by this controller or their time-to-live in the kube-apiserver expires. This way, events
+// which are deleted while the controller is not running are archived as well, as long as they were observed before.
+// Events which exist when the controller starts are archived again, hence the archive can contain duplicates. Updates
+// which only change the metadata of events (e.g., annotations added by other controllers) are not archived since the
+// event itself did not change.
+func (r *Reconciler) ArchiveEventHandler() handler.EventHandler {
+	return &handler.Funcs{
+		CreateFunc: func(_ context.Context, e event.CreateEvent, _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			if ev, ok := e.Object.(*corev1.Event); ok {
+				r.Archiver.Add(ev)
+			}
+		},
+		UpdateFunc: func(_ context.Context, e event.UpdateEvent, _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			ev, ok := e.ObjectNew.(*corev1.Event)
+			if !ok {
+				return
+			}
+			oldEv, ok := e.ObjectOld.(*corev1.Event)
+			if !ok || oldEv.ResourceVersion == ev.ResourceVersion || !eventDataChanged(oldEv, ev) {
+				return
+			}
+			r.Archiver.Add(ev)
+		},
+	}
+}
+
+func eventDataChanged(oldEvent, newEvent *corev1.Event) bool {
+	oldEvent, newEvent = oldEvent.DeepCopy(), newEvent.DeepCopy()
+	oldEvent.ObjectMeta, newEvent.ObjectMeta = metav1.ObjectMeta{}, metav1.ObjectMeta{}
+	return !apiequality.Semantic.DeepEqual(oldEvent, newEvent)
 }
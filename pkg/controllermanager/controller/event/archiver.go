@@ -0,0 +1,218 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package event
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+)
+
+// maxBufferedBatches is the maximum number of batches which are kept in memory if the sink is not available. The oldest
+// events are dropped if this limit is exceeded.
+const maxBufferedBatches = 10
+
+// ArchiveSink writes archived events to a storage.
+type ArchiveSink interface {
+	// Write writes the given events to the storage.
+	Write(ctx context.Context, events []corev1.Event) error
+}
+
+// FileSink appends archived events as JSON lines to a file.
+type FileSink struct {
+	Path string
+}
+
+// Write appends the given events as JSON lines to the file.
+func (s *FileSink) Write(_ context.Context, events []corev1.Event) error {
+	data, err := encodeEvents(events)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(s.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o640)
+	if err != nil {
+		return fmt.Errorf("failed opening archive file: %w", err)
+	}
+
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed writing archive file: %w", err)
+	}
+	return file.Close()
+}
+
+// HTTPSink sends archived events as JSON lines to an HTTP endpoint.
+type HTTPSink struct {
+	URL    string
+	Client *http.Client
+}
+
+// Write sends the given events as JSON lines to the HTTP endpoint.
+func (s *HTTPSink) Write(ctx context.Context, events []corev1.Event) error {
+	data, err := encodeEvents(events)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("archive endpoint responded with status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func encodeEvents(events []corev1.Event) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, event := range events {
+		if err := encoder.Encode(event); err != nil {
+			return nil, fmt.Errorf("failed encoding event %s/%s: %w", event.Namespace, event.Name, err)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// Archiver collects events and writes them in batches to a sink. It must be added to the manager as runnable.
+type Archiver struct {
+	Log           logr.Logger
+	Sink          ArchiveSink
+	Clock         clock.WithTicker
+	BatchSize     int
+	FlushInterval time.Duration
+
+	lock   sync.Mutex
+	events []corev1.Event
+	full   chan struct{}
+}
+
+// NewArchiver creates a new Archiver for the given configuration.
+func NewArchiver(log logr.Logger, config controllermanagerconfigv1alpha1.EventArchiveConfiguration, clock clock.WithTicker) (*Archiver, error) {
+	var sink ArchiveSink
+	switch {
+	case config.File != nil:
+		sink = &FileSink{Path: config.File.Path}
+	case config.HTTP != nil:
+		sink = &HTTPSink{URL: config.HTTP.URL, Client: &http.Client{Timeout: config.HTTP.Timeout.Duration}}
+	default:
+		return nil, fmt.Errorf("no sink configured for archiving events")
+	}
+
+	return &Archiver{
+		Log:           log,
+		Sink:          sink,
+		Clock:         clock,
+		BatchSize:     ptr.Deref(config.BatchSize, 500),
+		FlushInterval: config.FlushInterval.Duration,
+	}, nil
+}
+
+// Add adds the given event to the archive. It is written to the sink with the next batch.
+func (a *Archiver) Add(event *corev1.Event) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.events = append(a.events, *event.DeepCopy())
+	a.dropOverflow()
+
+	if len(a.events) >= a.BatchSize {
+		select {
+		case a.fullChannel() <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Start writes the collected events to the sink whenever a batch is full or the flush interval elapsed. The remaining
+// events are written when the context is cancelled.
+func (a *Archiver) Start(ctx context.Context) error {
+	a.lock.Lock()
+	full := a.fullChannel()
+	a.lock.Unlock()
+
+	ticker := a.Clock.NewTicker(a.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			a.Flush(flushCtx)
+			cancel()
+			return nil
+		case <-ticker.C():
+			a.Flush(ctx)
+		case <-full:
+			a.Flush(ctx)
+		}
+	}
+}
+
+// Flush writes all collected events in batches to the sink. If writing fails, the events are kept and written with
+// the next flush.
+func (a *Archiver) Flush(ctx context.Context) {
+	for {
+		a.lock.Lock()
+		batch := slices.Clone(a.events[:min(len(a.events), a.BatchSize)])
+		a.events = a.events[len(batch):]
+		a.lock.Unlock()
+
+		if len(batch) == 0 {
+			return
+		}
+
+		if err := a.Sink.Write(ctx, batch); err != nil {
+			a.Log.Error(err, "Failed archiving events, retrying with next flush", "count", len(batch))
+
+			a.lock.Lock()
+			a.events = append(batch, a.events...)
+			a.dropOverflow()
+			a.lock.Unlock()
+			return
+		}
+
+		a.Log.V(1).Info("Archived events", "count", len(batch))
+	}
+}
+
+// dropOverflow drops the oldest events if more events are buffered than allowed. The lock must be held by the caller.
+func (a *Archiver) dropOverflow() {
+	if overflow := len(a.events) - maxBufferedBatches*a.BatchSize; overflow > 0 {
+		a.Log.Info("Dropping events which could not be archived", "count", overflow)
+		a.events = a.events[overflow:]
+	}
+}
+
+// fullChannel returns the channel which is signalled when a batch is full. The lock must be held by the caller.
+func (a *Archiver) fullChannel() chan struct{} {
+	if a.full == nil {
+		a.full = make(chan struct{}, 1)
+	}
+	return a.full
+}
@@ -0,0 +1,269 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package event_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/event"
+)
+
+type fakeSink struct {
+	lock    sync.Mutex
+	batches [][]string
+	err     error
+}
+
+func (s *fakeSink) Write(_ context.Context, events []corev1.Event) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.err != nil {
+		return s.err
+	}
+
+	var names []string
+	for _, ev := range events {
+		names = append(names, ev.Name)
+	}
+	s.batches = append(s.batches, names)
+	return nil
+}
+
+func (s *fakeSink) Batches() [][]string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.batches
+}
+
+var _ = Describe("Archiver", func() {
+	var (
+		ctx = context.TODO()
+
+		newEvent = func(name string) *corev1.Event {
+			return &corev1.Event{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden"}, Reason: "Test", Message: "message of " + name}
+		}
+	)
+
+	Describe("FileSink", func() {
+		It("should append the events as JSON lines to the file", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "archive.jsonl")
+			sink := &FileSink{Path: path}
+
+			Expect(sink.Write(ctx, []corev1.Event{*newEvent("foo")})).To(Succeed())
+			Expect(sink.Write(ctx, []corev1.Event{*newEvent("bar"), *newEvent("baz")})).To(Succeed())
+
+			data, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).To(Equal(
+				`{"metadata":{"name":"foo","namespace":"garden"},"involvedObject":{},"reason":"Test","message":"message of foo","source":{},"firstTimestamp":null,"lastTimestamp":null,"eventTime":null,"reportingComponent":"","reportingInstance":""}` + "\n" +
+					`{"metadata":{"name":"bar","namespace":"garden"},"involvedObject":{},"reason":"Test","message":"message of bar","source":{},"firstTimestamp":null,"lastTimestamp":null,"eventTime":null,"reportingComponent":"","reportingInstance":""}` + "\n" +
+					`{"metadata":{"name":"baz","namespace":"garden"},"involvedObject":{},"reason":"Test","message":"message of baz","source":{},"firstTimestamp":null,"lastTimestamp":null,"eventTime":null,"reportingComponent":"","reportingInstance":""}` + "\n",
+			))
+		})
+
+		It("should fail if the file cannot be opened", func() {
+			sink := &FileSink{Path: filepath.Join(GinkgoT().TempDir(), "missing", "archive.jsonl")}
+
+			Expect(sink.Write(ctx, []corev1.Event{*newEvent("foo")})).To(MatchError(ContainSubstring("failed opening archive file")))
+		})
+	})
+
+	Describe("HTTPSink", func() {
+		var (
+			server     *httptest.Server
+			statusCode int
+			body       string
+		)
+
+		BeforeEach(func() {
+			statusCode = http.StatusOK
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+
+				Expect(r.Method).To(Equal(http.MethodPost))
+				Expect(r.Header.Get("Content-Type")).To(Equal("application/x-ndjson"))
+				data, err := io.ReadAll(r.Body)
+				Expect(err).NotTo(HaveOccurred())
+				body = string(data)
+				w.WriteHeader(statusCode)
+			}))
+			DeferCleanup(server.Close)
+		})
+
+		It("should send the events as JSON lines", func() {
+			sink := &HTTPSink{URL: server.URL, Client: server.Client()}
+
+			Expect(sink.Write(ctx, []corev1.Event{*newEvent("foo"), *newEvent("bar")})).To(Succeed())
+			Expect(body).To(And(
+				HavePrefix(`{"metadata":{"name":"foo","namespace":"garden"}`),
+				ContainSubstring("}\n"+`{"metadata":{"name":"bar","namespace":"garden"}`),
+				HaveSuffix("}\n"),
+			))
+		})
+
+		It("should fail if the endpoint responds with an error", func() {
+			statusCode = http.StatusInternalServerError
+			sink := &HTTPSink{URL: server.URL, Client: server.Client()}
+
+			Expect(sink.Write(ctx, []corev1.Event{*newEvent("foo")})).To(MatchError("archive endpoint responded with status code 500"))
+		})
+	})
+
+	Describe("Archiver", func() {
+		var (
+			sink      *fakeSink
+			fakeClock *testclock.FakeClock
+			archiver  *Archiver
+		)
+
+		BeforeEach(func() {
+			sink = &fakeSink{}
+			fakeClock = testclock.NewFakeClock(time.Now())
+			archiver = &Archiver{
+				Log:           logr.Discard(),
+				Sink:          sink,
+				Clock:         fakeClock,
+				BatchSize:     2,
+				FlushInterval: time.Minute,
+			}
+		})
+
+		It("should write the events in batches", func() {
+			for _, name := range []string{"a", "b", "c"} {
+				archiver.Add(newEvent(name))
+			}
+
+			archiver.Flush(ctx)
+
+			Expect(sink.Batches()).To(Equal([][]string{{"a", "b"}, {"c"}}))
+		})
+
+		It("should keep the events if writing fails", func() {
+			archiver.Add(newEvent("a"))
+			sink.err = fmt.Errorf("fake")
+			archiver.Flush(ctx)
+			Expect(sink.Batches()).To(BeEmpty())
+
+			archiver.Add(newEvent("b"))
+			sink.err = nil
+			archiver.Flush(ctx)
+			Expect(sink.Batches()).To(Equal([][]string{{"a", "b"}}))
+		})
+
+		It("should drop the oldest events if too many events are buffered", func() {
+			for i := range 21 {
+				archiver.Add(newEvent(fmt.Sprintf("%02d", i)))
+			}
+
+			archiver.Flush(ctx)
+
+			Expect(sink.Batches()).To(HaveLen(10))
+			Expect(sink.Batches()[0]).To(Equal([]string{"01", "02"}))
+		})
+
+		It("should flush when a batch is full, the flush interval elapsed and it is stopped", func() {
+			ctx, cancel := context.WithCancel(ctx)
+			done := make(chan struct{})
+			go func() {
+				defer GinkgoRecover()
+				Expect(archiver.Start(ctx)).To(Succeed())
+				close(done)
+			}()
+
+			By("Fill batch")
+			archiver.Add(newEvent("a"))
+			archiver.Add(newEvent("b"))
+			Eventually(sink.Batches).Should(Equal([][]string{{"a", "b"}}))
+
+			By("Wait for flush interval")
+			archiver.Add(newEvent("c"))
+			Consistently(sink.Batches).Should(HaveLen(1))
+			Eventually(func() bool { return fakeClock.HasWaiters() }).Should(BeTrue())
+			fakeClock.Step(time.Minute)
+			Eventually(sink.Batches).Should(Equal([][]string{{"a", "b"}, {"c"}}))
+
+			By("Stop archiver")
+			archiver.Add(newEvent("d"))
+			cancel()
+			Eventually(done).Should(BeClosed())
+			Expect(sink.Batches()).To(Equal([][]string{{"a", "b"}, {"c"}, {"d"}}))
+		})
+	})
+
+	Describe("#ArchiveEventHandler", func() {
+		var (
+			sink       *fakeSink
+			reconciler *Reconciler
+		)
+
+		BeforeEach(func() {
+			sink = &fakeSink{}
+			reconciler = &Reconciler{Archiver: &Archiver{Log: logr.Discard(), Sink: sink, BatchSize: 10}}
+		})
+
+		It("should add created events to the archive", func() {
+			reconciler.ArchiveEventHandler().Create(ctx, event.CreateEvent{Object: newEvent("foo")}, nil)
+			reconciler.Archiver.Flush(ctx)
+
+			Expect(sink.Batches()).To(Equal([][]string{{"foo"}}))
+		})
+
+		It("should add changed events to the archive", func() {
+			oldEv, newEv := newEvent("foo"), newEvent("foo")
+			oldEv.ResourceVersion, newEv.ResourceVersion = "1", "2"
+			oldEv.Count, newEv.Count = 1, 2
+
+			reconciler.ArchiveEventHandler().Update(ctx, event.UpdateEvent{ObjectOld: oldEv, ObjectNew: newEv}, nil)
+			reconciler.Archiver.Flush(ctx)
+
+			Expect(sink.Batches()).To(Equal([][]string{{"foo"}}))
+		})
+
+		It("should not add events to the archive if only their metadata changed", func() {
+			oldEv, newEv := newEvent("foo"), newEvent("foo")
+			oldEv.ResourceVersion, newEv.ResourceVersion = "1", "2"
+			newEv.Annotations = map[string]string{"notification.gardener.cloud/delivered-count": "1"}
+
+			reconciler.ArchiveEventHandler().Update(ctx, event.UpdateEvent{ObjectOld: oldEv, ObjectNew: newEv}, nil)
+			reconciler.Archiver.Flush(ctx)
+
+			Expect(sink.Batches()).To(BeEmpty())
+		})
+
+		It("should not add events to the archive on resyncs", func() {
+			ev := newEvent("foo")
+			ev.ResourceVersion = "1"
+
+			reconciler.ArchiveEventHandler().Update(ctx, event.UpdateEvent{ObjectOld: ev, ObjectNew: ev.DeepCopy()}, nil)
+			reconciler.Archiver.Flush(ctx)
+
+			Expect(sink.Batches()).To(BeEmpty())
+		})
+
+		It("should not add deleted events to the archive", func() {
+			reconciler.ArchiveEventHandler().Delete(ctx, event.DeleteEvent{Object: newEvent("foo")}, nil)
+			reconciler.Archiver.Flush(ctx)
+
+			Expect(sink.Batches()).To(BeEmpty())
+		})
+	})
+})
@@ -22,9 +22,10 @@ import (
 
 // Reconciler reconciles Event.
 type Reconciler struct {
-	Client client.Client
-	Config controllermanagerconfigv1alpha1.EventControllerConfiguration
-	Clock  clock.Clock
+	Client   client.Client
+	Config   controllermanagerconfigv1alpha1.EventControllerConfiguration
+	Clock    clock.WithTicker
+	Archiver *Archiver
 }
 
 // Reconcile performs the main reconciliation logic.
@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notification
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils/notification"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "notification"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(mgr manager.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+	if r.HTTPClient == nil {
+		httpClient, err := notification.NewHTTPClient(r.Config.WebhookTimeout.Duration, r.Config.BlockCIDRs)
+		if err != nil {
+			return err
+		}
+		r.HTTPClient = httpClient
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&corev1.Event{}, builder.WithPredicates(r.EventPredicate())).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+			ReconciliationTimeout:   controllerutils.DefaultReconciliationTimeout,
+		}).
+		Complete(r)
+}
+
+// EventPredicate returns true for 'CREATE' events about projects and shoots. For 'UPDATE' events, it returns true when
+// such an event recurred, i.e., its count changed.
+func (r *Reconciler) EventPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			ev, ok := e.Object.(*corev1.Event)
+			return ok && isRelevant(ev)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldEvent, ok := e.ObjectOld.(*corev1.Event)
+			if !ok {
+				return false
+			}
+			ev, ok := e.ObjectNew.(*corev1.Event)
+			if !ok {
+				return false
+			}
+
+			return isRelevant(ev) && ev.Count != oldEvent.Count
+		},
+		DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+	}
+}
+
+func isRelevant(event *corev1.Event) bool {
+	if gv, err := schema.ParseGroupVersion(event.InvolvedObject.APIVersion); err != nil || gv.Group != gardencorev1beta1.GroupName {
+		return false
+	}
+	return event.InvolvedObject.Kind == "Project" || event.InvolvedObject.Kind == "Shoot"
+}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notification_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/notification"
+)
+
+var _ = Describe("Add", func() {
+	var reconciler *Reconciler
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+	})
+
+	Describe("EventPredicate", func() {
+		var (
+			p  predicate.Predicate
+			ev *corev1.Event
+		)
+
+		BeforeEach(func() {
+			p = reconciler.EventPredicate()
+			ev = &corev1.Event{
+				InvolvedObject: corev1.ObjectReference{APIVersion: "core.gardener.cloud/v1beta1", Kind: "Shoot"},
+				Count:          1,
+			}
+		})
+
+		Describe("#Create", func() {
+			It("should return true for events about shoots", func() {
+				Expect(p.Create(event.CreateEvent{Object: ev})).To(BeTrue())
+			})
+
+			It("should return true for events about projects", func() {
+				ev.InvolvedObject.Kind = "Project"
+				Expect(p.Create(event.CreateEvent{Object: ev})).To(BeTrue())
+			})
+
+			It("should return false for events about other objects", func() {
+				ev.InvolvedObject.Kind = "Seed"
+				Expect(p.Create(event.CreateEvent{Object: ev})).To(BeFalse())
+			})
+
+			It("should return false for events about objects of other API groups", func() {
+				ev.InvolvedObject.APIVersion = "v1"
+				Expect(p.Create(event.CreateEvent{Object: ev})).To(BeFalse())
+			})
+		})
+
+		Describe("#Update", func() {
+			It("should return false when old object is not an event", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectNew: ev})).To(BeFalse())
+			})
+
+			It("should return false when the count did not change", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectOld: ev, ObjectNew: ev})).To(BeFalse())
+			})
+
+			It("should return true when the count changed", func() {
+				oldEvent := ev.DeepCopy()
+				ev.Count++
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldEvent, ObjectNew: ev})).To(BeTrue())
+			})
+		})
+
+		Describe("#Delete", func() {
+			It("should return false", func() {
+				Expect(p.Delete(event.DeleteEvent{})).To(BeFalse())
+			})
+		})
+
+		Describe("#Generic", func() {
+			It("should return false", func() {
+				Expect(p.Generic(event.GenericEvent{})).To(BeFalse())
+			})
+		})
+	})
+})
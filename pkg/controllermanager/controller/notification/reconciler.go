@@ -0,0 +1,225 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notification
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/notification"
+)
+
+// AnnotationDeliveredCount is the annotation on events which contains the count of the event for which the
+// notifications were delivered. It prevents sending notifications again, e.g., after a restart of the controller.
+const AnnotationDeliveredCount = "notification.gardener.cloud/delivered-count"
+
+// Reconciler reconciles Events about Projects and Shoots and sends them to the sinks of the matching notification
+// subscriptions of the Project.
+type Reconciler struct {
+	Client     client.Client
+	Config     controllermanagerconfigv1alpha1.NotificationControllerConfiguration
+	Clock      clock.Clock
+	HTTPClient *http.Client
+
+	lock sync.Mutex
+	sent map[deliveryKey]time.Time
+}
+
+type deliveryKey struct {
+	project, subscription string
+	involvedObject        types.UID
+	reason, message       string
+}
+
+// Reconcile sends the notifications for an Event.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	event := &corev1.Event{}
+	if err := r.Client.Get(ctx, req.NamespacedName, event); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	count := strconv.Itoa(int(event.Count))
+	if !isRelevant(event) || event.Annotations[AnnotationDeliveredCount] == count {
+		return reconcile.Result{}, nil
+	}
+
+	// Events which occurred before the deduplication window are not sent anymore. This stops retrying failed deliveries
+	// and prevents sending old events when the controller is enabled.
+	now := r.Clock.Now()
+	if now.Sub(eventTimestamp(event)) >= r.Config.DeduplicationWindow.Duration {
+		log.V(1).Info("Event is too old for sending notifications")
+		return reconcile.Result{}, nil
+	}
+
+	project, err := r.projectForEvent(ctx, event)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Project of event is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("failed getting project of event: %w", err)
+	}
+
+	var (
+		n       = newNotification(project, event)
+		errs    []error
+		matched bool
+	)
+
+	for _, subscription := range project.Spec.Notifications {
+		if !matches(subscription, event) {
+			continue
+		}
+		matched = true
+
+		key := deliveryKey{
+			project:        project.Name,
+			subscription:   subscription.Name,
+			involvedObject: event.InvolvedObject.UID,
+			reason:         event.Reason,
+			message:        event.Message,
+		}
+		if r.isDuplicate(key, now) {
+			log.V(1).Info("Skipping duplicate notification", "subscription", subscription.Name)
+			continue
+		}
+
+		log.Info("Sending notification", "subscription", subscription.Name)
+		if err := r.send(ctx, subscription.Webhook, n); err != nil {
+			errs = append(errs, fmt.Errorf("failed sending notification for subscription %q: %w", subscription.Name, err))
+			continue
+		}
+		r.remember(key, now)
+	}
+
+	// Failed deliveries are retried with exponential backoff, successful ones are not sent again due to the deduplication.
+	if err := errors.Join(errs...); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !matched {
+		return reconcile.Result{}, nil
+	}
+
+	patch := client.MergeFrom(event.DeepCopy())
+	metav1.SetMetaDataAnnotation(&event.ObjectMeta, AnnotationDeliveredCount, count)
+	return reconcile.Result{}, client.IgnoreNotFound(r.Client.Patch(ctx, event, patch))
+}
+
+func (r *Reconciler) projectForEvent(ctx context.Context, event *corev1.Event) (*gardencorev1beta1.Project, error) {
+	if event.InvolvedObject.Kind == "Project" {
+		project := &gardencorev1beta1.Project{}
+		return project, r.Client.Get(ctx, client.ObjectKey{Name: event.InvolvedObject.Name}, project)
+	}
+	return gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, event.InvolvedObject.Namespace)
+}
+
+// eventTimestamp returns the point in time at which the event occurred most recently.
+func eventTimestamp(event *corev1.Event) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	if !event.EventTime.IsZero() {
+		return event.EventTime.Time
+	}
+	return event.CreationTimestamp.Time
+}
+
+func newNotification(project *gardencorev1beta1.Project, event *corev1.Event) notification.Notification {
+	return notification.Notification{
+		Project:   project.Name,
+		Namespace: ptr.Deref(project.Spec.Namespace, ""),
+		Kind:      event.InvolvedObject.Kind,
+		Name:      event.InvolvedObject.Name,
+		Type:      event.Type,
+		Reason:    event.Reason,
+		Message:   event.Message,
+		Count:     event.Count,
+		Timestamp: eventTimestamp(event).UTC(),
+	}
+}
+
+func matches(subscription gardencorev1beta1.NotificationSubscription, event *corev1.Event) bool {
+	return (len(subscription.Kinds) == 0 || slices.Contains(subscription.Kinds, event.InvolvedObject.Kind)) &&
+		(len(subscription.Types) == 0 || slices.Contains(subscription.Types, event.Type)) &&
+		(len(subscription.Reasons) == 0 || slices.Contains(subscription.Reasons, event.Reason))
+}
+
+func (r *Reconciler) send(ctx context.Context, webhook gardencorev1beta1.NotificationWebhook, n notification.Notification) error {
+	payload, err := notification.Payload(webhook.PayloadTemplate, n)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", ptr.Deref(webhook.ContentType, "application/json"))
+
+	resp, err := r.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// isDuplicate returns true if a notification with the given key was sent within the deduplication window.
+func (r *Reconciler) isDuplicate(key deliveryKey, now time.Time) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	sentAt, ok := r.sent[key]
+	return ok && now.Sub(sentAt) < r.Config.DeduplicationWindow.Duration
+}
+
+// remember records that a notification with the given key was sent and forgets notifications which were sent before
+// the deduplication window.
+func (r *Reconciler) remember(key deliveryKey, now time.Time) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.sent == nil {
+		r.sent = make(map[deliveryKey]time.Time)
+	}
+
+	for k, sentAt := range r.sent {
+		if now.Sub(sentAt) >= r.Config.DeduplicationWindow.Duration {
+			delete(r.sent, k)
+		}
+	}
+	r.sent[key] = now
+}
@@ -0,0 +1,268 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notification_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/notification"
+)
+
+var _ = Describe("Reconciler", func() {
+	type request struct {
+		contentType string
+		body        string
+	}
+
+	var (
+		ctx       = context.TODO()
+		now       = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		fakeClock *testclock.FakeClock
+		c         client.Client
+
+		server     *httptest.Server
+		lock       sync.Mutex
+		requests   []request
+		statusCode int
+
+		reconciler *Reconciler
+		project    *gardencorev1beta1.Project
+		event      *corev1.Event
+	)
+
+	receivedRequests := func() []request {
+		lock.Lock()
+		defer lock.Unlock()
+		return append([]request(nil), requests...)
+	}
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(now)
+		c = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+			Build()
+
+		requests, statusCode = nil, http.StatusOK
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			Expect(err).NotTo(HaveOccurred())
+
+			lock.Lock()
+			defer lock.Unlock()
+			requests = append(requests, request{contentType: r.Header.Get("Content-Type"), body: string(body)})
+			w.WriteHeader(statusCode)
+		}))
+		DeferCleanup(server.Close)
+
+		reconciler = &Reconciler{
+			Client: c,
+			Config: controllermanagerconfigv1alpha1.NotificationControllerConfiguration{
+				DeduplicationWindow: &metav1.Duration{Duration: time.Hour},
+			},
+			Clock:      fakeClock,
+			HTTPClient: server.Client(),
+		}
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec: gardencorev1beta1.ProjectSpec{
+				Namespace: ptr.To("garden-foo"),
+				Notifications: []gardencorev1beta1.NotificationSubscription{
+					{
+						Name:    "all",
+						Webhook: gardencorev1beta1.NotificationWebhook{URL: server.URL + "/all"},
+					},
+					{
+						Name:  "chat",
+						Types: []string{corev1.EventTypeWarning},
+						Webhook: gardencorev1beta1.NotificationWebhook{
+							URL:             server.URL + "/chat",
+							PayloadTemplate: ptr.To(`{"text": {{ json (printf "%s: %s" .Name .Message) }}}`),
+							ContentType:     ptr.To("application/json; charset=utf-8"),
+						},
+					},
+				},
+			},
+		}
+		event = &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar.123", Namespace: "garden-foo"},
+			InvolvedObject: corev1.ObjectReference{
+				APIVersion: "core.gardener.cloud/v1beta1",
+				Kind:       "Shoot",
+				Name:       "bar",
+				Namespace:  "garden-foo",
+				UID:        "bar-uid",
+			},
+			Type:          corev1.EventTypeWarning,
+			Reason:        "ReconcileError",
+			Message:       "something went wrong",
+			Count:         1,
+			LastTimestamp: metav1.Time{Time: now.Add(-time.Minute)},
+		}
+	})
+
+	createObjects := func() {
+		ExpectWithOffset(1, c.Create(ctx, project)).To(Succeed())
+		ExpectWithOffset(1, c.Create(ctx, event)).To(Succeed())
+	}
+
+	reconcileEvent := func() error {
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(event)})
+		return err
+	}
+
+	It("should send the event to all matching subscriptions and mark it as delivered", func() {
+		createObjects()
+
+		Expect(reconcileEvent()).To(Succeed())
+
+		Expect(receivedRequests()).To(ConsistOf(
+			request{
+				contentType: "application/json",
+				body:        `{"project":"foo","namespace":"garden-foo","kind":"Shoot","name":"bar","type":"Warning","reason":"ReconcileError","message":"something went wrong","count":1,"timestamp":"2026-10-18T11:59:00Z"}`,
+			},
+			request{
+				contentType: "application/json; charset=utf-8",
+				body:        `{"text": "bar: something went wrong"}`,
+			},
+		))
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(event), event)).To(Succeed())
+		Expect(event.Annotations).To(HaveKeyWithValue(AnnotationDeliveredCount, "1"))
+
+		By("Reconcile again")
+		Expect(reconcileEvent()).To(Succeed())
+		Expect(receivedRequests()).To(HaveLen(2))
+	})
+
+	It("should only send the event to subscriptions selecting it", func() {
+		event.Type = corev1.EventTypeNormal
+
+		createObjects()
+
+		Expect(reconcileEvent()).To(Succeed())
+
+		Expect(receivedRequests()).To(HaveLen(1))
+	})
+
+	It("should send events about projects", func() {
+		event.Namespace = "default"
+		event.InvolvedObject = corev1.ObjectReference{APIVersion: "core.gardener.cloud/v1beta1", Kind: "Project", Name: "foo", UID: "foo-uid"}
+
+		createObjects()
+
+		Expect(reconcileEvent()).To(Succeed())
+
+		Expect(receivedRequests()).To(HaveLen(2))
+	})
+
+	It("should not send events which are too old", func() {
+		event.LastTimestamp = metav1.Time{Time: now.Add(-2 * time.Hour)}
+
+		createObjects()
+
+		Expect(reconcileEvent()).To(Succeed())
+
+		Expect(receivedRequests()).To(BeEmpty())
+	})
+
+	It("should not mark events as delivered if no subscription matches", func() {
+		project.Spec.Notifications = nil
+
+		createObjects()
+
+		Expect(reconcileEvent()).To(Succeed())
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(event), event)).To(Succeed())
+		Expect(event.Annotations).NotTo(HaveKey(AnnotationDeliveredCount))
+	})
+
+	It("should deduplicate recurring events within the deduplication window", func() {
+		createObjects()
+
+		Expect(reconcileEvent()).To(Succeed())
+		Expect(receivedRequests()).To(HaveLen(2))
+
+		By("Event recurs within the deduplication window")
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(event), event)).To(Succeed())
+		fakeClock.Step(30 * time.Minute)
+		event.Count++
+		event.LastTimestamp = metav1.Time{Time: fakeClock.Now()}
+		Expect(c.Update(ctx, event)).To(Succeed())
+
+		Expect(reconcileEvent()).To(Succeed())
+		Expect(receivedRequests()).To(HaveLen(2))
+
+		By("Event recurs after the deduplication window")
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(event), event)).To(Succeed())
+		fakeClock.Step(time.Hour)
+		event.Count++
+		event.LastTimestamp = metav1.Time{Time: fakeClock.Now()}
+		Expect(c.Update(ctx, event)).To(Succeed())
+
+		Expect(reconcileEvent()).To(Succeed())
+		Expect(receivedRequests()).To(HaveLen(4))
+	})
+
+	It("should retry failed deliveries", func() {
+		statusCode = http.StatusServiceUnavailable
+
+		createObjects()
+
+		Expect(reconcileEvent()).To(MatchError(And(
+			ContainSubstring(`failed sending notification for subscription "all": webhook responded with status code 503`),
+			ContainSubstring(`failed sending notification for subscription "chat": webhook responded with status code 503`),
+		)))
+		Expect(receivedRequests()).To(HaveLen(2))
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(event), event)).To(Succeed())
+		Expect(event.Annotations).NotTo(HaveKey(AnnotationDeliveredCount))
+
+		By("Retry")
+		lock.Lock()
+		statusCode = http.StatusOK
+		lock.Unlock()
+
+		Expect(reconcileEvent()).To(Succeed())
+		Expect(receivedRequests()).To(HaveLen(4))
+
+		By("Reconcile again")
+		Expect(reconcileEvent()).To(Succeed())
+		Expect(receivedRequests()).To(HaveLen(4))
+	})
+
+	It("should not send notifications again which were sent successfully", func() {
+		project.Spec.Notifications[1].Webhook.URL = "https://127.0.0.1:1/unreachable"
+
+		createObjects()
+
+		Expect(reconcileEvent()).To(MatchError(ContainSubstring(`failed sending notification for subscription "chat"`)))
+		Expect(receivedRequests()).To(HaveLen(1))
+
+		Expect(reconcileEvent()).To(MatchError(ContainSubstring(`failed sending notification for subscription "chat"`)))
+		Expect(receivedRequests()).To(HaveLen(1))
+	})
+})
@@ -11,6 +11,7 @@ import (
 
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/activity"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/project/lifecycle"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/project"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project/stale"
 )
@@ -35,5 +36,13 @@ func AddToManager(mgr manager.Manager, cfg controllermanagerconfigv1alpha1.Contr
 		return fmt.Errorf("failed adding stale reconciler: %w", err)
 	}
 
+	if cfg.Controllers.Project.Lifecycle != nil {
+		if err := (&lifecycle.Reconciler{
+			Config: *cfg.Controllers.Project,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding lifecycle reconciler: %w", err)
+		}
+	}
+
 	return nil
 }
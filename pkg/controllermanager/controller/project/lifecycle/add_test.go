@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project/lifecycle"
+)
+
+var _ = Describe("Add", func() {
+	var reconciler *Reconciler
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+	})
+
+	Describe("ProjectPredicate", func() {
+		var (
+			p       predicate.Predicate
+			project *gardencorev1beta1.Project
+		)
+
+		BeforeEach(func() {
+			p = reconciler.ProjectPredicate()
+			project = &gardencorev1beta1.Project{}
+		})
+
+		Describe("#Create", func() {
+			It("should return true", func() {
+				Expect(p.Create(event.CreateEvent{})).To(BeTrue())
+			})
+		})
+
+		Describe("#Update", func() {
+			It("should return false when old object is not project", func() {
+				Expect(p.Update(event.UpdateEvent{})).To(BeFalse())
+			})
+
+			It("should return false when new object is not project", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectOld: project})).To(BeFalse())
+			})
+
+			It("should return false when nothing relevant changed", func() {
+				oldProject := project.DeepCopy()
+				project.Status.Phase = gardencorev1beta1.ProjectReady
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldProject, ObjectNew: project})).To(BeFalse())
+			})
+
+			It("should return true when the generation changed", func() {
+				oldProject := project.DeepCopy()
+				project.Generation++
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldProject, ObjectNew: project})).To(BeTrue())
+			})
+
+			It("should return true when the project shall be renewed", func() {
+				oldProject := project.DeepCopy()
+				metav1.SetMetaDataAnnotation(&project.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.ProjectOperationRenew)
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldProject, ObjectNew: project})).To(BeTrue())
+			})
+
+			It("should return true when the deletion was confirmed", func() {
+				oldProject := project.DeepCopy()
+				metav1.SetMetaDataAnnotation(&project.ObjectMeta, v1beta1constants.ConfirmationDeletion, "true")
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldProject, ObjectNew: project})).To(BeTrue())
+			})
+
+			It("should return true when the last activity timestamp changed", func() {
+				oldProject := project.DeepCopy()
+				project.Status.LastActivityTimestamp = &metav1.Time{}
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldProject, ObjectNew: project})).To(BeTrue())
+			})
+
+			It("should return true when the stale auto-delete timestamp changed", func() {
+				oldProject := project.DeepCopy()
+				project.Status.StaleAutoDeleteTimestamp = &metav1.Time{}
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldProject, ObjectNew: project})).To(BeTrue())
+			})
+		})
+
+		Describe("#Delete", func() {
+			It("should return false", func() {
+				Expect(p.Delete(event.DeleteEvent{})).To(BeFalse())
+			})
+		})
+
+		Describe("#Generic", func() {
+			It("should return false", func() {
+				Expect(p.Generic(event.GenericEvent{})).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,374 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/project/stale"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+const day = 24 * time.Hour
+
+// Reconciler reconciles Projects, archives them when they expired or are inactive, notifies about their upcoming
+// automatic deletion and deletes them after the archival period.
+type Reconciler struct {
+	Client   client.Client
+	Config   controllermanagerconfigv1alpha1.ProjectControllerConfiguration
+	Clock    clock.Clock
+	Recorder record.EventRecorder
+}
+
+// Reconcile reconciles Projects, archives them when they expired or are inactive, notifies about their upcoming
+// automatic deletion and deletes them after the archival period.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	project := &gardencorev1beta1.Project{}
+	if err := r.Client.Get(ctx, request.NamespacedName, project); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if project.DeletionTimestamp != nil || project.Spec.Namespace == nil {
+		return reconcile.Result{}, nil
+	}
+
+	if project.Annotations[v1beta1constants.GardenerOperation] == v1beta1constants.ProjectOperationRenew {
+		log.Info("Renewing Project")
+		if err := r.renew(ctx, project); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	now := r.Clock.Now()
+
+	reason, err := r.archivalReason(ctx, project, now)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if reason != "" {
+		if err := r.archive(ctx, log, project, reason, now); err != nil {
+			return reconcile.Result{}, err
+		}
+	} else if project.Status.ArchivedTimestamp != nil {
+		if err := r.unarchive(ctx, log, project); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	if err := r.updateDeletionScheduledCondition(ctx, project, now); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if autoDeleteTimestamp := project.Status.ArchivedAutoDeleteTimestamp; autoDeleteTimestamp != nil && !now.Before(autoDeleteTimestamp.Time) {
+		log.Info("Deleting archived Project now because its auto-delete timestamp is exceeded", "archivedAutoDeleteTimestamp", autoDeleteTimestamp.Time)
+		return r.delete(ctx, log, project)
+	}
+
+	return reconcile.Result{RequeueAfter: r.requeueAfter(project, now)}, nil
+}
+
+// renew marks the project as active again and removes the operation annotation.
+func (r *Reconciler) renew(ctx context.Context, project *gardencorev1beta1.Project) error {
+	patch := client.MergeFrom(project.DeepCopy())
+	project.Status.LastActivityTimestamp = &metav1.Time{Time: r.Clock.Now()}
+	if err := r.Client.Status().Patch(ctx, project, patch); err != nil {
+		return fmt.Errorf("failed updating last activity timestamp: %w", err)
+	}
+
+	patch = client.MergeFrom(project.DeepCopy())
+	delete(project.Annotations, v1beta1constants.GardenerOperation)
+	return r.Client.Patch(ctx, project, patch)
+}
+
+// archivalReason returns the reason why the project has to be archived. An empty string is returned if the project
+// must not be archived.
+func (r *Reconciler) archivalReason(ctx context.Context, project *gardencorev1beta1.Project, now time.Time) (string, error) {
+	if expirationTimestamp := getExpirationTimestamp(project); expirationTimestamp != nil && !now.Before(expirationTimestamp.Time) {
+		return fmt.Sprintf("it expired at %s", expirationTimestamp.UTC().Format(time.RFC3339)), nil
+	}
+
+	inactivityArchivalTime := r.inactivityArchivalTime(project)
+	if inactivityArchivalTime == nil || now.Before(*inactivityArchivalTime) {
+		return "", nil
+	}
+
+	// Projects excluded from the stale check are not archived due to inactivity either.
+	namespace := &corev1.Namespace{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: *project.Spec.Namespace}, namespace); err != nil {
+		return "", err
+	}
+
+	if skip, _ := strconv.ParseBool(namespace.Annotations[v1beta1constants.ProjectSkipStaleCheck]); skip {
+		return "", nil
+	}
+
+	// Projects which are still in use by any resource are not archived due to inactivity, see the stale reconciler.
+	resource, err := (&stale.Reconciler{Client: r.Client}).InUseBy(ctx, namespace.Name)
+	if err != nil {
+		return "", err
+	}
+	if resource != "" {
+		return "", nil
+	}
+
+	return fmt.Sprintf("there was no activity since %s", lastActivity(project).UTC().Format(time.RFC3339)), nil
+}
+
+func (r *Reconciler) archive(ctx context.Context, log logr.Logger, project *gardencorev1beta1.Project, reason string, now time.Time) error {
+	if project.Status.ArchivedTimestamp == nil {
+		log.Info("Archiving Project", "reason", reason)
+
+		patch := client.MergeFrom(project.DeepCopy())
+		project.Status.ArchivedTimestamp = &metav1.Time{Time: now}
+		project.Status.ArchivedAutoDeleteTimestamp = &metav1.Time{Time: now.Add(time.Duration(ptr.Deref(r.Config.Lifecycle.ArchivalPeriodDays, 0)) * day)}
+		project.Status.Phase = gardencorev1beta1.ProjectArchived
+		if err := r.Client.Status().Patch(ctx, project, patch); err != nil {
+			return fmt.Errorf("failed marking project as archived: %w", err)
+		}
+
+		r.Recorder.Eventf(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventArchived, "Project has been archived because %s, its shoots are hibernated and it will be deleted at %s", reason, project.Status.ArchivedAutoDeleteTimestamp.UTC().Format(time.RFC3339))
+	}
+
+	return r.hibernateShoots(ctx, log, project)
+}
+
+// hibernateShoots hibernates all shoots of the project which are not already hibernated.
+func (r *Reconciler) hibernateShoots(ctx context.Context, log logr.Logger, project *gardencorev1beta1.Project) error {
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList, client.InNamespace(*project.Spec.Namespace)); err != nil {
+		return err
+	}
+
+	for _, shoot := range shootList.Items {
+		if shoot.DeletionTimestamp != nil || v1beta1helper.HibernationIsEnabled(&shoot) {
+			continue
+		}
+
+		log.Info("Hibernating Shoot of archived Project", "shoot", client.ObjectKeyFromObject(&shoot))
+
+		patch := client.MergeFrom(shoot.DeepCopy())
+		if shoot.Spec.Hibernation == nil {
+			shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{}
+		}
+		shoot.Spec.Hibernation.Enabled = ptr.To(true)
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.ShootHibernationReason, fmt.Sprintf("Project %s is archived", project.Name))
+		if err := r.Client.Patch(ctx, &shoot, patch); err != nil {
+			return fmt.Errorf("failed hibernating shoot %s: %w", client.ObjectKeyFromObject(&shoot), err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Reconciler) unarchive(ctx context.Context, log logr.Logger, project *gardencorev1beta1.Project) error {
+	log.Info("Reverting archival of Project")
+
+	patch := client.MergeFrom(project.DeepCopy())
+	project.Status.ArchivedTimestamp = nil
+	project.Status.ArchivedAutoDeleteTimestamp = nil
+	if project.Status.Phase == gardencorev1beta1.ProjectArchived {
+		project.Status.Phase = gardencorev1beta1.ProjectReady
+	}
+	if err := r.Client.Status().Patch(ctx, project, patch); err != nil {
+		return fmt.Errorf("failed reverting archival of project: %w", err)
+	}
+
+	r.Recorder.Event(project, corev1.EventTypeNormal, gardencorev1beta1.ProjectEventUnarchived, "Archival of project has been reverted, its shoots can be woken up again")
+	return nil
+}
+
+// updateDeletionScheduledCondition maintains the condition about the upcoming automatic deletion of the project, either
+// because it is archived or because it is stale. The owner and members of the project are notified with an event when
+// the deletion is scheduled and when the configured notification days before the deletion are reached.
+func (r *Reconciler) updateDeletionScheduledCondition(ctx context.Context, project *gardencorev1beta1.Project, now time.Time) error {
+	deletionTimestamp, reason := scheduledDeletion(project)
+
+	oldCondition := v1beta1helper.GetCondition(project.Status.Conditions, gardencorev1beta1.ProjectDeletionScheduled)
+	if deletionTimestamp == nil && oldCondition == nil {
+		return nil
+	}
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, project.Status.Conditions, gardencorev1beta1.ProjectDeletionScheduled)
+	if deletionTimestamp == nil {
+		condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, "NotScheduled", "Project is not scheduled for automatic deletion.")
+	} else {
+		message := fmt.Sprintf("Project will be deleted automatically at %s because it is %s.", deletionTimestamp.UTC().Format(time.RFC3339), strings.ToLower(reason))
+
+		switch {
+		case condition.Status != gardencorev1beta1.ConditionTrue || condition.Message != message:
+			condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionTrue, reason, message)
+			r.Recorder.Event(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventDeletionScheduled, message)
+		case r.notificationDue(deletionTimestamp.Time, condition.LastUpdateTime.Time, now):
+			condition.LastUpdateTime = metav1.Time{Time: now}
+			r.Recorder.Event(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventDeletionScheduled, message)
+		}
+	}
+
+	if oldCondition != nil && apiequality.Semantic.DeepEqual(*oldCondition, condition) {
+		return nil
+	}
+
+	patch := client.MergeFrom(project.DeepCopy())
+	project.Status.Conditions = v1beta1helper.MergeConditions(project.Status.Conditions, condition)
+	return r.Client.Status().Patch(ctx, project, patch)
+}
+
+// notificationDue returns true if one of the configured notification days before the given deletion time was reached
+// after the last notification.
+func (r *Reconciler) notificationDue(deletionTime, lastNotification, now time.Time) bool {
+	for _, days := range r.Config.Lifecycle.DeletionNotificationDays {
+		if notificationTime := deletionTime.Add(-time.Duration(days) * day); notificationTime.After(lastNotification) && !notificationTime.After(now) {
+			return true
+		}
+	}
+	return false
+}
+
+// delete deletes the archived project. Projects which still contain shoots are only deleted if their deletion was
+// confirmed explicitly, in this case the shoots are deleted as well.
+func (r *Reconciler) delete(ctx context.Context, log logr.Logger, project *gardencorev1beta1.Project) (reconcile.Result, error) {
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList, client.InNamespace(*project.Spec.Namespace)); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	var shoots []gardencorev1beta1.Shoot
+	for _, shoot := range shootList.Items {
+		if shoot.DeletionTimestamp == nil {
+			shoots = append(shoots, shoot)
+		}
+	}
+
+	if len(shoots) > 0 && gardenerutils.CheckIfDeletionIsConfirmed(project) != nil {
+		log.Info("Archived Project still contains Shoots and its deletion was not confirmed, skipping deletion", "shoots", len(shoots))
+		r.Recorder.Eventf(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventDeletionNotConfirmed, "Project has not been deleted because it still contains %d shoot(s), annotate it with %s=true to confirm the deletion of the project and its shoots", len(shoots), v1beta1constants.ConfirmationDeletion)
+		return reconcile.Result{RequeueAfter: r.Config.StaleSyncPeriod.Duration}, nil
+	}
+
+	for _, shoot := range shoots {
+		log.Info("Deleting Shoot of archived Project", "shoot", client.ObjectKeyFromObject(&shoot))
+		if err := gardenerutils.ConfirmDeletion(ctx, r.Client, &shoot); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return reconcile.Result{}, err
+		}
+		if err := r.Client.Delete(ctx, &shoot); client.IgnoreNotFound(err) != nil {
+			return reconcile.Result{}, fmt.Errorf("failed deleting shoot %s: %w", client.ObjectKeyFromObject(&shoot), err)
+		}
+	}
+
+	if err := gardenerutils.ConfirmDeletion(ctx, r.Client, project); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("Project already gone")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{}, client.IgnoreNotFound(r.Client.Delete(ctx, project))
+}
+
+// requeueAfter returns the duration after which the project has to be reconciled again, i.e., when it expires, becomes
+// inactive, its owner and members have to be notified or it has to be deleted. It is capped by the stale sync period.
+func (r *Reconciler) requeueAfter(project *gardencorev1beta1.Project, now time.Time) time.Duration {
+	requeueAfter := r.Config.StaleSyncPeriod.Duration
+
+	consider := func(t time.Time) {
+		if d := t.Sub(now); d > 0 && d < requeueAfter {
+			requeueAfter = d
+		}
+	}
+
+	if expirationTimestamp := getExpirationTimestamp(project); expirationTimestamp != nil {
+		consider(expirationTimestamp.Time)
+	}
+	if inactivityArchivalTime := r.inactivityArchivalTime(project); inactivityArchivalTime != nil {
+		consider(*inactivityArchivalTime)
+	}
+	if deletionTimestamp, _ := scheduledDeletion(project); deletionTimestamp != nil {
+		consider(deletionTimestamp.Time)
+		for _, days := range r.Config.Lifecycle.DeletionNotificationDays {
+			consider(deletionTimestamp.Add(-time.Duration(days) * day))
+		}
+	}
+
+	return requeueAfter
+}
+
+// inactivityArchivalTime returns the point in time at which the project is archived due to inactivity. It is never
+// before the configured minimum lifetime of the project is over. Nil is returned if archival due to inactivity is
+// disabled.
+func (r *Reconciler) inactivityArchivalTime(project *gardencorev1beta1.Project) *time.Time {
+	if r.Config.Lifecycle.InactivityArchivalDays == nil {
+		return nil
+	}
+
+	t := lastActivity(project).Add(time.Duration(*r.Config.Lifecycle.InactivityArchivalDays) * day)
+	if r.Config.MinimumLifetimeDays != nil {
+		if minimumLifetimeEnd := project.CreationTimestamp.Add(time.Duration(*r.Config.MinimumLifetimeDays) * day); minimumLifetimeEnd.After(t) {
+			t = minimumLifetimeEnd
+		}
+	}
+	return &t
+}
+
+func lastActivity(project *gardencorev1beta1.Project) time.Time {
+	if project.Status.LastActivityTimestamp != nil && project.Status.LastActivityTimestamp.After(project.CreationTimestamp.Time) {
+		return project.Status.LastActivityTimestamp.Time
+	}
+	return project.CreationTimestamp.Time
+}
+
+func getExpirationTimestamp(project *gardencorev1beta1.Project) *metav1.Time {
+	if project.Spec.Lifecycle == nil {
+		return nil
+	}
+	return project.Spec.Lifecycle.ExpirationTimestamp
+}
+
+// scheduledDeletion returns the earliest point in time at which the project will be deleted automatically and the
+// reason for it. Nil is returned if the project is not scheduled for automatic deletion.
+func scheduledDeletion(project *gardencorev1beta1.Project) (*metav1.Time, string) {
+	var (
+		deletionTimestamp *metav1.Time
+		reason            string
+	)
+
+	if project.Status.ArchivedAutoDeleteTimestamp != nil {
+		deletionTimestamp, reason = project.Status.ArchivedAutoDeleteTimestamp, "Archived"
+	}
+	if t := project.Status.StaleAutoDeleteTimestamp; t != nil && (deletionTimestamp == nil || t.Before(deletionTimestamp)) {
+		deletionTimestamp, reason = t, "Stale"
+	}
+
+	return deletionTimestamp, reason
+}
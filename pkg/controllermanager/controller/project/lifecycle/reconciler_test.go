@@ -0,0 +1,318 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lifecycle_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project/lifecycle"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	const day = 24 * time.Hour
+
+	var (
+		ctx       = context.TODO()
+		now       = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		fakeClock *testclock.FakeClock
+		recorder  *record.FakeRecorder
+		c         client.Client
+
+		reconciler *Reconciler
+		request    reconcile.Request
+
+		namespace *corev1.Namespace
+		project   *gardencorev1beta1.Project
+		shoot     *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(now)
+		recorder = record.NewFakeRecorder(10)
+		c = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.Project{}, &gardencorev1beta1.Shoot{}).
+			Build()
+
+		reconciler = &Reconciler{
+			Client: c,
+			Config: controllermanagerconfigv1alpha1.ProjectControllerConfiguration{
+				MinimumLifetimeDays: ptr.To(30),
+				StaleSyncPeriod:     &metav1.Duration{Duration: 12 * time.Hour},
+				Lifecycle: &controllermanagerconfigv1alpha1.ProjectLifecycleControllerConfiguration{
+					InactivityArchivalDays:   ptr.To(90),
+					ArchivalPeriodDays:       ptr.To(30),
+					DeletionNotificationDays: []int{7, 1},
+				},
+			},
+			Clock:    fakeClock,
+			Recorder: recorder,
+		}
+
+		namespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "garden-foo"}}
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", CreationTimestamp: metav1.Time{Time: now.Add(-day)}},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To(namespace.Name)},
+		}
+		shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: namespace.Name}}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)}
+	})
+
+	JustBeforeEach(func() {
+		status := project.Status.DeepCopy()
+
+		Expect(c.Create(ctx, namespace)).To(Succeed())
+		Expect(c.Create(ctx, project)).To(Succeed())
+		project.Status = *status
+		Expect(c.Status().Update(ctx, project)).To(Succeed())
+		if shoot != nil {
+			Expect(c.Create(ctx, shoot)).To(Succeed())
+		}
+	})
+
+	reconcileProject := func() reconcile.Result {
+		result, err := reconciler.Reconcile(ctx, request)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		ExpectWithOffset(1, c.Get(ctx, client.ObjectKeyFromObject(project), project)).To(Succeed())
+		if shoot != nil {
+			ExpectWithOffset(1, c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		}
+		return result
+	}
+
+	Context("active project", func() {
+		BeforeEach(func() {
+			project.Status.LastActivityTimestamp = &metav1.Time{Time: now.Add(-10 * day)}
+		})
+
+		It("should do nothing and requeue after the stale sync period", func() {
+			Expect(reconcileProject()).To(Equal(reconcile.Result{RequeueAfter: 12 * time.Hour}))
+
+			Expect(project.Status.ArchivedTimestamp).To(BeNil())
+			Expect(project.Status.Conditions).To(BeEmpty())
+			Expect(shoot.Spec.Hibernation).To(BeNil())
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should requeue when the project expires", func() {
+			project.Spec.Lifecycle = &gardencorev1beta1.ProjectLifecycle{ExpirationTimestamp: &metav1.Time{Time: now.Add(time.Hour)}}
+			Expect(c.Update(ctx, project)).To(Succeed())
+
+			Expect(reconcileProject()).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+		})
+	})
+
+	Context("expired project", func() {
+		BeforeEach(func() {
+			project.Spec.Lifecycle = &gardencorev1beta1.ProjectLifecycle{ExpirationTimestamp: &metav1.Time{Time: now.Add(-time.Hour)}}
+		})
+
+		It("should archive the project and hibernate its shoots", func() {
+			Expect(reconcileProject()).To(Equal(reconcile.Result{RequeueAfter: 12 * time.Hour}))
+
+			Expect(project.Status.Phase).To(Equal(gardencorev1beta1.ProjectArchived))
+			Expect(project.Status.ArchivedTimestamp.Time).To(BeTemporally("==", now))
+			Expect(project.Status.ArchivedAutoDeleteTimestamp.Time).To(BeTemporally("==", now.Add(30*day)))
+			Expect(project.Status.Conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(gardencorev1beta1.ProjectDeletionScheduled),
+				"Status":  Equal(gardencorev1beta1.ConditionTrue),
+				"Reason":  Equal("Archived"),
+				"Message": Equal("Project will be deleted automatically at 2026-11-17T12:00:00Z because it is archived."),
+			})))
+
+			Expect(shoot.Spec.Hibernation.Enabled).To(PointTo(BeTrue()))
+			Expect(shoot.Annotations).To(HaveKeyWithValue(v1beta1constants.ShootHibernationReason, "Project foo is archived"))
+
+			Expect(recorder.Events).To(HaveLen(2))
+			Expect(<-recorder.Events).To(Equal("Warning Archived Project has been archived because it expired at 2026-10-18T11:00:00Z, its shoots are hibernated and it will be deleted at 2026-11-17T12:00:00Z"))
+			Expect(<-recorder.Events).To(Equal("Warning DeletionScheduled Project will be deleted automatically at 2026-11-17T12:00:00Z because it is archived."))
+		})
+
+		It("should revert the archival when the expiration is removed", func() {
+			reconcileProject()
+			Eventually(recorder.Events).Should(HaveLen(2))
+			for len(recorder.Events) > 0 {
+				<-recorder.Events
+			}
+
+			project.Spec.Lifecycle = nil
+			Expect(c.Update(ctx, project)).To(Succeed())
+			reconcileProject()
+
+			Expect(project.Status.Phase).To(Equal(gardencorev1beta1.ProjectReady))
+			Expect(project.Status.ArchivedTimestamp).To(BeNil())
+			Expect(project.Status.ArchivedAutoDeleteTimestamp).To(BeNil())
+			Expect(project.Status.Conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(gardencorev1beta1.ProjectDeletionScheduled),
+				"Status": Equal(gardencorev1beta1.ConditionFalse),
+				"Reason": Equal("NotScheduled"),
+			})))
+			Expect(<-recorder.Events).To(Equal("Normal Unarchived Archival of project has been reverted, its shoots can be woken up again"))
+		})
+
+		It("should notify when a notification day is reached and delete the project after the archival period once the deletion is confirmed", func() {
+			reconcileProject()
+			for len(recorder.Events) > 0 {
+				<-recorder.Events
+			}
+
+			By("Reconcile again without any notification being due")
+			fakeClock.Step(day)
+			Expect(reconcileProject()).To(Equal(reconcile.Result{RequeueAfter: 12 * time.Hour}))
+			Expect(recorder.Events).To(BeEmpty())
+
+			By("Reconcile 7 days before the deletion")
+			fakeClock.SetTime(now.Add(23 * day))
+			Expect(reconcileProject()).To(Equal(reconcile.Result{RequeueAfter: 12 * time.Hour}))
+			Expect(<-recorder.Events).To(Equal("Warning DeletionScheduled Project will be deleted automatically at 2026-11-17T12:00:00Z because it is archived."))
+
+			By("Reconcile shortly before the last notification day")
+			fakeClock.SetTime(now.Add(29*day - time.Hour))
+			Expect(reconcileProject()).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+			Expect(recorder.Events).To(BeEmpty())
+
+			By("Reconcile after the archival period without confirmation")
+			fakeClock.SetTime(now.Add(30 * day))
+			Expect(reconcileProject()).To(Equal(reconcile.Result{RequeueAfter: 12 * time.Hour}))
+			Expect(project.DeletionTimestamp).To(BeNil())
+			Expect(shoot.DeletionTimestamp).To(BeNil())
+			Eventually(recorder.Events).Should(Receive(Equal("Warning DeletionNotConfirmed Project has not been deleted because it still contains 1 shoot(s), annotate it with confirmation.gardener.cloud/deletion=true to confirm the deletion of the project and its shoots")))
+
+			By("Reconcile after the deletion was confirmed")
+			metav1.SetMetaDataAnnotation(&project.ObjectMeta, v1beta1constants.ConfirmationDeletion, "true")
+			Expect(c.Update(ctx, project)).To(Succeed())
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(BeNotFoundError())
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(project), project)).To(BeNotFoundError())
+		})
+	})
+
+	Context("inactive project", func() {
+		BeforeEach(func() {
+			project.CreationTimestamp = metav1.Time{Time: now.Add(-200 * day)}
+			project.Status.LastActivityTimestamp = &metav1.Time{Time: now.Add(-91 * day)}
+			shoot = nil
+		})
+
+		It("should archive the project", func() {
+			reconcileProject()
+
+			Expect(project.Status.Phase).To(Equal(gardencorev1beta1.ProjectArchived))
+			Expect(<-recorder.Events).To(Equal("Warning Archived Project has been archived because there was no activity since 2026-07-19T12:00:00Z, its shoots are hibernated and it will be deleted at 2026-11-17T12:00:00Z"))
+		})
+
+		It("should delete the project after the archival period without confirmation", func() {
+			reconcileProject()
+
+			fakeClock.SetTime(now.Add(30 * day))
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(project), project)).To(BeNotFoundError())
+		})
+
+		It("should not archive the project if it is still in use", func() {
+			Expect(c.Create(ctx, &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: namespace.Name}})).To(Succeed())
+
+			reconcileProject()
+
+			Expect(project.Status.ArchivedTimestamp).To(BeNil())
+		})
+
+		Context("minimum lifetime is not over", func() {
+			BeforeEach(func() {
+				reconciler.Config.Lifecycle.InactivityArchivalDays = ptr.To(10)
+				project.CreationTimestamp = metav1.Time{Time: now.Add(-20 * day)}
+				project.Status.LastActivityTimestamp = &metav1.Time{Time: now.Add(-11 * day)}
+			})
+
+			It("should not archive the project", func() {
+				reconcileProject()
+
+				Expect(project.Status.ArchivedTimestamp).To(BeNil())
+			})
+		})
+
+		It("should not archive the project if archival due to inactivity is disabled", func() {
+			reconciler.Config.Lifecycle.InactivityArchivalDays = nil
+
+			reconcileProject()
+
+			Expect(project.Status.ArchivedTimestamp).To(BeNil())
+		})
+
+		Context("namespace is excluded from the stale check", func() {
+			BeforeEach(func() {
+				metav1.SetMetaDataAnnotation(&namespace.ObjectMeta, v1beta1constants.ProjectSkipStaleCheck, "true")
+			})
+
+			It("should not archive the project", func() {
+				reconcileProject()
+
+				Expect(project.Status.ArchivedTimestamp).To(BeNil())
+			})
+		})
+
+		It("should renew the project and revert the archival", func() {
+			reconcileProject()
+			Expect(project.Status.ArchivedTimestamp).NotTo(BeNil())
+
+			metav1.SetMetaDataAnnotation(&project.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.ProjectOperationRenew)
+			Expect(c.Update(ctx, project)).To(Succeed())
+			fakeClock.Step(time.Hour)
+
+			Expect(reconcileProject()).To(Equal(reconcile.Result{RequeueAfter: 12 * time.Hour}))
+
+			Expect(project.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+			Expect(project.Status.LastActivityTimestamp.Time).To(BeTemporally("==", now.Add(time.Hour)))
+			Expect(project.Status.ArchivedTimestamp).To(BeNil())
+			Expect(project.Status.Phase).To(Equal(gardencorev1beta1.ProjectReady))
+		})
+	})
+
+	Context("stale project", func() {
+		BeforeEach(func() {
+			project.Status.LastActivityTimestamp = &metav1.Time{Time: now}
+			project.Status.StaleAutoDeleteTimestamp = &metav1.Time{Time: now.Add(3 * day)}
+		})
+
+		It("should announce the deletion and requeue on the next notification day", func() {
+			Expect(reconcileProject()).To(Equal(reconcile.Result{RequeueAfter: 12 * time.Hour}))
+
+			Expect(project.Status.ArchivedTimestamp).To(BeNil())
+			Expect(project.Status.Conditions).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(gardencorev1beta1.ProjectDeletionScheduled),
+				"Status":  Equal(gardencorev1beta1.ConditionTrue),
+				"Reason":  Equal("Stale"),
+				"Message": Equal("Project will be deleted automatically at 2026-10-21T12:00:00Z because it is stale."),
+			})))
+			Expect(<-recorder.Events).To(Equal("Warning DeletionScheduled Project will be deleted automatically at 2026-10-21T12:00:00Z because it is stale."))
+
+			fakeClock.SetTime(now.Add(2*day - time.Minute))
+			Expect(reconcileProject()).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+			Expect(recorder.Events).To(BeEmpty())
+		})
+	})
+})
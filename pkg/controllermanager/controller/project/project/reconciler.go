@@ -164,8 +164,12 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *ga
 		return err
 	}
 
-	// Update the project status to mark it as 'ready'.
-	if err := patchProjectPhase(ctx, r.Client, project, gardencorev1beta1.ProjectReady); err != nil {
+	// Update the project status to mark it as 'ready' unless it is archived.
+	phase := gardencorev1beta1.ProjectReady
+	if project.Status.ArchivedTimestamp != nil {
+		phase = gardencorev1beta1.ProjectArchived
+	}
+	if err := patchProjectPhase(ctx, r.Client, project, phase); err != nil {
 		r.Recorder.Eventf(project, corev1.EventTypeWarning, gardencorev1beta1.ProjectEventNamespaceReconcileFailed, "Error while trying to mark project as ready: %+v", err)
 		return err
 	}
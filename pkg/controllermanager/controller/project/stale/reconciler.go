@@ -92,25 +92,13 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *ga
 		return r.markProjectAsNotStale(ctx, project)
 	}
 
-	for _, check := range []struct {
-		resource  string
-		checkFunc func(context.Context, string) (bool, error)
-	}{
-		{"Shoots", r.projectInUseDueToShoots},
-		{"BackupEntries", r.projectInUseDueToBackupEntries},
-		{"Secrets", r.projectInUseDueToSecrets},
-		{"InternalSecrets", r.projectInUseDueToInternalSecrets},
-		{"WorkloadIdentities", r.projectInUseDueToWorkloadIdentities},
-		{"Quotas", r.projectInUseDueToQuotas},
-	} {
-		projectInUse, err := check.checkFunc(ctx, *project.Spec.Namespace)
-		if err != nil {
-			return err
-		}
-		if projectInUse {
-			log.Info("Project is in use by resource, marking Project as not stale", "resource", check.resource)
-			return r.markProjectAsNotStale(ctx, project)
-		}
+	resource, err := r.InUseBy(ctx, *project.Spec.Namespace)
+	if err != nil {
+		return err
+	}
+	if resource != "" {
+		log.Info("Project is in use by resource, marking Project as not stale", "resource", resource)
+		return r.markProjectAsNotStale(ctx, project)
 	}
 
 	log.Info("Project is not in use by any resource, marking Project as stale")
@@ -139,6 +127,32 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, project *ga
 	return client.IgnoreNotFound(r.Client.Delete(ctx, project))
 }
 
+// InUseBy returns the kind of the first resource by which the project with the given namespace is in use. An empty
+// string is returned if the project is not in use by any resource.
+func (r *Reconciler) InUseBy(ctx context.Context, namespace string) (string, error) {
+	for _, check := range []struct {
+		resource  string
+		checkFunc func(context.Context, string) (bool, error)
+	}{
+		{"Shoots", r.projectInUseDueToShoots},
+		{"BackupEntries", r.projectInUseDueToBackupEntries},
+		{"Secrets", r.projectInUseDueToSecrets},
+		{"InternalSecrets", r.projectInUseDueToInternalSecrets},
+		{"WorkloadIdentities", r.projectInUseDueToWorkloadIdentities},
+		{"Quotas", r.projectInUseDueToQuotas},
+	} {
+		projectInUse, err := check.checkFunc(ctx, namespace)
+		if err != nil {
+			return "", err
+		}
+		if projectInUse {
+			return check.resource, nil
+		}
+	}
+
+	return "", nil
+}
+
 func (r *Reconciler) projectInUseDueToShoots(ctx context.Context, namespace string) (bool, error) {
 	return kubernetesutils.ResourcesExist(ctx, r.Client, &gardencorev1beta1.ShootList{}, r.Client.Scheme(), client.InNamespace(namespace))
 }
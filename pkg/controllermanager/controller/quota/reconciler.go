@@ -5,28 +5,49 @@
 package quota
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"maps"
+	"slices"
+	"time"
 
+	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/apis/security"
+	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+const (
+	// EventSoftLimitExceeded is an event reason for resources allocated by Shoots exceeding a soft limit of a Quota.
+	EventSoftLimitExceeded = "SoftLimitExceeded"
+	// EventLimitExceeded is an event reason for resources allocated by Shoots exceeding a limit of a Quota.
+	EventLimitExceeded = "LimitExceeded"
 )
 
 // Reconciler reconciles Quota.
 type Reconciler struct {
 	Client   client.Client
 	Config   controllermanagerconfigv1alpha1.QuotaControllerConfiguration
+	Clock    clock.Clock
 	Recorder record.EventRecorder
 }
 
@@ -86,5 +107,158 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		}
 	}
 
-	return reconcile.Result{}, nil
+	if err := r.updateStatus(ctx, log, quota); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to update usage in Quota status: %w", err)
+	}
+
+	var requeueAfter time.Duration
+	if r.Config.SyncPeriod != nil {
+		requeueAfter = r.Config.SyncPeriod.Duration
+	}
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *Reconciler) updateStatus(ctx context.Context, log logr.Logger, quota *gardencorev1beta1.Quota) error {
+	status, err := r.computeStatus(ctx, log, quota)
+	if err != nil {
+		return err
+	}
+
+	exceededLimits := exceededMetrics(status.Used, quota.Spec.Metrics)
+	if exceededLimits.Len() > 0 {
+		status.LimitsExceededSince = quota.Status.LimitsExceededSince
+		if status.LimitsExceededSince == nil {
+			status.LimitsExceededSince = &metav1.Time{Time: r.Clock.Now()}
+		}
+	}
+
+	for _, metric := range sets.List(exceededMetrics(status.Used, quota.Spec.SoftMetrics).Difference(exceededMetrics(quota.Status.Used, quota.Spec.SoftMetrics))) {
+		used, softLimit := status.Used[metric], quota.Spec.SoftMetrics[metric]
+		r.Recorder.Eventf(quota, corev1.EventTypeWarning, EventSoftLimitExceeded, "Allocated %s of %s exceeds the soft limit %s", used.String(), metric, softLimit.String())
+	}
+	for _, metric := range sets.List(exceededLimits.Difference(exceededMetrics(quota.Status.Used, quota.Spec.Metrics))) {
+		used, limit := status.Used[metric], quota.Spec.Metrics[metric]
+		message := fmt.Sprintf("Allocated %s of %s exceeds the limit %s", used.String(), metric, limit.String())
+		if quota.Spec.GracePeriod != nil {
+			message += fmt.Sprintf(", further allocations will be rejected after %s", status.LimitsExceededSince.Add(quota.Spec.GracePeriod.Duration).UTC().Format(time.RFC3339))
+		}
+		r.Recorder.Event(quota, corev1.EventTypeWarning, EventLimitExceeded, message)
+	}
+
+	if apiequality.Semantic.DeepEqual(quota.Status, status) {
+		return nil
+	}
+
+	patch := client.MergeFrom(quota.DeepCopy())
+	quota.Status = status
+	return r.Client.Status().Patch(ctx, quota, patch)
+}
+
+// computeStatus sums up the resources allocated by all Shoots which are using a SecretBinding or CredentialsBinding
+// referencing the given Quota. Only the metrics constrained by the Quota are considered. Shoots whose resources cannot
+// be computed, e.g., because they use a machine type unknown to their CloudProfile, are skipped.
+func (r *Reconciler) computeStatus(ctx context.Context, log logr.Logger, quota *gardencorev1beta1.Quota) (gardencorev1beta1.QuotaStatus, error) {
+	status := gardencorev1beta1.QuotaStatus{Used: make(corev1.ResourceList, len(quota.Spec.Metrics))}
+	for metric := range quota.Spec.Metrics {
+		status.Used[metric] = resource.Quantity{}
+	}
+
+	shoots, err := r.shootsReferencingQuota(ctx, quota)
+	if err != nil {
+		return status, err
+	}
+
+	for _, shoot := range shoots {
+		shootLog := log.WithValues("shoot", client.ObjectKeyFromObject(&shoot))
+
+		cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, &shoot)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				shootLog.Info("Skipping Shoot when computing the Quota usage, its CloudProfile was not found", "err", err)
+				continue
+			}
+			return status, fmt.Errorf("failed getting CloudProfile for Shoot %s: %w", client.ObjectKeyFromObject(&shoot), err)
+		}
+
+		shootResources, err := gardenerutils.ComputeShootQuotaResources(&shoot, &cloudProfile.Spec)
+		if err != nil {
+			shootLog.Info("Skipping Shoot when computing the Quota usage, its resources cannot be computed", "err", err)
+			continue
+		}
+
+		shootUsage := gardencorev1beta1.QuotaShootUsage{
+			Name:      shoot.Name,
+			Namespace: shoot.Namespace,
+			Used:      make(corev1.ResourceList, len(quota.Spec.Metrics)),
+		}
+		for metric := range quota.Spec.Metrics {
+			quantity := shootResources[metric]
+			shootUsage.Used[metric] = quantity
+
+			used := status.Used[metric]
+			used.Add(quantity)
+			status.Used[metric] = used
+		}
+		status.Shoots = append(status.Shoots, shootUsage)
+	}
+
+	slices.SortFunc(status.Shoots, func(a, b gardencorev1beta1.QuotaShootUsage) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+
+	return status, nil
+}
+
+// exceededMetrics returns the metrics for which the used resources exceed the given limits.
+func exceededMetrics(used, limits corev1.ResourceList) sets.Set[corev1.ResourceName] {
+	exceeded := sets.New[corev1.ResourceName]()
+	for metric, limit := range limits {
+		if quantity, ok := used[metric]; ok && quantity.Cmp(limit) > 0 {
+			exceeded.Insert(metric)
+		}
+	}
+	return exceeded
+}
+
+// shootsReferencingQuota returns the Shoots using a SecretBinding or CredentialsBinding which references the given
+// Quota. Each Shoot is returned only once.
+func (r *Reconciler) shootsReferencingQuota(ctx context.Context, quota *gardencorev1beta1.Quota) ([]gardencorev1beta1.Shoot, error) {
+	var (
+		quotaKey = quota.Namespace + "/" + quota.Name
+		shoots   = map[client.ObjectKey]gardencorev1beta1.Shoot{}
+	)
+
+	addShoots := func(namespace, field, bindingName string) error {
+		shootList := &gardencorev1beta1.ShootList{}
+		if err := r.Client.List(ctx, shootList, client.InNamespace(namespace), client.MatchingFields{field: bindingName}); err != nil {
+			return fmt.Errorf("failed listing Shoots referencing binding %s/%s: %w", namespace, bindingName, err)
+		}
+		for _, shoot := range shootList.Items {
+			shoots[client.ObjectKeyFromObject(&shoot)] = shoot
+		}
+		return nil
+	}
+
+	secretBindingList := &gardencorev1beta1.SecretBindingList{}
+	if err := r.Client.List(ctx, secretBindingList, client.MatchingFields{core.SecretBindingQuotas: quotaKey}); err != nil {
+		return nil, fmt.Errorf("failed listing SecretBindings: %w", err)
+	}
+	for _, binding := range secretBindingList.Items {
+		if err := addShoots(binding.Namespace, core.ShootSecretBindingName, binding.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	credentialsBindingList := &securityv1alpha1.CredentialsBindingList{}
+	if err := r.Client.List(ctx, credentialsBindingList, client.MatchingFields{security.CredentialsBindingQuotas: quotaKey}); err != nil {
+		return nil, fmt.Errorf("failed listing CredentialsBindings: %w", err)
+	}
+	for _, binding := range credentialsBindingList.Items {
+		if err := addShoots(binding.Namespace, core.ShootCredentialsBindingName, binding.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	return slices.Collect(maps.Values(shoots)), nil
 }
@@ -6,20 +6,28 @@ package quota
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/security"
 	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
@@ -38,7 +46,18 @@ var _ = Describe("Reconciler", func() {
 	)
 
 	BeforeEach(func() {
-		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.Quota{}).
+			WithIndex(&gardencorev1beta1.SecretBinding{}, core.SecretBindingQuotas, indexer.SecretBindingQuotasIndexerFunc).
+			WithIndex(&securityv1alpha1.CredentialsBinding{}, security.CredentialsBindingQuotas, indexer.CredentialsBindingQuotasIndexerFunc).
+			WithIndex(&gardencorev1beta1.Shoot{}, core.ShootSecretBindingName, func(obj client.Object) []string {
+				return []string{ptr.Deref(obj.(*gardencorev1beta1.Shoot).Spec.SecretBindingName, "")}
+			}).
+			WithIndex(&gardencorev1beta1.Shoot{}, core.ShootCredentialsBindingName, func(obj client.Object) []string {
+				return []string{ptr.Deref(obj.(*gardencorev1beta1.Shoot).Spec.CredentialsBindingName, "")}
+			}).
+			Build()
 
 		quotaName = "test-quota"
 		reconciler = &Reconciler{Client: fakeClient, Recorder: &record.FakeRecorder{}}
@@ -87,6 +106,179 @@ var _ = Describe("Reconciler", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(quota.GetFinalizers()).Should(ConsistOf(finalizerName))
 		})
+
+		Context("usage", func() {
+			var (
+				fakeClock    *testclock.FakeClock
+				recorder     *record.FakeRecorder
+				cloudProfile *gardencorev1beta1.CloudProfile
+				newShoot     func(name string) *gardencorev1beta1.Shoot
+			)
+
+			BeforeEach(func() {
+				fakeClock = testclock.NewFakeClock(time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC))
+				recorder = record.NewFakeRecorder(10)
+				reconciler = &Reconciler{
+					Client:   fakeClient,
+					Config:   controllermanagerconfigv1alpha1.QuotaControllerConfiguration{SyncPeriod: &metav1.Duration{Duration: time.Minute}},
+					Clock:    fakeClock,
+					Recorder: recorder,
+				}
+
+				patch := client.MergeFrom(quota.DeepCopy())
+				quota.Spec.Metrics = corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("100"),
+					"loadbalancer":        resource.MustParse("10"),
+					"storage.standard":    resource.MustParse("1Ti"),
+					corev1.ResourceMemory: resource.MustParse("1Ti"),
+					"gpu":                 resource.MustParse("0"),
+				}
+				Expect(fakeClient.Patch(ctx, quota, patch)).To(Succeed())
+
+				cloudProfile = &gardencorev1beta1.CloudProfile{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-cloudprofile"},
+					Spec: gardencorev1beta1.CloudProfileSpec{
+						MachineTypes: []gardencorev1beta1.MachineType{{
+							Name:   "machine-type",
+							CPU:    resource.MustParse("2"),
+							GPU:    resource.MustParse("0"),
+							Memory: resource.MustParse("4Gi"),
+						}},
+						VolumeTypes: []gardencorev1beta1.VolumeType{{
+							Name:  "volume-type",
+							Class: gardencorev1beta1.VolumeClassStandard,
+						}},
+					},
+				}
+				Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+
+				newShoot = func(name string) *gardencorev1beta1.Shoot {
+					return &gardencorev1beta1.Shoot{
+						ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test-namespace"},
+						Spec: gardencorev1beta1.ShootSpec{
+							CloudProfileName: ptr.To(cloudProfile.Name),
+							Provider: gardencorev1beta1.Provider{
+								Workers: []gardencorev1beta1.Worker{{
+									Name:    "worker",
+									Maximum: 3,
+									Machine: gardencorev1beta1.Machine{Type: "machine-type"},
+									Volume:  &gardencorev1beta1.Volume{Type: ptr.To("volume-type"), VolumeSize: "20Gi"},
+								}},
+							},
+						},
+					}
+				}
+			})
+
+			It("should publish zero usage if no binding references the Quota", func() {
+				result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: quotaName}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(quota), quota)).To(Succeed())
+				Expect(quota.Status.Used).To(HaveLen(5))
+				for _, quantity := range quota.Status.Used {
+					Expect(quantity.IsZero()).To(BeTrue())
+				}
+				Expect(quota.Status.Shoots).To(BeEmpty())
+			})
+
+			It("should sum up the usage of all shoots referencing the Quota", func() {
+				Expect(fakeClient.Create(ctx, secretBinding)).To(Succeed())
+				Expect(fakeClient.Create(ctx, credentialsBinding)).To(Succeed())
+
+				shootViaSecretBinding := newShoot("shoot-b")
+				shootViaSecretBinding.Spec.SecretBindingName = ptr.To(secretBinding.Name)
+				Expect(fakeClient.Create(ctx, shootViaSecretBinding)).To(Succeed())
+
+				shootViaCredentialsBinding := newShoot("shoot-a")
+				shootViaCredentialsBinding.Spec.CredentialsBindingName = ptr.To(credentialsBinding.Name)
+				shootViaCredentialsBinding.Spec.Addons = &gardencorev1beta1.Addons{NginxIngress: &gardencorev1beta1.NginxIngress{Addon: gardencorev1beta1.Addon{Enabled: true}}}
+				Expect(fakeClient.Create(ctx, shootViaCredentialsBinding)).To(Succeed())
+
+				unrelatedShoot := newShoot("shoot-c")
+				unrelatedShoot.Spec.SecretBindingName = ptr.To("other-secretbinding")
+				Expect(fakeClient.Create(ctx, unrelatedShoot)).To(Succeed())
+
+				result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: quotaName}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(quota), quota)).To(Succeed())
+				Expect(quota.Status.Used.Cpu().Cmp(resource.MustParse("12"))).To(Equal(0))
+				Expect(quota.Status.Used.Memory().Cmp(resource.MustParse("24Gi"))).To(Equal(0))
+				Expect(quota.Status.Used.Name("storage.standard", resource.BinarySI).Cmp(resource.MustParse("120Gi"))).To(Equal(0))
+				Expect(quota.Status.Used.Name("loadbalancer", resource.DecimalSI).Cmp(resource.MustParse("3"))).To(Equal(0))
+				Expect(quota.Status.Shoots).To(HaveExactElements(
+					And(HaveField("Name", "shoot-a"), HaveField("Namespace", "test-namespace")),
+					And(HaveField("Name", "shoot-b"), HaveField("Namespace", "test-namespace")),
+				))
+				Expect(quota.Status.Shoots[0].Used.Name("loadbalancer", resource.DecimalSI).Cmp(resource.MustParse("2"))).To(Equal(0))
+				Expect(quota.Status.Shoots[1].Used.Cpu().Cmp(resource.MustParse("6"))).To(Equal(0))
+			})
+
+			It("should track since when the limits are exceeded and emit events", func() {
+				patch := client.MergeFrom(quota.DeepCopy())
+				quota.Spec.Metrics[corev1.ResourceCPU] = resource.MustParse("4")
+				quota.Spec.SoftMetrics = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")}
+				quota.Spec.GracePeriod = &metav1.Duration{Duration: time.Hour}
+				Expect(fakeClient.Patch(ctx, quota, patch)).To(Succeed())
+
+				Expect(fakeClient.Create(ctx, secretBinding)).To(Succeed())
+				shoot := newShoot("shoot")
+				shoot.Spec.SecretBindingName = ptr.To(secretBinding.Name)
+				Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: quotaName}})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(quota), quota)).To(Succeed())
+				Expect(quota.Status.LimitsExceededSince.Time).To(BeTemporally("==", fakeClock.Now()))
+				Expect(recorder.Events).To(HaveLen(2))
+				Expect(<-recorder.Events).To(Equal("Warning SoftLimitExceeded Allocated 6 of cpu exceeds the soft limit 2"))
+				Expect(<-recorder.Events).To(Equal("Warning LimitExceeded Allocated 6 of cpu exceeds the limit 4, further allocations will be rejected after 2026-10-18T13:00:00Z"))
+
+				By("Keep the time and do not emit events again")
+				fakeClock.Step(time.Minute)
+				_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: quotaName}})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(quota), quota)).To(Succeed())
+				Expect(quota.Status.LimitsExceededSince.Time).To(BeTemporally("==", fakeClock.Now().Add(-time.Minute)))
+				Expect(recorder.Events).To(BeEmpty())
+
+				By("Reset the time once the limits are no longer exceeded")
+				shoot.Spec.Provider.Workers[0].Maximum = 1
+				Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+				_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: quotaName}})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(quota), quota)).To(Succeed())
+				Expect(quota.Status.LimitsExceededSince).To(BeNil())
+				Expect(recorder.Events).To(BeEmpty())
+			})
+
+			It("should skip shoots whose usage cannot be computed", func() {
+				Expect(fakeClient.Create(ctx, secretBinding)).To(Succeed())
+
+				shoot := newShoot("shoot")
+				shoot.Spec.SecretBindingName = ptr.To(secretBinding.Name)
+				Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+				unknownMachineTypeShoot := newShoot("shoot-unknown")
+				unknownMachineTypeShoot.Spec.SecretBindingName = ptr.To(secretBinding.Name)
+				unknownMachineTypeShoot.Spec.Provider.Workers[0].Machine.Type = "unknown"
+				Expect(fakeClient.Create(ctx, unknownMachineTypeShoot)).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: quotaName}})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(quota), quota)).To(Succeed())
+				Expect(quota.Status.Used.Cpu().Cmp(resource.MustParse("6"))).To(Equal(0))
+				Expect(quota.Status.Shoots).To(HaveExactElements(HaveField("Name", "shoot")))
+			})
+		})
 	})
 
 	Context("when deletion timestamp set", func() {
@@ -15,6 +15,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/maintenance"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/migration"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/quota"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/rebalancing"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/reference"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/retry"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/statuslabel"
@@ -52,6 +53,14 @@ func AddToManager(mgr manager.Manager, cfg controllermanagerconfigv1alpha1.Contr
 		return fmt.Errorf("failed adding migration reconciler: %w", err)
 	}
 
+	if cfg.Controllers.ShootRebalancing != nil {
+		if err := (&rebalancing.Reconciler{
+			Config: *cfg.Controllers.ShootRebalancing,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding rebalancing reconciler: %w", err)
+		}
+	}
+
 	if err := reference.AddToManager(mgr, *cfg.Controllers.ShootReference); err != nil {
 		return fmt.Errorf("failed adding reference reconciler: %w", err)
 	}
@@ -5,17 +5,25 @@
 package hibernation
 
 import (
+	"context"
 	"reflect"
 
+	"github.com/go-logr/logr"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/controllerutils"
 )
 
@@ -42,6 +50,11 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
 			ReconciliationTimeout:   controllerutils.DefaultReconciliationTimeout,
 		}).
+		Watches(
+			&gardencorev1beta1.Project{},
+			handler.EnqueueRequestsFromMapFunc(r.MapProjectToShoots(mgr.GetLogger().WithValues("controller", ControllerName))),
+			builder.WithPredicates(r.ProjectPredicate()),
+		).
 		Complete(r)
 }
 
@@ -53,7 +66,7 @@ func (r *Reconciler) ShootPredicate() predicate.Predicate {
 			if !ok {
 				return false
 			}
-			return len(getShootHibernationSchedules(shoot.Spec.Hibernation)) > 0
+			return isRelevantForHibernation(shoot)
 		},
 		UpdateFunc: func(e event.UpdateEvent) bool {
 			shoot, ok := e.ObjectNew.(*gardencorev1beta1.Shoot)
@@ -66,12 +79,80 @@ func (r *Reconciler) ShootPredicate() predicate.Predicate {
 				return false
 			}
 
-			var (
-				oldSchedules = getShootHibernationSchedules(oldShoot.Spec.Hibernation)
-				newSchedules = getShootHibernationSchedules(shoot.Spec.Hibernation)
-			)
+			if !isRelevantForHibernation(shoot) {
+				return false
+			}
+
+			return !reflect.DeepEqual(getShootHibernationSchedules(oldShoot.Spec.Hibernation), getShootHibernationSchedules(shoot.Spec.Hibernation)) ||
+				!apiequality.Semantic.DeepEqual(getStayAwakeUntil(oldShoot.Spec.Hibernation), getStayAwakeUntil(shoot.Spec.Hibernation)) ||
+				!apiequality.Semantic.DeepEqual(getAutoHibernation(oldShoot.Spec.Hibernation), getAutoHibernation(shoot.Spec.Hibernation)) ||
+				!apiequality.Semantic.DeepEqual(oldShoot.Status.LastActivityTime, shoot.Status.LastActivityTime) ||
+				v1beta1helper.HibernationIsEnabled(oldShoot) != v1beta1helper.HibernationIsEnabled(shoot)
+		},
+	}
+}
+
+// isRelevantForHibernation returns true if the given Shoot has hibernation schedules, is subject to automatic
+// hibernation (gardenlet only maintains the last activity time in this case), or carries a hibernation reason which
+// might have to be removed.
+func isRelevantForHibernation(shoot *gardencorev1beta1.Shoot) bool {
+	_, hasHibernationReason := shoot.Annotations[v1beta1constants.ShootHibernationReason]
+	return len(getShootHibernationSchedules(shoot.Spec.Hibernation)) > 0 || shoot.Status.LastActivityTime != nil || hasHibernationReason
+}
+
+// ProjectPredicate reacts on Project events that indicate that the hibernation calendars of the Project changed.
+func (r *Reconciler) ProjectPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc:  func(_ event.CreateEvent) bool { return false },
+		DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			project, ok := e.ObjectNew.(*gardencorev1beta1.Project)
+			if !ok {
+				return false
+			}
 
-			return !reflect.DeepEqual(oldSchedules, newSchedules) && len(newSchedules) > 0
+			oldProject, ok := e.ObjectOld.(*gardencorev1beta1.Project)
+			if !ok {
+				return false
+			}
+
+			return !apiequality.Semantic.DeepEqual(oldProject.Spec.HibernationCalendars, project.Spec.HibernationCalendars)
 		},
 	}
 }
+
+// MapProjectToShoots is a handler.MapFunc for mapping a Project to all Shoots in its namespace whose hibernation
+// schedules reference hibernation calendars.
+func (r *Reconciler) MapProjectToShoots(log logr.Logger) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		project, ok := obj.(*gardencorev1beta1.Project)
+		if !ok || project.Spec.Namespace == nil {
+			return nil
+		}
+
+		shootList := &gardencorev1beta1.ShootList{}
+		if err := r.Client.List(ctx, shootList, client.InNamespace(*project.Spec.Namespace)); err != nil {
+			log.Error(err, "Failed to list Shoots for Project", "project", client.ObjectKeyFromObject(project))
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for _, shoot := range shootList.Items {
+			if referencesHibernationCalendars(getShootHibernationSchedules(shoot.Spec.Hibernation)) {
+				requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&shoot)})
+			}
+		}
+
+		return requests
+	}
+}
+
+func referencesHibernationCalendars(schedules []gardencorev1beta1.HibernationSchedule) bool {
+	for _, schedule := range schedules {
+		if len(schedule.SkipCalendars) > 0 || len(schedule.HibernateCalendars) > 0 {
+			return true
+		}
+	}
+	return false
+}
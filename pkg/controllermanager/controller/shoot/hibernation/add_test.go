@@ -5,14 +5,22 @@
 package hibernation_test
 
 import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/hibernation"
 )
 
@@ -59,6 +67,12 @@ var _ = Describe("Add", func() {
 			It("should return true because shoot has hibernation schedules", func() {
 				Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
 			})
+
+			It("should return true because shoot is subject to automatic hibernation", func() {
+				shoot.Spec.Hibernation = nil
+				shoot.Status.LastActivityTime = &metav1.Time{Time: time.Date(2022, 4, 12, 9, 0, 0, 0, time.UTC)}
+				Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+			})
 		})
 
 		Describe("#Update", func() {
@@ -85,6 +99,105 @@ var _ = Describe("Add", func() {
 				shoot.Spec.Hibernation.Schedules[0].Start = ptr.To("00 20 * * 1,2,3,4,5,6,7")
 				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
 			})
+
+			It("should return true because the stay awake override changed", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Hibernation.StayAwakeUntil = &metav1.Time{Time: time.Date(2022, 4, 12, 9, 0, 0, 0, time.UTC)}
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+			})
+
+			It("should return true because the last activity time changed", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Status.LastActivityTime = &metav1.Time{Time: time.Date(2022, 4, 12, 9, 0, 0, 0, time.UTC)}
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+			})
+
+			It("should return true because the automatic hibernation configuration changed", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Hibernation.AutoHibernation = &gardencorev1beta1.AutoHibernation{Enabled: true}
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+			})
+
+			It("should return true because the shoot was woken up and carries a hibernation reason", func() {
+				shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/hibernation-reason", "idle")
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Hibernation.Enabled = ptr.To(false)
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+			})
+
+			It("should return false because the shoot was woken up but is not relevant for hibernation", func() {
+				shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Hibernation.Enabled = ptr.To(false)
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("#ProjectPredicate", func() {
+		var (
+			p       predicate.Predicate
+			project *gardencorev1beta1.Project
+		)
+
+		BeforeEach(func() {
+			p = reconciler.ProjectPredicate()
+			project = &gardencorev1beta1.Project{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}
+		})
+
+		It("should return false for create, delete and generic events", func() {
+			Expect(p.Create(event.CreateEvent{Object: project})).To(BeFalse())
+			Expect(p.Delete(event.DeleteEvent{Object: project})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: project})).To(BeFalse())
+		})
+
+		It("should return false because hibernation calendars are equal", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectNew: project, ObjectOld: project})).To(BeFalse())
+		})
+
+		It("should return true because hibernation calendars changed", func() {
+			oldProject := project.DeepCopy()
+			project.Spec.HibernationCalendars = []gardencorev1beta1.HibernationCalendar{{Name: "holidays", Days: []gardencorev1beta1.HibernationCalendarDays{{Begin: "2022-04-12"}}}}
+			Expect(p.Update(event.UpdateEvent{ObjectNew: project, ObjectOld: oldProject})).To(BeTrue())
+		})
+	})
+
+	Describe("#MapProjectToShoots", func() {
+		var (
+			ctx        = context.TODO()
+			fakeClient client.Client
+			project    *gardencorev1beta1.Project
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+			reconciler.Client = fakeClient
+
+			project = &gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+				Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To(shoot.Namespace)},
+			}
+		})
+
+		It("should return the shoots referencing hibernation calendars", func() {
+			shoot.Spec.Hibernation.Schedules[0].SkipCalendars = []string{"holidays"}
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			otherShoot := shoot.DeepCopy()
+			otherShoot.Name = "other"
+			otherShoot.ResourceVersion = ""
+			otherShoot.Spec.Hibernation.Schedules[0].SkipCalendars = nil
+			Expect(fakeClient.Create(ctx, otherShoot)).To(Succeed())
+
+			Expect(reconciler.MapProjectToShoots(logr.Discard())(ctx, project)).To(ConsistOf(
+				reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)},
+			))
+		})
+
+		It("should return nothing if the project has no namespace", func() {
+			project.Spec.Namespace = nil
+			Expect(reconciler.MapProjectToShoots(logr.Discard())(ctx, project)).To(BeEmpty())
 		})
 	})
 })
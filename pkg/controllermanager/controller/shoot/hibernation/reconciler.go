@@ -14,6 +14,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
@@ -22,6 +23,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
@@ -29,6 +32,10 @@ import (
 const (
 	sevenDays         = 7 * 24 * time.Hour
 	nextScheduleDelta = 100 * time.Millisecond
+
+	// EventHibernationCalendarNotFound is an event reason for a hibernation calendar which is referenced by a
+	// hibernation schedule but does not exist in the Shoot's Project.
+	EventHibernationCalendarNotFound = "HibernationCalendarNotFound"
 )
 
 type operation uint8
@@ -59,7 +66,7 @@ func (s *parsedHibernationSchedule) previous(from, to time.Time) *time.Time {
 	// To get the time that is immediately before `to`, iterate over every activation time in the cron schedule
 	// that is after "from" until the one that is immediately after `to` is reached.
 	var previousActivationTime *time.Time
-	for t := s.schedule.Next(from.In(&s.location)); !t.UTC().After(to.UTC()); t = s.schedule.Next(t) {
+	for t := s.schedule.Next(from.In(&s.location)); !t.IsZero() && !t.UTC().After(to.UTC()); t = s.schedule.Next(t) {
 		inUTC := t.UTC()
 		previousActivationTime = &inUTC
 	}
@@ -67,7 +74,143 @@ func (s *parsedHibernationSchedule) previous(from, to time.Time) *time.Time {
 	return previousActivationTime
 }
 
-// Reconciler reconciles Shoots and hibernates or wakes them up according to their hibernation schedules.
+// calendarSchedule is a cron.Schedule which activates at the beginning of each day of hibernation calendars. The days
+// are evaluated in the location of the time passed to Next.
+type calendarSchedule struct {
+	ranges []dayRange
+}
+
+// dayRange is a range of days. first and last are the dates of the first and the last day of the range.
+type dayRange struct {
+	first, last time.Time
+}
+
+func newCalendarSchedule(calendars []gardencorev1beta1.HibernationCalendar) (*calendarSchedule, error) {
+	s := &calendarSchedule{}
+
+	for _, calendar := range calendars {
+		for _, days := range calendar.Days {
+			first, err := time.Parse(time.DateOnly, days.Begin)
+			if err != nil {
+				return nil, fmt.Errorf("failed parsing days of hibernation calendar %q: %w", calendar.Name, err)
+			}
+
+			last := first
+			if days.End != nil {
+				if last, err = time.Parse(time.DateOnly, *days.End); err != nil {
+					return nil, fmt.Errorf("failed parsing days of hibernation calendar %q: %w", calendar.Name, err)
+				}
+			}
+
+			s.ranges = append(s.ranges, dayRange{first: first, last: last})
+		}
+	}
+
+	return s, nil
+}
+
+// Next returns the beginning of the next day of the calendar which is after the given time. The zero time is returned
+// if there is no such day.
+func (s *calendarSchedule) Next(t time.Time) time.Time {
+	var next time.Time
+
+	for _, r := range s.ranges {
+		var (
+			begin     = startOfDay(r.first, t.Location())
+			end       = startOfDay(r.last, t.Location())
+			candidate time.Time
+		)
+
+		switch {
+		case t.Before(begin):
+			candidate = begin
+		case t.Before(end):
+			candidate = startOfDay(t, t.Location()).AddDate(0, 0, 1)
+		default:
+			continue
+		}
+
+		if next.IsZero() || candidate.Before(next) {
+			next = candidate
+		}
+	}
+
+	return next
+}
+
+// skipUntil returns the beginning of the day after the range of days containing the given time. The second return
+// value is false if the given time is not on a day of the calendar.
+func (s *calendarSchedule) skipUntil(t time.Time) (time.Time, bool) {
+	var (
+		day   = startOfDay(t, t.Location())
+		until time.Time
+	)
+
+	for _, r := range s.ranges {
+		if day.Before(startOfDay(r.first, t.Location())) || day.After(startOfDay(r.last, t.Location())) {
+			continue
+		}
+		if end := startOfDay(r.last, t.Location()).AddDate(0, 0, 1); end.After(until) {
+			until = end
+		}
+	}
+
+	return until, !until.IsZero()
+}
+
+func startOfDay(t time.Time, location *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, location)
+}
+
+// skippingSchedule is a cron.Schedule which skips the activation times of the wrapped schedule falling on the days
+// of the given calendar.
+type skippingSchedule struct {
+	schedule cron.Schedule
+	skip     *calendarSchedule
+}
+
+// Next returns the next activation time of the wrapped schedule after the given time which is not on a skipped day.
+func (s *skippingSchedule) Next(t time.Time) time.Time {
+	next := s.schedule.Next(t)
+	for !next.IsZero() {
+		until, skipped := s.skip.skipUntil(next)
+		if !skipped {
+			break
+		}
+		next = s.schedule.Next(until.Add(-time.Nanosecond))
+	}
+
+	return next
+}
+
+// notBeforeSchedule is a cron.Schedule which suppresses the activation times of the wrapped schedule before the given
+// point in time.
+type notBeforeSchedule struct {
+	schedule  cron.Schedule
+	notBefore time.Time
+}
+
+// Next returns the next activation time of the wrapped schedule after the given time which is not before notBefore.
+func (s *notBeforeSchedule) Next(t time.Time) time.Time {
+	if notBefore := s.notBefore.In(t.Location()); t.Before(notBefore) {
+		t = notBefore.Add(-time.Nanosecond)
+	}
+	return s.schedule.Next(t)
+}
+
+// onceSchedule is a cron.Schedule which activates exactly once at the given point in time.
+type onceSchedule time.Time
+
+// Next returns the activation time if it is after the given time, or the zero time otherwise.
+func (s onceSchedule) Next(t time.Time) time.Time {
+	if at := time.Time(s); at.After(t) {
+		return at.In(t.Location())
+	}
+	return time.Time{}
+}
+
+// Reconciler reconciles Shoots and hibernates or wakes them up according to their hibernation schedules. Shoots with
+// enabled automatic hibernation are hibernated when they are idle.
 type Reconciler struct {
 	Client   client.Client
 	Config   controllermanagerconfigv1alpha1.ShootHibernationControllerConfiguration
@@ -75,7 +218,8 @@ type Reconciler struct {
 	Recorder record.EventRecorder
 }
 
-// Reconcile reconciles Shoots and hibernates or wakes them up according to their hibernation schedules.
+// Reconcile reconciles Shoots and hibernates or wakes them up according to their hibernation schedules. Shoots with
+// enabled automatic hibernation are hibernated when they are idle.
 func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
 	log := logf.FromContext(ctx)
 
@@ -93,21 +237,35 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, nil
 	}
 
+	if err := r.removeHibernationReasonIfAwake(ctx, shoot); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// The last activity time is only maintained by gardenlet for awake shoots with enabled automatic hibernation.
 	schedules := getShootHibernationSchedules(shoot.Spec.Hibernation)
-	if len(schedules) == 0 {
-		log.Info("Hibernation schedules have been removed from shoot, stopping reconciliation")
+	if len(schedules) == 0 && shoot.Status.LastActivityTime == nil {
+		log.Info("Shoot has neither hibernation schedules nor automatic hibernation, stopping reconciliation")
 		return reconcile.Result{}, nil
 	}
 
-	parsedSchedules, err := parseHibernationSchedules(schedules)
+	project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, shoot.Namespace)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return reconcile.Result{}, fmt.Errorf("failed getting project for namespace %q: %w", shoot.Namespace, err)
+	}
+
+	parsedSchedules, err := parseHibernationSchedules(schedules, r.getHibernationCalendars(shoot, project, schedules))
 	if err != nil {
 		log.Error(err, "Invalid hibernation schedules, stopping reconciliation")
 		return reconcile.Result{}, nil
 	}
 
+	if stayAwakeUntil := getStayAwakeUntil(shoot.Spec.Hibernation); stayAwakeUntil != nil {
+		parsedSchedules = applyStayAwakeOverride(parsedSchedules, stayAwakeUntil.Time)
+	}
+
 	now := r.Clock.Now()
 	if gardenerutils.IsShootFailedAndUpToDate(shoot) {
-		requeueAfter := nextHibernationTimeDuration(parsedSchedules, now)
+		requeueAfter := nextHibernationTimeDuration(parsedSchedules, idleHibernationTime(shoot, project), now)
 		log.Info("Shoot is in Failed state, requeuing shoot hibernation", "requeueAfter", requeueAfter)
 		return reconcile.Result{RequeueAfter: requeueAfter}, nil
 	}
@@ -116,18 +274,120 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	// If no such schedule is found, the hibernation schedules were changed mid-air and the shoot must be
 	// hibernated or wakeup the at a later time.
 	mostRecentSchedule := getScheduleWithMostRecentTime(parsedSchedules, r.Config.TriggerDeadlineDuration, shoot, now)
-	if mostRecentSchedule != nil {
+	if mostRecentSchedule != nil && mostRecentSchedule.operation == wakeUp && project != nil && project.Status.ArchivedTimestamp != nil {
+		log.Info("Not waking up shoot because its project is archived")
+	} else if mostRecentSchedule != nil {
 		if err := r.hibernateOrWakeUpShootBasedOnSchedule(ctx, shoot, mostRecentSchedule, now); err != nil {
 			return reconcile.Result{}, err
 		}
 		log.Info("Successfully set hibernation.enabled", "enabled", *shoot.Spec.Hibernation.Enabled)
+	} else if hibernateAt := idleHibernationTime(shoot, project); hibernateAt != nil && !now.Before(*hibernateAt) {
+		if err := r.hibernateIdleShoot(ctx, shoot, project, now); err != nil {
+			return reconcile.Result{}, err
+		}
+		log.Info("Successfully hibernated idle shoot", "lastActivityTime", shoot.Status.LastActivityTime)
+	}
+
+	requeueAfter := nextHibernationTimeDuration(parsedSchedules, idleHibernationTime(shoot, project), now)
+	if requeueAfter == 0 {
+		log.Info("Neither hibernation schedules nor automatic hibernation have any upcoming activation time, stopping reconciliation")
+		return reconcile.Result{}, nil
 	}
 
-	requeueAfter := nextHibernationTimeDuration(parsedSchedules, now)
 	log.Info("Requeuing shoot hibernation", "requeueAfter", requeueAfter)
 	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
 
+// getHibernationCalendars returns the hibernation calendars of the given Project which are referenced by the given
+// hibernation schedules. Calendars which do not exist are reported with an event and ignored.
+func (r *Reconciler) getHibernationCalendars(shoot *gardencorev1beta1.Shoot, project *gardencorev1beta1.Project, schedules []gardencorev1beta1.HibernationSchedule) map[string]gardencorev1beta1.HibernationCalendar {
+	names := sets.New[string]()
+	for _, schedule := range schedules {
+		names.Insert(schedule.SkipCalendars...)
+		names.Insert(schedule.HibernateCalendars...)
+	}
+
+	if names.Len() == 0 {
+		return nil
+	}
+
+	calendars := make(map[string]gardencorev1beta1.HibernationCalendar, names.Len())
+
+	if project != nil {
+		for _, calendar := range project.Spec.HibernationCalendars {
+			if names.Has(calendar.Name) {
+				calendars[calendar.Name] = calendar
+			}
+		}
+	}
+
+	for _, name := range sets.List(names) {
+		if _, ok := calendars[name]; !ok {
+			r.Recorder.Eventf(shoot, corev1.EventTypeWarning, EventHibernationCalendarNotFound, "Hibernation calendar %q referenced by hibernation schedules does not exist in project, ignoring it", name)
+		}
+	}
+
+	return calendars
+}
+
+// removeHibernationReasonIfAwake removes the annotation describing why the Shoot was hibernated automatically if the
+// Shoot is not supposed to be hibernated anymore.
+func (r *Reconciler) removeHibernationReasonIfAwake(ctx context.Context, shoot *gardencorev1beta1.Shoot) error {
+	if _, ok := shoot.Annotations[v1beta1constants.ShootHibernationReason]; !ok || v1beta1helper.HibernationIsEnabled(shoot) {
+		return nil
+	}
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	delete(shoot.Annotations, v1beta1constants.ShootHibernationReason)
+	return r.Client.Patch(ctx, shoot, patch)
+}
+
+// hibernateIdleShoot hibernates the given Shoot because no activity was observed within its idle timeout. The reason
+// is recorded in an event and an annotation on the Shoot.
+func (r *Reconciler) hibernateIdleShoot(ctx context.Context, shoot *gardencorev1beta1.Shoot, project *gardencorev1beta1.Project, now time.Time) error {
+	idleTimeout, _ := v1beta1helper.GetAutoHibernationIdleTimeout(shoot, project)
+	reason := fmt.Sprintf("No activity observed since %s (idle timeout %s)", shoot.Status.LastActivityTime.UTC().Format(time.RFC3339), idleTimeout)
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	if shoot.Spec.Hibernation == nil {
+		shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{}
+	}
+	shoot.Spec.Hibernation.Enabled = ptr.To(true)
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.ShootHibernationReason, reason)
+	r.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventHibernationEnabled, "Hibernating idle cluster: "+reason)
+	if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+		return err
+	}
+
+	patch = client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.LastHibernationTriggerTime = &metav1.Time{Time: now}
+	return r.Client.Status().Patch(ctx, shoot, patch)
+}
+
+// idleHibernationTime returns the point in time at which the given Shoot is hibernated automatically if no further
+// activity is observed. Nil is returned if the Shoot is not awake or automatic hibernation is not enabled for it.
+// The idle time is measured from the last activity, or from the last hibernation trigger if it is more recent, so
+// that a Shoot woken up by its hibernation schedules is not hibernated again right away. The stay-awake override is
+// respected as well.
+func idleHibernationTime(shoot *gardencorev1beta1.Shoot, project *gardencorev1beta1.Project) *time.Time {
+	idleTimeout, enabled := v1beta1helper.GetAutoHibernationIdleTimeout(shoot, project)
+	if !enabled || shoot.Status.LastActivityTime == nil || v1beta1helper.HibernationIsEnabled(shoot) || shoot.Status.IsHibernated {
+		return nil
+	}
+
+	idleSince := shoot.Status.LastActivityTime.Time
+	if lastTrigger := shoot.Status.LastHibernationTriggerTime; lastTrigger != nil && lastTrigger.After(idleSince) {
+		idleSince = lastTrigger.Time
+	}
+
+	hibernateAt := idleSince.Add(idleTimeout)
+	if stayAwakeUntil := getStayAwakeUntil(shoot.Spec.Hibernation); stayAwakeUntil != nil && stayAwakeUntil.After(hibernateAt) {
+		hibernateAt = stayAwakeUntil.Time
+	}
+
+	return &hibernateAt
+}
+
 func (r *Reconciler) hibernateOrWakeUpShootBasedOnSchedule(ctx context.Context, shoot *gardencorev1beta1.Shoot, schedule *parsedHibernationSchedule, now time.Time) error {
 	patch := client.MergeFrom(shoot.DeepCopy())
 	switch schedule.operation {
@@ -149,7 +409,10 @@ func (r *Reconciler) hibernateOrWakeUpShootBasedOnSchedule(ctx context.Context,
 
 // parseHibernationSchedules parses the given HibernationSchedules and returns an array of ParsedHibernationSchedules
 // If the Location of a HibernationSchedule is `nil`, it is defaulted to UTC.
-func parseHibernationSchedules(schedules []gardencorev1beta1.HibernationSchedule) ([]parsedHibernationSchedule, error) {
+// Start and end times falling on days of the referenced skip calendars are skipped. For the referenced hibernate
+// calendars, an additional schedule hibernating at the beginning of each calendar day is returned and end times
+// falling on these days are skipped. References to calendars which are not contained in the given map are ignored.
+func parseHibernationSchedules(schedules []gardencorev1beta1.HibernationSchedule, calendars map[string]gardencorev1beta1.HibernationCalendar) ([]parsedHibernationSchedule, error) {
 	var parsedHibernationSchedules []parsedHibernationSchedule
 
 	for _, schedule := range schedules {
@@ -163,13 +426,23 @@ func parseHibernationSchedules(schedules []gardencorev1beta1.HibernationSchedule
 			return nil, err
 		}
 
+		skipCalendar, err := newCalendarSchedule(referencedCalendars(calendars, schedule.SkipCalendars))
+		if err != nil {
+			return nil, err
+		}
+
+		hibernateCalendar, err := newCalendarSchedule(referencedCalendars(calendars, schedule.HibernateCalendars))
+		if err != nil {
+			return nil, err
+		}
+
 		if schedule.Start != nil {
 			parsed, err := cron.ParseStandard(*schedule.Start)
 			if err != nil {
 				return nil, err
 			}
 			parsedHibernationSchedules = append(parsedHibernationSchedules,
-				parsedHibernationSchedule{location: *location, schedule: parsed, operation: hibernate},
+				parsedHibernationSchedule{location: *location, schedule: skipDays(parsed, skipCalendar.ranges), operation: hibernate},
 			)
 		}
 
@@ -179,7 +452,13 @@ func parseHibernationSchedules(schedules []gardencorev1beta1.HibernationSchedule
 				return nil, err
 			}
 			parsedHibernationSchedules = append(parsedHibernationSchedules,
-				parsedHibernationSchedule{location: *location, schedule: parsed, operation: wakeUp},
+				parsedHibernationSchedule{location: *location, schedule: skipDays(parsed, skipCalendar.ranges, hibernateCalendar.ranges), operation: wakeUp},
+			)
+		}
+
+		if len(hibernateCalendar.ranges) > 0 {
+			parsedHibernationSchedules = append(parsedHibernationSchedules,
+				parsedHibernationSchedule{location: *location, schedule: hibernateCalendar, operation: hibernate},
 			)
 		}
 	}
@@ -187,14 +466,71 @@ func parseHibernationSchedules(schedules []gardencorev1beta1.HibernationSchedule
 	return parsedHibernationSchedules, nil
 }
 
-// nextHibernationTimeDuration returns the time duration after which to requeue the shoot based on the hibernation schedules and current time.
+func referencedCalendars(calendars map[string]gardencorev1beta1.HibernationCalendar, names []string) []gardencorev1beta1.HibernationCalendar {
+	var result []gardencorev1beta1.HibernationCalendar
+	for _, name := range names {
+		if calendar, ok := calendars[name]; ok {
+			result = append(result, calendar)
+		}
+	}
+	return result
+}
+
+func skipDays(schedule cron.Schedule, ranges ...[]dayRange) cron.Schedule {
+	skip := &calendarSchedule{}
+	for _, r := range ranges {
+		skip.ranges = append(skip.ranges, r...)
+	}
+
+	if len(skip.ranges) == 0 {
+		return schedule
+	}
+	return &skippingSchedule{schedule: schedule, skip: skip}
+}
+
+// applyStayAwakeOverride suppresses all hibernations of the given schedules before the given point in time. If the
+// Shoot would be hibernated according to the schedules at that point in time, an additional schedule hibernating it
+// exactly then is returned.
+func applyStayAwakeOverride(schedules []parsedHibernationSchedule, until time.Time) []parsedHibernationSchedule {
+	result := make([]parsedHibernationSchedule, 0, len(schedules)+1)
+	for _, schedule := range schedules {
+		if schedule.operation == hibernate {
+			schedule.schedule = &notBeforeSchedule{schedule: schedule.schedule, notBefore: until}
+		}
+		result = append(result, schedule)
+	}
+
+	if mostRecentSchedule := scheduleWithMostRecentTime(schedules, until.Add(-sevenDays), until); mostRecentSchedule != nil && mostRecentSchedule.operation == hibernate {
+		result = append(result, parsedHibernationSchedule{location: *time.UTC, schedule: onceSchedule(until), operation: hibernate})
+	}
+
+	return result
+}
+
+// nextHibernationTimeDuration returns the time duration after which to requeue the shoot based on the hibernation
+// schedules, the time at which the shoot is hibernated automatically (may be nil) and current time.
 // It adds a 100ms padding to the next requeue to account for Network Time Protocol(NTP) time skews.
 // If the time drifts are adjusted which in most realistic cases would be around 100ms, scheduled hibernation
 // will still be executed without missing the schedule.
-func nextHibernationTimeDuration(schedules []parsedHibernationSchedule, now time.Time) time.Duration {
-	timeStamps := make([]time.Time, 0, len(schedules))
+// Zero is returned if there is no upcoming activation time.
+func nextHibernationTimeDuration(schedules []parsedHibernationSchedule, idleHibernationTime *time.Time, now time.Time) time.Duration {
+	timeStamps := make([]time.Time, 0, len(schedules)+1)
 	for _, schedule := range schedules {
-		timeStamps = append(timeStamps, schedule.next(now))
+		if next := schedule.next(now); !next.IsZero() {
+			timeStamps = append(timeStamps, next)
+		}
+	}
+
+	if idleHibernationTime != nil {
+		if idleHibernationTime.Before(now) {
+			timeStamps = append(timeStamps, now)
+		} else {
+			timeStamps = append(timeStamps, *idleHibernationTime)
+		}
+	}
+
+	if len(timeStamps) == 0 {
+		return 0
 	}
 
 	slices.SortFunc(timeStamps, func(a, b time.Time) int {
@@ -224,11 +560,17 @@ func getScheduleWithMostRecentTime(schedules []parsedHibernationSchedule, trigge
 		earliestTime = sevenDaysAgo
 	}
 
+	return scheduleWithMostRecentTime(schedules, earliestTime, now)
+}
+
+// scheduleWithMostRecentTime returns the ParsedHibernationSchedule that contains the schedule with the most recent
+// execution time between `from` and `to`.
+func scheduleWithMostRecentTime(schedules []parsedHibernationSchedule, from, to time.Time) *parsedHibernationSchedule {
 	// Iterate over all schedules that were parsed from the shoot specification until we find one that contains
-	// a time entry between `earliestTime` and `now`` and that time entry is the latest one (most recent) with respect to `now`
+	// a time entry between `from` and `to` and that time entry is the latest one (most recent) with respect to `to`
 	var scheduleWithMostRecentTime *parsedHibernationSchedule
 	for i := range schedules {
-		cur := schedules[i].previous(earliestTime, now)
+		cur := schedules[i].previous(from, to)
 		if cur == nil {
 			continue
 		}
@@ -236,7 +578,7 @@ func getScheduleWithMostRecentTime(schedules []parsedHibernationSchedule, trigge
 			scheduleWithMostRecentTime = &schedules[i]
 			continue
 		}
-		mostRecentTime := scheduleWithMostRecentTime.previous(earliestTime, now)
+		mostRecentTime := scheduleWithMostRecentTime.previous(from, to)
 		if mostRecentTime == nil {
 			continue
 		}
@@ -254,3 +596,17 @@ func getShootHibernationSchedules(hibernation *gardencorev1beta1.Hibernation) []
 	}
 	return hibernation.Schedules
 }
+
+func getStayAwakeUntil(hibernation *gardencorev1beta1.Hibernation) *metav1.Time {
+	if hibernation == nil {
+		return nil
+	}
+	return hibernation.StayAwakeUntil
+}
+
+func getAutoHibernation(hibernation *gardencorev1beta1.Hibernation) *gardencorev1beta1.AutoHibernation {
+	if hibernation == nil {
+		return nil
+	}
+	return hibernation.AutoHibernation
+}
@@ -21,6 +21,8 @@ import (
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/gardener/gardener/pkg/api/indexer"
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
@@ -31,6 +33,7 @@ var _ = Describe("Shoot Hibernation", func() {
 		timeNow                 func() time.Time
 		triggerDeadlineDuration time.Duration
 		shootSettings           func(shoot *gardencorev1beta1.Shoot)
+		calendars               []gardencorev1beta1.HibernationCalendar
 
 		triggerHibernationOrWakeup  bool
 		expectedRequeueDurationFunc func(now time.Time) time.Duration
@@ -51,6 +54,11 @@ var _ = Describe("Shoot Hibernation", func() {
 		weekDayAt7  = "2022-04-12T07:00:00Z"
 		weekDayAt19 = "2022-04-12T19:00:00Z"
 
+		holidays = gardencorev1beta1.HibernationCalendar{
+			Name: "holidays",
+			Days: []gardencorev1beta1.HibernationCalendarDays{{Begin: "2022-04-12"}},
+		}
+
 		noDeadLine    = -1234 * time.Second
 		shortDeadline = 10 * time.Second
 		longDeadline  = 10 * time.Hour
@@ -87,6 +95,12 @@ var _ = Describe("Shoot Hibernation", func() {
 				return parsedTime.Add(offset)
 			}
 		}
+
+		requeueAfterUntil = func(t string) func(now time.Time) time.Duration {
+			return func(now time.Time) time.Duration {
+				return mustParseRFC3339Time(t).Add(nextScheduleDelta).Sub(now)
+			}
+		}
 	)
 
 	Context("parsedHibernationSchedule", func() {
@@ -132,6 +146,45 @@ var _ = Describe("Shoot Hibernation", func() {
 		})
 	})
 
+	Context("calendarSchedule", func() {
+		var schedule *calendarSchedule
+
+		BeforeEach(func() {
+			var err error
+			schedule, err = newCalendarSchedule([]gardencorev1beta1.HibernationCalendar{{
+				Name: "shutdown",
+				Days: []gardencorev1beta1.HibernationCalendarDays{{Begin: "2022-04-12"}, {Begin: "2022-04-14", End: ptr.To("2022-04-15")}},
+			}})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Describe("#Next", func() {
+			It("should return the beginning of the next calendar day", func() {
+				Expect(schedule.Next(mustParseRFC3339Time("2022-04-11T10:00:00Z"))).To(Equal(mustParseRFC3339Time("2022-04-12T00:00:00Z")))
+				Expect(schedule.Next(mustParseRFC3339Time("2022-04-12T00:00:00Z"))).To(Equal(mustParseRFC3339Time("2022-04-14T00:00:00Z")))
+				Expect(schedule.Next(mustParseRFC3339Time("2022-04-14T10:00:00Z"))).To(Equal(mustParseRFC3339Time("2022-04-15T00:00:00Z")))
+			})
+
+			It("should evaluate the days in the location of the given time", func() {
+				location := mustLoadLocation(locationEUBerlin)
+				Expect(schedule.Next(mustParseRFC3339Time("2022-04-11T10:00:00Z").In(&location)).UTC()).To(Equal(mustParseRFC3339Time("2022-04-11T22:00:00Z")))
+			})
+
+			It("should return the zero time if there is no upcoming calendar day", func() {
+				Expect(schedule.Next(mustParseRFC3339Time("2022-04-15T00:00:00Z"))).To(BeZero())
+			})
+		})
+
+		Describe("skippingSchedule#Next", func() {
+			It("should skip activation times on calendar days", func() {
+				skipping := &skippingSchedule{schedule: mustParseStandard(everyDayAt7), skip: schedule}
+
+				Expect(skipping.Next(mustParseRFC3339Time("2022-04-11T08:00:00Z"))).To(Equal(mustParseRFC3339Time("2022-04-13T07:00:00Z")))
+				Expect(skipping.Next(mustParseRFC3339Time("2022-04-13T08:00:00Z"))).To(Equal(mustParseRFC3339Time("2022-04-16T07:00:00Z")))
+			})
+		})
+	})
+
 	Context("Shoot hibernation reconciliation", func() {
 		Describe("#Reconcile", func() {
 			var (
@@ -139,6 +192,7 @@ var _ = Describe("Shoot Hibernation", func() {
 				c         client.Client
 				now       time.Time
 				fakeClock *testclock.FakeClock
+				recorder  *record.FakeRecorder
 
 				shoot *gardencorev1beta1.Shoot
 			)
@@ -157,7 +211,12 @@ var _ = Describe("Shoot Hibernation", func() {
 					Status: gardencorev1beta1.ShootStatus{},
 				}
 
-				c = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+				c = fakeclient.NewClientBuilder().
+					WithScheme(kubernetes.GardenScheme).
+					WithStatusSubresource(&gardencorev1beta1.Shoot{}).
+					WithIndex(&gardencorev1beta1.Project{}, gardencore.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+					Build()
+				recorder = record.NewFakeRecorder(2)
 			})
 
 			DescribeTable("should properly enable or disable hibernation and requeue the shoot", func(t testEntry) {
@@ -176,6 +235,17 @@ var _ = Describe("Shoot Hibernation", func() {
 				By("Create shoot")
 				Expect(c.Create(ctx, shoot)).To(Succeed())
 
+				if t.calendars != nil {
+					By("Create project")
+					Expect(c.Create(ctx, &gardencorev1beta1.Project{
+						ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+						Spec: gardencorev1beta1.ProjectSpec{
+							Namespace:            ptr.To(shoot.Namespace),
+							HibernationCalendars: t.calendars,
+						},
+					})).To(Succeed())
+				}
+
 				By("Configure hibernation reconciler")
 				config := controllermanagerconfigv1alpha1.ShootHibernationControllerConfiguration{}
 				if t.triggerDeadlineDuration != noDeadLine {
@@ -185,7 +255,7 @@ var _ = Describe("Shoot Hibernation", func() {
 				reconciler := &Reconciler{
 					Client:   c,
 					Config:   config,
-					Recorder: record.NewFakeRecorder(1),
+					Recorder: recorder,
 					Clock:    fakeClock,
 				}
 
@@ -400,6 +470,109 @@ var _ = Describe("Shoot Hibernation", func() {
 					triggerDeadlineDuration:     shortDeadline,
 					expectedRequeueDurationFunc: requeueAfterBasedOnSchedule(everyDayAt2, "UTC"),
 				}),
+				Entry("when the hibernation start and end times are on a day of a skip calendar", testEntry{
+					timeNow: timeWithOffset(weekDayAt7, 1*time.Second),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt7, -1*24*time.Hour)()}
+						shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{
+							Start:         &everyDayAt7,
+							End:           &everyDayAt2,
+							SkipCalendars: []string{holidays.Name},
+						}}
+					},
+					calendars:                   []gardencorev1beta1.HibernationCalendar{holidays},
+					triggerDeadlineDuration:     noDeadLine,
+					expectedRequeueDurationFunc: requeueAfterBasedOnSchedule(everyDayAt2, "UTC"),
+				}),
+				Entry("when a skip calendar does not exist", testEntry{
+					timeNow: timeWithOffset(weekDayAt7, 1*time.Second),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt7, -1*24*time.Hour)()}
+						shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{
+							Start:         &everyDayAt7,
+							End:           &everyDayAt2,
+							SkipCalendars: []string{"unknown"},
+						}}
+					},
+					calendars:                   []gardencorev1beta1.HibernationCalendar{holidays},
+					triggerDeadlineDuration:     noDeadLine,
+					triggerHibernationOrWakeup:  true,
+					expectedHibernationEnabled:  true,
+					expectedRequeueDurationFunc: requeueAfterBasedOnSchedule(everyDayAt2, "UTC"),
+				}),
+				Entry("when a day of a hibernate calendar begins", testEntry{
+					timeNow: timeWithOffset(weekDayAt0, 1*time.Second),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt0, -1*24*time.Hour)()}
+						shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{
+							End:                &everyWeekDayAt8,
+							HibernateCalendars: []string{holidays.Name},
+						}}
+					},
+					calendars:                   []gardencorev1beta1.HibernationCalendar{holidays},
+					triggerDeadlineDuration:     noDeadLine,
+					triggerHibernationOrWakeup:  true,
+					expectedHibernationEnabled:  true,
+					expectedRequeueDurationFunc: requeueAfterUntil("2022-04-13T08:00:00Z"),
+				}),
+				Entry("when the wakeup time is on a day of a hibernate calendar", testEntry{
+					timeNow: timeWithOffset(weekDayAt7, 1*time.Hour+1*time.Second),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt0, -1*24*time.Hour)()}
+						shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{
+							End:                &everyWeekDayAt8,
+							HibernateCalendars: []string{holidays.Name},
+						}}
+						shoot.Status.LastHibernationTriggerTime = &metav1.Time{Time: timeWithOffset(weekDayAt0, 0)()}
+					},
+					calendars:                   []gardencorev1beta1.HibernationCalendar{holidays},
+					triggerDeadlineDuration:     noDeadLine,
+					expectedRequeueDurationFunc: requeueAfterUntil("2022-04-13T08:00:00Z"),
+				}),
+				Entry("when the shoot is supposed to stay awake at hibernation start time", testEntry{
+					timeNow: timeWithOffset(weekDayAt7, 1*time.Second),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt7, -1*24*time.Hour)()}
+						shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{
+							Start: &everyDayAt7,
+							End:   &everyDayAt2,
+						}}
+						shoot.Spec.Hibernation.StayAwakeUntil = &metav1.Time{Time: timeWithOffset(weekDayAt7, 2*time.Hour)()}
+						shoot.Status.LastHibernationTriggerTime = &metav1.Time{Time: timeWithOffset(weekDayAt2, 0)()}
+					},
+					triggerDeadlineDuration:     noDeadLine,
+					expectedRequeueDurationFunc: requeueAfterUntil("2022-04-12T09:00:00Z"),
+				}),
+				Entry("when the stay awake override has passed and the shoot would be hibernated according to its schedules", testEntry{
+					timeNow: timeWithOffset(weekDayAt7, 2*time.Hour+1*time.Second),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt7, -1*24*time.Hour)()}
+						shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{
+							Start: &everyDayAt7,
+							End:   &everyDayAt2,
+						}}
+						shoot.Spec.Hibernation.StayAwakeUntil = &metav1.Time{Time: timeWithOffset(weekDayAt7, 2*time.Hour)()}
+						shoot.Status.LastHibernationTriggerTime = &metav1.Time{Time: timeWithOffset(weekDayAt2, 0)()}
+					},
+					triggerDeadlineDuration:     noDeadLine,
+					triggerHibernationOrWakeup:  true,
+					expectedHibernationEnabled:  true,
+					expectedRequeueDurationFunc: requeueAfterBasedOnSchedule(everyDayAt2, "UTC"),
+				}),
+				Entry("when the stay awake override has passed and the shoot would be awake according to its schedules", testEntry{
+					timeNow: timeWithOffset(weekDayAt2, 1*time.Hour+1*time.Second),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt7, -1*24*time.Hour)()}
+						shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{
+							Start: &everyDayAt7,
+							End:   &everyDayAt2,
+						}}
+						shoot.Spec.Hibernation.StayAwakeUntil = &metav1.Time{Time: timeWithOffset(weekDayAt2, 1*time.Hour)()}
+						shoot.Status.LastHibernationTriggerTime = &metav1.Time{Time: timeWithOffset(weekDayAt2, 0)()}
+					},
+					triggerDeadlineDuration:     noDeadLine,
+					expectedRequeueDurationFunc: requeueAfterBasedOnSchedule(everyDayAt7, "UTC"),
+				}),
 				Entry("when shoot is in failed state", testEntry{
 					timeNow: timeWithOffset(weekDayAt7, shortDeadline+1*time.Second),
 					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
@@ -417,6 +590,155 @@ var _ = Describe("Shoot Hibernation", func() {
 					expectedRequeueDurationFunc: requeueAfterBasedOnSchedule(everyDayAt2, "UTC"),
 				}),
 			)
+
+			It("should report hibernation calendars which do not exist", func() {
+				shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{
+					Start:              &everyDayAt7,
+					HibernateCalendars: []string{"unknown"},
+				}}
+				Expect(c.Create(ctx, shoot)).To(Succeed())
+
+				reconciler := &Reconciler{Client: c, Recorder: recorder, Clock: testclock.NewFakeClock(mustParseRFC3339Time(weekDayAt2))}
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(recorder.Events).To(Receive(Equal(`Warning HibernationCalendarNotFound Hibernation calendar "unknown" referenced by hibernation schedules does not exist in project, ignoring it`)))
+			})
+
+			It("should not wake up shoots of archived projects", func() {
+				timeNow := mustParseRFC3339Time(weekDayAt2).Add(time.Second)
+				shoot.CreationTimestamp = metav1.Time{Time: timeNow.Add(-24 * time.Hour)}
+				shoot.Spec.Hibernation.Enabled = ptr.To(true)
+				shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{Start: &everyDayAt7, End: &everyDayAt2}}
+				Expect(c.Create(ctx, shoot)).To(Succeed())
+				Expect(c.Create(ctx, &gardencorev1beta1.Project{
+					ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+					Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To(shoot.Namespace)},
+					Status:     gardencorev1beta1.ProjectStatus{ArchivedTimestamp: &metav1.Time{Time: timeNow.Add(-time.Hour)}},
+				})).To(Succeed())
+
+				reconciler := &Reconciler{Client: c, Recorder: recorder, Clock: testclock.NewFakeClock(timeNow)}
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+				Expect(shoot.Spec.Hibernation.Enabled).To(PointTo(BeTrue()))
+				Expect(shoot.Status.LastHibernationTriggerTime).To(BeNil())
+			})
+
+			Context("automatic hibernation", func() {
+				var (
+					reconciler *Reconciler
+					timeNow    time.Time
+				)
+
+				BeforeEach(func() {
+					timeNow = mustParseRFC3339Time(weekDayAt2)
+					reconciler = &Reconciler{Client: c, Recorder: recorder, Clock: testclock.NewFakeClock(timeNow)}
+
+					shoot.CreationTimestamp = metav1.Time{Time: timeNow.Add(-24 * time.Hour)}
+					shoot.Spec.Hibernation.AutoHibernation = &gardencorev1beta1.AutoHibernation{
+						Enabled:     true,
+						IdleTimeout: &metav1.Duration{Duration: time.Hour},
+					}
+				})
+
+				It("should hibernate the shoot if it has been idle for longer than the idle timeout", func() {
+					shoot.Status.LastActivityTime = &metav1.Time{Time: timeNow.Add(-time.Hour)}
+					Expect(c.Create(ctx, shoot)).To(Succeed())
+
+					result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(Equal(reconcile.Result{}))
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Spec.Hibernation.Enabled).To(PointTo(BeTrue()))
+					Expect(shoot.Status.LastHibernationTriggerTime.Time.UTC()).To(Equal(timeNow))
+					Expect(shoot.Annotations).To(HaveKeyWithValue("shoot.gardener.cloud/hibernation-reason", "No activity observed since 2022-04-12T01:00:00Z (idle timeout 1h0m0s)"))
+					Expect(recorder.Events).To(Receive(Equal("Normal Hibernated Hibernating idle cluster: No activity observed since 2022-04-12T01:00:00Z (idle timeout 1h0m0s)")))
+				})
+
+				It("should consider the automatic hibernation configuration of the project", func() {
+					shoot.Spec.Hibernation = nil
+					shoot.Status.LastActivityTime = &metav1.Time{Time: timeNow.Add(-5 * time.Hour)}
+					Expect(c.Create(ctx, shoot)).To(Succeed())
+					Expect(c.Create(ctx, &gardencorev1beta1.Project{
+						ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+						Spec: gardencorev1beta1.ProjectSpec{
+							Namespace:       ptr.To(shoot.Namespace),
+							AutoHibernation: &gardencorev1beta1.AutoHibernation{Enabled: true},
+						},
+					})).To(Succeed())
+
+					_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Spec.Hibernation.Enabled).To(PointTo(BeTrue()))
+					Expect(shoot.Annotations).To(HaveKeyWithValue("shoot.gardener.cloud/hibernation-reason", "No activity observed since 2022-04-11T21:00:00Z (idle timeout 4h0m0s)"))
+				})
+
+				It("should requeue the shoot until the idle timeout has passed", func() {
+					shoot.Status.LastActivityTime = &metav1.Time{Time: timeNow.Add(-20 * time.Minute)}
+					Expect(c.Create(ctx, shoot)).To(Succeed())
+
+					result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(Equal(reconcile.Result{RequeueAfter: 40*time.Minute + 100*time.Millisecond}))
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Spec.Hibernation.Enabled).To(BeNil())
+				})
+
+				It("should requeue the shoot for the earlier of the next schedule and the idle timeout", func() {
+					shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{Start: &everyDayAt2}}
+					shoot.Status.LastHibernationTriggerTime = &metav1.Time{Time: timeNow}
+					shoot.Status.LastActivityTime = &metav1.Time{Time: timeNow.Add(-50 * time.Minute)}
+					Expect(c.Create(ctx, shoot)).To(Succeed())
+
+					result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(Equal(reconcile.Result{RequeueAfter: time.Hour + 100*time.Millisecond}))
+				})
+
+				It("should not hibernate the shoot before the stay awake override has passed", func() {
+					shoot.Spec.Hibernation.StayAwakeUntil = &metav1.Time{Time: timeNow.Add(30 * time.Minute)}
+					shoot.Status.LastActivityTime = &metav1.Time{Time: timeNow.Add(-2 * time.Hour)}
+					Expect(c.Create(ctx, shoot)).To(Succeed())
+
+					result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(Equal(reconcile.Result{RequeueAfter: 30*time.Minute + 100*time.Millisecond}))
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Spec.Hibernation.Enabled).To(BeNil())
+				})
+
+				It("should not hibernate the shoot if automatic hibernation is disabled", func() {
+					shoot.Spec.Hibernation.AutoHibernation.Enabled = false
+					shoot.Status.LastActivityTime = &metav1.Time{Time: timeNow.Add(-2 * time.Hour)}
+					Expect(c.Create(ctx, shoot)).To(Succeed())
+
+					result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(Equal(reconcile.Result{}))
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Spec.Hibernation.Enabled).To(BeNil())
+				})
+
+				It("should remove the hibernation reason when the shoot is woken up", func() {
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/hibernation-reason", "idle")
+					shoot.Spec.Hibernation.Enabled = ptr.To(false)
+					Expect(c.Create(ctx, shoot)).To(Succeed())
+
+					_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Annotations).NotTo(HaveKey("shoot.gardener.cloud/hibernation-reason"))
+				})
+			})
 		})
 	})
 })
@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	"slices"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// HasVersionRollouts returns true if any Kubernetes or machine image version of the given CloudProfile is configured
+// with a staged rollout.
+func HasVersionRollouts(cloudProfile *gardencorev1beta1.CloudProfile) bool {
+	for _, version := range cloudProfile.Spec.Kubernetes.Versions {
+		if version.Rollout != nil {
+			return true
+		}
+	}
+
+	for _, image := range cloudProfile.Spec.MachineImages {
+		for _, version := range image.Versions {
+			if version.Rollout != nil {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// RestrictCloudProfileToRolloutWave returns a copy of the given CloudProfile which only contains the Kubernetes and
+// machine image versions offered to the rollout wave of the given Shoot. Versions currently used by the Shoot are
+// always kept. The given list of Shoots is used to determine whether the Shoots of earlier waves running a version
+// report healthy conditions.
+func RestrictCloudProfileToRolloutWave(cloudProfile *gardencorev1beta1.CloudProfile, shoot *gardencorev1beta1.Shoot, shoots []gardencorev1beta1.Shoot, now time.Time) *gardencorev1beta1.CloudProfile {
+	restricted := cloudProfile.DeepCopy()
+
+	restricted.Spec.Kubernetes.Versions = slices.DeleteFunc(restricted.Spec.Kubernetes.Versions, func(version gardencorev1beta1.ExpirableVersion) bool {
+		if version.Rollout == nil || usesKubernetesVersion(shoot, version.Version) {
+			return false
+		}
+
+		return !IsVersionOfferedToShoot(version.Rollout, shoot, filterShoots(shoots, func(s *gardencorev1beta1.Shoot) bool {
+			return usesKubernetesVersion(s, version.Version)
+		}), now)
+	})
+
+	for i, image := range restricted.Spec.MachineImages {
+		restricted.Spec.MachineImages[i].Versions = slices.DeleteFunc(image.Versions, func(version gardencorev1beta1.MachineImageVersion) bool {
+			if version.Rollout == nil || usesMachineImageVersion(shoot, image.Name, version.Version) {
+				return false
+			}
+
+			return !IsVersionOfferedToShoot(version.Rollout, shoot, filterShoots(shoots, func(s *gardencorev1beta1.Shoot) bool {
+				return usesMachineImageVersion(s, image.Name, version.Version)
+			}), now)
+		})
+	}
+
+	return restricted
+}
+
+// IsVersionOfferedToShoot returns true if a version with the given rollout configuration is offered to the rollout
+// wave of the given Shoot at the given point in time. A version is offered to the first wave once the rollout has
+// started. Each subsequent wave becomes eligible once its soak duration has passed after the previous wave became
+// eligible. Additionally, all given Shoots of earlier waves, i.e., those already running the version, must have
+// reported healthy conditions for at least the soak duration.
+func IsVersionOfferedToShoot(rollout *gardencorev1beta1.VersionRollout, shoot *gardencorev1beta1.Shoot, shootsRunningVersion []*gardencorev1beta1.Shoot, now time.Time) bool {
+	offeredAt := rollout.StartTime.Time
+	if now.Before(offeredAt) {
+		return false
+	}
+
+	wave := RolloutWaveIndex(rollout, shootPurpose(shoot))
+	for i := 1; i <= wave; i++ {
+		soakDuration := ptr.Deref(rollout.Waves[i].SoakDuration, metav1.Duration{}).Duration
+
+		offeredAt = offeredAt.Add(soakDuration)
+		if now.Before(offeredAt) {
+			return false
+		}
+
+		for _, s := range shootsRunningVersion {
+			if RolloutWaveIndex(rollout, shootPurpose(s)) < i && !isHealthySince(s, now.Add(-soakDuration)) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// RolloutWaveIndex returns the index of the rollout wave the given purpose belongs to. Purposes which are not part of
+// any wave belong to the last wave.
+func RolloutWaveIndex(rollout *gardencorev1beta1.VersionRollout, purpose gardencorev1beta1.ShootPurpose) int {
+	for i, wave := range rollout.Waves {
+		if slices.Contains(wave.Purposes, purpose) {
+			return i
+		}
+	}
+
+	return max(len(rollout.Waves)-1, 0)
+}
+
+// healthConditionTypes are the condition types of a Shoot which are considered when checking whether it is healthy.
+var healthConditionTypes = []gardencorev1beta1.ConditionType{
+	gardencorev1beta1.ShootAPIServerAvailable,
+	gardencorev1beta1.ShootControlPlaneHealthy,
+	gardencorev1beta1.ShootObservabilityComponentsHealthy,
+	gardencorev1beta1.ShootEveryNodeReady,
+	gardencorev1beta1.ShootSystemComponentsHealthy,
+}
+
+// isHealthySince returns true if the last operation of the given Shoot did not fail and all its health conditions have
+// been true at least since the given point in time. Other conditions, e.g., constraints, are not considered. Shoots
+// which do not report any health conditions yet are considered healthy.
+func isHealthySince(shoot *gardencorev1beta1.Shoot, since time.Time) bool {
+	if shoot.Status.LastOperation != nil && shoot.Status.LastOperation.State == gardencorev1beta1.LastOperationStateFailed {
+		return false
+	}
+
+	for _, condition := range shoot.Status.Conditions {
+		if !slices.Contains(healthConditionTypes, condition.Type) {
+			continue
+		}
+
+		if condition.Status != gardencorev1beta1.ConditionTrue || condition.LastTransitionTime.After(since) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func shootPurpose(shoot *gardencorev1beta1.Shoot) gardencorev1beta1.ShootPurpose {
+	return ptr.Deref(shoot.Spec.Purpose, gardencorev1beta1.ShootPurposeEvaluation)
+}
+
+func usesKubernetesVersion(shoot *gardencorev1beta1.Shoot, version string) bool {
+	if shoot.Spec.Kubernetes.Version == version {
+		return true
+	}
+
+	return slices.ContainsFunc(shoot.Spec.Provider.Workers, func(worker gardencorev1beta1.Worker) bool {
+		return worker.Kubernetes != nil && ptr.Deref(worker.Kubernetes.Version, "") == version
+	})
+}
+
+func usesMachineImageVersion(shoot *gardencorev1beta1.Shoot, imageName, version string) bool {
+	return slices.ContainsFunc(shoot.Spec.Provider.Workers, func(worker gardencorev1beta1.Worker) bool {
+		return worker.Machine.Image != nil && worker.Machine.Image.Name == imageName && ptr.Deref(worker.Machine.Image.Version, "") == version
+	})
+}
+
+func filterShoots(shoots []gardencorev1beta1.Shoot, predicate func(*gardencorev1beta1.Shoot) bool) []*gardencorev1beta1.Shoot {
+	var filtered []*gardencorev1beta1.Shoot
+	for i := range shoots {
+		if predicate(&shoots[i]) {
+			filtered = append(filtered, &shoots[i])
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/maintenance/helper"
+)
+
+var _ = Describe("Rollout", func() {
+	var (
+		now     time.Time
+		rollout *gardencorev1beta1.VersionRollout
+
+		newShoot func(purpose gardencorev1beta1.ShootPurpose, kubernetesVersion string) *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		now = time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+		rollout = &gardencorev1beta1.VersionRollout{
+			StartTime: metav1.NewTime(now.Add(-96 * time.Hour)),
+			Waves: []gardencorev1beta1.RolloutWave{
+				{Purposes: []gardencorev1beta1.ShootPurpose{gardencorev1beta1.ShootPurposeEvaluation}},
+				{Purposes: []gardencorev1beta1.ShootPurpose{gardencorev1beta1.ShootPurposeDevelopment}, SoakDuration: &metav1.Duration{Duration: 72 * time.Hour}},
+				{Purposes: []gardencorev1beta1.ShootPurpose{gardencorev1beta1.ShootPurposeProduction}, SoakDuration: &metav1.Duration{Duration: 72 * time.Hour}},
+			},
+		}
+
+		newShoot = func(purpose gardencorev1beta1.ShootPurpose, kubernetesVersion string) *gardencorev1beta1.Shoot {
+			return &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Purpose:    ptr.To(purpose),
+					Kubernetes: gardencorev1beta1.Kubernetes{Version: kubernetesVersion},
+					Provider: gardencorev1beta1.Provider{
+						Workers: []gardencorev1beta1.Worker{{
+							Name:    "worker",
+							Machine: gardencorev1beta1.Machine{Image: &gardencorev1beta1.ShootMachineImage{Name: "image", Version: ptr.To("1.0.0")}},
+						}},
+					},
+				},
+				Status: gardencorev1beta1.ShootStatus{
+					Conditions: []gardencorev1beta1.Condition{{
+						Type:               gardencorev1beta1.ShootControlPlaneHealthy,
+						Status:             gardencorev1beta1.ConditionTrue,
+						LastTransitionTime: metav1.NewTime(now.Add(-240 * time.Hour)),
+					}},
+				},
+			}
+		}
+	})
+
+	DescribeTable("#RolloutWaveIndex",
+		func(purpose gardencorev1beta1.ShootPurpose, expected int) {
+			Expect(RolloutWaveIndex(rollout, purpose)).To(Equal(expected))
+		},
+
+		Entry("first wave", gardencorev1beta1.ShootPurposeEvaluation, 0),
+		Entry("last wave", gardencorev1beta1.ShootPurposeProduction, 2),
+		Entry("purpose not part of any wave", gardencorev1beta1.ShootPurposeTesting, 2),
+	)
+
+	Describe("#IsVersionOfferedToShoot", func() {
+		It("should not offer the version before the rollout has started", func() {
+			rollout.StartTime = metav1.NewTime(now.Add(time.Hour))
+
+			Expect(IsVersionOfferedToShoot(rollout, newShoot(gardencorev1beta1.ShootPurposeEvaluation, "1.0.0"), nil, now)).To(BeFalse())
+		})
+
+		It("should offer the version to the first wave once the rollout has started", func() {
+			Expect(IsVersionOfferedToShoot(rollout, newShoot(gardencorev1beta1.ShootPurposeEvaluation, "1.0.0"), nil, now)).To(BeTrue())
+		})
+
+		It("should offer the version to the second wave after the soak duration if earlier waves are healthy", func() {
+			running := []*gardencorev1beta1.Shoot{newShoot(gardencorev1beta1.ShootPurposeEvaluation, "1.0.1")}
+
+			Expect(IsVersionOfferedToShoot(rollout, newShoot(gardencorev1beta1.ShootPurposeDevelopment, "1.0.0"), running, now)).To(BeTrue())
+		})
+
+		It("should not offer the version to the last wave before the accumulated soak duration has passed", func() {
+			Expect(IsVersionOfferedToShoot(rollout, newShoot(gardencorev1beta1.ShootPurposeProduction, "1.0.0"), nil, now)).To(BeFalse())
+		})
+
+		It("should not offer the version to a later wave if a shoot of an earlier wave is unhealthy", func() {
+			unhealthy := newShoot(gardencorev1beta1.ShootPurposeEvaluation, "1.0.1")
+			unhealthy.Status.Conditions[0].Status = gardencorev1beta1.ConditionFalse
+
+			Expect(IsVersionOfferedToShoot(rollout, newShoot(gardencorev1beta1.ShootPurposeDevelopment, "1.0.0"), []*gardencorev1beta1.Shoot{unhealthy}, now)).To(BeFalse())
+		})
+
+		It("should only consider the health conditions of shoots of an earlier wave", func() {
+			constrained := newShoot(gardencorev1beta1.ShootPurposeEvaluation, "1.0.1")
+			constrained.Status.Conditions = append(constrained.Status.Conditions, gardencorev1beta1.Condition{
+				Type:               gardencorev1beta1.ShootHibernationPossible,
+				Status:             gardencorev1beta1.ConditionFalse,
+				LastTransitionTime: metav1.NewTime(now.Add(-time.Minute)),
+			})
+
+			Expect(IsVersionOfferedToShoot(rollout, newShoot(gardencorev1beta1.ShootPurposeDevelopment, "1.0.0"), []*gardencorev1beta1.Shoot{constrained}, now)).To(BeTrue())
+		})
+
+		It("should not offer the version to a later wave if a shoot of an earlier wave has not been healthy for the soak duration", func() {
+			recovered := newShoot(gardencorev1beta1.ShootPurposeEvaluation, "1.0.1")
+			recovered.Status.Conditions[0].LastTransitionTime = metav1.NewTime(now.Add(-time.Hour))
+
+			Expect(IsVersionOfferedToShoot(rollout, newShoot(gardencorev1beta1.ShootPurposeDevelopment, "1.0.0"), []*gardencorev1beta1.Shoot{recovered}, now)).To(BeFalse())
+		})
+
+		It("should not offer the version to a later wave if the last operation of a shoot of an earlier wave failed", func() {
+			failed := newShoot(gardencorev1beta1.ShootPurposeEvaluation, "1.0.1")
+			failed.Status.LastOperation = &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateFailed}
+
+			Expect(IsVersionOfferedToShoot(rollout, newShoot(gardencorev1beta1.ShootPurposeDevelopment, "1.0.0"), []*gardencorev1beta1.Shoot{failed}, now)).To(BeFalse())
+		})
+
+		It("should ignore unhealthy shoots of the same or later waves", func() {
+			unhealthy := newShoot(gardencorev1beta1.ShootPurposeDevelopment, "1.0.1")
+			unhealthy.Status.Conditions[0].Status = gardencorev1beta1.ConditionFalse
+
+			Expect(IsVersionOfferedToShoot(rollout, newShoot(gardencorev1beta1.ShootPurposeDevelopment, "1.0.0"), []*gardencorev1beta1.Shoot{unhealthy}, now)).To(BeTrue())
+		})
+	})
+
+	Describe("#RestrictCloudProfileToRolloutWave", func() {
+		var cloudProfile *gardencorev1beta1.CloudProfile
+
+		BeforeEach(func() {
+			cloudProfile = &gardencorev1beta1.CloudProfile{
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					Kubernetes: gardencorev1beta1.KubernetesSettings{
+						Versions: []gardencorev1beta1.ExpirableVersion{
+							{Version: "1.0.1", Rollout: rollout},
+							{Version: "1.0.0", Rollout: rollout},
+						},
+					},
+					MachineImages: []gardencorev1beta1.MachineImage{{
+						Name: "image",
+						Versions: []gardencorev1beta1.MachineImageVersion{
+							{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.1", Rollout: rollout}},
+							{ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0"}},
+						},
+					}},
+				},
+			}
+		})
+
+		It("should report whether the cloud profile configures rollouts", func() {
+			Expect(HasVersionRollouts(cloudProfile)).To(BeTrue())
+			Expect(HasVersionRollouts(&gardencorev1beta1.CloudProfile{})).To(BeFalse())
+		})
+
+		It("should remove versions which are not offered to the shoot but keep the versions in use", func() {
+			shoot := newShoot(gardencorev1beta1.ShootPurposeProduction, "1.0.0")
+
+			restricted := RestrictCloudProfileToRolloutWave(cloudProfile, shoot, nil, now)
+			Expect(restricted.Spec.Kubernetes.Versions).To(ConsistOf(HaveField("Version", "1.0.0")))
+			Expect(restricted.Spec.MachineImages[0].Versions).To(ConsistOf(HaveField("Version", "1.0.0")))
+			Expect(cloudProfile.Spec.Kubernetes.Versions).To(HaveLen(2))
+		})
+
+		It("should keep versions which are offered to the shoot", func() {
+			shoot := newShoot(gardencorev1beta1.ShootPurposeDevelopment, "1.0.0")
+			shoots := []gardencorev1beta1.Shoot{*newShoot(gardencorev1beta1.ShootPurposeEvaluation, "1.0.1")}
+
+			restricted := RestrictCloudProfileToRolloutWave(cloudProfile, shoot, shoots, now)
+			Expect(restricted.Spec.Kubernetes.Versions).To(HaveLen(2))
+			Expect(restricted.Spec.MachineImages[0].Versions).To(HaveLen(2))
+		})
+	})
+})
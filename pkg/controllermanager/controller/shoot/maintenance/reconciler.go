@@ -7,6 +7,7 @@ package maintenance
 import (
 	"context"
 	"fmt"
+	"maps"
 	"slices"
 	"strconv"
 	"strings"
@@ -15,6 +16,7 @@ import (
 	"github.com/Masterminds/semver/v3"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
@@ -24,6 +26,7 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
@@ -67,16 +70,95 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	if !mustMaintainNow(shoot, r.Clock) {
 		log.V(1).Info("Skipping Shoot because it doesn't need to be maintained now")
+
+		// Failures to publish the preview must not block the maintenance of the Shoot, hence they are only logged.
+		if err := r.updateUpcomingMaintenance(ctx, shoot); err != nil {
+			log.Error(err, "Failed updating upcoming maintenance of Shoot")
+		}
+
 		log.V(1).Info("Scheduled next maintenance for Shoot", "duration", requeueAfter.Round(time.Minute), "nextMaintenance", nextMaintenance.Round(time.Minute))
-		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+		return reconcile.Result{RequeueAfter: r.upcomingMaintenanceRequeueAfter(shoot, requeueAfter)}, nil
 	}
 
 	if err := r.reconcile(ctx, log, shoot); err != nil {
 		return reconcile.Result{}, err
 	}
 
+	// The maintenance was already performed, so a failure to publish the preview must not lead to a retry.
+	if err := r.updateUpcomingMaintenance(ctx, shoot); err != nil {
+		log.Error(err, "Failed updating upcoming maintenance of Shoot")
+	}
+
 	log.V(1).Info("Scheduled next maintenance for Shoot", "duration", requeueAfter.Round(time.Minute), "nextMaintenance", nextMaintenance.Round(time.Minute))
-	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	return reconcile.Result{RequeueAfter: r.upcomingMaintenanceRequeueAfter(shoot, requeueAfter)}, nil
+}
+
+// upcomingMaintenanceRequeueAfter returns the duration after which the given Shoot must be reconciled again to refresh
+// the preview of its upcoming maintenance. The given duration until the next maintenance is returned if the refresh
+// would happen within the maintenance time window, so that the maintenance is not performed earlier than scheduled.
+func (r *Reconciler) upcomingMaintenanceRequeueAfter(shoot *gardencorev1beta1.Shoot, requeueAfter time.Duration) time.Duration {
+	syncPeriod := ptr.Deref(r.Config.UpcomingMaintenanceSyncPeriod, metav1.Duration{}).Duration
+	if syncPeriod <= 0 || syncPeriod >= requeueAfter {
+		return requeueAfter
+	}
+
+	var (
+		now    = r.Clock.Now()
+		window = gardenerutils.EffectiveShootMaintenanceTimeWindow(shoot)
+		next   = now.Add(syncPeriod)
+	)
+
+	if window.Contains(next) || !next.Before(window.NextBegin(now)) {
+		return requeueAfter
+	}
+
+	return syncPeriod
+}
+
+// updateUpcomingMaintenance computes the preview of the operations which are expected to be performed in the next
+// maintenance time window of the given Shoot and publishes it in the Shoot status if it has changed.
+func (r *Reconciler) updateUpcomingMaintenance(ctx context.Context, shoot *gardencorev1beta1.Shoot) error {
+	windowStart := gardenerutils.EffectiveShootMaintenanceTimeWindow(shoot).NextBegin(r.Clock.Now())
+
+	upcomingMaintenance, err := r.computeUpcomingMaintenance(ctx, shoot, windowStart)
+	if err != nil {
+		return fmt.Errorf("failed computing upcoming maintenance: %w", err)
+	}
+
+	if shoot.Status.UpcomingMaintenance != nil {
+		upcomingMaintenance.LastUpdateTime = shoot.Status.UpcomingMaintenance.LastUpdateTime
+		if apiequality.Semantic.DeepEqual(shoot.Status.UpcomingMaintenance, upcomingMaintenance) {
+			return nil
+		}
+	}
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	upcomingMaintenance.LastUpdateTime = metav1.Time{Time: r.Clock.Now()}
+	shoot.Status.UpcomingMaintenance = upcomingMaintenance
+
+	return r.Client.Status().Patch(ctx, shoot, patch)
+}
+
+// computeUpcomingMaintenance computes the preview of the operations which are expected to be performed for the given
+// Shoot in the maintenance time window beginning at the given point in time. The Shoot is not modified.
+func (r *Reconciler) computeUpcomingMaintenance(ctx context.Context, shoot *gardencorev1beta1.Shoot, windowStart time.Time) (*gardencorev1beta1.UpcomingMaintenance, error) {
+	previewShoot := shoot.DeepCopy()
+
+	updates, err := r.computeMaintenanceUpdates(ctx, logr.Discard(), shoot, previewShoot, windowStart)
+	if err != nil {
+		return nil, err
+	}
+
+	operations, failedOperations := buildUpcomingMaintenanceMessages(updates)
+	operations = append(operations, maintainFeatureGatesForShoot(previewShoot)...)
+	operations = append(operations, maintainAdmissionPluginsForShoot(previewShoot)...)
+
+	return &gardencorev1beta1.UpcomingMaintenance{
+		WindowStart:      metav1.Time{Time: windowStart},
+		Operations:       operations,
+		FailedOperations: failedOperations,
+		Deferral:         updates.deferral,
+	}, nil
 }
 
 func requeueAfterDuration(shoot *gardencorev1beta1.Shoot) (time.Duration, time.Time) {
@@ -109,32 +191,18 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		err        error
 	)
 
-	workerToKubernetesUpdate := make(map[string]updateResult)
-	workerToMachineImageUpdate := make(map[string]updateResult)
-
-	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
+	updates, err := r.computeMaintenanceUpdates(ctx, log, shoot, maintainedShoot, r.Clock.Now())
 	if err != nil {
 		return err
 	}
 
-	if !v1beta1helper.IsWorkerless(shoot) {
-		workerToMachineImageUpdate, err = maintainMachineImages(log, maintainedShoot, cloudProfile)
-		if err != nil {
-			// continue execution to allow the kubernetes version update
-			log.Error(err, "Failed to maintain Shoot machine images")
-		}
-	}
-
-	kubernetesControlPlaneUpdate, err := maintainKubernetesVersion(log, maintainedShoot.Spec.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
-		maintainedShoot.Spec.Kubernetes.Version = v
-		return v, nil
-	})
-	if err != nil {
-		// continue execution to allow the machine image version update and Kubernetes updates to worker pools
-		log.Error(err, "Failed to maintain Shoot kubernetes version")
-	}
-
-	credentialsToRotationUpdate := computeCredentialsToRotationResults(log, maintainedShoot, metav1.Time{Time: r.Clock.Now()})
+	var (
+		kubernetesControlPlaneUpdate = updates.kubernetesControlPlane
+		workerToKubernetesUpdate     = updates.workerToKubernetes
+		workerToMachineImageUpdate   = updates.workerToMachineImage
+		credentialsToRotationUpdate  = updates.credentialsToRotation
+		deferral                     = updates.deferral
+	)
 
 	oldShootKubernetesVersion, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
 	if err != nil {
@@ -236,41 +304,6 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		}
 	}
 
-	// Now it's time to update worker pool kubernetes version if specified
-	for i, pool := range maintainedShoot.Spec.Provider.Workers {
-		if pool.Kubernetes == nil || pool.Kubernetes.Version == nil {
-			continue
-		}
-
-		workerLog := log.WithValues("worker", pool.Name)
-		workerKubernetesUpdate, err := maintainKubernetesVersion(workerLog, *pool.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
-			workerPoolSemver, err := semver.NewVersion(v)
-			if err != nil {
-				return "", err
-			}
-			// If during autoupdate a worker pool kubernetes gets forcefully updated to the next minor which might be higher than the same minor of the shoot, take this
-			if workerPoolSemver.GreaterThan(shootKubernetesVersion) {
-				workerPoolSemver = shootKubernetesVersion
-			}
-			v = workerPoolSemver.String()
-			maintainedShoot.Spec.Provider.Workers[i].Kubernetes.Version = &v
-			return v, nil
-		})
-		if err != nil {
-			// continue execution to allow other maintenance activities to continue
-			workerLog.Error(err, "Could not maintain Kubernetes version for worker pool")
-		}
-
-		if workerKubernetesUpdate != nil {
-			result := updateResult{
-				reason: workerKubernetesUpdate.reason,
-			}
-			result.isSuccessful = workerKubernetesUpdate.isSuccessful
-			result.description = workerKubernetesUpdate.description
-			workerToKubernetesUpdate[pool.Name] = result
-		}
-	}
-
 	if reasons := maintainFeatureGatesForShoot(maintainedShoot); len(reasons) > 0 {
 		operations = append(operations, reasons...)
 	}
@@ -317,7 +350,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		operations = append(operations, fmt.Sprintf("Added %q operation annotation", operation))
 	}
 
-	requirePatch := len(operations) > 0 || kubernetesControlPlaneUpdate != nil || len(workerToKubernetesUpdate) > 0 || len(workerToMachineImageUpdate) > 0 || len(credentialsToRotationUpdate) > 0
+	requirePatch := len(operations) > 0 || kubernetesControlPlaneUpdate != nil || len(workerToKubernetesUpdate) > 0 || len(workerToMachineImageUpdate) > 0 || len(credentialsToRotationUpdate) > 0 || deferral != nil
 	if requirePatch {
 		patch := client.MergeFrom(shoot.DeepCopy())
 
@@ -334,10 +367,15 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 			description = fmt.Sprintf("%s, %s", description, strings.Join(operations, ", "))
 		}
 
+		if deferral != nil {
+			description = fmt.Sprintf("%s, %d maintenance operation(s) deferred until %s due to maintenance freeze %q", description, len(deferral.Operations), deferral.Until.UTC().Format(time.RFC3339), deferral.Freeze)
+		}
+
 		shoot.Status.LastMaintenance = &gardencorev1beta1.LastMaintenance{
 			Description:   description,
 			TriggeredTime: metav1.Time{Time: r.Clock.Now()},
 			State:         gardencorev1beta1.LastOperationStateProcessing,
+			Deferral:      deferral,
 		}
 
 		// if any maintenance operation failed, set the status to 'Failed' and retry in the next maintenance cycle
@@ -402,6 +440,17 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		}
 	}
 
+	// if the maintenance patch is not required, a previously reported deferral is outdated, i.e., the maintenance
+	// freeze is over and the deferred operations have been performed in the meantime or are not necessary anymore.
+	if !requirePatch && shoot.Status.LastMaintenance != nil && shoot.Status.LastMaintenance.Deferral != nil {
+		patch := client.MergeFrom(shoot.DeepCopy())
+		shoot.Status.LastMaintenance.Deferral = nil
+
+		if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
+			return err
+		}
+	}
+
 	if shoot.Status.LastMaintenance != nil && shoot.Status.LastMaintenance.State == gardencorev1beta1.LastOperationStateProcessing {
 		patch := client.MergeFrom(shoot.DeepCopy())
 		shoot.Status.LastMaintenance.State = gardencorev1beta1.LastOperationStateSucceeded
@@ -427,6 +476,101 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 	return nil
 }
 
+// maintenanceUpdates contains the results of the version updates and credentials rotations computed for a Shoot.
+type maintenanceUpdates struct {
+	kubernetesControlPlane *updateResult
+	workerToKubernetes     map[string]updateResult
+	workerToMachineImage   map[string]updateResult
+	credentialsToRotation  map[string]updateResult
+	deferral               *gardencorev1beta1.MaintenanceDeferral
+}
+
+// computeMaintenanceUpdates updates the Kubernetes and machine image versions of the given maintained Shoot and computes
+// the credentials rotations for a maintenance performed at the given point in time. Rollout waves and maintenance
+// freezes are taken into account.
+func (r *Reconciler) computeMaintenanceUpdates(ctx context.Context, log logr.Logger, shoot, maintainedShoot *gardencorev1beta1.Shoot, now time.Time) (*maintenanceUpdates, error) {
+	updates := &maintenanceUpdates{
+		workerToKubernetes:   make(map[string]updateResult),
+		workerToMachineImage: make(map[string]updateResult),
+	}
+
+	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	cloudProfile, err = r.restrictCloudProfileToRolloutWave(ctx, shoot, cloudProfile, now)
+	if err != nil {
+		return nil, err
+	}
+
+	freeze, freezeUntil, err := r.getActiveMaintenanceFreeze(ctx, shoot, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		autoUpdateKubernetesVersion   = maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion
+		autoUpdateMachineImageVersion = ptr.Deref(maintainedShoot.Spec.Maintenance.AutoUpdate.MachineImageVersion, false)
+		maintainVersions              = true
+	)
+
+	// During a maintenance freeze, automatic version updates are deferred and only forced updates of expired versions are
+	// performed, unless the freeze holds them as well.
+	if freeze != nil {
+		log = log.WithValues("maintenanceFreeze", freeze.Name, "until", freezeUntil)
+		log.Info("Maintenance freeze is active, deferring version updates and credentials rotations")
+
+		autoUpdateKubernetesVersion, autoUpdateMachineImageVersion = false, false
+		maintainVersions = !ptr.Deref(freeze.HoldForcedUpdates, false)
+	}
+
+	if maintainVersions && !v1beta1helper.IsWorkerless(shoot) {
+		updates.workerToMachineImage, err = maintainMachineImages(log, maintainedShoot, cloudProfile, autoUpdateMachineImageVersion)
+		if err != nil {
+			// continue execution to allow the kubernetes version update
+			log.Error(err, "Failed to maintain Shoot machine images")
+		}
+	}
+
+	if maintainVersions {
+		updates.kubernetesControlPlane, err = maintainKubernetesVersion(log, maintainedShoot.Spec.Kubernetes.Version, autoUpdateKubernetesVersion, cloudProfile, func(v string) (string, error) {
+			maintainedShoot.Spec.Kubernetes.Version = v
+			return v, nil
+		})
+		if err != nil {
+			// continue execution to allow the machine image version update and Kubernetes updates to worker pools
+			log.Error(err, "Failed to maintain Shoot kubernetes version")
+		}
+	}
+
+	updates.credentialsToRotation = computeCredentialsToRotationResults(log, maintainedShoot, metav1.Time{Time: now})
+
+	// Now it's time to update worker pool kubernetes version if specified
+	if maintainVersions {
+		shootKubernetesVersion, err := semver.NewVersion(maintainedShoot.Spec.Kubernetes.Version)
+		if err != nil {
+			return nil, err
+		}
+
+		updates.workerToKubernetes = maintainWorkerPoolKubernetesVersions(log, maintainedShoot, autoUpdateKubernetesVersion, cloudProfile, shootKubernetesVersion)
+	}
+
+	if freeze != nil {
+		if deferredOperations := computeDeferredOperations(shoot, maintainedShoot, cloudProfile, updates.credentialsToRotation); len(deferredOperations) > 0 {
+			updates.deferral = &gardencorev1beta1.MaintenanceDeferral{
+				Freeze:     freeze.Name,
+				Until:      metav1.Time{Time: freezeUntil},
+				Operations: deferredOperations,
+			}
+		}
+		// credentials rotations are not started during a maintenance freeze
+		updates.credentialsToRotation = make(map[string]updateResult)
+	}
+
+	return updates, nil
+}
+
 // buildMaintenanceMessages builds a combined message containing the performed maintenance operations over all worker pools. If the maintenance operation failed, the description
 // contains an indication for the failure and the reason the update was triggered. Details for failed maintenance operations are returned in the second return string.
 func buildMaintenanceMessages(kubernetesControlPlaneUpdate *updateResult, workerToKubernetesUpdate, workerToMachineImageUpdate, credentialsToRotationUpdate map[string]updateResult) (string, string) {
@@ -492,6 +636,46 @@ func buildMaintenanceMessages(kubernetesControlPlaneUpdate *updateResult, worker
 	return fmt.Sprintf("(%d/%d) maintenance operations successful. %s", countSuccessfulOperations, countSuccessfulOperations+countFailedOperations, description), failureReason
 }
 
+// buildUpcomingMaintenanceMessages builds one message per expected maintenance operation. Messages of operations which
+// are expected to fail are returned separately. The messages are sorted to keep the published preview stable.
+func buildUpcomingMaintenanceMessages(updates *maintenanceUpdates) ([]string, []string) {
+	var operations, failedOperations []string
+
+	if result := updates.kubernetesControlPlane; result != nil {
+		if result.isSuccessful {
+			operations = append(operations, fmt.Sprintf("Control Plane: %s. Reason: %s", result.description, result.reason))
+		} else {
+			failedOperations = append(failedOperations, fmt.Sprintf("Control Plane: Kubernetes version update will fail: %s. Reason for update: %s", result.description, result.reason))
+		}
+	}
+
+	for _, worker := range slices.Sorted(maps.Keys(updates.workerToKubernetes)) {
+		if result := updates.workerToKubernetes[worker]; result.isSuccessful {
+			operations = append(operations, fmt.Sprintf("Worker pool %q: %s. Reason: %s", worker, result.description, result.reason))
+		} else {
+			failedOperations = append(failedOperations, fmt.Sprintf("Worker pool %q: Kubernetes version update will fail: %s. Reason for update: %s", worker, result.description, result.reason))
+		}
+	}
+
+	for _, worker := range slices.Sorted(maps.Keys(updates.workerToMachineImage)) {
+		if result := updates.workerToMachineImage[worker]; result.isSuccessful {
+			operations = append(operations, fmt.Sprintf("Worker pool %q: %s. Reason: %s", worker, result.description, result.reason))
+		} else {
+			failedOperations = append(failedOperations, fmt.Sprintf("Worker pool %q: Machine image version update will fail: %s. Reason for update: %s", worker, result.description, result.reason))
+		}
+	}
+
+	for _, credentials := range slices.Sorted(maps.Keys(updates.credentialsToRotation)) {
+		if result := updates.credentialsToRotation[credentials]; result.isSuccessful {
+			operations = append(operations, fmt.Sprintf("Credentials %q: %s. Reason: %s", credentials, result.description, result.reason))
+		} else {
+			failedOperations = append(failedOperations, fmt.Sprintf("Credentials %q: Automatic rotation will fail: %s. Reason for update: %s", credentials, result.description, result.reason))
+		}
+	}
+
+	return operations, failedOperations
+}
+
 // recordMaintenanceEventsForPool records dedicated events for each failed/succeeded maintenance operation per pool
 func (r *Reconciler) recordMaintenanceEventsForPool(workerToUpdateResult map[string]updateResult, shoot *gardencorev1beta1.Shoot, eventType string, maintenanceType string) {
 	for worker, reason := range workerToUpdateResult {
@@ -553,7 +737,7 @@ func maintainTasks(shoot *gardencorev1beta1.Shoot, config controllermanagerconfi
 }
 
 // maintainMachineImages updates the machine images of a Shoot's worker pools if necessary
-func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) (map[string]updateResult, error) {
+func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile, autoUpdate bool) (map[string]updateResult, error) {
 	maintenanceResults := make(map[string]updateResult)
 
 	controlPlaneVersion, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
@@ -583,7 +767,7 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 		filteredMachineImageVersionsFromCloudProfile := helper.FilterMachineImageVersions(&machineImageFromCloudProfile, worker, kubeletVersion, machineTypeFromCloudProfile, cloudProfile.Spec.MachineCapabilities)
 
 		// first check if the machine image version should be updated
-		shouldBeUpdated, reason, isExpired := shouldMachineImageVersionBeUpdated(workerImage, filteredMachineImageVersionsFromCloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
+		shouldBeUpdated, reason, isExpired := shouldMachineImageVersionBeUpdated(workerImage, filteredMachineImageVersionsFromCloudProfile, autoUpdate)
 		if !shouldBeUpdated {
 			continue
 		}
@@ -617,6 +801,176 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 	return maintenanceResults, nil
 }
 
+// maintainWorkerPoolKubernetesVersions updates the Kubernetes versions of a Shoot's worker pools if necessary. The
+// versions are capped at the given Kubernetes version of the control plane.
+func maintainWorkerPoolKubernetesVersions(log logr.Logger, shoot *gardencorev1beta1.Shoot, autoUpdate bool, cloudProfile *gardencorev1beta1.CloudProfile, shootKubernetesVersion *semver.Version) map[string]updateResult {
+	maintenanceResults := make(map[string]updateResult)
+
+	for i, pool := range shoot.Spec.Provider.Workers {
+		if pool.Kubernetes == nil || pool.Kubernetes.Version == nil {
+			continue
+		}
+
+		workerLog := log.WithValues("worker", pool.Name)
+		workerKubernetesUpdate, err := maintainKubernetesVersion(workerLog, *pool.Kubernetes.Version, autoUpdate, cloudProfile, func(v string) (string, error) {
+			workerPoolSemver, err := semver.NewVersion(v)
+			if err != nil {
+				return "", err
+			}
+			// If during autoupdate a worker pool kubernetes gets forcefully updated to the next minor which might be higher than the same minor of the shoot, take this
+			if workerPoolSemver.GreaterThan(shootKubernetesVersion) {
+				workerPoolSemver = shootKubernetesVersion
+			}
+			v = workerPoolSemver.String()
+			shoot.Spec.Provider.Workers[i].Kubernetes.Version = &v
+			return v, nil
+		})
+		if err != nil {
+			// continue execution to allow other maintenance activities to continue
+			workerLog.Error(err, "Could not maintain Kubernetes version for worker pool")
+		}
+
+		if workerKubernetesUpdate != nil {
+			maintenanceResults[pool.Name] = *workerKubernetesUpdate
+		}
+	}
+
+	return maintenanceResults
+}
+
+// restrictCloudProfileToRolloutWave removes the versions from the given CloudProfile which are not yet offered to the
+// rollout wave of the given Shoot. Only Shoots referencing the same CloudProfile, either directly or via one of its
+// NamespacedCloudProfiles, are considered when checking whether earlier rollout waves are healthy.
+func (r *Reconciler) restrictCloudProfileToRolloutWave(ctx context.Context, shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile, now time.Time) (*gardencorev1beta1.CloudProfile, error) {
+	if !helper.HasVersionRollouts(cloudProfile) {
+		return cloudProfile, nil
+	}
+
+	shoots, err := r.listShootsOfCloudProfile(ctx, shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	return helper.RestrictCloudProfileToRolloutWave(cloudProfile, shoot, shoots, now), nil
+}
+
+// listShootsOfCloudProfile lists all Shoots which reference the CloudProfile of the given Shoot, either directly or via
+// a NamespacedCloudProfile with this CloudProfile as parent.
+func (r *Reconciler) listShootsOfCloudProfile(ctx context.Context, shoot *gardencorev1beta1.Shoot) ([]gardencorev1beta1.Shoot, error) {
+	cloudProfileReference := gardenerutils.BuildV1beta1CloudProfileReference(shoot)
+	if cloudProfileReference == nil {
+		return nil, fmt.Errorf("could not determine cloudprofile from shoot")
+	}
+
+	cloudProfileName := cloudProfileReference.Name
+	if cloudProfileReference.Kind == v1beta1constants.CloudProfileReferenceKindNamespacedCloudProfile {
+		namespacedCloudProfile := &gardencorev1beta1.NamespacedCloudProfile{}
+		if err := r.Client.Get(ctx, client.ObjectKey{Name: cloudProfileReference.Name, Namespace: shoot.Namespace}, namespacedCloudProfile); err != nil {
+			return nil, fmt.Errorf("failed reading NamespacedCloudProfile %s: %w", client.ObjectKey{Name: cloudProfileReference.Name, Namespace: shoot.Namespace}, err)
+		}
+		cloudProfileName = namespacedCloudProfile.Spec.Parent.Name
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList, client.MatchingFields{core.ShootCloudProfileRefName: cloudProfileName}); err != nil {
+		return nil, fmt.Errorf("failed listing shoots referencing CloudProfile %q: %w", cloudProfileName, err)
+	}
+
+	shoots := slices.DeleteFunc(shootList.Items, func(s gardencorev1beta1.Shoot) bool {
+		return gardenerutils.BuildV1beta1CloudProfileReference(&s).Kind != v1beta1constants.CloudProfileReferenceKindCloudProfile
+	})
+
+	namespacedCloudProfileList := &gardencorev1beta1.NamespacedCloudProfileList{}
+	if err := r.Client.List(ctx, namespacedCloudProfileList, client.MatchingFields{core.NamespacedCloudProfileParentRefName: cloudProfileName}); err != nil {
+		return nil, fmt.Errorf("failed listing NamespacedCloudProfiles of CloudProfile %q: %w", cloudProfileName, err)
+	}
+
+	for _, namespacedCloudProfile := range namespacedCloudProfileList.Items {
+		namespacedShootList := &gardencorev1beta1.ShootList{}
+		if err := r.Client.List(ctx, namespacedShootList, client.InNamespace(namespacedCloudProfile.Namespace), client.MatchingFields{core.ShootCloudProfileRefName: namespacedCloudProfile.Name}); err != nil {
+			return nil, fmt.Errorf("failed listing shoots referencing NamespacedCloudProfile %s: %w", client.ObjectKeyFromObject(&namespacedCloudProfile), err)
+		}
+
+		shoots = append(shoots, slices.DeleteFunc(namespacedShootList.Items, func(s gardencorev1beta1.Shoot) bool {
+			return gardenerutils.BuildV1beta1CloudProfileReference(&s).Kind != v1beta1constants.CloudProfileReferenceKindNamespacedCloudProfile
+		})...)
+	}
+
+	return shoots, nil
+}
+
+// getActiveMaintenanceFreeze returns the maintenance freeze which is active for the given Shoot at the given point in
+// time, if any. Both the landscape-wide freezes from the controller configuration and the freezes of the Shoot's Project
+// are considered.
+func (r *Reconciler) getActiveMaintenanceFreeze(ctx context.Context, shoot *gardencorev1beta1.Shoot, now time.Time) (*gardencorev1beta1.MaintenanceFreeze, time.Time, error) {
+	freezes := slices.Clone(r.Config.MaintenanceFreezes)
+
+	project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, shoot.Namespace)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, time.Time{}, fmt.Errorf("failed getting project for namespace %q: %w", shoot.Namespace, err)
+	}
+	if project != nil {
+		for _, freeze := range project.Spec.MaintenanceFreezes {
+			// Forced updates of expired versions can only be held by landscape-wide maintenance freezes.
+			freeze.HoldForcedUpdates = nil
+			freezes = append(freezes, freeze)
+		}
+	}
+
+	freeze, until := v1beta1helper.GetActiveMaintenanceFreeze(freezes, now)
+	return freeze, until, nil
+}
+
+// computeDeferredOperations returns the maintenance operations which would have been performed for the given Shoot
+// without an active maintenance freeze, but which are not part of the given maintained Shoot.
+func computeDeferredOperations(shoot, maintainedShoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile, credentialsToRotationUpdate map[string]updateResult) []string {
+	var (
+		log          = logr.Discard()
+		previewShoot = shoot.DeepCopy()
+		operations   []string
+	)
+
+	if !v1beta1helper.IsWorkerless(previewShoot) {
+		_, _ = maintainMachineImages(log, previewShoot, cloudProfile, ptr.Deref(previewShoot.Spec.Maintenance.AutoUpdate.MachineImageVersion, false))
+	}
+
+	_, _ = maintainKubernetesVersion(log, previewShoot.Spec.Kubernetes.Version, previewShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+		previewShoot.Spec.Kubernetes.Version = v
+		return v, nil
+	})
+
+	if previewKubernetesVersion, err := semver.NewVersion(previewShoot.Spec.Kubernetes.Version); err == nil {
+		_ = maintainWorkerPoolKubernetesVersions(log, previewShoot, previewShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, previewKubernetesVersion)
+	}
+
+	if previewShoot.Spec.Kubernetes.Version != maintainedShoot.Spec.Kubernetes.Version {
+		operations = append(operations, fmt.Sprintf("Control Plane: Update Kubernetes version to %q", previewShoot.Spec.Kubernetes.Version))
+	}
+
+	for i, pool := range previewShoot.Spec.Provider.Workers {
+		maintainedPool := maintainedShoot.Spec.Provider.Workers[i]
+
+		if pool.Machine.Image != nil && maintainedPool.Machine.Image != nil &&
+			ptr.Deref(pool.Machine.Image.Version, "") != ptr.Deref(maintainedPool.Machine.Image.Version, "") {
+			operations = append(operations, fmt.Sprintf("Worker pool %q: Update machine image %q to version %q", pool.Name, pool.Machine.Image.Name, ptr.Deref(pool.Machine.Image.Version, "")))
+		}
+
+		if pool.Kubernetes != nil && pool.Kubernetes.Version != nil && maintainedPool.Kubernetes != nil &&
+			*pool.Kubernetes.Version != ptr.Deref(maintainedPool.Kubernetes.Version, "") {
+			operations = append(operations, fmt.Sprintf("Worker pool %q: Update Kubernetes version to %q", pool.Name, *pool.Kubernetes.Version))
+		}
+	}
+
+	credentials := slices.Sorted(maps.Keys(credentialsToRotationUpdate))
+	for _, c := range credentials {
+		if credentialsToRotationUpdate[c].isSuccessful {
+			operations = append(operations, fmt.Sprintf("Credentials %q: %s", c, credentialsToRotationUpdate[c].description))
+		}
+	}
+
+	return operations
+}
+
 // maintainKubernetesVersion updates the Kubernetes version if necessary and returns the reason why an update was done
 func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUpdate bool, profile *gardencorev1beta1.CloudProfile, updateFunc func(string) (string, error)) (*updateResult, error) {
 	shouldBeUpdated, reason, isExpired, err := shouldKubernetesVersionBeUpdated(kubernetesVersion, autoUpdate, profile)
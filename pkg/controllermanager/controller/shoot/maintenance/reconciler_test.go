@@ -12,16 +12,22 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/gardener/gardener/pkg/api/indexer"
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/test"
 	admissionpluginsvalidation "github.com/gardener/gardener/pkg/utils/validation/admissionplugins"
@@ -156,7 +162,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			})
 
 			It("should update machine image version to overall latest. Auto update: already on latest patch for minor, and there is an overall higher version available", func() {
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
@@ -165,7 +171,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			It("should update machine image version to overall latest. Auto update: already on latest patch for minor, and there is an overall higher version available for in-place updates", func() {
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = ptr.To(shootCurrentImageVersion + "-inplace")
 				shoot.Spec.Provider.Workers[0].UpdateStrategy = ptr.To(gardencorev1beta1.AutoInPlaceUpdate)
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion+"-inplace")
@@ -201,7 +207,7 @@ var _ = Describe("Shoot Maintenance", func() {
 
 				shoot.Spec.Provider.Workers[0].Machine.Architecture = ptr.To("arm64")
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
 			})
@@ -244,7 +250,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 
 				shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, otherWorker)
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 
@@ -264,7 +270,7 @@ var _ = Describe("Shoot Maintenance", func() {
 
 				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, highestForMinor)
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
@@ -274,7 +280,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = ptr.To(false)
 				cloudProfile.Spec.MachineImages[0].Versions[0].ExpirationDate = &expirationDateInThePast
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
@@ -309,7 +315,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
@@ -325,7 +331,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, highestExpiredVersion)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestExpiredVersion.Version
-				results, err := maintainMachineImages(log, shoot, cloudProfile)
+				results, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(results[shoot.Spec.Provider.Workers[0].Name].isSuccessful).To(BeFalse())
 				Expect(err).ToNot(HaveOccurred())
@@ -333,7 +339,7 @@ var _ = Describe("Shoot Maintenance", func() {
 
 			It("should not change version: already on highest version.", func() {
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &overallLatestVersion
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
@@ -368,7 +374,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				cloudProfile.Spec.MachineImages[0].Versions = versions
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
@@ -424,7 +430,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expectedVersion)
@@ -467,7 +473,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestPatchNextMinor)
@@ -503,7 +509,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				cloudProfile.Spec.MachineImages[0].Versions = versions
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestNonPreviewPatchVersionNplusTwoMinor.Version)
@@ -548,7 +554,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				cloudProfile.Spec.MachineImages[0].Versions = versions
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", expiredPatchVersionNextMinor.Version)
@@ -582,7 +588,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestVersionForMinor
 				expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
 			})
@@ -597,7 +603,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, highestExpiredVersion)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestExpiredVersion.Version
-				results, err := maintainMachineImages(log, shoot, cloudProfile)
+				results, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(results[shoot.Spec.Provider.Workers[0].Name].isSuccessful).To(BeFalse())
 				Expect(err).ToNot(HaveOccurred())
@@ -649,7 +655,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestPatchCurrentMinor)
@@ -698,7 +704,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestVersionForCurrentMajor)
@@ -748,7 +754,7 @@ var _ = Describe("Shoot Maintenance", func() {
 					},
 				}
 
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestVersionForCurrentMajor)
@@ -794,7 +800,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &latestVersionForCurrentMajor
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", latestVersionNextMajor)
@@ -830,7 +836,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				cloudProfile.Spec.MachineImages[0].Versions = versions
 
 				// the shoots patch version is expired and there is no higher non-expired & non-preview patch version of the same minor -> force update
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestNonPreviewVersionNplusTwoMajor.Version)
@@ -846,7 +852,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 				cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions, highestExpiredVersion)
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestExpiredVersion.Version
-				results, err := maintainMachineImages(log, shoot, cloudProfile)
+				results, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(results[shoot.Spec.Provider.Workers[0].Name].isSuccessful).To(BeFalse())
 				Expect(err).ToNot(HaveOccurred())
@@ -882,7 +888,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				}
 
 				shoot.Spec.Provider.Workers[0].Machine.Image.Version = &highestVersionForMajor
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", highestVersionForMajor)
@@ -973,21 +979,21 @@ var _ = Describe("Shoot Maintenance", func() {
 
 			It("should update to the latest version with supported capabilities", func() {
 				// the latest overall version does not support the workers' capabilities, hence it should not be updated to
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", latestVersionWithSupportedCapabilities)
 			})
 
 			It("should update to the latest version as all capabilities are supported", func() {
 				shoot.Spec.Provider.Workers[0].Machine.Type = "someOtherMachineType"
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
 			})
 
 			It("should not update if the current version is the latest/only that supports the machine type capabilities", func() {
 				shoot.Spec.Provider.Workers[0].Machine.Type = "anotherMachineType"
-				_, err := maintainMachineImages(log, shoot, cloudProfile)
+				_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 				Expect(err).NotTo(HaveOccurred())
 				assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", shootCurrentImageVersion)
 			})
@@ -997,7 +1003,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.MachineImages[0].Versions[1].CRI = []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRIName("other")}}
 			cloudProfile.Spec.MachineImages[0].Versions[3].CRI = []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRIName("other")}}
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 			Expect(err).NotTo(HaveOccurred())
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.0")
 		})
@@ -1006,7 +1012,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = ptr.To(false)
 
 			expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
@@ -1018,7 +1024,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Provider.Workers[0].CRI = &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD}
 
 			expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
 		})
@@ -1031,7 +1037,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			// add another pool without CRI constraints -> should be updated via auto-update
 			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-without-cri-config", Machine: gardencorev1beta1.Machine{Image: shootCurrentImage.DeepCopy(), Architecture: ptr.To("amd64")}})
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 			Expect(err).NotTo(HaveOccurred())
 
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.0")
@@ -1047,7 +1053,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			// add another pool without CRI constraints -> should be updated via auto-update to the highest patch version of the same minor
 			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-without-containerruntime", CRI: &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD}, Machine: gardencorev1beta1.Machine{Image: shootCurrentImage.DeepCopy(), Architecture: ptr.To("amd64")}})
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 			Expect(err).NotTo(HaveOccurred())
 
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.0")
@@ -1062,7 +1068,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-with-gvisor-and-kata", CRI: &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD, ContainerRuntimes: []gardencorev1beta1.ContainerRuntime{{Type: "gvisor"}, {Type: "kata-container"}}}, Machine: gardencorev1beta1.Machine{Image: shootCurrentImage.DeepCopy(), Architecture: ptr.To("amd64")}})
 			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{Name: "worker-with-gvisor", CRI: &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD, ContainerRuntimes: []gardencorev1beta1.ContainerRuntime{{Type: "gvisor"}}}, Machine: gardencorev1beta1.Machine{Image: shootCurrentImage.DeepCopy(), Architecture: ptr.To("amd64")}})
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 			Expect(err).NotTo(HaveOccurred())
 
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", "1.0.0")
@@ -1076,7 +1082,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			shoot.Spec.Kubernetes.Version = "1.26.0"
 
 			expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
 		})
@@ -1085,7 +1091,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			cloudProfile.Spec.MachineImages[0].Versions[1].KubeletVersionConstraint = ptr.To("< 1.26")
 			shoot.Spec.Kubernetes.Version = "1.25.1"
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 			Expect(err).NotTo(HaveOccurred())
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", overallLatestVersion)
 		})
@@ -1099,7 +1105,7 @@ var _ = Describe("Shoot Maintenance", func() {
 			}
 
 			expected := shoot.Spec.Provider.Workers[0].Machine.Image.DeepCopy()
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(expected))
 		})
@@ -1112,7 +1118,7 @@ var _ = Describe("Shoot Maintenance", func() {
 				Version: ptr.To("1.26.0"),
 			}
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 			Expect(err).NotTo(HaveOccurred())
 			assertWorkerMachineImageVersion(&shoot.Spec.Provider.Workers[0], "CoreOs", cloudProfile.Spec.MachineImages[0].Versions[1].Version)
 		})
@@ -1120,7 +1126,7 @@ var _ = Describe("Shoot Maintenance", func() {
 		It("should return an error - cloud profile has no matching (machineImage.name) machine image defined", func() {
 			cloudProfile.Spec.MachineImages = cloudProfile.Spec.MachineImages[1:]
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 			Expect(err).To(HaveOccurred())
 
@@ -1129,7 +1135,7 @@ var _ = Describe("Shoot Maintenance", func() {
 		It("should return an error - cloud profile has no matching (machineImage.type) machine type defined", func() {
 			shoot.Spec.Provider.Workers[0].Machine.Type = "non-existing-machine-type"
 
-			_, err := maintainMachineImages(log, shoot, cloudProfile)
+			_, err := maintainMachineImages(log, shoot, cloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
 
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("machine type \"non-existing-machine-type\" of worker \"cpu-worker\" does not exist in cloudprofile"))
@@ -1852,6 +1858,367 @@ var _ = Describe("Shoot Maintenance", func() {
 		})
 	})
 
+	Describe("#getActiveMaintenanceFreeze", func() {
+		var (
+			ctx        = context.TODO()
+			fakeClock  *testclock.FakeClock
+			reconciler *Reconciler
+			shoot      *gardencorev1beta1.Shoot
+			project    *gardencorev1beta1.Project
+
+			landscapeFreeze = gardencorev1beta1.MaintenanceFreeze{
+				Name:       "year-end",
+				Begin:      metav1.Date(2025, time.December, 20, 0, 0, 0, 0, time.UTC),
+				End:        metav1.Date(2026, time.January, 6, 0, 0, 0, 0, time.UTC),
+				Recurrence: ptr.To(gardencorev1beta1.MaintenanceFreezeRecurrenceYearly),
+			}
+			projectFreeze = gardencorev1beta1.MaintenanceFreeze{
+				Name:  "release",
+				Begin: metav1.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC),
+				End:   metav1.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC),
+			}
+		)
+
+		BeforeEach(func() {
+			fakeClock = testclock.NewFakeClock(time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC))
+			fakeClient := fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.GardenScheme).
+				WithIndex(&gardencorev1beta1.Project{}, gardencore.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+				Build()
+
+			reconciler = &Reconciler{
+				Client: fakeClient,
+				Config: controllermanagerconfigv1alpha1.ShootMaintenanceControllerConfiguration{
+					MaintenanceFreezes: []gardencorev1beta1.MaintenanceFreeze{landscapeFreeze},
+				},
+				Clock: fakeClock,
+			}
+
+			shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"}}
+			project = &gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+				Spec: gardencorev1beta1.ProjectSpec{
+					Namespace:          ptr.To("garden-dev"),
+					MaintenanceFreezes: []gardencorev1beta1.MaintenanceFreeze{projectFreeze},
+				},
+			}
+			Expect(fakeClient.Create(ctx, project)).To(Succeed())
+		})
+
+		It("should return the active freeze of the project", func() {
+			freeze, until, err := reconciler.getActiveMaintenanceFreeze(ctx, shoot, fakeClock.Now())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(freeze).To(PointTo(MatchFields(IgnoreExtras, Fields{"Name": Equal(projectFreeze.Name)})))
+			Expect(until).To(BeTemporally("==", projectFreeze.End.Time))
+		})
+
+		It("should ignore held forced updates of the project's freeze", func() {
+			project.Spec.MaintenanceFreezes[0].HoldForcedUpdates = ptr.To(true)
+			Expect(reconciler.Client.Update(ctx, project)).To(Succeed())
+
+			freeze, _, err := reconciler.getActiveMaintenanceFreeze(ctx, shoot, fakeClock.Now())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(freeze).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Name":              Equal(projectFreeze.Name),
+				"HoldForcedUpdates": BeNil(),
+			})))
+		})
+
+		It("should return the active landscape-wide freeze", func() {
+			fakeClock.SetTime(time.Date(2026, time.December, 24, 12, 0, 0, 0, time.UTC))
+
+			freeze, until, err := reconciler.getActiveMaintenanceFreeze(ctx, shoot, fakeClock.Now())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(freeze).To(PointTo(MatchFields(IgnoreExtras, Fields{"Name": Equal(landscapeFreeze.Name)})))
+			Expect(until).To(BeTemporally("==", time.Date(2027, time.January, 6, 0, 0, 0, 0, time.UTC)))
+		})
+
+		It("should only consider landscape-wide freezes if the project cannot be found", func() {
+			shoot.Namespace = "garden-other"
+
+			freeze, _, err := reconciler.getActiveMaintenanceFreeze(ctx, shoot, fakeClock.Now())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(freeze).To(BeNil())
+		})
+	})
+
+	Describe("#listShootsOfCloudProfile", func() {
+		var (
+			ctx        = context.Background()
+			fakeClient client.Client
+			reconciler *Reconciler
+		)
+
+		newShoot := func(namespace, name, kind, cloudProfileName string) *gardencorev1beta1.Shoot {
+			return &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+				Spec: gardencorev1beta1.ShootSpec{
+					CloudProfile: &gardencorev1beta1.CloudProfileReference{Kind: kind, Name: cloudProfileName},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.GardenScheme).
+				WithIndex(&gardencorev1beta1.Shoot{}, gardencore.ShootCloudProfileRefName, indexer.ShootCloudProfileRefNameIndexerFunc).
+				WithIndex(&gardencorev1beta1.NamespacedCloudProfile{}, gardencore.NamespacedCloudProfileParentRefName, indexer.NamespacedCloudProfileParentRefNameIndexerFunc).
+				Build()
+			reconciler = &Reconciler{Client: fakeClient}
+
+			for _, obj := range []client.Object{
+				&gardencorev1beta1.NamespacedCloudProfile{
+					ObjectMeta: metav1.ObjectMeta{Name: "custom", Namespace: "garden-dev"},
+					Spec:       gardencorev1beta1.NamespacedCloudProfileSpec{Parent: gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "profile"}},
+				},
+				&gardencorev1beta1.NamespacedCloudProfile{
+					ObjectMeta: metav1.ObjectMeta{Name: "profile", Namespace: "garden-other"},
+					Spec:       gardencorev1beta1.NamespacedCloudProfileSpec{Parent: gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "other"}},
+				},
+				newShoot("garden-dev", "direct", "CloudProfile", "profile"),
+				newShoot("garden-dev", "namespaced", "NamespacedCloudProfile", "custom"),
+				newShoot("garden-other", "other-direct", "CloudProfile", "other"),
+				newShoot("garden-other", "other-namespaced", "NamespacedCloudProfile", "profile"),
+			} {
+				Expect(fakeClient.Create(ctx, obj)).To(Succeed())
+			}
+		})
+
+		It("should return the shoots referencing the cloud profile directly or via a namespaced cloud profile", func() {
+			shoots, err := reconciler.listShootsOfCloudProfile(ctx, newShoot("garden-dev", "shoot", "CloudProfile", "profile"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(shoots).To(ConsistOf(
+				HaveField("ObjectMeta.Name", "direct"),
+				HaveField("ObjectMeta.Name", "namespaced"),
+			))
+		})
+
+		It("should return the shoots of the parent cloud profile for a shoot referencing a namespaced cloud profile", func() {
+			shoots, err := reconciler.listShootsOfCloudProfile(ctx, newShoot("garden-other", "shoot", "NamespacedCloudProfile", "profile"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(shoots).To(ConsistOf(
+				HaveField("ObjectMeta.Name", "other-direct"),
+				HaveField("ObjectMeta.Name", "other-namespaced"),
+			))
+		})
+	})
+
+	Describe("#computeDeferredOperations", func() {
+		var (
+			cloudProfile *gardencorev1beta1.CloudProfile
+			shoot        *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			cloudProfile = &gardencorev1beta1.CloudProfile{
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					Kubernetes: gardencorev1beta1.KubernetesSettings{
+						Versions: []gardencorev1beta1.ExpirableVersion{
+							{Version: "1.0.2"},
+							{Version: "1.0.1"},
+							{Version: "1.0.0"},
+						},
+					},
+				},
+			}
+
+			shoot = &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Maintenance: &gardencorev1beta1.Maintenance{
+						AutoUpdate: &gardencorev1beta1.MaintenanceAutoUpdate{
+							KubernetesVersion: true,
+						},
+					},
+					Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.0.0"},
+				},
+			}
+		})
+
+		It("should return the deferred version updates and credentials rotations", func() {
+			credentialsToRotationUpdate := map[string]updateResult{
+				v1beta1constants.ShootOperationRotateSSHKeypair:   {description: "SSH keypair rotation started", isSuccessful: true},
+				v1beta1constants.OperationRotateETCDEncryptionKey: {description: "Could not start ETCD encryption key rotation", isSuccessful: false},
+			}
+
+			Expect(computeDeferredOperations(shoot, shoot.DeepCopy(), cloudProfile, credentialsToRotationUpdate)).To(Equal([]string{
+				`Control Plane: Update Kubernetes version to "1.0.2"`,
+				`Credentials "rotate-ssh-keypair": SSH keypair rotation started`,
+			}))
+		})
+
+		It("should not return version updates which have been performed anyway", func() {
+			maintainedShoot := shoot.DeepCopy()
+			maintainedShoot.Spec.Kubernetes.Version = "1.0.2"
+
+			Expect(computeDeferredOperations(shoot, maintainedShoot, cloudProfile, nil)).To(BeEmpty())
+		})
+	})
+
+	Describe("#updateUpcomingMaintenance", func() {
+		var (
+			ctx          = context.TODO()
+			fakeClient   client.Client
+			fakeClock    *testclock.FakeClock
+			reconciler   *Reconciler
+			cloudProfile *gardencorev1beta1.CloudProfile
+			shoot        *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.GardenScheme).
+				WithIndex(&gardencorev1beta1.Project{}, gardencore.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+				WithStatusSubresource(&gardencorev1beta1.Shoot{}).
+				Build()
+			fakeClock = testclock.NewFakeClock(time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC))
+
+			reconciler = &Reconciler{
+				Client: fakeClient,
+				Clock:  fakeClock,
+			}
+
+			cloudProfile = &gardencorev1beta1.CloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					Kubernetes: gardencorev1beta1.KubernetesSettings{
+						Versions: []gardencorev1beta1.ExpirableVersion{
+							{Version: "1.0.2"},
+							{Version: "1.0.1"},
+							{Version: "1.0.0"},
+						},
+					},
+				},
+			}
+			Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+
+			shoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+				Spec: gardencorev1beta1.ShootSpec{
+					CloudProfile: &gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: cloudProfile.Name},
+					Maintenance: &gardencorev1beta1.Maintenance{
+						AutoUpdate: &gardencorev1beta1.MaintenanceAutoUpdate{KubernetesVersion: true},
+						TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"},
+					},
+					Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.0.0"},
+				},
+			}
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		})
+
+		It("should publish the operations expected in the next maintenance time window", func() {
+			Expect(reconciler.updateUpcomingMaintenance(ctx, shoot)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Spec.Kubernetes.Version).To(Equal("1.0.0"))
+			Expect(shoot.Status.UpcomingMaintenance).NotTo(BeNil())
+			Expect(shoot.Status.UpcomingMaintenance.WindowStart.Time).To(BeTemporally("==", time.Date(2026, time.October, 18, 22, 0, 0, 0, time.UTC)))
+			Expect(shoot.Status.UpcomingMaintenance.LastUpdateTime.Time).To(BeTemporally("==", fakeClock.Now()))
+			Expect(shoot.Status.UpcomingMaintenance.Operations).To(HaveExactElements(
+				HavePrefix(`Control Plane: Updated Kubernetes version from "1.0.0" to "1.0.2". Reason: `),
+			))
+			Expect(shoot.Status.UpcomingMaintenance.FailedOperations).To(BeEmpty())
+			Expect(shoot.Status.UpcomingMaintenance.Deferral).To(BeNil())
+		})
+
+		It("should publish expected failures", func() {
+			cloudProfile.Spec.Kubernetes.Versions = []gardencorev1beta1.ExpirableVersion{{Version: "1.0.0", ExpirationDate: &metav1.Time{Time: fakeClock.Now().Add(-time.Hour)}}}
+			Expect(fakeClient.Update(ctx, cloudProfile)).To(Succeed())
+
+			Expect(reconciler.updateUpcomingMaintenance(ctx, shoot)).To(Succeed())
+
+			Expect(shoot.Status.UpcomingMaintenance.Operations).To(BeEmpty())
+			Expect(shoot.Status.UpcomingMaintenance.FailedOperations).To(HaveExactElements(
+				HavePrefix("Control Plane: Kubernetes version update will fail: "),
+			))
+		})
+
+		It("should publish the deferral if a maintenance freeze is active at the begin of the next maintenance time window", func() {
+			reconciler.Config.MaintenanceFreezes = []gardencorev1beta1.MaintenanceFreeze{{
+				Name:  "release",
+				Begin: metav1.NewTime(time.Date(2026, time.October, 18, 20, 0, 0, 0, time.UTC)),
+				End:   metav1.NewTime(time.Date(2026, time.October, 20, 0, 0, 0, 0, time.UTC)),
+			}}
+
+			Expect(reconciler.updateUpcomingMaintenance(ctx, shoot)).To(Succeed())
+
+			Expect(shoot.Status.UpcomingMaintenance.Operations).To(BeEmpty())
+			Expect(shoot.Status.UpcomingMaintenance.Deferral).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Freeze":     Equal("release"),
+				"Operations": Equal([]string{`Control Plane: Update Kubernetes version to "1.0.2"`}),
+			})))
+		})
+
+		It("should not update the preview if it has not changed", func() {
+			Expect(reconciler.updateUpcomingMaintenance(ctx, shoot)).To(Succeed())
+			lastUpdateTime := shoot.Status.UpcomingMaintenance.LastUpdateTime
+
+			fakeClock.Step(time.Hour)
+			Expect(reconciler.updateUpcomingMaintenance(ctx, shoot)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.UpcomingMaintenance.LastUpdateTime.Time).To(BeTemporally("==", lastUpdateTime.Time))
+		})
+
+		It("should update the preview if it has changed", func() {
+			Expect(reconciler.updateUpcomingMaintenance(ctx, shoot)).To(Succeed())
+
+			shoot.Spec.Kubernetes.Version = "1.0.2"
+			Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+			fakeClock.Step(time.Hour)
+			Expect(reconciler.updateUpcomingMaintenance(ctx, shoot)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.UpcomingMaintenance.Operations).To(BeEmpty())
+			Expect(shoot.Status.UpcomingMaintenance.LastUpdateTime.Time).To(BeTemporally("==", fakeClock.Now()))
+		})
+	})
+
+	Describe("#upcomingMaintenanceRequeueAfter", func() {
+		var (
+			fakeClock  *testclock.FakeClock
+			reconciler *Reconciler
+			shoot      *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			fakeClock = testclock.NewFakeClock(time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC))
+			reconciler = &Reconciler{
+				Config: controllermanagerconfigv1alpha1.ShootMaintenanceControllerConfiguration{
+					UpcomingMaintenanceSyncPeriod: &metav1.Duration{Duration: time.Hour},
+				},
+				Clock: fakeClock,
+			}
+			shoot = &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Maintenance: &gardencorev1beta1.Maintenance{
+						TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"},
+					},
+				},
+			}
+		})
+
+		It("should requeue after the sync period if the next maintenance is later", func() {
+			Expect(reconciler.upcomingMaintenanceRequeueAfter(shoot, 10*time.Hour)).To(Equal(time.Hour))
+		})
+
+		It("should requeue for the next maintenance if it is earlier than the sync period", func() {
+			Expect(reconciler.upcomingMaintenanceRequeueAfter(shoot, 30*time.Minute)).To(Equal(30 * time.Minute))
+		})
+
+		It("should requeue for the next maintenance if the refresh would happen within the maintenance time window", func() {
+			fakeClock.SetTime(time.Date(2026, time.October, 18, 21, 30, 0, 0, time.UTC))
+
+			Expect(reconciler.upcomingMaintenanceRequeueAfter(shoot, 75*time.Minute)).To(Equal(75 * time.Minute))
+		})
+
+		It("should requeue for the next maintenance if no sync period is configured", func() {
+			reconciler.Config.UpcomingMaintenanceSyncPeriod = nil
+
+			Expect(reconciler.upcomingMaintenanceRequeueAfter(shoot, 10*time.Hour)).To(Equal(10 * time.Hour))
+		})
+	})
+
 	Describe("#migrateSecretBindingToCredentialsBinding", func() {
 		var (
 			ctx               context.Context
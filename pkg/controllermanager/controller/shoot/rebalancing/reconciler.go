@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rebalancing
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"golang.org/x/exp/maps"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+const (
+	// EventRebalancingTriggered is an event reason for a control plane migration triggered by the rebalancing
+	// controller.
+	EventRebalancingTriggered = "RebalancingTriggered"
+	// EventRebalancingFailed is an event reason for a failed attempt of the rebalancing controller to trigger a control
+	// plane migration.
+	EventRebalancingFailed = "RebalancingFailed"
+)
+
+// SeedDeterminer determines the destination seed for the control plane migration of a shoot.
+type SeedDeterminer interface {
+	DetermineMigrationSeed(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (*gardencorev1beta1.Seed, error)
+}
+
+// Reconciler reconciles Seeds and triggers control plane migrations of Shoots away from Seeds which are under pressure,
+// i.e., which exceed the configured resource utilization thresholds or which are being deleted.
+type Reconciler struct {
+	Client         client.Client
+	Config         controllermanagerconfigv1alpha1.ShootRebalancingControllerConfiguration
+	Clock          clock.Clock
+	Recorder       record.EventRecorder
+	SeedDeterminer SeedDeterminer
+}
+
+// Reconcile reconciles Seeds and triggers control plane migrations of Shoots away from Seeds which are under pressure.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.Client.Get(ctx, request.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	requeueAfter := r.Config.SyncPeriod.Duration
+
+	reason := r.seedPressure(seed)
+	if reason == "" {
+		log.V(1).Info("Seed is not under pressure, nothing to rebalance")
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+	log = log.WithValues("reason", reason)
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	var (
+		migrationsInLandscape int32
+		migrationsFromSeed    int32
+		candidates            []*gardencorev1beta1.Shoot
+	)
+
+	for i := range shootList.Items {
+		shoot := &shootList.Items[i]
+
+		if isMigrating(shoot) {
+			migrationsInLandscape++
+			if ptr.Deref(shoot.Status.SeedName, "") == seed.Name {
+				migrationsFromSeed++
+			}
+			continue
+		}
+
+		if ptr.Deref(shoot.Spec.SeedName, "") == seed.Name && r.isEligible(shoot) {
+			candidates = append(candidates, shoot)
+		}
+	}
+
+	budget := min(*r.Config.MaxMigrationsPerSeed-migrationsFromSeed, *r.Config.MaxMigrations-migrationsInLandscape)
+	if budget <= 0 {
+		log.Info("Seed is under pressure but the limit of concurrent migrations is reached", "migrationsFromSeed", migrationsFromSeed, "migrationsInLandscape", migrationsInLandscape)
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+	if len(candidates) == 0 {
+		log.Info("Seed is under pressure but no shoot is eligible for migration right now")
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	slices.SortFunc(candidates, func(a, b *gardencorev1beta1.Shoot) int {
+		return strings.Compare(client.ObjectKeyFromObject(a).String(), client.ObjectKeyFromObject(b).String())
+	})
+
+	for _, shoot := range candidates {
+		if budget <= 0 {
+			break
+		}
+
+		if err := r.migrate(ctx, log.WithValues("shoot", client.ObjectKeyFromObject(shoot)), shoot, reason); err != nil {
+			log.Error(err, "Failed triggering control plane migration", "shoot", client.ObjectKeyFromObject(shoot))
+			r.Recorder.Eventf(shoot, corev1.EventTypeWarning, EventRebalancingFailed, "Failed triggering control plane migration away from seed %q (%s): %v", seed.Name, reason, err)
+			continue
+		}
+		budget--
+	}
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+func (r *Reconciler) migrate(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, reason string) error {
+	sourceSeedName := *shoot.Spec.SeedName
+
+	destinationSeed, err := r.SeedDeterminer.DetermineMigrationSeed(ctx, log, shoot)
+	if err != nil {
+		return fmt.Errorf("failed determining destination seed: %w", err)
+	}
+
+	shoot.Spec.SeedName = &destinationSeed.Name
+	if err := r.Client.SubResource("binding").Update(ctx, shoot); err != nil {
+		return fmt.Errorf("failed binding shoot to destination seed %q: %w", destinationSeed.Name, err)
+	}
+
+	log.Info("Triggered control plane migration", "sourceSeed", sourceSeedName, "destinationSeed", destinationSeed.Name)
+	r.Recorder.Eventf(shoot, corev1.EventTypeNormal, EventRebalancingTriggered, "Triggered control plane migration from seed %q to seed %q (%s)", sourceSeedName, destinationSeed.Name, reason)
+	return nil
+}
+
+// seedPressure returns a human-readable reason if the given seed is under pressure, or an empty string otherwise.
+func (r *Reconciler) seedPressure(seed *gardencorev1beta1.Seed) string {
+	if seed.DeletionTimestamp != nil {
+		return "seed is being deleted"
+	}
+
+	resourceNames := maps.Keys(r.Config.ResourceUtilizationThresholds)
+	slices.Sort(resourceNames)
+
+	for _, resourceName := range resourceNames {
+		threshold := r.Config.ResourceUtilizationThresholds[resourceName]
+		if utilization, ok := v1beta1helper.SeedResourceUtilization(seed, resourceName); ok && utilization > int64(threshold) {
+			return fmt.Sprintf("%s utilization %d%% exceeds threshold %d%%", resourceName, utilization, threshold)
+		}
+	}
+
+	return ""
+}
+
+// isEligible returns true if the control plane of the given shoot may be migrated now. Only shoots whose last operation
+// succeeded are considered, and migrations are only triggered during the shoot's maintenance time window.
+func (r *Reconciler) isEligible(shoot *gardencorev1beta1.Shoot) bool {
+	if shoot.DeletionTimestamp != nil ||
+		shoot.Status.SeedName == nil ||
+		shoot.Status.LastOperation == nil ||
+		shoot.Status.LastOperation.State != gardencorev1beta1.LastOperationStateSucceeded {
+		return false
+	}
+
+	return gardenerutils.IsNowInEffectiveShootMaintenanceTimeWindow(shoot, r.Clock)
+}
+
+func isMigrating(shoot *gardencorev1beta1.Shoot) bool {
+	if v1beta1helper.ShouldPrepareShootForMigration(shoot) {
+		return true
+	}
+
+	lastOperation := shoot.Status.LastOperation
+	return (v1beta1helper.ShootHasOperationType(lastOperation, gardencorev1beta1.LastOperationTypeMigrate) ||
+		v1beta1helper.ShootHasOperationType(lastOperation, gardencorev1beta1.LastOperationTypeRestore)) &&
+		lastOperation.State != gardencorev1beta1.LastOperationStateSucceeded
+}
@@ -0,0 +1,215 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package rebalancing_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/rebalancing"
+)
+
+type fakeSeedDeterminer struct {
+	seedName string
+	err      error
+}
+
+func (f *fakeSeedDeterminer) DetermineMigrationSeed(_ context.Context, _ logr.Logger, _ *gardencorev1beta1.Shoot) (*gardencorev1beta1.Seed, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: f.seedName}}, nil
+}
+
+var _ = Describe("Reconciler", func() {
+	const (
+		seedName            = "seed"
+		destinationSeedName = "destination"
+		syncPeriod          = 10 * time.Minute
+	)
+
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		determiner *fakeSeedDeterminer
+		reconciler *Reconciler
+		request    reconcile.Request
+
+		seed  *gardencorev1beta1.Seed
+		bound map[string]string
+
+		newShoot func(name, specSeedName, statusSeedName string) *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		bound = make(map[string]string)
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithInterceptorFuncs(interceptor.Funcs{
+				SubResourceUpdate: func(_ context.Context, _ client.Client, subResourceName string, obj client.Object, _ ...client.SubResourceUpdateOption) error {
+					if subResourceName != "binding" {
+						return fmt.Errorf("unexpected subresource %q", subResourceName)
+					}
+					bound[obj.GetName()] = *obj.(*gardencorev1beta1.Shoot).Spec.SeedName
+					return nil
+				},
+			}).
+			Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC))
+		determiner = &fakeSeedDeterminer{seedName: destinationSeedName}
+
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: controllermanagerconfigv1alpha1.ShootRebalancingControllerConfiguration{
+				SyncPeriod:                    &metav1.Duration{Duration: syncPeriod},
+				MaxMigrationsPerSeed:          ptr.To[int32](1),
+				MaxMigrations:                 ptr.To[int32](2),
+				ResourceUtilizationThresholds: map[corev1.ResourceName]int32{corev1.ResourceCPU: 80},
+			},
+			Clock:          fakeClock,
+			Recorder:       record.NewFakeRecorder(10),
+			SeedDeterminer: determiner,
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKey{Name: seedName}}
+
+		seed = &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: seedName},
+			Status: gardencorev1beta1.SeedStatus{
+				ResourceUsage: &gardencorev1beta1.SeedResourceUsage{
+					ControlPlaneRequests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("90")},
+					NodeAllocatable:      corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100")},
+				},
+			},
+		}
+
+		newShoot = func(name, specSeedName, statusSeedName string) *gardencorev1beta1.Shoot {
+			return &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-project"},
+				Spec: gardencorev1beta1.ShootSpec{
+					SeedName: ptr.To(specSeedName),
+					Maintenance: &gardencorev1beta1.Maintenance{
+						TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "110000+0000", End: "140000+0000"},
+					},
+				},
+				Status: gardencorev1beta1.ShootStatus{
+					SeedName: ptr.To(statusSeedName),
+					LastOperation: &gardencorev1beta1.LastOperation{
+						Type:  gardencorev1beta1.LastOperationTypeReconcile,
+						State: gardencorev1beta1.LastOperationStateSucceeded,
+					},
+				},
+			}
+		}
+	})
+
+	It("should do nothing if the seed is gone", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should not migrate shoots if the seed is not under pressure", func() {
+		seed.Status.ResourceUsage.ControlPlaneRequests[corev1.ResourceCPU] = resource.MustParse("50")
+		Expect(fakeClient.Create(ctx, newShoot("shoot", seedName, seedName))).To(Succeed())
+
+		Expect(fakeClient.Create(ctx, seed)).To(Succeed())
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(bound).To(BeEmpty())
+	})
+
+	It("should migrate an eligible shoot if the seed exceeds the utilization threshold", func() {
+		Expect(fakeClient.Create(ctx, newShoot("shoot", seedName, seedName))).To(Succeed())
+
+		Expect(fakeClient.Create(ctx, seed)).To(Succeed())
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(bound).To(Equal(map[string]string{"shoot": destinationSeedName}))
+	})
+
+	It("should migrate an eligible shoot if the seed is being deleted", func() {
+		seed.Status.ResourceUsage = nil
+		seed.Finalizers = []string{"gardener"}
+		Expect(fakeClient.Create(ctx, newShoot("shoot", seedName, seedName))).To(Succeed())
+
+		Expect(fakeClient.Create(ctx, seed)).To(Succeed())
+		Expect(fakeClient.Delete(ctx, seed)).To(Succeed())
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(bound).To(Equal(map[string]string{"shoot": destinationSeedName}))
+	})
+
+	It("should only migrate shoots which are eligible", func() {
+		outsideMaintenanceWindow := newShoot("shoot-1", seedName, seedName)
+		outsideMaintenanceWindow.Spec.Maintenance.TimeWindow = &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"}
+		Expect(fakeClient.Create(ctx, outsideMaintenanceWindow)).To(Succeed())
+
+		processing := newShoot("shoot-2", seedName, seedName)
+		processing.Status.LastOperation.State = gardencorev1beta1.LastOperationStateProcessing
+		Expect(fakeClient.Create(ctx, processing)).To(Succeed())
+
+		Expect(fakeClient.Create(ctx, newShoot("shoot-3", "other-seed", "other-seed"))).To(Succeed())
+
+		Expect(fakeClient.Create(ctx, seed)).To(Succeed())
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(bound).To(BeEmpty())
+	})
+
+	It("should honour the concurrency limit per seed", func() {
+		Expect(fakeClient.Create(ctx, newShoot("shoot-1", seedName, seedName))).To(Succeed())
+		Expect(fakeClient.Create(ctx, newShoot("shoot-2", seedName, seedName))).To(Succeed())
+
+		Expect(fakeClient.Create(ctx, seed)).To(Succeed())
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(bound).To(Equal(map[string]string{"shoot-1": destinationSeedName}))
+	})
+
+	It("should not migrate further shoots if a migration away from the seed is in progress", func() {
+		Expect(fakeClient.Create(ctx, newShoot("shoot-1", destinationSeedName, seedName))).To(Succeed())
+		Expect(fakeClient.Create(ctx, newShoot("shoot-2", seedName, seedName))).To(Succeed())
+
+		Expect(fakeClient.Create(ctx, seed)).To(Succeed())
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(bound).To(BeEmpty())
+	})
+
+	It("should honour the concurrency limit of the landscape", func() {
+		for i, sourceSeedName := range []string{"seed-a", "seed-b"} {
+			shoot := newShoot(fmt.Sprintf("migrating-%d", i), destinationSeedName, sourceSeedName)
+			shoot.Status.LastOperation.Type = gardencorev1beta1.LastOperationTypeRestore
+			shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateProcessing
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		}
+		Expect(fakeClient.Create(ctx, newShoot("shoot", seedName, seedName))).To(Succeed())
+
+		Expect(fakeClient.Create(ctx, seed)).To(Succeed())
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(bound).To(BeEmpty())
+	})
+
+	It("should continue with the next shoot if no destination seed can be determined", func() {
+		reconciler.Config.MaxMigrationsPerSeed = ptr.To[int32](2)
+		determiner.err = fmt.Errorf("no seed")
+		Expect(fakeClient.Create(ctx, newShoot("shoot", seedName, seedName))).To(Succeed())
+
+		Expect(fakeClient.Create(ctx, seed)).To(Succeed())
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(bound).To(BeEmpty())
+	})
+})
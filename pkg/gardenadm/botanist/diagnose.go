@@ -0,0 +1,247 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"os/exec"
+	"path"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/yaml"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kubeletcomponent "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+// maxCertificateFileSize is the maximum size of files which are inspected for certificates.
+const maxCertificateFileSize = 1 << 20
+
+// JournalLogs returns the journal logs of the given systemd unit since the given duration.
+// Exposed for testing.
+var JournalLogs = func(ctx context.Context, unitName string, since time.Duration) ([]byte, error) {
+	return exec.CommandContext(ctx, "journalctl", "--unit", unitName, "--no-pager", "--since", fmt.Sprintf("-%ds", int(since.Seconds()))).CombinedOutput()
+}
+
+// WriteSupportBundle collects the information needed for analyzing problems with this machine and writes it as a
+// gzip-compressed tarball to the given writer. The bundle contains the journal logs of gardener-node-agent and kubelet
+// since the given duration, the OperatingSystemConfig last applied by gardener-node-agent, the static pod manifests,
+// and the expiration dates of the certificates used by kubelet and the static pods. Inline file contents of the
+// OperatingSystemConfig are redacted since they might contain credentials, and private keys are never collected.
+// Problems with collecting single parts of the bundle do not abort the collection but are listed in errors.txt.
+func (b *GardenadmBotanist) WriteSupportBundle(ctx context.Context, w io.Writer, since time.Duration) error {
+	var (
+		gzipWriter = gzip.NewWriter(w)
+		tarWriter  = tar.NewWriter(gzipWriter)
+		now        = b.Clock.Now()
+		errs       []string
+	)
+
+	addFile := func(name string, content []byte) error {
+		if err := tarWriter.WriteHeader(&tar.Header{
+			Name:    name,
+			Mode:    0600,
+			Size:    int64(len(content)),
+			ModTime: now,
+		}); err != nil {
+			return fmt.Errorf("failed writing tar header for %s: %w", name, err)
+		}
+		if _, err := tarWriter.Write(content); err != nil {
+			return fmt.Errorf("failed writing %s to tarball: %w", name, err)
+		}
+		return nil
+	}
+
+	for _, unitName := range []string{nodeagentconfigv1alpha1.UnitName, v1beta1constants.OperatingSystemConfigUnitNameKubeletService} {
+		logs, err := JournalLogs(ctx, unitName, since)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("failed fetching journal logs of %s: %v", unitName, err))
+		}
+		if err := addFile(path.Join("logs", strings.TrimSuffix(unitName, ".service")+".log"), logs); err != nil {
+			return err
+		}
+	}
+
+	osc, err := b.lastAppliedOperatingSystemConfig()
+	if err != nil {
+		errs = append(errs, err.Error())
+	}
+
+	certificates, err := b.certificateExpirations(osc, now)
+	if err != nil {
+		errs = append(errs, err.Error())
+	}
+	if err := addFile("certificates.txt", certificates); err != nil {
+		return err
+	}
+
+	if osc != nil {
+		raw, err := redactedOperatingSystemConfig(osc)
+		if err != nil {
+			return err
+		}
+		if err := addFile("operatingsystemconfig.yaml", raw); err != nil {
+			return err
+		}
+	}
+
+	manifests, err := b.staticPodManifests()
+	if err != nil {
+		errs = append(errs, err.Error())
+	}
+	for _, name := range slices.Sorted(maps.Keys(manifests)) {
+		if err := addFile(path.Join("manifests", name), manifests[name]); err != nil {
+			return err
+		}
+	}
+
+	if len(errs) > 0 {
+		if err := addFile("errors.txt", []byte(strings.Join(errs, "\n")+"\n")); err != nil {
+			return err
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return fmt.Errorf("failed closing tar writer: %w", err)
+	}
+	return gzipWriter.Close()
+}
+
+func redactedOperatingSystemConfig(osc *extensionsv1alpha1.OperatingSystemConfig) ([]byte, error) {
+	for _, files := range [][]extensionsv1alpha1.File{osc.Spec.Files, osc.Status.ExtensionFiles} {
+		for i := range files {
+			if files[i].Content.Inline != nil {
+				files[i].Content.Inline.Encoding = ""
+				files[i].Content.Inline.Data = "<redacted>"
+			}
+		}
+	}
+
+	raw, err := yaml.Marshal(osc)
+	if err != nil {
+		return nil, fmt.Errorf("failed marshalling last applied OperatingSystemConfig: %w", err)
+	}
+	return raw, nil
+}
+
+// staticPodManifests returns the contents of the files in the static pod directory by their file names.
+func (b *GardenadmBotanist) staticPodManifests() (map[string][]byte, error) {
+	exists, err := b.FS.DirExists(kubeletcomponent.FilePathKubernetesManifests)
+	if err != nil {
+		return nil, fmt.Errorf("failed checking whether static pod directory %s exists: %w", kubeletcomponent.FilePathKubernetesManifests, err)
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	entries, err := b.FS.ReadDir(kubeletcomponent.FilePathKubernetesManifests)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading static pod directory %s: %w", kubeletcomponent.FilePathKubernetesManifests, err)
+	}
+
+	manifests := make(map[string][]byte, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		manifestPath := path.Join(kubeletcomponent.FilePathKubernetesManifests, entry.Name())
+		content, err := b.FS.ReadFile(manifestPath)
+		if err != nil {
+			return manifests, fmt.Errorf("failed reading static pod manifest %s: %w", manifestPath, err)
+		}
+		manifests[entry.Name()] = content
+	}
+
+	return manifests, nil
+}
+
+// certificateExpirations returns a table of all certificates found in the kubelet PKI directory and the files of the
+// given OperatingSystemConfig (e.g., the secrets mounted into the static pods).
+func (b *GardenadmBotanist) certificateExpirations(osc *extensionsv1alpha1.OperatingSystemConfig, now time.Time) ([]byte, error) {
+	paths := sets.New[string]()
+	if osc != nil {
+		for _, file := range append(osc.Spec.Files, osc.Status.ExtensionFiles...) {
+			paths.Insert(file.Path)
+		}
+	}
+
+	pkiDir := path.Join(kubeletcomponent.PathKubeletDirectory, "pki")
+	if err := b.FS.Walk(pkiDir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if !info.IsDir() {
+			paths.Insert(filePath)
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed walking kubelet PKI directory %s: %w", pkiDir, err)
+	}
+
+	var (
+		buffer = &bytes.Buffer{}
+		writer = tabwriter.NewWriter(buffer, 0, 4, 2, ' ', 0)
+		errs   []error
+	)
+
+	fmt.Fprintln(writer, "PATH\tSUBJECT\tNOT AFTER\tREMAINING")
+	for _, filePath := range sets.List(paths) {
+		info, err := b.FS.Stat(filePath)
+		if err != nil {
+			if !errors.Is(err, os.ErrNotExist) {
+				errs = append(errs, fmt.Errorf("failed inspecting %s: %w", filePath, err))
+			}
+			continue
+		}
+		if info.IsDir() || info.Size() > maxCertificateFileSize {
+			continue
+		}
+
+		content, err := b.FS.ReadFile(filePath)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed reading %s: %w", filePath, err))
+			continue
+		}
+
+		for block, rest := pem.Decode(content); block != nil; block, rest = pem.Decode(rest) {
+			if block.Type != "CERTIFICATE" {
+				continue
+			}
+
+			certificate, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed parsing certificate in %s: %w", filePath, err))
+				continue
+			}
+
+			fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", filePath, certificate.Subject.String(), certificate.NotAfter.UTC().Format(time.RFC3339), certificate.NotAfter.Sub(now).Round(time.Hour))
+		}
+	}
+
+	if err := writer.Flush(); err != nil {
+		return nil, fmt.Errorf("failed writing certificate table: %w", err)
+	}
+	return buffer.Bytes(), errors.Join(errs...)
+}
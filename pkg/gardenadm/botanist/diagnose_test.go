@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Diagnose", func() {
+	var (
+		ctx       context.Context
+		fakeFS    afero.Afero
+		fakeClock *testclock.FakeClock
+
+		b *GardenadmBotanist
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+		fakeClock = testclock.NewFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+		b = &GardenadmBotanist{
+			Botanist: &botanistpkg.Botanist{
+				Operation: &operation.Operation{
+					Logger: logr.Discard(),
+					Clock:  fakeClock,
+				},
+			},
+			FS: fakeFS,
+		}
+
+		DeferCleanup(test.WithVar(&secretsutils.Clock, fakeClock))
+		DeferCleanup(test.WithVar(&JournalLogs, func(_ context.Context, unitName string, since time.Duration) ([]byte, error) {
+			if unitName == "kubelet.service" {
+				return []byte("no journal"), fmt.Errorf("exit status 1")
+			}
+			return []byte(fmt.Sprintf("logs of %s since %s", unitName, since)), nil
+		}))
+	})
+
+	readBundle := func(bundle *bytes.Buffer) map[string]string {
+		gzipReader, err := gzip.NewReader(bundle)
+		Expect(err).NotTo(HaveOccurred())
+
+		files := map[string]string{}
+		tarReader := tar.NewReader(gzipReader)
+		for {
+			header, err := tarReader.Next()
+			if err == io.EOF {
+				break
+			}
+			Expect(err).NotTo(HaveOccurred())
+
+			content, err := io.ReadAll(tarReader)
+			Expect(err).NotTo(HaveOccurred())
+			files[header.Name] = string(content)
+		}
+		return files
+	}
+
+	Describe("#WriteSupportBundle", func() {
+		It("should only contain the logs, the certificates, and the errors if nothing was applied yet", func() {
+			bundle := &bytes.Buffer{}
+			Expect(b.WriteSupportBundle(ctx, bundle, time.Hour)).To(Succeed())
+
+			files := readBundle(bundle)
+			Expect(files).To(HaveLen(4))
+			Expect(files).To(HaveKeyWithValue("logs/gardener-node-agent.log", "logs of gardener-node-agent.service since 1h0m0s"))
+			Expect(files).To(HaveKeyWithValue("logs/kubelet.log", "no journal"))
+			Expect(files).To(HaveKeyWithValue("certificates.txt", "PATH  SUBJECT  NOT AFTER  REMAINING\n"))
+			Expect(files).To(HaveKeyWithValue("errors.txt", "failed fetching journal logs of kubelet.service: exit status 1\n"))
+		})
+
+		It("should contain the redacted operating system config, the static pod manifests, and the certificate expirations", func() {
+			Expect(fakeFS.WriteFile("/var/lib/gardener-node-agent/last-applied-osc.yaml", []byte(`apiVersion: extensions.gardener.cloud/v1alpha1
+kind: OperatingSystemConfig
+metadata:
+  name: osc
+  namespace: kube-system
+spec:
+  type: test
+  purpose: reconcile
+  units:
+  - name: foo.service
+    content: foo
+  files:
+  - path: /etc/foo
+    content:
+      inline:
+        encoding: b64
+        data: c2VjcmV0
+  - path: /var/lib/etcd-main/ca/bundle.crt
+    content:
+      inline:
+        data: certificate-data
+  - path: /var/lib/etcd-main/ca/ca.key
+    content:
+      inline:
+        data: key-data
+status:
+  extensionFiles:
+  - path: /etc/bar
+    content:
+      inline:
+        data: secret
+`), 0600)).To(Succeed())
+
+			etcdManifest := `apiVersion: v1
+kind: Pod
+metadata:
+  name: etcd-main
+  namespace: kube-system
+spec:
+  containers:
+  - name: etcd
+    image: etcd
+  volumes:
+  - name: data
+    hostPath:
+      path: /var/lib/etcd-main/data
+  - name: ca
+    hostPath:
+      path: /var/lib/etcd-main/ca
+`
+			Expect(fakeFS.WriteFile("/etc/kubernetes/manifests/etcd-main.yaml", []byte(etcdManifest), 0600)).To(Succeed())
+
+			ca, err := (&secretsutils.CertificateSecretConfig{Name: "ca", CommonName: "etcd-ca", CertType: secretsutils.CACert, Validity: ptr.To(48 * time.Hour)}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeFS.WriteFile("/var/lib/etcd-main/ca/bundle.crt", ca.CertificatePEM, 0600)).To(Succeed())
+			Expect(fakeFS.WriteFile("/var/lib/etcd-main/ca/ca.key", ca.PrivateKeyPEM, 0600)).To(Succeed())
+
+			kubeletCertificate, err := (&secretsutils.CertificateSecretConfig{Name: "kubelet", CommonName: "system:node:test", CertType: secretsutils.ClientCert, SigningCA: ca, Validity: ptr.To(24 * time.Hour)}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeFS.WriteFile("/var/lib/kubelet/pki/kubelet-client-current.pem", append(kubeletCertificate.CertificatePEM, kubeletCertificate.PrivateKeyPEM...), 0600)).To(Succeed())
+
+			// files which are not part of the operating system config must not be inspected
+			Expect(fakeFS.WriteFile("/var/lib/etcd-main/data/cert.pem", ca.CertificatePEM, 0600)).To(Succeed())
+
+			bundle := &bytes.Buffer{}
+			Expect(b.WriteSupportBundle(ctx, bundle, time.Hour)).To(Succeed())
+
+			files := readBundle(bundle)
+			Expect(files).To(HaveKeyWithValue("manifests/etcd-main.yaml", etcdManifest))
+
+			Expect(files).To(HaveKey("operatingsystemconfig.yaml"))
+			Expect(files["operatingsystemconfig.yaml"]).To(ContainSubstring("content: foo"))
+			Expect(files["operatingsystemconfig.yaml"]).To(ContainSubstring("data: <redacted>"))
+			Expect(files["operatingsystemconfig.yaml"]).NotTo(Or(ContainSubstring("secret"), ContainSubstring("c2VjcmV0"), ContainSubstring("certificate-data"), ContainSubstring("key-data")))
+
+			Expect(files).To(HaveKeyWithValue("certificates.txt", `PATH                                             SUBJECT              NOT AFTER             REMAINING
+/var/lib/etcd-main/ca/bundle.crt                 CN=etcd-ca           2025-01-03T00:00:00Z  48h0m0s
+/var/lib/kubelet/pki/kubelet-client-current.pem  CN=system:node:test  2025-01-02T00:00:00Z  24h0m0s
+`))
+		})
+	})
+})
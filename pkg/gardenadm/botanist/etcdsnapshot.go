@@ -0,0 +1,459 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/spf13/afero"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/gardener/imagevector"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	bootstrapetcd "github.com/gardener/gardener/pkg/component/etcd/bootstrap"
+	"github.com/gardener/gardener/pkg/component/etcd/etcd"
+	etcdconstants "github.com/gardener/gardener/pkg/component/etcd/etcd/constants"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/registry"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+const (
+	// PathEtcdRestoreDir is the directory in which `gardenadm etcd snapshot restore` stages the contents of a snapshot.
+	PathEtcdRestoreDir = GardenadmBaseDir + "/etcd-restore"
+	// PathEtcdRestoreSecrets is the file in which `gardenadm etcd snapshot restore` stages the secrets of the restored
+	// control plane until `gardenadm init` imports them.
+	PathEtcdRestoreSecrets = PathEtcdRestoreDir + "/secrets.yaml"
+
+	snapshotFileNameEtcdMain = "etcd-main.db"
+	snapshotFileNameSecrets  = "secrets.yaml"
+	filePathEtcdctlInImage   = "/usr/local/bin/etcdctl"
+)
+
+var (
+	// EtcdSnapshot streams a snapshot of the etcd listening at the given endpoint.
+	// Exposed for testing.
+	EtcdSnapshot = func(ctx context.Context, endpoint string, tlsConfig *tls.Config) (io.ReadCloser, error) {
+		etcdClient, err := clientv3.New(clientv3.Config{
+			Endpoints:   []string{endpoint},
+			TLS:         tlsConfig,
+			DialTimeout: 10 * time.Second,
+			Logger:      zap.NewNop(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed creating etcd client: %w", err)
+		}
+
+		snapshot, err := etcdClient.Snapshot(ctx)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed requesting snapshot: %w", err), etcdClient.Close())
+		}
+		return &snapshotReadCloser{ReadCloser: snapshot, client: etcdClient}, nil
+	}
+	// RunEtcdctl runs the etcdctl binary at the given path with the given arguments and returns its combined output.
+	// Exposed for testing.
+	RunEtcdctl = func(ctx context.Context, etcdctlPath string, args ...string) ([]byte, error) {
+		command := exec.CommandContext(ctx, etcdctlPath, args...)
+		command.Env = append(os.Environ(), "ETCDCTL_API=3")
+		return command.CombinedOutput()
+	}
+	// NewExtractor returns a registry.Extractor for copying files from container images.
+	// Exposed for testing.
+	NewExtractor = registry.NewExtractor
+)
+
+type snapshotReadCloser struct {
+	io.ReadCloser
+	client *clientv3.Client
+}
+
+func (s *snapshotReadCloser) Close() error {
+	return errors.Join(s.ReadCloser.Close(), s.client.Close())
+}
+
+// SaveEtcdSnapshot takes a snapshot of the main etcd of the control plane running on this machine and writes it as a
+// gzip-compressed tarball to the given writer. Next to the etcd data, the tarball contains the secrets managed by the
+// secrets manager (e.g., the certificate authorities, the service account signing key, and the etcd encryption key),
+// which are needed for restoring the control plane from the snapshot on a new machine. The given client is used for
+// reading the secrets from the cluster.
+// The tarball is not encrypted, so callers must encrypt it (see etcdsnapshot.NewEncryptingWriter) before it leaves
+// the machine.
+// The events etcd is not included, like for all shoot clusters managed by Gardener.
+func (b *GardenadmBotanist) SaveEtcdSnapshot(ctx context.Context, c client.Client, w io.Writer) (err error) {
+	secretList := &corev1.SecretList{}
+	if err := c.List(ctx, secretList, client.InNamespace(metav1.NamespaceSystem), client.MatchingLabels{
+		secretsmanager.LabelKeyManagedBy: secretsmanager.LabelValueSecretsManager,
+	}); err != nil {
+		return fmt.Errorf("failed listing secrets: %w", err)
+	}
+
+	tlsConfig, err := etcdClientTLSConfig(secretList.Items)
+	if err != nil {
+		return err
+	}
+
+	if err := b.FS.MkdirAll(GardenadmBaseDir, 0700); err != nil {
+		return fmt.Errorf("failed creating directory %s: %w", GardenadmBaseDir, err)
+	}
+	// The snapshot is written to a temporary file first because its size must be known for adding it to the tarball.
+	snapshotFile, err := b.FS.TempFile(GardenadmBaseDir, "etcd-snapshot-")
+	if err != nil {
+		return fmt.Errorf("failed creating temporary file for etcd snapshot: %w", err)
+	}
+	defer func() {
+		err = errors.Join(err, snapshotFile.Close(), b.FS.Remove(snapshotFile.Name()))
+	}()
+
+	endpoint := fmt.Sprintf("https://localhost:%d", etcdconstants.PortEtcdClient)
+	b.Logger.Info("Taking etcd snapshot", "endpoint", endpoint)
+
+	snapshot, err := EtcdSnapshot(ctx, endpoint, tlsConfig)
+	if err != nil {
+		return fmt.Errorf("failed taking snapshot of etcd at %s: %w", endpoint, err)
+	}
+	size, err := io.Copy(snapshotFile, snapshot)
+	if err := errors.Join(err, snapshot.Close()); err != nil {
+		return fmt.Errorf("failed receiving snapshot of etcd at %s: %w", endpoint, err)
+	}
+
+	if _, err := snapshotFile.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed rewinding etcd snapshot: %w", err)
+	}
+	if err := verifySnapshotChecksum(snapshotFile, size); err != nil {
+		return err
+	}
+	if _, err := snapshotFile.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed rewinding etcd snapshot: %w", err)
+	}
+
+	secrets, err := marshalSecrets(secretList.Items)
+	if err != nil {
+		return err
+	}
+
+	var (
+		gzipWriter = gzip.NewWriter(w)
+		tarWriter  = tar.NewWriter(gzipWriter)
+		now        = b.Clock.Now()
+	)
+
+	for _, file := range []struct {
+		name    string
+		size    int64
+		content io.Reader
+	}{
+		{snapshotFileNameEtcdMain, size, snapshotFile},
+		{snapshotFileNameSecrets, int64(len(secrets)), bytes.NewReader(secrets)},
+	} {
+		if err := tarWriter.WriteHeader(&tar.Header{Name: file.name, Mode: 0600, Size: file.size, ModTime: now}); err != nil {
+			return fmt.Errorf("failed writing tar header for %s: %w", file.name, err)
+		}
+		if _, err := io.Copy(tarWriter, file.content); err != nil {
+			return fmt.Errorf("failed writing %s to tarball: %w", file.name, err)
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return fmt.Errorf("failed closing tar writer: %w", err)
+	}
+	return gzipWriter.Close()
+}
+
+// RestoreEtcdSnapshot restores the data directory of the main etcd from the given snapshot written by SaveEtcdSnapshot.
+// It uses the etcdctl binary of the etcd image for this. The secrets contained in the snapshot are staged in
+// PathEtcdRestoreSecrets, so that a subsequent `gardenadm init` brings up the control plane with the same secrets.
+func (b *GardenadmBotanist) RestoreEtcdSnapshot(ctx context.Context, r io.Reader) error {
+	dataDir := bootstrapetcd.DataDir(v1beta1constants.ETCDRoleMain)
+	if err := b.ensureNoEtcdData(dataDir); err != nil {
+		return err
+	}
+
+	if err := b.FS.MkdirAll(PathEtcdRestoreDir, 0700); err != nil {
+		return fmt.Errorf("failed creating directory %s: %w", PathEtcdRestoreDir, err)
+	}
+
+	snapshotPath := path.Join(PathEtcdRestoreDir, snapshotFileNameEtcdMain)
+	if err := b.extractSnapshot(r, snapshotPath); err != nil {
+		return err
+	}
+
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameEtcd)
+	if err != nil {
+		return fmt.Errorf("failed finding image %s: %w", imagevector.ContainerImageNameEtcd, err)
+	}
+	// The extractor mounts the image in a temporary directory of gardener-node-agent, which does not exist yet on a new
+	// machine.
+	if err := b.FS.MkdirAll(nodeagentconfigv1alpha1.TempDir, os.ModeDir); err != nil {
+		return fmt.Errorf("failed creating temporary directory %s: %w", nodeagentconfigv1alpha1.TempDir, err)
+	}
+	etcdctlPath := path.Join(PathEtcdRestoreDir, "etcdctl")
+	if err := NewExtractor().CopyFromImage(ctx, image.String(), filePathEtcdctlInImage, etcdctlPath, 0755); err != nil {
+		return fmt.Errorf("failed copying etcdctl from image %s: %w", image.String(), err)
+	}
+
+	if err := b.FS.MkdirAll(path.Dir(dataDir), 0700); err != nil {
+		return fmt.Errorf("failed creating directory %s: %w", path.Dir(dataDir), err)
+	}
+
+	// The member name and peer URL must match the bootstrap etcd started by `gardenadm init`.
+	var (
+		memberName = bootstrapetcd.Name(v1beta1constants.ETCDRoleMain)
+		peerURL    = fmt.Sprintf("https://localhost:%d", etcdconstants.PortEtcdPeer)
+	)
+
+	b.Logger.Info("Restoring etcd data directory from snapshot", "dataDir", dataDir)
+	if output, err := RunEtcdctl(ctx, etcdctlPath, "snapshot", "restore", snapshotPath,
+		"--data-dir="+dataDir,
+		"--name="+memberName,
+		"--initial-cluster="+memberName+"="+peerURL,
+		"--initial-advertise-peer-urls="+peerURL,
+	); err != nil {
+		return fmt.Errorf("failed restoring etcd snapshot: %w: %s", err, output)
+	}
+
+	for _, file := range []string{snapshotPath, etcdctlPath} {
+		if err := b.FS.Remove(file); err != nil {
+			return fmt.Errorf("failed removing %s: %w", file, err)
+		}
+	}
+
+	return nil
+}
+
+// ensureNoEtcdData fails if the given etcd data directory contains data. An empty directory is removed since etcdctl
+// refuses to restore into an existing directory.
+func (b *GardenadmBotanist) ensureNoEtcdData(dataDir string) error {
+	exists, err := b.FS.DirExists(dataDir)
+	if err != nil {
+		return fmt.Errorf("failed checking whether etcd data directory %s exists: %w", dataDir, err)
+	}
+	if !exists {
+		return nil
+	}
+
+	empty, err := b.FS.IsEmpty(dataDir)
+	if err != nil {
+		return fmt.Errorf("failed checking whether etcd data directory %s is empty: %w", dataDir, err)
+	}
+	if !empty {
+		return fmt.Errorf("etcd data directory %s already contains data, run 'gardenadm reset' first", dataDir)
+	}
+
+	if err := b.FS.Remove(dataDir); err != nil {
+		return fmt.Errorf("failed removing empty etcd data directory %s: %w", dataDir, err)
+	}
+	return nil
+}
+
+func (b *GardenadmBotanist) extractSnapshot(r io.Reader, snapshotPath string) error {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("failed reading snapshot: %w", err)
+	}
+
+	found := map[string]bool{}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed reading snapshot: %w", err)
+		}
+
+		switch header.Name {
+		case snapshotFileNameEtcdMain:
+			if err := b.writeFile(snapshotPath, tarReader); err != nil {
+				return err
+			}
+		case snapshotFileNameSecrets:
+			content, err := io.ReadAll(tarReader)
+			if err != nil {
+				return fmt.Errorf("failed reading %s from snapshot: %w", header.Name, err)
+			}
+			if err := yaml.Unmarshal(content, &corev1.SecretList{}); err != nil {
+				return fmt.Errorf("failed decoding %s from snapshot: %w", header.Name, err)
+			}
+			if err := b.FS.WriteFile(PathEtcdRestoreSecrets, content, 0600); err != nil {
+				return fmt.Errorf("failed writing %s: %w", PathEtcdRestoreSecrets, err)
+			}
+		default:
+			continue
+		}
+		found[header.Name] = true
+	}
+
+	for _, name := range []string{snapshotFileNameEtcdMain, snapshotFileNameSecrets} {
+		if !found[name] {
+			return fmt.Errorf("snapshot does not contain %s", name)
+		}
+	}
+	return nil
+}
+
+func (b *GardenadmBotanist) writeFile(filePath string, r io.Reader) error {
+	file, err := b.FS.OpenFile(filePath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed creating %s: %w", filePath, err)
+	}
+	if _, err := io.Copy(file, r); err != nil {
+		return errors.Join(fmt.Errorf("failed writing %s: %w", filePath, err), file.Close())
+	}
+	return file.Close()
+}
+
+// ImportRestoredSecrets creates the secrets staged by RestoreEtcdSnapshot, if any, so that the secrets manager reuses
+// them instead of generating new ones. It must be called before the secrets management is initialized.
+func (b *GardenadmBotanist) ImportRestoredSecrets(ctx context.Context) error {
+	content, err := b.FS.ReadFile(PathEtcdRestoreSecrets)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed reading %s: %w", PathEtcdRestoreSecrets, err)
+	}
+
+	secretList := &corev1.SecretList{}
+	if err := yaml.Unmarshal(content, secretList); err != nil {
+		return fmt.Errorf("failed decoding %s: %w", PathEtcdRestoreSecrets, err)
+	}
+
+	b.Logger.Info("Importing secrets from restored etcd snapshot", "count", len(secretList.Items))
+	for _, secret := range secretList.Items {
+		if err := b.SeedClientSet.Client().Create(ctx, &secret); err != nil && !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed creating secret %s: %w", client.ObjectKeyFromObject(&secret), err)
+		}
+	}
+
+	return nil
+}
+
+// FinalizeEtcdRestore removes the contents of the snapshot staged by RestoreEtcdSnapshot after the control plane has
+// been brought up with them.
+func (b *GardenadmBotanist) FinalizeEtcdRestore(_ context.Context) error {
+	if err := b.FS.RemoveAll(PathEtcdRestoreDir); err != nil {
+		return fmt.Errorf("failed removing %s: %w", PathEtcdRestoreDir, err)
+	}
+	return nil
+}
+
+// etcdClientTLSConfig returns the TLS configuration for connecting to etcd based on the most recently issued client
+// certificate and CA bundle in the given secrets.
+func etcdClientTLSConfig(secrets []corev1.Secret) (*tls.Config, error) {
+	clientSecret := latestSecret(secrets, etcd.SecretNameClient)
+	if clientSecret == nil {
+		return nil, fmt.Errorf("secret for etcd client certificate %q not found", etcd.SecretNameClient)
+	}
+	caBundleSecret := latestSecret(secrets, v1beta1constants.SecretNameCAETCD+"-bundle")
+	if caBundleSecret == nil {
+		return nil, fmt.Errorf("secret for etcd CA bundle %q not found", v1beta1constants.SecretNameCAETCD+"-bundle")
+	}
+
+	certificate, err := tls.X509KeyPair(clientSecret.Data[secretsutils.DataKeyCertificate], clientSecret.Data[secretsutils.DataKeyPrivateKey])
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing etcd client certificate: %w", err)
+	}
+
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(caBundleSecret.Data[secretsutils.DataKeyCertificateBundle]) {
+		return nil, fmt.Errorf("failed parsing etcd CA bundle")
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		RootCAs:      caPool,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// latestSecret returns the most recently issued secret with the given name label. Multiple secrets exist during
+// certificate rotations.
+func latestSecret(secrets []corev1.Secret, name string) *corev1.Secret {
+	var (
+		latest         *corev1.Secret
+		latestIssuedAt int64
+	)
+
+	for i, secret := range secrets {
+		if secret.Labels[secretsmanager.LabelKeyName] != name {
+			continue
+		}
+
+		issuedAt, _ := strconv.ParseInt(secret.Labels[secretsmanager.LabelKeyIssuedAtTime], 10, 64)
+		if latest == nil || issuedAt > latestIssuedAt {
+			latest, latestIssuedAt = &secrets[i], issuedAt
+		}
+	}
+
+	return latest
+}
+
+// verifySnapshotChecksum verifies the SHA-256 checksum which etcd appends to the snapshot stream.
+func verifySnapshotChecksum(r io.Reader, size int64) error {
+	// 512 is the minimum disk sector size etcd pads the snapshot to, see go.etcd.io/etcd/client/v3/snapshot.
+	if size%512 != sha256.Size {
+		return fmt.Errorf("etcd snapshot does not contain a checksum (size %d bytes)", size)
+	}
+
+	hash := sha256.New()
+	if _, err := io.CopyN(hash, r, size-sha256.Size); err != nil {
+		return fmt.Errorf("failed computing checksum of etcd snapshot: %w", err)
+	}
+	checksum := make([]byte, sha256.Size)
+	if _, err := io.ReadFull(r, checksum); err != nil {
+		return fmt.Errorf("failed reading checksum of etcd snapshot: %w", err)
+	}
+
+	if !bytes.Equal(hash.Sum(nil), checksum) {
+		return fmt.Errorf("checksum of etcd snapshot does not match")
+	}
+	return nil
+}
+
+func marshalSecrets(secrets []corev1.Secret) ([]byte, error) {
+	secretList := &corev1.SecretList{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "SecretList"}}
+	for _, secret := range secrets {
+		secretList.Items = append(secretList.Items, corev1.Secret{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        secret.Name,
+				Namespace:   secret.Namespace,
+				Labels:      secret.Labels,
+				Annotations: secret.Annotations,
+			},
+			Type:      secret.Type,
+			Immutable: secret.Immutable,
+			Data:      secret.Data,
+		})
+	}
+
+	raw, err := yaml.Marshal(secretList)
+	if err != nil {
+		return nil, fmt.Errorf("failed marshalling secrets: %w", err)
+	}
+	return raw, nil
+}
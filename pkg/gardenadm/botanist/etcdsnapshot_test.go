@@ -0,0 +1,315 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	. "github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	"github.com/gardener/gardener/pkg/nodeagent/registry"
+	fakeregistry "github.com/gardener/gardener/pkg/nodeagent/registry/fake"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("EtcdSnapshot", func() {
+	var (
+		ctx        context.Context
+		fakeFS     afero.Afero
+		fakeClient client.Client
+
+		snapshotData []byte
+
+		b *GardenadmBotanist
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		// etcd pads the snapshot to a multiple of 512 bytes and appends its SHA-256 checksum.
+		snapshotData = []byte(strings.Repeat("x", 1024))
+		checksum := sha256.Sum256(snapshotData)
+		snapshotData = append(snapshotData, checksum[:]...)
+
+		b = &GardenadmBotanist{
+			Botanist: &botanistpkg.Botanist{
+				Operation: &operation.Operation{
+					Logger: logr.Discard(),
+					Clock:  testclock.NewFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)),
+					SeedClientSet: fakekubernetes.
+						NewClientSetBuilder().
+						WithClient(fakeClient).
+						WithRESTConfig(&rest.Config{}).
+						Build(),
+				},
+			},
+			FS: fakeFS,
+		}
+	})
+
+	newManagedSecret := func(name, issuedAt string, data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name + "-" + issuedAt,
+				Namespace: "kube-system",
+				Labels: map[string]string{
+					"name":           name,
+					"managed-by":     "secrets-manager",
+					"issued-at-time": issuedAt,
+				},
+			},
+			Data: data,
+		}
+	}
+
+	createEtcdSecrets := func() {
+		ca, err := (&secretsutils.CertificateSecretConfig{Name: "ca-etcd", CommonName: "etcd", CertType: secretsutils.CACert}).GenerateCertificate()
+		Expect(err).NotTo(HaveOccurred())
+
+		oldClient, err := (&secretsutils.CertificateSecretConfig{Name: "etcd-client", CommonName: "old-client", CertType: secretsutils.ClientCert, SigningCA: ca}).GenerateCertificate()
+		Expect(err).NotTo(HaveOccurred())
+		newClient, err := (&secretsutils.CertificateSecretConfig{Name: "etcd-client", CommonName: "new-client", CertType: secretsutils.ClientCert, SigningCA: ca}).GenerateCertificate()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeClient.Create(ctx, newManagedSecret("ca-etcd-bundle", "100", map[string][]byte{"bundle.crt": ca.CertificatePEM}))).To(Succeed())
+		Expect(fakeClient.Create(ctx, newManagedSecret("etcd-client", "100", oldClient.SecretData()))).To(Succeed())
+		Expect(fakeClient.Create(ctx, newManagedSecret("etcd-client", "200", newClient.SecretData()))).To(Succeed())
+		Expect(fakeClient.Create(ctx, newManagedSecret("service-account-key", "100", map[string][]byte{"id_rsa": []byte("key")}))).To(Succeed())
+		Expect(fakeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "unmanaged", Namespace: "kube-system"}})).To(Succeed())
+	}
+
+	readSnapshot := func(snapshot *bytes.Buffer) map[string]string {
+		gzipReader, err := gzip.NewReader(bytes.NewReader(snapshot.Bytes()))
+		Expect(err).NotTo(HaveOccurred())
+
+		files := map[string]string{}
+		tarReader := tar.NewReader(gzipReader)
+		for {
+			header, err := tarReader.Next()
+			if err == io.EOF {
+				break
+			}
+			Expect(err).NotTo(HaveOccurred())
+
+			content, err := io.ReadAll(tarReader)
+			Expect(err).NotTo(HaveOccurred())
+			files[header.Name] = string(content)
+		}
+		return files
+	}
+
+	Describe("#SaveEtcdSnapshot", func() {
+		var (
+			endpoint  string
+			tlsConfig *tls.Config
+		)
+
+		BeforeEach(func() {
+			endpoint, tlsConfig = "", nil
+
+			DeferCleanup(test.WithVar(&EtcdSnapshot, func(_ context.Context, e string, t *tls.Config) (io.ReadCloser, error) {
+				endpoint, tlsConfig = e, t
+				return io.NopCloser(bytes.NewReader(snapshotData)), nil
+			}))
+		})
+
+		It("should write the etcd snapshot and the managed secrets", func() {
+			createEtcdSecrets()
+
+			snapshot := &bytes.Buffer{}
+			Expect(b.SaveEtcdSnapshot(ctx, fakeClient, snapshot)).To(Succeed())
+
+			Expect(endpoint).To(Equal("https://localhost:2379"))
+			Expect(tlsConfig.Certificates).To(HaveLen(1))
+			Expect(tlsConfig.Certificates[0].Leaf.Subject.CommonName).To(Equal("new-client"))
+
+			files := readSnapshot(snapshot)
+			Expect(files).To(HaveLen(2))
+			Expect(files).To(HaveKeyWithValue("etcd-main.db", string(snapshotData)))
+			Expect(files).To(HaveKey("secrets.yaml"))
+			Expect(files["secrets.yaml"]).To(And(
+				ContainSubstring("kind: SecretList"),
+				ContainSubstring("name: ca-etcd-bundle-100"),
+				ContainSubstring("name: etcd-client-100"),
+				ContainSubstring("name: etcd-client-200"),
+				ContainSubstring("name: service-account-key-100"),
+				Not(ContainSubstring("unmanaged")),
+				Not(ContainSubstring("resourceVersion")),
+			))
+
+			entries, err := fakeFS.ReadDir(GardenadmBaseDir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entries).To(BeEmpty(), "temporary snapshot file should be removed")
+		})
+
+		It("should fail if the etcd client certificate does not exist", func() {
+			Expect(b.SaveEtcdSnapshot(ctx, fakeClient, &bytes.Buffer{})).To(MatchError(`secret for etcd client certificate "etcd-client" not found`))
+		})
+
+		It("should fail if the checksum of the snapshot does not match", func() {
+			createEtcdSecrets()
+			snapshotData[0] = 'y'
+
+			Expect(b.SaveEtcdSnapshot(ctx, fakeClient, &bytes.Buffer{})).To(MatchError("checksum of etcd snapshot does not match"))
+		})
+
+		It("should fail if the snapshot does not contain a checksum", func() {
+			createEtcdSecrets()
+			snapshotData = snapshotData[:1024]
+
+			Expect(b.SaveEtcdSnapshot(ctx, fakeClient, &bytes.Buffer{})).To(MatchError("etcd snapshot does not contain a checksum (size 1024 bytes)"))
+		})
+	})
+
+	Describe("#RestoreEtcdSnapshot", func() {
+		var (
+			snapshot    *bytes.Buffer
+			etcdctlArgs []string
+		)
+
+		BeforeEach(func() {
+			createEtcdSecrets()
+
+			DeferCleanup(test.WithVar(&EtcdSnapshot, func(context.Context, string, *tls.Config) (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewReader(snapshotData)), nil
+			}))
+			snapshot = &bytes.Buffer{}
+			Expect(b.SaveEtcdSnapshot(ctx, fakeClient, snapshot)).To(Succeed())
+
+			Expect(fakeFS.WriteFile("/image/usr/local/bin/etcdctl", []byte("etcdctl"), 0755)).To(Succeed())
+			DeferCleanup(test.WithVar(&NewExtractor, func() registry.Extractor { return fakeregistry.NewExtractor(fakeFS, "/image") }))
+
+			etcdctlArgs = nil
+			DeferCleanup(test.WithVar(&RunEtcdctl, func(_ context.Context, etcdctlPath string, args ...string) ([]byte, error) {
+				Expect(fakeFS.ReadFile(etcdctlPath)).To(Equal([]byte("etcdctl")))
+				Expect(fakeFS.ReadFile(args[2])).To(Equal(snapshotData))
+
+				etcdctlArgs = args
+				return nil, fakeFS.MkdirAll("/var/lib/etcd-main/data/new.etcd/member", 0700)
+			}))
+		})
+
+		It("should restore the data directory and stage the secrets", func() {
+			Expect(b.RestoreEtcdSnapshot(ctx, snapshot)).To(Succeed())
+
+			Expect(etcdctlArgs).To(Equal([]string{
+				"snapshot", "restore", "/var/lib/gardenadm/etcd-restore/etcd-main.db",
+				"--data-dir=/var/lib/etcd-main/data/new.etcd",
+				"--name=etcd-bootstrap-main",
+				"--initial-cluster=etcd-bootstrap-main=https://localhost:2380",
+				"--initial-advertise-peer-urls=https://localhost:2380",
+			}))
+
+			Expect(fakeFS.DirExists("/var/lib/etcd-main/data/new.etcd/member")).To(BeTrue())
+			Expect(fakeFS.ReadFile("/var/lib/gardenadm/etcd-restore/secrets.yaml")).To(ContainSubstring("name: service-account-key-100"))
+			Expect(fakeFS.Exists("/var/lib/gardenadm/etcd-restore/etcd-main.db")).To(BeFalse())
+			Expect(fakeFS.Exists("/var/lib/gardenadm/etcd-restore/etcdctl")).To(BeFalse())
+		})
+
+		It("should restore into an empty data directory", func() {
+			Expect(fakeFS.MkdirAll("/var/lib/etcd-main/data/new.etcd", 0700)).To(Succeed())
+
+			Expect(b.RestoreEtcdSnapshot(ctx, snapshot)).To(Succeed())
+			Expect(etcdctlArgs).NotTo(BeEmpty())
+		})
+
+		It("should fail if the data directory already contains data", func() {
+			Expect(fakeFS.MkdirAll("/var/lib/etcd-main/data/new.etcd/member", 0700)).To(Succeed())
+
+			Expect(b.RestoreEtcdSnapshot(ctx, snapshot)).To(MatchError("etcd data directory /var/lib/etcd-main/data/new.etcd already contains data, run 'gardenadm reset' first"))
+			Expect(etcdctlArgs).To(BeEmpty())
+		})
+
+		It("should fail if the snapshot does not contain the secrets", func() {
+			snapshot = &bytes.Buffer{}
+			gzipWriter := gzip.NewWriter(snapshot)
+			tarWriter := tar.NewWriter(gzipWriter)
+			Expect(tarWriter.WriteHeader(&tar.Header{Name: "etcd-main.db", Mode: 0600, Size: int64(len(snapshotData))})).To(Succeed())
+			_, err := tarWriter.Write(snapshotData)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tarWriter.Close()).To(Succeed())
+			Expect(gzipWriter.Close()).To(Succeed())
+
+			Expect(b.RestoreEtcdSnapshot(ctx, snapshot)).To(MatchError("snapshot does not contain secrets.yaml"))
+			Expect(etcdctlArgs).To(BeEmpty())
+		})
+
+		It("should fail if etcdctl fails", func() {
+			DeferCleanup(test.WithVar(&RunEtcdctl, func(context.Context, string, ...string) ([]byte, error) {
+				return []byte("snapshot file has wrong checksum"), fmt.Errorf("exit status 1")
+			}))
+
+			Expect(b.RestoreEtcdSnapshot(ctx, snapshot)).To(MatchError("failed restoring etcd snapshot: exit status 1: snapshot file has wrong checksum"))
+		})
+	})
+
+	Describe("#ImportRestoredSecrets", func() {
+		It("should do nothing if no secrets were staged", func() {
+			Expect(b.ImportRestoredSecrets(ctx)).To(Succeed())
+
+			secretList := &corev1.SecretList{}
+			Expect(fakeClient.List(ctx, secretList)).To(Succeed())
+			Expect(secretList.Items).To(BeEmpty())
+		})
+
+		It("should create the staged secrets", func() {
+			Expect(fakeFS.WriteFile(PathEtcdRestoreSecrets, []byte(`apiVersion: v1
+kind: SecretList
+items:
+- apiVersion: v1
+  kind: Secret
+  metadata:
+    name: ca-100
+    namespace: kube-system
+    labels:
+      managed-by: secrets-manager
+  data:
+    ca.crt: Y2E=
+`), 0600)).To(Succeed())
+
+			Expect(b.ImportRestoredSecrets(ctx)).To(Succeed())
+
+			secret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "ca-100", Namespace: "kube-system"}, secret)).To(Succeed())
+			Expect(secret.Labels).To(HaveKeyWithValue("managed-by", "secrets-manager"))
+			Expect(secret.Data).To(HaveKeyWithValue("ca.crt", []byte("ca")))
+		})
+	})
+
+	Describe("#FinalizeEtcdRestore", func() {
+		It("should remove the staged snapshot contents", func() {
+			Expect(fakeFS.WriteFile(PathEtcdRestoreSecrets, []byte("secrets"), 0600)).To(Succeed())
+
+			Expect(b.FinalizeEtcdRestore(ctx)).To(Succeed())
+			Expect(fakeFS.DirExists(PathEtcdRestoreDir)).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,285 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/rest"
+
+	etcdconstants "github.com/gardener/gardener/pkg/component/etcd/etcd/constants"
+	kubeapiserverconstants "github.com/gardener/gardener/pkg/component/kubernetes/apiserver/constants"
+)
+
+// Names of the preflight checks. They can be passed to the --skip-preflight-checks flag.
+const (
+	// PreflightCheckAll can be used to skip all preflight checks.
+	PreflightCheckAll = "all"
+	// PreflightCheckPorts checks that the ports used by kubelet and the control plane components are not in use.
+	PreflightCheckPorts = "ports"
+	// PreflightCheckSwap checks that swap is disabled.
+	PreflightCheckSwap = "swap"
+	// PreflightCheckCgroupDriver checks that the machine supports the systemd cgroup driver used by kubelet and
+	// containerd.
+	PreflightCheckCgroupDriver = "cgroup-driver"
+	// PreflightCheckKernelModules checks that the kernel modules required by containerd and the pod network are loaded.
+	PreflightCheckKernelModules = "kernel-modules"
+	// PreflightCheckAPIServer checks that the API server of the control plane is reachable.
+	PreflightCheckAPIServer = "api-server"
+	// PreflightCheckClockSkew checks that the clock of the machine does not deviate too much from the clock of the
+	// control plane.
+	PreflightCheckClockSkew = "clock-skew"
+)
+
+// PreflightCheckNames are the names of all preflight checks.
+var PreflightCheckNames = []string{
+	PreflightCheckPorts,
+	PreflightCheckSwap,
+	PreflightCheckCgroupDriver,
+	PreflightCheckKernelModules,
+	PreflightCheckAPIServer,
+	PreflightCheckClockSkew,
+}
+
+const (
+	portKubelet               = 10250
+	portKubeControllerManager = 10257
+	portKubeScheduler         = 10259
+)
+
+var (
+	// Listen is used for checking whether a port is available.
+	// Exposed for testing.
+	Listen = net.Listen
+	// MaxClockSkew is the maximum tolerated difference between the clock of the machine and the clock of the control
+	// plane.
+	MaxClockSkew = 30 * time.Second
+)
+
+// PreflightCheck verifies a prerequisite of the machine before `gardenadm init` or `gardenadm join` change anything on
+// it.
+type PreflightCheck struct {
+	// Name is the name of the check, see the PreflightCheck* constants.
+	Name string
+	// Fn returns an error if the prerequisite is not met.
+	Fn func(ctx context.Context) error
+}
+
+// PreflightChecks returns the preflight checks for this machine. If controlPlane is true, the machine is checked for
+// hosting control plane components as well. If a control plane address is given (i.e., for `gardenadm join`), the
+// machine is checked for reaching the API server and for clock skew. The certificate authority is used for verifying
+// the serving certificate of the API server. If it is empty, the system's trust store is used.
+func (b *GardenadmBotanist) PreflightChecks(controlPlane bool, controlPlaneAddress string, certificateAuthority []byte) []PreflightCheck {
+	ports := []int{portKubelet}
+	if controlPlane {
+		ports = append(ports,
+			kubeapiserverconstants.Port,
+			int(etcdconstants.PortEtcdClient), int(etcdconstants.PortEtcdPeer),
+			int(etcdconstants.StaticPodPortEtcdEventsClient), int(etcdconstants.StaticPodPortEtcdEventsPeer),
+			portKubeControllerManager,
+			portKubeScheduler,
+		)
+	}
+
+	checks := []PreflightCheck{
+		{Name: PreflightCheckPorts, Fn: func(_ context.Context) error { return checkPortsAvailable(ports) }},
+		{Name: PreflightCheckSwap, Fn: func(_ context.Context) error { return checkSwapDisabled(b.FS) }},
+		{Name: PreflightCheckCgroupDriver, Fn: func(_ context.Context) error { return checkSystemdCgroupDriver(b.FS) }},
+		{Name: PreflightCheckKernelModules, Fn: func(_ context.Context) error { return checkKernelModules(b.FS) }},
+	}
+
+	if controlPlaneAddress != "" {
+		checks = append(checks,
+			PreflightCheck{Name: PreflightCheckAPIServer, Fn: func(ctx context.Context) error {
+				_, err := requestAPIServerDate(ctx, controlPlaneAddress, certificateAuthority)
+				return err
+			}},
+			PreflightCheck{Name: PreflightCheckClockSkew, Fn: func(ctx context.Context) error {
+				return b.checkClockSkew(ctx, controlPlaneAddress, certificateAuthority)
+			}},
+		)
+	}
+
+	return checks
+}
+
+// RunPreflightChecks runs the given preflight checks except for the skipped ones. It runs all checks even if some of
+// them fail, so that all problems are reported at once.
+func (b *GardenadmBotanist) RunPreflightChecks(ctx context.Context, checks []PreflightCheck, skip []string) error {
+	var (
+		skipSet = sets.New(skip...)
+		failed  []string
+		errs    []error
+	)
+
+	for _, check := range checks {
+		log := b.Logger.WithValues("check", check.Name)
+
+		if skipSet.HasAny(check.Name, PreflightCheckAll) {
+			log.Info("Skipping preflight check")
+			continue
+		}
+
+		if err := check.Fn(ctx); err != nil {
+			log.Info("Preflight check failed", "error", err.Error())
+			failed = append(failed, check.Name)
+			errs = append(errs, fmt.Errorf("[%s] %w", check.Name, err))
+			continue
+		}
+
+		log.V(1).Info("Preflight check succeeded")
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("preflight checks failed, fix the problems or skip the checks with --skip-preflight-checks=%s:\n%w", strings.Join(failed, ","), errors.Join(errs...))
+	}
+
+	return nil
+}
+
+func checkPortsAvailable(ports []int) error {
+	var inUse []string
+	for _, port := range ports {
+		listener, err := Listen("tcp", net.JoinHostPort("", strconv.Itoa(port)))
+		if err != nil {
+			inUse = append(inUse, strconv.Itoa(port))
+			continue
+		}
+		if err := listener.Close(); err != nil {
+			return fmt.Errorf("failed closing listener for port %d: %w", port, err)
+		}
+	}
+
+	if len(inUse) > 0 {
+		return fmt.Errorf("ports %s are already in use", strings.Join(inUse, ", "))
+	}
+	return nil
+}
+
+func checkSwapDisabled(fs afero.Afero) error {
+	content, err := fs.ReadFile("/proc/swaps")
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed reading /proc/swaps: %w", err)
+	}
+
+	// The first line of /proc/swaps is a header, every further line is an active swap device.
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if len(lines) > 1 {
+		return fmt.Errorf("swap is enabled (%d active swap devices), kubelet does not start with swap enabled by default, disable it with 'swapoff -a'", len(lines)-1)
+	}
+	return nil
+}
+
+func checkSystemdCgroupDriver(fs afero.Afero) error {
+	// See sd_booted(3): systemd is the init system if this directory exists.
+	booted, err := fs.DirExists("/run/systemd/system")
+	if err != nil {
+		return fmt.Errorf("failed checking whether systemd is the init system: %w", err)
+	}
+	if !booted {
+		return fmt.Errorf("systemd is not the init system, but it is required for the systemd cgroup driver used by kubelet and containerd")
+	}
+
+	mounted, err := fs.DirExists("/sys/fs/cgroup")
+	if err != nil {
+		return fmt.Errorf("failed checking whether the cgroup file system is mounted: %w", err)
+	}
+	if !mounted {
+		return fmt.Errorf("the cgroup file system is not mounted at /sys/fs/cgroup")
+	}
+
+	return nil
+}
+
+func checkKernelModules(fs afero.Afero) error {
+	var missing []string
+
+	// The kernel modules might be built into the kernel, hence we check for the features they provide instead of
+	// looking at /proc/modules.
+	filesystems, err := fs.ReadFile("/proc/filesystems")
+	if err != nil {
+		return fmt.Errorf("failed reading /proc/filesystems: %w", err)
+	}
+	if !slices.ContainsFunc(strings.Split(string(filesystems), "\n"), func(line string) bool {
+		fields := strings.Fields(line)
+		return len(fields) > 0 && fields[len(fields)-1] == "overlay"
+	}) {
+		missing = append(missing, "overlay")
+	}
+
+	bridgeNetfilter, err := fs.Exists("/proc/sys/net/bridge/bridge-nf-call-iptables")
+	if err != nil {
+		return fmt.Errorf("failed checking whether br_netfilter is loaded: %w", err)
+	}
+	if !bridgeNetfilter {
+		missing = append(missing, "br_netfilter")
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("kernel modules %s are not loaded, load them with 'modprobe %s'", strings.Join(missing, ", "), strings.Join(missing, " "))
+	}
+	return nil
+}
+
+func (b *GardenadmBotanist) checkClockSkew(ctx context.Context, controlPlaneAddress string, certificateAuthority []byte) error {
+	date, err := requestAPIServerDate(ctx, controlPlaneAddress, certificateAuthority)
+	if err != nil {
+		return err
+	}
+
+	skew := b.Clock.Now().Sub(date)
+	if skew.Abs() > MaxClockSkew {
+		return fmt.Errorf("clock of this machine deviates by %s from the clock of the API server (max. %s), check the time synchronization of the machine", skew.Round(time.Second), MaxClockSkew)
+	}
+	return nil
+}
+
+// requestAPIServerDate sends an unauthenticated request to the API server and returns the time of its response. Any
+// HTTP response (also 401 or 403) proves that the API server is reachable.
+func requestAPIServerDate(ctx context.Context, controlPlaneAddress string, certificateAuthority []byte) (time.Time, error) {
+	httpClient, err := rest.HTTPClientFor(&rest.Config{
+		Host:            controlPlaneAddress,
+		TLSClientConfig: rest.TLSClientConfig{CAData: certificateAuthority},
+		Timeout:         10 * time.Second,
+	})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed creating HTTP client: %w", err)
+	}
+
+	url := controlPlaneAddress
+	if !strings.Contains(url, "://") {
+		url = "https://" + url
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(url, "/")+"/readyz", nil)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed creating request: %w", err)
+	}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("API server at %s is not reachable: %w", controlPlaneAddress, err)
+	}
+	defer response.Body.Close()
+
+	date, err := http.ParseTime(response.Header.Get("Date"))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed parsing Date header of API server response: %w", err)
+	}
+	return date, nil
+}
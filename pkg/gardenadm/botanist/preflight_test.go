@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	testclock "k8s.io/utils/clock/testing"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Preflight", func() {
+	var (
+		ctx       context.Context
+		fakeFS    afero.Afero
+		fakeClock *testclock.FakeClock
+
+		b *GardenadmBotanist
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+		fakeClock = testclock.NewFakeClock(time.Now())
+
+		b = &GardenadmBotanist{
+			Botanist: &botanistpkg.Botanist{
+				Operation: &operation.Operation{
+					Logger: logr.Discard(),
+					Clock:  fakeClock,
+				},
+			},
+			FS: fakeFS,
+		}
+
+		DeferCleanup(test.WithVar(&Listen, func(string, string) (net.Listener, error) {
+			return net.Listen("tcp", "127.0.0.1:0")
+		}))
+	})
+
+	findCheck := func(checks []PreflightCheck, name string) PreflightCheck {
+		for _, check := range checks {
+			if check.Name == name {
+				return check
+			}
+		}
+		Fail("preflight check " + name + " not found")
+		return PreflightCheck{}
+	}
+
+	checkNames := func(checks []PreflightCheck) []string {
+		var names []string
+		for _, check := range checks {
+			names = append(names, check.Name)
+		}
+		return names
+	}
+
+	Describe("#PreflightChecks", func() {
+		It("should only contain the machine checks if no control plane address is given", func() {
+			Expect(checkNames(b.PreflightChecks(false, "", nil))).To(Equal([]string{"ports", "swap", "cgroup-driver", "kernel-modules"}))
+		})
+
+		It("should contain the API server checks if a control plane address is given", func() {
+			Expect(checkNames(b.PreflightChecks(false, "https://api.example.com", nil))).To(Equal([]string{"ports", "swap", "cgroup-driver", "kernel-modules", "api-server", "clock-skew"}))
+		})
+
+		Describe("ports", func() {
+			var listenedPorts []string
+
+			BeforeEach(func() {
+				listenedPorts = nil
+
+				DeferCleanup(test.WithVar(&Listen, func(_, address string) (net.Listener, error) {
+					_, port, err := net.SplitHostPort(address)
+					Expect(err).NotTo(HaveOccurred())
+					listenedPorts = append(listenedPorts, port)
+
+					if port == "443" || port == "2379" {
+						return nil, fmt.Errorf("address already in use")
+					}
+					return net.Listen("tcp", "127.0.0.1:0")
+				}))
+			})
+
+			It("should only check the kubelet port for worker nodes", func() {
+				Expect(findCheck(b.PreflightChecks(false, "", nil), "ports").Fn(ctx)).To(Succeed())
+				Expect(listenedPorts).To(ConsistOf("10250"))
+			})
+
+			It("should check the control plane ports for control plane nodes", func() {
+				Expect(findCheck(b.PreflightChecks(true, "", nil), "ports").Fn(ctx)).To(MatchError("ports 443, 2379 are already in use"))
+				Expect(listenedPorts).To(ConsistOf("10250", "443", "2379", "2380", "2382", "2383", "10257", "10259"))
+			})
+		})
+
+		Describe("swap", func() {
+			It("should succeed if no swap device is active", func() {
+				Expect(fakeFS.WriteFile("/proc/swaps", []byte("Filename\t\t\t\tType\t\tSize\t\tUsed\t\tPriority\n"), 0600)).To(Succeed())
+				Expect(findCheck(b.PreflightChecks(false, "", nil), "swap").Fn(ctx)).To(Succeed())
+			})
+
+			It("should fail if a swap device is active", func() {
+				Expect(fakeFS.WriteFile("/proc/swaps", []byte("Filename\t\t\t\tType\t\tSize\t\tUsed\t\tPriority\n/swap.img\tfile\t\t2097148\t\t0\t\t-2\n"), 0600)).To(Succeed())
+				Expect(findCheck(b.PreflightChecks(false, "", nil), "swap").Fn(ctx)).To(MatchError(ContainSubstring("swap is enabled (1 active swap devices)")))
+			})
+		})
+
+		Describe("cgroup-driver", func() {
+			It("should succeed if systemd is the init system", func() {
+				Expect(fakeFS.MkdirAll("/run/systemd/system", 0755)).To(Succeed())
+				Expect(fakeFS.MkdirAll("/sys/fs/cgroup", 0755)).To(Succeed())
+				Expect(findCheck(b.PreflightChecks(false, "", nil), "cgroup-driver").Fn(ctx)).To(Succeed())
+			})
+
+			It("should fail if systemd is not the init system", func() {
+				Expect(fakeFS.MkdirAll("/sys/fs/cgroup", 0755)).To(Succeed())
+				Expect(findCheck(b.PreflightChecks(false, "", nil), "cgroup-driver").Fn(ctx)).To(MatchError(ContainSubstring("systemd is not the init system")))
+			})
+		})
+
+		Describe("kernel-modules", func() {
+			It("should succeed if the required kernel modules are loaded", func() {
+				Expect(fakeFS.WriteFile("/proc/filesystems", []byte("nodev\tsysfs\n\text4\nnodev\toverlay\n"), 0600)).To(Succeed())
+				Expect(fakeFS.WriteFile("/proc/sys/net/bridge/bridge-nf-call-iptables", []byte("1\n"), 0600)).To(Succeed())
+				Expect(findCheck(b.PreflightChecks(false, "", nil), "kernel-modules").Fn(ctx)).To(Succeed())
+			})
+
+			It("should fail if the required kernel modules are not loaded", func() {
+				Expect(fakeFS.WriteFile("/proc/filesystems", []byte("nodev\tsysfs\n\text4\n"), 0600)).To(Succeed())
+				Expect(findCheck(b.PreflightChecks(false, "", nil), "kernel-modules").Fn(ctx)).To(MatchError("kernel modules overlay, br_netfilter are not loaded, load them with 'modprobe overlay br_netfilter'"))
+			})
+		})
+
+		Describe("api-server and clock-skew", func() {
+			var (
+				server               *httptest.Server
+				certificateAuthority []byte
+			)
+
+			BeforeEach(func() {
+				server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusUnauthorized)
+				}))
+				DeferCleanup(server.Close)
+
+				certificateAuthority = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+			})
+
+			It("should succeed if the API server is reachable and the clocks are in sync", func() {
+				checks := b.PreflightChecks(false, server.URL, certificateAuthority)
+				Expect(findCheck(checks, "api-server").Fn(ctx)).To(Succeed())
+				Expect(findCheck(checks, "clock-skew").Fn(ctx)).To(Succeed())
+			})
+
+			It("should fail if the API server is not reachable", func() {
+				server.Close()
+
+				Expect(findCheck(b.PreflightChecks(false, server.URL, certificateAuthority), "api-server").Fn(ctx)).To(MatchError(ContainSubstring("is not reachable")))
+			})
+
+			It("should fail if the serving certificate of the API server cannot be verified", func() {
+				Expect(findCheck(b.PreflightChecks(false, server.URL, nil), "api-server").Fn(ctx)).To(MatchError(ContainSubstring("is not reachable")))
+			})
+
+			It("should fail if the clock of the machine deviates too much", func() {
+				fakeClock.Step(time.Hour)
+
+				Expect(findCheck(b.PreflightChecks(false, server.URL, certificateAuthority), "clock-skew").Fn(ctx)).To(MatchError(ContainSubstring("clock of this machine deviates by 1h0m")))
+			})
+		})
+	})
+
+	Describe("#RunPreflightChecks", func() {
+		var (
+			executed []string
+			checks   []PreflightCheck
+		)
+
+		BeforeEach(func() {
+			executed = nil
+
+			newCheck := func(name string, err error) PreflightCheck {
+				return PreflightCheck{Name: name, Fn: func(context.Context) error {
+					executed = append(executed, name)
+					return err
+				}}
+			}
+
+			checks = []PreflightCheck{
+				newCheck("ports", fmt.Errorf("port in use")),
+				newCheck("swap", nil),
+				newCheck("kernel-modules", fmt.Errorf("module missing")),
+			}
+		})
+
+		It("should run all checks and report all failures", func() {
+			err := b.RunPreflightChecks(ctx, checks, nil)
+			Expect(err).To(MatchError(ContainSubstring("preflight checks failed, fix the problems or skip the checks with --skip-preflight-checks=ports,kernel-modules")))
+			Expect(err).To(MatchError(ContainSubstring("[ports] port in use\n[kernel-modules] module missing")))
+			Expect(executed).To(Equal([]string{"ports", "swap", "kernel-modules"}))
+		})
+
+		It("should not run skipped checks", func() {
+			Expect(b.RunPreflightChecks(ctx, checks, []string{"ports", "kernel-modules"})).To(Succeed())
+			Expect(executed).To(Equal([]string{"swap"}))
+		})
+
+		It("should not run any check if all checks are skipped", func() {
+			Expect(b.RunPreflightChecks(ctx, checks, []string{"all"})).To(Succeed())
+			Expect(executed).To(BeEmpty())
+		})
+	})
+})
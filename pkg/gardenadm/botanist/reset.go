@@ -0,0 +1,320 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubeletcomponent "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+const (
+	pathSystemdUnits = "/etc/systemd/system"
+	// annotationKeyMirrorPod is the annotation kubelet adds to mirror pods of static pods.
+	annotationKeyMirrorPod = "kubernetes.io/config.mirror"
+)
+
+// DrainPollInterval is the interval in which the pods on a node are checked while draining it. Exposed for testing.
+var DrainPollInterval = 5 * time.Second
+
+// ResetPlan contains everything which is removed from the machine by `gardenadm reset`.
+type ResetPlan struct {
+	// Units are the names of the systemd units which are stopped and disabled. gardener-node-agent is always the first
+	// unit so that it cannot restore any of the other units or files.
+	Units []string
+	// Paths are the files and directories which are deleted. This includes the unit files of the Units.
+	Paths []string
+}
+
+// ComputeResetPlan computes the systemd units and paths which must be removed in order to reset the machine. It is
+// based on the OperatingSystemConfig last applied by gardener-node-agent and the static pod manifests found on the
+// machine. Well-known units and paths are always part of the plan, so that machines on which `gardenadm init` or
+// `gardenadm join` failed early can be reset as well.
+func (b *GardenadmBotanist) ComputeResetPlan() (*ResetPlan, error) {
+	var (
+		units = sets.New(
+			nodeagentconfigv1alpha1.UnitName,
+			nodeagentconfigv1alpha1.InitUnitName,
+			v1beta1constants.OperatingSystemConfigUnitNameKubeletService,
+		)
+		paths = sets.New(
+			nodeagentconfigv1alpha1.BaseDir,
+			path.Join(nodeagentconfigv1alpha1.BinaryDir, "gardener-node-agent"),
+			PathKubeconfig,
+			kubeletcomponent.PathKubeconfigReal,
+			path.Join(kubeletcomponent.PathKubeletDirectory, "pki"),
+			path.Join(GardenadmBaseDir, "shoot-uid"),
+		)
+	)
+
+	osc, err := b.lastAppliedOperatingSystemConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	if osc != nil {
+		for _, unit := range append(osc.Spec.Units, osc.Status.ExtensionUnits...) {
+			// Units without content are provided by the operating system (e.g., containerd.service), only their drop-ins
+			// have been written by gardener-node-agent.
+			if unit.Content != nil {
+				units.Insert(unit.Name)
+			}
+			for _, dropIn := range unit.DropIns {
+				paths.Insert(path.Join(pathSystemdUnits, unit.Name+".d", dropIn.Name))
+			}
+		}
+
+		for _, file := range append(osc.Spec.Files, osc.Status.ExtensionFiles...) {
+			paths.Insert(file.Path)
+		}
+	}
+
+	for unit := range units {
+		paths.Insert(path.Join(pathSystemdUnits, unit), path.Join(pathSystemdUnits, unit+".d"))
+	}
+
+	staticPodPaths, err := b.staticPodPaths()
+	if err != nil {
+		return nil, err
+	}
+	paths.Insert(staticPodPaths...)
+
+	unitNames := sets.List(units.Delete(nodeagentconfigv1alpha1.UnitName, nodeagentconfigv1alpha1.InitUnitName))
+
+	return &ResetPlan{
+		Units: append([]string{nodeagentconfigv1alpha1.UnitName, nodeagentconfigv1alpha1.InitUnitName}, unitNames...),
+		Paths: sets.List(paths),
+	}, nil
+}
+
+func (b *GardenadmBotanist) lastAppliedOperatingSystemConfig() (*extensionsv1alpha1.OperatingSystemConfig, error) {
+	raw, err := b.FS.ReadFile(nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			b.Logger.Info("No OperatingSystemConfig has been applied by gardener-node-agent yet, only well-known units and files are removed", "path", nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading last applied OperatingSystemConfig from %s: %w", nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath, err)
+	}
+
+	osc := &extensionsv1alpha1.OperatingSystemConfig{}
+	if err := runtime.DecodeInto(kubernetes.SeedCodec.UniversalDeserializer(), raw, osc); err != nil {
+		return nil, fmt.Errorf("failed decoding last applied OperatingSystemConfig from %s: %w", nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath, err)
+	}
+	return osc, nil
+}
+
+// staticPodPaths returns the static pod manifests found on the machine as well as the directories of their host path
+// volumes below /var/lib (e.g., the data directory of etcd).
+func (b *GardenadmBotanist) staticPodPaths() ([]string, error) {
+	exists, err := b.FS.DirExists(kubeletcomponent.FilePathKubernetesManifests)
+	if err != nil {
+		return nil, fmt.Errorf("failed checking whether static pod directory %s exists: %w", kubeletcomponent.FilePathKubernetesManifests, err)
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	entries, err := b.FS.ReadDir(kubeletcomponent.FilePathKubernetesManifests)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading static pod directory %s: %w", kubeletcomponent.FilePathKubernetesManifests, err)
+	}
+
+	var (
+		paths              []string
+		protectedHostPaths = sets.New(
+			kubeletcomponent.PathKubeletDirectory,
+			nodeagentconfigv1alpha1.BaseDir,
+			GardenadmBaseDir,
+			"/var/lib/containerd",
+		)
+	)
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		manifestPath := path.Join(kubeletcomponent.FilePathKubernetesManifests, entry.Name())
+		paths = append(paths, manifestPath)
+
+		raw, err := b.FS.ReadFile(manifestPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed reading static pod manifest %s: %w", manifestPath, err)
+		}
+
+		pod := &corev1.Pod{}
+		if err := runtime.DecodeInto(kubernetes.SeedCodec.UniversalDeserializer(), raw, pod); err != nil {
+			b.Logger.Info("Skipping file in static pod directory which does not contain a pod manifest", "path", manifestPath, "error", err.Error())
+			continue
+		}
+
+		for _, volume := range pod.Spec.Volumes {
+			if volume.HostPath == nil {
+				continue
+			}
+
+			// Only consider directories created for the static pod, i.e., /var/lib/<pod-or-volume-name>/...
+			segments := strings.Split(strings.TrimPrefix(path.Clean(volume.HostPath.Path), "/"), "/")
+			if len(segments) < 4 || segments[0] != "var" || segments[1] != "lib" {
+				continue
+			}
+
+			if dir := path.Join("/", segments[0], segments[1], segments[2]); !protectedHostPaths.Has(dir) {
+				paths = append(paths, dir)
+			}
+		}
+	}
+
+	return paths, nil
+}
+
+// StopAndDisableUnits stops and disables the given systemd units. Units which are unknown to systemd are skipped.
+func (b *GardenadmBotanist) StopAndDisableUnits(ctx context.Context, unitNames []string) error {
+	unitStatuses, err := b.DBus.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed listing systemd units: %w", err)
+	}
+
+	knownUnits := sets.New[string]()
+	for _, status := range unitStatuses {
+		knownUnits.Insert(status.Name)
+	}
+
+	for _, unitName := range unitNames {
+		if knownUnits.Has(unitName) {
+			b.Logger.Info("Stopping systemd unit", "unitName", unitName)
+			if err := b.DBus.Stop(ctx, nil, nil, unitName); err != nil {
+				return fmt.Errorf("failed stopping systemd unit %s: %w", unitName, err)
+			}
+		}
+
+		exists, err := b.FS.Exists(path.Join(pathSystemdUnits, unitName))
+		if err != nil {
+			return fmt.Errorf("failed checking whether unit file of systemd unit %s exists: %w", unitName, err)
+		}
+		if !exists {
+			continue
+		}
+
+		b.Logger.Info("Disabling systemd unit", "unitName", unitName)
+		if err := b.DBus.Disable(ctx, unitName); err != nil {
+			return fmt.Errorf("failed disabling systemd unit %s: %w", unitName, err)
+		}
+	}
+
+	return nil
+}
+
+// RemovePaths deletes the given files and directories and reloads the systemd daemon afterwards so that it forgets
+// about the deleted unit files.
+func (b *GardenadmBotanist) RemovePaths(ctx context.Context, paths []string) error {
+	for _, p := range paths {
+		if err := b.FS.RemoveAll(p); err != nil {
+			return fmt.Errorf("failed removing %s: %w", p, err)
+		}
+	}
+
+	return b.DBus.DaemonReload(ctx)
+}
+
+// IsLastNode returns true if the given node is the only node of the cluster.
+func IsLastNode(ctx context.Context, c client.Reader, node *corev1.Node) (bool, error) {
+	nodeList := &metav1.PartialObjectMetadataList{}
+	nodeList.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("NodeList"))
+	if err := c.List(ctx, nodeList); err != nil {
+		return false, fmt.Errorf("failed listing nodes: %w", err)
+	}
+
+	return !slices.ContainsFunc(nodeList.Items, func(item metav1.PartialObjectMetadata) bool {
+		return item.Name != node.Name
+	}), nil
+}
+
+// CordonAndDrainNode marks the given node unschedulable and evicts all pods running on it, except for mirror pods
+// and pods managed by DaemonSets. It waits until all evicted pods are gone or the timeout is reached.
+func CordonAndDrainNode(ctx context.Context, c client.Client, node *corev1.Node, timeout time.Duration) error {
+	if !node.Spec.Unschedulable {
+		patch := client.MergeFrom(node.DeepCopy())
+		node.Spec.Unschedulable = true
+		if err := c.Patch(ctx, node, patch); err != nil {
+			return fmt.Errorf("failed cordoning node %s: %w", node.Name, err)
+		}
+	}
+
+	return retry.UntilTimeout(ctx, DrainPollInterval, timeout, func(ctx context.Context) (bool, error) {
+		podList := &corev1.PodList{}
+		if err := c.List(ctx, podList, client.MatchingFields{"spec.nodeName": node.Name}); err != nil {
+			return retry.SevereError(fmt.Errorf("failed listing pods on node %s: %w", node.Name, err))
+		}
+
+		var remainingPods []string
+		for _, pod := range podList.Items {
+			if !podMustBeEvicted(pod) {
+				continue
+			}
+			remainingPods = append(remainingPods, client.ObjectKeyFromObject(&pod).String())
+
+			if pod.DeletionTimestamp != nil {
+				continue
+			}
+
+			if err := c.SubResource("eviction").Create(ctx, &pod, &policyv1.Eviction{ObjectMeta: metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace}}); err != nil && !apierrors.IsNotFound(err) {
+				// Evictions are rejected with 429 (Too Many Requests) if they would violate a PodDisruptionBudget, hence
+				// they are retried.
+				if apierrors.IsTooManyRequests(err) {
+					continue
+				}
+				return retry.SevereError(fmt.Errorf("failed evicting pod %s: %w", client.ObjectKeyFromObject(&pod), err))
+			}
+		}
+
+		if len(remainingPods) > 0 {
+			return retry.MinorError(fmt.Errorf("waiting for pods to be evicted from node %s: %s", node.Name, strings.Join(remainingPods, ", ")))
+		}
+		return retry.Ok()
+	})
+}
+
+func podMustBeEvicted(pod corev1.Pod) bool {
+	if _, ok := pod.Annotations[annotationKeyMirrorPod]; ok {
+		return false
+	}
+
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+
+	if ownerRef := metav1.GetControllerOf(&pod); ownerRef != nil && ownerRef.Kind == "DaemonSet" {
+		return false
+	}
+
+	return true
+}
+
+// DeleteNode deletes the given node object.
+func DeleteNode(ctx context.Context, c client.Client, node *corev1.Node) error {
+	return client.IgnoreNotFound(c.Delete(ctx, node))
+}
@@ -0,0 +1,287 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"time"
+
+	systemddbus "github.com/coreos/go-systemd/v22/dbus"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reset", func() {
+	var (
+		ctx      context.Context
+		fakeDBus *fakedbus.DBus
+		fakeFS   afero.Afero
+
+		b *GardenadmBotanist
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fakeDBus = fakedbus.New()
+		fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+
+		b = &GardenadmBotanist{
+			Botanist: &botanistpkg.Botanist{
+				Operation: &operation.Operation{
+					Logger: logr.Discard(),
+				},
+			},
+			HostName: "test",
+			DBus:     fakeDBus,
+			FS:       fakeFS,
+		}
+	})
+
+	Describe("#ComputeResetPlan", func() {
+		wellKnownPaths := []string{
+			"/etc/kubernetes/admin.conf",
+			"/etc/systemd/system/gardener-node-agent.service",
+			"/etc/systemd/system/gardener-node-agent.service.d",
+			"/etc/systemd/system/gardener-node-init.service",
+			"/etc/systemd/system/gardener-node-init.service.d",
+			"/etc/systemd/system/kubelet.service",
+			"/etc/systemd/system/kubelet.service.d",
+			"/opt/bin/gardener-node-agent",
+			"/var/lib/gardenadm/shoot-uid",
+			"/var/lib/gardener-node-agent",
+			"/var/lib/kubelet/kubeconfig-real",
+			"/var/lib/kubelet/pki",
+		}
+
+		It("should only contain well-known units and paths if nothing was applied yet", func() {
+			plan, err := b.ComputeResetPlan()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(plan.Units).To(Equal([]string{"gardener-node-agent.service", "gardener-node-init.service", "kubelet.service"}))
+			Expect(plan.Paths).To(ConsistOf(wellKnownPaths))
+		})
+
+		It("should contain the units and files of the last applied operating system config and the static pods", func() {
+			Expect(fakeFS.WriteFile("/var/lib/gardener-node-agent/last-applied-osc.yaml", []byte(`apiVersion: extensions.gardener.cloud/v1alpha1
+kind: OperatingSystemConfig
+metadata:
+  name: osc
+  namespace: kube-system
+spec:
+  type: test
+  purpose: reconcile
+  units:
+  - name: foo.service
+    content: foo
+    dropIns:
+    - name: 10-foo.conf
+      content: foo
+  - name: containerd.service
+    dropIns:
+    - name: 30-env.conf
+      content: bar
+  files:
+  - path: /etc/foo
+    content:
+      inline:
+        data: foo
+status:
+  extensionUnits:
+  - name: bar.service
+    content: bar
+  extensionFiles:
+  - path: /etc/bar
+    content:
+      inline:
+        data: bar
+`), 0600)).To(Succeed())
+
+			Expect(fakeFS.WriteFile("/etc/kubernetes/manifests/etcd-main.yaml", []byte(`apiVersion: v1
+kind: Pod
+metadata:
+  name: etcd-main
+  namespace: kube-system
+spec:
+  containers:
+  - name: etcd
+    image: etcd
+  volumes:
+  - name: data
+    hostPath:
+      path: /var/lib/main-etcd/data
+  - name: kubelet
+    hostPath:
+      path: /var/lib/kubelet/pki
+  - name: ca
+    hostPath:
+      path: /etc/ssl
+`), 0600)).To(Succeed())
+			Expect(fakeFS.WriteFile("/etc/kubernetes/manifests/README", []byte(`not a pod`), 0600)).To(Succeed())
+
+			plan, err := b.ComputeResetPlan()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(plan.Units).To(Equal([]string{"gardener-node-agent.service", "gardener-node-init.service", "bar.service", "foo.service", "kubelet.service"}))
+			Expect(plan.Paths).To(ConsistOf(append(wellKnownPaths,
+				"/etc/systemd/system/foo.service",
+				"/etc/systemd/system/foo.service.d",
+				"/etc/systemd/system/foo.service.d/10-foo.conf",
+				"/etc/systemd/system/bar.service",
+				"/etc/systemd/system/bar.service.d",
+				"/etc/systemd/system/containerd.service.d/30-env.conf",
+				"/etc/foo",
+				"/etc/bar",
+				"/etc/kubernetes/manifests/etcd-main.yaml",
+				"/etc/kubernetes/manifests/README",
+				"/var/lib/main-etcd",
+			)))
+		})
+
+		It("should fail if the last applied operating system config cannot be decoded", func() {
+			Expect(fakeFS.WriteFile("/var/lib/gardener-node-agent/last-applied-osc.yaml", []byte(`{`), 0600)).To(Succeed())
+
+			_, err := b.ComputeResetPlan()
+			Expect(err).To(MatchError(ContainSubstring("failed decoding last applied OperatingSystemConfig")))
+		})
+	})
+
+	Describe("#StopAndDisableUnits", func() {
+		It("should stop loaded units and disable units with unit files", func() {
+			fakeDBus.AddUnitsToList(systemddbus.UnitStatus{Name: "gardener-node-agent.service"}, systemddbus.UnitStatus{Name: "kubelet.service"})
+			Expect(fakeFS.WriteFile("/etc/systemd/system/gardener-node-agent.service", nil, 0600)).To(Succeed())
+			Expect(fakeFS.WriteFile("/etc/systemd/system/foo.service", nil, 0600)).To(Succeed())
+
+			Expect(b.StopAndDisableUnits(ctx, []string{"gardener-node-agent.service", "kubelet.service", "foo.service", "bar.service"})).To(Succeed())
+
+			Expect(fakeDBus.Actions).To(Equal([]fakedbus.SystemdAction{
+				{Action: fakedbus.ActionList},
+				{Action: fakedbus.ActionStop, UnitNames: []string{"gardener-node-agent.service"}},
+				{Action: fakedbus.ActionDisable, UnitNames: []string{"gardener-node-agent.service"}},
+				{Action: fakedbus.ActionStop, UnitNames: []string{"kubelet.service"}},
+				{Action: fakedbus.ActionDisable, UnitNames: []string{"foo.service"}},
+			}))
+		})
+	})
+
+	Describe("#RemovePaths", func() {
+		It("should remove the paths and reload the systemd daemon", func() {
+			Expect(fakeFS.WriteFile("/etc/foo", nil, 0600)).To(Succeed())
+			Expect(fakeFS.WriteFile("/var/lib/bar/data/file", nil, 0600)).To(Succeed())
+			Expect(fakeFS.WriteFile("/etc/keep", nil, 0600)).To(Succeed())
+
+			Expect(b.RemovePaths(ctx, []string{"/etc/foo", "/var/lib/bar", "/does/not/exist"})).To(Succeed())
+
+			Expect(fakeFS.Exists("/etc/foo")).To(BeFalse())
+			Expect(fakeFS.DirExists("/var/lib/bar")).To(BeFalse())
+			Expect(fakeFS.Exists("/etc/keep")).To(BeTrue())
+			Expect(fakeDBus.Actions).To(ConsistOf(fakedbus.SystemdAction{Action: fakedbus.ActionDaemonReload}))
+		})
+	})
+
+	Describe("node removal", func() {
+		var (
+			fakeClient client.Client
+			node       *corev1.Node
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.SeedScheme).
+				WithIndex(&corev1.Pod{}, "spec.nodeName", func(obj client.Object) []string {
+					return []string{obj.(*corev1.Pod).Spec.NodeName}
+				}).
+				Build()
+
+			node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "test"}}
+			Expect(fakeClient.Create(ctx, node)).To(Succeed())
+		})
+
+		Describe("#IsLastNode", func() {
+			It("should return true if there is no other node", func() {
+				Expect(IsLastNode(ctx, fakeClient, node)).To(BeTrue())
+			})
+
+			It("should return false if there are other nodes", func() {
+				Expect(fakeClient.Create(ctx, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "other"}})).To(Succeed())
+				Expect(IsLastNode(ctx, fakeClient, node)).To(BeFalse())
+			})
+		})
+
+		Describe("#CordonAndDrainNode", func() {
+			var pod, mirrorPod, daemonSetPod, otherNodePod *corev1.Pod
+
+			BeforeEach(func() {
+				DeferCleanup(test.WithVar(&DrainPollInterval, time.Millisecond))
+
+				pod = &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default"},
+					Spec:       corev1.PodSpec{NodeName: node.Name},
+				}
+				mirrorPod = &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "mirror", Namespace: "kube-system", Annotations: map[string]string{"kubernetes.io/config.mirror": "hash"}},
+					Spec:       corev1.PodSpec{NodeName: node.Name},
+				}
+				daemonSetPod = &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "daemonset", Namespace: "kube-system", OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: appsv1.SchemeGroupVersion.String(),
+						Kind:       "DaemonSet",
+						Name:       "ds",
+						UID:        "uid",
+						Controller: ptr.To(true),
+					}}},
+					Spec: corev1.PodSpec{NodeName: node.Name},
+				}
+				otherNodePod = &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"},
+					Spec:       corev1.PodSpec{NodeName: "other"},
+				}
+
+				for _, p := range []*corev1.Pod{pod, mirrorPod, daemonSetPod, otherNodePod} {
+					Expect(fakeClient.Create(ctx, p)).To(Succeed())
+				}
+			})
+
+			It("should cordon the node and evict the relevant pods", func() {
+				Expect(CordonAndDrainNode(ctx, fakeClient, node, time.Second)).To(Succeed())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+				Expect(node.Spec.Unschedulable).To(BeTrue())
+
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pod), &corev1.Pod{})).To(BeNotFoundError())
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(mirrorPod), &corev1.Pod{})).To(Succeed())
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(daemonSetPod), &corev1.Pod{})).To(Succeed())
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(otherNodePod), &corev1.Pod{})).To(Succeed())
+			})
+		})
+
+		Describe("#DeleteNode", func() {
+			It("should delete the node", func() {
+				Expect(DeleteNode(ctx, fakeClient, node)).To(Succeed())
+				Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), &corev1.Node{})).To(BeNotFoundError())
+			})
+
+			It("should succeed if the node is already gone", func() {
+				Expect(fakeClient.Delete(ctx, node)).To(Succeed())
+				Expect(DeleteNode(ctx, fakeClient, node)).To(Succeed())
+			})
+		})
+	})
+})
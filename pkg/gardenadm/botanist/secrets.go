@@ -15,7 +15,8 @@ import (
 	"github.com/gardener/gardener/pkg/utils/flow"
 )
 
-// MigrateSecrets exports the secrets generated with the fake client and imports them with the real client.
+// MigrateSecrets exports the secrets generated with the fake client and imports them with the real client. Secrets
+// which already exist are skipped, e.g., when the control plane was restored from an etcd snapshot.
 func (b *GardenadmBotanist) MigrateSecrets(ctx context.Context, fakeClient, realClient client.Client) error {
 	secretList := &corev1.SecretList{}
 	if err := fakeClient.List(ctx, secretList, client.InNamespace(b.Shoot.ControlPlaneNamespace)); err != nil {
@@ -26,7 +27,7 @@ func (b *GardenadmBotanist) MigrateSecrets(ctx context.Context, fakeClient, real
 
 	for _, secret := range secretList.Items {
 		taskFns = append(taskFns, func(ctx context.Context) error {
-			return realClient.Create(ctx, &corev1.Secret{
+			return client.IgnoreAlreadyExists(realClient.Create(ctx, &corev1.Secret{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:        secret.Name,
 					Namespace:   secret.Namespace,
@@ -36,7 +37,7 @@ func (b *GardenadmBotanist) MigrateSecrets(ctx context.Context, fakeClient, real
 				Type:      secret.Type,
 				Immutable: secret.Immutable,
 				Data:      secret.Data,
-			})
+			}))
 		})
 	}
 
@@ -100,5 +100,29 @@ var _ = Describe("Secrets", func() {
 				},
 			))
 		})
+
+		It("should skip secrets which already exist", func() {
+			Expect(fakeClient1.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "s1", Namespace: "kube-system"},
+				Data:       map[string][]byte{"foo": []byte("bar")},
+			})).To(Succeed())
+			Expect(fakeClient1.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "s2", Namespace: "kube-system"},
+				Data:       map[string][]byte{"baz": []byte("bar")},
+			})).To(Succeed())
+			Expect(fakeClient2.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "s1", Namespace: "kube-system"},
+				Data:       map[string][]byte{"foo": []byte("restored")},
+			})).To(Succeed())
+
+			Expect(b.MigrateSecrets(ctx, fakeClient1, fakeClient2)).To(Succeed())
+
+			secretList := &corev1.SecretList{}
+			Expect(fakeClient2.List(ctx, secretList)).To(Succeed())
+			Expect(secretList.Items).To(HaveExactElements(
+				HaveField("Data", map[string][]byte{"foo": []byte("restored")}),
+				HaveField("Data", map[string][]byte{"baz": []byte("bar")}),
+			))
+		})
 	})
 })
@@ -0,0 +1,478 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	druidcorev1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/imagevector"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/component/etcd/etcd"
+	"github.com/gardener/gardener/pkg/component/networking/coredns"
+	"github.com/gardener/gardener/pkg/gardenadm/staticpod"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	operatingsystemconfigcontroller "github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+// imageNone is displayed in the upgrade plan for components which are not deployed yet.
+const imageNone = "<none>"
+
+// NodeUpdatePollInterval is the interval in which the state of a node is checked while it is updated. Exposed for
+// testing.
+var NodeUpdatePollInterval = 5 * time.Second
+
+// UpgradePlan contains the versions which are rolled out by `gardenadm upgrade apply`.
+type UpgradePlan struct {
+	// CurrentKubernetesVersion is the Kubernetes version of the running control plane.
+	CurrentKubernetesVersion *semver.Version
+	// TargetKubernetesVersion is the Kubernetes version specified in the Shoot manifest.
+	TargetKubernetesVersion *semver.Version
+	// Components contains the images of the control plane components.
+	Components []ComponentUpgrade
+	// Extensions contains the charts of the extensions.
+	Extensions []ExtensionUpgrade
+	// Nodes contains the nodes in the order in which they are updated.
+	Nodes []NodeUpgrade
+}
+
+// ComponentUpgrade contains the current and the target image of a control plane component.
+type ComponentUpgrade struct {
+	Name         string
+	CurrentImage string
+	TargetImage  string
+}
+
+// ExtensionUpgrade contains the target chart of an extension.
+type ExtensionUpgrade struct {
+	Name        string
+	Installed   bool
+	TargetChart string
+}
+
+// NodeUpgrade contains the current state of a node.
+type NodeUpgrade struct {
+	Name           string
+	WorkerPool     string
+	KubeletVersion string
+	// UpToDate is true if the node has already applied the desired operating system config.
+	UpToDate bool
+}
+
+type upgradeComponent struct {
+	name      string
+	namespace string
+	object    client.Object
+	imageName string
+}
+
+func (b *GardenadmBotanist) upgradeComponents() []upgradeComponent {
+	return []upgradeComponent{
+		{v1beta1constants.DeploymentNameKubeAPIServer, b.Shoot.ControlPlaneNamespace, &appsv1.Deployment{}, imagevector.ContainerImageNameKubeApiserver},
+		{v1beta1constants.DeploymentNameKubeControllerManager, b.Shoot.ControlPlaneNamespace, &appsv1.Deployment{}, imagevector.ContainerImageNameKubeControllerManager},
+		{v1beta1constants.DeploymentNameKubeScheduler, b.Shoot.ControlPlaneNamespace, &appsv1.Deployment{}, imagevector.ContainerImageNameKubeScheduler},
+		{v1beta1constants.DeploymentNameGardenerResourceManager, b.Shoot.ControlPlaneNamespace, &appsv1.Deployment{}, imagevector.ContainerImageNameGardenerResourceManager},
+		{etcd.Druid, v1beta1constants.GardenNamespace, &appsv1.Deployment{}, imagevector.ContainerImageNameEtcdDruid},
+		{coredns.DeploymentName, metav1.NamespaceSystem, &appsv1.Deployment{}, imagevector.ContainerImageNameCoredns},
+	}
+}
+
+// ComputeUpgradePlan compares the running control plane with the manifests in the config directory and returns the
+// versions which would be rolled out by `gardenadm upgrade apply`.
+func (b *GardenadmBotanist) ComputeUpgradePlan(ctx context.Context) (*UpgradePlan, error) {
+	currentVersion, err := b.DiscoverKubernetesVersion(b.SeedClientSet)
+	if err != nil {
+		return nil, fmt.Errorf("failed discovering Kubernetes version of the control plane: %w", err)
+	}
+
+	plan := &UpgradePlan{
+		CurrentKubernetesVersion: currentVersion,
+		TargetKubernetesVersion:  b.Shoot.KubernetesVersion,
+	}
+
+	for _, component := range b.upgradeComponents() {
+		currentImage, err := b.currentImage(ctx, component)
+		if err != nil {
+			return nil, err
+		}
+
+		image, err := imagevector.Containers().FindImage(component.imageName, imagevectorutils.RuntimeVersion(b.SeedVersion()), imagevectorutils.TargetVersion(b.ShootVersion()))
+		if err != nil {
+			return nil, fmt.Errorf("failed finding image %q: %w", component.imageName, err)
+		}
+
+		plan.Components = append(plan.Components, ComponentUpgrade{
+			Name:         component.name,
+			CurrentImage: currentImage,
+			TargetImage:  image.String(),
+		})
+	}
+
+	for _, extension := range b.Extensions {
+		installed := true
+		if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Name: extension.ControllerInstallation.Name, Namespace: b.Shoot.ControlPlaneNamespace}, &resourcesv1alpha1.ManagedResource{}); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("failed reading ManagedResource for extension %q: %w", extension.ControllerInstallation.Name, err)
+			}
+			installed = false
+		}
+
+		chart := "<raw chart>"
+		if helm := extension.ControllerDeployment.Helm; helm != nil && helm.OCIRepository != nil {
+			chart = helm.OCIRepository.GetURL()
+		}
+
+		plan.Extensions = append(plan.Extensions, ExtensionUpgrade{
+			Name:        extension.ControllerRegistration.Name,
+			Installed:   installed,
+			TargetChart: chart,
+		})
+	}
+
+	nodes, err := b.nodesInUpdateOrder(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	workerPoolToSecretMeta, err := botanist.WorkerPoolToOperatingSystemConfigSecretMetaMap(ctx, b.ShootClientSet.Client(), v1beta1constants.GardenRoleOperatingSystemConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed listing operating system config secrets: %w", err)
+	}
+
+	for _, node := range nodes {
+		plan.Nodes = append(plan.Nodes, NodeUpgrade{
+			Name:           node.Name,
+			WorkerPool:     node.Labels[v1beta1constants.LabelWorkerPool],
+			KubeletVersion: node.Status.NodeInfo.KubeletVersion,
+			UpToDate:       nodeHasAppliedOperatingSystemConfig(&node, workerPoolToSecretMeta),
+		})
+	}
+
+	return plan, nil
+}
+
+func (b *GardenadmBotanist) currentImage(ctx context.Context, component upgradeComponent) (string, error) {
+	if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Name: component.name, Namespace: component.namespace}, component.object); err != nil {
+		if apierrors.IsNotFound(err) {
+			return imageNone, nil
+		}
+		return "", fmt.Errorf("failed reading object for %q: %w", component.name, err)
+	}
+
+	var containers []corev1.Container
+	switch obj := component.object.(type) {
+	case *appsv1.Deployment:
+		containers = obj.Spec.Template.Spec.Containers
+	case *appsv1.StatefulSet:
+		containers = obj.Spec.Template.Spec.Containers
+	}
+
+	if len(containers) == 0 {
+		return imageNone, nil
+	}
+	return containers[0].Image, nil
+}
+
+// ValidateKubernetesVersionUpgrade checks whether the control plane can be upgraded from the current to the target
+// Kubernetes version. Like for regular shoots, downgrades and skipping minor versions are not supported.
+func ValidateKubernetesVersionUpgrade(current, target *semver.Version) error {
+	if target.LessThan(current) {
+		return fmt.Errorf("downgrading Kubernetes from version %s to %s is not supported", current, target)
+	}
+
+	if target.Major() != current.Major() || target.Minor() > current.Minor()+1 {
+		return fmt.Errorf("upgrading Kubernetes from version %s to %s is not supported, only one minor version can be upgraded at a time (next minor version is %d.%d)", current, target, current.Major(), current.Minor()+1)
+	}
+
+	return nil
+}
+
+// IsEtcdManagedByDruid returns true if the etcd of the control plane has already been transitioned from the bootstrap
+// etcd to an etcd managed by etcd-druid (i.e., `gardenadm init` was executed without `--use-bootstrap-etcd`).
+func (b *GardenadmBotanist) IsEtcdManagedByDruid(ctx context.Context) (bool, error) {
+	etcdMain := &druidcorev1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: "etcd-" + v1beta1constants.ETCDRoleMain, Namespace: b.Shoot.ControlPlaneNamespace}}
+	if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKeyFromObject(etcdMain), etcdMain); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed reading Etcd %s: %w", client.ObjectKeyFromObject(etcdMain), err)
+	}
+
+	return true, nil
+}
+
+// nodesInUpdateOrder returns the nodes of the cluster in the order in which they are updated: The nodes of the control
+// plane worker pool come first, followed by the nodes of the other worker pools. Within a worker pool, nodes are
+// sorted by name.
+func (b *GardenadmBotanist) nodesInUpdateOrder(ctx context.Context) ([]corev1.Node, error) {
+	nodeList := &corev1.NodeList{}
+	if err := b.ShootClientSet.Client().List(ctx, nodeList); err != nil {
+		return nil, fmt.Errorf("failed listing nodes: %w", err)
+	}
+
+	controlPlanePoolName := b.controlPlaneWorkerPoolName()
+
+	slices.SortFunc(nodeList.Items, func(n1, n2 corev1.Node) int {
+		var (
+			n1IsControlPlane = n1.Labels[v1beta1constants.LabelWorkerPool] == controlPlanePoolName
+			n2IsControlPlane = n2.Labels[v1beta1constants.LabelWorkerPool] == controlPlanePoolName
+		)
+
+		if n1IsControlPlane != n2IsControlPlane {
+			if n1IsControlPlane {
+				return -1
+			}
+			return 1
+		}
+
+		return cmp.Or(
+			strings.Compare(n1.Labels[v1beta1constants.LabelWorkerPool], n2.Labels[v1beta1constants.LabelWorkerPool]),
+			strings.Compare(n1.Name, n2.Name),
+		)
+	})
+
+	return nodeList.Items, nil
+}
+
+func nodeHasAppliedOperatingSystemConfig(node *corev1.Node, workerPoolToSecretMeta map[string]metav1.ObjectMeta) bool {
+	secretMeta, ok := workerPoolToSecretMeta[node.Labels[v1beta1constants.LabelWorkerPool]]
+	if !ok {
+		return false
+	}
+
+	checksum, ok := node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig]
+	return ok && checksum == secretMeta.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumDownloadedOperatingSystemConfig]
+}
+
+// UpdateNodes rolls out the operating system configs deployed by DeployControlPlaneDeployments node by node, starting
+// with the control plane nodes. For each node, it waits until gardener-node-agent has applied the desired operating
+// system config, the node is ready, and the static control plane pods on the node run with the desired manifests
+// before continuing with the next node.
+//
+// For worker pools with an in-place update strategy, gardener-node-agent only performs in-place updates (e.g., kubelet
+// minor version updates) once the node has been marked as ready for the update. In this case, the node is drained
+// (unless it is a control plane node or the last node of the cluster) and marked as ready for the update by gardenadm
+// one after another, and gardener-node-agent checks the kubelet health after the update. Control plane nodes are not
+// drained since this would evict the control plane components running on them. For all other worker pools,
+// gardener-node-agent applies the changes without further coordination, hence gardenadm only verifies the health of the
+// nodes one after another.
+func (b *GardenadmBotanist) UpdateNodes(ctx context.Context, drainTimeout, nodeTimeout time.Duration) error {
+	nodes, err := b.nodesInUpdateOrder(ctx)
+	if err != nil {
+		return err
+	}
+
+	workerPoolToSecretMeta, err := botanist.WorkerPoolToOperatingSystemConfigSecretMetaMap(ctx, b.ShootClientSet.Client(), v1beta1constants.GardenRoleOperatingSystemConfig)
+	if err != nil {
+		return fmt.Errorf("failed listing operating system config secrets: %w", err)
+	}
+
+	for _, node := range nodes {
+		if err := b.updateNode(ctx, &node, workerPoolToSecretMeta, drainTimeout, nodeTimeout); err != nil {
+			return fmt.Errorf("failed updating node %s: %w", node.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (b *GardenadmBotanist) updateNode(ctx context.Context, node *corev1.Node, workerPoolToSecretMeta map[string]metav1.ObjectMeta, drainTimeout, nodeTimeout time.Duration) error {
+	var (
+		c        = b.ShootClientSet.Client()
+		log      = b.Logger.WithValues("nodeName", node.Name)
+		poolName = node.Labels[v1beta1constants.LabelWorkerPool]
+	)
+
+	if _, ok := workerPoolToSecretMeta[poolName]; !ok {
+		log.Info("Node does not belong to a worker pool with an operating system config, skipping it")
+		return nil
+	}
+
+	if nodeHasAppliedOperatingSystemConfig(node, workerPoolToSecretMeta) && b.staticPodsUpToDate(ctx, node) == nil {
+		log.Info("Node is already up to date")
+		return nil
+	}
+
+	var (
+		inPlaceUpdate = b.isInPlaceUpdateCoordinatedByGardenadm(poolName)
+		uncordon      bool
+	)
+
+	if inPlaceUpdate {
+		lastNode, err := IsLastNode(ctx, c, node)
+		if err != nil {
+			return err
+		}
+
+		if !lastNode && poolName != b.controlPlaneWorkerPoolName() {
+			uncordon = !node.Spec.Unschedulable
+			log.Info("Cordoning and draining node")
+			if err := CordonAndDrainNode(ctx, c, node, drainTimeout); err != nil {
+				return err
+			}
+		}
+
+		// A result of a previous update attempt must not be mistaken for the result of this update.
+		patch := client.MergeFrom(node.DeepCopy())
+		delete(node.Labels, machinev1alpha1.LabelKeyNodeUpdateResult)
+		delete(node.Annotations, machinev1alpha1.AnnotationKeyMachineUpdateFailedReason)
+		if err := c.Patch(ctx, node, patch); err != nil {
+			return fmt.Errorf("failed removing result of previous in-place update from node: %w", err)
+		}
+
+		log.Info("Marking node as ready for in-place update")
+		if err := b.patchNodeInPlaceUpdateCondition(ctx, node, true); err != nil {
+			return err
+		}
+	}
+
+	log.Info("Waiting until node has been updated")
+	if err := retry.UntilTimeout(ctx, NodeUpdatePollInterval, nodeTimeout, func(ctx context.Context) (bool, error) {
+		if err := c.Get(ctx, client.ObjectKeyFromObject(node), node); err != nil {
+			// kube-apiserver might restart, hence, we should tolerate that it is temporarily not available.
+			return retry.MinorError(fmt.Errorf("failed reading node: %w", err))
+		}
+
+		if kubernetesutils.HasMetaDataLabel(node, machinev1alpha1.LabelKeyNodeUpdateResult, machinev1alpha1.LabelValueNodeUpdateFailed) {
+			return retry.SevereError(fmt.Errorf("in-place update failed: %s", node.Annotations[machinev1alpha1.AnnotationKeyMachineUpdateFailedReason]))
+		}
+
+		if !nodeHasAppliedOperatingSystemConfig(node, workerPoolToSecretMeta) {
+			return retry.MinorError(fmt.Errorf("node has not applied the desired operating system config yet"))
+		}
+
+		if err := health.CheckNode(node); err != nil {
+			return retry.MinorError(fmt.Errorf("node is not healthy yet: %w", err))
+		}
+
+		if err := b.staticPodsUpToDate(ctx, node); err != nil {
+			return retry.MinorError(err)
+		}
+
+		return retry.Ok()
+	}); err != nil {
+		return err
+	}
+
+	if node.Labels[corev1.LabelHostname] == b.HostName {
+		log.Info("Checking health of kubelet on this machine")
+		if err := operatingsystemconfigcontroller.WaitUntilKubeletHealthy(ctx, log); err != nil {
+			return fmt.Errorf("kubelet is not healthy after the update: %w", err)
+		}
+	}
+
+	if inPlaceUpdate {
+		log.Info("Completing in-place update", "uncordon", uncordon)
+		if err := b.patchNodeInPlaceUpdateCondition(ctx, node, false); err != nil {
+			return err
+		}
+
+		patch := client.MergeFrom(node.DeepCopy())
+		delete(node.Labels, machinev1alpha1.LabelKeyNodeUpdateResult)
+		if uncordon {
+			node.Spec.Unschedulable = false
+		}
+		if err := c.Patch(ctx, node, patch); err != nil {
+			return fmt.Errorf("failed completing in-place update of node: %w", err)
+		}
+	}
+
+	log.Info("Node has been updated successfully")
+	return nil
+}
+
+// isInPlaceUpdateCoordinatedByGardenadm returns true if gardener-node-agent waits for the nodes of the given worker
+// pool to be marked as ready for in-place updates. With managed infrastructure, machine-controller-manager takes care
+// of this.
+func (b *GardenadmBotanist) isInPlaceUpdateCoordinatedByGardenadm(poolName string) bool {
+	if b.Shoot.HasManagedInfrastructure() {
+		return false
+	}
+
+	workers := b.Shoot.GetInfo().Spec.Provider.Workers
+	idx := slices.IndexFunc(workers, func(worker gardencorev1beta1.Worker) bool { return worker.Name == poolName })
+	return idx != -1 && v1beta1helper.IsUpdateStrategyInPlace(workers[idx].UpdateStrategy)
+}
+
+func (b *GardenadmBotanist) patchNodeInPlaceUpdateCondition(ctx context.Context, node *corev1.Node, readyForUpdate bool) error {
+	patch := client.StrategicMergeFrom(node.DeepCopy())
+
+	node.Status.Conditions = slices.DeleteFunc(node.Status.Conditions, func(condition corev1.NodeCondition) bool {
+		return condition.Type == machinev1alpha1.NodeInPlaceUpdate
+	})
+	if readyForUpdate {
+		now := metav1.NewTime(b.Clock.Now())
+		node.Status.Conditions = append(node.Status.Conditions, corev1.NodeCondition{
+			Type:               machinev1alpha1.NodeInPlaceUpdate,
+			Status:             corev1.ConditionTrue,
+			Reason:             machinev1alpha1.ReadyForUpdate,
+			Message:            "Node is ready for in-place update by gardenadm upgrade",
+			LastHeartbeatTime:  now,
+			LastTransitionTime: now,
+		})
+	}
+
+	if err := b.ShootClientSet.Client().Status().Patch(ctx, node, patch); err != nil {
+		return fmt.Errorf("failed patching %s condition of node: %w", machinev1alpha1.NodeInPlaceUpdate, err)
+	}
+
+	return nil
+}
+
+// staticPodsUpToDate checks whether the static control plane pods running on the given node have the desired hashes.
+// It is a no-op for nodes without static control plane pods.
+func (b *GardenadmBotanist) staticPodsUpToDate(ctx context.Context, node *corev1.Node) error {
+	if len(b.staticPodNameToHash) == 0 || node.Labels[v1beta1constants.LabelWorkerPool] != b.controlPlaneWorkerPoolName() {
+		return nil
+	}
+
+	staticPodList := &corev1.PodList{}
+	if err := b.SeedClientSet.Client().List(ctx, staticPodList, client.InNamespace(b.Shoot.ControlPlaneNamespace), client.MatchingLabels{staticpod.LabelKeyIsStaticPod: staticpod.LabelValueIsStaticPod}); err != nil {
+		return fmt.Errorf("failed listing static pods in namespace %q: %w", b.Shoot.ControlPlaneNamespace, err)
+	}
+
+	staticPodNameToHash := make(map[string]string)
+	for _, pod := range staticPodList.Items {
+		if pod.Spec.NodeName == node.Name {
+			staticPodNameToHash[strings.TrimSuffix(pod.Name, "-"+pod.Spec.NodeName)] = pod.Annotations[staticpod.AnnotationKeyHash]
+		}
+	}
+
+	if !maps.Equal(staticPodNameToHash, b.staticPodNameToHash) {
+		return fmt.Errorf("static pods on node %s have not been updated yet", node.Name)
+	}
+
+	return nil
+}
+
+func (b *GardenadmBotanist) controlPlaneWorkerPoolName() string {
+	if pool := v1beta1helper.ControlPlaneWorkerPoolForShoot(b.Shoot.GetInfo().Spec.Provider.Workers); pool != nil {
+		return pool.Name
+	}
+	return ""
+}
@@ -0,0 +1,326 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	druidcorev1alpha1 "github.com/gardener/etcd-druid/api/core/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Upgrade", func() {
+	Describe("#ValidateKubernetesVersionUpgrade", func() {
+		DescribeTable("should validate the upgrade",
+			func(current, target string, matcher OmegaMatcher) {
+				Expect(ValidateKubernetesVersionUpgrade(semver.MustParse(current), semver.MustParse(target))).To(matcher)
+			},
+
+			Entry("same version", "1.33.0", "1.33.0", Succeed()),
+			Entry("patch version upgrade", "1.33.0", "1.33.2", Succeed()),
+			Entry("minor version upgrade", "1.33.4", "1.34.0", Succeed()),
+			Entry("downgrade", "1.33.1", "1.33.0", MatchError(ContainSubstring("downgrading Kubernetes from version 1.33.1 to 1.33.0 is not supported"))),
+			Entry("skipping a minor version", "1.32.1", "1.34.0", MatchError(ContainSubstring("only one minor version can be upgraded at a time (next minor version is 1.33)"))),
+			Entry("major version upgrade", "1.33.1", "2.0.0", MatchError(ContainSubstring("not supported"))),
+		)
+	})
+
+	Context("with cluster", func() {
+		var (
+			ctx           = context.Background()
+			fakeClient    client.Client
+			interceptFunc interceptor.Funcs
+
+			b *GardenadmBotanist
+
+			secret           *corev1.Secret
+			controlPlaneNode *corev1.Node
+			workerNode       *corev1.Node
+		)
+
+		newNode := func(name, pool, checksum string) *corev1.Node {
+			return &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        name,
+					Labels:      map[string]string{"worker.gardener.cloud/pool": pool, "kubernetes.io/hostname": name},
+					Annotations: map[string]string{"checksum/cloud-config-data": checksum},
+				},
+				Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+					NodeInfo:   corev1.NodeSystemInfo{KubeletVersion: "v1.32.3"},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			interceptFunc = interceptor.Funcs{}
+		})
+
+		JustBeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.SeedScheme).
+				WithStatusSubresource(&corev1.Node{}).
+				WithIndex(&corev1.Pod{}, "spec.nodeName", func(obj client.Object) []string {
+					return []string{obj.(*corev1.Pod).Spec.NodeName}
+				}).
+				WithInterceptorFuncs(interceptFunc).
+				Build()
+			fakeClientSet := fakekubernetes.NewClientSetBuilder().WithClient(fakeClient).WithVersion("1.32.3").Build()
+
+			b = &GardenadmBotanist{
+				Botanist: &botanistpkg.Botanist{Operation: &operation.Operation{
+					Logger:         logr.Discard(),
+					Clock:          testclock.NewFakeClock(time.Now()),
+					SeedClientSet:  fakeClientSet,
+					ShootClientSet: fakeClientSet,
+					Shoot: &shoot.Shoot{
+						ControlPlaneNamespace: "kube-system",
+						KubernetesVersion:     semver.MustParse("1.33.0"),
+					},
+				}},
+				HostName: "this-machine",
+				Extensions: []Extension{{
+					ControllerRegistration: &gardencorev1beta1.ControllerRegistration{ObjectMeta: metav1.ObjectMeta{Name: "provider-local"}},
+					ControllerDeployment: &gardencorev1.ControllerDeployment{Helm: &gardencorev1.HelmControllerDeployment{
+						OCIRepository: &gardencorev1.OCIRepository{Ref: ptr.To("example.com/charts/provider-local:v1.2.3")},
+					}},
+					ControllerInstallation: &gardencorev1beta1.ControllerInstallation{ObjectMeta: metav1.ObjectMeta{Name: "provider-local"}},
+				}},
+			}
+			b.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.33.0"},
+					Provider: gardencorev1beta1.Provider{
+						Workers: []gardencorev1beta1.Worker{
+							{Name: "worker"},
+							{Name: "control-plane", ControlPlane: &gardencorev1beta1.WorkerControlPlane{}},
+						},
+					},
+				},
+			})
+
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "gardener-node-agent-control-plane",
+					Namespace:   "kube-system",
+					Labels:      map[string]string{"worker.gardener.cloud/pool": "control-plane", "gardener.cloud/role": "operating-system-config"},
+					Annotations: map[string]string{"checksum/data-script": "new"},
+				},
+			}
+			Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+
+			workerSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "gardener-node-agent-worker",
+					Namespace:   "kube-system",
+					Labels:      map[string]string{"worker.gardener.cloud/pool": "worker", "gardener.cloud/role": "operating-system-config"},
+					Annotations: map[string]string{"checksum/data-script": "new"},
+				},
+			}
+			Expect(fakeClient.Create(ctx, workerSecret)).To(Succeed())
+
+			controlPlaneNode = newNode("node-b", "control-plane", "old")
+			workerNode = newNode("node-a", "worker", "new")
+			Expect(fakeClient.Create(ctx, controlPlaneNode)).To(Succeed())
+			Expect(fakeClient.Create(ctx, workerNode)).To(Succeed())
+
+			DeferCleanup(test.WithVars(
+				&DrainPollInterval, time.Millisecond,
+				&NodeUpdatePollInterval, time.Millisecond,
+			))
+		})
+
+		Describe("#ComputeUpgradePlan", func() {
+			It("should compute the plan", func() {
+				Expect(fakeClient.Create(ctx, &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver", Namespace: "kube-system"},
+					Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "kube-apiserver", Image: "registry.k8s.io/kube-apiserver:v1.32.3"}},
+					}}},
+				})).To(Succeed())
+				Expect(fakeClient.Create(ctx, &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: "provider-local", Namespace: "kube-system"}})).To(Succeed())
+
+				plan, err := b.ComputeUpgradePlan(ctx)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(plan.CurrentKubernetesVersion).To(Equal(semver.MustParse("1.32.3")))
+				Expect(plan.TargetKubernetesVersion).To(Equal(semver.MustParse("1.33.0")))
+
+				Expect(plan.Components).To(HaveLen(6))
+				Expect(plan.Components[0]).To(Equal(ComponentUpgrade{
+					Name:         "kube-apiserver",
+					CurrentImage: "registry.k8s.io/kube-apiserver:v1.32.3",
+					TargetImage:  "registry.k8s.io/kube-apiserver:v1.33.0",
+				}))
+				Expect(plan.Components[1].Name).To(Equal("kube-controller-manager"))
+				Expect(plan.Components[1].CurrentImage).To(Equal("<none>"))
+
+				Expect(plan.Extensions).To(ConsistOf(ExtensionUpgrade{Name: "provider-local", Installed: true, TargetChart: "example.com/charts/provider-local:v1.2.3"}))
+
+				Expect(plan.Nodes).To(Equal([]NodeUpgrade{
+					{Name: "node-b", WorkerPool: "control-plane", KubeletVersion: "v1.32.3", UpToDate: false},
+					{Name: "node-a", WorkerPool: "worker", KubeletVersion: "v1.32.3", UpToDate: true},
+				}))
+			})
+		})
+
+		Describe("#IsEtcdManagedByDruid", func() {
+			It("should return false if the Etcd resource does not exist", func() {
+				Expect(b.IsEtcdManagedByDruid(ctx)).To(BeFalse())
+			})
+
+			It("should return true if the Etcd resource exists", func() {
+				Expect(fakeClient.Create(ctx, &druidcorev1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: "etcd-main", Namespace: "kube-system"}})).To(Succeed())
+				Expect(b.IsEtcdManagedByDruid(ctx)).To(BeTrue())
+			})
+		})
+
+		Describe("#UpdateNodes", func() {
+			It("should succeed if all nodes are up to date", func() {
+				controlPlaneNode.Annotations["checksum/cloud-config-data"] = "new"
+				Expect(fakeClient.Update(ctx, controlPlaneNode)).To(Succeed())
+
+				Expect(b.UpdateNodes(ctx, time.Second, time.Second)).To(Succeed())
+			})
+
+			It("should fail if a node does not apply the operating system config in time", func() {
+				Expect(b.UpdateNodes(ctx, time.Second, 10*time.Millisecond)).To(MatchError(And(
+					ContainSubstring("failed updating node node-b"),
+					ContainSubstring("node has not applied the desired operating system config yet"),
+				)))
+			})
+
+			It("should wait until the static pods on the node are up to date", func() {
+				controlPlaneNode.Annotations["checksum/cloud-config-data"] = "new"
+				Expect(fakeClient.Update(ctx, controlPlaneNode)).To(Succeed())
+
+				b.staticPodNameToHash = map[string]string{"kube-apiserver": "hash"}
+				staticPod := &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        "kube-apiserver-node-b",
+						Namespace:   "kube-system",
+						Labels:      map[string]string{"static-pod": "true"},
+						Annotations: map[string]string{"gardener.cloud/config.mirror": "old-hash"},
+					},
+					Spec: corev1.PodSpec{NodeName: "node-b"},
+				}
+				Expect(fakeClient.Create(ctx, staticPod)).To(Succeed())
+
+				Expect(b.UpdateNodes(ctx, time.Second, 10*time.Millisecond)).To(MatchError(ContainSubstring("static pods on node node-b have not been updated yet")))
+
+				staticPod.Annotations["gardener.cloud/config.mirror"] = "hash"
+				Expect(fakeClient.Update(ctx, staticPod)).To(Succeed())
+
+				Expect(b.UpdateNodes(ctx, time.Second, 10*time.Millisecond)).To(Succeed())
+			})
+
+			Context("in-place update strategy", func() {
+				var updateResult string
+
+				BeforeEach(func() {
+					updateResult = machinev1alpha1.LabelValueNodeUpdateSuccessful
+
+					// simulate gardener-node-agent performing the in-place update once the node is ready for it
+					interceptFunc.SubResourcePatch = func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+						if err := c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...); err != nil {
+							return err
+						}
+
+						node, ok := obj.(*corev1.Node)
+						if !ok || !nodeHasReadyForUpdateCondition(node) {
+							return nil
+						}
+
+						nodePatch := client.MergeFrom(node.DeepCopy())
+						node.Labels[machinev1alpha1.LabelKeyNodeUpdateResult] = updateResult
+						if updateResult == machinev1alpha1.LabelValueNodeUpdateFailed {
+							node.Annotations[machinev1alpha1.AnnotationKeyMachineUpdateFailedReason] = "kubelet is not healthy"
+						} else {
+							node.Annotations["checksum/cloud-config-data"] = "new"
+						}
+						return c.Patch(ctx, node, nodePatch)
+					}
+				})
+
+				JustBeforeEach(func() {
+					b.Shoot.GetInfo().Spec.Provider.Workers[0].UpdateStrategy = ptr.To(gardencorev1beta1.AutoInPlaceUpdate)
+					b.Shoot.GetInfo().Spec.Provider.Workers[1].UpdateStrategy = ptr.To(gardencorev1beta1.AutoInPlaceUpdate)
+
+					workerNode.Annotations["checksum/cloud-config-data"] = "old"
+					Expect(fakeClient.Update(ctx, workerNode)).To(Succeed())
+
+					Expect(fakeClient.Create(ctx, &corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{Name: "control-plane-pod", Namespace: "kube-system"},
+						Spec:       corev1.PodSpec{NodeName: controlPlaneNode.Name},
+					})).To(Succeed())
+					Expect(fakeClient.Create(ctx, &corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default"},
+						Spec:       corev1.PodSpec{NodeName: workerNode.Name},
+					})).To(Succeed())
+				})
+
+				It("should drain the worker node but not the control plane node, mark them ready for the update, and complete the update", func() {
+					Expect(b.UpdateNodes(ctx, time.Second, time.Second)).To(Succeed())
+
+					Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "control-plane-pod", Namespace: "kube-system"}, &corev1.Pod{})).To(Succeed())
+					Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "pod", Namespace: "default"}, &corev1.Pod{})).To(BeNotFoundError())
+
+					for _, node := range []*corev1.Node{controlPlaneNode, workerNode} {
+						Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+						Expect(node.Spec.Unschedulable).To(BeFalse())
+						Expect(node.Labels).NotTo(HaveKey(machinev1alpha1.LabelKeyNodeUpdateResult))
+						Expect(node.Annotations).To(HaveKeyWithValue("checksum/cloud-config-data", "new"))
+						Expect(nodeHasReadyForUpdateCondition(node)).To(BeFalse())
+					}
+				})
+
+				It("should fail if the in-place update failed", func() {
+					updateResult = machinev1alpha1.LabelValueNodeUpdateFailed
+
+					Expect(b.UpdateNodes(ctx, time.Second, time.Second)).To(MatchError(And(
+						ContainSubstring("failed updating node node-b"),
+						ContainSubstring("in-place update failed: kubelet is not healthy"),
+					)))
+
+					Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(controlPlaneNode), controlPlaneNode)).To(Succeed())
+					Expect(controlPlaneNode.Spec.Unschedulable).To(BeFalse())
+				})
+			})
+		})
+	})
+})
+
+func nodeHasReadyForUpdateCondition(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == machinev1alpha1.NodeInPlaceUpdate && condition.Reason == machinev1alpha1.ReadyForUpdate {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package diagnose
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "diagnose",
+		Short: "Collect a support bundle for analyzing problems with this machine",
+		Long: `Collect a support bundle for analyzing problems with this machine.
+
+The support bundle is a gzip-compressed tarball containing
+  - the journal logs of gardener-node-agent and kubelet,
+  - the OperatingSystemConfig last applied by gardener-node-agent,
+  - the static pod manifests, and
+  - the expiration dates of the certificates used by kubelet and the static pods.
+
+Inline file contents of the OperatingSystemConfig are redacted since they might contain credentials, and private keys
+are never collected. Still, review the bundle before sharing it.`,
+		Example: `# Collect a support bundle in the working directory
+gardenadm diagnose
+
+# Collect a support bundle with the logs of the last hour
+gardenadm diagnose --since 1h --output /tmp/bundle.tar.gz`,
+
+		Args: cobra.NoArgs,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func run(ctx context.Context, opts *Options) (err error) {
+	b, err := botanist.NewGardenadmBotanistWithoutResources(opts.Log)
+	if err != nil {
+		return fmt.Errorf("failed creating gardenadm botanist: %w", err)
+	}
+
+	output := opts.Output
+	if output == "" {
+		output = fmt.Sprintf("gardenadm-diagnose-%s-%s.tar.gz", b.HostName, b.Clock.Now().UTC().Format("20060102-150405"))
+	}
+
+	file, err := b.FS.OpenFile(output, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed creating support bundle file %s: %w", output, err)
+	}
+	defer func() {
+		if closeErr := file.Close(); closeErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed closing support bundle file %s: %w", output, closeErr))
+		}
+	}()
+
+	if err := b.WriteSupportBundle(ctx, file, opts.Since); err != nil {
+		return fmt.Errorf("failed writing support bundle: %w", err)
+	}
+
+	fmt.Fprintf(opts.Out, "The support bundle has been written to %s.\n", output)
+	return nil
+}
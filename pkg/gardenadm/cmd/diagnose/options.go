@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package diagnose
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+// Options contains options for this command.
+type Options struct {
+	*cmd.Options
+
+	// Output is the path of the support bundle. If not provided, the bundle is written to a file named after the host
+	// name and the current time in the working directory.
+	Output string
+	// Since is the duration for which the journal logs are collected.
+	Since time.Duration
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *Options) ParseArgs([]string) error { return nil }
+
+// Validate validates the options.
+func (o *Options) Validate() error {
+	if o.Since <= 0 {
+		return fmt.Errorf("log duration must be positive")
+	}
+
+	return nil
+}
+
+// Complete completes the options.
+func (o *Options) Complete() error { return nil }
+
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.Output, "output", "o", "", "Path of the support bundle (defaults to gardenadm-diagnose-<host-name>-<time>.tar.gz in the working directory)")
+	fs.DurationVar(&o.Since, "since", 24*time.Hour, "Collect the journal logs of this duration")
+}
@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/etcd/snapshot"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "etcd",
+		Short: "Manage the etcd of a self-hosted shoot cluster",
+		Long:  "Manage the etcd of a self-hosted shoot cluster.",
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	cmd.AddCommand(snapshot.NewCommand(globalOpts))
+
+	return cmd
+}
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package restore
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+// Options contains options for this command.
+type Options struct {
+	*cmd.Options
+	cmd.EtcdSnapshotStoreOptions
+	// Name is the name of the snapshot which should be restored.
+	Name string
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *Options) ParseArgs(args []string) error {
+	if len(args) > 0 {
+		o.Name = args[0]
+	}
+
+	return o.EtcdSnapshotStoreOptions.ParseArgs(args)
+}
+
+// Validate validates the options.
+func (o *Options) Validate() error {
+	if len(o.Name) == 0 {
+		return fmt.Errorf("must provide the name of the snapshot")
+	}
+
+	return o.EtcdSnapshotStoreOptions.Validate()
+}
+
+// Complete completes the options.
+func (o *Options) Complete() error { return o.EtcdSnapshotStoreOptions.Complete() }
+
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	o.EtcdSnapshotStoreOptions.AddFlags(fs)
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package restore_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/etcd/snapshot/restore"
+)
+
+var _ = Describe("Options", func() {
+	var (
+		options *Options
+	)
+
+	BeforeEach(func() {
+		options = &Options{}
+		options.Location = "/var/backups/gardenadm"
+	})
+
+	Describe("#ParseArgs", func() {
+		It("should parse the snapshot name", func() {
+			Expect(options.ParseArgs([]string{"snapshot-20250101-000000.tar.gz"})).To(Succeed())
+			Expect(options.Name).To(Equal("snapshot-20250101-000000.tar.gz"))
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should succeed when proper values were provided", func() {
+			options.Name = "snapshot-20250101-000000.tar.gz"
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should fail when no snapshot name is provided", func() {
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide the name of the snapshot")))
+		})
+
+		It("should fail when no location is provided", func() {
+			options.Name = "snapshot-20250101-000000.tar.gz"
+			options.Location = ""
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a location")))
+		})
+	})
+
+	Describe("#Complete", func() {
+		It("should create the snapshot store", func() {
+			Expect(options.Complete()).To(Succeed())
+			Expect(options.Store).NotTo(BeNil())
+		})
+	})
+})
@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package restore
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/gardenadm/etcdsnapshot"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "restore <snapshot-name>",
+		Short: "Restore a snapshot of the etcd and the secrets of the control plane on a fresh machine",
+		Long: `Restore a snapshot of the etcd and the secrets of the control plane on a fresh machine.
+
+This command prepares the data directory of the main etcd from the given snapshot and stages the secrets of the
+control plane. Afterwards, run 'gardenadm init' with the same config directory as for the original cluster to bring up
+the control plane again. It reuses the restored secrets, so existing kubeconfigs and service account tokens stay valid.
+
+The machine should have the same hostname (and ideally the same IP address) as the original control plane node. The
+data of the events etcd is not restored.
+
+Encrypted snapshots are decrypted with the key given by --encryption-key-file, which must be the same key as used for
+saving the snapshot.
+
+The credentials for an S3-compatible object store are read from the AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY, and
+AWS_SESSION_TOKEN environment variables.`,
+		Example: `# Restore a snapshot from a local directory
+gardenadm etcd snapshot restore snapshot-20250101-000000.tar.gz --location /var/backups/gardenadm
+
+# Restore an encrypted snapshot from an S3 bucket
+gardenadm etcd snapshot restore before-upgrade.tar.gz.enc --location s3://my-bucket/my-cluster --s3-region eu-west-1 --encryption-key-file /etc/gardenadm/snapshot-key`,
+
+		Args: cobra.ExactArgs(1),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func run(ctx context.Context, opts *Options) (err error) {
+	b, err := botanist.NewGardenadmBotanistWithoutResources(opts.Log)
+	if err != nil {
+		return fmt.Errorf("failed creating gardenadm botanist: %w", err)
+	}
+
+	kubeconfigFileExists, err := b.FS.Exists(botanist.PathKubeconfig)
+	if err != nil {
+		return fmt.Errorf("failed checking whether kubeconfig file %s exists: %w", botanist.PathKubeconfig, err)
+	}
+	if kubeconfigFileExists {
+		return fmt.Errorf("found kubeconfig file %s, this machine already runs a control plane, run 'gardenadm reset' first", botanist.PathKubeconfig)
+	}
+
+	if err := b.FS.MkdirAll(botanist.GardenadmBaseDir, 0700); err != nil {
+		return fmt.Errorf("failed creating directory %s: %w", botanist.GardenadmBaseDir, err)
+	}
+
+	file, err := b.FS.TempFile(botanist.GardenadmBaseDir, "etcd-snapshot-")
+	if err != nil {
+		return fmt.Errorf("failed creating temporary file for snapshot: %w", err)
+	}
+	defer func() {
+		err = errors.Join(err, file.Close(), b.FS.Remove(file.Name()))
+	}()
+
+	b.Logger.Info("Downloading snapshot", "name", opts.Name, "location", opts.Location)
+	if err := opts.Store.Get(ctx, opts.Name, file); err != nil {
+		return fmt.Errorf("failed fetching snapshot %s from %s: %w", opts.Name, opts.Location, err)
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed rewinding %s: %w", file.Name(), err)
+	}
+
+	snapshot, err := decryptSnapshotIfNeeded(bufio.NewReader(file), opts)
+	if err != nil {
+		return err
+	}
+
+	b.Logger.Info("Restoring etcd data directory and secrets from snapshot", "name", opts.Name)
+	if err := b.RestoreEtcdSnapshot(ctx, snapshot); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.Out, `
+The etcd snapshot %s has been restored successfully!
+
+Bring up the control plane by running
+
+  gardenadm init -d <config-dir>
+
+with the same config directory as for the original cluster.
+`, opts.Name)
+
+	return nil
+}
+
+func decryptSnapshotIfNeeded(r *bufio.Reader, opts *Options) (io.Reader, error) {
+	encrypted, err := etcdsnapshot.IsEncrypted(r)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case encrypted && opts.EncryptionKey == nil:
+		return nil, fmt.Errorf("snapshot %s is encrypted, provide the encryption key with --encryption-key-file", opts.Name)
+	case !encrypted && opts.EncryptionKey != nil:
+		return nil, fmt.Errorf("snapshot %s is not encrypted, but an encryption key was provided with --encryption-key-file", opts.Name)
+	case !encrypted:
+		return r, nil
+	}
+
+	return etcdsnapshot.NewDecryptingReader(r, opts.EncryptionKey)
+}
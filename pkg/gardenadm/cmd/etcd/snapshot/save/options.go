@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package save
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+// Options contains options for this command.
+type Options struct {
+	*cmd.Options
+	cmd.EtcdSnapshotStoreOptions
+	// Kubeconfig is the path to the kubeconfig used for reading the secrets of the control plane. If not provided, the
+	// admin kubeconfig of the control plane node is used.
+	Kubeconfig string
+	// Name is the name of the snapshot. If not provided, it is generated from the current time.
+	Name string
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *Options) ParseArgs(args []string) error { return o.EtcdSnapshotStoreOptions.ParseArgs(args) }
+
+// Validate validates the options.
+func (o *Options) Validate() error {
+	if strings.Contains(o.Name, "/") {
+		return fmt.Errorf("snapshot name must not contain '/'")
+	}
+
+	return o.EtcdSnapshotStoreOptions.Validate()
+}
+
+// Complete completes the options.
+func (o *Options) Complete() error { return o.EtcdSnapshotStoreOptions.Complete() }
+
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	o.EtcdSnapshotStoreOptions.AddFlags(fs)
+	fs.StringVar(&o.Kubeconfig, "kubeconfig", "", "Path to the kubeconfig used for reading the secrets of the control plane (defaults to the admin kubeconfig of the control plane node)")
+	fs.StringVar(&o.Name, "name", "", "Name of the snapshot (defaults to snapshot-<timestamp>.tar.gz, with an additional .enc suffix if the snapshot is encrypted)")
+}
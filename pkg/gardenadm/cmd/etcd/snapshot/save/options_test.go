@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package save_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/etcd/snapshot/save"
+)
+
+var _ = Describe("Options", func() {
+	var (
+		options *Options
+	)
+
+	BeforeEach(func() {
+		options = &Options{}
+		options.Location = "/var/backups/gardenadm"
+	})
+
+	Describe("#ParseArgs", func() {
+		It("should return nil", func() {
+			Expect(options.ParseArgs(nil)).To(Succeed())
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should succeed when proper values were provided", func() {
+			options.Name = "before-upgrade.tar.gz"
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should fail when the name contains a slash", func() {
+			options.Name = "foo/bar.tar.gz"
+			Expect(options.Validate()).To(MatchError(ContainSubstring("snapshot name must not contain '/'")))
+		})
+
+		It("should fail when no location is provided", func() {
+			options.Location = ""
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a location")))
+		})
+	})
+
+	Describe("#Complete", func() {
+		It("should create the snapshot store", func() {
+			Expect(options.Complete()).To(Succeed())
+			Expect(options.Store).NotTo(BeNil())
+		})
+	})
+})
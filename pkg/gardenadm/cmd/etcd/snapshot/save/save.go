@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package save
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/gardenadm/etcdsnapshot"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "save",
+		Short: "Save a snapshot of the etcd and the secrets of the control plane",
+		Long: `Save a snapshot of the etcd and the secrets of the control plane.
+
+This command must be run on a control plane node of a self-hosted shoot cluster. It takes a snapshot of the main etcd
+and bundles it with the secrets of the control plane into a single archive, which is stored in the given location.
+The snapshot can be restored on a fresh machine with 'gardenadm etcd snapshot restore'.
+
+The snapshot contains the private keys of the control plane (e.g., of the certificate authorities and the service
+account signing key) as well as the etcd encryption key. Hence, it is encrypted with the key given by
+--encryption-key-file before it leaves the machine. The encryption key is mandatory for S3-compatible buckets. Keep it
+separate from the snapshots, since it is needed for restoring them.
+
+The credentials for an S3-compatible object store are read from the AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY, and
+AWS_SESSION_TOKEN environment variables.`,
+		Example: `# Save a snapshot to a local directory
+gardenadm etcd snapshot save --location /var/backups/gardenadm
+
+# Generate an encryption key for the snapshots
+head -c 32 /dev/urandom | base64 > /etc/gardenadm/snapshot-key
+
+# Save an encrypted snapshot with a specific name to an S3 bucket
+gardenadm etcd snapshot save --location s3://my-bucket/my-cluster --s3-region eu-west-1 --encryption-key-file /etc/gardenadm/snapshot-key --name before-upgrade.tar.gz.enc`,
+
+		Args: cobra.NoArgs,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func run(ctx context.Context, opts *Options) (err error) {
+	b, err := botanist.NewGardenadmBotanistWithoutResources(opts.Log)
+	if err != nil {
+		return fmt.Errorf("failed creating gardenadm botanist: %w", err)
+	}
+
+	kubeconfigPath := opts.Kubeconfig
+	if kubeconfigPath == "" {
+		kubeconfigPath = botanist.PathKubeconfig
+	}
+
+	clientSet, err := botanist.NewClientSetFromFile(kubeconfigPath, kubernetes.SeedScheme)
+	if err != nil {
+		return fmt.Errorf("failed creating client set from kubeconfig %s: %w", kubeconfigPath, err)
+	}
+
+	name := opts.Name
+	if name == "" {
+		name = "snapshot-" + b.Clock.Now().UTC().Format("20060102-150405") + ".tar.gz"
+		if opts.EncryptionKey != nil {
+			name += ".enc"
+		}
+	}
+
+	// The snapshot is written to a temporary file first, since the upload to an S3-compatible object store needs to know
+	// its size and checksum upfront.
+	file, err := b.FS.TempFile(botanist.GardenadmBaseDir, "etcd-snapshot-")
+	if err != nil {
+		return fmt.Errorf("failed creating temporary file for snapshot: %w", err)
+	}
+	defer func() {
+		err = errors.Join(err, file.Close(), b.FS.Remove(file.Name()))
+	}()
+
+	if opts.EncryptionKey == nil {
+		b.Logger.Info("No encryption key provided, the snapshot is stored unencrypted although it contains the private keys of the control plane")
+		if err := b.SaveEtcdSnapshot(ctx, clientSet.Client(), file); err != nil {
+			return fmt.Errorf("failed saving etcd snapshot: %w", err)
+		}
+	} else {
+		encryptingWriter, err := etcdsnapshot.NewEncryptingWriter(file, opts.EncryptionKey)
+		if err != nil {
+			return fmt.Errorf("failed encrypting etcd snapshot: %w", err)
+		}
+		if err := b.SaveEtcdSnapshot(ctx, clientSet.Client(), encryptingWriter); err != nil {
+			return fmt.Errorf("failed saving etcd snapshot: %w", err)
+		}
+		if err := encryptingWriter.Close(); err != nil {
+			return fmt.Errorf("failed encrypting etcd snapshot: %w", err)
+		}
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed rewinding %s: %w", file.Name(), err)
+	}
+
+	if err := opts.Store.Put(ctx, name, file); err != nil {
+		return fmt.Errorf("failed storing snapshot %s in %s: %w", name, opts.Location, err)
+	}
+
+	if opts.EncryptionKey == nil {
+		fmt.Fprintf(opts.Out, `
+The etcd snapshot %[1]s has been saved to %[2]s successfully!
+
+WARNING: The snapshot is NOT encrypted and contains the private keys of the control plane, so make sure that only
+administrators of the cluster can access it. Use --encryption-key-file for encrypting it. You can restore it on a fresh
+machine by running
+
+  gardenadm etcd snapshot restore %[1]s --location %[2]s
+`, name, opts.Location)
+		return nil
+	}
+
+	fmt.Fprintf(opts.Out, `
+The etcd snapshot %[1]s has been encrypted and saved to %[2]s successfully!
+
+Keep the encryption key in a safe place, it is needed for restoring the snapshot. You can restore it on a fresh
+machine by running
+
+  gardenadm etcd snapshot restore %[1]s --location %[2]s --encryption-key-file %[3]s
+`, name, opts.Location, opts.EncryptionKeyFile)
+
+	return nil
+}
@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/etcd/snapshot/restore"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/etcd/snapshot/save"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Save and restore snapshots of the etcd of a self-hosted shoot cluster",
+		Long: `Save and restore snapshots of the etcd of a self-hosted shoot cluster.
+
+A snapshot contains the data of the main etcd and the secrets of the control plane (e.g., the certificate authorities,
+the service account signing key, and the etcd encryption key). The data of the events etcd is not part of the
+snapshot.
+
+Snapshots are stored either in a directory or in a bucket of an S3-compatible object store. As they contain private
+keys, make sure that only administrators of the cluster can access them.`,
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	cmd.AddCommand(save.NewCommand(globalOpts))
+	cmd.AddCommand(restore.NewCommand(globalOpts))
+
+	return cmd
+}
@@ -436,17 +436,25 @@ func bootstrapControlPlane(ctx context.Context, opts *Options) (*botanist.Garden
 
 	if kubeconfigFileExists {
 		b.Logger.Info("Found existing kubeconfig file, skipping initialization of control plane", "path", botanist.PathKubeconfig)
+	} else if err := b.RunPreflightChecks(ctx, b.PreflightChecks(true, "", nil), opts.SkipPreflightChecks); err != nil {
+		return nil, err
 	}
 
 	var (
 		clientSet kubernetes.Interface
 		g         = flow.NewGraph("bootstrap")
 
-		initializeSecretsManagement = g.Add(flow.Task{
-			Name:   "Initializing secrets management",
-			Fn:     b.InitializeSecretsManagement,
+		importRestoredSecrets = g.Add(flow.Task{
+			Name:   "Importing secrets from restored etcd snapshot",
+			Fn:     b.ImportRestoredSecrets,
 			SkipIf: kubeconfigFileExists,
 		})
+		initializeSecretsManagement = g.Add(flow.Task{
+			Name:         "Initializing secrets management",
+			Fn:           b.InitializeSecretsManagement,
+			SkipIf:       kubeconfigFileExists,
+			Dependencies: flow.NewTaskIDs(importRestoredSecrets),
+		})
 		writeKubeletBootstrapKubeconfig = g.Add(flow.Task{
 			Name:         "Writing kubelet bootstrap kubeconfig with a fake token to disk to make kubelet start",
 			Fn:           b.WriteKubeletBootstrapKubeconfig,
@@ -473,7 +481,7 @@ func bootstrapControlPlane(ctx context.Context, opts *Options) (*botanist.Garden
 			}).RetryUntilTimeout(2*time.Second, 2*time.Minute),
 			Dependencies: flow.NewTaskIDs(applyOperatingSystemConfig),
 		})
-		_ = g.Add(flow.Task{
+		importSecrets = g.Add(flow.Task{
 			Name: "Importing secrets into control plane",
 			Fn: func(ctx context.Context) error {
 				return b.MigrateSecrets(ctx, b.SeedClientSet.Client(), clientSet.Client())
@@ -481,6 +489,12 @@ func bootstrapControlPlane(ctx context.Context, opts *Options) (*botanist.Garden
 			SkipIf:       kubeconfigFileExists,
 			Dependencies: flow.NewTaskIDs(initializeClientSet),
 		})
+		_ = g.Add(flow.Task{
+			Name:         "Cleaning up restored etcd snapshot",
+			Fn:           b.FinalizeEtcdRestore,
+			SkipIf:       kubeconfigFileExists,
+			Dependencies: flow.NewTaskIDs(importSecrets),
+		})
 	)
 
 	if err := g.Compile().Run(ctx, flow.Opts{
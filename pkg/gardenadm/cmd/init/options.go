@@ -14,6 +14,7 @@ import (
 type Options struct {
 	*cmd.Options
 	cmd.ManifestOptions
+	cmd.PreflightOptions
 
 	// UseBootstrapEtcd indicates whether to use the bootstrap etcd instead of transitioning to etcd-druid.
 	UseBootstrapEtcd bool
@@ -26,6 +27,10 @@ func (o *Options) ParseArgs(args []string) error {
 
 // Validate validates the options.
 func (o *Options) Validate() error {
+	if err := o.PreflightOptions.Validate(); err != nil {
+		return err
+	}
+
 	return o.ManifestOptions.Validate()
 }
 
@@ -36,5 +41,6 @@ func (o *Options) Complete() error {
 
 func (o *Options) addFlags(fs *pflag.FlagSet) {
 	o.ManifestOptions.AddFlags(fs)
+	o.PreflightOptions.AddFlags(fs)
 	fs.BoolVar(&o.UseBootstrapEtcd, "use-bootstrap-etcd", false, "If set, the control plane continues using the bootstrap etcd instead of transitioning to etcd-druid. This is useful for testing purposes to save time.")
 }
@@ -36,6 +36,13 @@ var _ = Describe("Options", func() {
 		It("should fail because config dir path is not set", func() {
 			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to a config directory")))
 		})
+
+		It("should fail because an unknown preflight check should be skipped", func() {
+			options.ConfigDir = "some-path-to-config-dir"
+			options.SkipPreflightChecks = []string{"foo"}
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring(`unknown preflight check "foo"`)))
+		})
 	})
 
 	Describe("#Complete", func() {
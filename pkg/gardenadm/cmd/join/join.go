@@ -74,6 +74,17 @@ func run(ctx context.Context, opts *Options) error {
 		return fmt.Errorf("failed creating gardenadm botanist: %w", err)
 	}
 
+	alreadyJoined, err := b.IsGardenerNodeAgentInitialized(ctx)
+	if err != nil {
+		return fmt.Errorf("failed checking if gardener-node-agent was already initialized: %w", err)
+	}
+
+	if !alreadyJoined {
+		if err := b.RunPreflightChecks(ctx, b.PreflightChecks(opts.ControlPlane, opts.ControlPlaneAddress, opts.CertificateAuthority), opts.SkipPreflightChecks); err != nil {
+			return err
+		}
+	}
+
 	bootstrapClientSet, err := cmd.NewClientSetFromBootstrapToken(opts.ControlPlaneAddress, opts.CertificateAuthority, opts.BootstrapToken, kubernetes.SeedScheme)
 	if err != nil {
 		return fmt.Errorf("failed creating a new bootstrap client set: %w", err)
@@ -85,11 +96,6 @@ func run(ctx context.Context, opts *Options) error {
 	b.Shoot = &shootpkg.Shoot{KubernetesVersion: version}
 	b.Shoot.SetInfo(nil)
 
-	alreadyJoined, err := b.IsGardenerNodeAgentInitialized(ctx)
-	if err != nil {
-		return fmt.Errorf("failed checking if gardener-node-agent was already initialized: %w", err)
-	}
-
 	if !alreadyJoined {
 		var (
 			g                           = flow.NewGraph("join")
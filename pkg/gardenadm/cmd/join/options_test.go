@@ -51,6 +51,13 @@ var _ = Describe("Options", func() {
 
 			Expect(options.Validate()).To(MatchError(ContainSubstring("cannot provide a worker pool name when joining a control plane node")))
 		})
+
+		It("should fail when an unknown preflight check should be skipped", func() {
+			options.BootstrapToken = "some-token"
+			options.SkipPreflightChecks = []string{"foo"}
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring(`unknown preflight check "foo"`)))
+		})
 	})
 
 	Describe("#Complete", func() {
@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/go-logr/logr"
@@ -16,6 +17,7 @@ import (
 	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/tracing"
 )
 
 // LogfSetLogger is an alias for logf.SetLogger for testing purposes.
@@ -34,6 +36,11 @@ type Options struct {
 	LogLevel string
 	// LogFormat is the log format (one of [json,text]).
 	LogFormat string
+	// TracingEndpoint is the address of the OTLP/gRPC endpoint to which traces are exported. Tracing is disabled if it
+	// is empty.
+	TracingEndpoint string
+	// TracingInsecure disables TLS for the connection to the TracingEndpoint.
+	TracingInsecure bool
 }
 
 // Validate validates the options.
@@ -59,6 +66,11 @@ func (o *Options) Complete() error {
 
 	LogfSetLogger(o.Log)
 	klog.SetLogger(o.Log)
+
+	// The tracer provider is shut down via tracing.Shutdown once the command has been executed.
+	if _, err := tracing.Setup(context.Background(), "gardenadm", o.TracingEndpoint, o.TracingInsecure); err != nil {
+		return fmt.Errorf("error setting up tracing: %w", err)
+	}
 	return nil
 }
 
@@ -66,4 +78,6 @@ func (o *Options) Complete() error {
 func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVarP(&o.LogLevel, "log-level", "", "info", fmt.Sprintf("The level/severity for the logs. Must be one of %v", logger.AllLogLevels))
 	fs.StringVarP(&o.LogFormat, "log-format", "", "text", fmt.Sprintf("The format for the logs. Must be one of %v", logger.AllLogFormats))
+	fs.StringVar(&o.TracingEndpoint, "tracing-endpoint", "", "The address of the OTLP/gRPC endpoint to which traces of the executed flows are exported (e.g., otel-collector:4317). Tracing is disabled if empty.")
+	fs.BoolVar(&o.TracingInsecure, "tracing-insecure", false, "Disable TLS for the connection to the tracing endpoint.")
 }
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/afero"
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/gardenadm/etcdsnapshot"
+)
+
+// EtcdSnapshotStoreOptions contains options related to the location in which etcd snapshots are stored.
+type EtcdSnapshotStoreOptions struct {
+	// Location is the directory or the S3-compatible bucket (s3://bucket/prefix) in which snapshots are stored.
+	Location string
+	// S3Endpoint is the URL of the S3-compatible object store.
+	S3Endpoint string
+	// S3Region is the region of the S3-compatible object store.
+	S3Region string
+	// EncryptionKeyFile is the path to the file containing the base64-encoded key for encrypting the snapshots.
+	EncryptionKeyFile string
+
+	// Store is the store for the snapshots. It is set by Complete.
+	Store etcdsnapshot.Store
+	// EncryptionKey is the key for encrypting the snapshots. It is set by Complete if EncryptionKeyFile is provided.
+	EncryptionKey []byte
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *EtcdSnapshotStoreOptions) ParseArgs(_ []string) error { return nil }
+
+// Validate validates the options.
+func (o *EtcdSnapshotStoreOptions) Validate() error {
+	if len(o.Location) == 0 {
+		return fmt.Errorf("must provide a location for the snapshots with --location")
+	}
+
+	// Snapshots contain the private keys of the control plane, hence they must not be stored unencrypted outside the
+	// machine.
+	if strings.HasPrefix(o.Location, etcdsnapshot.SchemeS3) && len(o.EncryptionKeyFile) == 0 {
+		return fmt.Errorf("must provide an encryption key with --encryption-key-file for storing snapshots in an S3-compatible bucket")
+	}
+
+	return nil
+}
+
+// Complete completes the options.
+func (o *EtcdSnapshotStoreOptions) Complete() error {
+	fs := afero.Afero{Fs: afero.NewOsFs()}
+
+	if o.EncryptionKeyFile != "" {
+		var err error
+		if o.EncryptionKey, err = etcdsnapshot.ReadEncryptionKey(fs, o.EncryptionKeyFile); err != nil {
+			return err
+		}
+	}
+
+	s3Options := etcdsnapshot.S3Options{
+		Endpoint: o.S3Endpoint,
+		Region:   o.S3Region,
+	}
+	etcdsnapshot.S3CredentialsFromEnvironment(&s3Options)
+
+	var err error
+	o.Store, err = etcdsnapshot.NewStore(fs, o.Location, s3Options)
+	return err
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (o *EtcdSnapshotStoreOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.Location, "location", "", "Directory or S3-compatible bucket (s3://<bucket>/<prefix>) in which the snapshots are stored. The credentials for the bucket are read from the AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY, and AWS_SESSION_TOKEN environment variables")
+	fs.StringVar(&o.S3Endpoint, "s3-endpoint", "", "URL of the S3-compatible object store, defaults to the AWS S3 endpoint of the region")
+	fs.StringVar(&o.S3Region, "s3-region", "us-east-1", "Region of the S3-compatible object store")
+	fs.StringVar(&o.EncryptionKeyFile, "encryption-key-file", "", "Path to a file containing the base64-encoded 32 bytes key for encrypting the snapshots with AES-256-GCM, e.g., generated with 'head -c 32 /dev/urandom | base64'. Required for S3-compatible buckets")
+}
@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+var _ = Describe("EtcdSnapshotStoreOptions", func() {
+	var (
+		options *EtcdSnapshotStoreOptions
+	)
+
+	BeforeEach(func() {
+		options = &EtcdSnapshotStoreOptions{}
+	})
+
+	Describe("#ParseArgs", func() {
+		It("should return nil", func() {
+			Expect(options.ParseArgs(nil)).To(Succeed())
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should pass when a location is provided", func() {
+			options.Location = "/var/backups/gardenadm"
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should fail when no location is provided", func() {
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a location")))
+		})
+
+		It("should pass when an encryption key is provided for an S3-compatible bucket", func() {
+			options.Location = "s3://bucket/prefix"
+			options.EncryptionKeyFile = "/etc/gardenadm/snapshot-key"
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should fail when no encryption key is provided for an S3-compatible bucket", func() {
+			options.Location = "s3://bucket/prefix"
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide an encryption key with --encryption-key-file")))
+		})
+	})
+
+	Describe("#Complete", func() {
+		It("should create a store for a local directory", func() {
+			options.Location = "/var/backups/gardenadm"
+
+			Expect(options.Complete()).To(Succeed())
+			Expect(options.Store).NotTo(BeNil())
+		})
+
+		It("should create a store for an S3-compatible bucket", func() {
+			GinkgoT().Setenv("AWS_ACCESS_KEY_ID", "access-key-id")
+			GinkgoT().Setenv("AWS_SECRET_ACCESS_KEY", "secret-access-key")
+			options.Location = "s3://bucket/prefix"
+			options.S3Region = "eu-west-1"
+
+			Expect(options.Complete()).To(Succeed())
+			Expect(options.Store).NotTo(BeNil())
+		})
+
+		It("should read the encryption key", func() {
+			key := bytes.Repeat([]byte{1}, 32)
+			keyFile := filepath.Join(GinkgoT().TempDir(), "key")
+			Expect(os.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString(key)), 0600)).To(Succeed())
+			options.Location = "/var/backups/gardenadm"
+			options.EncryptionKeyFile = keyFile
+
+			Expect(options.Complete()).To(Succeed())
+			Expect(options.EncryptionKey).To(Equal(key))
+		})
+
+		It("should fail for an invalid encryption key", func() {
+			keyFile := filepath.Join(GinkgoT().TempDir(), "key")
+			Expect(os.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString([]byte("too-short"))), 0600)).To(Succeed())
+			options.Location = "/var/backups/gardenadm"
+			options.EncryptionKeyFile = keyFile
+
+			Expect(options.Complete()).To(MatchError(ContainSubstring("must be 32 bytes long")))
+		})
+
+		It("should fail for an S3-compatible bucket without credentials", func() {
+			GinkgoT().Setenv("AWS_ACCESS_KEY_ID", "")
+			GinkgoT().Setenv("AWS_SECRET_ACCESS_KEY", "")
+			options.Location = "s3://bucket/prefix"
+			options.S3Region = "eu-west-1"
+
+			Expect(options.Complete()).To(MatchError(ContainSubstring("credentials for the S3-compatible object store are missing")))
+		})
+	})
+})
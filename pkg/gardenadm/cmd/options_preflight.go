@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+)
+
+// PreflightOptions contains options related to the preflight checks.
+type PreflightOptions struct {
+	// SkipPreflightChecks are the names of the preflight checks which should be skipped.
+	SkipPreflightChecks []string
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *PreflightOptions) ParseArgs(_ []string) error { return nil }
+
+// Validate validates the options.
+func (o *PreflightOptions) Validate() error {
+	for _, name := range o.SkipPreflightChecks {
+		if name != botanist.PreflightCheckAll && !slices.Contains(botanist.PreflightCheckNames, name) {
+			return fmt.Errorf("unknown preflight check %q, must be one of [%s %s]", name, botanist.PreflightCheckAll, strings.Join(botanist.PreflightCheckNames, " "))
+		}
+	}
+
+	return nil
+}
+
+// Complete completes the options.
+func (o *PreflightOptions) Complete() error { return nil }
+
+// AddFlags implements Flagger.AddFlags.
+func (o *PreflightOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringSliceVar(&o.SkipPreflightChecks, "skip-preflight-checks", nil, fmt.Sprintf("Names of preflight checks to skip, use '%s' to skip all checks. One of [%s]", botanist.PreflightCheckAll, strings.Join(botanist.PreflightCheckNames, " ")))
+}
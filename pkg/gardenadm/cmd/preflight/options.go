@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+// Options contains options for this command.
+type Options struct {
+	*cmd.Options
+	cmd.PreflightOptions
+
+	// ControlPlaneAddress is the address of the control plane which the machine should join. If set, the machine is
+	// checked for reaching the API server and for clock skew.
+	ControlPlaneAddress string
+	// CertificateAuthority is the CA bundle of the control plane.
+	CertificateAuthority []byte
+	// ControlPlane indicates whether the machine should host control plane components.
+	ControlPlane bool
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *Options) ParseArgs(args []string) error {
+	if len(args) > 0 {
+		o.ControlPlaneAddress = strings.TrimSpace(args[0])
+	}
+
+	return nil
+}
+
+// Validate validates the options.
+func (o *Options) Validate() error {
+	if len(o.CertificateAuthority) > 0 && o.ControlPlaneAddress == "" {
+		return fmt.Errorf("cannot provide a certificate authority without a control plane address")
+	}
+
+	return o.PreflightOptions.Validate()
+}
+
+// Complete completes the options.
+func (o *Options) Complete() error { return nil }
+
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.BytesBase64Var(&o.CertificateAuthority, "ca-certificate", nil, "Base64-encoded certificate authority bundle of the control plane")
+	fs.BoolVar(&o.ControlPlane, "control-plane", false, "Check the prerequisites for hosting control plane components on this machine")
+	o.PreflightOptions.AddFlags(fs)
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/preflight"
+)
+
+var _ = Describe("Options", func() {
+	var (
+		options *Options
+	)
+
+	BeforeEach(func() {
+		options = &Options{}
+	})
+
+	Describe("#ParseArgs", func() {
+		It("should do nothing when no argument is set", func() {
+			Expect(options.ParseArgs(nil)).To(Succeed())
+			Expect(options.ControlPlaneAddress).To(BeEmpty())
+		})
+
+		It("should trim spaces when the argument is set", func() {
+			Expect(options.ParseArgs([]string{" foo.bar   "})).To(Succeed())
+			Expect(options.ControlPlaneAddress).To(Equal("foo.bar"))
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should succeed when proper values were provided", func() {
+			options.ControlPlaneAddress = "foo.bar"
+			options.CertificateAuthority = []byte("ca")
+			options.SkipPreflightChecks = []string{"swap"}
+
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should fail when a certificate authority is provided without a control plane address", func() {
+			options.CertificateAuthority = []byte("ca")
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("cannot provide a certificate authority without a control plane address")))
+		})
+
+		It("should fail when an unknown preflight check should be skipped", func() {
+			options.SkipPreflightChecks = []string{"foo"}
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring(`unknown preflight check "foo"`)))
+		})
+	})
+
+	Describe("#Complete", func() {
+		It("should return nil", func() {
+			Expect(options.Complete()).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package preflight
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "preflight [control-plane-address]",
+		Short: "Check whether this machine meets the prerequisites for 'gardenadm init' or 'gardenadm join'",
+		Long: `Check whether this machine meets the prerequisites for 'gardenadm init' or 'gardenadm join'.
+
+The same checks are executed automatically by 'gardenadm init' and 'gardenadm join' before they change anything on the
+machine. They verify that the ports used by kubelet (and the control plane components) are not in use, that swap is
+disabled, that the machine supports the systemd cgroup driver, and that the required kernel modules are loaded.
+If the address of the control plane is given, they also verify that the API server is reachable and that the clock of
+this machine does not deviate too much from the clock of the control plane.
+
+Each check can be skipped with --skip-preflight-checks, also when running 'gardenadm init' or 'gardenadm join'.`,
+		Example: `# Check the prerequisites for running 'gardenadm init' on this machine
+gardenadm preflight --control-plane
+
+# Check the prerequisites for joining this machine as a worker node
+gardenadm preflight --ca-certificate <ca-cert> <control-plane-address>
+
+# Check the prerequisites except for swap being disabled
+gardenadm preflight --skip-preflight-checks swap`,
+
+		Args: cobra.MaximumNArgs(1),
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func run(ctx context.Context, opts *Options) error {
+	b, err := botanist.NewGardenadmBotanistWithoutResources(opts.Log)
+	if err != nil {
+		return fmt.Errorf("failed creating gardenadm botanist: %w", err)
+	}
+
+	if err := b.RunPreflightChecks(ctx, b.PreflightChecks(opts.ControlPlane, opts.ControlPlaneAddress, opts.CertificateAuthority), opts.SkipPreflightChecks); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(opts.Out, "All preflight checks passed.")
+	return nil
+}
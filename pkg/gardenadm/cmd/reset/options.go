@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reset
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+// Options contains options for this command.
+type Options struct {
+	*cmd.Options
+
+	// DryRun only prints what would be removed from the machine and the cluster without changing anything.
+	DryRun bool
+	// Kubeconfig is the path to a kubeconfig used for cordoning, draining and deleting the Node object of this machine.
+	// If not provided, the admin kubeconfig of the control plane node is used if it exists.
+	Kubeconfig string
+	// SkipNodeRemoval skips cordoning, draining and deleting the Node object of this machine.
+	SkipNodeRemoval bool
+	// DrainTimeout is the maximum duration to wait for all pods to be evicted from the node.
+	DrainTimeout time.Duration
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *Options) ParseArgs([]string) error { return nil }
+
+// Validate validates the options.
+func (o *Options) Validate() error {
+	if o.DrainTimeout <= 0 {
+		return fmt.Errorf("drain timeout must be positive")
+	}
+
+	if o.SkipNodeRemoval && len(o.Kubeconfig) > 0 {
+		return fmt.Errorf("cannot provide a kubeconfig when skipping the node removal")
+	}
+
+	return nil
+}
+
+// Complete completes the options.
+func (o *Options) Complete() error { return nil }
+
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&o.DryRun, "dry-run", false, "Only print the units, files, and cluster objects that would be removed without changing anything")
+	fs.StringVar(&o.Kubeconfig, "kubeconfig", "", "Path to a kubeconfig used for cordoning, draining, and deleting the Node object of this machine (defaults to the admin kubeconfig of the control plane node)")
+	fs.BoolVar(&o.SkipNodeRemoval, "skip-node-removal", false, "Do not cordon, drain, and delete the Node object of this machine")
+	fs.DurationVar(&o.DrainTimeout, "drain-timeout", 5*time.Minute, "Maximum duration to wait for all pods to be evicted from the node")
+}
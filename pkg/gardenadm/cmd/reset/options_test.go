@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reset_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/reset"
+)
+
+var _ = Describe("Options", func() {
+	var (
+		options *Options
+	)
+
+	BeforeEach(func() {
+		options = &Options{DrainTimeout: time.Minute}
+	})
+
+	Describe("#ParseArgs", func() {
+		It("should return nil", func() {
+			Expect(options.ParseArgs(nil)).To(Succeed())
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should succeed when proper values were provided", func() {
+			options.Kubeconfig = "/path/to/kubeconfig"
+
+			Expect(options.Validate()).To(Succeed())
+		})
+
+		It("should fail when the drain timeout is not positive", func() {
+			options.DrainTimeout = 0
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("drain timeout must be positive")))
+		})
+
+		It("should fail when a kubeconfig is provided while the node removal is skipped", func() {
+			options.Kubeconfig = "/path/to/kubeconfig"
+			options.SkipNodeRemoval = true
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("cannot provide a kubeconfig when skipping the node removal")))
+		})
+	})
+
+	Describe("#Complete", func() {
+		It("should return nil", func() {
+			Expect(options.Complete()).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reset
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/nodeagent"
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "reset",
+		Short: "Revert the changes made to this machine by 'gardenadm init' or 'gardenadm join'",
+		Long: `Revert the changes made to this machine by 'gardenadm init' or 'gardenadm join'.
+
+This command stops and disables the gardener-node-agent and kubelet units as well as all other units of the
+OperatingSystemConfig applied to this machine. Afterwards, it deletes the unit files, the files written from the
+OperatingSystemConfig, the static pod manifests, and the data directories of the static pods.
+If the Node object of this machine is part of a cluster with other nodes, it is cordoned, drained, and deleted first.
+
+Containers of static pods which are still running are not removed, run 'crictl rm --all --force' afterwards if needed.`,
+		Example: `# Print everything that would be removed from this machine and the cluster
+gardenadm reset --dry-run
+
+# Reset a worker node and remove it from the cluster
+gardenadm reset --kubeconfig /path/to/kubeconfig
+
+# Reset this machine without touching the Node object
+gardenadm reset --skip-node-removal`,
+
+		Args: cobra.NoArgs,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func run(ctx context.Context, opts *Options) error {
+	b, err := botanist.NewGardenadmBotanistWithoutResources(opts.Log)
+	if err != nil {
+		return fmt.Errorf("failed creating gardenadm botanist: %w", err)
+	}
+
+	plan, err := b.ComputeResetPlan()
+	if err != nil {
+		return fmt.Errorf("failed computing what must be removed from this machine: %w", err)
+	}
+
+	var clientSet kubernetes.Interface
+	if !opts.SkipNodeRemoval {
+		clientSet, err = newClientSet(b, opts)
+		if err != nil {
+			return err
+		}
+	}
+
+	var node *corev1.Node
+	if clientSet != nil {
+		node, err = nodeToRemove(ctx, b, clientSet)
+		if err != nil {
+			return err
+		}
+	}
+
+	if opts.DryRun {
+		PrintPlan(opts.Out, plan, node)
+		return nil
+	}
+
+	var (
+		g = flow.NewGraph("reset")
+
+		drainNode = g.Add(flow.Task{
+			Name: "Cordoning and draining Node",
+			Fn: func(ctx context.Context) error {
+				return botanist.CordonAndDrainNode(ctx, clientSet.Client(), node, opts.DrainTimeout)
+			},
+			SkipIf: node == nil,
+		})
+		stopUnits = g.Add(flow.Task{
+			Name: "Stopping and disabling systemd units",
+			Fn: func(ctx context.Context) error {
+				return b.StopAndDisableUnits(ctx, plan.Units)
+			},
+			Dependencies: flow.NewTaskIDs(drainNode),
+		})
+		// The Node must be deleted after kubelet was stopped, otherwise it would register the Node again.
+		deleteNode = g.Add(flow.Task{
+			Name: "Deleting Node",
+			Fn: func(ctx context.Context) error {
+				return botanist.DeleteNode(ctx, clientSet.Client(), node)
+			},
+			SkipIf:       node == nil,
+			Dependencies: flow.NewTaskIDs(stopUnits),
+		})
+		_ = g.Add(flow.Task{
+			Name: "Removing files and directories",
+			Fn: func(ctx context.Context) error {
+				return b.RemovePaths(ctx, plan.Paths)
+			},
+			Dependencies: flow.NewTaskIDs(deleteNode),
+		})
+	)
+
+	if err := g.Compile().Run(ctx, flow.Opts{
+		Log: opts.Log,
+	}); err != nil {
+		return flow.Errors(err)
+	}
+
+	fmt.Fprintf(opts.Out, `
+This machine has been reset successfully!
+
+Containers of static pods might still be running. You can remove them by running
+'crictl rm --all --force'. Also, iptables rules and CNI configuration created by
+the pod network are not cleaned up.
+`)
+
+	return nil
+}
+
+func newClientSet(b *botanist.GardenadmBotanist, opts *Options) (kubernetes.Interface, error) {
+	kubeconfigPath := opts.Kubeconfig
+	if kubeconfigPath == "" {
+		kubeconfigPath = botanist.PathKubeconfig
+		if path := os.Getenv("KUBECONFIG"); path != "" {
+			kubeconfigPath = path
+		}
+
+		exists, err := b.FS.Exists(kubeconfigPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed checking whether kubeconfig %s exists: %w", kubeconfigPath, err)
+		}
+		if !exists {
+			b.Logger.Info("No kubeconfig found, skipping removal of Node object (use --kubeconfig to provide one)", "path", kubeconfigPath)
+			return nil, nil
+		}
+	}
+
+	clientSet, err := botanist.NewClientSetFromFile(kubeconfigPath, kubernetes.SeedScheme)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating client set from kubeconfig %s: %w", kubeconfigPath, err)
+	}
+	return clientSet, nil
+}
+
+func nodeToRemove(ctx context.Context, b *botanist.GardenadmBotanist, clientSet kubernetes.Interface) (*corev1.Node, error) {
+	node, err := nodeagent.FetchNodeByHostName(ctx, clientSet.Client(), b.HostName)
+	if err != nil {
+		return nil, fmt.Errorf("failed fetching Node object for host name %s: %w", b.HostName, err)
+	}
+	if node == nil {
+		b.Logger.Info("No Node object found for this machine, skipping its removal", "hostName", b.HostName)
+		return nil, nil
+	}
+
+	lastNode, err := botanist.IsLastNode(ctx, clientSet.Client(), node)
+	if err != nil {
+		return nil, err
+	}
+	if lastNode {
+		// Draining the last node of a self-hosted shoot cluster would evict its own control plane components.
+		b.Logger.Info("Node is the last node of the cluster, skipping its removal", "nodeName", node.Name)
+		return nil, nil
+	}
+
+	return node, nil
+}
+
+// PrintPlan prints everything that would be removed from this machine and the cluster.
+func PrintPlan(w io.Writer, plan *botanist.ResetPlan, node *corev1.Node) {
+	fmt.Fprintf(w, "The following Node object would be cordoned, drained, and deleted:\n")
+	if node == nil {
+		fmt.Fprintf(w, "  <none>\n")
+	} else {
+		fmt.Fprintf(w, "  %s\n", node.Name)
+	}
+
+	fmt.Fprintf(w, "\nThe following systemd units would be stopped and disabled:\n")
+	for _, unit := range plan.Units {
+		fmt.Fprintf(w, "  %s\n", unit)
+	}
+
+	fmt.Fprintf(w, "\nThe following files and directories would be removed:\n")
+	for _, path := range plan.Paths {
+		fmt.Fprintf(w, "  %s\n", path)
+	}
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reset_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/reset"
+)
+
+var _ = Describe("Reset", func() {
+	Describe("#PrintPlan", func() {
+		var (
+			out  *bytes.Buffer
+			plan *botanist.ResetPlan
+		)
+
+		BeforeEach(func() {
+			out = &bytes.Buffer{}
+			plan = &botanist.ResetPlan{
+				Units: []string{"gardener-node-agent.service", "kubelet.service"},
+				Paths: []string{"/etc/foo", "/var/lib/gardener-node-agent"},
+			}
+		})
+
+		It("should print the node, units and paths", func() {
+			PrintPlan(out, plan, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}})
+
+			Expect(out.String()).To(Equal(`The following Node object would be cordoned, drained, and deleted:
+  node
+
+The following systemd units would be stopped and disabled:
+  gardener-node-agent.service
+  kubelet.service
+
+The following files and directories would be removed:
+  /etc/foo
+  /var/lib/gardener-node-agent
+`))
+		})
+
+		It("should print that no node is removed", func() {
+			PrintPlan(out, plan, nil)
+
+			Expect(out.String()).To(HavePrefix(`The following Node object would be cordoned, drained, and deleted:
+  <none>
+`))
+		})
+	})
+})
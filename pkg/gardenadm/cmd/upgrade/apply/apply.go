@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apply
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	gardenerextensions "github.com/gardener/gardener/pkg/extensions"
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/plan"
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Upgrade the cluster to the state described by the config directory and this gardenadm binary",
+		Long: `Upgrade the cluster to the state described by the config directory and this gardenadm binary.
+
+The upgrade is performed in the following order:
+  1. gardener-resource-manager and the extensions are upgraded.
+  2. etcd-druid and the etcds are upgraded (unless the cluster still runs the bootstrap etcd).
+  3. The control plane components (kube-apiserver, kube-controller-manager, kube-scheduler) are upgraded and the
+     operating system configs for the nodes are updated.
+  4. The nodes are updated one after another, starting with the control plane nodes. Nodes of worker pools with an
+     in-place update strategy are drained (except for control plane nodes) and marked as ready for the update before
+     gardener-node-agent updates them. The upgrade stops as soon as a node fails to become healthy.
+  5. The system components (kube-proxy, CoreDNS) are upgraded.
+
+Upgrading the Kubernetes version by more than one minor version or downgrading it is not supported.
+Run 'gardenadm upgrade plan' first to review the changes.`,
+
+		Example: `# Upgrade the cluster using the config directory used for 'gardenadm init'
+gardenadm upgrade apply
+
+# Upgrade the cluster using an updated config directory and allow each node 30 minutes for its update
+gardenadm upgrade apply --config-dir /path/to/manifests --node-timeout 30m`,
+
+		Args: cobra.NoArgs,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func run(ctx context.Context, opts *Options) error {
+	b, err := plan.NewGardenadmBotanist(ctx, opts.Log, opts.ConfigDir)
+	if err != nil {
+		return err
+	}
+
+	upgradePlan, err := b.ComputeUpgradePlan(ctx)
+	if err != nil {
+		return fmt.Errorf("failed computing upgrade plan: %w", err)
+	}
+
+	if err := plan.PrintPlan(opts.Out, upgradePlan); err != nil {
+		return err
+	}
+	fmt.Fprintln(opts.Out)
+
+	if err := botanist.ValidateKubernetesVersionUpgrade(upgradePlan.CurrentKubernetesVersion, upgradePlan.TargetKubernetesVersion); err != nil {
+		return err
+	}
+
+	etcdManagedByDruid, err := b.IsEtcdManagedByDruid(ctx)
+	if err != nil {
+		return fmt.Errorf("failed checking whether etcd is managed by etcd-druid: %w", err)
+	}
+
+	var (
+		g                = flow.NewGraph("upgrade")
+		kubeProxyEnabled = v1beta1helper.KubeProxyEnabled(b.Shoot.GetInfo().Spec.Kubernetes.KubeProxy)
+
+		reconcileCustomResourceDefinitions = g.Add(flow.Task{
+			Name: "Reconciling CustomResourceDefinitions",
+			Fn:   b.ReconcileCustomResourceDefinitions,
+		})
+		ensureCustomResourceDefinitionsReady = g.Add(flow.Task{
+			Name:         "Ensuring CustomResourceDefinitions are ready",
+			Fn:           flow.TaskFn(b.EnsureCustomResourceDefinitionsReady).RetryUntilTimeout(time.Second, time.Minute),
+			Dependencies: flow.NewTaskIDs(reconcileCustomResourceDefinitions),
+		})
+		reconcileClusterResource = g.Add(flow.Task{
+			Name: "Reconciling extensions.gardener.cloud/v1alpha1.Cluster resource",
+			Fn: func(ctx context.Context) error {
+				return gardenerextensions.SyncClusterResourceToSeed(ctx, b.SeedClientSet.Client(), b.Shoot.ControlPlaneNamespace, b.Shoot.GetInfo(), b.Shoot.CloudProfile, b.Seed.GetInfo())
+			},
+			Dependencies: flow.NewTaskIDs(ensureCustomResourceDefinitionsReady),
+		})
+		initializeSecretsManagement = g.Add(flow.Task{
+			Name:         "Initializing internal state of Gardener secrets manager",
+			Fn:           b.InitializeSecretsManagement,
+			Dependencies: flow.NewTaskIDs(reconcileClusterResource),
+		})
+		deployGardenerResourceManager = g.Add(flow.Task{
+			Name: "Upgrading gardener-resource-manager",
+			Fn: func(ctx context.Context) error {
+				b.Components.RuntimeResourceManager.SetBootstrapControlPlaneNode(false)
+				b.Shoot.Components.ControlPlane.ResourceManager.SetBootstrapControlPlaneNode(false)
+
+				return flow.Parallel(
+					b.Components.RuntimeResourceManager.Deploy,
+					b.Shoot.Components.ControlPlane.ResourceManager.Deploy,
+				)(ctx)
+			},
+			Dependencies: flow.NewTaskIDs(initializeSecretsManagement),
+		})
+		waitUntilGardenerResourceManagerReady = g.Add(flow.Task{
+			Name: "Waiting until gardener-resource-manager reports readiness",
+			Fn: flow.Parallel(
+				b.Components.RuntimeResourceManager.Wait,
+				b.Shoot.Components.ControlPlane.ResourceManager.Wait,
+			),
+			Dependencies: flow.NewTaskIDs(deployGardenerResourceManager),
+		})
+		deployExtensionControllers = g.Add(flow.Task{
+			Name: "Upgrading extension controllers",
+			Fn: func(ctx context.Context) error {
+				return b.ReconcileExtensionControllerInstallations(ctx, false)
+			},
+			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady),
+		})
+		waitUntilExtensionControllersReady = g.Add(flow.Task{
+			Name:         "Waiting until extension controllers report readiness",
+			Fn:           b.WaitUntilExtensionControllerInstallationsHealthy,
+			Dependencies: flow.NewTaskIDs(deployExtensionControllers),
+		})
+		deployEtcdDruid = g.Add(flow.Task{
+			Name:         "Upgrading ETCD Druid",
+			Fn:           b.DeployEtcdDruid,
+			Dependencies: flow.NewTaskIDs(waitUntilExtensionControllersReady),
+		})
+		deployEtcds = g.Add(flow.Task{
+			Name:         "Upgrading main and events ETCDs",
+			Fn:           b.DeployEtcd,
+			SkipIf:       !etcdManagedByDruid,
+			Dependencies: flow.NewTaskIDs(deployEtcdDruid),
+		})
+		waitUntilEtcdsReady = g.Add(flow.Task{
+			Name:         "Waiting until main and event ETCDs have been reconciled",
+			Fn:           b.WaitUntilEtcdsReconciled,
+			SkipIf:       !etcdManagedByDruid,
+			Dependencies: flow.NewTaskIDs(deployEtcds),
+		})
+		deployControlPlane = g.Add(flow.Task{
+			Name:         "Deploying shoot control plane components",
+			Fn:           b.DeployControlPlane,
+			Dependencies: flow.NewTaskIDs(waitUntilExtensionControllersReady),
+		})
+		waitUntilControlPlaneReady = g.Add(flow.Task{
+			Name:         "Waiting until shoot control plane has been reconciled",
+			Fn:           b.Shoot.Components.Extensions.ControlPlane.Wait,
+			Dependencies: flow.NewTaskIDs(deployControlPlane),
+		})
+		deployControlPlaneDeployments = g.Add(flow.Task{
+			Name:         "Upgrading control plane components and updating gardener-node-agent Secret",
+			Fn:           b.DeployControlPlaneDeployments,
+			Dependencies: flow.NewTaskIDs(waitUntilControlPlaneReady, waitUntilEtcdsReady),
+		})
+		updateNodes = g.Add(flow.Task{
+			Name: "Updating nodes one after another",
+			Fn: func(ctx context.Context) error {
+				return b.UpdateNodes(ctx, opts.DrainTimeout, opts.NodeTimeout)
+			},
+			Dependencies: flow.NewTaskIDs(deployControlPlaneDeployments),
+		})
+		waitUntilControlPlaneDeploymentsReady = g.Add(flow.Task{
+			Name:         "Waiting until control plane components (static pods) are ready",
+			Fn:           b.WaitUntilControlPlaneDeploymentsReady,
+			Dependencies: flow.NewTaskIDs(updateNodes),
+		})
+		// The control plane components serving webhooks might be crash-looping while the kube-apiserver is restarted.
+		// Therefore, we explicitly wait for them to be healthy again before upgrading the system components.
+		waitUntilWebhookComponentsReady = g.Add(flow.Task{
+			Name: "Waiting until components with webhooks are ready",
+			Fn: flow.Sequential(
+				b.Shoot.Components.ControlPlane.ResourceManager.Wait,
+				b.WaitUntilExtensionControllerInstallationsHealthy,
+			),
+			Dependencies: flow.NewTaskIDs(waitUntilControlPlaneDeploymentsReady),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Upgrading kube-proxy system component",
+			Fn:           b.DeployKubeProxy,
+			SkipIf:       !kubeProxyEnabled,
+			Dependencies: flow.NewTaskIDs(waitUntilWebhookComponentsReady),
+		})
+		deployCoreDNS = g.Add(flow.Task{
+			Name:         "Upgrading CoreDNS system component",
+			Fn:           b.DeployCoreDNS,
+			Dependencies: flow.NewTaskIDs(waitUntilWebhookComponentsReady),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Waiting until CoreDNS system component is ready",
+			Fn:           b.Shoot.Components.SystemComponents.CoreDNS.Wait,
+			Dependencies: flow.NewTaskIDs(deployCoreDNS),
+		})
+	)
+
+	if err := g.Compile().Run(ctx, flow.Opts{
+		Log: opts.Log,
+	}); err != nil {
+		return flow.Errors(err)
+	}
+
+	fmt.Fprintf(opts.Out, `
+Your cluster has been upgraded to Kubernetes version %s successfully!
+
+Run 'gardenadm upgrade plan' to verify that all components and nodes are up to date.
+`, upgradePlan.TargetKubernetesVersion)
+
+	return nil
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package apply
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+)
+
+// Options contains options for this command.
+type Options struct {
+	*cmd.Options
+	cmd.ManifestOptions
+
+	// DrainTimeout is the maximum duration to wait for all pods to be evicted from a node before it is updated in-place.
+	DrainTimeout time.Duration
+	// NodeTimeout is the maximum duration to wait for a single node to be updated.
+	NodeTimeout time.Duration
+}
+
+// ParseArgs parses the arguments to the options.
+func (o *Options) ParseArgs(args []string) error { return o.ManifestOptions.ParseArgs(args) }
+
+// Validate validates the options.
+func (o *Options) Validate() error {
+	if o.DrainTimeout <= 0 {
+		return fmt.Errorf("drain timeout must be positive")
+	}
+
+	if o.NodeTimeout <= 0 {
+		return fmt.Errorf("node timeout must be positive")
+	}
+
+	if err := o.DefaultConfigDir(); err != nil {
+		return err
+	}
+
+	return o.ManifestOptions.Validate()
+}
+
+// Complete completes the options.
+func (o *Options) Complete() error { return o.ManifestOptions.Complete() }
+
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	o.ManifestOptions.AddFlags(fs)
+	fs.DurationVar(&o.DrainTimeout, "drain-timeout", 5*time.Minute, "Maximum duration to wait for all pods to be evicted from a node before it is updated in-place")
+	fs.DurationVar(&o.NodeTimeout, "node-timeout", 15*time.Minute, "Maximum duration to wait for a single node to be updated")
+}
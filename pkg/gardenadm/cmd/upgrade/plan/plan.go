@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/printers"
+
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	tokenutils "github.com/gardener/gardener/pkg/gardenadm/cmd/token/utils"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Show what 'gardenadm upgrade apply' would change in the cluster",
+		Long: `Show what 'gardenadm upgrade apply' would change in the cluster.
+
+The plan compares the currently running Kubernetes version, control plane component images, extensions, and nodes
+with the desired state described by the manifests in the config directory and the image vector embedded in this
+gardenadm binary. It does not change anything in the cluster.`,
+
+		Example: `# Show the upgrade plan for the config directory used for 'gardenadm init'
+gardenadm upgrade plan
+
+# Show the upgrade plan for an updated config directory
+gardenadm upgrade plan --config-dir /path/to/manifests`,
+
+		Args: cobra.NoArgs,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.ParseArgs(args); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+func run(ctx context.Context, opts *Options) error {
+	b, err := NewGardenadmBotanist(ctx, opts.Log, opts.ConfigDir)
+	if err != nil {
+		return err
+	}
+
+	plan, err := b.ComputeUpgradePlan(ctx)
+	if err != nil {
+		return fmt.Errorf("failed computing upgrade plan: %w", err)
+	}
+
+	if err := PrintPlan(opts.Out, plan); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.Out, "\nRun 'gardenadm upgrade apply' to perform the upgrade.\n")
+	return nil
+}
+
+// NewGardenadmBotanist creates a new GardenadmBotanist for an existing cluster from the manifests in the given config
+// directory.
+// Exposed for unit testing.
+var NewGardenadmBotanist = func(ctx context.Context, log logr.Logger, configDir string) (*botanist.GardenadmBotanist, error) {
+	clientSet, err := tokenutils.CreateClientSet(ctx, log)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating client set: %w", err)
+	}
+
+	b, err := botanist.NewGardenadmBotanistFromManifests(ctx, log, clientSet, configDir, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating gardenadm botanist: %w", err)
+	}
+
+	return b, nil
+}
+
+// PrintPlan prints the given upgrade plan in a human-readable format.
+func PrintPlan(w io.Writer, plan *botanist.UpgradePlan) error {
+	printer := printers.NewTablePrinter(printers.PrintOptions{})
+
+	fmt.Fprintf(w, "Kubernetes version: %s -> %s\n", plan.CurrentKubernetesVersion, plan.TargetKubernetesVersion)
+	if err := botanist.ValidateKubernetesVersionUpgrade(plan.CurrentKubernetesVersion, plan.TargetKubernetesVersion); err != nil {
+		fmt.Fprintf(w, "WARNING: This upgrade is not supported: %v\n", err)
+	}
+
+	fmt.Fprintf(w, "\nControl plane components:\n")
+	components := &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "COMPONENT", Type: "string", Format: "name", Description: "Name of the component"},
+			{Name: "CURRENT IMAGE", Type: "string", Description: "Currently running image"},
+			{Name: "TARGET IMAGE", Type: "string", Description: "Image after the upgrade"},
+		},
+		Rows: make([]metav1.TableRow, 0, len(plan.Components)),
+	}
+	for _, component := range plan.Components {
+		components.Rows = append(components.Rows, metav1.TableRow{Cells: []any{component.Name, component.CurrentImage, component.TargetImage}})
+	}
+	if err := printer.PrintObj(components, w); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "\nExtensions:\n")
+	if len(plan.Extensions) == 0 {
+		fmt.Fprintln(w, "No resources found.")
+	} else {
+		extensions := &metav1.Table{
+			ColumnDefinitions: []metav1.TableColumnDefinition{
+				{Name: "EXTENSION", Type: "string", Format: "name", Description: "Name of the extension"},
+				{Name: "INSTALLED", Type: "string", Description: "Whether the extension is already installed"},
+				{Name: "TARGET CHART", Type: "string", Description: "Helm chart deployed by the upgrade"},
+			},
+			Rows: make([]metav1.TableRow, 0, len(plan.Extensions)),
+		}
+		for _, extension := range plan.Extensions {
+			extensions.Rows = append(extensions.Rows, metav1.TableRow{Cells: []any{extension.Name, strconv.FormatBool(extension.Installed), extension.TargetChart}})
+		}
+		if err := printer.PrintObj(extensions, w); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(w, "\nNodes (in update order):\n")
+	if len(plan.Nodes) == 0 {
+		fmt.Fprintln(w, "No resources found.")
+		return nil
+	}
+
+	nodes := &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "NODE", Type: "string", Format: "name", Description: "Name of the node"},
+			{Name: "WORKER POOL", Type: "string", Description: "Worker pool of the node"},
+			{Name: "KUBELET VERSION", Type: "string", Description: "Currently running kubelet version"},
+			{Name: "UP TO DATE", Type: "string", Description: "Whether the node already applied the desired operating system config"},
+		},
+		Rows: make([]metav1.TableRow, 0, len(plan.Nodes)),
+	}
+	for _, node := range plan.Nodes {
+		nodes.Rows = append(nodes.Rows, metav1.TableRow{Cells: []any{node.Name, node.WorkerPool, node.KubeletVersion, strconv.FormatBool(node.UpToDate)}})
+	}
+	return printer.PrintObj(nodes, w)
+}
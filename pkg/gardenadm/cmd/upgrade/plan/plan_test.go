@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plan_test
+
+import (
+	"github.com/Masterminds/semver/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+
+	"github.com/gardener/gardener/pkg/gardenadm/botanist"
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/plan"
+)
+
+var _ = Describe("Plan", func() {
+	var (
+		out  *Buffer
+		plan *botanist.UpgradePlan
+	)
+
+	BeforeEach(func() {
+		out = NewBuffer()
+		plan = &botanist.UpgradePlan{
+			CurrentKubernetesVersion: semver.MustParse("1.32.3"),
+			TargetKubernetesVersion:  semver.MustParse("1.33.0"),
+			Components: []botanist.ComponentUpgrade{
+				{Name: "kube-apiserver", CurrentImage: "registry.k8s.io/kube-apiserver:v1.32.3", TargetImage: "registry.k8s.io/kube-apiserver:v1.33.0"},
+				{Name: "coredns", CurrentImage: "<none>", TargetImage: "registry.k8s.io/coredns/coredns:v1.12.1"},
+			},
+			Nodes: []botanist.NodeUpgrade{
+				{Name: "node-b", WorkerPool: "control-plane", KubeletVersion: "v1.32.3", UpToDate: false},
+				{Name: "node-a", WorkerPool: "worker", KubeletVersion: "v1.33.0", UpToDate: true},
+			},
+		}
+	})
+
+	Describe("#PrintPlan", func() {
+		It("should print the plan as tables", func() {
+			plan.Extensions = []botanist.ExtensionUpgrade{{Name: "provider-local", Installed: true, TargetChart: "example.com/provider-local:v1.0.0"}}
+
+			Expect(PrintPlan(out, plan)).To(Succeed())
+
+			Expect(out).To(Say(`Kubernetes version: 1.32.3 -> 1.33.0
+`))
+			Expect(out).To(Say(`COMPONENT\s+CURRENT IMAGE\s+TARGET IMAGE
+kube-apiserver\s+registry.k8s.io/kube-apiserver:v1.32.3\s+registry.k8s.io/kube-apiserver:v1.33.0
+coredns\s+<none>\s+registry.k8s.io/coredns/coredns:v1.12.1
+`))
+			Expect(out).To(Say(`EXTENSION\s+INSTALLED\s+TARGET CHART
+provider-local\s+true\s+example.com/provider-local:v1.0.0
+`))
+			Expect(out).To(Say(`NODE\s+WORKER POOL\s+KUBELET VERSION\s+UP TO DATE
+node-b\s+control-plane\s+v1.32.3\s+false
+node-a\s+worker\s+v1.33.0\s+true
+`))
+			Expect(out.Contents()).NotTo(ContainSubstring("WARNING"))
+		})
+
+		It("should print that there are no extensions", func() {
+			Expect(PrintPlan(out, plan)).To(Succeed())
+
+			Expect(out).To(Say(`Extensions:
+No resources found.
+`))
+		})
+
+		It("should warn about unsupported upgrades", func() {
+			plan.TargetKubernetesVersion = semver.MustParse("1.34.0")
+
+			Expect(PrintPlan(out, plan)).To(Succeed())
+
+			Expect(out).To(Say(`WARNING: This upgrade is not supported: upgrading Kubernetes from version 1.32.3 to 1.34.0 is not supported`))
+		})
+	})
+})
@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/apply"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/upgrade/plan"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(globalOpts *cmd.Options) *cobra.Command {
+	opts := &Options{Options: globalOpts}
+
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade the Kubernetes and Gardener versions of a self-hosted shoot cluster",
+		Long: `Upgrade the Kubernetes and Gardener versions of a self-hosted shoot cluster.
+
+To upgrade the cluster, update the manifests in the config directory used for 'gardenadm init' (e.g., the Kubernetes
+version in the Shoot manifest or the ControllerDeployments of the extensions) and replace the gardenadm binary with
+the new version. Afterwards, run 'gardenadm upgrade plan' to review the changes and 'gardenadm upgrade apply' on a
+control plane node to roll them out.`,
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	cmd.AddCommand(plan.NewCommand(globalOpts))
+	cmd.AddCommand(apply.NewCommand(globalOpts))
+
+	return cmd
+}
@@ -0,0 +1,254 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdsnapshot
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+// Snapshots contain the secrets of the control plane (e.g., the private keys of the certificate authorities and the
+// etcd encryption key), hence they are encrypted before they leave the machine. The format is:
+//
+//	magic (8 bytes) | salt (32 bytes) | chunk | chunk | ... | final chunk
+//
+// Each chunk consists of the length of its ciphertext (4 bytes, big endian) and the ciphertext, which is the AES-256-GCM
+// sealed plaintext of up to encryptionChunkSize bytes. The encryption key of a snapshot is derived from the user-supplied
+// key and the random salt, so the nonces can be derived from the chunk index without the risk of reusing them. The
+// additional data of each chunk marks whether it is the final chunk, so that truncated snapshots are detected.
+const (
+	encryptionMagic     = "GASNAP\x00\x01"
+	encryptionSaltSize  = 32
+	encryptionChunkSize = 64 * 1024
+	encryptionKeyInfo   = "gardenadm etcd snapshot"
+
+	// EncryptionKeySize is the size of the keys for encrypting snapshots in bytes.
+	EncryptionKeySize = 32
+)
+
+// ReadEncryptionKey reads the base64-encoded key for encrypting snapshots from the given file. A suitable key can be
+// generated with `head -c 32 /dev/urandom | base64`.
+func ReadEncryptionKey(fs afero.Afero, path string) ([]byte, error) {
+	content, err := fs.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading encryption key file %s: %w", path, err)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(content)))
+	if err != nil {
+		return nil, fmt.Errorf("failed decoding encryption key from %s, it must be base64-encoded: %w", path, err)
+	}
+	if len(key) != EncryptionKeySize {
+		return nil, fmt.Errorf("encryption key in %s must be %d bytes long, got %d bytes", path, EncryptionKeySize, len(key))
+	}
+	return key, nil
+}
+
+// IsEncrypted returns true if the given snapshot content starts like an encrypted snapshot. It does not consume any
+// data from the reader.
+func IsEncrypted(r *bufio.Reader) (bool, error) {
+	header, err := r.Peek(len(encryptionMagic))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, fmt.Errorf("failed reading snapshot header: %w", err)
+	}
+	return bytes.Equal(header, []byte(encryptionMagic)), nil
+}
+
+// NewEncryptingWriter returns a writer which encrypts everything written to it with the given key and writes it to w.
+// The writer must be closed to write the final chunk, otherwise the snapshot cannot be decrypted.
+func NewEncryptingWriter(w io.Writer, key []byte) (io.WriteCloser, error) {
+	salt := make([]byte, encryptionSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("failed generating salt: %w", err)
+	}
+
+	aead, err := newAEAD(key, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := w.Write(append([]byte(encryptionMagic), salt...)); err != nil {
+		return nil, fmt.Errorf("failed writing snapshot header: %w", err)
+	}
+
+	return &encryptingWriter{w: w, aead: aead, buf: make([]byte, 0, encryptionChunkSize)}, nil
+}
+
+type encryptingWriter struct {
+	w      io.Writer
+	aead   cipher.AEAD
+	buf    []byte
+	index  uint64
+	closed bool
+}
+
+func (e *encryptingWriter) Write(p []byte) (int, error) {
+	if e.closed {
+		return 0, errors.New("write to closed encrypting writer")
+	}
+
+	written := 0
+	for len(p) > 0 {
+		// A full chunk is only sealed once more data arrives, since the last chunk must be marked as final on Close.
+		if len(e.buf) == encryptionChunkSize {
+			if err := e.writeChunk(false); err != nil {
+				return written, err
+			}
+		}
+
+		n := min(len(p), encryptionChunkSize-len(e.buf))
+		e.buf = append(e.buf, p[:n]...)
+		p = p[n:]
+		written += n
+	}
+	return written, nil
+}
+
+func (e *encryptingWriter) Close() error {
+	if e.closed {
+		return nil
+	}
+	e.closed = true
+	return e.writeChunk(true)
+}
+
+func (e *encryptingWriter) writeChunk(final bool) error {
+	ciphertext := e.aead.Seal(nil, chunkNonce(e.index), e.buf, chunkAdditionalData(final))
+
+	header := make([]byte, 4)
+	binary.BigEndian.PutUint32(header, uint32(len(ciphertext))) // #nosec G115 -- Chunks are at most 64KiB plus overhead.
+	if _, err := e.w.Write(append(header, ciphertext...)); err != nil {
+		return fmt.Errorf("failed writing encrypted chunk: %w", err)
+	}
+
+	e.index++
+	e.buf = e.buf[:0]
+	return nil
+}
+
+// NewDecryptingReader returns a reader which decrypts the snapshot read from r with the given key. Reading fails if the
+// key is wrong, or if the snapshot was modified or truncated.
+func NewDecryptingReader(r io.Reader, key []byte) (io.Reader, error) {
+	header := make([]byte, len(encryptionMagic)+encryptionSaltSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("failed reading snapshot header: %w", err)
+	}
+	if !bytes.Equal(header[:len(encryptionMagic)], []byte(encryptionMagic)) {
+		return nil, errors.New("snapshot is not encrypted")
+	}
+
+	aead, err := newAEAD(key, header[len(encryptionMagic):])
+	if err != nil {
+		return nil, err
+	}
+
+	return &decryptingReader{r: r, aead: aead}, nil
+}
+
+type decryptingReader struct {
+	r     io.Reader
+	aead  cipher.AEAD
+	buf   []byte
+	index uint64
+	final bool
+}
+
+func (d *decryptingReader) Read(p []byte) (int, error) {
+	for len(d.buf) == 0 {
+		if d.final {
+			// Data after the final chunk must not be silently ignored.
+			if n, _ := d.r.Read(make([]byte, 1)); n > 0 {
+				return 0, errors.New("snapshot contains data after the final chunk")
+			}
+			return 0, io.EOF
+		}
+
+		if err := d.readChunk(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, d.buf)
+	d.buf = d.buf[n:]
+	return n, nil
+}
+
+func (d *decryptingReader) readChunk() error {
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(d.r, header); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return errors.New("snapshot is truncated")
+		}
+		return fmt.Errorf("failed reading encrypted chunk: %w", err)
+	}
+
+	length := binary.BigEndian.Uint32(header)
+	if length > encryptionChunkSize+uint32(d.aead.Overhead()) { // #nosec G115 -- The overhead of AES-GCM is 16 bytes.
+		return fmt.Errorf("encrypted chunk is too large (%d bytes)", length)
+	}
+
+	ciphertext := make([]byte, length)
+	if _, err := io.ReadFull(d.r, ciphertext); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return errors.New("snapshot is truncated")
+		}
+		return fmt.Errorf("failed reading encrypted chunk: %w", err)
+	}
+
+	// Whether this is the final chunk is only known after authenticating it with the respective additional data.
+	for _, final := range []bool{false, true} {
+		plaintext, err := d.aead.Open(nil, chunkNonce(d.index), ciphertext, chunkAdditionalData(final))
+		if err == nil {
+			d.buf, d.final = plaintext, final
+			d.index++
+			return nil
+		}
+	}
+
+	return errors.New("failed decrypting snapshot, the encryption key is wrong or the snapshot was modified")
+}
+
+func newAEAD(key, salt []byte) (cipher.AEAD, error) {
+	if len(key) != EncryptionKeySize {
+		return nil, fmt.Errorf("encryption key must be %d bytes long, got %d bytes", EncryptionKeySize, len(key))
+	}
+
+	derivedKey, err := hkdf.Key(sha256.New, key, salt, encryptionKeyInfo, 32)
+	if err != nil {
+		return nil, fmt.Errorf("failed deriving encryption key: %w", err)
+	}
+
+	block, err := aes.NewCipher(derivedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+func chunkNonce(index uint64) []byte {
+	nonce := make([]byte, 12)
+	binary.BigEndian.PutUint64(nonce[4:], index)
+	return nonce
+}
+
+func chunkAdditionalData(final bool) []byte {
+	if final {
+		return []byte{1}
+	}
+	return []byte{0}
+}
@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdsnapshot_test
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/etcdsnapshot"
+)
+
+var _ = Describe("Encryption", func() {
+	var (
+		key       []byte
+		plaintext []byte
+	)
+
+	BeforeEach(func() {
+		key = make([]byte, EncryptionKeySize)
+		_, err := rand.Read(key)
+		Expect(err).NotTo(HaveOccurred())
+
+		// Span multiple chunks to cover the chunking.
+		plaintext = make([]byte, 200*1024+17)
+		_, err = rand.Read(plaintext)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	encrypt := func(content []byte) []byte {
+		buf := &bytes.Buffer{}
+		w, err := NewEncryptingWriter(buf, key)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = w.Write(content)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(w.Close()).To(Succeed())
+		return buf.Bytes()
+	}
+
+	decrypt := func(ciphertext, key []byte) ([]byte, error) {
+		r, err := NewDecryptingReader(bytes.NewReader(ciphertext), key)
+		if err != nil {
+			return nil, err
+		}
+		return io.ReadAll(r)
+	}
+
+	It("should encrypt and decrypt snapshots", func() {
+		ciphertext := encrypt(plaintext)
+		Expect(bytes.Contains(ciphertext, plaintext[:64])).To(BeFalse())
+
+		Expect(decrypt(ciphertext, key)).To(Equal(plaintext))
+	})
+
+	It("should encrypt and decrypt empty snapshots", func() {
+		Expect(decrypt(encrypt(nil), key)).To(BeEmpty())
+	})
+
+	It("should use a different encryption for each snapshot", func() {
+		Expect(encrypt(plaintext)).NotTo(Equal(encrypt(plaintext)))
+	})
+
+	It("should detect encrypted snapshots", func() {
+		Expect(IsEncrypted(bufio.NewReader(bytes.NewReader(encrypt(plaintext))))).To(BeTrue())
+		Expect(IsEncrypted(bufio.NewReader(bytes.NewReader(plaintext)))).To(BeFalse())
+		Expect(IsEncrypted(bufio.NewReader(bytes.NewReader(nil)))).To(BeFalse())
+	})
+
+	It("should fail decrypting with a wrong key", func() {
+		wrongKey := bytes.Repeat([]byte{1}, EncryptionKeySize)
+
+		_, err := decrypt(encrypt(plaintext), wrongKey)
+		Expect(err).To(MatchError("failed decrypting snapshot, the encryption key is wrong or the snapshot was modified"))
+	})
+
+	It("should fail decrypting modified snapshots", func() {
+		ciphertext := encrypt(plaintext)
+		ciphertext[len(ciphertext)/2] ^= 0xff
+
+		_, err := decrypt(ciphertext, key)
+		Expect(err).To(MatchError("failed decrypting snapshot, the encryption key is wrong or the snapshot was modified"))
+	})
+
+	It("should fail decrypting truncated snapshots", func() {
+		ciphertext := encrypt(plaintext)
+
+		By("Truncate in the middle of a chunk")
+		_, err := decrypt(ciphertext[:len(ciphertext)-10], key)
+		Expect(err).To(MatchError("snapshot is truncated"))
+
+		By("Truncate at a chunk boundary")
+		_, err = decrypt(ciphertext[:8+32+4+64*1024+16], key)
+		Expect(err).To(MatchError("snapshot is truncated"))
+	})
+
+	It("should fail decrypting snapshots with trailing data", func() {
+		_, err := decrypt(append(encrypt(plaintext), 0), key)
+		Expect(err).To(MatchError("snapshot contains data after the final chunk"))
+	})
+
+	It("should fail decrypting unencrypted snapshots", func() {
+		_, err := decrypt(plaintext, key)
+		Expect(err).To(MatchError("snapshot is not encrypted"))
+	})
+
+	It("should fail for keys with the wrong size", func() {
+		_, err := NewEncryptingWriter(&bytes.Buffer{}, key[:16])
+		Expect(err).To(MatchError("encryption key must be 32 bytes long, got 16 bytes"))
+	})
+
+	Describe("#ReadEncryptionKey", func() {
+		var fs afero.Afero
+
+		BeforeEach(func() {
+			fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		})
+
+		It("should read a base64-encoded key", func() {
+			Expect(fs.WriteFile("/key", []byte(base64.StdEncoding.EncodeToString(key)+"\n"), 0600)).To(Succeed())
+
+			Expect(ReadEncryptionKey(fs, "/key")).To(Equal(key))
+		})
+
+		It("should fail if the file does not exist", func() {
+			_, err := ReadEncryptionKey(fs, "/key")
+			Expect(err).To(MatchError(ContainSubstring("failed reading encryption key file /key")))
+		})
+
+		It("should fail if the key is not base64-encoded", func() {
+			Expect(fs.WriteFile("/key", []byte("not base64!"), 0600)).To(Succeed())
+
+			_, err := ReadEncryptionKey(fs, "/key")
+			Expect(err).To(MatchError(ContainSubstring("it must be base64-encoded")))
+		})
+
+		It("should fail if the key has the wrong size", func() {
+			Expect(fs.WriteFile("/key", []byte(base64.StdEncoding.EncodeToString(key[:16])), 0600)).To(Succeed())
+
+			_, err := ReadEncryptionKey(fs, "/key")
+			Expect(err).To(MatchError("encryption key in /key must be 32 bytes long, got 16 bytes"))
+		})
+	})
+})
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdsnapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/afero"
+)
+
+// localStore stores snapshots in a directory. The snapshots contain the secrets of the control plane, hence the
+// directory and the files are only accessible by their owner.
+type localStore struct {
+	fs  afero.Afero
+	dir string
+}
+
+func (l *localStore) Put(_ context.Context, name string, r io.ReadSeeker) (err error) {
+	if err := l.fs.MkdirAll(l.dir, 0700); err != nil {
+		return fmt.Errorf("failed creating snapshot directory %s: %w", l.dir, err)
+	}
+
+	// Write to a temporary file first, so that an interrupted upload does not leave a truncated snapshot behind.
+	file, err := l.fs.TempFile(l.dir, "."+name+"-")
+	if err != nil {
+		return fmt.Errorf("failed creating temporary file in %s: %w", l.dir, err)
+	}
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, l.fs.Remove(file.Name()))
+		}
+	}()
+
+	if err := l.fs.Chmod(file.Name(), 0600); err != nil {
+		return errors.Join(fmt.Errorf("failed restricting permissions of %s: %w", file.Name(), err), file.Close())
+	}
+	if _, err := io.Copy(file, r); err != nil {
+		return errors.Join(fmt.Errorf("failed writing %s: %w", file.Name(), err), file.Close())
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed closing %s: %w", file.Name(), err)
+	}
+
+	if err := l.fs.Rename(file.Name(), filepath.Join(l.dir, name)); err != nil {
+		return fmt.Errorf("failed renaming %s to %s: %w", file.Name(), name, err)
+	}
+	return nil
+}
+
+func (l *localStore) Get(_ context.Context, name string, w io.Writer) error {
+	path := filepath.Join(l.dir, name)
+
+	file, err := l.fs.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("snapshot %s not found in %s", name, l.dir)
+		}
+		return fmt.Errorf("failed opening %s: %w", path, err)
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(w, file); err != nil {
+		return fmt.Errorf("failed reading %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdsnapshot_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/etcdsnapshot"
+)
+
+var _ = Describe("Local Store", func() {
+	var (
+		ctx   context.Context
+		fs    afero.Afero
+		store Store
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+
+		var err error
+		store, err = NewStore(fs, "/var/backups/etcd", S3Options{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should store and return snapshots", func() {
+		Expect(store.Put(ctx, "snapshot.tar.gz", strings.NewReader("snapshot"))).To(Succeed())
+
+		info, err := fs.Stat("/var/backups/etcd/snapshot.tar.gz")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Mode().Perm()).To(BeEquivalentTo(0600))
+
+		dirInfo, err := fs.Stat("/var/backups/etcd")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dirInfo.Mode().Perm()).To(BeEquivalentTo(0700))
+
+		buffer := &bytes.Buffer{}
+		Expect(store.Get(ctx, "snapshot.tar.gz", buffer)).To(Succeed())
+		Expect(buffer.String()).To(Equal("snapshot"))
+	})
+
+	It("should overwrite existing snapshots and not leave temporary files behind", func() {
+		Expect(store.Put(ctx, "snapshot.tar.gz", strings.NewReader("old"))).To(Succeed())
+		Expect(store.Put(ctx, "snapshot.tar.gz", strings.NewReader("new"))).To(Succeed())
+
+		Expect(fs.ReadFile("/var/backups/etcd/snapshot.tar.gz")).To(Equal([]byte("new")))
+
+		entries, err := fs.ReadDir("/var/backups/etcd")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+	})
+
+	It("should fail if the snapshot does not exist", func() {
+		Expect(store.Get(ctx, "snapshot.tar.gz", &bytes.Buffer{})).To(MatchError("snapshot snapshot.tar.gz not found in /var/backups/etcd"))
+	})
+})
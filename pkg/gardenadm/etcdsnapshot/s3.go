@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdsnapshot
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+)
+
+const (
+	headerContentSHA256 = "X-Amz-Content-Sha256"
+	// emptyPayloadHash is the SHA-256 hash of an empty payload.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// s3Store stores snapshots in a bucket of an S3-compatible object store. It only implements the few requests needed
+// for uploading and downloading single objects, signed with AWS Signature Version 4.
+type s3Store struct {
+	endpoint    *url.URL
+	bucket      string
+	prefix      string
+	region      string
+	credentials aws.Credentials
+	httpClient  *http.Client
+	signer      *v4.Signer
+}
+
+func newS3Store(bucket, prefix string, opts S3Options) (*s3Store, error) {
+	if opts.Region == "" {
+		return nil, fmt.Errorf("region of the S3-compatible object store must not be empty")
+	}
+	if opts.AccessKeyID == "" || opts.SecretAccessKey == "" {
+		return nil, fmt.Errorf("credentials for the S3-compatible object store are missing, provide them with the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables")
+	}
+
+	endpoint := opts.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", opts.Region)
+	}
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing endpoint %s: %w", endpoint, err)
+	}
+	if endpointURL.Scheme != "https" && endpointURL.Scheme != "http" {
+		return nil, fmt.Errorf("endpoint %s must use the https or http scheme", endpoint)
+	}
+
+	httpClient := opts.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return &s3Store{
+		endpoint: endpointURL,
+		bucket:   bucket,
+		prefix:   prefix,
+		region:   opts.Region,
+		credentials: aws.Credentials{
+			AccessKeyID:     opts.AccessKeyID,
+			SecretAccessKey: opts.SecretAccessKey,
+			SessionToken:    opts.SessionToken,
+		},
+		httpClient: httpClient,
+		signer: v4.NewSigner(func(o *v4.SignerOptions) {
+			o.DisableURIPathEscaping = true
+		}),
+	}, nil
+}
+
+func (s *s3Store) Put(ctx context.Context, name string, r io.ReadSeeker) error {
+	hash := sha256.New()
+	size, err := io.Copy(hash, r)
+	if err != nil {
+		return fmt.Errorf("failed computing checksum of snapshot %s: %w", name, err)
+	}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed rewinding snapshot %s: %w", name, err)
+	}
+
+	request, err := s.newRequest(ctx, http.MethodPut, name, io.NopCloser(r), hex.EncodeToString(hash.Sum(nil)))
+	if err != nil {
+		return err
+	}
+	request.ContentLength = size
+
+	response, err := s.do(request)
+	if err != nil {
+		return fmt.Errorf("failed uploading snapshot %s: %w", name, err)
+	}
+	return response.Body.Close()
+}
+
+func (s *s3Store) Get(ctx context.Context, name string, w io.Writer) error {
+	request, err := s.newRequest(ctx, http.MethodGet, name, nil, emptyPayloadHash)
+	if err != nil {
+		return err
+	}
+
+	response, err := s.do(request)
+	if err != nil {
+		return fmt.Errorf("failed downloading snapshot %s: %w", name, err)
+	}
+	defer response.Body.Close()
+
+	if _, err := io.Copy(w, response.Body); err != nil {
+		return fmt.Errorf("failed downloading snapshot %s: %w", name, err)
+	}
+	return nil
+}
+
+func (s *s3Store) newRequest(ctx context.Context, method, name string, body io.ReadCloser, payloadHash string) (*http.Request, error) {
+	objectURL := *s.endpoint
+	objectURL.Path = path.Join("/", s.endpoint.Path, s.bucket, s.prefix, name)
+
+	request, err := http.NewRequestWithContext(ctx, method, objectURL.String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating request: %w", err)
+	}
+	request.Header.Set(headerContentSHA256, payloadHash)
+
+	return request, nil
+}
+
+func (s *s3Store) do(request *http.Request) (*http.Response, error) {
+	if err := s.signer.SignHTTP(request.Context(), s.credentials, request, request.Header.Get(headerContentSHA256), "s3", s.region, time.Now()); err != nil {
+		return nil, fmt.Errorf("failed signing request: %w", err)
+	}
+
+	response, err := s.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		defer response.Body.Close()
+		// S3 returns an XML document describing the error, which is short enough to be included in the error message.
+		body, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		return nil, fmt.Errorf("unexpected response status %s: %s", response.Status, body)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdsnapshot_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/etcdsnapshot"
+)
+
+var _ = Describe("S3 Store", func() {
+	var (
+		ctx    context.Context
+		server *httptest.Server
+		store  Store
+
+		lock    sync.Mutex
+		objects map[string][]byte
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		objects = map[string][]byte{}
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+
+			Expect(r.Header.Get("Authorization")).To(HavePrefix("AWS4-HMAC-SHA256 Credential=access-key/"))
+			Expect(r.Header.Get("Authorization")).To(ContainSubstring("/eu-west-1/s3/aws4_request"))
+			Expect(r.Header.Get("X-Amz-Date")).NotTo(BeEmpty())
+
+			lock.Lock()
+			defer lock.Unlock()
+
+			switch r.Method {
+			case http.MethodPut:
+				body, err := io.ReadAll(r.Body)
+				Expect(err).NotTo(HaveOccurred())
+				checksum := sha256.Sum256(body)
+				Expect(r.Header.Get("X-Amz-Content-Sha256")).To(Equal(hex.EncodeToString(checksum[:])))
+
+				objects[r.URL.Path] = body
+			case http.MethodGet:
+				object, ok := objects[r.URL.Path]
+				if !ok {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte("<Error><Code>NoSuchKey</Code></Error>"))
+					return
+				}
+				_, _ = w.Write(object)
+			default:
+				w.WriteHeader(http.StatusMethodNotAllowed)
+			}
+		}))
+		DeferCleanup(server.Close)
+
+		var err error
+		store, err = NewStore(afero.Afero{Fs: afero.NewMemMapFs()}, "s3://bucket/prefix/", S3Options{
+			Endpoint:        server.URL,
+			Region:          "eu-west-1",
+			AccessKeyID:     "access-key",
+			SecretAccessKey: "secret-key",
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should upload and download snapshots with path-style addressing", func() {
+		Expect(store.Put(ctx, "snapshot.tar.gz", strings.NewReader("snapshot"))).To(Succeed())
+		Expect(objects).To(HaveKeyWithValue("/bucket/prefix/snapshot.tar.gz", []byte("snapshot")))
+
+		buffer := &bytes.Buffer{}
+		Expect(store.Get(ctx, "snapshot.tar.gz", buffer)).To(Succeed())
+		Expect(buffer.String()).To(Equal("snapshot"))
+	})
+
+	It("should fail if the snapshot does not exist", func() {
+		Expect(store.Get(ctx, "snapshot.tar.gz", &bytes.Buffer{})).To(MatchError(And(
+			ContainSubstring("failed downloading snapshot snapshot.tar.gz: unexpected response status 404 Not Found"),
+			ContainSubstring("NoSuchKey"),
+		)))
+	})
+})
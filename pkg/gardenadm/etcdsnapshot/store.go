@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdsnapshot
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+// Store stores etcd snapshots of self-hosted shoot clusters.
+type Store interface {
+	// Put stores the content of the given reader under the given name. An existing snapshot with the same name is
+	// overwritten.
+	Put(ctx context.Context, name string, r io.ReadSeeker) error
+	// Get writes the content of the snapshot with the given name to the given writer.
+	Get(ctx context.Context, name string, w io.Writer) error
+}
+
+// S3Options contains the options for storing snapshots in an S3-compatible object store.
+type S3Options struct {
+	// Endpoint is the URL of the S3-compatible endpoint. Buckets are addressed path-style, so that S3-compatible object
+	// stores like MinIO can be used. If empty, the AWS S3 endpoint of the region is used.
+	Endpoint string
+	// Region is the region used for signing the requests.
+	Region string
+	// AccessKeyID is the access key ID used for signing the requests.
+	AccessKeyID string
+	// SecretAccessKey is the secret access key used for signing the requests.
+	SecretAccessKey string
+	// SessionToken is the optional session token for temporary credentials.
+	SessionToken string
+	// HTTPClient is the client used for sending the requests. If nil, http.DefaultClient is used.
+	HTTPClient *http.Client
+}
+
+// S3CredentialsFromEnvironment reads the credentials for the S3-compatible object store from the environment variables
+// commonly used by S3 clients (AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY, and AWS_SESSION_TOKEN).
+func S3CredentialsFromEnvironment(opts *S3Options) {
+	opts.AccessKeyID = os.Getenv("AWS_ACCESS_KEY_ID")
+	opts.SecretAccessKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+	opts.SessionToken = os.Getenv("AWS_SESSION_TOKEN")
+}
+
+// SchemeS3 is the scheme of locations in an S3-compatible object store, e.g., s3://bucket/prefix.
+const SchemeS3 = "s3://"
+
+// NewStore returns the store for the given location. Locations starting with s3:// (e.g., s3://bucket/prefix) refer to
+// an S3-compatible object store configured with the given options, all other locations refer to a directory on the
+// given file system.
+func NewStore(fs afero.Afero, location string, s3Options S3Options) (Store, error) {
+	if location == "" {
+		return nil, fmt.Errorf("location must not be empty")
+	}
+
+	if !strings.HasPrefix(location, SchemeS3) {
+		return &localStore{fs: fs, dir: location}, nil
+	}
+
+	bucket, prefix, _ := strings.Cut(strings.TrimPrefix(location, SchemeS3), "/")
+	if bucket == "" {
+		return nil, fmt.Errorf("location %s does not contain a bucket name", location)
+	}
+
+	return newS3Store(bucket, strings.Trim(prefix, "/"), s3Options)
+}
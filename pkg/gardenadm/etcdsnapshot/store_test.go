@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcdsnapshot_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/etcdsnapshot"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Store", func() {
+	var (
+		fs        afero.Afero
+		s3Options S3Options
+	)
+
+	BeforeEach(func() {
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		s3Options = S3Options{Region: "eu-west-1", AccessKeyID: "access-key", SecretAccessKey: "secret-key"}
+	})
+
+	Describe("#NewStore", func() {
+		It("should return a store for a local directory", func() {
+			Expect(NewStore(fs, "/var/backups/etcd", S3Options{})).NotTo(BeNil())
+		})
+
+		It("should return a store for an S3 bucket", func() {
+			Expect(NewStore(fs, "s3://bucket/prefix", s3Options)).NotTo(BeNil())
+		})
+
+		It("should fail if the location is empty", func() {
+			_, err := NewStore(fs, "", s3Options)
+			Expect(err).To(MatchError("location must not be empty"))
+		})
+
+		It("should fail if the S3 location does not contain a bucket", func() {
+			_, err := NewStore(fs, "s3:///prefix", s3Options)
+			Expect(err).To(MatchError("location s3:///prefix does not contain a bucket name"))
+		})
+
+		It("should fail if the S3 credentials are missing", func() {
+			s3Options.SecretAccessKey = ""
+
+			_, err := NewStore(fs, "s3://bucket", s3Options)
+			Expect(err).To(MatchError(ContainSubstring("credentials for the S3-compatible object store are missing")))
+		})
+
+		It("should fail if the S3 region is missing", func() {
+			s3Options.Region = ""
+
+			_, err := NewStore(fs, "s3://bucket", s3Options)
+			Expect(err).To(MatchError(ContainSubstring("region of the S3-compatible object store must not be empty")))
+		})
+
+		It("should fail if the S3 endpoint has an unsupported scheme", func() {
+			s3Options.Endpoint = "ftp://minio.example.com"
+
+			_, err := NewStore(fs, "s3://bucket", s3Options)
+			Expect(err).To(MatchError("endpoint ftp://minio.example.com must use the https or http scheme"))
+		})
+	})
+
+	Describe("#S3CredentialsFromEnvironment", func() {
+		It("should read the credentials from the environment", func() {
+			DeferCleanup(test.WithEnvVar("AWS_ACCESS_KEY_ID", "id"))
+			DeferCleanup(test.WithEnvVar("AWS_SECRET_ACCESS_KEY", "secret"))
+			DeferCleanup(test.WithEnvVar("AWS_SESSION_TOKEN", "token"))
+
+			opts := S3Options{Region: "eu-west-1"}
+			S3CredentialsFromEnvironment(&opts)
+			Expect(opts).To(Equal(S3Options{Region: "eu-west-1", AccessKeyID: "id", SecretAccessKey: "secret", SessionToken: "token"}))
+		})
+	})
+})
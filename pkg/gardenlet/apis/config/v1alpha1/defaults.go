@@ -313,6 +313,10 @@ func SetDefaults_SeedCareControllerConfiguration(obj *SeedCareControllerConfigur
 		v := metav1.Duration{Duration: 30 * time.Second}
 		obj.SyncPeriod = &v
 	}
+
+	if obj.ResourceUsageSyncPeriod == nil {
+		obj.ResourceUsageSyncPeriod = &metav1.Duration{Duration: 10 * time.Minute}
+	}
 }
 
 // SetDefaults_ShootControllerConfiguration sets defaults for the shoot controller.
@@ -363,6 +367,10 @@ func SetDefaults_ShootCareControllerConfiguration(obj *ShootCareControllerConfig
 		v := StaleExtensionHealthChecks{Enabled: true}
 		obj.StaleExtensionHealthChecks = &v
 	}
+
+	if obj.CACertificateExpirationThreshold == nil {
+		obj.CACertificateExpirationThreshold = &metav1.Duration{Duration: 365 * 24 * time.Hour}
+	}
 }
 
 // SetDefaults_StaleExtensionHealthChecks sets defaults for the stale extension health checks.
@@ -343,16 +343,19 @@ var _ = Describe("Defaults", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
 
 			Expect(obj.Controllers.SeedCare.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 30 * time.Second})))
+			Expect(obj.Controllers.SeedCare.ResourceUsageSyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Minute})))
 		})
 
 		It("should not overwrite already set values for the seed care controller configuration", func() {
 			syncPeriod := metav1.Duration{Duration: 2 * time.Minute}
+			resourceUsageSyncPeriod := metav1.Duration{Duration: time.Hour}
 			obj.Controllers = &GardenletControllerConfiguration{
-				SeedCare: &SeedCareControllerConfiguration{SyncPeriod: &syncPeriod},
+				SeedCare: &SeedCareControllerConfiguration{SyncPeriod: &syncPeriod, ResourceUsageSyncPeriod: &resourceUsageSyncPeriod},
 			}
 			SetObjectDefaults_GardenletConfiguration(obj)
 
 			Expect(obj.Controllers.SeedCare.SyncPeriod).To(PointTo(Equal(syncPeriod)))
+			Expect(obj.Controllers.SeedCare.ResourceUsageSyncPeriod).To(PointTo(Equal(resourceUsageSyncPeriod)))
 		})
 	})
 
@@ -399,15 +402,17 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.ShootCare.ConcurrentSyncs).To(PointTo(Equal(20)))
 			Expect(obj.Controllers.ShootCare.StaleExtensionHealthChecks.Enabled).To(BeTrue())
 			Expect(obj.Controllers.ShootCare.StaleExtensionHealthChecks.Threshold).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Minute})))
+			Expect(obj.Controllers.ShootCare.CACertificateExpirationThreshold).To(PointTo(Equal(metav1.Duration{Duration: 8760 * time.Hour})))
 		})
 
 		It("should not overwrite already set values for the shoot care controller configuration", func() {
 			syncPeriod := metav1.Duration{Duration: 2 * time.Minute}
 			obj.Controllers = &GardenletControllerConfiguration{
 				ShootCare: &ShootCareControllerConfiguration{
-					SyncPeriod:                 &syncPeriod,
-					ConcurrentSyncs:            ptr.To(10),
-					StaleExtensionHealthChecks: &StaleExtensionHealthChecks{Enabled: false},
+					SyncPeriod:                       &syncPeriod,
+					ConcurrentSyncs:                  ptr.To(10),
+					StaleExtensionHealthChecks:       &StaleExtensionHealthChecks{Enabled: false},
+					CACertificateExpirationThreshold: &metav1.Duration{Duration: 720 * time.Hour},
 				},
 			}
 			SetObjectDefaults_GardenletConfiguration(obj)
@@ -415,6 +420,7 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.ShootCare.SyncPeriod).To(PointTo(Equal(syncPeriod)))
 			Expect(obj.Controllers.ShootCare.ConcurrentSyncs).To(PointTo(Equal(10)))
 			Expect(obj.Controllers.ShootCare.StaleExtensionHealthChecks.Enabled).To(BeFalse())
+			Expect(obj.Controllers.ShootCare.CACertificateExpirationThreshold).To(PointTo(Equal(metav1.Duration{Duration: 720 * time.Hour})))
 		})
 	})
 
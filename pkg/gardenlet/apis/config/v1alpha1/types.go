@@ -55,6 +55,9 @@ type GardenletConfiguration struct {
 	// Debugging holds configuration for Debugging related features.
 	// +optional
 	Debugging *componentbaseconfigv1alpha1.DebuggingConfiguration `json:"debugging,omitempty"`
+	// Tracing contains configuration for exporting traces, e.g., of the reconciliation flows.
+	// +optional
+	Tracing *TracingConfiguration `json:"tracing,omitempty"`
 	// FeatureGates is a map of feature names to bools that enable or disable alpha/experimental
 	// features. This field modifies piecemeal the built-in default values from
 	// "github.com/gardener/gardener/pkg/gardenlet/features/features.go".
@@ -320,6 +323,27 @@ type ShootControllerConfiguration struct {
 	// Default: 120s
 	// +optional
 	DNSEntryTTLSeconds *int64 `json:"dnsEntryTTLSeconds,omitempty"`
+	// CAPrivateKeyStore configures a store keeping the private keys of the shoot CAs outside the seed. If set, the
+	// private keys of newly generated CAs are kept in the store instead of the secrets in the seed and the ShootState.
+	// This does not apply to the client and kubelet CAs whose private keys are needed by the kube-controller-manager.
+	// The store must be reachable from all seeds the shoots might be migrated to.
+	// +optional
+	CAPrivateKeyStore *PrivateKeyStoreConfiguration `json:"caPrivateKeyStore,omitempty"`
+}
+
+// PrivateKeyStoreConfiguration defines the configuration of a store for private keys. Exactly one store must be
+// configured.
+type PrivateKeyStoreConfiguration struct {
+	// File configures a store keeping the private keys in files below a local directory. It is meant for development
+	// and single-seed setups only.
+	// +optional
+	File *FilePrivateKeyStoreConfiguration `json:"file,omitempty"`
+}
+
+// FilePrivateKeyStoreConfiguration defines the configuration of a store keeping private keys in files.
+type FilePrivateKeyStoreConfiguration struct {
+	// Directory is the absolute path of the directory the private keys are stored in.
+	Directory string `json:"directory"`
 }
 
 // ShootCareControllerConfiguration defines the configuration of the ShootCare
@@ -350,6 +374,11 @@ type ShootCareControllerConfiguration struct {
 	// is enabled.
 	// +optional
 	WebhookRemediatorEnabled *bool `json:"webhookRemediatorEnabled,omitempty"`
+	// CACertificateExpirationThreshold is the minimum remaining validity of the CA certificates of a Shoot. If a CA
+	// certificate expires earlier, the CACertificateValiditiesAcceptable constraint is set to False, unless a rotation
+	// of the certificate authorities is in progress. Defaults to 8760h (one year).
+	// +optional
+	CACertificateExpirationThreshold *metav1.Duration `json:"caCertificateExpirationThreshold,omitempty"`
 }
 
 // SeedCareControllerConfiguration defines the configuration of the SeedCare
@@ -362,6 +391,11 @@ type SeedCareControllerConfiguration struct {
 	// ConditionThresholds defines the condition threshold per condition type.
 	// +optional
 	ConditionThresholds []ConditionThreshold `json:"conditionThresholds,omitempty"`
+	// ResourceUsageSyncPeriod is the duration how often the resource usage of the seed cluster is computed and published
+	// in the Seed status. Computing it requires listing all pods in shoot namespaces and all nodes, hence it should be
+	// significantly longer than the SyncPeriod.
+	// +optional
+	ResourceUsageSyncPeriod *metav1.Duration `json:"resourceUsageSyncPeriod,omitempty"`
 }
 
 // ShootStateControllerConfiguration defines the configuration of the ShootState controller.
@@ -541,6 +575,15 @@ type ServerConfiguration struct {
 	Metrics *Server `json:"metrics,omitempty"`
 }
 
+// TracingConfiguration contains configuration for exporting traces via OTLP.
+type TracingConfiguration struct {
+	// Endpoint is the address of the OTLP/gRPC endpoint to which the spans are exported, e.g. `otel-collector:4317`.
+	Endpoint string `json:"endpoint"`
+	// Insecure disables TLS for the connection to the endpoint.
+	// +optional
+	Insecure *bool `json:"insecure,omitempty"`
+}
+
 // Server contains information for HTTP(S) server configuration.
 type Server struct {
 	// BindAddress is the IP address on which to listen for the specified port.
@@ -7,6 +7,7 @@ package validation
 import (
 	"fmt"
 	"net"
+	"path/filepath"
 	"time"
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -107,6 +108,10 @@ func ValidateGardenletConfiguration(cfg *gardenletconfigv1alpha1.GardenletConfig
 		}
 	}
 
+	if cfg.Tracing != nil && cfg.Tracing.Endpoint == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("tracing", "endpoint"), "must provide an endpoint when tracing is configured"))
+	}
+
 	allErrs = append(allErrs, validateExposureClassHandlers(cfg.ExposureClassHandlers, fldPath.Child("exposureClassHandlers"))...)
 
 	if nodeTolerationCfg := cfg.NodeToleration; nodeTolerationCfg != nil {
@@ -223,6 +228,25 @@ func validateShootControllerConfiguration(cfg *gardenletconfigv1alpha1.ShootCont
 		}
 	}
 
+	if cfg.CAPrivateKeyStore != nil {
+		allErrs = append(allErrs, validatePrivateKeyStoreConfiguration(cfg.CAPrivateKeyStore, fldPath.Child("caPrivateKeyStore"))...)
+	}
+
+	return allErrs
+}
+
+func validatePrivateKeyStoreConfiguration(cfg *gardenletconfigv1alpha1.PrivateKeyStoreConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.File == nil {
+		allErrs = append(allErrs, field.Required(fldPath, "exactly one private key store must be configured"))
+		return allErrs
+	}
+
+	if !filepath.IsAbs(cfg.File.Directory) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("file", "directory"), cfg.File.Directory, "must be an absolute path"))
+	}
+
 	return allErrs
 }
 
@@ -249,6 +273,10 @@ func validateShootCareControllerConfiguration(cfg *gardenletconfigv1alpha1.Shoot
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.ConditionThresholds[i].Duration.Duration), fldPath.Child("conditionThresholds").Index(i).Child("duration"))...)
 	}
 
+	if cfg.CACertificateExpirationThreshold != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.CACertificateExpirationThreshold.Duration), fldPath.Child("caCertificateExpirationThreshold"))...)
+	}
+
 	return allErrs
 }
 
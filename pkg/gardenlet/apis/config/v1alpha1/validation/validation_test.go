@@ -361,6 +361,38 @@ var _ = Describe("GardenletConfiguration", func() {
 					"Field": Equal("controllers.shoot.dnsEntryTTLSeconds"),
 				}))))
 			})
+
+			It("should allow a valid CA private key store", func() {
+				cfg.Controllers.Shoot.CAPrivateKeyStore = &gardenletconfigv1alpha1.PrivateKeyStoreConfiguration{
+					File: &gardenletconfigv1alpha1.FilePrivateKeyStoreConfiguration{Directory: "/var/lib/ca-private-keys"},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil)).To(BeEmpty())
+			})
+
+			It("should forbid a CA private key store without store", func() {
+				cfg.Controllers.Shoot.CAPrivateKeyStore = &gardenletconfigv1alpha1.PrivateKeyStoreConfiguration{}
+
+				errorList := ValidateGardenletConfiguration(cfg, nil)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.shoot.caPrivateKeyStore"),
+				}))))
+			})
+
+			It("should forbid a relative directory for the CA private key store", func() {
+				cfg.Controllers.Shoot.CAPrivateKeyStore = &gardenletconfigv1alpha1.PrivateKeyStoreConfiguration{
+					File: &gardenletconfigv1alpha1.FilePrivateKeyStoreConfiguration{Directory: "ca-private-keys"},
+				}
+
+				errorList := ValidateGardenletConfiguration(cfg, nil)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.shoot.caPrivateKeyStore.file.directory"),
+				}))))
+			})
 		})
 
 		Context("shootCare controller", func() {
@@ -372,6 +404,7 @@ var _ = Describe("GardenletConfiguration", func() {
 				cfg.Controllers.ShootCare.StaleExtensionHealthChecks = &gardenletconfigv1alpha1.StaleExtensionHealthChecks{Threshold: &metav1.Duration{Duration: -1}}
 				cfg.Controllers.ShootCare.ManagedResourceProgressingThreshold = &metav1.Duration{Duration: -1}
 				cfg.Controllers.ShootCare.ConditionThresholds = []gardenletconfigv1alpha1.ConditionThreshold{{Duration: metav1.Duration{Duration: -1}}}
+				cfg.Controllers.ShootCare.CACertificateExpirationThreshold = &metav1.Duration{Duration: -1}
 
 				errorList := ValidateGardenletConfiguration(cfg, nil)
 
@@ -396,6 +429,10 @@ var _ = Describe("GardenletConfiguration", func() {
 						"Type":  Equal(field.ErrorTypeInvalid),
 						"Field": Equal("controllers.shootCare.conditionThresholds[0].duration"),
 					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootCare.caCertificateExpirationThreshold"),
+					})),
 				))
 			})
 		})
@@ -605,6 +642,25 @@ var _ = Describe("GardenletConfiguration", func() {
 			})
 		})
 
+		Context("tracing", func() {
+			It("should pass as tracing config contains an endpoint", func() {
+				cfg.Tracing = &gardenletconfigv1alpha1.TracingConfiguration{Endpoint: "otel-collector:4317"}
+
+				errorList := ValidateGardenletConfiguration(cfg, nil)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid as tracing config does not contain an endpoint", func() {
+				cfg.Tracing = &gardenletconfigv1alpha1.TracingConfiguration{}
+
+				errorList := ValidateGardenletConfiguration(cfg, nil)
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("tracing.endpoint"),
+				}))))
+			})
+		})
+
 		Context("exposureClassHandlers", func() {
 			BeforeEach(func() {
 				cfg.ExposureClassHandlers = []gardenletconfigv1alpha1.ExposureClassHandler{
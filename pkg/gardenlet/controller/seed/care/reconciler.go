@@ -6,19 +6,25 @@ package care
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	resourcehelper "k8s.io/component-helpers/resource"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
@@ -37,6 +43,8 @@ type Reconciler struct {
 	Clock        clock.Clock
 	Namespace    *string
 	SeedName     string
+
+	lastResourceUsageComputation time.Time
 }
 
 // Reconcile reconciles Seed resources and executes health check operations.
@@ -90,9 +98,20 @@ func (r *Reconciler) Reconcile(reconcileCtx context.Context, req reconcile.Reque
 		}
 	}
 
-	// Trigger garbage collection
+	var errs []error
+
+	// Update Seed status resource usage if necessary
+	if err := r.updateResourceUsage(ctx, log, seed); err != nil {
+		errs = append(errs, fmt.Errorf("failed updating resource usage: %w", err))
+	}
+
+	// Trigger garbage collection, even if the resource usage could not be updated
 	if err := r.performGarbageCollection(ctx, log); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed performing garbage collection: %w", err)
+		errs = append(errs, fmt.Errorf("failed performing garbage collection: %w", err))
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return reconcile.Result{}, err
 	}
 
 	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
@@ -120,3 +139,108 @@ func (r *Reconciler) performGarbageCollection(ctx context.Context, log logr.Logg
 
 	return kubernetesutils.DeleteStalePods(ctx, log, r.SeedClient, podList.Items)
 }
+
+// resourceUsageNames are the names of the resources for which the usage is published in the Seed status.
+var resourceUsageNames = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}
+
+func (r *Reconciler) updateResourceUsage(ctx context.Context, log logr.Logger, seed *gardencorev1beta1.Seed) error {
+	// Computing the resource usage is expensive on large seeds, hence it is only done once per resource usage sync
+	// period instead of in every care sync.
+	now := r.Clock.Now()
+	if r.Config.ResourceUsageSyncPeriod != nil && seed.Status.ResourceUsage != nil && now.Sub(r.lastResourceUsageComputation) < r.Config.ResourceUsageSyncPeriod.Duration {
+		return nil
+	}
+
+	resourceUsage, err := r.computeResourceUsage(ctx)
+	if err != nil {
+		return err
+	}
+	r.lastResourceUsageComputation = now
+
+	if apiequality.Semantic.DeepEqual(seed.Status.ResourceUsage, resourceUsage) {
+		return nil
+	}
+
+	log.V(1).Info("Updating seed status resource usage")
+	patch := client.MergeFrom(seed.DeepCopy())
+	seed.Status.ResourceUsage = resourceUsage
+	return r.GardenClient.Status().Patch(ctx, seed, patch)
+}
+
+// computeResourceUsage aggregates the resource requests of all pods in shoot namespaces and the allocatable resources
+// of all nodes. The values are rounded up (CPU to 100m, memory to 1Mi) to avoid status updates for marginal changes.
+func (r *Reconciler) computeResourceUsage(ctx context.Context) (*gardencorev1beta1.SeedResourceUsage, error) {
+	resourceUsage := &gardencorev1beta1.SeedResourceUsage{
+		ControlPlaneRequests: newResourceUsageList(),
+		NodeAllocatable:      newResourceUsageList(),
+	}
+
+	namespaceList := &metav1.PartialObjectMetadataList{}
+	namespaceList.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("NamespaceList"))
+	if err := r.SeedClient.List(ctx, namespaceList, client.MatchingLabels{v1beta1constants.GardenRole: v1beta1constants.GardenRoleShoot}); err != nil {
+		return nil, fmt.Errorf("failed listing shoot namespaces: %w", err)
+	}
+
+	shootNamespaces := sets.New[string]()
+	for _, namespace := range namespaceList.Items {
+		shootNamespaces.Insert(namespace.Name)
+	}
+
+	// List all pods at once (served from the cache which is also used for the garbage collection) instead of listing
+	// them per shoot namespace.
+	podList := &corev1.PodList{}
+	if err := r.SeedClient.List(ctx, podList); err != nil {
+		return nil, fmt.Errorf("failed listing pods: %w", err)
+	}
+
+	for _, pod := range podList.Items {
+		if !shootNamespaces.Has(pod.Namespace) || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		addResourceUsage(resourceUsage.ControlPlaneRequests, resourcehelper.PodRequests(&pod, resourcehelper.PodResourcesOptions{}))
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := r.SeedClient.List(ctx, nodeList); err != nil {
+		return nil, fmt.Errorf("failed listing nodes: %w", err)
+	}
+
+	for _, node := range nodeList.Items {
+		addResourceUsage(resourceUsage.NodeAllocatable, node.Status.Allocatable)
+	}
+
+	roundResourceUsage(resourceUsage.ControlPlaneRequests)
+	roundResourceUsage(resourceUsage.NodeAllocatable)
+	return resourceUsage, nil
+}
+
+func newResourceUsageList() corev1.ResourceList {
+	list := make(corev1.ResourceList, len(resourceUsageNames))
+	for _, name := range resourceUsageNames {
+		list[name] = resource.Quantity{}
+	}
+	return list
+}
+
+func addResourceUsage(list, resources corev1.ResourceList) {
+	for _, name := range resourceUsageNames {
+		if quantity, ok := resources[name]; ok {
+			sum := list[name]
+			sum.Add(quantity)
+			list[name] = sum
+		}
+	}
+}
+
+func roundResourceUsage(list corev1.ResourceList) {
+	const (
+		cpuStepMilli = 100
+		memoryStep   = 1 << 20
+	)
+
+	cpu := list[corev1.ResourceCPU]
+	list[corev1.ResourceCPU] = *resource.NewMilliQuantity((cpu.MilliValue()+cpuStepMilli-1)/cpuStepMilli*cpuStepMilli, resource.DecimalSI)
+
+	memory := list[corev1.ResourceMemory]
+	list[corev1.ResourceMemory] = *resource.NewQuantity((memory.Value()+memoryStep-1)/memoryStep*memoryStep, resource.BinarySI)
+}
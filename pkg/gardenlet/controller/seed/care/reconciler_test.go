@@ -6,17 +6,21 @@ package care_test
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
 	testclock "k8s.io/utils/clock/testing"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -27,6 +31,7 @@ import (
 	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/care"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health/checker"
 	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
 const (
@@ -189,6 +194,106 @@ var _ = Describe("Seed Care Control", func() {
 		})
 	})
 
+	Describe("#ResourceUsage", func() {
+		var (
+			req reconcile.Request
+
+			newPod = func(namespace, name string, phase corev1.PodPhase, cpu, memory string) *corev1.Pod {
+				return &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+					Spec: corev1.PodSpec{Containers: []corev1.Container{{
+						Name: "container",
+						Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse(cpu),
+							corev1.ResourceMemory: resource.MustParse(memory),
+						}},
+					}}},
+					Status: corev1.PodStatus{Phase: phase},
+				}
+			}
+		)
+
+		BeforeEach(func() {
+			req = reconcile.Request{NamespacedName: client.ObjectKey{Name: seedName}}
+
+			controllerConfig = gardenletconfigv1alpha1.SeedCareControllerConfiguration{
+				SyncPeriod:              &metav1.Duration{Duration: careSyncPeriod},
+				ResourceUsageSyncPeriod: &metav1.Duration{Duration: 10 * careSyncPeriod},
+			}
+
+			DeferCleanup(test.WithVars(&NewHealthCheck,
+				healthCheckFunc(func(_ SeedConditions) []gardencorev1beta1.Condition { return nil })))
+
+			for _, obj := range []client.Object{
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar", Labels: map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleShoot}}},
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "garden"}},
+				newPod("shoot--foo--bar", "kube-apiserver", corev1.PodRunning, "1", "1Gi"),
+				newPod("shoot--foo--bar", "etcd", corev1.PodRunning, "250m", "512Mi"),
+				newPod("shoot--foo--bar", "job", corev1.PodSucceeded, "1", "1Gi"),
+				newPod("garden", "gardenlet", corev1.PodRunning, "1", "1Gi"),
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}, Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("3920m"), corev1.ResourceMemory: resource.MustParse("14Gi")}}},
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-2"}, Status: corev1.NodeStatus{Allocatable: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("3920m"), corev1.ResourceMemory: resource.MustParse("14Gi")}}},
+			} {
+				Expect(seedClient.Create(ctx, obj)).To(Succeed())
+			}
+		})
+
+		JustBeforeEach(func() {
+			Expect(gardenClient.Create(ctx, seed)).To(Succeed())
+			reconciler = &Reconciler{GardenClient: gardenClient, SeedClient: seedClient, Config: controllerConfig, Clock: fakeClock}
+		})
+
+		It("should publish the aggregated control plane requests and node allocatable resources", func() {
+			Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+			updatedSeed := &gardencorev1beta1.Seed{}
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(seed), updatedSeed)).To(Succeed())
+			Expect(updatedSeed.Status.ResourceUsage).NotTo(BeNil())
+			Expect(updatedSeed.Status.ResourceUsage.ControlPlaneRequests.Cpu().String()).To(Equal("1300m"))
+			Expect(updatedSeed.Status.ResourceUsage.ControlPlaneRequests.Memory().String()).To(Equal("1536Mi"))
+			Expect(updatedSeed.Status.ResourceUsage.NodeAllocatable.Cpu().String()).To(Equal("7900m"))
+			Expect(updatedSeed.Status.ResourceUsage.NodeAllocatable.Memory().String()).To(Equal("28Gi"))
+		})
+
+		It("should recompute the resource usage only once per resource usage sync period", func() {
+			Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+			Expect(seedClient.Create(ctx, newPod("shoot--foo--bar", "prometheus", corev1.PodRunning, "1", "1Gi"))).To(Succeed())
+
+			fakeClock.Step(careSyncPeriod)
+			Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+			updatedSeed := &gardencorev1beta1.Seed{}
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(seed), updatedSeed)).To(Succeed())
+			Expect(updatedSeed.Status.ResourceUsage.ControlPlaneRequests.Cpu().String()).To(Equal("1300m"))
+
+			fakeClock.Step(9 * careSyncPeriod)
+			Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(seed), updatedSeed)).To(Succeed())
+			Expect(updatedSeed.Status.ResourceUsage.ControlPlaneRequests.Cpu().String()).To(Equal("2300m"))
+		})
+
+		It("should perform the garbage collection even if the resource usage cannot be updated", func() {
+			evictedPod := newPod("shoot--foo--bar", "evicted", corev1.PodFailed, "1", "1Gi")
+			evictedPod.Status.Reason = "Evicted"
+			Expect(seedClient.Create(ctx, evictedPod)).To(Succeed())
+
+			reconciler.SeedClient = interceptor.NewClient(seedClient.(client.WithWatch), interceptor.Funcs{
+				List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+					if _, ok := list.(*corev1.NodeList); ok {
+						return fmt.Errorf("fake error")
+					}
+					return c.List(ctx, list, opts...)
+				},
+			})
+
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).To(MatchError(ContainSubstring("failed updating resource usage: failed listing nodes: fake error")))
+
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(evictedPod), evictedPod)).To(BeNotFoundError())
+		})
+	})
+
 	Describe("#Reconcile", func() {
 		Describe("emergency switch annotation condition", func() {
 			var req reconcile.Request
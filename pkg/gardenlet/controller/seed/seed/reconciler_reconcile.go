@@ -33,6 +33,7 @@ import (
 	sharedcomponent "github.com/gardener/gardener/pkg/component/shared"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
+	gardenletmetrics "github.com/gardener/gardener/pkg/gardenlet/metrics"
 	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
@@ -512,7 +513,19 @@ func (r *Reconciler) runReconcileSeedFlow(
 		return flow.Errors(err)
 	}
 
-	return secretsManager.Cleanup(ctx)
+	if err := secretsManager.Cleanup(ctx); err != nil {
+		return err
+	}
+
+	expirations, err := gardenerutils.CertificateExpirations(ctx, r.SeedClientSet.Client(), r.GardenNamespace, v1beta1constants.SecretManagerIdentityGardenlet)
+	if err != nil {
+		// The certificate expirations are only informational, hence they must not fail the reconciliation.
+		log.Error(err, "Failed computing certificate expirations")
+		return nil
+	}
+	gardenletmetrics.RecordCertificateExpirations(r.GardenNamespace, expirations)
+
+	return nil
 }
 
 func cleanupOrphanExposureClassHandlerResources(
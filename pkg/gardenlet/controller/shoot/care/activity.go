@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	prom "github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	shootprometheus "github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/shoot"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+)
+
+// activityWindow is the time window in which the kube-apiserver request metrics are checked for activity. The last
+// activity time of a shoot is not updated more often than this.
+const activityWindow = 15 * time.Minute
+
+// activityQuery returns the kube-apiserver requests served within the given time window which might indicate user
+// activity, i.e., the dispatched requests per API Priority and Fairness flow schema as well as the mutating and connect
+// requests per resource. The kube-apiserver metrics do not carry the requesting user, hence the result is classified by
+// isUserActivity.
+const activityQuery = `(sum by (flow_schema) (increase(apiserver_flowcontrol_dispatched_requests_total[%[1]s])) > 0) or (sum by (verb, resource, subresource) (increase(apiserver_request_total{verb=~"POST|PUT|PATCH|DELETE|DELETECOLLECTION|APPLY|CONNECT"}[%[1]s])) > 0)`
+
+var (
+	// userFlowSchemas are the flow schemas which only match requests of users. Requests of system components are
+	// matched by the more specific flow schemas for nodes, the kube-system service accounts (which are also used by the
+	// Gardener components acting on the shoot), leader election or probes, or by the exempt flow schema which is used by
+	// the kube-apiserver's loopback client.
+	userFlowSchemas = sets.New("global-default")
+	// userConnectSubresources are the pod subresources which are only connected to by users. The proxy subresource is
+	// excluded because it is used for scraping metrics.
+	userConnectSubresources = sets.New("exec", "attach", "portforward")
+	// userFacingResources are the resources which are only written by users. Resources which are constantly written by
+	// system components, e.g., ConfigMaps by the cluster-autoscaler, Pods and Jobs by the kube-controller-manager or
+	// custom resources of extensions, are not included.
+	userFacingResources = sets.New("deployments", "statefulsets", "daemonsets", "cronjobs", "services", "ingresses")
+)
+
+// ActivityQuerier is a function type that returns whether the Prometheus at the given endpoint observed activity in the
+// kube-apiserver of a shoot within the given time window.
+type ActivityQuerier func(ctx context.Context, endpoint string, port int, window time.Duration) (bool, error)
+
+// QueryShootActivity returns whether the Prometheus at the given endpoint observed user activity in the kube-apiserver
+// of a shoot within the given time window. Traffic of system components is not considered as activity.
+func QueryShootActivity(ctx context.Context, endpoint string, port int, window time.Duration) (bool, error) {
+	client, err := prom.NewClient(prom.Config{Address: fmt.Sprintf("http://%s:%d", endpoint, port)})
+	if err != nil {
+		return false, fmt.Errorf("failed to create Prometheus client: %w", err)
+	}
+
+	// set a maximum timeout for the query, but callers can set a shorter timeout via the context
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	result, _, err := promv1.NewAPI(client).Query(ctx, fmt.Sprintf(activityQuery, model.Duration(window)), time.Now())
+	if err != nil {
+		return false, fmt.Errorf("query failed: %w", err)
+	}
+
+	if result.Type() != model.ValVector {
+		return false, fmt.Errorf("query returned an unexpected result type")
+	}
+
+	return slices.ContainsFunc(result.(model.Vector), func(sample *model.Sample) bool {
+		return isUserActivity(sample.Metric)
+	}), nil
+}
+
+// isUserActivity returns whether the given series of the activity query indicates user activity.
+func isUserActivity(metric model.Metric) bool {
+	if flowSchema, ok := metric["flow_schema"]; ok {
+		return userFlowSchemas.Has(string(flowSchema))
+	}
+
+	if metric["verb"] == "CONNECT" {
+		return metric["resource"] == "pods" && userConnectSubresources.Has(string(metric["subresource"]))
+	}
+
+	return metric["subresource"] == "" && userFacingResources.Has(string(metric["resource"]))
+}
+
+// Activity contains required information for maintaining the last activity time of a shoot.
+type Activity struct {
+	log     logr.Logger
+	shoot   *shoot.Shoot
+	project *gardencorev1beta1.Project
+	clock   clock.Clock
+	querier ActivityQuerier
+}
+
+// NewActivity creates a new instance for maintaining the last activity time of a shoot.
+func NewActivity(log logr.Logger, shoot *shoot.Shoot, project *gardencorev1beta1.Project, clock clock.Clock, querier ActivityQuerier) *Activity {
+	return &Activity{
+		log:     log,
+		shoot:   shoot,
+		project: project,
+		clock:   clock,
+		querier: querier,
+	}
+}
+
+// Check returns the last activity time of the shoot. It is only maintained for awake shoots with enabled automatic
+// hibernation, otherwise nil is returned. If the activity of the shoot cannot be determined, the shoot is considered
+// active to prevent hibernating it accidentally.
+func (a *Activity) Check(ctx context.Context) *metav1.Time {
+	shoot := a.shoot.GetInfo()
+
+	if _, enabled := v1beta1helper.GetAutoHibernationIdleTimeout(shoot, a.project); !enabled || v1beta1helper.HibernationIsEnabled(shoot) || shoot.Status.IsHibernated {
+		return nil
+	}
+
+	var (
+		now              = a.clock.Now()
+		lastActivityTime = shoot.Status.LastActivityTime
+	)
+
+	// Start measuring the idle time when automatic hibernation was enabled or the shoot was woken up.
+	if lastActivityTime == nil {
+		return &metav1.Time{Time: now}
+	}
+
+	if now.Sub(lastActivityTime.Time) < activityWindow {
+		return lastActivityTime
+	}
+
+	endpoint := fmt.Sprintf("prometheus-%s-0.prometheus-operated.%s.svc.cluster.local", shootprometheus.Label, a.shoot.ControlPlaneNamespace)
+	active, err := a.querier(ctx, endpoint, 9090, activityWindow)
+	if err != nil {
+		a.log.Error(err, "Failed querying Prometheus for shoot activity, considering shoot as active")
+		active = true
+	}
+
+	if active {
+		return &metav1.Time{Time: now}
+	}
+	return lastActivityTime
+}
@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+)
+
+var _ = Describe("Activity", func() {
+	var (
+		ctx       = context.TODO()
+		fakeClock *testclock.FakeClock
+		now       = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+		shoot   *gardencorev1beta1.Shoot
+		project *gardencorev1beta1.Project
+
+		queried  bool
+		active   bool
+		queryErr error
+		querier  ActivityQuerier
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(now)
+
+		shoot = &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Hibernation: &gardencorev1beta1.Hibernation{
+					AutoHibernation: &gardencorev1beta1.AutoHibernation{Enabled: true},
+				},
+			},
+		}
+		project = &gardencorev1beta1.Project{}
+
+		queried, active, queryErr = false, false, nil
+		querier = func(_ context.Context, endpoint string, port int, window time.Duration) (bool, error) {
+			queried = true
+			Expect(endpoint).To(Equal("prometheus-shoot-0.prometheus-operated.shoot--foo--bar.svc.cluster.local"))
+			Expect(port).To(Equal(9090))
+			Expect(window).To(Equal(15 * time.Minute))
+			return active, queryErr
+		}
+	})
+
+	check := func() *metav1.Time {
+		s := &shootpkg.Shoot{ControlPlaneNamespace: "shoot--foo--bar"}
+		s.SetInfo(shoot)
+		return NewActivity(logr.Discard(), s, project, fakeClock, querier).Check(ctx)
+	}
+
+	It("should return nil if automatic hibernation is not enabled", func() {
+		shoot.Spec.Hibernation.AutoHibernation = nil
+		shoot.Status.LastActivityTime = &metav1.Time{Time: now.Add(-time.Hour)}
+
+		Expect(check()).To(BeNil())
+		Expect(queried).To(BeFalse())
+	})
+
+	It("should return nil if the shoot is hibernated", func() {
+		shoot.Spec.Hibernation.Enabled = ptr.To(true)
+		shoot.Status.LastActivityTime = &metav1.Time{Time: now.Add(-time.Hour)}
+
+		Expect(check()).To(BeNil())
+	})
+
+	It("should consider the automatic hibernation configuration of the project", func() {
+		shoot.Spec.Hibernation = nil
+		project.Spec.AutoHibernation = &gardencorev1beta1.AutoHibernation{Enabled: true}
+
+		Expect(check()).To(PointTo(Equal(metav1.Time{Time: now})))
+	})
+
+	It("should start measuring the idle time if no activity has been recorded yet", func() {
+		Expect(check()).To(PointTo(Equal(metav1.Time{Time: now})))
+		Expect(queried).To(BeFalse())
+	})
+
+	It("should not query Prometheus if the last activity is more recent than the activity window", func() {
+		shoot.Status.LastActivityTime = &metav1.Time{Time: now.Add(-5 * time.Minute)}
+
+		Expect(check()).To(Equal(shoot.Status.LastActivityTime))
+		Expect(queried).To(BeFalse())
+	})
+
+	It("should keep the last activity time if no activity was observed", func() {
+		shoot.Status.LastActivityTime = &metav1.Time{Time: now.Add(-time.Hour)}
+
+		Expect(check()).To(Equal(shoot.Status.LastActivityTime))
+		Expect(queried).To(BeTrue())
+	})
+
+	It("should update the last activity time if activity was observed", func() {
+		shoot.Status.LastActivityTime = &metav1.Time{Time: now.Add(-time.Hour)}
+		active = true
+
+		Expect(check()).To(PointTo(Equal(metav1.Time{Time: now})))
+	})
+
+	It("should consider the shoot as active if Prometheus cannot be queried", func() {
+		shoot.Status.LastActivityTime = &metav1.Time{Time: now.Add(-time.Hour)}
+		queryErr = fmt.Errorf("fake")
+
+		Expect(check()).To(PointTo(Equal(metav1.Time{Time: now})))
+	})
+
+	Describe("#QueryShootActivity", func() {
+		var (
+			server *httptest.Server
+			host   string
+			port   int
+			series []string
+		)
+
+		BeforeEach(func() {
+			series = nil
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).To(Equal("/api/v1/query"))
+				Expect(r.ParseForm()).To(Succeed())
+				Expect(r.Form.Get("query")).To(ContainSubstring("[15m]"))
+
+				result := make([]string, 0, len(series))
+				for _, metric := range series {
+					result = append(result, fmt.Sprintf(`{"metric":%s,"value":[1760788800,"3"]}`, metric))
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"status":"success","data":{"resultType":"vector","result":[%s]}}`, strings.Join(result, ","))
+			}))
+			DeferCleanup(server.Close)
+
+			serverURL, err := url.Parse(server.URL)
+			Expect(err).NotTo(HaveOccurred())
+			host = serverURL.Hostname()
+			port, err = strconv.Atoi(serverURL.Port())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should consider system-only traffic as idle", func() {
+			series = []string{
+				`{"flow_schema":"exempt"}`,
+				`{"flow_schema":"system-nodes"}`,
+				`{"flow_schema":"kube-system-service-accounts"}`,
+				`{"flow_schema":"service-accounts"}`,
+				`{"flow_schema":"system-leader-election"}`,
+				`{"flow_schema":"probes"}`,
+				`{"verb":"PATCH","resource":"configmaps","subresource":""}`,
+				`{"verb":"PATCH","resource":"verticalpodautoscalers","subresource":"status"}`,
+				`{"verb":"PUT","resource":"deployments","subresource":"status"}`,
+				`{"verb":"POST","resource":"pods","subresource":"eviction"}`,
+				`{"verb":"POST","resource":"pods","subresource":""}`,
+				`{"verb":"PUT","resource":"leases","subresource":""}`,
+				`{"verb":"POST","resource":"networks","subresource":""}`,
+				`{"verb":"CONNECT","resource":"nodes","subresource":"proxy"}`,
+				`{"verb":"CONNECT","resource":"pods","subresource":"proxy"}`,
+			}
+
+			Expect(QueryShootActivity(ctx, host, port, 15*time.Minute)).To(BeFalse())
+		})
+
+		DescribeTable("should consider user traffic as activity",
+			func(metric string) {
+				series = []string{`{"flow_schema":"kube-system-service-accounts"}`, metric}
+
+				Expect(QueryShootActivity(ctx, host, port, 15*time.Minute)).To(BeTrue())
+			},
+
+			Entry("requests of users", `{"flow_schema":"global-default"}`),
+			Entry("exec into pods", `{"verb":"CONNECT","resource":"pods","subresource":"exec"}`),
+			Entry("port-forward to pods", `{"verb":"CONNECT","resource":"pods","subresource":"portforward"}`),
+			Entry("changes to deployments", `{"verb":"PATCH","resource":"deployments","subresource":""}`),
+			Entry("creation of services", `{"verb":"POST","resource":"services","subresource":""}`),
+		)
+
+		It("should consider the shoot as idle if no requests were served", func() {
+			Expect(QueryShootActivity(ctx, host, port, 15*time.Minute)).To(BeFalse())
+		})
+	})
+})
@@ -30,7 +30,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/operation/botanist/matchers"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	"github.com/gardener/gardener/pkg/utils"
-	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
@@ -76,6 +76,8 @@ type Constraint struct {
 
 	log   logr.Logger
 	clock clock.Clock
+
+	caCertificateExpirationThreshold time.Duration
 }
 
 // NewConstraint returns a new constraint instance.
@@ -85,13 +87,15 @@ func NewConstraint(
 	seedClient client.Client,
 	shootClientInit ShootClientInit,
 	clock clock.Clock,
+	caCertificateExpirationThreshold time.Duration,
 ) *Constraint {
 	return &Constraint{
-		clock:                  clock,
-		shoot:                  shoot,
-		seedClient:             seedClient,
-		initializeShootClients: shootClientInit,
-		log:                    log,
+		clock:                            clock,
+		shoot:                            shoot,
+		seedClient:                       seedClient,
+		initializeShootClients:           shootClientInit,
+		log:                              log,
+		caCertificateExpirationThreshold: caCertificateExpirationThreshold,
 	}
 }
 
@@ -192,12 +196,20 @@ func getMutatingWebhookConfigurations(ctx context.Context, c client.Client) ([]a
 	return mutatingWebhookConfigs.Items, nil
 }
 
-// CheckIfCACertificateValiditiesAcceptable checks whether there are CA certificates which are expiring in less than a
-// year.
+// CheckIfCACertificateValiditiesAcceptable checks whether there are CA certificates which are expiring in less than the
+// configured threshold. Expiring CA certificates are not considered problematic while they are being rotated.
 func (c *Constraint) CheckIfCACertificateValiditiesAcceptable(ctx context.Context) (gardencorev1beta1.ConditionStatus, string, string, []gardencorev1beta1.ErrorCode, error) {
-	// CA certificates are valid for 10y, so let's only consider those certificates problematic which are valid for less
-	// than 1y.
-	const minimumValidity = 24 * time.Hour * 365
+	// CA certificates are valid for 10y, so by default only those certificates are considered problematic which are
+	// valid for less than 1y.
+	minimumValidity := c.caCertificateExpirationThreshold
+
+	if phase := v1beta1helper.GetShootCARotationPhase(c.shoot.GetInfo().Status.Credentials); len(phase) > 0 && phase != gardencorev1beta1.RotationCompleted {
+		return gardencorev1beta1.ConditionTrue,
+			"CACertificateRotationInProgress",
+			fmt.Sprintf("The certificate authorities are being rotated (phase %s), expiring CA certificates are replaced once the rotation is completed.", phase),
+			nil,
+			nil
+	}
 
 	secretList := &corev1.SecretList{}
 	if err := c.seedClient.List(ctx, secretList, client.InNamespace(c.shoot.ControlPlaneNamespace), client.MatchingLabels{
@@ -210,7 +222,7 @@ func (c *Constraint) CheckIfCACertificateValiditiesAcceptable(ctx context.Contex
 
 	expiringCACertificates := make(map[string]time.Time, len(secretList.Items))
 	for _, secret := range secretList.Items {
-		if secret.Data[secretsutils.DataKeyCertificateCA] == nil || secret.Data[secretsutils.DataKeyPrivateKeyCA] == nil {
+		if !gardenerutils.IsCASecret(secret.Data) {
 			continue
 		}
 
@@ -484,6 +484,7 @@ var _ = Describe("Constraints", func() {
 					return fakekubernetes.NewClientSetBuilder().WithClient(shootClient).Build(), true, nil
 				},
 				clock,
+				24*time.Hour*365,
 			)
 		})
 
@@ -589,6 +590,7 @@ var _ = Describe("Constraints", func() {
 							return fakekubernetes.NewClientSetBuilder().WithClient(shootClient).Build(), true, nil
 						},
 						clock,
+						24*time.Hour*365,
 					)
 				})
 
@@ -608,6 +610,7 @@ var _ = Describe("Constraints", func() {
 							return fakekubernetes.NewClientSetBuilder().WithClient(shootClient).Build(), true, nil
 						},
 						clock,
+						24*time.Hour*365,
 					)
 
 					Expect(constraint.Check(ctx, constraints)).NotTo(ContainCondition(
@@ -631,6 +634,7 @@ var _ = Describe("Constraints", func() {
 							return fakekubernetes.NewClientSetBuilder().WithClient(shootClient).Build(), true, nil
 						},
 						clock,
+						24*time.Hour*365,
 					)
 
 					Expect(constraint.Check(ctx, constraints)).NotTo(ContainCondition(
@@ -666,6 +670,7 @@ var _ = Describe("Constraints", func() {
 							return fakekubernetes.NewClientSetBuilder().WithClient(shootClient).Build(), true, nil
 						},
 						clock,
+						24*time.Hour*365,
 					)
 
 					Expect(constraint.Check(ctx, constraints)).To(ContainCondition(
@@ -732,6 +737,50 @@ var _ = Describe("Constraints", func() {
 				expectFalseCondition(status, reason, message, errorCodes, fmt.Sprintf(`"" (expiring at %s)`, now.String()))
 			})
 
+			It("should return a 'false' condition when there are CA secrets with external private keys not valid long enough", func() {
+				secret := newCASecret(now)
+				secret.Data = map[string][]byte{"ca.crt": []byte(""), "ca.key.ref": []byte("")}
+				Expect(seedClient.Create(ctx, secret)).To(Succeed())
+
+				status, reason, message, errorCodes, err := constraint.CheckIfCACertificateValiditiesAcceptable(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				expectFalseCondition(status, reason, message, errorCodes, fmt.Sprintf(`"" (expiring at %s)`, now.String()))
+			})
+
+			It("should consider the configured threshold", func() {
+				Expect(seedClient.Create(ctx, newCASecret(now.Add(24*time.Hour*60)))).To(Succeed())
+
+				shoot := &shootpkg.Shoot{ControlPlaneNamespace: controlPlaneNamespace}
+				shoot.SetInfo(&gardencorev1beta1.Shoot{})
+				constraint = NewConstraint(logr.Discard(), shoot, seedClient, nil, clock, 24*time.Hour*30)
+
+				status, reason, message, errorCodes, err := constraint.CheckIfCACertificateValiditiesAcceptable(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal(gardencorev1beta1.ConditionTrue))
+				Expect(reason).To(Equal("NoExpiringCACertificates"))
+				Expect(message).To(Equal("All CA certificates are still valid for at least 720h0m0s."))
+				Expect(errorCodes).To(BeNil())
+			})
+
+			It("should return a 'true' condition while the certificate authorities are being rotated", func() {
+				Expect(seedClient.Create(ctx, newCASecret(now))).To(Succeed())
+
+				shoot := &shootpkg.Shoot{ControlPlaneNamespace: controlPlaneNamespace}
+				shoot.SetInfo(&gardencorev1beta1.Shoot{Status: gardencorev1beta1.ShootStatus{Credentials: &gardencorev1beta1.ShootCredentials{
+					Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+						CertificateAuthorities: &gardencorev1beta1.CARotation{Phase: gardencorev1beta1.RotationPrepared},
+					},
+				}}})
+				constraint = NewConstraint(logr.Discard(), shoot, seedClient, nil, clock, 24*time.Hour*365)
+
+				status, reason, message, errorCodes, err := constraint.CheckIfCACertificateValiditiesAcceptable(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal(gardencorev1beta1.ConditionTrue))
+				Expect(reason).To(Equal("CACertificateRotationInProgress"))
+				Expect(message).To(Equal("The certificate authorities are being rotated (phase Prepared), expiring CA certificates are replaced once the rotation is completed."))
+				Expect(errorCodes).To(BeNil())
+			})
+
 			It("should return an error when the valid-until-time label cannot be parsed", func() {
 				secret := newCASecret(now)
 				secret.Labels["valid-until-time"] = "unparsable"
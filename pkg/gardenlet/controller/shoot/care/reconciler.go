@@ -12,6 +12,7 @@ import (
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
@@ -21,12 +22,14 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1/helper"
+	gardenletmetrics "github.com/gardener/gardener/pkg/gardenlet/metrics"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
@@ -43,6 +46,8 @@ var (
 	NewGarbageCollector = defaultNewGarbageCollector
 	// NewWebhookRemediator is used to create a new webhook remediation instance.
 	NewWebhookRemediator = defaultNewWebhookRemediator
+	// NewActivityCheck is used to create a new instance for determining the last activity time of a shoot.
+	NewActivityCheck = defaultNewActivityCheck
 )
 
 // Reconciler reconciles Shoot resources and executes care operations, e.g. health checks or garbage collection.
@@ -157,7 +162,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	)
 	if err != nil {
 		updatedConditions, updatedConstraints := r.setStatusToUnknown("Precondition failed: operation could not be initialized", shootConditions.ConvertToSlice(), shootConstraints.ConvertToSlice())
-		if err := r.patchStatus(ctx, log, shoot, shootConditions, updatedConditions, shootConstraints, updatedConstraints); err != nil {
+		if err := r.patchStatus(ctx, log, shoot, shootConditions, updatedConditions, shootConstraints, updatedConstraints, shoot.Status.LastActivityTime); err != nil {
 			log.Error(err, "Error when trying to update the shoot status after failed operation initialization")
 		}
 		return reconcile.Result{}, err
@@ -165,8 +170,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 
 	var (
 		staleExtensionHealthCheckThreshold    = gardenlethelper.StaleExtensionHealthChecksThreshold(r.Config.Controllers.ShootCare.StaleExtensionHealthChecks)
+		caCertificateExpirationThreshold      = ptr.Deref(r.Config.Controllers.ShootCare.CACertificateExpirationThreshold, metav1.Duration{}).Duration
 		initializeShootClients                = shootClientInitializer(careCtx, o)
 		updatedConditions, updatedConstraints []gardencorev1beta1.Condition
+		lastActivityTime                      *metav1.Time
 	)
 
 	if err := flow.Parallel(
@@ -197,6 +204,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 				r.SeedClientSet.Client(),
 				initializeShootClients,
 				clock.RealClock{},
+				caCertificateExpirationThreshold,
 			).Check(
 				ctx,
 				shootConstraints,
@@ -209,6 +217,21 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			// errors during garbage collection are only being logged and do not cause the care operation to fail
 			return nil
 		},
+		// Determine last activity time for automatic hibernation
+		func(ctx context.Context) error {
+			var project *gardencorev1beta1.Project
+			if o.Garden != nil {
+				project = o.Garden.Project
+			}
+			lastActivityTime = NewActivityCheck(log, o.Shoot, project, r.Clock).Check(ctx)
+			return nil
+		},
+		// Record certificate expirations
+		func(ctx context.Context) error {
+			r.recordCertificateExpirations(ctx, log, o.Shoot.ControlPlaneNamespace)
+			// errors when recording the certificate expirations are only being logged and do not cause the care operation to fail
+			return nil
+		},
 		// Trigger webhook remediation
 		func(ctx context.Context) error {
 			if ptr.Deref(r.Config.Controllers.ShootCare.WebhookRemediatorEnabled, false) {
@@ -221,7 +244,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{}, err
 	}
 
-	if err := r.patchStatus(ctx, log, shoot, shootConditions, updatedConditions, shootConstraints, updatedConstraints); err != nil {
+	if err := r.patchStatus(ctx, log, shoot, shootConditions, updatedConditions, shootConstraints, updatedConstraints, lastActivityTime); err != nil {
 		log.Error(err, "Error when trying to update the shoot status")
 		return reconcile.Result{}, err
 	}
@@ -229,6 +252,19 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	return reconcile.Result{RequeueAfter: r.Config.Controllers.ShootCare.SyncPeriod.Duration}, nil
 }
 
+// recordCertificateExpirations records the expiration times of the certificates managed by gardenlet for the shoot as
+// metrics. The shoot reconciliation records them as well, however, it only runs rarely and the metrics are lost when
+// gardenlet restarts. Hence, they are also recorded with each care operation.
+func (r *Reconciler) recordCertificateExpirations(ctx context.Context, log logr.Logger, namespace string) {
+	expirations, err := gardenerutils.CertificateExpirations(ctx, r.SeedClientSet.Client(), namespace, v1beta1constants.SecretManagerIdentityGardenlet)
+	if err != nil {
+		log.Error(err, "Failed computing certificate expirations")
+		return
+	}
+
+	gardenletmetrics.RecordCertificateExpirations(namespace, expirations)
+}
+
 func (r *Reconciler) conditionThresholdsToProgressingMapping() map[gardencorev1beta1.ConditionType]time.Duration {
 	out := make(map[gardencorev1beta1.ConditionType]time.Duration)
 	for _, threshold := range r.Config.Controllers.ShootCare.ConditionThresholds {
@@ -237,9 +273,11 @@ func (r *Reconciler) conditionThresholdsToProgressingMapping() map[gardencorev1b
 	return out
 }
 
-func (r *Reconciler) patchStatus(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, existingConditions ShootConditions, updatedConditions []gardencorev1beta1.Condition, existingConstraints ShootConstraints, updatedConstraints []gardencorev1beta1.Condition) error {
-	// Update Shoot status (conditions, constraints) only if necessary
-	if !v1beta1helper.ConditionsNeedUpdate(existingConditions.ConvertToSlice(), updatedConditions) && !v1beta1helper.ConditionsNeedUpdate(existingConstraints.ConvertToSlice(), updatedConstraints) {
+func (r *Reconciler) patchStatus(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot, existingConditions ShootConditions, updatedConditions []gardencorev1beta1.Condition, existingConstraints ShootConstraints, updatedConstraints []gardencorev1beta1.Condition, lastActivityTime *metav1.Time) error {
+	// Update Shoot status (conditions, constraints, last activity time) only if necessary
+	if !v1beta1helper.ConditionsNeedUpdate(existingConditions.ConvertToSlice(), updatedConditions) &&
+		!v1beta1helper.ConditionsNeedUpdate(existingConstraints.ConvertToSlice(), updatedConstraints) &&
+		apiequality.Semantic.DeepEqual(shoot.Status.LastActivityTime, lastActivityTime) {
 		return nil
 	}
 
@@ -253,6 +291,7 @@ func (r *Reconciler) patchStatus(ctx context.Context, log logr.Logger, shoot *ga
 	patch := client.StrategicMergeFrom(shoot.DeepCopy())
 	shoot.Status.Conditions = mergedConditions
 	shoot.Status.Constraints = mergedConstraints
+	shoot.Status.LastActivityTime = lastActivityTime
 	return r.GardenClient.Status().Patch(ctx, shoot, patch)
 }
 
@@ -7,6 +7,7 @@ package care_test
 import (
 	"context"
 	"errors"
+	"strconv"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -32,6 +33,7 @@ import (
 	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+	gardenletmetrics "github.com/gardener/gardener/pkg/gardenlet/metrics"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
@@ -246,8 +248,15 @@ var _ = Describe("Shoot Care Control", func() {
 				shootClientMap clientmap.ClientMap
 				managedSeed    *seedmanagementv1alpha1.ManagedSeed
 				operationFunc  NewOperationFunc
+				seedClient     client.Client
+
+				controlPlaneNamespace = "shoot--project--shoot"
 			)
 
+			BeforeEach(func() {
+				seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+			})
+
 			JustBeforeEach(func() {
 				shootClientMap = fakeclientmap.NewClientMapBuilder().Build()
 
@@ -255,7 +264,7 @@ var _ = Describe("Shoot Care Control", func() {
 					GardenClient:  gardenClient,
 					SeedClientSet: fakekubernetes.NewClientSetBuilder().Build(),
 					ManagedSeed:   managedSeed,
-					Shoot:         &shootpkg.Shoot{},
+					Shoot:         &shootpkg.Shoot{ControlPlaneNamespace: controlPlaneNamespace},
 					Logger:        logr.Discard(),
 				}
 				op.Shoot.SetInfo(shoot)
@@ -267,7 +276,7 @@ var _ = Describe("Shoot Care Control", func() {
 				))
 				reconciler = &Reconciler{
 					GardenClient:   gardenClient,
-					SeedClientSet:  fakekubernetes.NewClientSet(),
+					SeedClientSet:  fakekubernetes.NewClientSetBuilder().WithClient(seedClient).Build(),
 					ShootClientMap: shootClientMap,
 					Config:         gardenletConf,
 					Clock:          fakeClock,
@@ -279,6 +288,35 @@ var _ = Describe("Shoot Care Control", func() {
 				shoot = nil
 			})
 
+			Context("certificate expirations", func() {
+				BeforeEach(func() {
+					DeferCleanup(test.WithVars(
+						&NewHealthCheck, healthCheckFunc(func(_ ShootConditions) []gardencorev1beta1.Condition { return nil }),
+						&NewConstraintCheck, constraintCheckFunc(func(_ ShootConstraints) []gardencorev1beta1.Condition { return nil }),
+					))
+
+					Expect(seedClient.Create(ctx, &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "ca-123",
+							Namespace: controlPlaneNamespace,
+							Labels: map[string]string{
+								"name":             "ca",
+								"managed-by":       "secrets-manager",
+								"manager-identity": "gardenlet",
+								"valid-until-time": strconv.FormatInt(fakeClock.Now().Add(time.Hour).Unix(), 10),
+							},
+						},
+						Data: map[string][]byte{"ca.crt": []byte("cert"), "ca.key": []byte("key")},
+					})).To(Succeed())
+				})
+
+				It("should record the certificate expirations as metrics", func() {
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					Expect(gardenletmetrics.CertificateExpirationTimestampSeconds.DeleteLabelValues(controlPlaneNamespace, "ca", "true")).To(BeTrue())
+				})
+			})
+
 			Context("when no conditions / constraints are returned", func() {
 				BeforeEach(func() {
 					DeferCleanup(test.WithVars(
@@ -580,6 +618,7 @@ func constraintCheckFunc(fn resultingConstraintFunc) NewConstraintCheckFunc {
 		_ client.Client,
 		_ ShootClientInit,
 		_ clock.Clock,
+		_ time.Duration,
 	) ConstraintCheck {
 		return fn
 	}
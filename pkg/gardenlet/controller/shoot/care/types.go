@@ -79,6 +79,7 @@ type NewConstraintCheckFunc func(
 	seedClient client.Client,
 	shootClientInit ShootClientInit,
 	clock clock.Clock,
+	caCertificateExpirationThreshold time.Duration,
 ) ConstraintCheck
 
 // defaultNewConstraintCheck is the default function to create a new instance for performing constraint checks.
@@ -88,6 +89,7 @@ var defaultNewConstraintCheck = func(
 	seedClient client.Client,
 	shootClientInit ShootClientInit,
 	clock clock.Clock,
+	caCertificateExpirationThreshold time.Duration,
 ) ConstraintCheck {
 	return NewConstraint(
 		log,
@@ -95,6 +97,7 @@ var defaultNewConstraintCheck = func(
 		seedClient,
 		shootClientInit,
 		clock,
+		caCertificateExpirationThreshold,
 	)
 }
 
@@ -124,6 +127,30 @@ var defaultNewWebhookRemediator = func(log logr.Logger, shoot *gardencorev1beta1
 	return NewWebhookRemediation(log, shoot, init)
 }
 
+// ActivityCheck is an interface used to determine the last activity time of a shoot.
+type ActivityCheck interface {
+	Check(ctx context.Context) *metav1.Time
+}
+
+// NewActivityCheckFunc is a function used to create a new instance for determining the last activity time of a shoot.
+type NewActivityCheckFunc func(
+	log logr.Logger,
+	shoot *shoot.Shoot,
+	project *gardencorev1beta1.Project,
+	clock clock.Clock,
+) ActivityCheck
+
+// defaultNewActivityCheck is the default function to create a new instance for determining the last activity time of
+// a shoot.
+var defaultNewActivityCheck NewActivityCheckFunc = func(
+	log logr.Logger,
+	shoot *shoot.Shoot,
+	project *gardencorev1beta1.Project,
+	clock clock.Clock,
+) ActivityCheck {
+	return NewActivity(log, shoot, project, clock, QueryShootActivity)
+}
+
 // NewOperationFunc is a function used to create a new `operation.Operation` instance.
 type NewOperationFunc func(
 	ctx context.Context,
@@ -69,6 +69,9 @@ type Reconciler struct {
 	GardenClusterIdentity       string
 	Clock                       clock.Clock
 	ShootStateControllerEnabled bool
+	// FlowRuns keeps the last run of the flows executed for each shoot. It is served on a debug endpoint and only set if
+	// profiling is enabled.
+	FlowRuns *flow.LastRuns
 }
 
 // Reconcile implements the main shoot reconciliation logic, i.e., creation, hibernation, migration and deletion.
@@ -79,6 +82,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	if err := r.GardenClient.Get(ctx, request.NamespacedName, shoot); err != nil {
 		if apierrors.IsNotFound(err) {
 			log.V(1).Info("Object is gone, stop reconciling")
+			if r.FlowRuns != nil {
+				r.FlowRuns.Delete(request.String())
+			}
 			return reconcile.Result{}, nil
 		}
 		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
@@ -499,6 +505,8 @@ func (r *Reconciler) finalizeShootMigration(ctx context.Context, shoot *gardenco
 		return reconcile.Result{}, err
 	}
 
+	gardenletmetrics.DeleteCertificateExpirations(shoot.Status.TechnicalID)
+
 	r.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.EventMigrationPrepared, "Prepared Shoot cluster for migration")
 	return reconcile.Result{}, r.patchShootStatusOperationSuccess(ctx, shoot, nil, gardencorev1beta1.LastOperationTypeMigrate)
 }
@@ -511,6 +519,8 @@ func (r *Reconciler) finalizeShootDeletion(ctx context.Context, log logr.Logger,
 		return reconcile.Result{}, errorsutils.WithSuppressed(errors.New(lastErr.Description), updateErr)
 	}
 
+	gardenletmetrics.DeleteCertificateExpirations(shoot.Status.TechnicalID)
+
 	return reconcile.Result{}, r.removeFinalizerFromShoot(ctx, log, shoot)
 }
 
@@ -564,6 +574,13 @@ func (r *Reconciler) newProgressReporter(reporterFn flow.ProgressReporterFn) flo
 	return flow.NewImmediateProgressReporter(reporterFn)
 }
 
+func (r *Reconciler) newFlowRecorder(shoot *gardencorev1beta1.Shoot) flow.Recorder {
+	if r.FlowRuns == nil {
+		return nil
+	}
+	return r.FlowRuns.RecorderFor(client.ObjectKeyFromObject(shoot).String())
+}
+
 func (r *Reconciler) updateShootStatusOperationStart(
 	ctx context.Context,
 	shoot *gardencorev1beta1.Shoot,
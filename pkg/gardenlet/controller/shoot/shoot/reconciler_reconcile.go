@@ -1037,6 +1037,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 	if err := f.Run(ctx, flow.Opts{
 		Log:              o.Logger,
 		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
+		Recorder:         r.newFlowRecorder(o.Shoot.GetInfo()),
 		ErrorContext:     errorContext,
 		ErrorCleaner:     o.CleanShootTaskError,
 	}); err != nil {
@@ -1057,6 +1058,13 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		}
 	}
 
+	o.Logger.Info("Updating certificate expirations")
+	if err := botanist.UpdateCertificateExpirations(ctx); err != nil {
+		// The certificate expirations are only informational, hence they must not fail the reconciliation. They are
+		// updated again with the next reconciliation, and the metrics are also recorded by the care controller.
+		o.Logger.Error(err, "Failed to update certificate expirations")
+	}
+
 	// ensure that shoot client is invalidated after it has been hibernated
 	if o.Shoot.HibernationEnabled {
 		if err := o.ShootClientMap.InvalidateClient(keys.ForShoot(o.Shoot.GetInfo())); err != nil {
@@ -4,9 +4,13 @@
 package metrics
 
 import (
+	"strconv"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 )
 
 // Namespace is the metric namespace for the gardenlet.
@@ -29,4 +33,34 @@ var (
 			"hibernated",
 		},
 	)
+	// CertificateExpirationTimestampSeconds defines the gauge certificate_expiration_timestamp_seconds.
+	CertificateExpirationTimestampSeconds = factory.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "certificate_expiration_timestamp_seconds",
+			Help:      "Expiration time of the certificates managed by gardenlet in seconds since the Unix epoch.",
+		},
+		[]string{
+			"namespace",
+			"name",
+			"certificate_authority",
+		},
+	)
 )
+
+// RecordCertificateExpirations replaces the certificate expiration metrics of the given namespace with the given
+// expirations.
+func RecordCertificateExpirations(namespace string, expirations []gardencorev1beta1.CertificateExpiration) {
+	DeleteCertificateExpirations(namespace)
+
+	for _, expiration := range expirations {
+		CertificateExpirationTimestampSeconds.
+			WithLabelValues(namespace, expiration.Name, strconv.FormatBool(expiration.CertificateAuthority)).
+			Set(float64(expiration.ExpirationTime.Unix()))
+	}
+}
+
+// DeleteCertificateExpirations deletes the certificate expiration metrics of the given namespace.
+func DeleteCertificateExpirations(namespace string) {
+	CertificateExpirationTimestampSeconds.DeletePartialMatch(prometheus.Labels{"namespace": namespace})
+}
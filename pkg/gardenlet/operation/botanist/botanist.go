@@ -60,6 +60,7 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 		secretsmanager.Config{
 			CASecretAutoRotation: false,
 			SecretNamesToTimes:   b.lastSecretRotationStartTimes(),
+			PrivateKeyStore:      b.caPrivateKeyStore(),
 		},
 		namespaces...,
 	)
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -22,6 +23,7 @@ import (
 	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
 	kubeapiserver "github.com/gardener/gardener/pkg/component/kubernetes/apiserver"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenletmetrics "github.com/gardener/gardener/pkg/gardenlet/metrics"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/gardener/tokenrequest"
@@ -66,6 +68,29 @@ func (b *Botanist) InitializeSecretsManagement(ctx context.Context) error {
 	return flow.Sequential(taskFns...)(ctx)
 }
 
+// UpdateCertificateExpirations records the expiration times of the certificates managed by gardenlet for the shoot
+// as metrics and publishes them in the Shoot status.
+func (b *Botanist) UpdateCertificateExpirations(ctx context.Context) error {
+	expirations, err := gardenerutils.CertificateExpirations(ctx, b.SeedClientSet.Client(), b.Shoot.ControlPlaneNamespace, v1beta1constants.SecretManagerIdentityGardenlet)
+	if err != nil {
+		return fmt.Errorf("failed computing certificate expirations: %w", err)
+	}
+
+	gardenletmetrics.RecordCertificateExpirations(b.Shoot.ControlPlaneNamespace, expirations)
+
+	if credentials := b.Shoot.GetInfo().Status.Credentials; credentials != nil && apiequality.Semantic.DeepEqual(credentials.CertificateExpirations, expirations) {
+		return nil
+	}
+
+	return b.Shoot.UpdateInfoStatus(ctx, b.GardenClient, true, false, func(shoot *gardencorev1beta1.Shoot) error {
+		if shoot.Status.Credentials == nil {
+			shoot.Status.Credentials = &gardencorev1beta1.ShootCredentials{}
+		}
+		shoot.Status.Credentials.CertificateExpirations = expirations
+		return nil
+	})
+}
+
 func (b *Botanist) lastSecretRotationStartTimes() map[string]time.Time {
 	rotation := make(map[string]time.Time)
 
@@ -121,6 +146,14 @@ func (b *Botanist) restoreSecretsFromShootState(ctx context.Context) error {
 				return err
 			}
 
+			if ref, ok := data[secretsmanager.DataKeyPrivateKeyCAReference]; ok {
+				// The private key of the CA is not part of the ShootState, hence it must be available in the private
+				// key store configured for this gardenlet.
+				if err := b.checkCAPrivateKeyAvailable(ctx, entry.Name, string(ref)); err != nil {
+					return err
+				}
+			}
+
 			var secret *corev1.Secret
 			if objectMeta.Labels[secretsmanager.LabelKeyManagedBy] == secretsmanager.LabelValueSecretsManager {
 				secret = secretsmanager.Secret(objectMeta, data)
@@ -145,6 +178,32 @@ func (b *Botanist) restoreSecretsFromShootState(ctx context.Context) error {
 	return flow.Parallel(fns...)(ctx)
 }
 
+func (b *Botanist) checkCAPrivateKeyAvailable(ctx context.Context, name, ref string) error {
+	privateKeyStore := b.caPrivateKeyStore()
+	if privateKeyStore == nil {
+		return fmt.Errorf("cannot restore CA secret %q since its private key is kept in a private key store but none is configured", name)
+	}
+
+	if _, err := privateKeyStore.Signer(ctx, ref); err != nil {
+		return fmt.Errorf("cannot restore CA secret %q since its private key is not available in the private key store: %w", name, err)
+	}
+
+	return nil
+}
+
+// caPrivateKeyStore returns the store for the private keys of the CAs, or nil if none is configured.
+func (b *Botanist) caPrivateKeyStore() secretsmanager.PrivateKeyStore {
+	if b.Config == nil || b.Config.Controllers == nil || b.Config.Controllers.Shoot == nil || b.Config.Controllers.Shoot.CAPrivateKeyStore == nil {
+		return nil
+	}
+
+	if fileStore := b.Config.Controllers.Shoot.CAPrivateKeyStore.File; fileStore != nil {
+		return secretsmanager.NewFilePrivateKeyStore(fileStore.Directory)
+	}
+
+	return nil
+}
+
 func caCertConfigurations(isWorkerless, isSelfHosted bool) []secretsutils.ConfigInterface {
 	certificateSecretConfigs := []secretsutils.ConfigInterface{
 		// The CommonNames for CA certificates will be overridden with the secret name by the secrets manager when
@@ -184,6 +243,13 @@ func (b *Botanist) caCertGenerateOptionsFor(configName string) []secretsmanager.
 		options = append(options, secretsmanager.IgnoreOldSecrets())
 	}
 
+	// The private keys of the client and kubelet CAs are needed by the kube-controller-manager for signing certificate
+	// signing requests, hence they cannot be kept in the private key store.
+	if b.caPrivateKeyStore() != nil && !b.Shoot.IsSelfHosted() &&
+		configName != v1beta1constants.SecretNameCAClient && configName != v1beta1constants.SecretNameCAKubelet {
+		options = append(options, secretsmanager.ExternalPrivateKey())
+	}
+
 	if configName == v1beta1constants.SecretNameCAClient {
 		return options
 	}
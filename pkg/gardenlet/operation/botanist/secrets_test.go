@@ -6,6 +6,8 @@ package botanist_test
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
@@ -24,6 +26,7 @@ import (
 	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	fakekubernetes "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	gardenletconfigv1alpha1 "github.com/gardener/gardener/pkg/gardenlet/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
 	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
@@ -531,6 +534,115 @@ var _ = Describe("Secrets", func() {
 				By("Verify unrelated data not to be restored")
 				Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: controlPlaneNamespace, Name: "some-other-data"}, &corev1.Secret{})).To(BeNotFoundError())
 			})
+
+			Context("CA secrets with external private keys", func() {
+				var (
+					privateKeyStoreDir string
+					ref                = controlPlaneNamespace + "/ca-etcd"
+				)
+
+				BeforeEach(func() {
+					privateKeyStoreDir = GinkgoT().TempDir()
+
+					botanist.Shoot.SetShootState(&gardencorev1beta1.ShootState{
+						Spec: gardencorev1beta1.ShootStateSpec{
+							Gardener: []gardencorev1beta1.GardenerResourceData{
+								{
+									Name:   "ca-etcd",
+									Type:   "secret",
+									Labels: map[string]string{"managed-by": "secrets-manager", "manager-identity": fakesecretsmanager.ManagerIdentity},
+									Data:   runtime.RawExtension{Raw: []byte(`{"ca.crt":"` + utils.EncodeBase64([]byte("cert")) + `","ca.key.ref":"` + utils.EncodeBase64([]byte(ref)) + `"}`)},
+								},
+							},
+						},
+					})
+				})
+
+				It("should fail if no private key store is configured", func() {
+					Expect(botanist.InitializeSecretsManagement(ctx)).To(MatchError(ContainSubstring(`cannot restore CA secret "ca-etcd" since its private key is kept in a private key store but none is configured`)))
+				})
+
+				Context("with private key store", func() {
+					BeforeEach(func() {
+						botanist.Config = &gardenletconfigv1alpha1.GardenletConfiguration{
+							Controllers: &gardenletconfigv1alpha1.GardenletControllerConfiguration{
+								Shoot: &gardenletconfigv1alpha1.ShootControllerConfiguration{
+									CAPrivateKeyStore: &gardenletconfigv1alpha1.PrivateKeyStoreConfiguration{
+										File: &gardenletconfigv1alpha1.FilePrivateKeyStoreConfiguration{Directory: privateKeyStoreDir},
+									},
+								},
+							},
+						}
+					})
+
+					It("should fail if the private key is not available in the store", func() {
+						Expect(botanist.InitializeSecretsManagement(ctx)).To(MatchError(ContainSubstring(`cannot restore CA secret "ca-etcd" since its private key is not available in the private key store`)))
+					})
+
+					It("should restore the CA secret if the private key is available in the store", func() {
+						_, err := secretsmanager.NewFilePrivateKeyStore(privateKeyStoreDir).Generate(ctx, ref)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(botanist.InitializeSecretsManagement(ctx)).To(Succeed())
+
+						secret := &corev1.Secret{}
+						Expect(seedClient.Get(ctx, client.ObjectKey{Namespace: controlPlaneNamespace, Name: "ca-etcd"}, secret)).To(Succeed())
+						Expect(secret.Data).To(Equal(map[string][]byte{"ca.crt": []byte("cert"), "ca.key.ref": []byte(ref)}))
+					})
+				})
+			})
+		})
+	})
+
+	Describe("#UpdateCertificateExpirations", func() {
+		var (
+			validUntil = time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+			shoot      *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			shoot = botanist.Shoot.GetInfo().DeepCopy()
+			gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithObjects(shoot).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+			botanist.GardenClient = gardenClient
+
+			Expect(seedClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ca-123",
+					Namespace: controlPlaneNamespace,
+					Labels: map[string]string{
+						"name":             "ca",
+						"managed-by":       "secrets-manager",
+						"manager-identity": "gardenlet",
+						"valid-until-time": strconv.FormatInt(validUntil.Unix(), 10),
+					},
+				},
+				Data: map[string][]byte{"ca.crt": []byte("cert"), "ca.key": []byte("key")},
+			})).To(Succeed())
+		})
+
+		It("should publish the certificate expirations in the shoot status", func() {
+			Expect(botanist.UpdateCertificateExpirations(ctx)).To(Succeed())
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.Credentials).NotTo(BeNil())
+			Expect(shoot.Status.Credentials.CertificateExpirations).To(ConsistOf(
+				MatchFields(IgnoreExtras, Fields{
+					"Name":                 Equal("ca"),
+					"CertificateAuthority": BeTrue(),
+					"ExpirationTime":       WithTransform(func(t metav1.Time) time.Time { return t.UTC() }, Equal(validUntil)),
+				}),
+			))
+			Expect(botanist.Shoot.GetInfo().Status.Credentials.CertificateExpirations).To(Equal(shoot.Status.Credentials.CertificateExpirations))
+		})
+
+		It("should not patch the shoot status if the certificate expirations are unchanged", func() {
+			Expect(botanist.UpdateCertificateExpirations(ctx)).To(Succeed())
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			resourceVersion := shoot.ResourceVersion
+
+			Expect(botanist.UpdateCertificateExpirations(ctx)).To(Succeed())
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.ResourceVersion).To(Equal(resourceVersion))
 		})
 	})
 })
@@ -7,9 +7,11 @@ package v1alpha1
 import (
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/logger"
 )
@@ -42,6 +44,70 @@ func SetDefaults_TokenControllerConfig(obj *TokenControllerConfig) {
 	}
 }
 
+// SetDefaults_HealthCheckControllerConfig sets defaults for the HealthCheckControllerConfig object.
+func SetDefaults_HealthCheckControllerConfig(obj *HealthCheckControllerConfig) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 30 * time.Second}
+	}
+}
+
+// SetDefaults_HealthCheck sets defaults for the HealthCheck object.
+func SetDefaults_HealthCheck(obj *HealthCheck) {
+	if obj.FailureThreshold == nil {
+		obj.FailureThreshold = &metav1.Duration{Duration: time.Minute}
+	}
+
+	if obj.Backoff == nil {
+		obj.Backoff = &HealthCheckBackoff{}
+	}
+
+	for i, remediation := range obj.Remediations {
+		switch remediation.Type {
+		case HealthCheckRemediationRestartUnit:
+			if remediation.Unit == "" && obj.SystemdUnit != nil {
+				obj.Remediations[i].Unit = obj.SystemdUnit.Name
+			}
+		case HealthCheckRemediationTaintNode:
+			if remediation.Taint == nil {
+				obj.Remediations[i].Taint = &corev1.Taint{
+					Key:    HealthCheckTaintKeyPrefix + obj.Name,
+					Effect: corev1.TaintEffectNoSchedule,
+				}
+			}
+		}
+	}
+}
+
+// SetDefaults_HTTPHealthCheck sets defaults for the HTTPHealthCheck object.
+func SetDefaults_HTTPHealthCheck(obj *HTTPHealthCheck) {
+	if obj.ExpectedStatusCode == nil {
+		obj.ExpectedStatusCode = ptr.To[int32](200)
+	}
+	if obj.Timeout == nil {
+		obj.Timeout = &metav1.Duration{Duration: 10 * time.Second}
+	}
+}
+
+// SetDefaults_DiskPressureHealthCheck sets defaults for the DiskPressureHealthCheck object.
+func SetDefaults_DiskPressureHealthCheck(obj *DiskPressureHealthCheck) {
+	if obj.MinAvailablePercentage == nil {
+		obj.MinAvailablePercentage = ptr.To[int32](10)
+	}
+	if obj.MinAvailableInodesPercentage == nil {
+		obj.MinAvailableInodesPercentage = ptr.To[int32](5)
+	}
+}
+
+// SetDefaults_HealthCheckBackoff sets defaults for the HealthCheckBackoff object.
+func SetDefaults_HealthCheckBackoff(obj *HealthCheckBackoff) {
+	if obj.InitialDelay == nil {
+		obj.InitialDelay = &metav1.Duration{Duration: time.Minute}
+	}
+	if obj.MaxDelay == nil {
+		obj.MaxDelay = &metav1.Duration{Duration: 30 * time.Minute}
+	}
+}
+
 // SetDefaults_ClientConnectionConfiguration sets defaults for the garden client connection.
 func SetDefaults_ClientConnectionConfiguration(obj *componentbaseconfigv1alpha1.ClientConnectionConfiguration) {
 	componentbaseconfigv1alpha1.RecommendedDefaultClientConnectionConfiguration(obj)
@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/gardener/gardener/pkg/logger"
@@ -86,6 +87,90 @@ var _ = Describe("Defaults", func() {
 					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
 				})
 			})
+
+			Describe("Health check controller", func() {
+				It("should default the object", func() {
+					obj := &HealthCheckControllerConfig{}
+
+					SetDefaults_HealthCheckControllerConfig(obj)
+
+					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 30 * time.Second})))
+				})
+
+				It("should not overwrite existing values", func() {
+					obj := &HealthCheckControllerConfig{
+						SyncPeriod: &metav1.Duration{Duration: time.Second},
+					}
+
+					SetDefaults_HealthCheckControllerConfig(obj)
+
+					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
+				})
+
+				It("should default the health checks", func() {
+					obj := &NodeAgentConfiguration{
+						Controllers: ControllerConfiguration{
+							HealthCheck: HealthCheckControllerConfig{
+								Checks: []HealthCheck{
+									{
+										Name:        "containerd",
+										SystemdUnit: &SystemdUnitHealthCheck{Name: "containerd.service"},
+										Remediations: []HealthCheckRemediation{
+											{Type: HealthCheckRemediationRestartUnit},
+											{Type: HealthCheckRemediationTaintNode},
+										},
+									},
+									{
+										Name: "registry",
+										HTTP: &HTTPHealthCheck{URL: "http://localhost:5000/healthz"},
+									},
+									{
+										Name:         "containerd-disk",
+										DiskPressure: &DiskPressureHealthCheck{Path: "/var/lib/containerd"},
+									},
+								},
+							},
+						},
+					}
+
+					SetObjectDefaults_NodeAgentConfiguration(obj)
+
+					checks := obj.Controllers.HealthCheck.Checks
+					Expect(checks[0].FailureThreshold).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+					Expect(checks[0].Backoff).To(Equal(&HealthCheckBackoff{
+						InitialDelay: &metav1.Duration{Duration: time.Minute},
+						MaxDelay:     &metav1.Duration{Duration: 30 * time.Minute},
+					}))
+					Expect(checks[0].Remediations).To(Equal([]HealthCheckRemediation{
+						{Type: HealthCheckRemediationRestartUnit, Unit: "containerd.service"},
+						{Type: HealthCheckRemediationTaintNode, Taint: &corev1.Taint{Key: "health-check.node.gardener.cloud/containerd", Effect: corev1.TaintEffectNoSchedule}},
+					}))
+					Expect(checks[1].HTTP.ExpectedStatusCode).To(PointTo(Equal(int32(200))))
+					Expect(checks[1].HTTP.Timeout).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Second})))
+					Expect(checks[2].DiskPressure.MinAvailablePercentage).To(PointTo(Equal(int32(10))))
+					Expect(checks[2].DiskPressure.MinAvailableInodesPercentage).To(PointTo(Equal(int32(5))))
+				})
+
+				It("should not overwrite existing values of the health checks", func() {
+					obj := &HealthCheck{
+						Name:             "kubelet",
+						SystemdUnit:      &SystemdUnitHealthCheck{Name: "kubelet.service"},
+						FailureThreshold: &metav1.Duration{Duration: time.Second},
+						Remediations: []HealthCheckRemediation{
+							{Type: HealthCheckRemediationRestartUnit, Unit: "containerd.service"},
+							{Type: HealthCheckRemediationTaintNode, Taint: &corev1.Taint{Key: "foo", Effect: corev1.TaintEffectNoExecute}},
+						},
+						Backoff: &HealthCheckBackoff{InitialDelay: &metav1.Duration{Duration: time.Second}},
+					}
+
+					SetDefaults_HealthCheck(obj)
+
+					Expect(obj.FailureThreshold).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
+					Expect(obj.Remediations[0].Unit).To(Equal("containerd.service"))
+					Expect(obj.Remediations[1].Taint).To(Equal(&corev1.Taint{Key: "foo", Effect: corev1.TaintEffectNoExecute}))
+					Expect(obj.Backoff.InitialDelay).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
+				})
+			})
 		})
 
 		Describe("Server configuration", func() {
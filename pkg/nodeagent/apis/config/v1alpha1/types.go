@@ -8,6 +8,7 @@ import (
 	"regexp"
 
 	"github.com/Masterminds/semver/v3"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
@@ -30,6 +31,9 @@ const (
 	KubeconfigFilePath = CredentialsDir + "/kubeconfig"
 	// MachineNameFilePath is the file path on the worker node that contains the machine name.
 	MachineNameFilePath = BaseDir + "/machine-name"
+	// LastAppliedOperatingSystemConfigFilePath is the file path on the worker node that contains the last operating
+	// system config applied by gardener-node-agent.
+	LastAppliedOperatingSystemConfigFilePath = BaseDir + "/last-applied-osc.yaml"
 
 	// UnitName is the name of the gardener-node-agent systemd service.
 	UnitName = "gardener-node-agent.service"
@@ -45,6 +49,10 @@ const (
 	// AnnotationKeyChecksumAppliedOperatingSystemConfig is a constant for an annotation key on a Node describing the
 	// checksum of the last applied operating system configuration.
 	AnnotationKeyChecksumAppliedOperatingSystemConfig = "checksum/cloud-config-data"
+
+	// HealthCheckTaintKeyPrefix is the prefix of the default key of the taint added by the TaintNode remediation action
+	// of a health check. The name of the health check is appended to it.
+	HealthCheckTaintKeyPrefix = "health-check.node.gardener.cloud/"
 )
 
 // OSVersionRegex is a regular expression to match operating system versions.
@@ -104,6 +112,9 @@ type ControllerConfiguration struct {
 	OperatingSystemConfig OperatingSystemConfigControllerConfig `json:"operatingSystemConfig"`
 	// Token is the configuration for the access token controller.
 	Token TokenControllerConfig `json:"token"`
+	// HealthCheck is the configuration for the health check controller.
+	// +optional
+	HealthCheck HealthCheckControllerConfig `json:"healthCheck"`
 }
 
 // OperatingSystemConfigControllerConfig defines the configuration of the operating system config controller.
@@ -138,6 +149,127 @@ type TokenSecretSyncConfig struct {
 	Path string `json:"path"`
 }
 
+// HealthCheckControllerConfig defines the configuration of the health check controller.
+type HealthCheckControllerConfig struct {
+	// SyncPeriod is the duration how often the health checks are executed.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// Checks is the list of health checks which are executed in addition to the built-in health checks for containerd
+	// and the kubelet.
+	// +optional
+	Checks []HealthCheck `json:"checks,omitempty"`
+}
+
+// HealthCheck defines a health check of a node component and the remediation actions which are executed if it keeps
+// failing. Exactly one of SystemdUnit, HTTP, DiskPressure, and FileExists must be set.
+type HealthCheck struct {
+	// Name is the unique name of the health check.
+	Name string `json:"name"`
+	// ConditionType is the type of the Node condition which reports the result of the health check. Its status is True
+	// if the health check succeeds, and False otherwise.
+	ConditionType corev1.NodeConditionType `json:"conditionType"`
+	// SystemdUnit checks whether a systemd unit is active.
+	// +optional
+	SystemdUnit *SystemdUnitHealthCheck `json:"systemdUnit,omitempty"`
+	// HTTP checks whether an HTTP endpoint responds with the expected status code.
+	// +optional
+	HTTP *HTTPHealthCheck `json:"http,omitempty"`
+	// DiskPressure checks whether a file system has enough available space and inodes.
+	// +optional
+	DiskPressure *DiskPressureHealthCheck `json:"diskPressure,omitempty"`
+	// FileExists checks whether a file exists.
+	// +optional
+	FileExists *FileExistsHealthCheck `json:"fileExists,omitempty"`
+	// FailureThreshold is the duration for which the health check must fail before the first remediation action is
+	// executed.
+	// +optional
+	FailureThreshold *metav1.Duration `json:"failureThreshold,omitempty"`
+	// Remediations are the actions which are executed if the health check keeps failing. Each remediation attempt
+	// executes the next action of the list, the last action is repeated for all further attempts.
+	// +optional
+	Remediations []HealthCheckRemediation `json:"remediations,omitempty"`
+	// Backoff configures the delay between the remediation attempts.
+	// +optional
+	Backoff *HealthCheckBackoff `json:"backoff,omitempty"`
+}
+
+// SystemdUnitHealthCheck checks whether a systemd unit is active.
+type SystemdUnitHealthCheck struct {
+	// Name is the name of the systemd unit, e.g., containerd.service.
+	Name string `json:"name"`
+}
+
+// HTTPHealthCheck checks whether an HTTP endpoint responds with the expected status code.
+type HTTPHealthCheck struct {
+	// URL is the URL of the HTTP endpoint.
+	URL string `json:"url"`
+	// ExpectedStatusCode is the status code which the HTTP endpoint is expected to respond with.
+	// +optional
+	ExpectedStatusCode *int32 `json:"expectedStatusCode,omitempty"`
+	// Timeout is the timeout for the HTTP request.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// DiskPressureHealthCheck checks whether a file system has enough available space and inodes.
+type DiskPressureHealthCheck struct {
+	// Path is a path on the file system, e.g., /var/lib/containerd.
+	Path string `json:"path"`
+	// MinAvailablePercentage is the minimum percentage of available space on the file system.
+	// +optional
+	MinAvailablePercentage *int32 `json:"minAvailablePercentage,omitempty"`
+	// MinAvailableInodesPercentage is the minimum percentage of available inodes on the file system.
+	// +optional
+	MinAvailableInodesPercentage *int32 `json:"minAvailableInodesPercentage,omitempty"`
+}
+
+// FileExistsHealthCheck checks whether a file exists.
+type FileExistsHealthCheck struct {
+	// Path is the path of the file.
+	Path string `json:"path"`
+}
+
+// HealthCheckRemediationType is the type of remediation action.
+type HealthCheckRemediationType string
+
+const (
+	// HealthCheckRemediationRestartUnit restarts a systemd unit.
+	HealthCheckRemediationRestartUnit HealthCheckRemediationType = "RestartUnit"
+	// HealthCheckRemediationCleanImageCache removes all container images which are not used by any container.
+	HealthCheckRemediationCleanImageCache HealthCheckRemediationType = "CleanImageCache"
+	// HealthCheckRemediationTaintNode adds a taint to the Node. The taint is removed as soon as the health check succeeds
+	// again.
+	HealthCheckRemediationTaintNode HealthCheckRemediationType = "TaintNode"
+	// HealthCheckRemediationReplaceMachine requests machine-controller-manager to replace the machine by annotating the
+	// Node. It has no effect for Nodes which are not managed by machine-controller-manager, e.g., the control plane
+	// nodes of self-hosted shoots or the nodes of self-hosted shoots with unmanaged infrastructure.
+	HealthCheckRemediationReplaceMachine HealthCheckRemediationType = "ReplaceMachine"
+)
+
+// HealthCheckRemediation is a remediation action of a health check.
+type HealthCheckRemediation struct {
+	// Type is the type of the remediation action.
+	Type HealthCheckRemediationType `json:"type"`
+	// Unit is the name of the systemd unit which is restarted by the RestartUnit action. Defaults to the unit of the
+	// SystemdUnit health check.
+	// +optional
+	Unit string `json:"unit,omitempty"`
+	// Taint is the taint which is added by the TaintNode action.
+	// +optional
+	Taint *corev1.Taint `json:"taint,omitempty"`
+}
+
+// HealthCheckBackoff configures the delay between the remediation attempts of a health check. The delay starts with
+// InitialDelay and doubles with each attempt until it reaches MaxDelay.
+type HealthCheckBackoff struct {
+	// InitialDelay is the delay between the first and the second remediation attempt.
+	// +optional
+	InitialDelay *metav1.Duration `json:"initialDelay,omitempty"`
+	// MaxDelay is the maximum delay between two remediation attempts.
+	// +optional
+	MaxDelay *metav1.Duration `json:"maxDelay,omitempty"`
+}
+
 // ServerConfiguration contains details for the HTTP(S) servers.
 type ServerConfiguration struct {
 	// HealthProbes is the configuration for serving the healthz and readyz endpoints.
@@ -5,10 +5,17 @@
 package validation
 
 import (
+	"fmt"
+	"net/url"
+	"path/filepath"
 	"time"
 
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/gardener/pkg/logger"
@@ -48,6 +55,7 @@ func validateControllerConfiguration(conf nodeagentconfigv1alpha1.ControllerConf
 
 	allErrs = append(allErrs, validateOperatingSystemConfigControllerConfiguration(conf.OperatingSystemConfig, fldPath.Child("operatingSystemConfig"))...)
 	allErrs = append(allErrs, validateTokenControllerConfiguration(conf.Token, fldPath.Child("token"))...)
+	allErrs = append(allErrs, validateHealthCheckControllerConfiguration(conf.HealthCheck, fldPath.Child("healthCheck"))...)
 
 	return allErrs
 }
@@ -98,6 +106,214 @@ func validateTokenControllerConfiguration(conf nodeagentconfigv1alpha1.TokenCont
 	return allErrs
 }
 
+var (
+	// builtInNodeConditionTypes are the types of the Node conditions maintained by the kubelet and gardener-node-agent.
+	builtInNodeConditionTypes = sets.New(
+		corev1.NodeReady,
+		corev1.NodeMemoryPressure,
+		corev1.NodeDiskPressure,
+		corev1.NodePIDPressure,
+		corev1.NodeNetworkUnavailable,
+		machinev1alpha1.NodeInPlaceUpdate,
+	)
+
+	supportedHealthCheckRemediationTypes = sets.New(
+		nodeagentconfigv1alpha1.HealthCheckRemediationRestartUnit,
+		nodeagentconfigv1alpha1.HealthCheckRemediationCleanImageCache,
+		nodeagentconfigv1alpha1.HealthCheckRemediationTaintNode,
+		nodeagentconfigv1alpha1.HealthCheckRemediationReplaceMachine,
+	)
+
+	supportedTaintEffects = sets.New(
+		corev1.TaintEffectNoSchedule,
+		corev1.TaintEffectPreferNoSchedule,
+		corev1.TaintEffectNoExecute,
+	)
+)
+
+func validateHealthCheckControllerConfiguration(conf nodeagentconfigv1alpha1.HealthCheckControllerConfig, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs        = field.ErrorList{}
+		names          = sets.New[string]()
+		conditionTypes = sets.New[corev1.NodeConditionType]()
+	)
+
+	if conf.SyncPeriod != nil {
+		allErrs = append(allErrs, validateSyncPeriod(conf.SyncPeriod, fldPath)...)
+	}
+
+	for i, check := range conf.Checks {
+		idxPath := fldPath.Child("checks").Index(i)
+
+		if check.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide the name of the health check"))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(check.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), check.Name, msg))
+			}
+			if names.Has(check.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), check.Name))
+			}
+			names.Insert(check.Name)
+		}
+
+		if check.ConditionType == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("conditionType"), "must provide the type of the node condition reporting the result of the health check"))
+		} else {
+			if builtInNodeConditionTypes.Has(check.ConditionType) {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("conditionType"), fmt.Sprintf("must not be one of the built-in node condition types %v", sets.List(builtInNodeConditionTypes))))
+			}
+			if conditionTypes.Has(check.ConditionType) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("conditionType"), check.ConditionType))
+			}
+			conditionTypes.Insert(check.ConditionType)
+		}
+
+		allErrs = append(allErrs, validateHealthCheckProbe(check, idxPath)...)
+
+		if check.FailureThreshold != nil && check.FailureThreshold.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("failureThreshold"), check.FailureThreshold, "must not be negative"))
+		}
+
+		for j, remediation := range check.Remediations {
+			allErrs = append(allErrs, validateHealthCheckRemediation(check, remediation, idxPath.Child("remediations").Index(j))...)
+		}
+
+		if check.Backoff != nil {
+			allErrs = append(allErrs, validateHealthCheckBackoff(*check.Backoff, idxPath.Child("backoff"))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateHealthCheckProbe(check nodeagentconfigv1alpha1.HealthCheck, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		probes  int
+	)
+
+	if check.SystemdUnit != nil {
+		probes++
+
+		if check.SystemdUnit.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("systemdUnit", "name"), "must provide the name of the systemd unit"))
+		}
+	}
+
+	if check.HTTP != nil {
+		probes++
+		httpPath := fldPath.Child("http")
+
+		if check.HTTP.URL == "" {
+			allErrs = append(allErrs, field.Required(httpPath.Child("url"), "must provide the URL of the HTTP endpoint"))
+		} else if u, err := url.Parse(check.HTTP.URL); err != nil {
+			allErrs = append(allErrs, field.Invalid(httpPath.Child("url"), check.HTTP.URL, err.Error()))
+		} else if u.Scheme != "http" && u.Scheme != "https" {
+			allErrs = append(allErrs, field.Invalid(httpPath.Child("url"), check.HTTP.URL, "must use the http or https scheme"))
+		}
+
+		if check.HTTP.ExpectedStatusCode != nil && (*check.HTTP.ExpectedStatusCode < 100 || *check.HTTP.ExpectedStatusCode > 599) {
+			allErrs = append(allErrs, field.Invalid(httpPath.Child("expectedStatusCode"), *check.HTTP.ExpectedStatusCode, "must be a valid HTTP status code"))
+		}
+
+		if check.HTTP.Timeout != nil && check.HTTP.Timeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(httpPath.Child("timeout"), check.HTTP.Timeout, "must be positive"))
+		}
+	}
+
+	if check.DiskPressure != nil {
+		probes++
+		diskPressurePath := fldPath.Child("diskPressure")
+
+		allErrs = append(allErrs, validateAbsolutePath(check.DiskPressure.Path, diskPressurePath.Child("path"))...)
+		allErrs = append(allErrs, validatePercentage(check.DiskPressure.MinAvailablePercentage, diskPressurePath.Child("minAvailablePercentage"))...)
+		allErrs = append(allErrs, validatePercentage(check.DiskPressure.MinAvailableInodesPercentage, diskPressurePath.Child("minAvailableInodesPercentage"))...)
+	}
+
+	if check.FileExists != nil {
+		probes++
+
+		allErrs = append(allErrs, validateAbsolutePath(check.FileExists.Path, fldPath.Child("fileExists", "path"))...)
+	}
+
+	if probes != 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, check.Name, "must specify exactly one of systemdUnit, http, diskPressure, and fileExists"))
+	}
+
+	return allErrs
+}
+
+func validateHealthCheckRemediation(check nodeagentconfigv1alpha1.HealthCheck, remediation nodeagentconfigv1alpha1.HealthCheckRemediation, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !supportedHealthCheckRemediationTypes.Has(remediation.Type) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), remediation.Type, sets.List(supportedHealthCheckRemediationTypes)))
+	}
+
+	if remediation.Type == nodeagentconfigv1alpha1.HealthCheckRemediationRestartUnit {
+		if remediation.Unit == "" && check.SystemdUnit == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("unit"), "must provide the systemd unit to restart"))
+		}
+	} else if remediation.Unit != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("unit"), fmt.Sprintf("can only be set for remediation type %s", nodeagentconfigv1alpha1.HealthCheckRemediationRestartUnit)))
+	}
+
+	if remediation.Taint != nil {
+		if remediation.Type != nodeagentconfigv1alpha1.HealthCheckRemediationTaintNode {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("taint"), fmt.Sprintf("can only be set for remediation type %s", nodeagentconfigv1alpha1.HealthCheckRemediationTaintNode)))
+		} else {
+			allErrs = append(allErrs, metav1validation.ValidateLabelName(remediation.Taint.Key, fldPath.Child("taint", "key"))...)
+			if remediation.Taint.Value != "" {
+				for _, msg := range validation.IsValidLabelValue(remediation.Taint.Value) {
+					allErrs = append(allErrs, field.Invalid(fldPath.Child("taint", "value"), remediation.Taint.Value, msg))
+				}
+			}
+			if !supportedTaintEffects.Has(remediation.Taint.Effect) {
+				allErrs = append(allErrs, field.NotSupported(fldPath.Child("taint", "effect"), remediation.Taint.Effect, sets.List(supportedTaintEffects)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateHealthCheckBackoff(backoff nodeagentconfigv1alpha1.HealthCheckBackoff, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if backoff.InitialDelay != nil && backoff.InitialDelay.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("initialDelay"), backoff.InitialDelay, "must be positive"))
+	}
+
+	if backoff.InitialDelay != nil && backoff.MaxDelay != nil && backoff.MaxDelay.Duration < backoff.InitialDelay.Duration {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxDelay"), backoff.MaxDelay, "must not be less than the initial delay"))
+	}
+
+	return allErrs
+}
+
+func validateAbsolutePath(path string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if path == "" {
+		allErrs = append(allErrs, field.Required(fldPath, "must provide a path"))
+	} else if !filepath.IsAbs(path) {
+		allErrs = append(allErrs, field.Invalid(fldPath, path, "must be an absolute path"))
+	}
+
+	return allErrs
+}
+
+func validatePercentage(val *int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if val != nil && (*val < 0 || *val > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath, *val, "must be between 0 and 100"))
+	}
+
+	return allErrs
+}
+
 func validateSyncPeriod(val *metav1.Duration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -11,9 +11,11 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	"k8s.io/utils/ptr"
 
 	. "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	. "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1/validation"
@@ -161,4 +163,235 @@ var _ = Describe("#ValidateNodeAgentConfiguration", func() {
 			))
 		})
 	})
+
+	Context("Health Check Controller", func() {
+		BeforeEach(func() {
+			config.Controllers.HealthCheck = HealthCheckControllerConfig{
+				SyncPeriod: &metav1.Duration{Duration: 30 * time.Second},
+				Checks: []HealthCheck{
+					{
+						Name:          "containerd",
+						ConditionType: "ContainerdHealthy",
+						SystemdUnit:   &SystemdUnitHealthCheck{Name: "containerd.service"},
+						Remediations: []HealthCheckRemediation{
+							{Type: HealthCheckRemediationRestartUnit},
+							{Type: HealthCheckRemediationCleanImageCache},
+							{Type: HealthCheckRemediationTaintNode, Taint: &corev1.Taint{Key: "example.com/unhealthy", Effect: corev1.TaintEffectNoSchedule}},
+							{Type: HealthCheckRemediationReplaceMachine},
+						},
+						Backoff: &HealthCheckBackoff{
+							InitialDelay: &metav1.Duration{Duration: time.Minute},
+							MaxDelay:     &metav1.Duration{Duration: time.Hour},
+						},
+					},
+					{
+						Name:          "registry",
+						ConditionType: "RegistryHealthy",
+						HTTP:          &HTTPHealthCheck{URL: "http://localhost:5000/healthz", ExpectedStatusCode: ptr.To[int32](204)},
+					},
+					{
+						Name:          "containerd-disk",
+						ConditionType: "ContainerdDiskHealthy",
+						DiskPressure:  &DiskPressureHealthCheck{Path: "/var/lib/containerd", MinAvailablePercentage: ptr.To[int32](20)},
+					},
+					{
+						Name:          "cni-config",
+						ConditionType: "CNIConfigHealthy",
+						FileExists:    &FileExistsHealthCheck{Path: "/etc/cni/net.d/10-calico.conflist"},
+						Remediations: []HealthCheckRemediation{
+							{Type: HealthCheckRemediationRestartUnit, Unit: "kubelet.service"},
+						},
+					},
+				},
+			}
+		})
+
+		It("should pass for valid health checks", func() {
+			Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
+		})
+
+		It("should fail because sync period is too small", func() {
+			config.Controllers.HealthCheck.SyncPeriod.Duration = 10 * time.Second
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.syncPeriod"),
+				})),
+			))
+		})
+
+		It("should fail because sync period is below one second", func() {
+			config.Controllers.HealthCheck.SyncPeriod.Duration = 500 * time.Millisecond
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.syncPeriod"),
+				})),
+			))
+		})
+
+		It("should fail because names and condition types are missing, invalid or duplicated", func() {
+			config.Controllers.HealthCheck.Checks[1].Name = "containerd"
+			config.Controllers.HealthCheck.Checks[1].ConditionType = "ContainerdHealthy"
+			config.Controllers.HealthCheck.Checks[2].Name = "Containerd_Disk"
+			config.Controllers.HealthCheck.Checks[2].ConditionType = corev1.NodeDiskPressure
+			config.Controllers.HealthCheck.Checks[3].Name = ""
+			config.Controllers.HealthCheck.Checks[3].ConditionType = ""
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("controllers.healthCheck.checks[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("controllers.healthCheck.checks[1].conditionType"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.checks[2].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("controllers.healthCheck.checks[2].conditionType"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.healthCheck.checks[3].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.healthCheck.checks[3].conditionType"),
+				})),
+			))
+		})
+
+		It("should fail because not exactly one probe is specified", func() {
+			config.Controllers.HealthCheck.Checks[0].FileExists = &FileExistsHealthCheck{Path: "/etc/containerd/config.toml"}
+			config.Controllers.HealthCheck.Checks[1].HTTP = nil
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.checks[0]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.checks[1]"),
+				})),
+			))
+		})
+
+		It("should fail because the probes are invalid", func() {
+			config.Controllers.HealthCheck.Checks[0].SystemdUnit.Name = ""
+			config.Controllers.HealthCheck.Checks[1].HTTP = &HTTPHealthCheck{
+				URL:                "ftp://localhost/healthz",
+				ExpectedStatusCode: ptr.To[int32](42),
+				Timeout:            &metav1.Duration{},
+			}
+			config.Controllers.HealthCheck.Checks[2].DiskPressure = &DiskPressureHealthCheck{
+				Path:                         "var/lib/containerd",
+				MinAvailablePercentage:       ptr.To[int32](101),
+				MinAvailableInodesPercentage: ptr.To[int32](-1),
+			}
+			config.Controllers.HealthCheck.Checks[3].FileExists.Path = ""
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.healthCheck.checks[0].systemdUnit.name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.checks[1].http.url"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.checks[1].http.expectedStatusCode"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.checks[1].http.timeout"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.checks[2].diskPressure.path"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.checks[2].diskPressure.minAvailablePercentage"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.checks[2].diskPressure.minAvailableInodesPercentage"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.healthCheck.checks[3].fileExists.path"),
+				})),
+			))
+		})
+
+		It("should fail because the remediations are invalid", func() {
+			config.Controllers.HealthCheck.Checks[0].Remediations = []HealthCheckRemediation{
+				{Type: "Reboot"},
+				{Type: HealthCheckRemediationCleanImageCache, Unit: "containerd.service"},
+				{Type: HealthCheckRemediationReplaceMachine, Taint: &corev1.Taint{Key: "foo", Effect: corev1.TaintEffectNoSchedule}},
+				{Type: HealthCheckRemediationTaintNode, Taint: &corev1.Taint{Key: "foo/bar/baz", Effect: "Evict"}},
+			}
+			config.Controllers.HealthCheck.Checks[1].Remediations = []HealthCheckRemediation{
+				{Type: HealthCheckRemediationRestartUnit},
+			}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("controllers.healthCheck.checks[0].remediations[0].type"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("controllers.healthCheck.checks[0].remediations[1].unit"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("controllers.healthCheck.checks[0].remediations[2].taint"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.checks[0].remediations[3].taint.key"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("controllers.healthCheck.checks[0].remediations[3].taint.effect"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("controllers.healthCheck.checks[1].remediations[0].unit"),
+				})),
+			))
+		})
+
+		It("should fail because the failure threshold and the backoff are invalid", func() {
+			config.Controllers.HealthCheck.Checks[0].FailureThreshold = &metav1.Duration{Duration: -time.Second}
+			config.Controllers.HealthCheck.Checks[0].Backoff.MaxDelay.Duration = time.Second
+			config.Controllers.HealthCheck.Checks[1].Backoff = &HealthCheckBackoff{InitialDelay: &metav1.Duration{}}
+
+			Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.checks[0].failureThreshold"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.checks[0].backoff.maxDelay"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.healthCheck.checks[1].backoff.initialDelay"),
+				})),
+			))
+		})
+	})
 })
@@ -27,4 +27,18 @@ func SetObjectDefaults_NodeAgentConfiguration(in *NodeAgentConfiguration) {
 	SetDefaults_ServerConfiguration(&in.Server)
 	SetDefaults_OperatingSystemConfigControllerConfig(&in.Controllers.OperatingSystemConfig)
 	SetDefaults_TokenControllerConfig(&in.Controllers.Token)
+	SetDefaults_HealthCheckControllerConfig(&in.Controllers.HealthCheck)
+	for i := range in.Controllers.HealthCheck.Checks {
+		a := &in.Controllers.HealthCheck.Checks[i]
+		SetDefaults_HealthCheck(a)
+		if a.HTTP != nil {
+			SetDefaults_HTTPHealthCheck(a.HTTP)
+		}
+		if a.DiskPressure != nil {
+			SetDefaults_DiskPressureHealthCheck(a.DiskPressure)
+		}
+		if a.Backoff != nil {
+			SetDefaults_HealthCheckBackoff(a.Backoff)
+		}
+	}
 }
@@ -12,6 +12,7 @@ import (
 	containerd "github.com/containerd/containerd/v2/client"
 	"github.com/containerd/containerd/v2/defaults"
 	"github.com/containerd/containerd/v2/pkg/namespaces"
+	"github.com/spf13/afero"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -51,6 +52,10 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, nodePredicate predicate.P
 
 	if r.HealthCheckIntervalSeconds == 0 {
 		r.HealthCheckIntervalSeconds = defaultIntervalSeconds
+		if r.Config.SyncPeriod != nil {
+			// Sub-second sync periods would be truncated to 0, hence run the checks at least every second.
+			r.HealthCheckIntervalSeconds = max(1, int32(r.Config.SyncPeriod.Seconds()))
+		}
 	}
 
 	return builder.
@@ -83,5 +88,15 @@ func (r *Reconciler) setDefaultHealthChecks() error {
 
 	kubeletHealthChecker := NewKubeletHealthChecker(r.Client, clock, r.DBus, r.Recorder, net.InterfaceAddrs)
 	r.HealthCheckers = []HealthChecker{containerdHealthChecker, kubeletHealthChecker}
+
+	var (
+		fs          = afero.Afero{Fs: afero.NewOsFs()}
+		imagePruner = NewContainerdImagePruner(client)
+	)
+
+	for _, check := range r.Config.Checks {
+		r.HealthCheckers = append(r.HealthCheckers, NewConfigurableHealthChecker(check, r.Client, clock, r.DBus, r.Recorder, fs, imagePruner))
+	}
+
 	return nil
 }
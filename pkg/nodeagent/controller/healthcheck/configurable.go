@@ -0,0 +1,387 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"syscall"
+	"time"
+
+	systemddbus "github.com/coreos/go-systemd/v22/dbus"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+)
+
+const (
+	// ConditionReasonHealthCheckSucceeded is the reason of the Node condition of a health check which succeeded.
+	ConditionReasonHealthCheckSucceeded = "HealthCheckSucceeded"
+	// ConditionReasonHealthCheckFailed is the reason of the Node condition of a health check which failed.
+	ConditionReasonHealthCheckFailed = "HealthCheckFailed"
+
+	// AnnotationKeyTriggerDeletionByMCM is the annotation which requests machine-controller-manager to replace the
+	// machine of a Node.
+	AnnotationKeyTriggerDeletionByMCM = "node.machine.sapcloud.io/trigger-deletion-by-mcm"
+)
+
+// DiskUsage contains the available and total space and inodes of a file system.
+type DiskUsage struct {
+	// AvailableBlocks is the number of blocks available to unprivileged users.
+	AvailableBlocks uint64
+	// TotalBlocks is the total number of blocks.
+	TotalBlocks uint64
+	// AvailableInodes is the number of free inodes.
+	AvailableInodes uint64
+	// TotalInodes is the total number of inodes.
+	TotalInodes uint64
+}
+
+// StatFS returns the usage of the file system containing the given path. Exposed for testing.
+var StatFS = func(path string) (DiskUsage, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return DiskUsage{}, err
+	}
+
+	return DiskUsage{
+		AvailableBlocks: stat.Bavail,
+		TotalBlocks:     stat.Blocks,
+		AvailableInodes: stat.Ffree,
+		TotalInodes:     stat.Files,
+	}, nil
+}
+
+// ImagePruner removes container images.
+type ImagePruner interface {
+	// PruneImages removes all container images which are not used by any container.
+	PruneImages(ctx context.Context) error
+}
+
+// configurableHealthChecker executes a health check defined in the configuration of gardener-node-agent. It reports
+// the result as a Node condition and executes the configured remediation actions if the check keeps failing.
+type configurableHealthChecker struct {
+	config      nodeagentconfigv1alpha1.HealthCheck
+	client      client.Client
+	clock       clock.Clock
+	dbus        dbus.DBus
+	recorder    record.EventRecorder
+	fs          afero.Afero
+	imagePruner ImagePruner
+	httpClient  *http.Client
+
+	firstFailure    *time.Time
+	attempts        int
+	nextRemediation time.Time
+}
+
+// NewConfigurableHealthChecker creates a new instance of a health check defined in the configuration.
+func NewConfigurableHealthChecker(
+	config nodeagentconfigv1alpha1.HealthCheck,
+	client client.Client,
+	clock clock.Clock,
+	dbus dbus.DBus,
+	recorder record.EventRecorder,
+	fs afero.Afero,
+	imagePruner ImagePruner,
+) HealthChecker {
+	timeout := 10 * time.Second
+	if config.HTTP != nil && config.HTTP.Timeout != nil {
+		timeout = config.HTTP.Timeout.Duration
+	}
+
+	return &configurableHealthChecker{
+		config:      config,
+		client:      client,
+		clock:       clock,
+		dbus:        dbus,
+		recorder:    recorder,
+		fs:          fs,
+		imagePruner: imagePruner,
+		httpClient:  &http.Client{Timeout: timeout},
+	}
+}
+
+// Name returns the name of this health check.
+func (c *configurableHealthChecker) Name() string {
+	return c.config.Name
+}
+
+// Check executes the health check, reports its result as a Node condition, and executes the next remediation action
+// if the health check failed for longer than the failure threshold and the backoff delay has passed.
+func (c *configurableHealthChecker) Check(ctx context.Context, node *corev1.Node) error {
+	log := logf.FromContext(ctx).WithName(c.Name())
+
+	checkErr := c.check(ctx)
+
+	if err := c.patchCondition(ctx, node, checkErr); err != nil {
+		return err
+	}
+
+	if checkErr == nil {
+		if c.firstFailure != nil {
+			log.Info("Health check succeeds again")
+			c.recorder.Eventf(node, corev1.EventTypeNormal, c.Name(), "Health check %s succeeds again", c.Name())
+		}
+
+		c.firstFailure, c.attempts, c.nextRemediation = nil, 0, time.Time{}
+		return c.removeTaints(ctx, node)
+	}
+
+	now := c.clock.Now()
+	if c.firstFailure == nil {
+		c.firstFailure = &now
+
+		log.Error(checkErr, "Health check failed")
+		c.recorder.Eventf(node, corev1.EventTypeWarning, c.Name(), "Health check %s failed: %s", c.Name(), checkErr.Error())
+	}
+
+	if len(c.config.Remediations) == 0 || now.Sub(*c.firstFailure) < c.failureThreshold() || now.Before(c.nextRemediation) {
+		return nil
+	}
+
+	remediation := c.config.Remediations[min(c.attempts, len(c.config.Remediations)-1)]
+
+	log.Info("Health check keeps failing, executing remediation action", "type", remediation.Type, "attempt", c.attempts+1)
+	c.recorder.Eventf(node, corev1.EventTypeWarning, c.Name(), "Health check %s failed for more than %s, executing remediation action %s: %s", c.Name(), now.Sub(*c.firstFailure).Round(time.Second), remediation.Type, checkErr.Error())
+
+	c.nextRemediation = now.Add(c.backoffDelay())
+	c.attempts++
+
+	if err := c.remediate(ctx, node, remediation); err != nil {
+		return fmt.Errorf("failed executing remediation action %s of health check %s: %w", remediation.Type, c.Name(), err)
+	}
+
+	return nil
+}
+
+func (c *configurableHealthChecker) check(ctx context.Context) error {
+	switch {
+	case c.config.SystemdUnit != nil:
+		return c.checkSystemdUnit(ctx)
+	case c.config.HTTP != nil:
+		return c.checkHTTP(ctx)
+	case c.config.DiskPressure != nil:
+		return c.checkDiskPressure()
+	case c.config.FileExists != nil:
+		return c.checkFileExists()
+	default:
+		return fmt.Errorf("health check %s does not specify what to check", c.Name())
+	}
+}
+
+func (c *configurableHealthChecker) checkSystemdUnit(ctx context.Context) error {
+	units, err := c.dbus.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed listing systemd units: %w", err)
+	}
+
+	idx := slices.IndexFunc(units, func(unit systemddbus.UnitStatus) bool { return unit.Name == c.config.SystemdUnit.Name })
+	if idx == -1 {
+		return fmt.Errorf("systemd unit %s not found", c.config.SystemdUnit.Name)
+	}
+
+	if state := units[idx].ActiveState; state != "active" {
+		return fmt.Errorf("systemd unit %s is %s (%s)", c.config.SystemdUnit.Name, state, units[idx].SubState)
+	}
+
+	return nil
+}
+
+func (c *configurableHealthChecker) checkHTTP(ctx context.Context) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, c.config.HTTP.URL, nil)
+	if err != nil {
+		return fmt.Errorf("failed creating request to %s: %w", c.config.HTTP.URL, err)
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("request to %s failed: %w", c.config.HTTP.URL, err)
+	}
+	defer response.Body.Close()
+
+	expectedStatusCode := http.StatusOK
+	if c.config.HTTP.ExpectedStatusCode != nil {
+		expectedStatusCode = int(*c.config.HTTP.ExpectedStatusCode)
+	}
+
+	if response.StatusCode != expectedStatusCode {
+		return fmt.Errorf("%s responded with status code %d instead of %d", c.config.HTTP.URL, response.StatusCode, expectedStatusCode)
+	}
+
+	return nil
+}
+
+func (c *configurableHealthChecker) checkDiskPressure() error {
+	usage, err := StatFS(c.config.DiskPressure.Path)
+	if err != nil {
+		return fmt.Errorf("failed reading usage of file system containing %s: %w", c.config.DiskPressure.Path, err)
+	}
+
+	if minAvailable := c.config.DiskPressure.MinAvailablePercentage; minAvailable != nil && usage.TotalBlocks > 0 {
+		if available := usage.AvailableBlocks * 100 / usage.TotalBlocks; available < uint64(*minAvailable) {
+			return fmt.Errorf("file system containing %s has only %d%% available space, at least %d%% are required", c.config.DiskPressure.Path, available, *minAvailable)
+		}
+	}
+
+	if minAvailable := c.config.DiskPressure.MinAvailableInodesPercentage; minAvailable != nil && usage.TotalInodes > 0 {
+		if available := usage.AvailableInodes * 100 / usage.TotalInodes; available < uint64(*minAvailable) {
+			return fmt.Errorf("file system containing %s has only %d%% available inodes, at least %d%% are required", c.config.DiskPressure.Path, available, *minAvailable)
+		}
+	}
+
+	return nil
+}
+
+func (c *configurableHealthChecker) checkFileExists() error {
+	exists, err := c.fs.Exists(c.config.FileExists.Path)
+	if err != nil {
+		return fmt.Errorf("failed checking whether file %s exists: %w", c.config.FileExists.Path, err)
+	}
+
+	if !exists {
+		return fmt.Errorf("file %s does not exist", c.config.FileExists.Path)
+	}
+
+	return nil
+}
+
+func (c *configurableHealthChecker) remediate(ctx context.Context, node *corev1.Node, remediation nodeagentconfigv1alpha1.HealthCheckRemediation) error {
+	switch remediation.Type {
+	case nodeagentconfigv1alpha1.HealthCheckRemediationRestartUnit:
+		return c.dbus.Restart(ctx, c.recorder, node, remediation.Unit)
+
+	case nodeagentconfigv1alpha1.HealthCheckRemediationCleanImageCache:
+		if c.imagePruner == nil {
+			return fmt.Errorf("cleaning the image cache is not supported")
+		}
+		return c.imagePruner.PruneImages(ctx)
+
+	case nodeagentconfigv1alpha1.HealthCheckRemediationTaintNode:
+		if remediation.Taint == nil || slices.ContainsFunc(node.Spec.Taints, func(taint corev1.Taint) bool { return remediation.Taint.MatchTaint(&taint) }) {
+			return nil
+		}
+
+		patch := client.MergeFromWithOptions(node.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		node.Spec.Taints = append(node.Spec.Taints, *remediation.Taint)
+		return c.client.Patch(ctx, node, patch)
+
+	case nodeagentconfigv1alpha1.HealthCheckRemediationReplaceMachine:
+		if node.Annotations[AnnotationKeyTriggerDeletionByMCM] == "true" {
+			return nil
+		}
+
+		patch := client.MergeFrom(node.DeepCopy())
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, AnnotationKeyTriggerDeletionByMCM, "true")
+		return c.client.Patch(ctx, node, patch)
+
+	default:
+		return fmt.Errorf("unsupported remediation action %s", remediation.Type)
+	}
+}
+
+// removeTaints removes the taints added by the TaintNode remediation actions of this health check.
+func (c *configurableHealthChecker) removeTaints(ctx context.Context, node *corev1.Node) error {
+	var taints []corev1.Taint
+	for _, remediation := range c.config.Remediations {
+		if remediation.Type == nodeagentconfigv1alpha1.HealthCheckRemediationTaintNode && remediation.Taint != nil {
+			taints = append(taints, *remediation.Taint)
+		}
+	}
+
+	isOwnTaint := func(taint corev1.Taint) bool {
+		return slices.ContainsFunc(taints, func(t corev1.Taint) bool { return t.MatchTaint(&taint) })
+	}
+
+	if !slices.ContainsFunc(node.Spec.Taints, isOwnTaint) {
+		return nil
+	}
+
+	patch := client.MergeFromWithOptions(node.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	node.Spec.Taints = slices.DeleteFunc(node.Spec.Taints, isOwnTaint)
+	if err := c.client.Patch(ctx, node, patch); err != nil {
+		return fmt.Errorf("failed removing taints of health check %s from node: %w", c.Name(), err)
+	}
+
+	return nil
+}
+
+// patchCondition reports the result of the health check as a Node condition. The Node is only patched if the status
+// or the message of the condition changes.
+func (c *configurableHealthChecker) patchCondition(ctx context.Context, node *corev1.Node, checkErr error) error {
+	condition := corev1.NodeCondition{
+		Type:    c.config.ConditionType,
+		Status:  corev1.ConditionTrue,
+		Reason:  ConditionReasonHealthCheckSucceeded,
+		Message: fmt.Sprintf("Health check %s succeeded", c.Name()),
+	}
+	if checkErr != nil {
+		condition.Status = corev1.ConditionFalse
+		condition.Reason = ConditionReasonHealthCheckFailed
+		condition.Message = checkErr.Error()
+	}
+
+	idx := slices.IndexFunc(node.Status.Conditions, func(cond corev1.NodeCondition) bool { return cond.Type == condition.Type })
+	if idx != -1 && node.Status.Conditions[idx].Status == condition.Status && node.Status.Conditions[idx].Message == condition.Message {
+		return nil
+	}
+
+	now := metav1.NewTime(c.clock.Now())
+	condition.LastHeartbeatTime = now
+	condition.LastTransitionTime = now
+
+	patch := client.StrategicMergeFrom(node.DeepCopy())
+	if idx == -1 {
+		node.Status.Conditions = append(node.Status.Conditions, condition)
+	} else {
+		if node.Status.Conditions[idx].Status == condition.Status {
+			condition.LastTransitionTime = node.Status.Conditions[idx].LastTransitionTime
+		}
+		node.Status.Conditions[idx] = condition
+	}
+
+	if err := c.client.Status().Patch(ctx, node, patch); err != nil {
+		return fmt.Errorf("failed patching %s condition of node: %w", condition.Type, err)
+	}
+
+	return nil
+}
+
+func (c *configurableHealthChecker) failureThreshold() time.Duration {
+	if c.config.FailureThreshold == nil {
+		return maxFailureDuration
+	}
+	return c.config.FailureThreshold.Duration
+}
+
+// backoffDelay returns the delay until the next remediation attempt. It doubles with each attempt, starting with the
+// initial delay, until it reaches the maximum delay.
+func (c *configurableHealthChecker) backoffDelay() time.Duration {
+	initialDelay, maxDelay := time.Minute, 30*time.Minute
+	if c.config.Backoff != nil {
+		if c.config.Backoff.InitialDelay != nil {
+			initialDelay = c.config.Backoff.InitialDelay.Duration
+		}
+		if c.config.Backoff.MaxDelay != nil {
+			maxDelay = c.config.Backoff.MaxDelay.Duration
+		}
+	}
+
+	delay := initialDelay
+	for i := 0; i < c.attempts && delay < maxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, maxDelay)
+}
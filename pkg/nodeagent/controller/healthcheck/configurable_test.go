@@ -0,0 +1,307 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	systemddbus "github.com/coreos/go-systemd/v22/dbus"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("ConfigurableHealthChecker", func() {
+	var (
+		ctx = context.Background()
+
+		fakeClient  client.Client
+		fakeDBus    *fakedbus.DBus
+		fakeClock   *testclock.FakeClock
+		recorder    *record.FakeRecorder
+		fs          afero.Afero
+		imagePruner *fakeImagePruner
+
+		node   *corev1.Node
+		config nodeagentconfigv1alpha1.HealthCheck
+
+		conditionType corev1.NodeConditionType = "ExampleHealthy"
+
+		newChecker = func() HealthChecker {
+			return NewConfigurableHealthChecker(config, fakeClient, fakeClock, fakeDBus, recorder, fs, imagePruner)
+		}
+
+		check = func(checker HealthChecker) {
+			ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			ExpectWithOffset(1, checker.Check(ctx, node.DeepCopy())).To(Succeed())
+			ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		}
+
+		restarts = func() int {
+			var count int
+			for _, action := range fakeDBus.Actions {
+				if action.Action == fakedbus.ActionRestart {
+					count++
+				}
+			}
+			return count
+		}
+	)
+
+	BeforeEach(func() {
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).WithStatusSubresource(&corev1.Node{}).WithObjects(node).Build()
+		fakeDBus = fakedbus.New()
+		fakeClock = testclock.NewFakeClock(time.Now())
+		recorder = record.NewFakeRecorder(100)
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		imagePruner = &fakeImagePruner{}
+
+		config = nodeagentconfigv1alpha1.HealthCheck{
+			Name:             "example",
+			ConditionType:    conditionType,
+			FailureThreshold: &metav1.Duration{Duration: time.Minute},
+			Backoff: &nodeagentconfigv1alpha1.HealthCheckBackoff{
+				InitialDelay: &metav1.Duration{Duration: time.Minute},
+				MaxDelay:     &metav1.Duration{Duration: 3 * time.Minute},
+			},
+		}
+	})
+
+	Describe("#Name", func() {
+		It("should return the configured name", func() {
+			Expect(newChecker().Name()).To(Equal("example"))
+		})
+	})
+
+	Describe("#Check", func() {
+		Context("systemd unit", func() {
+			BeforeEach(func() {
+				config.SystemdUnit = &nodeagentconfigv1alpha1.SystemdUnitHealthCheck{Name: "example.service"}
+				config.Remediations = []nodeagentconfigv1alpha1.HealthCheckRemediation{{Type: nodeagentconfigv1alpha1.HealthCheckRemediationRestartUnit, Unit: "example.service"}}
+			})
+
+			It("should report a healthy condition if the unit is active", func() {
+				fakeDBus.AddUnitsToList(systemddbus.UnitStatus{Name: "example.service", ActiveState: "active"})
+
+				check(newChecker())
+
+				Expect(node.Status.Conditions).To(ConsistOf(beCondition(conditionType, corev1.ConditionTrue, ConditionReasonHealthCheckSucceeded)))
+				Expect(restarts()).To(BeZero())
+			})
+
+			It("should report an unhealthy condition if the unit is not found", func() {
+				check(newChecker())
+
+				Expect(node.Status.Conditions).To(ConsistOf(beCondition(conditionType, corev1.ConditionFalse, ConditionReasonHealthCheckFailed)))
+				Expect(node.Status.Conditions[0].Message).To(ContainSubstring("not found"))
+			})
+
+			It("should restart the unit after the failure threshold and back off exponentially", func() {
+				fakeDBus.AddUnitsToList(systemddbus.UnitStatus{Name: "example.service", ActiveState: "failed", SubState: "failed"})
+				checker := newChecker()
+
+				check(checker)
+				Expect(node.Status.Conditions).To(ConsistOf(beCondition(conditionType, corev1.ConditionFalse, ConditionReasonHealthCheckFailed)))
+				Expect(restarts()).To(BeZero())
+
+				fakeClock.Step(time.Minute)
+				check(checker)
+				Expect(restarts()).To(Equal(1))
+
+				By("Wait for the initial delay")
+				fakeClock.Step(30 * time.Second)
+				check(checker)
+				Expect(restarts()).To(Equal(1))
+				fakeClock.Step(30 * time.Second)
+				check(checker)
+				Expect(restarts()).To(Equal(2))
+
+				By("Wait for the doubled delay")
+				fakeClock.Step(time.Minute)
+				check(checker)
+				Expect(restarts()).To(Equal(2))
+				fakeClock.Step(time.Minute)
+				check(checker)
+				Expect(restarts()).To(Equal(3))
+
+				By("Wait for the maximum delay")
+				fakeClock.Step(2 * time.Minute)
+				check(checker)
+				Expect(restarts()).To(Equal(3))
+				fakeClock.Step(time.Minute)
+				check(checker)
+				Expect(restarts()).To(Equal(4))
+			})
+
+			It("should return an error if the remediation fails", func() {
+				fakeDBus.AddUnitsToList(systemddbus.UnitStatus{Name: "example.service", ActiveState: "inactive"})
+				fakeDBus.InjectRestartFailure(errors.New("fake"), "example.service")
+				checker := newChecker()
+
+				check(checker)
+				fakeClock.Step(time.Minute)
+				Expect(checker.Check(ctx, node.DeepCopy())).To(MatchError(ContainSubstring("fake")))
+			})
+		})
+
+		Context("HTTP endpoint", func() {
+			var (
+				server     *httptest.Server
+				statusCode int
+			)
+
+			BeforeEach(func() {
+				statusCode = http.StatusOK
+				server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(statusCode)
+				}))
+				DeferCleanup(server.Close)
+
+				config.HTTP = &nodeagentconfigv1alpha1.HTTPHealthCheck{URL: server.URL, ExpectedStatusCode: ptr.To[int32](http.StatusOK), Timeout: &metav1.Duration{Duration: time.Second}}
+			})
+
+			It("should report a healthy condition if the endpoint responds with the expected status code", func() {
+				check(newChecker())
+
+				Expect(node.Status.Conditions).To(ConsistOf(beCondition(conditionType, corev1.ConditionTrue, ConditionReasonHealthCheckSucceeded)))
+			})
+
+			It("should report an unhealthy condition if the endpoint responds with another status code", func() {
+				statusCode = http.StatusInternalServerError
+
+				check(newChecker())
+
+				Expect(node.Status.Conditions).To(ConsistOf(beCondition(conditionType, corev1.ConditionFalse, ConditionReasonHealthCheckFailed)))
+				Expect(node.Status.Conditions[0].Message).To(ContainSubstring("status code 500"))
+			})
+		})
+
+		Context("disk pressure", func() {
+			BeforeEach(func() {
+				config.DiskPressure = &nodeagentconfigv1alpha1.DiskPressureHealthCheck{Path: "/var/lib/containerd", MinAvailablePercentage: ptr.To[int32](10), MinAvailableInodesPercentage: ptr.To[int32](5)}
+				config.Remediations = []nodeagentconfigv1alpha1.HealthCheckRemediation{{Type: nodeagentconfigv1alpha1.HealthCheckRemediationCleanImageCache}}
+			})
+
+			It("should report a healthy condition if enough space and inodes are available", func() {
+				DeferCleanup(test.WithVar(&StatFS, func(string) (DiskUsage, error) {
+					return DiskUsage{AvailableBlocks: 50, TotalBlocks: 100, AvailableInodes: 50, TotalInodes: 100}, nil
+				}))
+
+				check(newChecker())
+
+				Expect(node.Status.Conditions).To(ConsistOf(beCondition(conditionType, corev1.ConditionTrue, ConditionReasonHealthCheckSucceeded)))
+			})
+
+			It("should clean the image cache if not enough space is available", func() {
+				DeferCleanup(test.WithVar(&StatFS, func(string) (DiskUsage, error) {
+					return DiskUsage{AvailableBlocks: 5, TotalBlocks: 100, AvailableInodes: 50, TotalInodes: 100}, nil
+				}))
+				checker := newChecker()
+
+				check(checker)
+				Expect(node.Status.Conditions).To(ConsistOf(beCondition(conditionType, corev1.ConditionFalse, ConditionReasonHealthCheckFailed)))
+				Expect(node.Status.Conditions[0].Message).To(ContainSubstring("5% available space"))
+				Expect(imagePruner.calls).To(BeZero())
+
+				fakeClock.Step(time.Minute)
+				check(checker)
+				Expect(imagePruner.calls).To(Equal(1))
+			})
+
+			It("should report an unhealthy condition if not enough inodes are available", func() {
+				DeferCleanup(test.WithVar(&StatFS, func(string) (DiskUsage, error) {
+					return DiskUsage{AvailableBlocks: 50, TotalBlocks: 100, AvailableInodes: 1, TotalInodes: 100}, nil
+				}))
+
+				check(newChecker())
+
+				Expect(node.Status.Conditions).To(ConsistOf(beCondition(conditionType, corev1.ConditionFalse, ConditionReasonHealthCheckFailed)))
+				Expect(node.Status.Conditions[0].Message).To(ContainSubstring("1% available inodes"))
+			})
+		})
+
+		Context("file exists", func() {
+			var taint corev1.Taint
+
+			BeforeEach(func() {
+				taint = corev1.Taint{Key: nodeagentconfigv1alpha1.HealthCheckTaintKeyPrefix + "example", Effect: corev1.TaintEffectNoSchedule}
+
+				config.FileExists = &nodeagentconfigv1alpha1.FileExistsHealthCheck{Path: "/var/run/example"}
+				config.Remediations = []nodeagentconfigv1alpha1.HealthCheckRemediation{
+					{Type: nodeagentconfigv1alpha1.HealthCheckRemediationTaintNode, Taint: &taint},
+					{Type: nodeagentconfigv1alpha1.HealthCheckRemediationReplaceMachine},
+				}
+			})
+
+			It("should report a healthy condition if the file exists", func() {
+				Expect(fs.WriteFile("/var/run/example", nil, 0600)).To(Succeed())
+
+				check(newChecker())
+
+				Expect(node.Status.Conditions).To(ConsistOf(beCondition(conditionType, corev1.ConditionTrue, ConditionReasonHealthCheckSucceeded)))
+			})
+
+			It("should taint the node, request a machine replacement, and remove the taint once healthy again", func() {
+				checker := newChecker()
+
+				check(checker)
+				Expect(node.Status.Conditions).To(ConsistOf(beCondition(conditionType, corev1.ConditionFalse, ConditionReasonHealthCheckFailed)))
+				Expect(node.Spec.Taints).To(BeEmpty())
+
+				fakeClock.Step(time.Minute)
+				check(checker)
+				Expect(node.Spec.Taints).To(ConsistOf(taint))
+				Expect(node.Annotations).NotTo(HaveKey(AnnotationKeyTriggerDeletionByMCM))
+
+				fakeClock.Step(time.Minute)
+				check(checker)
+				Expect(node.Spec.Taints).To(ConsistOf(taint))
+				Expect(node.Annotations).To(HaveKeyWithValue(AnnotationKeyTriggerDeletionByMCM, "true"))
+
+				Expect(fs.WriteFile("/var/run/example", nil, 0600)).To(Succeed())
+				check(checker)
+				Expect(node.Status.Conditions).To(ConsistOf(beCondition(conditionType, corev1.ConditionTrue, ConditionReasonHealthCheckSucceeded)))
+				Expect(node.Spec.Taints).To(BeEmpty())
+				Expect(recorder.Events).To(Receive(ContainSubstring("failed")))
+			})
+		})
+	})
+})
+
+// beCondition returns a matcher for a Node condition with the given type, status and reason.
+func beCondition(conditionType corev1.NodeConditionType, status corev1.ConditionStatus, reason string) OmegaMatcher {
+	return And(
+		HaveField("Type", conditionType),
+		HaveField("Status", status),
+		HaveField("Reason", reason),
+	)
+}
+
+type fakeImagePruner struct {
+	calls int
+}
+
+func (f *fakeImagePruner) PruneImages(_ context.Context) error {
+	f.calls++
+	return nil
+}
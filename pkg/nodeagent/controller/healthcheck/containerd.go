@@ -10,6 +10,8 @@ import (
 	"time"
 
 	containerd "github.com/containerd/containerd/v2/client"
+	"github.com/containerd/containerd/v2/core/images"
+	"github.com/containerd/containerd/v2/pkg/namespaces"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
@@ -86,3 +88,63 @@ func (c *containerdHealthChecker) Check(ctx context.Context, node *corev1.Node)
 	}
 	return nil
 }
+
+const (
+	// containerdNamespaceCRI is the containerd namespace which contains the images and containers managed by the kubelet.
+	containerdNamespaceCRI = "k8s.io"
+	// imageLabelPinned is the label which marks images that must not be garbage collected, e.g. the sandbox image.
+	imageLabelPinned = "io.cri-containerd.pinned"
+)
+
+type containerdImagePruner struct {
+	client *containerd.Client
+}
+
+// NewContainerdImagePruner creates a new ImagePruner which removes all images from containerd which are neither used
+// by any container nor pinned.
+func NewContainerdImagePruner(client *containerd.Client) ImagePruner {
+	return &containerdImagePruner{client: client}
+}
+
+// PruneImages removes all images from containerd which are neither used by any container nor pinned.
+func (p *containerdImagePruner) PruneImages(ctx context.Context) error {
+	ctx = namespaces.WithNamespace(ctx, containerdNamespaceCRI)
+
+	imageList, err := p.client.ImageService().List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed listing images: %w", err)
+	}
+
+	containerList, err := p.client.ContainerService().List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed listing containers: %w", err)
+	}
+
+	imageNameToDigest := make(map[string]string, len(imageList))
+	for _, image := range imageList {
+		imageNameToDigest[image.Name] = image.Target.Digest.String()
+	}
+
+	// An image may be referenced by multiple names (tag, digest, ID), hence compare the digests of the image targets.
+	usedDigests := make(map[string]struct{}, len(containerList))
+	for _, container := range containerList {
+		if digest, ok := imageNameToDigest[container.Image]; ok {
+			usedDigests[digest] = struct{}{}
+		}
+	}
+
+	log := logf.FromContext(ctx)
+
+	for _, image := range imageList {
+		if _, ok := usedDigests[image.Target.Digest.String()]; ok || image.Labels[imageLabelPinned] == "pinned" {
+			continue
+		}
+
+		log.Info("Removing unused image", "image", image.Name)
+		if err := p.client.ImageService().Delete(ctx, image.Name, images.SynchronousDelete()); err != nil {
+			return fmt.Errorf("failed removing image %s: %w", image.Name, err)
+		}
+	}
+
+	return nil
+}
@@ -14,13 +14,16 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/nodeagent/dbus"
 	"github.com/gardener/gardener/pkg/utils/flow"
 )
 
-// Reconciler checks for containerd and kubelet health and restarts them if required.
+// Reconciler checks for containerd and kubelet health and restarts them if required. Additionally, it executes the
+// health checks defined in the configuration and remediates failures as configured.
 type Reconciler struct {
 	Client                     client.Client
+	Config                     nodeagentconfigv1alpha1.HealthCheckControllerConfig
 	Recorder                   record.EventRecorder
 	DBus                       dbus.DBus
 	HealthCheckers             []HealthChecker
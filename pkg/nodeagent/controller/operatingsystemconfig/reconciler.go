@@ -59,7 +59,7 @@ import (
 )
 
 const (
-	lastAppliedOperatingSystemConfigFilePath         = nodeagentconfigv1alpha1.BaseDir + "/last-applied-osc.yaml"
+	lastAppliedOperatingSystemConfigFilePath         = nodeagentconfigv1alpha1.LastAppliedOperatingSystemConfigFilePath
 	lastComputedOperatingSystemConfigChangesFilePath = nodeagentconfigv1alpha1.BaseDir + "/last-computed-osc-changes.yaml"
 
 	annotationUpdatingOperatingSystemVersion = "node-agent.gardener.cloud/updating-operating-system-version"
@@ -776,6 +776,21 @@ func isInPlaceKubeletUpdate(changes *operatingSystemConfigChanges) bool {
 }
 
 func (r *Reconciler) checkKubeletHealth(ctx context.Context, log logr.Logger, node *corev1.Node) error {
+	if err := WaitUntilKubeletHealthy(ctx, log); err != nil {
+		if patchErr := r.patchNodeUpdateFailed(ctx, log, node, fmt.Sprintf("kubelet is not healthy after in-place update: %s", err.Error())); patchErr != nil {
+			return patchErr
+		}
+
+		return fmt.Errorf("kubelet is not healthy after in-place update: %w", err)
+	}
+
+	log.Info("Kubelet is healthy after in-place update")
+	return nil
+}
+
+// WaitUntilKubeletHealthy polls the health endpoint of the kubelet running on this machine until it reports
+// healthiness or the KubeletHealthCheckRetryTimeout is exceeded.
+func WaitUntilKubeletHealthy(ctx context.Context, log logr.Logger) error {
 	httpClient := &http.Client{Timeout: 10 * time.Second}
 	request, err := http.NewRequestWithContext(ctx, http.MethodGet, healthcheckcontroller.DefaultKubeletHealthEndpoint, nil)
 	if err != nil {
@@ -783,24 +798,15 @@ func (r *Reconciler) checkKubeletHealth(ctx context.Context, log logr.Logger, no
 		return err
 	}
 
-	if err := retryutils.UntilTimeout(ctx, KubeletHealthCheckRetryInterval, KubeletHealthCheckRetryTimeout, func(_ context.Context) (bool, error) {
+	return retryutils.UntilTimeout(ctx, KubeletHealthCheckRetryInterval, KubeletHealthCheckRetryTimeout, func(_ context.Context) (bool, error) {
 		if response, err2 := httpClient.Do(request); err2 != nil {
 			return retryutils.MinorError(fmt.Errorf("HTTP request to kubelet health endpoint failed: %w", err2))
 		} else if response.StatusCode == http.StatusOK {
-			log.Info("Kubelet is healthy after in-place update")
 			return retryutils.Ok()
 		}
 
 		return retryutils.NotOk()
-	}); err != nil {
-		if patchErr := r.patchNodeUpdateFailed(ctx, log, node, fmt.Sprintf("kubelet is not healthy after in-place update: %s", err.Error())); patchErr != nil {
-			return patchErr
-		}
-
-		return fmt.Errorf("kubelet is not healthy after in-place update: %w", err)
-	}
-
-	return nil
+	})
 }
 
 func (r *Reconciler) completeKubeletInPlaceUpdate(ctx context.Context, log logr.Logger, changes *operatingSystemConfigChanges, node *corev1.Node) error {
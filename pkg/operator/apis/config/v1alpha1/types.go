@@ -34,6 +34,9 @@ type OperatorConfiguration struct {
 	// Debugging holds configuration for Debugging related features.
 	// +optional
 	Debugging *componentbaseconfigv1alpha1.DebuggingConfiguration `json:"debugging,omitempty"`
+	// Tracing contains configuration for exporting traces, e.g., of the reconciliation flows.
+	// +optional
+	Tracing *TracingConfiguration `json:"tracing,omitempty"`
 	// FeatureGates is a map of feature names to bools that enable or disable alpha/experimental features. This field
 	// modifies piecemeal the built-in default values from "github.com/gardener/gardener/pkg/operator/features/features.go".
 	// Default: nil
@@ -173,6 +176,15 @@ type ServerConfiguration struct {
 	Metrics *Server `json:"metrics,omitempty"`
 }
 
+// TracingConfiguration contains configuration for exporting traces via OTLP.
+type TracingConfiguration struct {
+	// Endpoint is the address of the OTLP/gRPC endpoint to which the spans are exported, e.g. `otel-collector:4317`.
+	Endpoint string `json:"endpoint"`
+	// Insecure disables TLS for the connection to the endpoint.
+	// +optional
+	Insecure *bool `json:"insecure,omitempty"`
+}
+
 // Server contains information for HTTP(S) server configuration.
 type Server struct {
 	// BindAddress is the IP address on which to listen for the specified port.
@@ -35,6 +35,10 @@ func ValidateOperatorConfiguration(conf *operatorconfigv1alpha1.OperatorConfigur
 		allErrs = append(allErrs, field.NotSupported(field.NewPath("logFormat"), conf.LogFormat, logger.AllLogFormats))
 	}
 
+	if conf.Tracing != nil && conf.Tracing.Endpoint == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("tracing", "endpoint"), "must provide an endpoint when tracing is configured"))
+	}
+
 	allErrs = append(allErrs, validateControllerConfiguration(conf.Controllers, field.NewPath("controllers"))...)
 	allErrs = append(allErrs, validateNodeTolerationConfiguration(conf.NodeToleration, field.NewPath("nodeToleration"))...)
 
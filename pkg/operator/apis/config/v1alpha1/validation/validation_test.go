@@ -156,6 +156,25 @@ var _ = Describe("#ValidateOperatorConfiguration", func() {
 		),
 	)
 
+	Context("tracing configuration", func() {
+		It("should allow tracing configuration with an endpoint", func() {
+			conf.Tracing = &operatorconfigv1alpha1.TracingConfiguration{Endpoint: "otel-collector:4317"}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should reject tracing configuration without an endpoint", func() {
+			conf.Tracing = &operatorconfigv1alpha1.TracingConfiguration{}
+
+			Expect(ValidateOperatorConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("tracing.endpoint"),
+				})),
+			))
+		})
+	})
+
 	Context("controller configuration", func() {
 		Context("garden", func() {
 			It("should return errors because concurrent syncs are <= 0", func() {
@@ -5,6 +5,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
@@ -37,6 +38,74 @@ const (
 // Strategies defines all currently implemented SeedCandidateDeterminationStrategies
 var Strategies = []CandidateDeterminationStrategy{SameRegion, MinimalDistance}
 
+const (
+	// FilterUsableSeeds filters seeds which are not being deleted, visible for scheduling and ready.
+	FilterUsableSeeds = "UsableSeeds"
+	// FilterCloudProfileSeedSelector filters seeds matching the seed selector of the shoot's cloud profile.
+	FilterCloudProfileSeedSelector = "CloudProfileSeedSelector"
+	// FilterShootSeedSelector filters seeds matching the seed selector of the shoot.
+	FilterShootSeedSelector = "ShootSeedSelector"
+	// FilterProvider filters seeds with a provider type supported by the shoot and its cloud profile.
+	FilterProvider = "Provider"
+	// FilterZonalControlPlane filters seeds with at least three zones for shoots with a multi-zonal control plane.
+	FilterZonalControlPlane = "ZonalControlPlane"
+	// FilterAccessRestrictions filters seeds supporting the access restrictions of the shoot.
+	FilterAccessRestrictions = "AccessRestrictions"
+	// FilterDomain filters seeds supporting the domain of the shoot.
+	FilterDomain = "Domain"
+	// FilterShootReconciliationsEnabled filters seeds which have shoot reconciliations enabled.
+	FilterShootReconciliationsEnabled = "ShootReconciliationsEnabled"
+	// FilterNetworksTaintsAndCapacity filters seeds with networks disjoint to the shoot's networks, taints tolerated by
+	// the shoot, and capacity for another shoot.
+	FilterNetworksTaintsAndCapacity = "NetworksTaintsAndCapacity"
+	// FilterResourceUtilization filters seeds whose resource utilization exceeds the configured thresholds.
+	FilterResourceUtilization = "ResourceUtilization"
+	// FilterStrategy filters seeds according to the configured CandidateDeterminationStrategy.
+	FilterStrategy = "Strategy"
+
+	// ScorerShootCount prefers seeds with fewer shoots.
+	ScorerShootCount = "ShootCount"
+	// ScorerAllocatableCapacity prefers seeds with the highest share of unused allocatable shoot capacity.
+	ScorerAllocatableCapacity = "AllocatableCapacity"
+	// ScorerLabelAffinity prefers seeds matching the configured label selector.
+	ScorerLabelAffinity = "LabelAffinity"
+	// ScorerResourceHeadroom prefers seeds with the most unrequested node resources.
+	ScorerResourceHeadroom = "ResourceHeadroom"
+)
+
+// DefaultFilters is the ordered list of filter plugins which are applied if no filters are configured.
+var DefaultFilters = []string{
+	FilterUsableSeeds,
+	FilterCloudProfileSeedSelector,
+	FilterShootSeedSelector,
+	FilterProvider,
+	FilterZonalControlPlane,
+	FilterAccessRestrictions,
+	FilterDomain,
+	FilterShootReconciliationsEnabled,
+	FilterNetworksTaintsAndCapacity,
+	FilterResourceUtilization,
+	FilterStrategy,
+}
+
+// MandatoryFilters is the list of filter plugins which are always applied, regardless of the configured filters, to
+// prevent shoots from being scheduled onto seeds which are not eligible for them.
+var MandatoryFilters = []string{
+	FilterUsableSeeds,
+	FilterCloudProfileSeedSelector,
+	FilterShootSeedSelector,
+	FilterProvider,
+	FilterZonalControlPlane,
+	FilterAccessRestrictions,
+	FilterDomain,
+	FilterShootReconciliationsEnabled,
+	FilterNetworksTaintsAndCapacity,
+	FilterStrategy,
+}
+
+// Scorers defines all currently implemented score plugins.
+var Scorers = []string{ScorerShootCount, ScorerAllocatableCapacity, ScorerLabelAffinity, ScorerResourceHeadroom}
+
 // CandidateDeterminationStrategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 type CandidateDeterminationStrategy string
 
@@ -98,6 +167,44 @@ type ShootSchedulerConfiguration struct {
 	ConcurrentSyncs int `json:"concurrentSyncs"`
 	// Strategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 	Strategy CandidateDeterminationStrategy `json:"candidateDeterminationStrategy"`
+	// Filters is the ordered list of filter plugins which are applied to determine the seed candidates for a shoot.
+	// If empty, all built-in filter plugins are applied in the order of DefaultFilters. Otherwise, it must contain all
+	// MandatoryFilters.
+	// +optional
+	Filters []string `json:"filters,omitempty"`
+	// Scorers is the list of weighted score plugins which are used to rank the seed candidates. The candidate with the
+	// highest total score is chosen, ties are broken by choosing the seed with the least shoots.
+	// +optional
+	Scorers []ScorerConfiguration `json:"scorers,omitempty"`
+	// ResourceUtilization configures the capacity-aware scheduling based on the resource usage published by gardenlet
+	// in the Seed status.
+	// +optional
+	ResourceUtilization *ResourceUtilizationConfiguration `json:"resourceUtilization,omitempty"`
+	// EnableDryRunEndpoint enables the endpoint on the metrics server which determines the seed a given Shoot manifest
+	// would be scheduled to, without binding it. Requests to the endpoint must be authenticated and authorized by the
+	// garden cluster. Defaults to false.
+	// +optional
+	EnableDryRunEndpoint *bool `json:"enableDryRunEndpoint,omitempty"`
+}
+
+// ResourceUtilizationConfiguration contains the configuration for the capacity-aware scheduling.
+type ResourceUtilizationConfiguration struct {
+	// Thresholds maps resource names (cpu, memory) to the maximum utilization in percent, i.e., the share of the seed
+	// nodes' allocatable resources requested by the shoot control planes. Seeds exceeding any of the thresholds are
+	// not considered for scheduling. Seeds which do not publish their resource usage are not filtered.
+	// +optional
+	Thresholds map[corev1.ResourceName]int32 `json:"thresholds,omitempty"`
+}
+
+// ScorerConfiguration contains the configuration of a score plugin.
+type ScorerConfiguration struct {
+	// Name is the name of the score plugin.
+	Name string `json:"name"`
+	// Weight is multiplied with the score (between 0 and 100) of the plugin before it is added to the total score.
+	Weight int32 `json:"weight"`
+	// LabelSelector is used by the LabelAffinity plugin. Seeds matching it get the maximum score.
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
 }
 
 // ServerConfiguration contains details for the HTTP(S) servers.
@@ -5,7 +5,12 @@
 package validation
 
 import (
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
@@ -49,8 +54,22 @@ func validateSchedulerControllerConfiguration(schedulers schedulerconfigv1alpha1
 	}
 
 	if schedulers.Shoot != nil {
-		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(schedulers.Shoot.ConcurrentSyncs), fldPath.Child("shoot", "concurrentSyncs"))...)
-		allErrs = append(allErrs, validateStrategy(schedulers.Shoot.Strategy, fldPath.Child("shoot", "strategy"))...)
+		allErrs = append(allErrs, ValidateShootSchedulerConfiguration(schedulers.Shoot, fldPath.Child("shoot"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateShootSchedulerConfiguration validates the shoot scheduler configuration.
+func ValidateShootSchedulerConfiguration(conf *schedulerconfigv1alpha1.ShootSchedulerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(conf.ConcurrentSyncs), fldPath.Child("concurrentSyncs"))...)
+	allErrs = append(allErrs, validateStrategy(conf.Strategy, fldPath.Child("strategy"))...)
+	allErrs = append(allErrs, validateFilters(conf.Filters, fldPath.Child("filters"))...)
+	allErrs = append(allErrs, validateScorers(conf.Scorers, fldPath.Child("scorers"))...)
+	if conf.ResourceUtilization != nil {
+		allErrs = append(allErrs, ValidateResourceUtilizationThresholds(conf.ResourceUtilization.Thresholds, fldPath.Child("resourceUtilization", "thresholds"))...)
 	}
 
 	return allErrs
@@ -74,3 +93,80 @@ func validateStrategy(strategy schedulerconfigv1alpha1.CandidateDeterminationStr
 
 	return allErrs
 }
+
+func validateFilters(filters []string, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs          = field.ErrorList{}
+		supportedFilters = sets.New(schedulerconfigv1alpha1.DefaultFilters...)
+		names            = sets.New[string]()
+	)
+
+	for i, name := range filters {
+		idxPath := fldPath.Index(i)
+
+		if !supportedFilters.Has(name) {
+			allErrs = append(allErrs, field.NotSupported(idxPath, name, schedulerconfigv1alpha1.DefaultFilters))
+		}
+		if names.Has(name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, name))
+		}
+		names.Insert(name)
+	}
+
+	if len(filters) > 0 {
+		for _, name := range schedulerconfigv1alpha1.MandatoryFilters {
+			if !names.Has(name) {
+				allErrs = append(allErrs, field.Required(fldPath, fmt.Sprintf("must contain mandatory filter %q", name)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateScorers(scorers []schedulerconfigv1alpha1.ScorerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, scorer := range scorers {
+		idxPath := fldPath.Index(i)
+
+		if !slices.Contains(schedulerconfigv1alpha1.Scorers, scorer.Name) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("name"), scorer.Name, schedulerconfigv1alpha1.Scorers))
+		}
+		if scorer.Weight <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("weight"), scorer.Weight, "must be greater than 0"))
+		}
+
+		if scorer.Name == schedulerconfigv1alpha1.ScorerLabelAffinity {
+			if scorer.LabelSelector == nil {
+				allErrs = append(allErrs, field.Required(idxPath.Child("labelSelector"), "must provide a label selector for the LabelAffinity scorer"))
+			} else {
+				allErrs = append(allErrs, metav1validation.ValidateLabelSelector(scorer.LabelSelector, metav1validation.LabelSelectorValidationOptions{}, idxPath.Child("labelSelector"))...)
+			}
+		} else if scorer.LabelSelector != nil {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("labelSelector"), "label selector is only supported for the LabelAffinity scorer"))
+		}
+	}
+
+	return allErrs
+}
+
+var supportedResourceUtilizationResources = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}
+
+// ValidateResourceUtilizationThresholds validates the given resource utilization thresholds.
+func ValidateResourceUtilizationThresholds(thresholds map[corev1.ResourceName]int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for resourceName, threshold := range thresholds {
+		idxPath := fldPath.Key(string(resourceName))
+
+		if !slices.Contains(supportedResourceUtilizationResources, resourceName) {
+			allErrs = append(allErrs, field.NotSupported(idxPath, resourceName, supportedResourceUtilizationResources))
+		}
+		if threshold <= 0 || threshold > 100 {
+			allErrs = append(allErrs, field.Invalid(idxPath, threshold, "must be in the range [1, 100]"))
+		}
+	}
+
+	return allErrs
+}
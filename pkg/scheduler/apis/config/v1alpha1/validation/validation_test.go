@@ -5,9 +5,13 @@
 package validation
 
 import (
+	"fmt"
+	"slices"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
@@ -138,6 +142,98 @@ var _ = Describe("#ValidateConfiguration", func() {
 			}))))
 		})
 
+		It("should pass because the configured filters and scorers are valid", func() {
+			conf.Schedulers.Shoot.Filters = append([]string{schedulerconfigv1alpha1.FilterResourceUtilization}, schedulerconfigv1alpha1.MandatoryFilters...)
+			conf.Schedulers.Shoot.Scorers = []schedulerconfigv1alpha1.ScorerConfiguration{
+				{Name: schedulerconfigv1alpha1.ScorerShootCount, Weight: 1},
+				{Name: schedulerconfigv1alpha1.ScorerLabelAffinity, Weight: 2, LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}},
+			}
+
+			Expect(ValidateConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail because the filters are unknown or duplicated", func() {
+			conf.Schedulers.Shoot.Filters = append(slices.Clone(schedulerconfigv1alpha1.MandatoryFilters), "foo", schedulerconfigv1alpha1.FilterUsableSeeds)
+
+			Expect(ValidateConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal(fmt.Sprintf("schedulers.shoot.filters[%d]", len(schedulerconfigv1alpha1.MandatoryFilters))),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal(fmt.Sprintf("schedulers.shoot.filters[%d]", len(schedulerconfigv1alpha1.MandatoryFilters)+1)),
+				})),
+			))
+		})
+
+		It("should fail because mandatory filters are missing", func() {
+			conf.Schedulers.Shoot.Filters = slices.DeleteFunc(slices.Clone(schedulerconfigv1alpha1.DefaultFilters), func(name string) bool {
+				return name == schedulerconfigv1alpha1.FilterUsableSeeds || name == schedulerconfigv1alpha1.FilterNetworksTaintsAndCapacity
+			})
+
+			Expect(ValidateConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeRequired),
+					"Field":  Equal("schedulers.shoot.filters"),
+					"Detail": Equal(`must contain mandatory filter "UsableSeeds"`),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeRequired),
+					"Field":  Equal("schedulers.shoot.filters"),
+					"Detail": Equal(`must contain mandatory filter "NetworksTaintsAndCapacity"`),
+				})),
+			))
+		})
+
+		It("should fail because the scorers are invalid", func() {
+			conf.Schedulers.Shoot.Scorers = []schedulerconfigv1alpha1.ScorerConfiguration{
+				{Name: "foo", Weight: 1},
+				{Name: schedulerconfigv1alpha1.ScorerShootCount, Weight: 0, LabelSelector: &metav1.LabelSelector{}},
+				{Name: schedulerconfigv1alpha1.ScorerLabelAffinity, Weight: 1},
+			}
+
+			Expect(ValidateConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("schedulers.shoot.scorers[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("schedulers.shoot.scorers[1].weight"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("schedulers.shoot.scorers[1].labelSelector"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("schedulers.shoot.scorers[2].labelSelector"),
+				})),
+			))
+		})
+
+		It("should fail because the resource utilization thresholds are invalid", func() {
+			conf.Schedulers.Shoot.ResourceUtilization = &schedulerconfigv1alpha1.ResourceUtilizationConfiguration{
+				Thresholds: map[corev1.ResourceName]int32{
+					corev1.ResourceCPU:     80,
+					corev1.ResourceMemory:  101,
+					corev1.ResourceStorage: 50,
+				},
+			}
+
+			Expect(ValidateConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("schedulers.shoot.resourceUtilization.thresholds[memory]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("schedulers.shoot.resourceUtilization.thresholds[storage]"),
+				})),
+			))
+		})
+
 		It("should fail because backupBucket concurrentSyncs are negative", func() {
 			invalidConfiguration := conf.DeepCopy()
 			invalidConfiguration.Schedulers.BackupBucket.ConcurrentSyncs = -1
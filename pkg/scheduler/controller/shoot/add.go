@@ -5,6 +5,8 @@
 package shoot
 
 import (
+	"fmt"
+
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -12,6 +14,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -36,6 +39,25 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 		r.GardenNamespace = v1beta1constants.GardenNamespace
 	}
 
+	if ptr.Deref(r.Config.EnableDryRunEndpoint, false) {
+		// The metrics server does not authenticate requests, hence the dry-run handler requires the callers to be
+		// authenticated and authorized by the garden cluster (via TokenReviews and SubjectAccessReviews).
+		filter, err := filters.WithAuthenticationAndAuthorization(mgr.GetConfig(), mgr.GetHTTPClient())
+		if err != nil {
+			return fmt.Errorf("failed creating authentication and authorization filter for dry-run handler: %w", err)
+		}
+
+		log := mgr.GetLogger().WithName("controller").WithName(ControllerName).WithName("dry-run")
+		handler, err := filter(log, r.NewDryRunHandler(log))
+		if err != nil {
+			return fmt.Errorf("failed wrapping dry-run handler: %w", err)
+		}
+
+		if err := mgr.AddMetricsServerExtraHandler(DryRunHandlerPath, handler); err != nil {
+			return err
+		}
+	}
+
 	return builder.
 		ControllerManagedBy(mgr).
 		Named(ControllerName).
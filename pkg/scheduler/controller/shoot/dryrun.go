@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/yaml"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+// DryRunHandlerPath is the path on which the dry-run handler is served.
+const DryRunHandlerPath = "/shoots/schedule"
+
+// maxDryRunRequestBytes is the maximum size of a Shoot manifest accepted by the dry-run handler.
+const maxDryRunRequestBytes = 1 << 20
+
+// DryRunResult is the result of a scheduling dry-run for a shoot.
+type DryRunResult struct {
+	// Seed is the name of the seed the shoot would be scheduled to. It is empty if no seed is eligible.
+	Seed string `json:"seed,omitempty"`
+	// Candidates are the names of the seeds which passed all filters.
+	Candidates []string `json:"candidates"`
+	// Rejections maps the names of the seeds which did not pass all filters to the reason.
+	Rejections map[string]string `json:"rejections,omitempty"`
+	// Scores maps the names of the seed candidates to their weighted total score if score plugins are configured.
+	Scores map[string]int64 `json:"scores,omitempty"`
+	// Error is the reason why the shoot cannot be scheduled.
+	Error string `json:"error,omitempty"`
+}
+
+// DryRun determines the seed which the given shoot would be scheduled to, without binding it. An error is only
+// returned if the scheduling information could not be read, the scheduling failure itself is part of the result.
+func (r *Reconciler) DryRun(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (*DryRunResult, error) {
+	framework, state, seeds, err := r.prepareScheduling(ctx, log, shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	schedulingResult, err := framework.DryRun(state, seeds)
+	result := &DryRunResult{
+		Candidates: schedulingResult.Candidates,
+		Rejections: schedulingResult.Rejections,
+		Scores:     schedulingResult.Scores,
+	}
+	if result.Candidates == nil {
+		result.Candidates = []string{}
+	}
+
+	if err != nil {
+		result.Error = err.Error()
+		return result, nil
+	}
+
+	result.Seed = schedulingResult.Seed.Name
+	return result, nil
+}
+
+// NewDryRunHandler returns an HTTP handler which accepts a Shoot manifest (JSON or YAML) via POST and responds with
+// the DryRunResult for it.
+func (r *Reconciler) NewDryRunHandler(log logr.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, err := io.ReadAll(io.LimitReader(req.Body, maxDryRunRequestBytes))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed reading request body: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		shoot := &gardencorev1beta1.Shoot{}
+		if err := yaml.Unmarshal(body, shoot); err != nil {
+			http.Error(w, fmt.Sprintf("failed decoding shoot: %v", err), http.StatusBadRequest)
+			return
+		}
+		if shoot.Namespace == "" {
+			http.Error(w, "shoot must specify metadata.namespace", http.StatusBadRequest)
+			return
+		}
+		kubernetes.GardenScheme.Default(shoot)
+
+		result, err := r.DryRun(req.Context(), log.WithValues("shoot", shoot.Namespace+"/"+shoot.Name), shoot)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed determining seed: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(result); err != nil {
+			log.Error(err, "Failed writing dry-run response")
+		}
+	})
+}
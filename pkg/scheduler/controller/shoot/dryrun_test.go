@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+)
+
+var _ = Describe("DryRun", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		reconciler *Reconciler
+		handler    http.Handler
+
+		newSeed = func(name, region string) *gardencorev1beta1.Seed {
+			return &gardencorev1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: gardencorev1beta1.SeedSpec{
+					Provider: gardencorev1beta1.SeedProvider{Type: "foo", Region: region},
+					Networks: gardencorev1beta1.SeedNetworks{Pods: "10.20.0.0/16", Services: "10.30.0.0/16"},
+					Settings: &gardencorev1beta1.SeedSettings{Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: true}},
+				},
+				Status: gardencorev1beta1.SeedStatus{
+					Conditions:    []gardencorev1beta1.Condition{{Type: gardencorev1beta1.GardenletReady, Status: gardencorev1beta1.ConditionTrue}},
+					LastOperation: &gardencorev1beta1.LastOperation{},
+				},
+			}
+		}
+
+		shootManifest = `apiVersion: core.gardener.cloud/v1beta1
+kind: Shoot
+metadata:
+  name: shoot
+  namespace: garden-dev
+spec:
+  cloudProfileName: cloudprofile
+  region: europe
+  provider:
+    type: foo
+    workers:
+    - name: worker
+  networking:
+    pods: 10.50.0.0/16
+    services: 10.60.0.0/16
+`
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithIndex(&gardencorev1beta1.Project{}, gardencore.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+			Build()
+
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: &schedulerconfigv1alpha1.ShootSchedulerConfiguration{Strategy: schedulerconfigv1alpha1.SameRegion},
+		}
+		handler = reconciler.NewDryRunHandler(logr.Discard())
+
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "cloudprofile"}})).To(Succeed())
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.Project{ObjectMeta: metav1.ObjectMeta{Name: "dev"}, Spec: gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-dev")}})).To(Succeed())
+	})
+
+	serve := func(method, body string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(method, DryRunHandlerPath, strings.NewReader(body)))
+		return recorder
+	}
+
+	It("should return the chosen seed, the candidates and the rejections", func() {
+		Expect(fakeClient.Create(ctx, newSeed("seed-1", "europe"))).To(Succeed())
+		Expect(fakeClient.Create(ctx, newSeed("seed-2", "asia"))).To(Succeed())
+		seed3 := newSeed("seed-3", "europe")
+		seed3.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: "foo"}}
+		Expect(fakeClient.Create(ctx, seed3)).To(Succeed())
+
+		response := serve(http.MethodPost, shootManifest)
+		Expect(response.Code).To(Equal(http.StatusOK))
+
+		result := &DryRunResult{}
+		Expect(json.Unmarshal(response.Body.Bytes(), result)).To(Succeed())
+		Expect(result.Seed).To(Equal("seed-1"))
+		Expect(result.Candidates).To(ConsistOf("seed-1"))
+		Expect(result.Rejections).To(HaveLen(2))
+		Expect(result.Rejections["seed-2"]).To(HavePrefix("Strategy: "))
+		Expect(result.Rejections["seed-3"]).To(And(HavePrefix("NetworksTaintsAndCapacity: "), ContainSubstring("shoot does not tolerate the seed's taints")))
+		Expect(result.Error).To(BeEmpty())
+	})
+
+	It("should return the rejections and the error if no seed is eligible", func() {
+		Expect(fakeClient.Create(ctx, newSeed("seed-2", "asia"))).To(Succeed())
+
+		response := serve(http.MethodPost, shootManifest)
+		Expect(response.Code).To(Equal(http.StatusOK))
+
+		result := &DryRunResult{}
+		Expect(json.Unmarshal(response.Body.Bytes(), result)).To(Succeed())
+		Expect(result.Seed).To(BeEmpty())
+		Expect(result.Candidates).To(BeEmpty())
+		Expect(result.Rejections).To(HaveKey("seed-2"))
+		Expect(result.Error).To(ContainSubstring("no matching seed candidate found"))
+	})
+
+	It("should reject invalid requests", func() {
+		Expect(serve(http.MethodGet, "").Code).To(Equal(http.StatusMethodNotAllowed))
+		Expect(serve(http.MethodPost, "{").Code).To(Equal(http.StatusBadRequest))
+		Expect(serve(http.MethodPost, "metadata:\n  name: shoot\n").Code).To(Equal(http.StatusBadRequest))
+	})
+})
@@ -0,0 +1,318 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+)
+
+// MaxScore is the maximum score a ScorePlugin may return for a seed.
+const MaxScore int64 = 100
+
+// SchedulingState contains the information about the shoot to be scheduled which is shared by all plugins.
+type SchedulingState struct {
+	Log          logr.Logger
+	Shoot        *gardencorev1beta1.Shoot
+	ShootList    []*gardencorev1beta1.Shoot
+	CloudProfile *gardencorev1beta1.CloudProfile
+	Project      *gardencorev1beta1.Project
+	RegionConfig *corev1.ConfigMap
+	Strategy     schedulerconfigv1alpha1.CandidateDeterminationStrategy
+	// SeedUsage maps seed names to the number of shoots scheduled to them.
+	SeedUsage map[string]int
+	// ResourceUtilizationThresholds maps resource names to the maximum utilization of a seed in percent.
+	ResourceUtilizationThresholds map[corev1.ResourceName]int32
+}
+
+// FilterPlugin removes seeds which are not eligible for the shoot. It returns an error if no seed remains.
+type FilterPlugin interface {
+	// Name returns the name of the plugin.
+	Name() string
+	// Filter returns the seeds which are eligible for the shoot.
+	Filter(state *SchedulingState, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error)
+}
+
+// ScorePlugin ranks the seed candidates for the shoot.
+type ScorePlugin interface {
+	// Name returns the name of the plugin.
+	Name() string
+	// Score returns a score between 0 and MaxScore for each of the given seeds, keyed by seed name.
+	Score(state *SchedulingState, seeds []gardencorev1beta1.Seed) (map[string]int64, error)
+}
+
+// SchedulingResult is the result of a scheduling decision.
+type SchedulingResult struct {
+	// Seed is the chosen seed.
+	Seed *gardencorev1beta1.Seed
+	// Scores maps the names of the seed candidates to their weighted total score. It is nil if no score plugins are
+	// configured.
+	Scores map[string]int64
+	// Candidates are the names of the seeds which passed all filter plugins. It is only set by DryRun.
+	Candidates []string
+	// Rejections maps the names of the seeds which did not pass a filter plugin to the reason. It is only set by DryRun.
+	Rejections map[string]string
+}
+
+type filterFunc func(state *SchedulingState, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error)
+
+type filterPlugin struct {
+	name string
+	fn   filterFunc
+}
+
+func (f *filterPlugin) Name() string { return f.name }
+
+func (f *filterPlugin) Filter(state *SchedulingState, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+	return f.fn(state, seeds)
+}
+
+var filterFuncs = map[string]filterFunc{
+	schedulerconfigv1alpha1.FilterUsableSeeds: func(_ *SchedulingState, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+		return filterUsableSeeds(seeds)
+	},
+	schedulerconfigv1alpha1.FilterCloudProfileSeedSelector: func(state *SchedulingState, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+		return filterSeedsMatchingLabelSelector(seeds, state.CloudProfile.Spec.SeedSelector, "CloudProfile")
+	},
+	schedulerconfigv1alpha1.FilterShootSeedSelector: func(state *SchedulingState, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+		return filterSeedsMatchingLabelSelector(seeds, state.Shoot.Spec.SeedSelector, "Shoot")
+	},
+	schedulerconfigv1alpha1.FilterProvider: func(state *SchedulingState, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+		return filterSeedsMatchingProviders(state.CloudProfile, state.Shoot, seeds)
+	},
+	schedulerconfigv1alpha1.FilterZonalControlPlane: func(state *SchedulingState, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+		return filterSeedsForZonalShootControlPlanes(seeds, state.Shoot)
+	},
+	schedulerconfigv1alpha1.FilterAccessRestrictions: func(state *SchedulingState, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+		return filterSeedsForAccessRestrictions(seeds, state.Shoot)
+	},
+	schedulerconfigv1alpha1.FilterDomain: func(state *SchedulingState, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+		return filterSeedsMatchingDomain(seeds, state.Shoot, state.Project.Name)
+	},
+	schedulerconfigv1alpha1.FilterShootReconciliationsEnabled: func(_ *SchedulingState, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+		return filterSeedsWithDisabledShootReconciliations(seeds)
+	},
+	schedulerconfigv1alpha1.FilterNetworksTaintsAndCapacity: func(state *SchedulingState, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+		return filterCandidates(state.Shoot, state.ShootList, seeds)
+	},
+	schedulerconfigv1alpha1.FilterResourceUtilization: func(state *SchedulingState, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+		return filterSeedsExceedingResourceUtilization(seeds, state.ResourceUtilizationThresholds)
+	},
+	schedulerconfigv1alpha1.FilterStrategy: func(state *SchedulingState, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+		return applyStrategy(state.Log, state.Shoot, seeds, state.Strategy, state.RegionConfig)
+	},
+}
+
+// NewFilterPlugin returns the built-in FilterPlugin with the given name.
+func NewFilterPlugin(name string) (FilterPlugin, error) {
+	fn, ok := filterFuncs[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown filter plugin %q", name)
+	}
+	return &filterPlugin{name: name, fn: fn}, nil
+}
+
+type weightedScorePlugin struct {
+	ScorePlugin
+	weight int64
+}
+
+// Framework determines the seed for a shoot by applying an ordered list of FilterPlugins followed by a weighted list
+// of ScorePlugins.
+type Framework struct {
+	filters []FilterPlugin
+	scorers []weightedScorePlugin
+}
+
+// NewFramework returns a new Framework for the given configuration. If no filters are configured, the
+// DefaultFilters are used. The MandatoryFilters are always applied, mandatory filters missing in the configuration are
+// applied before the configured ones.
+func NewFramework(config *schedulerconfigv1alpha1.ShootSchedulerConfiguration) (*Framework, error) {
+	filterNames := schedulerconfigv1alpha1.DefaultFilters
+	if config != nil && len(config.Filters) > 0 {
+		filterNames = withMandatoryFilters(config.Filters)
+	}
+
+	var scorers []schedulerconfigv1alpha1.ScorerConfiguration
+	if config != nil {
+		scorers = config.Scorers
+	}
+
+	return newFramework(filterNames, scorers)
+}
+
+func withMandatoryFilters(filterNames []string) []string {
+	var missing []string
+	for _, name := range schedulerconfigv1alpha1.MandatoryFilters {
+		if !slices.Contains(filterNames, name) {
+			missing = append(missing, name)
+		}
+	}
+	return append(missing, filterNames...)
+}
+
+func newFramework(filterNames []string, scorers []schedulerconfigv1alpha1.ScorerConfiguration) (*Framework, error) {
+	framework := &Framework{}
+
+	for _, name := range filterNames {
+		plugin, err := NewFilterPlugin(name)
+		if err != nil {
+			return nil, err
+		}
+		framework.filters = append(framework.filters, plugin)
+	}
+
+	for _, scorerConfig := range scorers {
+		plugin, err := NewScorePlugin(scorerConfig)
+		if err != nil {
+			return nil, err
+		}
+		framework.scorers = append(framework.scorers, weightedScorePlugin{ScorePlugin: plugin, weight: int64(scorerConfig.Weight)})
+	}
+
+	return framework, nil
+}
+
+// Schedule determines the seed for the shoot in the given state out of the given seeds. The seed with the highest
+// total score wins, ties are broken by choosing the seed with the least shoots.
+func (f *Framework) Schedule(state *SchedulingState, seeds []gardencorev1beta1.Seed) (*SchedulingResult, error) {
+	candidates, err := f.filter(state, seeds, nil)
+	if err != nil {
+		return nil, err
+	}
+	return f.score(state, candidates)
+}
+
+// DryRun determines the seed for the shoot like Schedule, but additionally records the seed candidates and the reasons
+// why the other seeds were rejected. If no seed is eligible, the returned result contains the rejections along with
+// the error.
+func (f *Framework) DryRun(state *SchedulingState, seeds []gardencorev1beta1.Seed) (*SchedulingResult, error) {
+	rejections := make(map[string]string)
+
+	candidates, err := f.filter(state, seeds, rejections)
+	if err != nil {
+		return &SchedulingResult{Rejections: rejections}, err
+	}
+
+	result, err := f.score(state, candidates)
+	if err != nil {
+		return &SchedulingResult{Rejections: rejections}, err
+	}
+
+	for _, seed := range candidates {
+		result.Candidates = append(result.Candidates, seed.Name)
+	}
+	result.Rejections = rejections
+	return result, nil
+}
+
+func (f *Framework) filter(state *SchedulingState, seeds []gardencorev1beta1.Seed, rejections map[string]string) ([]gardencorev1beta1.Seed, error) {
+	candidates := seeds
+
+	for _, filter := range f.filters {
+		filtered, err := filter.Filter(state, candidates)
+
+		if rejections != nil {
+			remaining := make(map[string]struct{}, len(filtered))
+			for _, seed := range filtered {
+				remaining[seed.Name] = struct{}{}
+			}
+
+			for _, seed := range candidates {
+				if _, ok := remaining[seed.Name]; !ok {
+					rejections[seed.Name] = rejectionReason(filter, state, seed)
+				}
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+		candidates = filtered
+	}
+
+	return candidates, nil
+}
+
+// rejectionReason determines why the given seed was rejected by the filter plugin by applying it to this seed only.
+// Filters which select the best seeds among all candidates (e.g., the MinimalDistance strategy) might accept a single
+// seed, though.
+func rejectionReason(filter FilterPlugin, state *SchedulingState, seed gardencorev1beta1.Seed) string {
+	if _, err := filter.Filter(state, []gardencorev1beta1.Seed{seed}); err != nil {
+		return fmt.Sprintf("%s: %s", filter.Name(), err.Error())
+	}
+	return fmt.Sprintf("%s: other seed candidates are preferred", filter.Name())
+}
+
+func (f *Framework) score(state *SchedulingState, candidates []gardencorev1beta1.Seed) (*SchedulingResult, error) {
+	if len(f.scorers) == 0 {
+		seed, err := getSeedWithLeastShootsDeployed(candidates, state.ShootList)
+		if err != nil {
+			return nil, err
+		}
+		return &SchedulingResult{Seed: seed}, nil
+	}
+
+	totalScores := make(map[string]int64, len(candidates))
+	for _, scorer := range f.scorers {
+		scores, err := scorer.Score(state, candidates)
+		if err != nil {
+			return nil, fmt.Errorf("failed running score plugin %q: %w", scorer.Name(), err)
+		}
+
+		for _, seed := range candidates {
+			totalScores[seed.Name] += scorer.weight * min(max(scores[seed.Name], 0), MaxScore)
+		}
+	}
+
+	var (
+		bestCandidates []gardencorev1beta1.Seed
+		bestScore      int64
+	)
+
+	for _, seed := range candidates {
+		switch score := totalScores[seed.Name]; {
+		case bestCandidates == nil || score > bestScore:
+			bestCandidates, bestScore = []gardencorev1beta1.Seed{seed}, score
+		case score == bestScore:
+			bestCandidates = append(bestCandidates, seed)
+		}
+	}
+
+	seed, err := getSeedWithLeastShootsDeployed(bestCandidates, state.ShootList)
+	if err != nil {
+		return nil, err
+	}
+	return &SchedulingResult{Seed: seed, Scores: totalScores}, nil
+}
+
+// scoresToString returns a human-readable representation of the given scores, sorted by descending score.
+func scoresToString(scores map[string]int64) string {
+	seedNames := make([]string, 0, len(scores))
+	for seedName := range scores {
+		seedNames = append(seedNames, seedName)
+	}
+
+	slices.SortFunc(seedNames, func(a, b string) int {
+		if c := cmp.Compare(scores[b], scores[a]); c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
+	})
+
+	entries := make([]string, 0, len(seedNames))
+	for _, seedName := range seedNames {
+		entries = append(entries, fmt.Sprintf("%s=%d", seedName, scores[seedName]))
+	}
+	return strings.Join(entries, ", ")
+}
@@ -0,0 +1,232 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+)
+
+var _ = Describe("Framework", func() {
+	var (
+		state *SchedulingState
+		seeds []gardencorev1beta1.Seed
+	)
+
+	BeforeEach(func() {
+		state = &SchedulingState{
+			Log:       logr.Discard(),
+			Shoot:     &gardencorev1beta1.Shoot{},
+			SeedUsage: map[string]int{"seed-1": 2, "seed-2": 8, "seed-3": 4},
+		}
+		for seedName, count := range state.SeedUsage {
+			for range count {
+				state.ShootList = append(state.ShootList, &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{SeedName: &seedName}})
+			}
+		}
+
+		seeds = []gardencorev1beta1.Seed{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "seed-1"},
+				Status: gardencorev1beta1.SeedStatus{Allocatable: corev1.ResourceList{
+					gardencorev1beta1.ResourceShoots: resource.MustParse("4"),
+				}},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "seed-2", Labels: map[string]string{"tier": "premium"}},
+				Status: gardencorev1beta1.SeedStatus{Allocatable: corev1.ResourceList{
+					gardencorev1beta1.ResourceShoots: resource.MustParse("40"),
+				}},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "seed-3"},
+			},
+		}
+	})
+
+	Describe("#NewFramework", func() {
+		It("should use the default filters if none are configured", func() {
+			framework, err := NewFramework(&schedulerconfigv1alpha1.ShootSchedulerConfiguration{})
+			Expect(err).NotTo(HaveOccurred())
+
+			var names []string
+			for _, filter := range framework.filters {
+				names = append(names, filter.Name())
+			}
+			Expect(names).To(Equal(schedulerconfigv1alpha1.DefaultFilters))
+		})
+
+		It("should apply the mandatory filters before the configured filters", func() {
+			framework, err := NewFramework(&schedulerconfigv1alpha1.ShootSchedulerConfiguration{Filters: []string{
+				schedulerconfigv1alpha1.FilterResourceUtilization,
+				schedulerconfigv1alpha1.FilterStrategy,
+				schedulerconfigv1alpha1.FilterUsableSeeds,
+			}})
+			Expect(err).NotTo(HaveOccurred())
+
+			var names []string
+			for _, filter := range framework.filters {
+				names = append(names, filter.Name())
+			}
+			Expect(names).To(Equal([]string{
+				schedulerconfigv1alpha1.FilterCloudProfileSeedSelector,
+				schedulerconfigv1alpha1.FilterShootSeedSelector,
+				schedulerconfigv1alpha1.FilterProvider,
+				schedulerconfigv1alpha1.FilterZonalControlPlane,
+				schedulerconfigv1alpha1.FilterAccessRestrictions,
+				schedulerconfigv1alpha1.FilterDomain,
+				schedulerconfigv1alpha1.FilterShootReconciliationsEnabled,
+				schedulerconfigv1alpha1.FilterNetworksTaintsAndCapacity,
+				schedulerconfigv1alpha1.FilterResourceUtilization,
+				schedulerconfigv1alpha1.FilterStrategy,
+				schedulerconfigv1alpha1.FilterUsableSeeds,
+			}))
+		})
+
+		It("should fail for unknown plugins", func() {
+			_, err := NewFramework(&schedulerconfigv1alpha1.ShootSchedulerConfiguration{Filters: []string{"foo"}})
+			Expect(err).To(MatchError(`unknown filter plugin "foo"`))
+
+			_, err = NewFramework(&schedulerconfigv1alpha1.ShootSchedulerConfiguration{Scorers: []schedulerconfigv1alpha1.ScorerConfiguration{{Name: "bar", Weight: 1}}})
+			Expect(err).To(MatchError(`unknown score plugin "bar"`))
+		})
+	})
+
+	Describe("#Schedule", func() {
+		It("should choose the seed with the least shoots if no scorers are configured", func() {
+			framework, err := newFramework([]string{schedulerconfigv1alpha1.FilterZonalControlPlane}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := framework.Schedule(state, seeds)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Seed.Name).To(Equal("seed-1"))
+			Expect(result.Scores).To(BeNil())
+		})
+
+		It("should choose the seed with the highest weighted score", func() {
+			framework, err := newFramework([]string{schedulerconfigv1alpha1.FilterZonalControlPlane}, []schedulerconfigv1alpha1.ScorerConfiguration{
+				{Name: schedulerconfigv1alpha1.ScorerShootCount, Weight: 1},
+				{Name: schedulerconfigv1alpha1.ScorerAllocatableCapacity, Weight: 2},
+				{Name: schedulerconfigv1alpha1.ScorerLabelAffinity, Weight: 3, LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "premium"}}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := framework.Schedule(state, seeds)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Seed.Name).To(Equal("seed-2"))
+			Expect(result.Scores).To(Equal(map[string]int64{
+				"seed-1": 1*75 + 2*50 + 3*0,
+				"seed-2": 1*0 + 2*80 + 3*100,
+				"seed-3": 1*50 + 2*100 + 3*0,
+			}))
+		})
+
+		It("should break ties by choosing the seed with the least shoots", func() {
+			framework, err := newFramework([]string{schedulerconfigv1alpha1.FilterZonalControlPlane}, []schedulerconfigv1alpha1.ScorerConfiguration{
+				{Name: schedulerconfigv1alpha1.ScorerLabelAffinity, Weight: 1, LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "standard"}}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := framework.Schedule(state, seeds)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Seed.Name).To(Equal("seed-1"))
+			Expect(result.Scores).To(Equal(map[string]int64{"seed-1": 0, "seed-2": 0, "seed-3": 0}))
+		})
+
+		It("should return the error of a failing filter", func() {
+			framework, err := newFramework([]string{schedulerconfigv1alpha1.FilterShootSeedSelector, schedulerconfigv1alpha1.FilterZonalControlPlane}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			state.Shoot.Spec.SeedSelector = &gardencorev1beta1.SeedSelector{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"tier": "standard"}}}
+
+			result, err := framework.Schedule(state, seeds)
+			Expect(err).To(MatchError(ContainSubstring("none out of the 3 seeds has the matching labels required by seed selector of 'Shoot'")))
+			Expect(result).To(BeNil())
+		})
+	})
+
+	Describe("#DryRun", func() {
+		var framework *Framework
+
+		BeforeEach(func() {
+			var err error
+			framework, err = newFramework([]string{schedulerconfigv1alpha1.FilterShootSeedSelector}, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should return the candidates and the reasons for rejected seeds", func() {
+			state.Shoot.Spec.SeedSelector = &gardencorev1beta1.SeedSelector{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"tier": "premium"}}}
+
+			result, err := framework.DryRun(state, seeds)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Seed.Name).To(Equal("seed-2"))
+			Expect(result.Candidates).To(ConsistOf("seed-2"))
+			Expect(result.Rejections).To(HaveLen(2))
+			Expect(result.Rejections).To(HaveKeyWithValue("seed-1", HavePrefix("ShootSeedSelector: none out of the 1 seeds has the matching labels")))
+			Expect(result.Rejections).To(HaveKeyWithValue("seed-3", HavePrefix("ShootSeedSelector: ")))
+		})
+
+		It("should return the rejections together with the error if no seed is eligible", func() {
+			state.Shoot.Spec.SeedSelector = &gardencorev1beta1.SeedSelector{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"tier": "standard"}}}
+
+			result, err := framework.DryRun(state, seeds)
+			Expect(err).To(MatchError(ContainSubstring("none out of the 3 seeds has the matching labels")))
+			Expect(result.Candidates).To(BeEmpty())
+			Expect(result.Rejections).To(HaveKey("seed-1"))
+			Expect(result.Rejections).To(HaveKey("seed-2"))
+			Expect(result.Rejections).To(HaveKey("seed-3"))
+		})
+	})
+
+	Describe("capacity-aware scheduling", func() {
+		BeforeEach(func() {
+			seeds[0].Status.ResourceUsage = &gardencorev1beta1.SeedResourceUsage{
+				ControlPlaneRequests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("9"), corev1.ResourceMemory: resource.MustParse("20Gi")},
+				NodeAllocatable:      corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("10"), corev1.ResourceMemory: resource.MustParse("100Gi")},
+			}
+			seeds[1].Status.ResourceUsage = &gardencorev1beta1.SeedResourceUsage{
+				ControlPlaneRequests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourceMemory: resource.MustParse("40Gi")},
+				NodeAllocatable:      corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("10"), corev1.ResourceMemory: resource.MustParse("100Gi")},
+			}
+		})
+
+		It("should filter seeds exceeding the utilization thresholds", func() {
+			framework, err := newFramework([]string{schedulerconfigv1alpha1.FilterResourceUtilization}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			state.ResourceUtilizationThresholds = map[corev1.ResourceName]int32{corev1.ResourceCPU: 80}
+
+			result, err := framework.Schedule(state, seeds)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Seed.Name).To(Equal("seed-3"))
+
+			state.ResourceUtilizationThresholds = map[corev1.ResourceName]int32{corev1.ResourceCPU: 10}
+			result, err = framework.Schedule(state, seeds[:2])
+			Expect(err).To(MatchError("0/2 seed cluster candidate(s) are eligible for scheduling: {seed-1 => seed cpu utilization 90% exceeds threshold 10%, seed-2 => seed cpu utilization 20% exceeds threshold 10%}"))
+			Expect(result).To(BeNil())
+		})
+
+		It("should prefer the seed with the most headroom", func() {
+			framework, err := newFramework([]string{schedulerconfigv1alpha1.FilterResourceUtilization}, []schedulerconfigv1alpha1.ScorerConfiguration{{Name: schedulerconfigv1alpha1.ScorerResourceHeadroom, Weight: 1}})
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := framework.Schedule(state, seeds)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Seed.Name).To(Equal("seed-2"))
+			Expect(result.Scores).To(Equal(map[string]int64{"seed-1": 10, "seed-2": 60, "seed-3": 50}))
+		})
+	})
+
+	Describe("#scoresToString", func() {
+		It("should sort the scores descending and by name", func() {
+			Expect(scoresToString(map[string]int64{"b": 10, "a": 10, "c": 20})).To(Equal("c=20, a=10, b=10"))
+		})
+	})
+})
@@ -65,11 +65,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	// If no Seed is referenced, we try to determine an adequate one.
-	seed, err := r.DetermineSeed(ctx, log, shoot)
+	result, err := r.schedule(ctx, log, shoot)
 	if err != nil {
 		r.reportFailedScheduling(ctx, log, shoot, err)
 		return reconcile.Result{}, fmt.Errorf("failed to determine seed for shoot: %w", err)
 	}
+	seed := result.Seed
 
 	shoot.Spec.SeedName = &seed.Name
 	if err = r.Client.SubResource("binding").Update(ctx, shoot); err != nil {
@@ -85,6 +86,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		"strategy", r.Config.Strategy,
 	)
 
+	if result.Scores != nil {
+		r.reportEvent(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventSchedulingScores, "Scores of seed candidates: %s", scoresToString(result.Scores))
+	}
 	r.reportEvent(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventSchedulingSuccessful, "Scheduled to seed '%s'", seed.Name)
 	return reconcile.Result{}, nil
 }
@@ -119,71 +123,113 @@ func (r *Reconciler) DetermineSeed(
 	*gardencorev1beta1.Seed,
 	error,
 ) {
-	seedList := &gardencorev1beta1.SeedList{}
-	if err := r.Client.List(ctx, seedList); err != nil {
-		return nil, err
-	}
-	sl := &gardencorev1beta1.ShootList{}
-	if err := r.Client.List(ctx, sl); err != nil {
+	result, err := r.schedule(ctx, log, shoot)
+	if err != nil {
 		return nil, err
 	}
+	return result.Seed, nil
+}
 
-	shootList := v1beta1helper.ConvertShootList(sl.Items)
+// DetermineMigrationSeed returns an appropriate Seed cluster for migrating the control plane of the given (already
+// scheduled) shoot to. Only seeds which are accepted as destination of a control plane migration are considered, i.e.,
+// seeds other than the current one which have backups configured and use the same internal domain.
+func (r *Reconciler) DetermineMigrationSeed(
+	ctx context.Context,
+	log logr.Logger,
+	shoot *gardencorev1beta1.Shoot,
+) (
+	*gardencorev1beta1.Seed,
+	error,
+) {
+	if shoot.Spec.SeedName == nil {
+		return nil, fmt.Errorf("shoot is not scheduled to a seed yet")
+	}
 
-	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
+	framework, state, seeds, err := r.prepareScheduling(ctx, log, shoot)
 	if err != nil {
 		return nil, err
 	}
-	regionConfig, err := r.getRegionConfigMap(ctx, log, cloudProfile)
-	if err != nil {
-		return nil, err
+
+	idx := slices.IndexFunc(seeds, func(seed gardencorev1beta1.Seed) bool { return seed.Name == *shoot.Spec.SeedName })
+	if idx == -1 {
+		return nil, fmt.Errorf("current seed %q of shoot not found", *shoot.Spec.SeedName)
 	}
-	project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, shoot.Namespace)
-	if err != nil {
-		return nil, err
+	internalDomain := seedInternalDomain(&seeds[idx])
+
+	seeds = slices.DeleteFunc(seeds, func(seed gardencorev1beta1.Seed) bool {
+		return seed.Name == *shoot.Spec.SeedName || seed.Spec.Backup == nil || seedInternalDomain(&seed) != internalDomain
+	})
+	if len(seeds) == 0 {
+		return nil, fmt.Errorf("no other seed with backup and internal domain %q found", internalDomain)
 	}
 
-	filteredSeeds, err := filterUsableSeeds(seedList.Items)
+	result, err := framework.Schedule(state, seeds)
 	if err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, cloudProfile.Spec.SeedSelector, "CloudProfile")
-	if err != nil {
-		return nil, err
+	return result.Seed, nil
+}
+
+func seedInternalDomain(seed *gardencorev1beta1.Seed) string {
+	if seed.Spec.DNS.Internal == nil {
+		return ""
 	}
-	filteredSeeds, err = filterSeedsMatchingLabelSelector(filteredSeeds, shoot.Spec.SeedSelector, "Shoot")
+	return seed.Spec.DNS.Internal.Domain
+}
+
+func (r *Reconciler) schedule(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (*SchedulingResult, error) {
+	framework, state, seeds, err := r.prepareScheduling(ctx, log, shoot)
 	if err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = filterSeedsMatchingProviders(cloudProfile, shoot, filteredSeeds)
-	if err != nil {
-		return nil, err
+	return framework.Schedule(state, seeds)
+}
+
+func (r *Reconciler) prepareScheduling(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) (*Framework, *SchedulingState, []gardencorev1beta1.Seed, error) {
+	seedList := &gardencorev1beta1.SeedList{}
+	if err := r.Client.List(ctx, seedList); err != nil {
+		return nil, nil, nil, err
 	}
-	filteredSeeds, err = filterSeedsForZonalShootControlPlanes(filteredSeeds, shoot)
-	if err != nil {
-		return nil, err
+	sl := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, sl); err != nil {
+		return nil, nil, nil, err
 	}
-	filteredSeeds, err = filterSeedsForAccessRestrictions(filteredSeeds, shoot)
+
+	shootList := v1beta1helper.ConvertShootList(sl.Items)
+
+	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
-	filteredSeeds, err = filterSeedsMatchingDomain(filteredSeeds, shoot, project.Name)
+	regionConfig, err := r.getRegionConfigMap(ctx, log, cloudProfile)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
-	filteredSeeds, err = filterSeedsWithDisabledShootReconciliations(filteredSeeds)
+	project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, shoot.Namespace)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
-	filteredSeeds, err = filterCandidates(shoot, shootList, filteredSeeds)
+
+	framework, err := NewFramework(r.Config)
 	if err != nil {
-		return nil, err
+		return nil, nil, nil, err
 	}
-	filteredSeeds, err = applyStrategy(log, shoot, filteredSeeds, r.Config.Strategy, regionConfig)
-	if err != nil {
-		return nil, err
+
+	state := &SchedulingState{
+		Log:          log,
+		Shoot:        shoot,
+		ShootList:    shootList,
+		CloudProfile: cloudProfile,
+		Project:      project,
+		RegionConfig: regionConfig,
+		Strategy:     r.Config.Strategy,
+		SeedUsage:    v1beta1helper.CalculateSeedUsage(shootList),
+	}
+	if r.Config.ResourceUtilization != nil {
+		state.ResourceUtilizationThresholds = r.Config.ResourceUtilization.Thresholds
 	}
-	return getSeedWithLeastShootsDeployed(filteredSeeds, shootList)
+
+	return framework, state, seedList.Items, nil
 }
 
 func (r *Reconciler) getRegionConfigMap(ctx context.Context, log logr.Logger, cloudProfile *gardencorev1beta1.CloudProfile) (*corev1.ConfigMap, error) {
@@ -426,6 +472,40 @@ func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1b
 	return candidates, nil
 }
 
+func filterSeedsExceedingResourceUtilization(seedList []gardencorev1beta1.Seed, thresholds map[corev1.ResourceName]int32) ([]gardencorev1beta1.Seed, error) {
+	if len(thresholds) == 0 {
+		return seedList, nil
+	}
+
+	var (
+		candidates    []gardencorev1beta1.Seed
+		seedNameToErr = make(map[string]error)
+		resourceNames = maps.Keys(thresholds)
+	)
+	slices.Sort(resourceNames)
+
+	for _, seed := range seedList {
+		var err error
+		for _, resourceName := range resourceNames {
+			if utilization, ok := v1beta1helper.SeedResourceUtilization(&seed, resourceName); ok && utilization > int64(thresholds[resourceName]) {
+				err = fmt.Errorf("seed %s utilization %d%% exceeds threshold %d%%", resourceName, utilization, thresholds[resourceName])
+				break
+			}
+		}
+
+		if err != nil {
+			seedNameToErr[seed.Name] = err
+			continue
+		}
+		candidates = append(candidates, seed)
+	}
+
+	if candidates == nil {
+		return nil, fmt.Errorf("0/%d seed cluster candidate(s) are eligible for scheduling: %v", len(seedList), errorMapToString(seedNameToErr))
+	}
+	return candidates, nil
+}
+
 // getSeedWithLeastShootsDeployed finds the best candidate (i.e. the one managing the smallest number of shoots right now).
 func getSeedWithLeastShootsDeployed(seedList []gardencorev1beta1.Seed, shootList []*gardencorev1beta1.Shoot) (*gardencorev1beta1.Seed, error) {
 	var (
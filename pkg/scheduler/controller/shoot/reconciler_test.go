@@ -914,6 +914,59 @@ var _ = Describe("Scheduler_Control", func() {
 		})
 	})
 
+	Context("#DetermineMigrationSeed", func() {
+		var newSeed func(name, internalDomain string, backup bool) *gardencorev1beta1.Seed
+
+		BeforeEach(func() {
+			cloudProfile = cloudProfileBase.DeepCopy()
+			project = projectBase.DeepCopy()
+			shoot = shootBase.DeepCopy()
+			shoot.Spec.SeedName = ptr.To(seedName)
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+
+			newSeed = func(name, internalDomain string, backup bool) *gardencorev1beta1.Seed {
+				s := seedBase.DeepCopy()
+				s.Name = name
+				s.Spec.DNS.Internal = &gardencorev1beta1.SeedDNSProviderConfig{Domain: internalDomain}
+				if backup {
+					s.Spec.Backup = &gardencorev1beta1.Backup{Provider: providerType}
+					s.Status.Conditions = append(s.Status.Conditions, gardencorev1beta1.Condition{Type: gardencorev1beta1.SeedBackupBucketsReady, Status: gardencorev1beta1.ConditionTrue})
+				}
+				return s
+			}
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, project)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, newSeed(seedName, "internal.example.com", true))).To(Succeed())
+		})
+
+		It("should choose another seed with backup and the same internal domain", func() {
+			Expect(fakeGardenClient.Create(ctx, newSeed("seed-2", "internal.example.com", false))).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, newSeed("seed-3", "other.example.com", true))).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, newSeed("seed-4", "internal.example.com", true))).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineMigrationSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal("seed-4"))
+		})
+
+		It("should fail if no other seed is eligible as migration destination", func() {
+			Expect(fakeGardenClient.Create(ctx, newSeed("seed-2", "internal.example.com", false))).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineMigrationSeed(ctx, log, shoot)
+			Expect(err).To(MatchError(ContainSubstring("no other seed with backup and internal domain")))
+			Expect(bestSeed).To(BeNil())
+		})
+
+		It("should fail if the shoot is not scheduled yet", func() {
+			shoot.Spec.SeedName = nil
+
+			bestSeed, err := reconciler.DetermineMigrationSeed(ctx, log, shoot)
+			Expect(err).To(HaveOccurred())
+			Expect(bestSeed).To(BeNil())
+		})
+	})
+
 	Context("#DetermineBestSeedCandidate", func() {
 		BeforeEach(func() {
 			seed = seedBase.DeepCopy()
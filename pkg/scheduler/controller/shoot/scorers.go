@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shoot
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+)
+
+// NewScorePlugin returns the built-in ScorePlugin for the given configuration.
+func NewScorePlugin(config schedulerconfigv1alpha1.ScorerConfiguration) (ScorePlugin, error) {
+	switch config.Name {
+	case schedulerconfigv1alpha1.ScorerShootCount:
+		return &shootCountScorer{}, nil
+	case schedulerconfigv1alpha1.ScorerAllocatableCapacity:
+		return &allocatableCapacityScorer{}, nil
+	case schedulerconfigv1alpha1.ScorerResourceHeadroom:
+		return &resourceHeadroomScorer{}, nil
+	case schedulerconfigv1alpha1.ScorerLabelAffinity:
+		if config.LabelSelector == nil {
+			return nil, fmt.Errorf("score plugin %q requires a label selector", config.Name)
+		}
+		selector, err := metav1.LabelSelectorAsSelector(config.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing label selector of score plugin %q: %w", config.Name, err)
+		}
+		return &labelAffinityScorer{selector: selector}, nil
+	default:
+		return nil, fmt.Errorf("unknown score plugin %q", config.Name)
+	}
+}
+
+// shootCountScorer prefers seeds with fewer shoots. The score is normalized by the highest number of shoots among the
+// candidates.
+type shootCountScorer struct{}
+
+func (s *shootCountScorer) Name() string { return schedulerconfigv1alpha1.ScorerShootCount }
+
+func (s *shootCountScorer) Score(state *SchedulingState, seeds []gardencorev1beta1.Seed) (map[string]int64, error) {
+	var maxShoots int64
+	for _, seed := range seeds {
+		maxShoots = max(maxShoots, int64(state.SeedUsage[seed.Name]))
+	}
+
+	scores := make(map[string]int64, len(seeds))
+	for _, seed := range seeds {
+		if maxShoots == 0 {
+			scores[seed.Name] = MaxScore
+			continue
+		}
+		scores[seed.Name] = MaxScore * (maxShoots - int64(state.SeedUsage[seed.Name])) / maxShoots
+	}
+	return scores, nil
+}
+
+// allocatableCapacityScorer prefers seeds with the highest share of unused allocatable shoot capacity. Seeds without
+// allocatable capacity for shoots are considered to have unlimited capacity.
+type allocatableCapacityScorer struct{}
+
+func (s *allocatableCapacityScorer) Name() string {
+	return schedulerconfigv1alpha1.ScorerAllocatableCapacity
+}
+
+func (s *allocatableCapacityScorer) Score(state *SchedulingState, seeds []gardencorev1beta1.Seed) (map[string]int64, error) {
+	scores := make(map[string]int64, len(seeds))
+	for _, seed := range seeds {
+		allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]
+		if !ok {
+			scores[seed.Name] = MaxScore
+			continue
+		}
+
+		if allocatable := allocatableShoots.Value(); allocatable > 0 {
+			scores[seed.Name] = max(MaxScore*(allocatable-int64(state.SeedUsage[seed.Name]))/allocatable, 0)
+		} else {
+			scores[seed.Name] = 0
+		}
+	}
+	return scores, nil
+}
+
+// labelAffinityScorer gives the maximum score to seeds matching its label selector.
+type labelAffinityScorer struct {
+	selector labels.Selector
+}
+
+func (s *labelAffinityScorer) Name() string { return schedulerconfigv1alpha1.ScorerLabelAffinity }
+
+func (s *labelAffinityScorer) Score(_ *SchedulingState, seeds []gardencorev1beta1.Seed) (map[string]int64, error) {
+	scores := make(map[string]int64, len(seeds))
+	for _, seed := range seeds {
+		if s.selector.Matches(labels.Set(seed.Labels)) {
+			scores[seed.Name] = MaxScore
+		} else {
+			scores[seed.Name] = 0
+		}
+	}
+	return scores, nil
+}
+
+// resourceHeadroomScorer prefers seeds with the most unrequested node resources. The score is determined by the most
+// utilized resource of the seed. Seeds which do not publish their resource usage get a neutral score.
+type resourceHeadroomScorer struct{}
+
+func (s *resourceHeadroomScorer) Name() string { return schedulerconfigv1alpha1.ScorerResourceHeadroom }
+
+func (s *resourceHeadroomScorer) Score(_ *SchedulingState, seeds []gardencorev1beta1.Seed) (map[string]int64, error) {
+	scores := make(map[string]int64, len(seeds))
+	for _, seed := range seeds {
+		var (
+			maxUtilization int64
+			known          bool
+		)
+
+		for _, resourceName := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+			if utilization, ok := v1beta1helper.SeedResourceUtilization(&seed, resourceName); ok {
+				maxUtilization = max(maxUtilization, utilization)
+				known = true
+			}
+		}
+
+		if !known {
+			scores[seed.Name] = MaxScore / 2
+			continue
+		}
+		scores[seed.Name] = max(MaxScore-maxUtilization, 0)
+	}
+	return scores, nil
+}
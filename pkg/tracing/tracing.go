@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+)
+
+// ShutdownFunc flushes all pending spans and stops the exporter.
+type ShutdownFunc func(context.Context) error
+
+// NoopShutdown is a ShutdownFunc which does nothing. It is returned if tracing is not configured.
+var NoopShutdown ShutdownFunc = func(context.Context) error { return nil }
+
+// Setup configures the global TracerProvider to export spans via OTLP/gRPC to the given endpoint. If the endpoint is
+// empty, the global no-op TracerProvider is kept and NoopShutdown is returned. The returned ShutdownFunc must be called
+// before the component exits to flush all pending spans.
+func Setup(ctx context.Context, serviceName, endpoint string, insecure bool) (ShutdownFunc, error) {
+	if endpoint == "" {
+		return NoopShutdown, nil
+	}
+
+	options := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(endpoint)}
+	if insecure {
+		options = append(options, otlptracegrpc.WithInsecure())
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating OTLP trace exporter for endpoint %q: %w", endpoint, err)
+	}
+
+	tracerProvider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+	)
+	otel.SetTracerProvider(tracerProvider)
+
+	return tracerProvider.Shutdown, nil
+}
+
+// Shutdown flushes all pending spans of the global TracerProvider configured by Setup and stops its exporter. It does
+// nothing if tracing is not configured.
+func Shutdown(ctx context.Context) error {
+	tracerProvider, ok := otel.GetTracerProvider().(*sdktrace.TracerProvider)
+	if !ok {
+		return nil
+	}
+	return tracerProvider.Shutdown(ctx)
+}
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	. "github.com/gardener/gardener/pkg/tracing"
+)
+
+var _ = Describe("Tracing", func() {
+	var (
+		ctx = context.Background()
+
+		originalTracerProvider trace.TracerProvider
+	)
+
+	BeforeEach(func() {
+		originalTracerProvider = otel.GetTracerProvider()
+		DeferCleanup(func() { otel.SetTracerProvider(originalTracerProvider) })
+	})
+
+	Describe("#Setup", func() {
+		It("should keep the global tracer provider if no endpoint is configured", func() {
+			shutdown, err := Setup(ctx, "test", "", false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(shutdown(ctx)).To(Succeed())
+
+			Expect(otel.GetTracerProvider()).To(BeIdenticalTo(originalTracerProvider))
+		})
+
+		It("should configure the global tracer provider if an endpoint is configured", func() {
+			shutdown, err := Setup(ctx, "test", "localhost:4317", true)
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(func() { Expect(shutdown(ctx)).To(Succeed()) })
+
+			Expect(otel.GetTracerProvider()).To(BeAssignableToTypeOf(&sdktrace.TracerProvider{}))
+		})
+	})
+
+	Describe("#Shutdown", func() {
+		It("should do nothing if tracing is not configured", func() {
+			Expect(Shutdown(ctx)).To(Succeed())
+		})
+
+		It("should shut down the tracer provider configured by Setup", func() {
+			_, err := Setup(ctx, "test", "localhost:4317", true)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(Shutdown(ctx)).To(Succeed())
+
+			// spans are no longer recorded after the tracer provider has been shut down
+			_, span := otel.Tracer("test").Start(ctx, "span")
+			Expect(span.IsRecording()).To(BeFalse())
+		})
+	})
+})
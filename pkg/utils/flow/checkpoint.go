@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-logr/logr"
+)
+
+// CheckpointStore persists the TaskIDs which succeeded during a Flow execution, so that a subsequent execution for
+// the same generation can skip them.
+type CheckpointStore interface {
+	// Load returns the TaskIDs which have been stored for the given generation. If there are no checkpoints, or if they
+	// were stored for a different generation, an empty set is returned.
+	Load(ctx context.Context, generation int64) (TaskIDs, error)
+	// Store persists the given succeeded TaskIDs for the given generation, replacing all previous checkpoints.
+	Store(ctx context.Context, generation int64, succeeded TaskIDs) error
+	// Clear removes all checkpoints.
+	Clear(ctx context.Context) error
+}
+
+type inMemoryCheckpointStore struct {
+	lock       sync.RWMutex
+	generation int64
+	succeeded  TaskIDs
+}
+
+// NewInMemoryCheckpointStore returns a new CheckpointStore which keeps the checkpoints in memory.
+func NewInMemoryCheckpointStore() CheckpointStore {
+	return &inMemoryCheckpointStore{}
+}
+
+func (s *inMemoryCheckpointStore) Load(_ context.Context, generation int64) (TaskIDs, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if s.succeeded == nil || s.generation != generation {
+		return NewTaskIDs(), nil
+	}
+	return s.succeeded.Copy(), nil
+}
+
+func (s *inMemoryCheckpointStore) Store(_ context.Context, generation int64, succeeded TaskIDs) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.generation = generation
+	s.succeeded = succeeded.Copy()
+	return nil
+}
+
+func (s *inMemoryCheckpointStore) Clear(_ context.Context) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.generation = 0
+	s.succeeded = nil
+	return nil
+}
+
+func (e *execution) restoreCheckpoints(ctx context.Context) {
+	if e.checkpoints == nil {
+		return
+	}
+
+	// Checkpoints are only an optimization, hence errors are logged but do not fail the flow execution.
+	checkpointed, err := e.checkpoints.Load(ctx, e.generation)
+	if err != nil {
+		e.log.Error(err, "Failed loading checkpoints, executing all tasks", "generation", e.generation)
+		return
+	}
+
+	if checkpointed.Len() > 0 {
+		e.log.Info("Restored checkpoints of previous execution", "generation", e.generation, "succeededTasks", checkpointed.Len())
+	}
+	e.checkpointedTaskIDs = checkpointed
+}
+
+// checkpointWriter persists checkpoints asynchronously so that the flow execution is not blocked by the round-trips to
+// the CheckpointStore. Checkpoints which are enqueued while a write is in progress are coalesced, i.e., only the latest
+// set of succeeded tasks is written afterwards.
+type checkpointWriter struct {
+	store      CheckpointStore
+	generation int64
+	log        logr.Logger
+
+	lock    sync.Mutex
+	pending TaskIDs
+	trigger chan struct{}
+	done    chan struct{}
+}
+
+func (e *execution) startCheckpointWriter(ctx context.Context) *checkpointWriter {
+	if e.checkpoints == nil {
+		return nil
+	}
+
+	w := &checkpointWriter{
+		store:      e.checkpoints,
+		generation: e.generation,
+		log:        e.log,
+		trigger:    make(chan struct{}, 1),
+		done:       make(chan struct{}),
+	}
+
+	go w.run(ctx)
+	return w
+}
+
+func (w *checkpointWriter) run(ctx context.Context) {
+	defer close(w.done)
+
+	for range w.trigger {
+		w.lock.Lock()
+		succeeded := w.pending
+		w.pending = nil
+		w.lock.Unlock()
+
+		if succeeded == nil {
+			continue
+		}
+
+		// Checkpoints are only an optimization, hence errors are logged but do not fail the flow execution.
+		if err := w.store.Store(ctx, w.generation, succeeded); err != nil {
+			w.log.Error(err, "Failed storing checkpoints", "generation", w.generation)
+		}
+	}
+}
+
+// enqueue schedules storing the given succeeded tasks. It does not block.
+func (w *checkpointWriter) enqueue(succeeded TaskIDs) {
+	if w == nil {
+		return
+	}
+
+	w.lock.Lock()
+	w.pending = succeeded.Copy()
+	w.lock.Unlock()
+
+	select {
+	case w.trigger <- struct{}{}:
+	default:
+		// a write is already scheduled and will pick up the latest pending checkpoints
+	}
+}
+
+// stop writes the pending checkpoints and waits until the writer has finished.
+func (w *checkpointWriter) stop() {
+	if w == nil {
+		return
+	}
+
+	close(w.trigger)
+	<-w.done
+}
+
+func (e *execution) clearCheckpoints(ctx context.Context) {
+	if e.checkpoints == nil {
+		return
+	}
+
+	if err := e.checkpoints.Clear(ctx); err != nil {
+		e.log.Error(err, "Failed clearing checkpoints")
+	}
+}
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("Checkpoints", func() {
+	var ctx = context.Background()
+
+	Describe("#Run", func() {
+		var (
+			store      flow.CheckpointStore
+			lock       sync.Mutex
+			executions map[string]int
+			failY      bool
+
+			newFlow func() *flow.Flow
+		)
+
+		BeforeEach(func() {
+			store = flow.NewInMemoryCheckpointStore()
+			executions = make(map[string]int)
+			failY = true
+
+			newFlow = func() *flow.Flow {
+				fn := func(name string) flow.TaskFn {
+					return func(_ context.Context) error {
+						lock.Lock()
+						defer lock.Unlock()
+
+						executions[name]++
+						if name == "y" && failY {
+							return errors.New("fail")
+						}
+						return nil
+					}
+				}
+
+				g := flow.NewGraph("foo")
+				x1 := g.Add(flow.Task{Name: "x1", Fn: fn("x1")})
+				x2 := g.Add(flow.Task{Name: "x2", Fn: fn("x2"), AlwaysRun: true})
+				_ = g.Add(flow.Task{Name: "y", Fn: fn("y"), Dependencies: flow.NewTaskIDs(x1, x2)})
+				return g.Compile()
+			}
+		})
+
+		It("should skip tasks which succeeded in the previous execution for the same generation", func() {
+			Expect(newFlow().Run(ctx, flow.Opts{Checkpoints: store, Generation: 1})).NotTo(Succeed())
+			Expect(executions).To(Equal(map[string]int{"x1": 1, "x2": 1, "y": 1}))
+
+			failY = false
+			Expect(newFlow().Run(ctx, flow.Opts{Checkpoints: store, Generation: 1})).To(Succeed())
+			Expect(executions).To(Equal(map[string]int{"x1": 1, "x2": 2, "y": 2}))
+
+			checkpointed, err := store.Load(ctx, 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checkpointed.Len()).To(BeZero())
+		})
+
+		It("should not block the execution while storing checkpoints", func() {
+			var (
+				blockingStore = &blockingCheckpointStore{CheckpointStore: store, release: make(chan struct{})}
+				done          = make(chan error)
+			)
+
+			g := flow.NewGraph("foo")
+			x := g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { return nil }})
+			_ = g.Add(flow.Task{Name: "y", Fn: func(_ context.Context) error { close(blockingStore.release); return errors.New("fail") }, Dependencies: flow.NewTaskIDs(x)})
+
+			go func() { done <- g.Compile().Run(ctx, flow.Opts{Checkpoints: blockingStore, Generation: 1}) }()
+
+			Eventually(done).Should(Receive(HaveOccurred()))
+
+			checkpointed, err := store.Load(ctx, 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checkpointed.StringList()).To(ConsistOf("x"))
+		})
+
+		It("should not skip tasks if the generation changed", func() {
+			Expect(newFlow().Run(ctx, flow.Opts{Checkpoints: store, Generation: 1})).NotTo(Succeed())
+			Expect(newFlow().Run(ctx, flow.Opts{Checkpoints: store, Generation: 2})).NotTo(Succeed())
+			Expect(executions).To(Equal(map[string]int{"x1": 2, "x2": 2, "y": 2}))
+		})
+	})
+
+})
+
+// blockingCheckpointStore blocks storing checkpoints until release is closed.
+type blockingCheckpointStore struct {
+	flow.CheckpointStore
+	release chan struct{}
+}
+
+func (s *blockingCheckpointStore) Store(ctx context.Context, generation int64, succeeded flow.TaskIDs) error {
+	<-s.release
+	return s.CheckpointStore.Store(ctx, generation, succeeded)
+}
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DebugHandlerPath is the HTTP handler path for this debug handler.
+const DebugHandlerPath = "/debug/flows"
+
+type handler struct {
+	lastRuns *LastRuns
+}
+
+// NewDebugHandler creates a new HTTP handler for debugging the last runs of flows.
+// Without query parameters, it lists all recorded runs. With the 'key' query parameter, it renders the flow of the
+// respective run annotated with its statistics. The 'format' query parameter can be used to choose between 'dot'
+// (default) and 'mermaid'.
+func NewDebugHandler(lastRuns *LastRuns) http.HandlerFunc {
+	return (&handler{lastRuns}).Handle
+}
+
+func (h *handler) Handle(w http.ResponseWriter, r *http.Request) {
+	key := r.URL.Query().Get("key")
+	if key == "" {
+		h.list(w)
+		return
+	}
+
+	run, ok := h.lastRuns.Get(key)
+	if !ok {
+		http.Error(w, fmt.Sprintf("no flow run recorded for key %q", key), http.StatusNotFound)
+		return
+	}
+
+	var out string
+	switch format := r.URL.Query().Get("format"); format {
+	case "", "dot":
+		out = run.Flow.DOT(run.Stats)
+	case "mermaid":
+		out = run.Flow.Mermaid(run.Stats)
+	default:
+		http.Error(w, fmt.Sprintf("unsupported format %q, must be one of [dot mermaid]", format), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	fmt.Fprint(w, out)
+}
+
+func (h *handler) list(w http.ResponseWriter) {
+	var out strings.Builder
+
+	for _, key := range h.lastRuns.Keys() {
+		run, ok := h.lastRuns.Get(key)
+		if !ok {
+			continue
+		}
+
+		var (
+			path     = DebugHandlerPath + "?key=" + url.QueryEscape(key)
+			stats    = run.Stats
+			progress int32
+		)
+
+		if stats.All.Len() > 0 {
+			progress = stats.ProgressPercent()
+		}
+
+		out.WriteString(fmt.Sprintf(`| %s: %s (%d%%, updated %s) <a href="%s">dot</a> <a href="%s&format=mermaid">mermaid</a><br />`,
+			html.EscapeString(key),
+			html.EscapeString(run.Flow.Name()),
+			progress,
+			run.LastUpdateTime.Format(time.RFC3339),
+			path,
+			path,
+		))
+
+		for _, taskIDs := range []struct {
+			prefix string
+			ids    TaskIDs
+		}{
+			{"running", stats.Running},
+			{"failed", stats.Failed},
+		} {
+			if taskIDs.ids.Len() > 0 {
+				out.WriteString(fmt.Sprintf("| &nbsp;&nbsp;%s: %s<br />", taskIDs.prefix, html.EscapeString(strings.Join(taskIDs.ids.StringList(), ", "))))
+			}
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	fmt.Fprint(w, `<font size="2" face="Courier New">`+out.String()+`</font>`)
+}
@@ -9,10 +9,14 @@ package flow
 import (
 	"context"
 	"fmt"
+	"maps"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/go-multierror"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/utils/clock"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
@@ -71,10 +75,12 @@ func (f *Flow) Len() int {
 // node is a compiled Task that contains the triggered Tasks, the
 // number of triggers the node itself requires and its payload function.
 type node struct {
-	targetIDs TaskIDs
-	required  int
-	fn        TaskFn
-	skip      bool
+	targetIDs     TaskIDs
+	dependencyIDs TaskIDs
+	required      int
+	fn            TaskFn
+	skip          bool
+	alwaysRun     bool
 }
 
 func (n *node) String() string {
@@ -102,6 +108,18 @@ type Opts struct {
 	ErrorCleaner func(ctx context.Context, taskID string)
 	// ErrorContext is used to store any error related context.
 	ErrorContext *errorsutils.ErrorContext
+	// Recorder is used to record the statistics of the flow execution whenever they change.
+	Recorder Recorder
+	// Checkpoints is used to persist the succeeded tasks of the flow execution. If set, tasks which succeeded in a
+	// previous, unfinished execution for the same Generation are not executed again unless they are marked to always
+	// run. The checkpoints are written asynchronously, and cleared once the flow has finished successfully.
+	// Checkpoints are opt-in per flow: they must only be used for flows whose tasks do not depend on in-memory state
+	// initialized by earlier tasks (unless those tasks are marked to always run). Hence, they are not used by the Shoot
+	// reconciliation flow of gardenlet.
+	Checkpoints CheckpointStore
+	// Generation is the generation of the specification the flow is executed for. Checkpoints recorded for a different
+	// generation are ignored.
+	Generation int64
 }
 
 // Run starts an execution of a Flow.
@@ -111,9 +129,10 @@ func (f *Flow) Run(ctx context.Context, opts Opts) error {
 }
 
 type nodeResult struct {
-	TaskID  TaskID
-	Error   error
-	skipped bool
+	TaskID   TaskID
+	Error    error
+	skipped  bool
+	restored bool
 
 	delay    time.Duration
 	duration time.Duration
@@ -128,6 +147,8 @@ type Stats struct {
 	Running   TaskIDs
 	Skipped   TaskIDs
 	Pending   TaskIDs
+	// Durations contains the execution durations of all finished tasks.
+	Durations map[TaskID]time.Duration
 }
 
 // ProgressPercent retrieves the progress of a Flow execution in percent.
@@ -146,6 +167,7 @@ func (s *Stats) Copy() *Stats {
 		s.Running.Copy(),
 		s.Skipped.Copy(),
 		s.Pending.Copy(),
+		maps.Clone(s.Durations),
 	}
 }
 
@@ -160,6 +182,7 @@ func InitialStats(flowName string, all TaskIDs) *Stats {
 		NewTaskIDs(),
 		NewTaskIDs(),
 		all.Copy(),
+		make(map[TaskID]time.Duration),
 	}
 }
 
@@ -185,8 +208,13 @@ func newExecution(flow *Flow, opts Opts) *execution {
 		opts.ProgressReporter,
 		opts.ErrorCleaner,
 		opts.ErrorContext,
+		opts.Recorder,
+		opts.Checkpoints,
+		opts.Generation,
+		NewTaskIDs(),
 		make(chan *nodeResult),
 		make(map[TaskID]int),
+		nil,
 	}
 }
 
@@ -200,9 +228,16 @@ type execution struct {
 	progressReporter ProgressReporter
 	errorCleaner     ErrorCleaner
 	errorContext     *errorsutils.ErrorContext
+	recorder         Recorder
+
+	checkpoints         CheckpointStore
+	generation          int64
+	checkpointedTaskIDs TaskIDs
 
 	done          chan *nodeResult
 	triggerCounts map[TaskID]int
+
+	checkpointWriter *checkpointWriter
 }
 
 func (e *execution) runNode(ctx context.Context, id TaskID) {
@@ -228,7 +263,23 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 	e.stats.Pending.Delete(id)
 	e.stats.Running.Insert(id)
 
+	if e.checkpointedTaskIDs.Has(id) && !node.alwaysRun {
+		log.Info("Succeeded in previous execution, skipping")
+
+		go func() {
+			e.done <- &nodeResult{TaskID: id, Error: nil, restored: true, delay: taskStartDelay}
+		}()
+
+		return
+	}
+
 	go func() {
+		ctx, span := tracer.Start(ctx, string(id), trace.WithAttributes(
+			attribute.String(traceAttributeTaskID, string(id)),
+			attribute.StringSlice(traceAttributeTaskDependencies, node.dependencyIDs.StringList()),
+		))
+		defer span.End()
+
 		start := e.flow.clock.Now().UTC()
 		log.V(1).Info("Started")
 		err := node.fn(ctx)
@@ -238,6 +289,8 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 		if err != nil {
 			log.Error(err, "Error")
 			err = fmt.Errorf("task %q failed: %w", id, err)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
 		} else {
 			log.Info("Succeeded")
 		}
@@ -246,14 +299,16 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 	}()
 }
 
-func (e *execution) updateSuccess(id TaskID) {
+func (e *execution) updateSuccess(id TaskID, duration time.Duration) {
 	e.stats.Running.Delete(id)
 	e.stats.Succeeded.Insert(id)
+	e.stats.Durations[id] = duration
 }
 
-func (e *execution) updateFailure(id TaskID) {
+func (e *execution) updateFailure(id TaskID, duration time.Duration) {
 	e.stats.Running.Delete(id)
 	e.stats.Failed.Insert(id)
+	e.stats.Durations[id] = duration
 }
 
 func (e *execution) processTriggers(ctx context.Context, id TaskID) {
@@ -276,12 +331,27 @@ func (e *execution) reportProgress(ctx context.Context) {
 	if e.progressReporter != nil {
 		e.progressReporter.Report(ctx, e.stats.Copy())
 	}
+	if e.recorder != nil {
+		e.recorder.Record(e.flow, e.stats.Copy())
+	}
 }
 
-func (e *execution) run(ctx context.Context) error {
+func (e *execution) run(ctx context.Context) (err error) {
 	e.flow.start = e.flow.clock.Now()
 	defer close(e.done)
 
+	ctx, span := tracer.Start(ctx, e.flow.name, trace.WithAttributes(
+		attribute.String(traceAttributeFlowName, e.flow.name),
+		attribute.Int(traceAttributeFlowTasks, e.stats.All.Len()),
+	))
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
 	if e.progressReporter != nil {
 		if err := e.progressReporter.Start(ctx); err != nil {
 			return err
@@ -290,6 +360,8 @@ func (e *execution) run(ctx context.Context) error {
 	}
 
 	e.log.Info("Starting")
+	e.restoreCheckpoints(ctx)
+	e.checkpointWriter = e.startCheckpointWriter(ctx)
 	e.reportProgress(ctx)
 
 	var (
@@ -315,9 +387,12 @@ func (e *execution) run(ctx context.Context) error {
 		} else {
 			if result.Error != nil {
 				e.taskErrors = append(e.taskErrors, errorsutils.WithID(string(result.TaskID), result.Error))
-				e.updateFailure(result.TaskID)
+				e.updateFailure(result.TaskID, result.duration)
 			} else {
-				e.updateSuccess(result.TaskID)
+				e.updateSuccess(result.TaskID, result.duration)
+				if !result.restored {
+					e.checkpointWriter.enqueue(e.stats.Succeeded)
+				}
 				if e.errorContext != nil && e.errorContext.HasLastErrorWithID(string(result.TaskID)) {
 					e.cleanErrors(ctx, result.TaskID)
 				}
@@ -330,6 +405,11 @@ func (e *execution) run(ctx context.Context) error {
 		e.reportProgress(ctx)
 	}
 
+	e.checkpointWriter.stop()
+	if cancelErr == nil && len(e.taskErrors) == 0 {
+		e.clearCheckpoints(ctx)
+	}
+
 	e.log.Info("Finished")
 	return e.result(cancelErr)
 }
@@ -356,10 +436,10 @@ func (e *execution) result(cancelErr error) error {
 func (e *execution) reportTaskMetrics(r *nodeResult) {
 	if flowTaskDelaySeconds != nil {
 		flowTaskDelaySeconds.
-			WithLabelValues(e.flow.name, string(r.TaskID), utils.IifString(r.skipped, "true", "false")).
+			WithLabelValues(e.flow.name, string(r.TaskID), utils.IifString(r.skipped || r.restored, "true", "false")).
 			Observe(r.delay.Seconds())
 	}
-	if flowTaskDurationSeconds != nil && !r.skipped {
+	if flowTaskDurationSeconds != nil && !r.skipped && !r.restored {
 		flowTaskDurationSeconds.WithLabelValues(e.flow.name, string(r.TaskID)).Observe(r.duration.Seconds())
 	}
 	if flowTaskResults != nil {
@@ -147,6 +147,30 @@ var _ = Describe("Flow", func() {
 			Expect(cleaned).To(BeTrue())
 		})
 
+		It("should record the statistics of the execution", func() {
+			var (
+				g = flow.NewGraph("foo")
+				x = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { return nil }})
+				_ = g.Add(flow.Task{Name: "y", Fn: func(_ context.Context) error { return errors.New("err") }, Dependencies: flow.NewTaskIDs(x)})
+				f = g.Compile()
+
+				lastStats *flow.Stats
+				records   int
+			)
+
+			Expect(f.Run(ctx, flow.Opts{Recorder: flow.RecorderFunc(func(recordedFlow *flow.Flow, stats *flow.Stats) {
+				Expect(recordedFlow).To(BeIdenticalTo(f))
+				lastStats = stats
+				records++
+			})})).NotTo(Succeed())
+
+			Expect(records).To(BeNumerically(">=", 3))
+			Expect(lastStats.Succeeded.StringList()).To(ConsistOf("x"))
+			Expect(lastStats.Failed.StringList()).To(ConsistOf("y"))
+			Expect(lastStats.Durations).To(HaveKey(flow.TaskID("x")))
+			Expect(lastStats.Durations).To(HaveKey(flow.TaskID("y")))
+		})
+
 		It("should stop the execution after the context has been canceled in between tasks", func() {
 			var (
 				testCtx, cancelTestCtx = context.WithCancel(context.Background())
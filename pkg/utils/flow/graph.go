@@ -17,6 +17,9 @@ type Task struct {
 	Fn           TaskFn
 	SkipIf       bool
 	Dependencies TaskIDs
+	// AlwaysRun specifies that the task is executed even if it succeeded in a previous execution of the flow which was
+	// restored from checkpoints (see Opts.Checkpoints).
+	AlwaysRun bool
 }
 
 // Spec returns the TaskSpec of a task.
@@ -25,6 +28,7 @@ func (t *Task) Spec() *TaskSpec {
 		t.Fn,
 		t.SkipIf,
 		t.Dependencies.Copy(),
+		t.AlwaysRun,
 	}
 }
 
@@ -34,6 +38,7 @@ type TaskSpec struct {
 	Fn           TaskFn
 	Skip         bool
 	Dependencies TaskIDs
+	AlwaysRun    bool
 }
 
 // Tasks is a mapping from TaskID to TaskSpec.
@@ -91,7 +96,9 @@ func (g *Graph) Compile() *Flow {
 		node := nodes.getOrCreate(taskName)
 		node.fn = taskSpec.Fn
 		node.skip = taskSpec.Skip
+		node.alwaysRun = taskSpec.AlwaysRun
 		node.required = taskSpec.Dependencies.Len()
+		node.dependencyIDs = taskSpec.Dependencies
 	}
 
 	return &Flow{
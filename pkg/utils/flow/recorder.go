@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+)
+
+// Recorder records the statistics of a Flow execution. It is called whenever the statistics change.
+type Recorder interface {
+	// Record records the given statistics of the given flow.
+	Record(flow *Flow, stats *Stats)
+}
+
+// RecorderFunc is a function that implements Recorder.
+type RecorderFunc func(flow *Flow, stats *Stats)
+
+// Record implements Recorder.
+func (f RecorderFunc) Record(flow *Flow, stats *Stats) {
+	f(flow, stats)
+}
+
+// Run is the last recorded state of a Flow execution.
+type Run struct {
+	// Flow is the executed flow.
+	Flow *Flow
+	// Stats are the last recorded statistics of the execution.
+	Stats *Stats
+	// LastUpdateTime is the time when the statistics were recorded.
+	LastUpdateTime time.Time
+}
+
+// LastRuns keeps the last recorded run of flows, identified by arbitrary keys (e.g., the namespace and name of the
+// object the flow is executed for).
+type LastRuns struct {
+	clock clock.Clock
+
+	lock sync.RWMutex
+	runs map[string]*Run
+}
+
+// NewLastRuns returns a new LastRuns object.
+func NewLastRuns(clock clock.Clock) *LastRuns {
+	return &LastRuns{clock: clock, runs: make(map[string]*Run)}
+}
+
+// RecorderFor returns a Recorder which stores the recorded statistics under the given key.
+func (l *LastRuns) RecorderFor(key string) Recorder {
+	return RecorderFunc(func(flow *Flow, stats *Stats) {
+		l.lock.Lock()
+		defer l.lock.Unlock()
+
+		l.runs[key] = &Run{Flow: flow, Stats: stats, LastUpdateTime: l.clock.Now().UTC()}
+	})
+}
+
+// Get returns the last run stored for the given key.
+func (l *LastRuns) Get(key string) (*Run, bool) {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	run, ok := l.runs[key]
+	return run, ok
+}
+
+// Delete removes the last run stored for the given key.
+func (l *LastRuns) Delete(key string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	delete(l.runs, key)
+}
+
+// Keys returns the sorted keys of all stored runs.
+func (l *LastRuns) Keys() []string {
+	l.lock.RLock()
+	defer l.lock.RUnlock()
+
+	keys := make([]string, 0, len(l.runs))
+	for key := range l.runs {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
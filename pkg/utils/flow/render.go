@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// TaskState is the execution state of a task as derived from the Stats of a Flow execution.
+type TaskState string
+
+const (
+	// TaskStateUnknown is the state of a task if no execution statistics are available.
+	TaskStateUnknown TaskState = ""
+	// TaskStatePending is the state of a task which has not been started yet.
+	TaskStatePending TaskState = "Pending"
+	// TaskStateRunning is the state of a task which is currently running.
+	TaskStateRunning TaskState = "Running"
+	// TaskStateSucceeded is the state of a task which has finished successfully.
+	TaskStateSucceeded TaskState = "Succeeded"
+	// TaskStateFailed is the state of a task which has finished with an error.
+	TaskStateFailed TaskState = "Failed"
+	// TaskStateSkipped is the state of a task which is skipped.
+	TaskStateSkipped TaskState = "Skipped"
+)
+
+// renderNode is a task in a representation which is independent of whether it was taken from a Graph or a Flow.
+type renderNode struct {
+	id           TaskID
+	dependencies []TaskID
+	skip         bool
+}
+
+// DOT renders the graph in the DOT language (see https://graphviz.org/doc/info/lang.html).
+func (g *Graph) DOT() string {
+	return renderDOT(g.name, g.renderNodes(), nil)
+}
+
+// Mermaid renders the graph as Mermaid flowchart (see https://mermaid.js.org/syntax/flowchart.html).
+func (g *Graph) Mermaid() string {
+	return renderMermaid(g.renderNodes(), nil)
+}
+
+// DOT renders the flow in the DOT language (see https://graphviz.org/doc/info/lang.html). If stats are given, each
+// node is annotated with its execution state and duration.
+func (f *Flow) DOT(stats *Stats) string {
+	return renderDOT(f.name, f.renderNodes(), stats)
+}
+
+// Mermaid renders the flow as Mermaid flowchart (see https://mermaid.js.org/syntax/flowchart.html). If stats are
+// given, each node is annotated with its execution state and duration.
+func (f *Flow) Mermaid(stats *Stats) string {
+	return renderMermaid(f.renderNodes(), stats)
+}
+
+// TaskState returns the execution state of the task with the given id according to the given stats.
+func (f *Flow) TaskState(id TaskID, stats *Stats) TaskState {
+	if n, ok := f.nodes[id]; ok && n.skip {
+		return TaskStateSkipped
+	}
+	return stats.taskState(id)
+}
+
+func (s *Stats) taskState(id TaskID) TaskState {
+	switch {
+	case s == nil:
+		return TaskStateUnknown
+	case s.Succeeded.Has(id):
+		return TaskStateSucceeded
+	case s.Failed.Has(id):
+		return TaskStateFailed
+	case s.Running.Has(id):
+		return TaskStateRunning
+	case s.Skipped.Has(id):
+		return TaskStateSkipped
+	case s.Pending.Has(id):
+		return TaskStatePending
+	}
+	return TaskStateUnknown
+}
+
+func (g *Graph) renderNodes() []renderNode {
+	out := make([]renderNode, 0, len(g.tasks))
+	for id, spec := range g.tasks {
+		out = append(out, renderNode{id: id, dependencies: spec.Dependencies.List(), skip: spec.Skip})
+	}
+	return sortRenderNodes(out)
+}
+
+func (f *Flow) renderNodes() []renderNode {
+	dependencies := make(map[TaskID][]TaskID, len(f.nodes))
+	for id, n := range f.nodes {
+		for target := range n.targetIDs {
+			dependencies[target] = append(dependencies[target], id)
+		}
+	}
+
+	out := make([]renderNode, 0, len(f.nodes))
+	for id, n := range f.nodes {
+		deps := dependencies[id]
+		slices.Sort(deps)
+		out = append(out, renderNode{id: id, dependencies: deps, skip: n.skip})
+	}
+	return sortRenderNodes(out)
+}
+
+func sortRenderNodes(nodes []renderNode) []renderNode {
+	slices.SortFunc(nodes, func(a, b renderNode) int { return strings.Compare(string(a.id), string(b.id)) })
+	return nodes
+}
+
+func renderState(n renderNode, stats *Stats) TaskState {
+	if n.skip {
+		return TaskStateSkipped
+	}
+	return stats.taskState(n.id)
+}
+
+func renderLabel(n renderNode, stats *Stats, newline string) string {
+	label := string(n.id)
+	if stats == nil {
+		return label
+	}
+
+	state := renderState(n, stats)
+	if state == TaskStateUnknown {
+		return label
+	}
+
+	label += newline + string(state)
+	if duration, ok := stats.Durations[n.id]; ok {
+		label += fmt.Sprintf(" (%s)", duration.Round(time.Millisecond))
+	}
+	return label
+}
+
+var dotStateAttributes = map[TaskState]string{
+	TaskStatePending:   `style=filled, fillcolor="white"`,
+	TaskStateRunning:   `style=filled, fillcolor="gold"`,
+	TaskStateSucceeded: `style=filled, fillcolor="palegreen"`,
+	TaskStateFailed:    `style=filled, fillcolor="salmon"`,
+	TaskStateSkipped:   `style=dashed, fontcolor="gray"`,
+}
+
+func renderDOT(name string, nodes []renderNode, stats *Stats) string {
+	var out strings.Builder
+
+	fmt.Fprintf(&out, "digraph %s {\n", dotQuote(name))
+	out.WriteString("  rankdir=LR;\n")
+	out.WriteString("  node [shape=box];\n")
+
+	for _, n := range nodes {
+		attributes := "label=" + dotQuote(renderLabel(n, stats, "\n"))
+		if a, ok := dotStateAttributes[renderState(n, stats)]; ok {
+			attributes += ", " + a
+		}
+		fmt.Fprintf(&out, "  %s [%s];\n", dotQuote(string(n.id)), attributes)
+	}
+
+	for _, n := range nodes {
+		for _, dependency := range n.dependencies {
+			fmt.Fprintf(&out, "  %s -> %s;\n", dotQuote(string(dependency)), dotQuote(string(n.id)))
+		}
+	}
+
+	out.WriteString("}\n")
+	return out.String()
+}
+
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
+
+var mermaidStateClasses = map[TaskState]string{
+	TaskStatePending:   "fill:#ffffff",
+	TaskStateRunning:   "fill:#ffd700",
+	TaskStateSucceeded: "fill:#98fb98",
+	TaskStateFailed:    "fill:#fa8072",
+	TaskStateSkipped:   "stroke-dasharray:5 5,color:#808080",
+}
+
+func renderMermaid(nodes []renderNode, stats *Stats) string {
+	var (
+		out     strings.Builder
+		ids     = make(map[TaskID]string, len(nodes))
+		classes = make(map[TaskState][]string)
+	)
+
+	out.WriteString("flowchart LR\n")
+
+	// Task names may contain characters which are not allowed in Mermaid node ids, hence we generate them.
+	for i, n := range nodes {
+		ids[n.id] = fmt.Sprintf("t%d", i)
+	}
+
+	for _, n := range nodes {
+		fmt.Fprintf(&out, "  %s[%s]\n", ids[n.id], mermaidQuote(renderLabel(n, stats, "<br/>")))
+		if stats != nil || n.skip {
+			state := renderState(n, stats)
+			classes[state] = append(classes[state], ids[n.id])
+		}
+	}
+
+	for _, n := range nodes {
+		for _, dependency := range n.dependencies {
+			fmt.Fprintf(&out, "  %s --> %s\n", ids[dependency], ids[n.id])
+		}
+	}
+
+	for _, state := range []TaskState{TaskStatePending, TaskStateRunning, TaskStateSucceeded, TaskStateFailed, TaskStateSkipped} {
+		if len(classes[state]) == 0 {
+			continue
+		}
+		className := strings.ToLower(string(state))
+		fmt.Fprintf(&out, "  classDef %s %s\n", className, mermaidStateClasses[state])
+		fmt.Fprintf(&out, "  class %s %s\n", strings.Join(classes[state], ","), className)
+	}
+
+	return out.String()
+}
+
+func mermaidQuote(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, "#quot;") + `"`
+}
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("Render", func() {
+	var (
+		g *flow.Graph
+		f *flow.Flow
+	)
+
+	BeforeEach(func() {
+		g = flow.NewGraph("foo")
+		a := g.Add(flow.Task{Name: "a"})
+		b := g.Add(flow.Task{Name: `b "quoted"`, Dependencies: flow.NewTaskIDs(a)})
+		_ = g.Add(flow.Task{Name: "c", SkipIf: true, Dependencies: flow.NewTaskIDs(a, b)})
+		f = g.Compile()
+	})
+
+	Describe("#DOT", func() {
+		It("should render the graph", func() {
+			Expect(g.DOT()).To(Equal(`digraph "foo" {
+  rankdir=LR;
+  node [shape=box];
+  "a" [label="a"];
+  "b \"quoted\"" [label="b \"quoted\""];
+  "c" [label="c", style=dashed, fontcolor="gray"];
+  "a" -> "b \"quoted\"";
+  "a" -> "c";
+  "b \"quoted\"" -> "c";
+}
+`))
+		})
+
+		It("should render the flow with the execution state", func() {
+			stats := flow.InitialStats("foo", flow.NewTaskIDs(flow.TaskID("a"), flow.TaskID(`b "quoted"`)))
+			stats.Pending.Delete(flow.TaskID("a"), flow.TaskID(`b "quoted"`))
+			stats.Succeeded.Insert(flow.TaskID("a"))
+			stats.Durations["a"] = 1500 * time.Millisecond
+			stats.Running.Insert(flow.TaskID(`b "quoted"`))
+
+			Expect(f.DOT(stats)).To(Equal(`digraph "foo" {
+  rankdir=LR;
+  node [shape=box];
+  "a" [label="a\nSucceeded (1.5s)", style=filled, fillcolor="palegreen"];
+  "b \"quoted\"" [label="b \"quoted\"\nRunning", style=filled, fillcolor="gold"];
+  "c" [label="c\nSkipped", style=dashed, fontcolor="gray"];
+  "a" -> "b \"quoted\"";
+  "a" -> "c";
+  "b \"quoted\"" -> "c";
+}
+`))
+		})
+	})
+
+	Describe("#Mermaid", func() {
+		It("should render the graph", func() {
+			Expect(g.Mermaid()).To(Equal(`flowchart LR
+  t0["a"]
+  t1["b #quot;quoted#quot;"]
+  t2["c"]
+  t0 --> t1
+  t0 --> t2
+  t1 --> t2
+  classDef skipped stroke-dasharray:5 5,color:#808080
+  class t2 skipped
+`))
+		})
+
+		It("should render the flow with the execution state", func() {
+			stats := flow.InitialStats("foo", flow.NewTaskIDs(flow.TaskID("a"), flow.TaskID(`b "quoted"`)))
+			stats.Pending.Delete(flow.TaskID("a"))
+			stats.Failed.Insert(flow.TaskID("a"))
+			stats.Durations["a"] = 2 * time.Second
+
+			Expect(f.Mermaid(stats)).To(Equal(`flowchart LR
+  t0["a<br/>Failed (2s)"]
+  t1["b #quot;quoted#quot;<br/>Pending"]
+  t2["c<br/>Skipped"]
+  t0 --> t1
+  t0 --> t2
+  t1 --> t2
+  classDef pending fill:#ffffff
+  class t1 pending
+  classDef failed fill:#fa8072
+  class t0 failed
+  classDef skipped stroke-dasharray:5 5,color:#808080
+  class t2 skipped
+`))
+		})
+	})
+})
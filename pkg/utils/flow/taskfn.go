@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-multierror"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/gardener/gardener/pkg/utils/retry"
 )
@@ -42,8 +44,16 @@ func (t TaskFn) RetryUntilTimeout(interval, timeout time.Duration) TaskFn {
 		ctx, cancel := context.WithTimeout(ctx, timeout)
 		defer cancel()
 
+		var (
+			span    = trace.SpanFromContext(ctx)
+			retries int
+		)
+
 		return retry.Until(ctx, interval, func(ctx context.Context) (done bool, err error) {
 			if err := t(ctx); err != nil {
+				retries++
+				span.SetAttributes(attribute.Int(traceAttributeTaskRetries, retries))
+				span.AddEvent("Retrying after error", trace.WithAttributes(attribute.String("error", err.Error())))
 				return retry.MinorError(err)
 			}
 			return retry.Ok()
@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow
+
+import (
+	"go.opentelemetry.io/otel"
+)
+
+const (
+	tracerName = "github.com/gardener/gardener/pkg/utils/flow"
+
+	traceAttributeFlowName         = "flow.name"
+	traceAttributeFlowTasks        = "flow.tasks"
+	traceAttributeTaskID           = "flow.task.id"
+	traceAttributeTaskDependencies = "flow.task.dependencies"
+	traceAttributeTaskRetries      = "flow.task.retries"
+)
+
+// tracer is used to open a span for each flow execution and a child span for each task. It uses the global
+// TracerProvider, i.e., spans are only exported if a component has configured tracing.
+var tracer = otel.Tracer(tracerName)
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flow_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("Tracing", func() {
+	var (
+		ctx = context.Background()
+
+		recorder               *tracetest.SpanRecorder
+		originalTracerProvider trace.TracerProvider
+	)
+
+	BeforeEach(func() {
+		originalTracerProvider = otel.GetTracerProvider()
+		recorder = tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	})
+
+	AfterEach(func() {
+		otel.SetTracerProvider(originalTracerProvider)
+	})
+
+	It("should open a span for the flow and a child span for each task", func() {
+		var (
+			attempts int
+
+			g = flow.NewGraph("foo")
+			x = g.Add(flow.Task{Name: "x", Fn: flow.TaskFn(func(_ context.Context) error {
+				if attempts++; attempts < 3 {
+					return errors.New("retry")
+				}
+				return nil
+			}).RetryUntilTimeout(time.Millisecond, time.Second)})
+			_ = g.Add(flow.Task{Name: "y", Fn: func(_ context.Context) error { return errors.New("fail") }, Dependencies: flow.NewTaskIDs(x)})
+		)
+
+		Expect(g.Compile().Run(ctx, flow.Opts{})).NotTo(Succeed())
+
+		spans := make(map[string]sdktrace.ReadOnlySpan)
+		for _, span := range recorder.Ended() {
+			spans[span.Name()] = span
+		}
+		Expect(spans).To(HaveLen(3))
+
+		flowSpan := spans["foo"]
+		Expect(flowSpan.Status().Code).To(Equal(codes.Error))
+
+		Expect(spans["x"].Parent().SpanID()).To(Equal(flowSpan.SpanContext().SpanID()))
+		Expect(spans["x"].Attributes()).To(ContainElement(attribute.Int("flow.task.retries", 2)))
+		Expect(spans["x"].Status().Code).To(Equal(codes.Unset))
+
+		Expect(spans["y"].Parent().SpanID()).To(Equal(flowSpan.SpanContext().SpanID()))
+		Expect(spans["y"].Attributes()).To(ContainElement(attribute.StringSlice("flow.task.dependencies", []string{"x"})))
+		Expect(spans["y"].Status().Code).To(Equal(codes.Error))
+	})
+})
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+// CertificateExpirations lists the secrets managed by the secrets manager with the given identity in the given
+// namespace and returns the expiration times of the certificates stored in them. Only the newest secret per name is
+// considered, i.e., old certificates which are still kept during a rotation are not part of the result. The result is
+// sorted by name.
+func CertificateExpirations(ctx context.Context, c client.Reader, namespace, identity string) ([]gardencorev1beta1.CertificateExpiration, error) {
+	secretList := &corev1.SecretList{}
+	if err := c.List(ctx, secretList, client.InNamespace(namespace), client.MatchingLabels{
+		secretsmanager.LabelKeyManagedBy:       secretsmanager.LabelValueSecretsManager,
+		secretsmanager.LabelKeyManagerIdentity: identity,
+	}); err != nil {
+		return nil, fmt.Errorf("failed listing secrets in namespace %s: %w", namespace, err)
+	}
+
+	nameToNewestSecret := make(map[string]corev1.Secret, len(secretList.Items))
+	for _, secret := range secretList.Items {
+		if !isCertificateSecret(secret.Data) || secret.Labels[secretsmanager.LabelKeyValidUntilTime] == "" {
+			continue
+		}
+
+		name := secret.Labels[secretsmanager.LabelKeyName]
+		if newestSecret, ok := nameToNewestSecret[name]; !ok || newestSecret.CreationTimestamp.Before(&secret.CreationTimestamp) {
+			nameToNewestSecret[name] = secret
+		}
+	}
+
+	var expirations []gardencorev1beta1.CertificateExpiration
+	for name, secret := range nameToNewestSecret {
+		validUntilUnix, err := strconv.ParseInt(secret.Labels[secretsmanager.LabelKeyValidUntilTime], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse %s label from secret %s: %w", secretsmanager.LabelKeyValidUntilTime, client.ObjectKeyFromObject(&secret), err)
+		}
+
+		expirations = append(expirations, gardencorev1beta1.CertificateExpiration{
+			Name:                 name,
+			CertificateAuthority: IsCASecret(secret.Data),
+			ExpirationTime:       metav1.NewTime(time.Unix(validUntilUnix, 0).UTC()),
+		})
+	}
+
+	slices.SortFunc(expirations, func(a, b gardencorev1beta1.CertificateExpiration) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return expirations, nil
+}
+
+func isCertificateSecret(data map[string][]byte) bool {
+	return data[secretsutils.DataKeyCertificate] != nil || IsCASecret(data)
+}
+
+// IsCASecret returns true if the given secret data belongs to a CA secret. The private key of the CA is either
+// contained in the data or kept in the private key store of the secrets manager.
+func IsCASecret(data map[string][]byte) bool {
+	return data[secretsutils.DataKeyCertificateCA] != nil &&
+		(data[secretsutils.DataKeyPrivateKeyCA] != nil || data[secretsmanager.DataKeyPrivateKeyCAReference] != nil)
+}
@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+var _ = Describe("Certificates", func() {
+	Describe("#CertificateExpirations", func() {
+		var (
+			ctx        = context.Background()
+			namespace  = "shoot--foo--bar"
+			identity   = "gardenlet"
+			fakeClient client.Client
+
+			now = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		)
+
+		newSecret := func(name, configName, identity string, creationTimestamp time.Time, validUntil string, data map[string][]byte) *corev1.Secret {
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              name,
+					Namespace:         namespace,
+					CreationTimestamp: metav1.NewTime(creationTimestamp),
+					Labels: map[string]string{
+						"name":             configName,
+						"managed-by":       "secrets-manager",
+						"manager-identity": identity,
+					},
+				},
+				Data: data,
+			}
+			if validUntil != "" {
+				secret.Labels["valid-until-time"] = validUntil
+			}
+			return secret
+		}
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+		})
+
+		It("should return nothing if there are no secrets", func() {
+			Expect(CertificateExpirations(ctx, fakeClient, namespace, identity)).To(BeEmpty())
+		})
+
+		It("should return the expiration times of the newest certificates", func() {
+			var (
+				caData     = map[string][]byte{"ca.crt": []byte("cert"), "ca.key": []byte("key")}
+				serverData = map[string][]byte{"tls.crt": []byte("cert"), "tls.key": []byte("key"), "ca.crt": []byte("ca")}
+			)
+
+			for _, secret := range []*corev1.Secret{
+				newSecret("ca-1", "ca", identity, now.Add(-time.Hour), "1767225600", caData),
+				newSecret("ca-2", "ca", identity, now, "1798761600", caData),
+				newSecret("ca-external", "ca-external", identity, now, "1798761600", map[string][]byte{"ca.crt": []byte("cert"), "ca.key.ref": []byte("ref")}),
+				newSecret("ca-bundle", "ca-bundle", identity, now, "", map[string][]byte{"bundle.crt": []byte("bundle")}),
+				newSecret("server", "server", identity, now, "1769904000", serverData),
+				newSecret("ssh-keypair", "ssh-keypair", identity, now, "", map[string][]byte{"id_rsa": []byte("key")}),
+				newSecret("other-server", "other-server", "other", now, "1769904000", serverData),
+			} {
+				Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+			}
+
+			Expect(CertificateExpirations(ctx, fakeClient, namespace, identity)).To(Equal([]gardencorev1beta1.CertificateExpiration{
+				{Name: "ca", CertificateAuthority: true, ExpirationTime: metav1.NewTime(time.Unix(1798761600, 0).UTC())},
+				{Name: "ca-external", CertificateAuthority: true, ExpirationTime: metav1.NewTime(time.Unix(1798761600, 0).UTC())},
+				{Name: "server", ExpirationTime: metav1.NewTime(time.Unix(1769904000, 0).UTC())},
+			}))
+		})
+
+		It("should fail if the valid-until-time label cannot be parsed", func() {
+			Expect(fakeClient.Create(ctx, newSecret("server", "server", identity, now, "foo", map[string][]byte{"tls.crt": []byte("cert")}))).To(Succeed())
+
+			_, err := CertificateExpirations(ctx, fakeClient, namespace, identity)
+			Expect(err).To(MatchError(ContainSubstring("could not parse valid-until-time label")))
+		})
+	})
+})
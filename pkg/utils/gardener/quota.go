@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+)
+
+// ComputeShootQuotaResources computes the resources which are accounted against a Quota for the given Shoot. For now,
+// the maximum number of machines of each worker pool is always used for the calculation. The control plane is counted
+// in the metric of its tier, and the quota accounting of the CloudProfile adds further resources for the control plane
+// and each load balancer.
+// Only the load balancers deducible from the Shoot specification are counted. LoadBalancer services created in the
+// Shoot cluster cannot be considered: neither the admission plugin nor the quota controller have access to Shoot
+// clusters, and admission has to decide before the Shoot cluster and its services exist.
+func ComputeShootQuotaResources(shoot *gardencorev1beta1.Shoot, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec) (corev1.ResourceList, error) {
+	if cloudProfileSpec == nil {
+		return nil, fmt.Errorf("no cloudprofile reference has been provided")
+	}
+
+	var (
+		countLB   = countLoadBalancers(shoot)
+		resources = make(corev1.ResourceList)
+	)
+
+	for _, worker := range shootWorkersWithDefaultVolumes(shoot, cloudProfileSpec) {
+		var (
+			machineType *gardencorev1beta1.MachineType
+			volumeType  *gardencorev1beta1.VolumeType
+		)
+
+		for _, e := range cloudProfileSpec.MachineTypes {
+			element := e
+			if element.Name == worker.Machine.Type {
+				machineType = &element
+				break
+			}
+		}
+		if machineType == nil {
+			return nil, fmt.Errorf("machineType %s not found in CloudProfile", worker.Machine.Type)
+		}
+
+		if worker.Volume != nil {
+			if machineType.Storage != nil {
+				volumeType = &gardencorev1beta1.VolumeType{
+					Class: machineType.Storage.Class,
+				}
+			} else {
+				for _, e := range cloudProfileSpec.VolumeTypes {
+					element := e
+					if worker.Volume.Type != nil && element.Name == *worker.Volume.Type {
+						volumeType = &element
+						break
+					}
+				}
+			}
+		}
+		if volumeType == nil {
+			return nil, fmt.Errorf("VolumeType %s not found in CloudProfile", worker.Machine.Type)
+		}
+
+		addQuantity(resources, core.QuotaMetricCPU, multiplyQuantity(machineType.CPU, worker.Maximum))
+		addQuantity(resources, core.QuotaMetricGPU, multiplyQuantity(machineType.GPU, worker.Maximum))
+		addQuantity(resources, core.QuotaMetricMemory, multiplyQuantity(machineType.Memory, worker.Maximum))
+
+		size := resource.MustParse("0Gi")
+		if worker.Volume != nil {
+			var err error
+			size, err = resource.ParseQuantity(worker.Volume.VolumeSize)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		switch volumeType.Class {
+		case gardencorev1beta1.VolumeClassStandard:
+			addQuantity(resources, core.QuotaMetricStorageStandard, multiplyQuantity(size, worker.Maximum))
+		case gardencorev1beta1.VolumeClassPremium:
+			addQuantity(resources, core.QuotaMetricStoragePremium, multiplyQuantity(size, worker.Maximum))
+		default:
+			return nil, fmt.Errorf("unknown volumeType class %s", volumeType.Class)
+		}
+	}
+
+	resources[core.QuotaMetricLoadbalancer] = *resource.NewQuantity(countLB, resource.DecimalSI)
+
+	failureToleranceType := v1beta1helper.GetFailureToleranceType(shoot)
+	resources[controlPlaneQuotaMetric(failureToleranceType)] = *resource.NewQuantity(1, resource.DecimalSI)
+
+	if quotaAccounting := cloudProfileSpec.QuotaAccounting; quotaAccounting != nil {
+		for _, controlPlane := range quotaAccounting.ControlPlanes {
+			if ptr.Equal(controlPlane.FailureToleranceType, failureToleranceType) {
+				for name, quantity := range controlPlane.Resources {
+					addQuantity(resources, name, quantity)
+				}
+			}
+		}
+
+		for name, quantity := range quotaAccounting.LoadBalancer {
+			addQuantity(resources, name, multiplyQuantity(quantity, int32(countLB)))
+		}
+	}
+
+	return resources, nil
+}
+
+// countLoadBalancers returns the number of load balancers deducible from the specification of the given Shoot, i.e.,
+// one for the Shoot itself plus one for the nginx-ingress addon if it is enabled. Workerless Shoots do not have any load
+// balancers since there are no nodes to which they could route the traffic.
+func countLoadBalancers(shoot *gardencorev1beta1.Shoot) int64 {
+	if v1beta1helper.IsWorkerless(shoot) {
+		return 0
+	}
+
+	count := int64(1)
+	if v1beta1helper.NginxIngressEnabled(shoot.Spec.Addons) {
+		count++
+	}
+	return count
+}
+
+func controlPlaneQuotaMetric(failureToleranceType *gardencorev1beta1.FailureToleranceType) corev1.ResourceName {
+	switch ptr.Deref(failureToleranceType, "") {
+	case gardencorev1beta1.FailureToleranceTypeNode:
+		return core.QuotaMetricControlPlaneHANode
+	case gardencorev1beta1.FailureToleranceTypeZone:
+		return core.QuotaMetricControlPlaneHAZone
+	default:
+		return core.QuotaMetricControlPlaneNonHA
+	}
+}
+
+// shootWorkersWithDefaultVolumes returns the workers of the given Shoot. Workers without a volume get the default
+// storage of their machine type from the CloudProfile.
+func shootWorkersWithDefaultVolumes(shoot *gardencorev1beta1.Shoot, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec) []gardencorev1beta1.Worker {
+	workers := make([]gardencorev1beta1.Worker, 0, len(shoot.Spec.Provider.Workers))
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		workerCopy := worker.DeepCopy()
+
+		if worker.Volume == nil {
+			for _, machineType := range cloudProfileSpec.MachineTypes {
+				if worker.Machine.Type == machineType.Name && machineType.Storage != nil && machineType.Storage.StorageSize != nil {
+					workerCopy.Volume = &gardencorev1beta1.Volume{
+						Type:       &machineType.Storage.Type,
+						VolumeSize: machineType.Storage.StorageSize.String(),
+					}
+				}
+			}
+		}
+
+		workers = append(workers, *workerCopy)
+	}
+
+	return workers
+}
+
+func addQuantity(resources corev1.ResourceList, name corev1.ResourceName, quantity resource.Quantity) {
+	sum := resources[name]
+	sum.Add(quantity)
+	resources[name] = sum
+}
+
+func multiplyQuantity(quantity resource.Quantity, multiplier int32) resource.Quantity {
+	res := resource.Quantity{}
+	for i := 0; i < int(multiplier); i++ {
+		res.Add(quantity)
+	}
+	return res
+}
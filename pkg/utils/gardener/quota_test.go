@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardener_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+var _ = Describe("Quota", func() {
+	Describe("#ComputeShootQuotaResources", func() {
+		var (
+			shoot            *gardencorev1beta1.Shoot
+			cloudProfileSpec *gardencorev1beta1.CloudProfileSpec
+		)
+
+		BeforeEach(func() {
+			cloudProfileSpec = &gardencorev1beta1.CloudProfileSpec{
+				MachineTypes: []gardencorev1beta1.MachineType{
+					{
+						Name:   "machine-type-1",
+						CPU:    resource.MustParse("2"),
+						GPU:    resource.MustParse("0"),
+						Memory: resource.MustParse("4Gi"),
+					},
+					{
+						Name:   "machine-type-2",
+						CPU:    resource.MustParse("4"),
+						GPU:    resource.MustParse("1"),
+						Memory: resource.MustParse("16Gi"),
+						Storage: &gardencorev1beta1.MachineTypeStorage{
+							Class:       gardencorev1beta1.VolumeClassPremium,
+							StorageSize: ptr.To(resource.MustParse("50Gi")),
+							Type:        "premium-disk",
+						},
+					},
+				},
+				VolumeTypes: []gardencorev1beta1.VolumeType{{
+					Name:  "standard-disk",
+					Class: gardencorev1beta1.VolumeClassStandard,
+				}},
+			}
+
+			shoot = &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Provider: gardencorev1beta1.Provider{
+						Workers: []gardencorev1beta1.Worker{
+							{
+								Name:    "worker-1",
+								Maximum: 3,
+								Machine: gardencorev1beta1.Machine{Type: "machine-type-1"},
+								Volume:  &gardencorev1beta1.Volume{Type: ptr.To("standard-disk"), VolumeSize: "20Gi"},
+							},
+							{
+								Name:    "worker-2",
+								Maximum: 2,
+								Machine: gardencorev1beta1.Machine{Type: "machine-type-2"},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should compute the resources for the maximum number of machines", func() {
+			resources, err := ComputeShootQuotaResources(shoot, cloudProfileSpec)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(resources).To(HaveLen(7))
+			Expect(resources.Cpu().Cmp(resource.MustParse("14"))).To(Equal(0))
+			Expect(resources.Name(core.QuotaMetricGPU, resource.DecimalSI).Cmp(resource.MustParse("2"))).To(Equal(0))
+			Expect(resources.Memory().Cmp(resource.MustParse("44Gi"))).To(Equal(0))
+			Expect(resources.Name(core.QuotaMetricStorageStandard, resource.BinarySI).Cmp(resource.MustParse("60Gi"))).To(Equal(0))
+			Expect(resources.Name(core.QuotaMetricStoragePremium, resource.BinarySI).Cmp(resource.MustParse("100Gi"))).To(Equal(0))
+			Expect(resources.Name(core.QuotaMetricLoadbalancer, resource.DecimalSI).Cmp(resource.MustParse("1"))).To(Equal(0))
+			Expect(resources.Name(core.QuotaMetricControlPlaneNonHA, resource.DecimalSI).Cmp(resource.MustParse("1"))).To(Equal(0))
+		})
+
+		It("should account the control plane tier and the resources of the quota accounting", func() {
+			shoot.Spec.ControlPlane = &gardencorev1beta1.ControlPlane{HighAvailability: &gardencorev1beta1.HighAvailability{FailureTolerance: gardencorev1beta1.FailureTolerance{Type: gardencorev1beta1.FailureToleranceTypeZone}}}
+			shoot.Spec.Addons = &gardencorev1beta1.Addons{NginxIngress: &gardencorev1beta1.NginxIngress{Addon: gardencorev1beta1.Addon{Enabled: true}}}
+			cloudProfileSpec.QuotaAccounting = &gardencorev1beta1.QuotaAccounting{
+				ControlPlanes: []gardencorev1beta1.ControlPlaneQuotaAccounting{
+					{Resources: corev1.ResourceList{"example.com/cost": resource.MustParse("10")}},
+					{FailureToleranceType: ptr.To(gardencorev1beta1.FailureToleranceTypeZone), Resources: corev1.ResourceList{"example.com/cost": resource.MustParse("30")}},
+				},
+				LoadBalancer: corev1.ResourceList{"example.com/cost": resource.MustParse("5")},
+			}
+
+			resources, err := ComputeShootQuotaResources(shoot, cloudProfileSpec)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(resources).NotTo(HaveKey(core.QuotaMetricControlPlaneNonHA))
+			Expect(resources.Name(core.QuotaMetricControlPlaneHAZone, resource.DecimalSI).Cmp(resource.MustParse("1"))).To(Equal(0))
+			Expect(resources.Name("example.com/cost", resource.DecimalSI).Cmp(resource.MustParse("40"))).To(Equal(0))
+		})
+
+		It("should account an additional load balancer for the nginx-ingress addon", func() {
+			shoot.Spec.Addons = &gardencorev1beta1.Addons{NginxIngress: &gardencorev1beta1.NginxIngress{Addon: gardencorev1beta1.Addon{Enabled: true}}}
+
+			resources, err := ComputeShootQuotaResources(shoot, cloudProfileSpec)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resources.Name(core.QuotaMetricLoadbalancer, resource.DecimalSI).Cmp(resource.MustParse("2"))).To(Equal(0))
+		})
+
+		It("should not account any load balancer for workerless shoots", func() {
+			shoot.Spec.Provider.Workers = nil
+			cloudProfileSpec.QuotaAccounting = &gardencorev1beta1.QuotaAccounting{
+				LoadBalancer: corev1.ResourceList{"example.com/cost": resource.MustParse("5")},
+			}
+
+			resources, err := ComputeShootQuotaResources(shoot, cloudProfileSpec)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resources.Name(core.QuotaMetricLoadbalancer, resource.DecimalSI).IsZero()).To(BeTrue())
+			Expect(resources.Name("example.com/cost", resource.DecimalSI).IsZero()).To(BeTrue())
+		})
+
+		It("should fail if the machine type is unknown", func() {
+			shoot.Spec.Provider.Workers[0].Machine.Type = "unknown"
+
+			_, err := ComputeShootQuotaResources(shoot, cloudProfileSpec)
+			Expect(err).To(MatchError("machineType unknown not found in CloudProfile"))
+		})
+
+		It("should fail if no cloud profile spec is given", func() {
+			_, err := ComputeShootQuotaResources(shoot, nil)
+			Expect(err).To(MatchError("no cloudprofile reference has been provided"))
+		})
+	})
+})
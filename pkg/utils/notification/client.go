@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notification
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// sharedAddressSpace is the network reserved for carrier-grade NAT (RFC 6598) which is commonly used for the pod and
+// service networks of clusters.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// internalHostSuffixes are suffixes of host names which are only resolvable inside a cluster or a local network.
+var internalHostSuffixes = []string{".localhost", ".local", ".internal", ".svc"}
+
+// NewHTTPClient returns an HTTP client for sending notifications to webhooks. Since webhook URLs are provided by project
+// members, the client does not follow redirects and refuses to connect to loopback, private, link-local, shared,
+// unspecified and multicast addresses as well as to the given blocked networks. The addresses are checked after name
+// resolution, i.e., host names resolving to such addresses are rejected as well.
+func NewHTTPClient(timeout time.Duration, blockCIDRs []string) (*http.Client, error) {
+	blocked := make([]netip.Prefix, 0, len(blockCIDRs))
+	for _, cidr := range blockCIDRs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("failed parsing blocked CIDR %q: %w", cidr, err)
+		}
+		blocked = append(blocked, prefix)
+	}
+
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			addr, err := netip.ParseAddr(host)
+			if err != nil {
+				return err
+			}
+			return checkAddress(addr, blocked)
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// Connections via a proxy would bypass the address checks of the dialer.
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}, nil
+}
+
+func checkAddress(addr netip.Addr, blocked []netip.Prefix) error {
+	addr = addr.Unmap()
+
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() || addr.IsUnspecified() || sharedAddressSpace.Contains(addr) {
+		return fmt.Errorf("connections to %s are not allowed", addr)
+	}
+
+	for _, prefix := range blocked {
+		if prefix.Contains(addr) {
+			return fmt.Errorf("connections to %s are not allowed because it is in blocked network %s", addr, prefix)
+		}
+	}
+
+	return nil
+}
+
+// ValidateWebhookHost returns an error if the given host of a webhook URL is an IP address or a host name which is only
+// resolvable inside a cluster or a local network.
+func ValidateWebhookHost(host string) error {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if _, err := netip.ParseAddr(host); err == nil {
+		return fmt.Errorf("must be a host name, IP addresses are not allowed")
+	}
+
+	if !strings.Contains(host, ".") {
+		return fmt.Errorf("must be a fully qualified host name")
+	}
+
+	for _, suffix := range internalHostSuffixes {
+		if strings.HasSuffix(host, suffix) {
+			return fmt.Errorf("must not be a cluster-internal or local host name")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notification_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/utils/notification"
+)
+
+var _ = Describe("Client", func() {
+	Describe("#NewHTTPClient", func() {
+		var client *http.Client
+
+		BeforeEach(func() {
+			var err error
+			client, err = NewHTTPClient(time.Second, []string{"203.0.113.0/24"})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail for invalid blocked CIDRs", func() {
+			_, err := NewHTTPClient(time.Second, []string{"foo"})
+			Expect(err).To(MatchError(ContainSubstring(`failed parsing blocked CIDR "foo"`)))
+		})
+
+		It("should refuse to connect to loopback addresses", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+			DeferCleanup(server.Close)
+
+			_, err := client.Get(server.URL)
+			Expect(err).To(MatchError(ContainSubstring("connections to 127.0.0.1 are not allowed")))
+		})
+
+		It("should refuse to connect to blocked networks", func() {
+			_, err := client.Get("https://203.0.113.1")
+			Expect(err).To(MatchError(ContainSubstring("connections to 203.0.113.1 are not allowed because it is in blocked network 203.0.113.0/24")))
+		})
+
+		It("should not follow redirects", func() {
+			Expect(client.CheckRedirect(nil, nil)).To(MatchError(http.ErrUseLastResponse))
+		})
+	})
+
+	DescribeTable("#ValidateWebhookHost",
+		func(host string, matcher OmegaMatcher) {
+			Expect(ValidateWebhookHost(host)).To(matcher)
+		},
+
+		Entry("public host name", "hooks.example.com", Succeed()),
+		Entry("public host name with trailing dot", "hooks.example.com.", Succeed()),
+		Entry("IPv4 address", "10.0.0.1", MatchError(ContainSubstring("IP addresses are not allowed"))),
+		Entry("IPv6 address", "::1", MatchError(ContainSubstring("IP addresses are not allowed"))),
+		Entry("single label", "kubernetes", MatchError(ContainSubstring("must be a fully qualified host name"))),
+		Entry("localhost", "localhost", MatchError(ContainSubstring("must be a fully qualified host name"))),
+		Entry("service", "foo.bar.svc", MatchError(ContainSubstring("cluster-internal"))),
+		Entry("cluster domain", "foo.bar.svc.cluster.local", MatchError(ContainSubstring("cluster-internal"))),
+		Entry("upper-case internal domain", "Metadata.Google.Internal", MatchError(ContainSubstring("cluster-internal"))),
+	)
+})
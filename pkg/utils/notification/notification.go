@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notification
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"text/template"
+	"time"
+)
+
+// Notification contains the information about an event which is sent to the sinks of notification subscriptions. It is
+// the data which payload templates are rendered with.
+type Notification struct {
+	// Project is the name of the project.
+	Project string `json:"project"`
+	// Namespace is the namespace of the project.
+	Namespace string `json:"namespace"`
+	// Kind is the kind of the object the event is about.
+	Kind string `json:"kind"`
+	// Name is the name of the object the event is about.
+	Name string `json:"name"`
+	// Type is the type of the event.
+	Type string `json:"type"`
+	// Reason is the reason of the event.
+	Reason string `json:"reason"`
+	// Message is the message of the event.
+	Message string `json:"message"`
+	// Count is the number of times the event occurred.
+	Count int32 `json:"count"`
+	// Timestamp is the point in time at which the event occurred most recently.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+var templateFuncs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+}
+
+// ParsePayloadTemplate parses the given payload template.
+func ParsePayloadTemplate(text string) (*template.Template, error) {
+	return template.New("payload").Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+}
+
+// Payload renders the payload for the given notification. If no template is given, the notification is rendered as
+// JSON object.
+func Payload(payloadTemplate *string, notification Notification) ([]byte, error) {
+	if payloadTemplate == nil {
+		return json.Marshal(notification)
+	}
+
+	tmpl, err := ParsePayloadTemplate(*payloadTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing payload template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, notification); err != nil {
+		return nil, fmt.Errorf("failed rendering payload template: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package notification_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/pkg/utils/notification"
+)
+
+var _ = Describe("Notification", func() {
+	var notification Notification
+
+	BeforeEach(func() {
+		notification = Notification{
+			Project:   "foo",
+			Namespace: "garden-foo",
+			Kind:      "Shoot",
+			Name:      "bar",
+			Type:      "Warning",
+			Reason:    "ReconcileError",
+			Message:   `Failed reconciling "bar"`,
+			Count:     2,
+			Timestamp: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+		}
+	})
+
+	Describe("#ParsePayloadTemplate", func() {
+		It("should parse templates using the json function", func() {
+			Expect(ParsePayloadTemplate(`{"text": {{ json .Message }}}`)).NotTo(BeNil())
+		})
+
+		It("should fail for invalid templates", func() {
+			_, err := ParsePayloadTemplate(`{{ .Message `)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#Payload", func() {
+		It("should render the notification as JSON object if no template is given", func() {
+			Expect(Payload(nil, notification)).To(MatchJSON(`{
+  "project": "foo",
+  "namespace": "garden-foo",
+  "kind": "Shoot",
+  "name": "bar",
+  "type": "Warning",
+  "reason": "ReconcileError",
+  "message": "Failed reconciling \"bar\"",
+  "count": 2,
+  "timestamp": "2026-10-18T12:00:00Z"
+}`))
+		})
+
+		It("should render the given template", func() {
+			Expect(Payload(ptr.To(`{"text": {{ json (printf "%s/%s: %s" .Namespace .Name .Message) }}}`), notification)).To(MatchJSON(`{"text": "garden-foo/bar: Failed reconciling \"bar\""}`))
+		})
+
+		It("should fail if the template refers to unknown fields", func() {
+			_, err := Payload(ptr.To(`{{ .Foo }}`), notification)
+			Expect(err).To(MatchError(ContainSubstring("failed rendering payload template")))
+		})
+	})
+})
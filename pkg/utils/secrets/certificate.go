@@ -5,6 +5,7 @@
 package secrets
 
 import (
+	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -66,6 +67,10 @@ type CertificateSecretConfig struct {
 	CertType  CertType
 	SigningCA *Certificate
 	PKCS      int
+	// Signer is the signer for the private key of the certificate in case the private key is kept outside of this
+	// process, e.g., in an external key management service. If set, no private key is generated, the certificate is
+	// issued for the public key of the signer, and the secret data does not contain a private key.
+	Signer crypto.Signer
 
 	Validity                          *time.Duration
 	SkipPublishingCACertificate       bool
@@ -84,6 +89,9 @@ type Certificate struct {
 
 	PrivateKey    *rsa.PrivateKey
 	PrivateKeyPEM []byte
+	// Signer signs certificates on behalf of this certificate authority in case its private key is not available,
+	// e.g., because it is kept in an external key management service. If set, it takes precedence over PrivateKey.
+	Signer crypto.Signer
 
 	Certificate    *x509.Certificate
 	CertificatePEM []byte
@@ -111,41 +119,55 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 
 	// If no cert type is given then we only return a certificate object that contains the CA.
 	if s.CertType != "" {
-		privateKey, err := GenerateKey(rand.Reader, 3072)
-		if err != nil {
-			return nil, err
-		}
-
 		var (
 			certificate       = s.generateCertificateTemplate()
 			certificateSigner = certificate
-			privateKeySigner  = privateKey
+			privateKey        *rsa.PrivateKey
+			publicKey         crypto.PublicKey
+			privateKeySigner  crypto.Signer
 		)
 
+		if s.Signer != nil {
+			publicKey, privateKeySigner = s.Signer.Public(), s.Signer
+		} else {
+			var err error
+			privateKey, err = GenerateKey(rand.Reader, 3072)
+			if err != nil {
+				return nil, err
+			}
+			publicKey, privateKeySigner = &privateKey.PublicKey, privateKey
+		}
+
 		if s.SigningCA != nil {
 			certificateSigner = s.SigningCA.Certificate
 			privateKeySigner = s.SigningCA.PrivateKey
+			if s.SigningCA.Signer != nil {
+				privateKeySigner = s.SigningCA.Signer
+			}
 		}
 
-		certificatePEM, err := signCertificate(certificate, privateKey, certificateSigner, privateKeySigner)
+		certificatePEM, err := signCertificate(certificate, publicKey, certificateSigner, privateKeySigner)
 		if err != nil {
 			return nil, err
 		}
 
 		var pk []byte
-		switch s.PKCS {
-		case PKCS1:
-			pk = utils.EncodePrivateKey(privateKey)
-		case PKCS8:
-			pk, err = utils.EncodePrivateKeyInPKCS8(privateKey)
-
-			if err != nil {
-				return nil, err
+		if privateKey != nil {
+			switch s.PKCS {
+			case PKCS1:
+				pk = utils.EncodePrivateKey(privateKey)
+			case PKCS8:
+				pk, err = utils.EncodePrivateKeyInPKCS8(privateKey)
+
+				if err != nil {
+					return nil, err
+				}
 			}
 		}
 
 		certificateObj.PrivateKey = privateKey
 		certificateObj.PrivateKeyPEM = pk
+		certificateObj.Signer = s.Signer
 		certificateObj.Certificate = certificate
 		certificateObj.CertificatePEM = certificatePEM
 	}
@@ -162,7 +184,9 @@ func (c *Certificate) SecretData() map[string][]byte {
 		// The certificate is a CA certificate itself, so we use different keys in the secret data (for backwards-
 		// compatibility).
 		data[DataKeyCertificateCA] = c.CertificatePEM
-		data[DataKeyPrivateKeyCA] = c.PrivateKeyPEM
+		if c.PrivateKeyPEM != nil {
+			data[DataKeyPrivateKeyCA] = c.PrivateKeyPEM
+		}
 
 	case c.CA != nil:
 		cert := c.CertificatePEM
@@ -173,7 +197,9 @@ func (c *Certificate) SecretData() map[string][]byte {
 		}
 
 		data[DataKeyCertificate] = cert
-		data[DataKeyPrivateKey] = c.PrivateKeyPEM
+		if c.PrivateKeyPEM != nil {
+			data[DataKeyPrivateKey] = c.PrivateKeyPEM
+		}
 		if !c.SkipPublishingCACertificate {
 			data[DataKeyCertificateCA] = c.CA.CertificatePEM
 		}
@@ -252,10 +278,10 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 }
 
 // SignCertificate takes a <certificateTemplate> and a <certificateTemplateSigner> which is used to sign
-// the first. It also requires the corresponding private keys of both certificates. The created certificate
-// is returned as byte slice.
-func signCertificate(certificateTemplate *x509.Certificate, privateKey *rsa.PrivateKey, certificateTemplateSigner *x509.Certificate, privateKeySigner *rsa.PrivateKey) ([]byte, error) {
-	certificate, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplateSigner, &privateKey.PublicKey, privateKeySigner)
+// the first. It also requires the public key of the first and the signer for the private key of the second
+// certificate. The created certificate is returned as byte slice.
+func signCertificate(certificateTemplate *x509.Certificate, publicKey crypto.PublicKey, certificateTemplateSigner *x509.Certificate, privateKeySigner crypto.Signer) ([]byte, error) {
+	certificate, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplateSigner, publicKey, privateKeySigner)
 	if err != nil {
 		return nil, err
 	}
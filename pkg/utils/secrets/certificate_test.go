@@ -5,9 +5,13 @@
 package secrets_test
 
 import (
+	"crypto"
+	"io"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/gardener/gardener/pkg/utils"
 	. "github.com/gardener/gardener/pkg/utils/secrets"
 )
 
@@ -37,6 +41,51 @@ var _ = Describe("Certificate Secrets", func() {
 				Expect(certificate.Certificate).NotTo(BeNil())
 				Expect(certificate.CA).To(BeNil())
 			})
+
+			It("should sign the certificate with the signer of the CA if set", func() {
+				ca, err := certificateConfig.GenerateCertificate()
+				Expect(err).NotTo(HaveOccurred())
+
+				signer := &countingSigner{Signer: ca.PrivateKey}
+				signingCA := &Certificate{Name: ca.Name, Certificate: ca.Certificate, CertificatePEM: ca.CertificatePEM, Signer: signer}
+
+				certificate, err := (&CertificateSecretConfig{
+					Name:       "server",
+					CommonName: "server",
+					CertType:   ServerCert,
+					SigningCA:  signingCA,
+				}).GenerateCertificate()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(signer.count).To(Equal(1))
+				caCert, err := utils.DecodeCertificate(ca.CertificatePEM)
+				Expect(err).NotTo(HaveOccurred())
+				cert, err := utils.DecodeCertificate(certificate.CertificatePEM)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cert.CheckSignatureFrom(caCert)).To(Succeed())
+			})
+
+			It("should issue the certificate for the signer if set", func() {
+				ca, err := certificateConfig.GenerateCertificate()
+				Expect(err).NotTo(HaveOccurred())
+
+				signer := &countingSigner{Signer: ca.PrivateKey}
+				certificateConfig.Signer = signer
+
+				certificate, err := certificateConfig.GenerateCertificate()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(signer.count).To(Equal(1))
+				Expect(certificate.PrivateKey).To(BeNil())
+				Expect(certificate.PrivateKeyPEM).To(BeNil())
+				Expect(certificate.Signer).To(Equal(signer))
+				cert, err := utils.DecodeCertificate(certificate.CertificatePEM)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ca.PrivateKey.PublicKey.Equal(cert.PublicKey)).To(BeTrue())
+				Expect(cert.CheckSignatureFrom(cert)).To(Succeed())
+				Expect(certificate.SecretData()).To(HaveKey("ca.crt"))
+				Expect(certificate.SecretData()).NotTo(HaveKey("ca.key"))
+			})
 		})
 	})
 
@@ -107,3 +156,13 @@ var _ = Describe("Certificate Secrets", func() {
 		})
 	})
 })
+
+type countingSigner struct {
+	crypto.Signer
+	count int
+}
+
+func (s *countingSigner) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	s.count++
+	return s.Signer.Sign(rand, digest, opts)
+}
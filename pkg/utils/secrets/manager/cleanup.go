@@ -6,6 +6,7 @@ package manager
 
 import (
 	"context"
+	"fmt"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -34,6 +35,15 @@ func (m *manager) Cleanup(ctx context.Context) error {
 		}
 
 		fns = append(fns, func(ctx context.Context) error {
+			// The private key is deleted first so that it is not leaked in case the deletion of the secret succeeds but
+			// the deletion of the private key fails.
+			if ref, ok := secret.Data[DataKeyPrivateKeyCAReference]; ok && m.privateKeyStore != nil {
+				m.logger.Info("Deleting private key of stale secret", "secret", client.ObjectKeyFromObject(&secret), "privateKeyReference", string(ref))
+				if err := m.privateKeyStore.Delete(ctx, string(ref)); err != nil {
+					return fmt.Errorf("failed deleting private key %q of secret %s: %w", string(ref), client.ObjectKeyFromObject(&secret), err)
+				}
+			}
+
 			m.logger.Info("Deleting stale secret", "secret", client.ObjectKeyFromObject(&secret))
 			return client.IgnoreNotFound(m.client.Delete(ctx, &secret))
 		})
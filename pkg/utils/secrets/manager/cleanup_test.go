@@ -108,6 +108,37 @@ var _ = Describe("Cleanup", func() {
 			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secretsInNamespace2[7]), &corev1.Secret{})).To(BeNotFoundError())
 		})
 
+		It("should delete the private keys of stale secrets from the private key store", func() {
+			privateKeyStore := NewFilePrivateKeyStore(GinkgoT().TempDir())
+			m.privateKeyStore = privateKeyStore
+
+			_, err := privateKeyStore.Generate(ctx, namespace+"/ca-current")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = privateKeyStore.Generate(ctx, namespace+"/ca-stale")
+			Expect(err).NotTo(HaveOccurred())
+
+			secrets := []*corev1.Secret{
+				{ObjectMeta: metav1.ObjectMeta{Name: "ca-current"}, Data: map[string][]byte{"ca.key.ref": []byte(namespace + "/ca-current")}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "ca-stale"}, Data: map[string][]byte{"ca.key.ref": []byte(namespace + "/ca-stale")}},
+			}
+			for _, secret := range secrets {
+				secret.Namespace = namespace
+				secret.Labels = map[string]string{"name": "ca", "managed-by": "secrets-manager", "manager-identity": testIdentity}
+				Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+			}
+			Expect(m.addToStore("ca", secrets[0], current)).To(Succeed())
+
+			Expect(m.Cleanup(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secrets[0]), &corev1.Secret{})).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secrets[1]), &corev1.Secret{})).To(BeNotFoundError())
+
+			_, err = privateKeyStore.Signer(ctx, namespace+"/ca-current")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = privateKeyStore.Signer(ctx, namespace+"/ca-stale")
+			Expect(err).To(MatchError(ContainSubstring("no such file or directory")))
+		})
+
 		It("should not touch secrets from other manager instance", func() {
 			secrets := secretList(testIdentity, "other")
 			for i := range secrets {
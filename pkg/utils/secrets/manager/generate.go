@@ -34,6 +34,10 @@ func (m *manager) Generate(ctx context.Context, config secretsutils.ConfigInterf
 		bundleFor = ptr.To(strings.TrimSuffix(config.GetName(), nameSuffixBundle))
 	}
 
+	if options.ExternalPrivateKey && m.privateKeyStore == nil {
+		return nil, fmt.Errorf("private key of config %s should be stored externally but no private key store is configured", config.GetName())
+	}
+
 	namespace := m.namespaces[0]
 	if len(options.Namespace) > 0 {
 		namespace = options.Namespace
@@ -63,7 +67,7 @@ func (m *manager) Generate(ctx context.Context, config secretsutils.ConfigInterf
 			return nil, fmt.Errorf("failed reading secret %s for config %s: %w", client.ObjectKeyFromObject(secret), config.GetName(), err)
 		}
 
-		secret, err = m.generateAndCreate(ctx, config, objectMeta)
+		secret, err = m.generateAndCreate(ctx, config, objectMeta, options)
 		if err != nil {
 			return nil, fmt.Errorf("failed generating and creating new secret %s for config %s: %w", client.ObjectKey{Name: objectMeta.Name, Namespace: objectMeta.Namespace}, config.GetName(), err)
 		}
@@ -98,12 +102,30 @@ func (m *manager) Generate(ctx context.Context, config secretsutils.ConfigInterf
 	return secret, nil
 }
 
-func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.ConfigInterface, objectMeta metav1.ObjectMeta) (*corev1.Secret, error) {
+func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.ConfigInterface, objectMeta metav1.ObjectMeta, options *GenerateOptions) (*corev1.Secret, error) {
 	// Use secret name as common name to make sure the x509 subject names in the CA certificates are always unique.
 	if certConfig := certificateSecretConfig(config); certConfig != nil && certConfig.CertType == secretsutils.CACert {
 		certConfig.CommonName = objectMeta.Name
 	}
 
+	if options.signingCAPrivateKeyReference != nil {
+		if err := m.delegateSigning(ctx, config, *options.signingCAPrivateKeyReference); err != nil {
+			return nil, fmt.Errorf("failed delegating signing to private key store: %w", err)
+		}
+	}
+
+	var privateKeyRef string
+	if options.ExternalPrivateKey {
+		// The private key is generated by the store before the secret gets created so that a secret never references a
+		// private key which does not exist.
+		privateKeyRef = privateKeyReference(objectMeta.Namespace, objectMeta.Name)
+		signer, err := m.privateKeyStore.Generate(ctx, privateKeyRef)
+		if err != nil {
+			return nil, fmt.Errorf("failed generating private key in private key store: %w", err)
+		}
+		certificateSecretConfig(config).Signer = signer
+	}
+
 	data, err := config.Generate()
 	if err != nil {
 		return nil, fmt.Errorf("failed generating data: %w", err)
@@ -114,6 +136,17 @@ func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.Con
 		return nil, fmt.Errorf("failed taking over data from existing secret when needed: %w", err)
 	}
 
+	if options.ExternalPrivateKey {
+		if dataMap[secretsutils.DataKeyPrivateKeyCA] != nil || dataMap[DataKeyPrivateKeyCAReference] != nil {
+			// The data of an existing secret was taken over, hence the generated private key is not needed.
+			if err := m.privateKeyStore.Delete(ctx, privateKeyRef); err != nil {
+				return nil, fmt.Errorf("failed deleting unused private key from private key store: %w", err)
+			}
+		} else {
+			dataMap[DataKeyPrivateKeyCAReference] = []byte(privateKeyRef)
+		}
+	}
+
 	secret := Secret(objectMeta, dataMap)
 	if err := m.client.Create(ctx, secret); err != nil {
 		if !apierrors.IsAlreadyExists(err) {
@@ -129,6 +162,20 @@ func (m *manager) generateAndCreate(ctx context.Context, config secretsutils.Con
 	return secret, nil
 }
 
+func (m *manager) delegateSigning(ctx context.Context, config secretsutils.ConfigInterface, ref string) error {
+	if m.privateKeyStore == nil {
+		return fmt.Errorf("private key %q of signing CA is stored externally but no private key store is configured", ref)
+	}
+
+	signer, err := m.privateKeyStore.Signer(ctx, ref)
+	if err != nil {
+		return err
+	}
+
+	certificateSecretConfig(config).SigningCA.Signer = signer
+	return nil
+}
+
 func (m *manager) keepExistingSecretsIfNeeded(ctx context.Context, configName string, newData map[string][]byte, namespace string) (map[string][]byte, error) {
 	existingSecrets := &corev1.SecretList{}
 	if err := m.client.List(ctx, existingSecrets, client.InNamespace(namespace), client.MatchingLabels{LabelKeyUseDataForName: configName}); err != nil {
@@ -391,9 +438,13 @@ type GenerateOptions struct {
 	IgnoreConfigChecksumForCASecretName bool
 	// Namespace overwrites the namespace in which the secret should be created.
 	Namespace string
+	// ExternalPrivateKey specifies whether the private key of a CA should be kept in the private key store of the
+	// manager instead of the secret.
+	ExternalPrivateKey bool
 
-	signingCAChecksum *string
-	isBundleSecret    bool
+	signingCAChecksum            *string
+	signingCAPrivateKeyReference *string
+	isBundleSecret               bool
 }
 
 type rotationStrategy string
@@ -491,9 +542,23 @@ func SignedByCA(name string, opts ...SignedByCAOption) GenerateOption {
 			}
 		}
 
-		ca, err := secretsutils.LoadCertificate(name, secret.obj.Data[secretsutils.DataKeyPrivateKeyCA], secret.obj.Data[secretsutils.DataKeyCertificateCA])
-		if err != nil {
-			return err
+		var ca *secretsutils.Certificate
+		if ref, ok := secret.obj.Data[DataKeyPrivateKeyCAReference]; ok {
+			// The private key of the CA is kept in the private key store, hence signing is delegated to it when the
+			// certificate gets generated.
+			certificate, err := utils.DecodeCertificate(secret.obj.Data[secretsutils.DataKeyCertificateCA])
+			if err != nil {
+				return err
+			}
+
+			ca = &secretsutils.Certificate{Name: name, Certificate: certificate, CertificatePEM: secret.obj.Data[secretsutils.DataKeyCertificateCA]}
+			options.signingCAPrivateKeyReference = ptr.To(string(ref))
+		} else {
+			var err error
+			ca, err = secretsutils.LoadCertificate(name, secret.obj.Data[secretsutils.DataKeyPrivateKeyCA], secret.obj.Data[secretsutils.DataKeyCertificateCA])
+			if err != nil {
+				return err
+			}
 		}
 
 		certificateConfig.SigningCA = ca
@@ -561,6 +626,20 @@ func IgnoreConfigChecksumForCASecretName() GenerateOption {
 	}
 }
 
+// ExternalPrivateKey returns a function which sets the 'ExternalPrivateKey' field to true. It can only be used for CA
+// certificate configurations. Note that it only applies to newly generated CAs, i.e., existing CAs keep their private
+// keys in the secrets until they are rotated.
+func ExternalPrivateKey() GenerateOption {
+	return func(_ Interface, config secretsutils.ConfigInterface, options *GenerateOptions) error {
+		if certificateConfig, ok := config.(*secretsutils.CertificateSecretConfig); !ok || certificateConfig.CertType != secretsutils.CACert {
+			return fmt.Errorf("could not apply option to %T, expected *secrets.CertificateSecretConfig for a CA", config)
+		}
+
+		options.ExternalPrivateKey = true
+		return nil
+	}
+}
+
 func isBundleSecret() GenerateOption {
 	return func(_ Interface, _ secretsutils.ConfigInterface, options *GenerateOptions) error {
 		options.isBundleSecret = true
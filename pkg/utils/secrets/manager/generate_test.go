@@ -23,6 +23,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/gardener/gardener/pkg/utils"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	"github.com/gardener/gardener/pkg/utils/test"
 )
@@ -966,6 +967,77 @@ var _ = Describe("Generate", func() {
 				Expect(secret).To(BeNil())
 			})
 		})
+
+		Context("with external private keys", func() {
+			var (
+				caName, serverName     = "ca", "server"
+				caConfig, serverConfig *secretsutils.CertificateSecretConfig
+				privateKeyStore        PrivateKeyStore
+			)
+
+			BeforeEach(func() {
+				caConfig = &secretsutils.CertificateSecretConfig{
+					Name:       caName,
+					CommonName: caName,
+					CertType:   secretsutils.CACert,
+				}
+				serverConfig = &secretsutils.CertificateSecretConfig{
+					Name:       serverName,
+					CommonName: serverName,
+					CertType:   secretsutils.ServerCert,
+				}
+
+				privateKeyStore = NewFilePrivateKeyStore(GinkgoT().TempDir())
+				mgr, err := New(ctx, logr.Discard(), fakeClock, fakeClient, identity, Config{PrivateKeyStore: privateKeyStore}, namespace)
+				Expect(err).NotTo(HaveOccurred())
+				m = mgr.(*manager)
+			})
+
+			It("should keep the private key of the CA in the private key store and delegate signing to it", func() {
+				By("Generate new CA secret")
+				caSecret, err := m.Generate(ctx, caConfig, ExternalPrivateKey())
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, caSecret)
+				Expect(caSecret.Data).To(HaveKey("ca.crt"))
+				Expect(caSecret.Data).NotTo(HaveKey("ca.key"))
+				Expect(caSecret.Data).To(HaveKeyWithValue("ca.key.ref", []byte(namespace+"/"+caSecret.Name)))
+
+				signer, err := privateKeyStore.Signer(ctx, namespace+"/"+caSecret.Name)
+				Expect(err).NotTo(HaveOccurred())
+				caCertificate, err := utils.DecodeCertificate(caSecret.Data["ca.crt"])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(signer.Public()).To(Equal(caCertificate.PublicKey))
+
+				By("Verify bundle secret was generated")
+				secretInfos, found := m.getFromStore(caName)
+				Expect(found).To(BeTrue())
+				Expect(secretInfos.bundle).NotTo(BeNil())
+
+				By("Generate new server secret")
+				serverSecret, err := m.Generate(ctx, serverConfig, SignedByCA(caName))
+				Expect(err).NotTo(HaveOccurred())
+				expectSecretWasCreated(ctx, fakeClient, serverSecret)
+
+				serverCertificate, err := utils.DecodeCertificate(serverSecret.Data["tls.crt"])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(serverCertificate.CheckSignatureFrom(caCertificate)).To(Succeed())
+			})
+
+			It("should fail if no private key store is configured", func() {
+				mgr, err := New(ctx, logr.Discard(), fakeClock, fakeClient, identity, Config{}, namespace)
+				Expect(err).NotTo(HaveOccurred())
+
+				secret, err := mgr.Generate(ctx, caConfig, ExternalPrivateKey())
+				Expect(err).To(MatchError(ContainSubstring("no private key store is configured")))
+				Expect(secret).To(BeNil())
+			})
+
+			It("should fail if the config is not for a CA", func() {
+				secret, err := m.Generate(ctx, serverConfig, ExternalPrivateKey())
+				Expect(err).To(MatchError(ContainSubstring("expected *secrets.CertificateSecretConfig for a CA")))
+				Expect(secret).To(BeNil())
+			})
+		})
 	})
 })
 
@@ -79,6 +79,7 @@ type (
 		namespaces                  []string
 		identity                    string
 		lastRotationInitiationTimes nameToUnixTime
+		privateKeyStore             PrivateKeyStore
 	}
 
 	nameToUnixTime map[string]string
@@ -102,6 +103,9 @@ type (
 		// SecretNamesToTimes is a map whose keys are secret names and whose values are the last rotation initiation
 		// times.
 		SecretNamesToTimes map[string]time.Time
+		// PrivateKeyStore stores the private keys of CAs generated with the ExternalPrivateKey option outside the
+		// cluster. If not set, all private keys are kept in the secrets.
+		PrivateKeyStore PrivateKeyStore
 	}
 )
 
@@ -140,6 +144,7 @@ func New(
 		namespaces:                  namespaces,
 		identity:                    identity,
 		lastRotationInitiationTimes: make(nameToUnixTime),
+		privateKeyStore:             rotation.PrivateKeyStore,
 	}
 
 	if err := m.initialize(ctx, rotation); err != nil {
@@ -387,7 +392,7 @@ func unixTime(in time.Time) string {
 }
 
 func isCASecret(data map[string][]byte) bool {
-	return data[secretsutils.DataKeyCertificateCA] != nil && data[secretsutils.DataKeyPrivateKeyCA] != nil
+	return data[secretsutils.DataKeyCertificateCA] != nil && (data[secretsutils.DataKeyPrivateKeyCA] != nil || data[DataKeyPrivateKeyCAReference] != nil)
 }
 
 func certificateSecretConfig(config secretsutils.ConfigInterface) *secretsutils.CertificateSecretConfig {
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/gardener/gardener/pkg/utils"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+// DataKeyPrivateKeyCAReference is the key in the data of a CA secret holding the reference to its private key in the
+// PrivateKeyStore. It replaces the private key itself if the CA was generated with the ExternalPrivateKey option.
+const DataKeyPrivateKeyCAReference = "ca.key.ref"
+
+// PrivateKeyStore keeps private keys of certificate authorities outside the cluster, e.g., in a transit-style secrets
+// engine of an external key management service or in a hardware security module. The private keys are generated by the
+// store and never leave it, certificates are signed by the signers returned by the store.
+type PrivateKeyStore interface {
+	// Generate generates a new private key under the given reference and returns a signer for it. An existing private
+	// key is replaced.
+	Generate(ctx context.Context, ref string) (crypto.Signer, error)
+	// Signer returns a signer for the private key stored under the given reference.
+	Signer(ctx context.Context, ref string) (crypto.Signer, error)
+	// Delete deletes the private key stored under the given reference. It does not fail if it does not exist.
+	Delete(ctx context.Context, ref string) error
+}
+
+// privateKeyReference returns the reference under which the private key of the given CA secret is stored.
+func privateKeyReference(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+type filePrivateKeyStore struct {
+	dir string
+}
+
+var _ PrivateKeyStore = &filePrivateKeyStore{}
+
+// NewFilePrivateKeyStore returns a PrivateKeyStore which keeps the private keys in files below the given directory.
+// It is a stand-in for an external key management service, e.g., in tests or local setups.
+func NewFilePrivateKeyStore(dir string) PrivateKeyStore {
+	return &filePrivateKeyStore{dir: dir}
+}
+
+func (f *filePrivateKeyStore) Generate(_ context.Context, ref string) (crypto.Signer, error) {
+	path, err := f.path(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := secretsutils.GenerateKey(rand.Reader, 3072)
+	if err != nil {
+		return nil, fmt.Errorf("failed generating private key %q: %w", ref, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("failed creating directory for private key %q: %w", ref, err)
+	}
+	if err := os.WriteFile(path, utils.EncodePrivateKey(privateKey), 0600); err != nil {
+		return nil, fmt.Errorf("failed writing private key %q: %w", ref, err)
+	}
+	return privateKey, nil
+}
+
+func (f *filePrivateKeyStore) Signer(_ context.Context, ref string) (crypto.Signer, error) {
+	path, err := f.path(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeyPEM, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading private key %q: %w", ref, err)
+	}
+	return utils.DecodePrivateKey(privateKeyPEM)
+}
+
+func (f *filePrivateKeyStore) Delete(_ context.Context, ref string) error {
+	path, err := f.path(ref)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (f *filePrivateKeyStore) path(ref string) (string, error) {
+	if !filepath.IsLocal(filepath.FromSlash(ref)) {
+		return "", fmt.Errorf("invalid private key reference %q", ref)
+	}
+	return filepath.Join(f.dir, filepath.FromSlash(ref)+".key"), nil
+}
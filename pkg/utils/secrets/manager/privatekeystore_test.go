@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+var _ = Describe("PrivateKeyStore", func() {
+	Describe("#NewFilePrivateKeyStore", func() {
+		var (
+			ctx = context.TODO()
+			ref = "shoot--foo--bar/ca"
+
+			dir             string
+			privateKeyStore PrivateKeyStore
+		)
+
+		BeforeEach(func() {
+			dir = GinkgoT().TempDir()
+			privateKeyStore = NewFilePrivateKeyStore(dir)
+		})
+
+		It("should generate the private key and return a signer for it", func() {
+			generatedSigner, err := privateKeyStore.Generate(ctx, ref)
+			Expect(err).NotTo(HaveOccurred())
+
+			info, err := os.Stat(filepath.Join(dir, "shoot--foo--bar", "ca.key"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+			privateKeyPEM, err := os.ReadFile(filepath.Join(dir, "shoot--foo--bar", "ca.key"))
+			Expect(err).NotTo(HaveOccurred())
+			privateKey, err := utils.DecodePrivateKey(privateKeyPEM)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(generatedSigner.Public()).To(Equal(privateKey.Public()))
+
+			signer, err := privateKeyStore.Signer(ctx, ref)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(signer.Public()).To(Equal(generatedSigner.Public()))
+		})
+
+		It("should delete the private key", func() {
+			_, err := privateKeyStore.Generate(ctx, ref)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(privateKeyStore.Delete(ctx, ref)).To(Succeed())
+			_, err = privateKeyStore.Signer(ctx, ref)
+			Expect(err).To(MatchError(ContainSubstring("no such file or directory")))
+
+			By("Delete again")
+			Expect(privateKeyStore.Delete(ctx, ref)).To(Succeed())
+		})
+
+		It("should reject references outside of the directory", func() {
+			_, err := privateKeyStore.Generate(ctx, "../ca")
+			Expect(err).To(MatchError(`invalid private key reference "../ca"`))
+		})
+	})
+})
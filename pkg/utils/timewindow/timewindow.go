@@ -222,6 +222,21 @@ func (m *MaintenanceTimeWindow) RandomDurationUntilNext(from time.Time, shiftBeg
 	return time.Duration(int64(begin.Sub(from)) + RandomFunc(0, delta.Nanoseconds()))
 }
 
+// NextBegin computes the begin of the next maintenance time window.
+// The <from> parameter is the time from which the calculation for the next maintenance time window shall be performed
+// (typically: time.Now()). If <from> is already inside the maintenance time window, the begin of the following time
+// window is returned.
+func (m *MaintenanceTimeWindow) NextBegin(from time.Time) time.Time {
+	from = from.UTC()
+
+	begin := m.AdjustedBegin(from)
+	if begin.Before(from) {
+		begin = begin.AddDate(0, 0, 1)
+	}
+
+	return begin
+}
+
 // Duration returns the duration of the maintenance time window.
 func (m *MaintenanceTimeWindow) Duration() time.Duration {
 	var (
@@ -9,6 +9,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
+	"slices"
+	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -18,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/warning"
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -34,16 +38,6 @@ import (
 	plugin "github.com/gardener/gardener/plugin/pkg"
 )
 
-var (
-	quotaMetricNames = [6]corev1.ResourceName{
-		core.QuotaMetricCPU,
-		core.QuotaMetricGPU,
-		core.QuotaMetricMemory,
-		core.QuotaMetricStorageStandard,
-		core.QuotaMetricStoragePremium,
-		core.QuotaMetricLoadbalancer}
-)
-
 // Register registers a plugin.
 func Register(plugins *admission.Plugins) {
 	plugins.Register(plugin.PluginNameShootQuotaValidator, func(_ io.Reader) (admission.Interface, error) {
@@ -140,7 +134,7 @@ func (q *QuotaValidator) ValidateInitialization() error {
 var _ admission.ValidationInterface = (*QuotaValidator)(nil)
 
 // Validate checks that the requested Shoot resources do not exceed the quota limits.
-func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+func (q *QuotaValidator) Validate(ctx context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
 	// Wait until the caches have been synced
 	if q.readyFunc == nil {
 		q.AssignReadyFunc(func() bool {
@@ -229,16 +223,31 @@ func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ a
 		}
 
 		if checkQuota {
-			exceededMetrics, err := q.isQuotaExceeded(*shoot, *quota)
+			exceededMetrics, exceededSoftMetrics, err := q.isQuotaExceeded(*shoot, *quota)
 			if err != nil {
 				return apierrors.NewInternalError(err)
 			}
-			if exceededMetrics != nil {
+			if len(exceededMetrics) > 0 {
 				message := ""
-				for _, metric := range *exceededMetrics {
+				for _, metric := range exceededMetrics {
 					message = message + metric.String() + " "
 				}
-				return admission.NewForbidden(a, fmt.Errorf("quota limits exceeded. Unable to allocate further %s", message))
+
+				if quota.Spec.GracePeriod == nil {
+					return admission.NewForbidden(a, fmt.Errorf("quota limits exceeded. Unable to allocate further %s", message))
+				}
+
+				gracePeriodEnd, withinGracePeriod := computeGracePeriodEnd(quota, q.time.Now())
+				if !withinGracePeriod {
+					return admission.NewForbidden(a, fmt.Errorf("quota limits exceeded and grace period ended at %s. Unable to allocate further %s", gracePeriodEnd.UTC().Format(time.RFC3339), message))
+				}
+				warning.AddWarning(ctx, "", fmt.Sprintf("limits of Quota %s/%s exceeded for %s, further allocations will be rejected after the grace period ends at %s", quota.Namespace, quota.Name, strings.TrimSpace(message), gracePeriodEnd.UTC().Format(time.RFC3339)))
+			} else if len(exceededSoftMetrics) > 0 {
+				message := ""
+				for _, metric := range exceededSoftMetrics {
+					message = message + metric.String() + " "
+				}
+				warning.AddWarning(ctx, "", fmt.Sprintf("soft limits of Quota %s/%s exceeded for %s", quota.Namespace, quota.Name, strings.TrimSpace(message)))
 			}
 		}
 	}
@@ -264,29 +273,40 @@ func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ a
 	return nil
 }
 
-func (q *QuotaValidator) isQuotaExceeded(shoot core.Shoot, quota gardencorev1beta1.Quota) (*[]corev1.ResourceName, error) {
+func (q *QuotaValidator) isQuotaExceeded(shoot core.Shoot, quota gardencorev1beta1.Quota) ([]corev1.ResourceName, []corev1.ResourceName, error) {
 	allocatedResources, err := q.determineAllocatedResources(quota, shoot)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	requiredResources, err := q.determineRequiredResources(allocatedResources, shoot)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	exceededMetrics := make([]corev1.ResourceName, 0)
-	for _, metric := range quotaMetricNames {
-		if _, ok := quota.Spec.Metrics[metric]; !ok {
-			continue
-		}
-		if !hasSufficientQuota(quota.Spec.Metrics[metric], requiredResources[metric]) {
+	return exceededQuotaMetrics(quota.Spec.Metrics, requiredResources), exceededQuotaMetrics(quota.Spec.SoftMetrics, requiredResources), nil
+}
+
+func exceededQuotaMetrics(limits, requiredResources corev1.ResourceList) []corev1.ResourceName {
+	var exceededMetrics []corev1.ResourceName
+	for _, metric := range slices.Sorted(maps.Keys(limits)) {
+		if !hasSufficientQuota(limits[metric], requiredResources[metric]) {
 			exceededMetrics = append(exceededMetrics, metric)
 		}
 	}
-	if len(exceededMetrics) != 0 {
-		return &exceededMetrics, nil
+	return exceededMetrics
+}
+
+// computeGracePeriodEnd computes the end of the grace period of the given Quota and returns whether it is still
+// running. The grace period starts when the limits are exceeded for the first time. If the quota controller has not
+// observed the exceeded limits yet, the grace period is considered to start now.
+func computeGracePeriodEnd(quota *gardencorev1beta1.Quota, now time.Time) (time.Time, bool) {
+	start := now
+	if quota.Status.LimitsExceededSince != nil {
+		start = quota.Status.LimitsExceededSince.Time
 	}
-	return nil, nil
+
+	end := start.Add(quota.Spec.GracePeriod.Duration)
+	return end, now.Before(end)
 }
 
 func (q *QuotaValidator) determineAllocatedResources(quota gardencorev1beta1.Quota, shoot core.Shoot) (corev1.ResourceList, error) {
@@ -303,8 +323,8 @@ func (q *QuotaValidator) determineAllocatedResources(quota gardencorev1beta1.Quo
 			return nil, err
 		}
 
-		for _, metric := range quotaMetricNames {
-			allocatedResources[metric] = sumQuantity(allocatedResources[metric], shootResources[metric])
+		for metric, quantity := range shootResources {
+			allocatedResources[metric] = sumQuantity(allocatedResources[metric], quantity)
 		}
 	}
 
@@ -398,9 +418,9 @@ func (q *QuotaValidator) determineRequiredResources(allocatedResources corev1.Re
 		return nil, err
 	}
 
-	requiredResources := make(corev1.ResourceList)
-	for _, metric := range quotaMetricNames {
-		requiredResources[metric] = sumQuantity(allocatedResources[metric], shootResources[metric])
+	requiredResources := allocatedResources.DeepCopy()
+	for metric, quantity := range shootResources {
+		requiredResources[metric] = sumQuantity(requiredResources[metric], quantity)
 	}
 	return requiredResources, nil
 }
@@ -410,108 +430,13 @@ func (q *QuotaValidator) getShootResources(shoot core.Shoot) (corev1.ResourceLis
 	if err != nil {
 		return nil, apierrors.NewInternalError(fmt.Errorf("could not find referenced cloud profile: %+v", err.Error()))
 	}
-	if cloudProfileSpec == nil {
-		return nil, fmt.Errorf("no cloudprofile reference has been provided")
-	}
-
-	var (
-		countLB      int64 = 1
-		resources          = make(corev1.ResourceList)
-		workers            = getShootWorkerResources(&shoot, cloudProfileSpec)
-		machineTypes       = cloudProfileSpec.MachineTypes
-		volumeTypes        = cloudProfileSpec.VolumeTypes
-	)
-
-	for _, worker := range workers {
-		var (
-			machineType *gardencorev1beta1.MachineType
-			volumeType  *gardencorev1beta1.VolumeType
-		)
-
-		// Get the proper machineType
-		for _, e := range machineTypes {
-			element := e
-			if element.Name == worker.Machine.Type {
-				machineType = &element
-				break
-			}
-		}
-		if machineType == nil {
-			return nil, fmt.Errorf("machineType %s not found in CloudProfile", worker.Machine.Type)
-		}
-
-		if worker.Volume != nil {
-			if machineType.Storage != nil {
-				volumeType = &gardencorev1beta1.VolumeType{
-					Class: machineType.Storage.Class,
-				}
-			} else {
-				// Get the proper VolumeType
-				for _, e := range volumeTypes {
-					element := e
-					if worker.Volume.Type != nil && element.Name == *worker.Volume.Type {
-						volumeType = &element
-						break
-					}
-				}
-			}
-		}
-		if volumeType == nil {
-			return nil, fmt.Errorf("VolumeType %s not found in CloudProfile", worker.Machine.Type)
-		}
-
-		// For now we always use the max. amount of resources for quota calculation
-		resources[core.QuotaMetricCPU] = sumQuantity(resources[core.QuotaMetricCPU], multiplyQuantity(machineType.CPU, worker.Maximum))
-		resources[core.QuotaMetricGPU] = sumQuantity(resources[core.QuotaMetricGPU], multiplyQuantity(machineType.GPU, worker.Maximum))
-		resources[core.QuotaMetricMemory] = sumQuantity(resources[core.QuotaMetricMemory], multiplyQuantity(machineType.Memory, worker.Maximum))
-
-		size, _ := resource.ParseQuantity("0Gi")
-		if worker.Volume != nil {
-			size, err = resource.ParseQuantity(worker.Volume.VolumeSize)
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		switch volumeType.Class {
-		case core.VolumeClassStandard:
-			resources[core.QuotaMetricStorageStandard] = sumQuantity(resources[core.QuotaMetricStorageStandard], multiplyQuantity(size, worker.Maximum))
-		case core.VolumeClassPremium:
-			resources[core.QuotaMetricStoragePremium] = sumQuantity(resources[core.QuotaMetricStoragePremium], multiplyQuantity(size, worker.Maximum))
-		default:
-			return nil, fmt.Errorf("unknown volumeType class %s", volumeType.Class)
-		}
-	}
-
-	if helper.NginxIngressEnabled(shoot.Spec.Addons) {
-		countLB++
-	}
-	resources[core.QuotaMetricLoadbalancer] = *resource.NewQuantity(countLB, resource.DecimalSI)
-
-	return resources, nil
-}
-
-func getShootWorkerResources(shoot *core.Shoot, cloudProfile *gardencorev1beta1.CloudProfileSpec) []core.Worker {
-	workers := make([]core.Worker, 0, len(shoot.Spec.Provider.Workers))
 
-	for _, worker := range shoot.Spec.Provider.Workers {
-		workerCopy := worker.DeepCopy()
-
-		if worker.Volume == nil {
-			for _, machineType := range cloudProfile.MachineTypes {
-				if worker.Machine.Type == machineType.Name && machineType.Storage != nil && machineType.Storage.StorageSize != nil {
-					workerCopy.Volume = &core.Volume{
-						Type:       &machineType.Storage.Type,
-						VolumeSize: machineType.Storage.StorageSize.String(),
-					}
-				}
-			}
-		}
-
-		workers = append(workers, *workerCopy)
+	v1beta1Shoot := &gardencorev1beta1.Shoot{}
+	if err := gardencorev1beta1.Convert_core_Shoot_To_v1beta1_Shoot(&shoot, v1beta1Shoot, nil); err != nil {
+		return nil, apierrors.NewInternalError(err)
 	}
 
-	return workers
+	return gardenerutils.ComputeShootQuotaResources(v1beta1Shoot, cloudProfileSpec)
 }
 
 func lifetimeVerificationNeeded(new, old core.Shoot) bool {
@@ -562,11 +487,3 @@ func sumQuantity(values ...resource.Quantity) resource.Quantity {
 	}
 	return res
 }
-
-func multiplyQuantity(quantity resource.Quantity, multiplier int32) resource.Quantity {
-	res := resource.Quantity{}
-	for i := 0; i < int(multiplier); i++ {
-		res.Add(quantity)
-	}
-	return res
-}
@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/warning"
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -339,6 +340,29 @@ var _ = Describe("quotavalidator", func() {
 				Expect(err).To(HaveOccurred())
 			})
 
+			It("should fail because the quota does not allow further highly available control planes", func() {
+				shoot.Spec.ControlPlane = &core.ControlPlane{HighAvailability: &core.HighAvailability{FailureTolerance: core.FailureTolerance{Type: core.FailureToleranceTypeZone}}}
+				quotaProject.Spec.Metrics[core.QuotaMetricControlPlaneHAZone] = resource.MustParse("0")
+				Expect(coreInformerFactory.Core().V1beta1().Quotas().Informer().GetStore().Add(&quotaProject)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring("Unable to allocate further controlplane.ha.zone")))
+			})
+
+			It("should fail because the resources of the quota accounting exceed a custom metric", func() {
+				cloudProfile.Spec.QuotaAccounting = &gardencorev1beta1.QuotaAccounting{
+					LoadBalancer: corev1.ResourceList{"example.com/cost": resource.MustParse("5")},
+				}
+				Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+				quotaProject.Spec.Metrics["example.com/cost"] = resource.MustParse("8")
+				Expect(coreInformerFactory.Core().V1beta1().Quotas().Informer().GetStore().Add(&quotaProject)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring("Unable to allocate further example.com/cost")))
+			})
+
 			It("should pass because can update non worker property although quota is exceeded", func() {
 				oldShoot = *shoot.DeepCopy()
 				quotaProject.Spec.Metrics[core.QuotaMetricCPU] = resource.MustParse("1")
@@ -379,6 +403,69 @@ var _ = Describe("quotavalidator", func() {
 					Expect(err.Error()).To(ContainSubstring("quota limits exceeded"))
 				})
 			})
+
+			Context("soft limits and grace period", func() {
+				var (
+					now      time.Time
+					recorder *warningRecorder
+					ctx      context.Context
+				)
+
+				BeforeEach(func() {
+					now = time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+					recorder = &warningRecorder{}
+					ctx = warning.WithWarningRecorder(context.TODO(), recorder)
+				})
+
+				It("should pass with a warning because a soft limit is exceeded", func() {
+					quotaProject.Spec.SoftMetrics = corev1.ResourceList{core.QuotaMetricCPU: resource.MustParse("1")}
+					Expect(coreInformerFactory.Core().V1beta1().Quotas().Informer().GetStore().Add(&quotaProject)).To(Succeed())
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+					Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+					Expect(recorder.warnings).To(ConsistOf("soft limits of Quota trial/project-quota exceeded for cpu"))
+				})
+
+				It("should pass with a warning because the grace period starts with the first request exceeding the limits", func() {
+					shoot.Spec.Provider.Workers[0].Maximum = 2
+					quotaProject.Spec.GracePeriod = &metav1.Duration{Duration: time.Hour}
+					quotaProject.Status = gardencorev1beta1.QuotaStatus{}
+					Expect(coreInformerFactory.Core().V1beta1().Quotas().Informer().GetStore().Add(&quotaProject)).To(Succeed())
+					timeOps.EXPECT().Now().Return(now)
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+					Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+					Expect(recorder.warnings).To(ConsistOf(ContainSubstring("grace period ends at 2026-10-18T13:00:00Z")))
+				})
+
+				It("should pass with a warning because the grace period has not ended yet", func() {
+					shoot.Spec.Provider.Workers[0].Maximum = 2
+					quotaProject.Spec.GracePeriod = &metav1.Duration{Duration: time.Hour}
+					quotaProject.Status.LimitsExceededSince = &metav1.Time{Time: now.Add(-30 * time.Minute)}
+					Expect(coreInformerFactory.Core().V1beta1().Quotas().Informer().GetStore().Add(&quotaProject)).To(Succeed())
+					timeOps.EXPECT().Now().Return(now)
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+					Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+					Expect(recorder.warnings).To(ConsistOf(ContainSubstring("grace period ends at 2026-10-18T12:30:00Z")))
+				})
+
+				It("should fail because the grace period has ended", func() {
+					shoot.Spec.Provider.Workers[0].Maximum = 2
+					quotaProject.Spec.GracePeriod = &metav1.Duration{Duration: time.Hour}
+					quotaProject.Status.LimitsExceededSince = &metav1.Time{Time: now.Add(-2 * time.Hour)}
+					Expect(coreInformerFactory.Core().V1beta1().Quotas().Informer().GetStore().Add(&quotaProject)).To(Succeed())
+					timeOps.EXPECT().Now().Return(now)
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+					Expect(admissionHandler.Validate(ctx, attrs, nil)).To(MatchError(ContainSubstring("quota limits exceeded and grace period ended at 2026-10-18T11:00:00Z")))
+					Expect(recorder.warnings).To(BeEmpty())
+				})
+			})
 		})
 
 		Context("tests for Quota validation corner cases", func() {
@@ -489,3 +576,11 @@ var _ = Describe("quotavalidator", func() {
 		})
 	})
 })
+
+type warningRecorder struct {
+	warnings []string
+}
+
+func (r *warningRecorder) AddWarning(_, text string) {
+	r.warnings = append(r.warnings, text)
+}
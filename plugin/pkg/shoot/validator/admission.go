@@ -389,6 +389,10 @@ func (c *validationContext) validateProjectMembership(a admission.Attributes) er
 		return admission.NewForbidden(a, fmt.Errorf("cannot create shoot '%s' in project '%s' that is already marked for deletion", c.shoot.Name, c.project.Name))
 	}
 
+	if c.project.Status.ArchivedTimestamp != nil {
+		return admission.NewForbidden(a, fmt.Errorf("cannot create shoot '%s' in project '%s' that is archived", c.shoot.Name, c.project.Name))
+	}
+
 	return nil
 }
 
@@ -623,6 +627,11 @@ func (c *validationContext) validateShootHibernation(a admission.Attributes) err
 	oldIsHibernated := c.oldShoot.Spec.Hibernation != nil && c.oldShoot.Spec.Hibernation.Enabled != nil && *c.oldShoot.Spec.Hibernation.Enabled
 	newIsHibernated := c.shoot.Spec.Hibernation != nil && c.shoot.Spec.Hibernation.Enabled != nil && *c.shoot.Spec.Hibernation.Enabled
 
+	// Prevent Shoots of archived projects from being woken up.
+	if oldIsHibernated && !newIsHibernated && c.project.Status.ArchivedTimestamp != nil {
+		return admission.NewForbidden(a, fmt.Errorf("cannot wake up shoot '%s' in project '%s' that is archived", c.shoot.Name, c.project.Name))
+	}
+
 	if !oldIsHibernated && newIsHibernated {
 		if hibernationConstraint := helper.GetCondition(c.shoot.Status.Constraints, core.ShootHibernationPossible); hibernationConstraint != nil {
 			if hibernationConstraint.Status != core.ConditionTrue {
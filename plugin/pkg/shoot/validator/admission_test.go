@@ -392,6 +392,22 @@ var _ = Describe("validator", func() {
 				Expect(err.Error()).To(ContainSubstring("already marked for deletion"))
 			})
 
+			It("should reject create operations on Shoot resources in projects which are archived", func() {
+				project.Status.ArchivedTimestamp = &metav1.Time{Time: time.Now()}
+
+				Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+				Expect(securityInformerFactory.Security().V1alpha1().CredentialsBindings().Informer().GetStore().Add(&credentialsBinding)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				err := admissionHandler.Validate(ctx, attrs, nil)
+
+				Expect(err).To(BeForbiddenError())
+				Expect(err.Error()).To(ContainSubstring("that is archived"))
+			})
+
 			It("should reject Shoot resources with not fulfilling the length constraints", func() {
 				tooLongName := "too-long-namespace"
 				project.ObjectMeta = metav1.ObjectMeta{
@@ -542,6 +558,29 @@ var _ = Describe("validator", func() {
 				}, And(HaveOccurred(), MatchError(ContainSubstring("foo")))),
 				Entry("should allow if unset", []core.Condition{}, Not(HaveOccurred())),
 			)
+
+			DescribeTable("should allow/deny waking up the Shoot according to the archival of the project",
+				func(archived bool, match types.GomegaMatcher) {
+					if archived {
+						project.Status.ArchivedTimestamp = &metav1.Time{Time: time.Now()}
+					}
+
+					Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+					Expect(securityInformerFactory.Security().V1alpha1().CredentialsBindings().Informer().GetStore().Add(&credentialsBinding)).To(Succeed())
+
+					oldShoot.Spec.Hibernation.Enabled = ptr.To(true)
+					shoot.Spec.Hibernation.Enabled = ptr.To(false)
+
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+					err := admissionHandler.Validate(ctx, attrs, nil)
+					Expect(err).To(match)
+				},
+				Entry("should allow if the project is not archived", false, Not(HaveOccurred())),
+				Entry("should deny if the project is archived", true, And(BeForbiddenError(), MatchError(ContainSubstring("that is archived")))),
+			)
 		})
 
 		Context("checks for shoots referencing a deleted seed", func() {
@@ -23,6 +23,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
+	"github.com/gardener/gardener/pkg/api/indexer"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
 	quotacontroller "github.com/gardener/gardener/pkg/controllermanager/controller/quota"
@@ -106,6 +107,12 @@ var _ = BeforeSuite(func() {
 	Expect(err).NotTo(HaveOccurred())
 	mgrClient = mgr.GetClient()
 
+	By("Setup field indexes")
+	Expect(indexer.AddSecretBindingQuotas(ctx, mgr.GetFieldIndexer())).To(Succeed())
+	Expect(indexer.AddCredentialsBindingQuotas(ctx, mgr.GetFieldIndexer())).To(Succeed())
+	Expect(indexer.AddShootSecretBindingName(ctx, mgr.GetFieldIndexer())).To(Succeed())
+	Expect(indexer.AddShootCredentialsBindingName(ctx, mgr.GetFieldIndexer())).To(Succeed())
+
 	By("Register controller")
 	Expect((&quotacontroller.Reconciler{
 		Config: controllermanagerconfigv1alpha1.QuotaControllerConfiguration{